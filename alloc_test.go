@@ -0,0 +1,81 @@
+package x64
+
+import "testing"
+
+// Arguments are not retained by the matcher, so encoding with the default policy must not allocate once the
+// assembler's buffer is large enough. Values are opaque to the compiler, so their boxes are not static.
+var allocCases = []struct {
+	name string
+	f    func(a *Assembler, disp int32, imm int64) error
+}{
+	{"rr", func(a *Assembler, disp int32, imm int64) error { return a.Inst(ADD, RAX, R11) }},
+	{"rm_disp32", func(a *Assembler, disp int32, imm int64) error {
+		return a.Inst(ADD, RAX, Mem{Base: RBX, Index: R12, Scale: 4, Disp: Rel32(disp)})
+	}},
+	{"mi_imm32", func(a *Assembler, disp int32, imm int64) error {
+		return a.Inst(ADD, Mem{Base: RSP, Disp: Rel32(disp), Width: 8}, Imm32(imm))
+	}},
+	{"ri_imm64", func(a *Assembler, disp int32, imm int64) error { return a.Inst(MOV, RAX, Imm64(imm<<32)) }},
+	{"vex", func(a *Assembler, disp int32, imm int64) error {
+		return a.Inst(VPADDD, Y0, Y1, Mem{Base: RDI, Disp: Rel32(disp), Width: 32})
+	}},
+	{"label", func(a *Assembler, disp int32, imm int64) error {
+		l := a.NewLabel()
+		if err := a.Inst(JMP, l); err != nil {
+			return err
+		}
+		if err := a.Inst(LEA, RAX, Mem{Base: RIP, Disp: l.Disp32(disp)}); err != nil {
+			return err
+		}
+		return a.Finalize()
+	}},
+	{"typed", func(a *Assembler, disp int32, imm int64) error {
+		if err := a.ADD_R64_R64(Reg64(RAX), Reg64(R11)); err != nil {
+			return err
+		}
+		return a.ADD_M64_I32(Mem64{Base: RBX, Disp: Rel32(disp)}, Imm32(imm))
+	}},
+	{"rmi_helper", func(a *Assembler, disp int32, imm int64) error {
+		return a.RMI(IMUL, RAX, Mem{Base: RBX, Disp: Rel32(disp)}, Imm32(imm))
+	}},
+}
+
+func TestEncodeAllocs(t *testing.T) {
+	asm := NewAssembler(make([]byte, 256))
+	disp, imm := int32(1<<20), int64(1<<20)
+	for _, c := range allocCases {
+		n := testing.AllocsPerRun(100, func() {
+			asm.Reset(nil)
+			if err := c.f(asm, disp, imm); err != nil {
+				t.Fatal(c.name, err)
+			}
+		})
+		if n != 0 {
+			t.Errorf("%s: expected no allocations, found %v", c.name, n)
+		}
+	}
+
+	m := NewInstMatcher()
+	if n := testing.AllocsPerRun(100, func() { m.Match(ADD, RAX, Mem{Base: RBX, Disp: Rel32(disp)}) }); n != 0 {
+		t.Errorf("InstMatcher.Match: expected no allocations, found %v", n)
+	}
+	if n := testing.AllocsPerRun(100, func() { asm.DryRun(ADD, RAX, Imm32(imm)) }); n != 0 {
+		t.Errorf("DryRun: expected no allocations, found %v", n)
+	}
+}
+
+func BenchmarkEncodeAllocs(b *testing.B) {
+	for _, c := range allocCases {
+		b.Run(c.name, func(b *testing.B) {
+			asm := NewAssembler(make([]byte, 256))
+			disp, imm := int32(1<<20), int64(1<<20)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				asm.Reset(nil)
+				if err := c.f(asm, disp, imm); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
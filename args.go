@@ -28,11 +28,6 @@ type RegArg interface {
 	isReg()
 }
 
-func isReg(arg Arg) bool {
-	_, ok := arg.(RegArg)
-	return ok
-}
-
 // Reg is a register argument with a specific width and family. All registers have a number
 // which distinguishes them within their family, with the exception of the IP/EIP/RIP registers.
 //
@@ -56,7 +51,7 @@ func (r Reg) Num() uint8 { return uint8(r) & 0xf }
 
 // Get the width of the register in bytes.
 func (r Reg) Width() uint8 { return r.width() }
-func (r Reg) width() uint8 { return uint8(r>>16) & 0x1f }
+func (r Reg) width() uint8 { return uint8(r>>16) & 0x3f }
 
 // Check if the register is numbered 8 or higher. The IP/EIP/RIP registers have no meaningful number,
 // so they will return false.
@@ -71,11 +66,6 @@ type ImmArg interface {
 	Int64() int64
 }
 
-func isImm(arg Arg) bool {
-	_, ok := arg.(ImmArg)
-	return ok
-}
-
 // Imm8 is an 8-bit immediate argument.
 //
 // Imm8 implements ImmArg.
@@ -125,22 +115,12 @@ type DispArg interface {
 	Int32() int32
 }
 
-func isDisp(arg Arg) bool {
-	_, ok := arg.(DispArg)
-	return ok
-}
-
 // RelArg represents a relative displacement.
 type RelArg interface {
 	DispArg
 	isRel()
 }
 
-func isRel(arg Arg) bool {
-	_, ok := arg.(RelArg)
-	return ok
-}
-
 // Rel8 is an 8-bit displacement argument.
 //
 // Rel8 implements DispArg.
@@ -191,11 +171,6 @@ var _ LabelArg = Label8(0)
 var _ LabelArg = Label16(0)
 var _ LabelArg = Label32(0)
 
-func isLabel(arg Arg) bool {
-	_, ok := arg.(LabelArg)
-	return ok
-}
-
 // Label is a reference to a label.
 //
 // Label implements LabelArg and DispArg.
@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"math"
 
-	. "github.com/wdamron/x64/feats"
+	"github.com/wdamron/x64/feats"
 )
 
 // An assembler encodes instructions into a byte slice. Label references are supported, though Finalize
@@ -14,16 +14,34 @@ import (
 // When re-using an assembler after encoding a set of instructions, the Reset method must be called beforehand.
 type Assembler struct {
 	b           buffer
-	labels      []Label
+	labels      []labelState
+	labelNames  map[string]uint16
+	localLabels map[uint32]localLabel
+	rebinds     []LabelRef // labels which were bound more than once
+	sections    []section  // all sections, if a section other than the default has been selected
+	cur         uint8      // index of the current section, whose buffer is b
+	data        []dataRegion
+	inData      bool // a data block is in progress (see BeginData)
+	dataSection uint8
+	dataStart   uint32
+	holes       []holeSite
+	cfi         []cfiProc
 	relocs      []reloc
-	feats       Feature
+	finalized   int // number of relocs which were resolved by the last call to Finalize
+	feats       feats.Feature
+	usedFeats   feats.Feature // features required by all encoded instructions
+	policy      EncodingPolicy
+	diagnostics bool
+	name        string // reported to code hooks (see Assembler.SetFunctionCode)
 	nextLabelId uint16
 	err         error
 
 	instPrefix byte        // prefix for the current instruction (LOCK, REP, etc...)
 	match      InstMatcher // current instruction (value is non-zero only while encoding)
+	layout     InstLayout  // layout of the most recently encoded instruction
+	scratch    [32]byte    // buffer for dry runs
 
-	_labels [32]Label
+	_labels [32]labelState
 	_relocs [32]reloc
 }
 
@@ -32,33 +50,36 @@ type Assembler struct {
 //
 // All CPU features will be enabled by default, for instruction-matching.
 func NewAssembler(buf []byte) *Assembler {
-	a := Assembler{b: buffer{b: buf, i: 0, sz: len(buf)}, feats: AllFeatures}
-	a.match.feats = AllFeatures
+	a := Assembler{b: buffer{b: buf, i: 0, sz: len(buf)}, feats: feats.AllFeatures}
+	a.match.feats = feats.AllFeatures
 	a.labels = a._labels[:0]
 	a.relocs = a._relocs[:0]
 	return &a
 }
 
 type reloc struct {
-	loc   uint32 // displacement offset (pc)
-	disp  int32  // additional displacement relative to the label offset (pc)
-	label uint16 // target label.id
-	_     byte
-	_     byte
-	_     byte
-	width uint8 // displacement width
+	loc     uint32 // displacement offset (pc)
+	disp    int32  // additional displacement relative to the label offset (pc)
+	label   uint16 // target label.id
+	section uint8  // section containing the displacement
+	_       byte
+	_       byte
+	width   uint8 // displacement width
 }
 
 // Get the current, allowable CPU feature-set for instruction-matching.
 //
 // See package x64/feats for all available CPU features.
-func (a *Assembler) Features() Feature { return a.feats }
+func (a *Assembler) Features() feats.Feature { return a.feats }
+
+// Get the CPU features required by all instructions which have been encoded since the assembler was last reset.
+func (a *Assembler) UsedFeatures() feats.Feature { return a.usedFeats }
 
 // Restrict the allowable CPU feature-set for instruction-matching. This will not affect
 // instructions which have already been encoded.
 //
 // See package x64/feats for all available CPU features.
-func (a *Assembler) SetFeatures(enabledFeatures Feature) {
+func (a *Assembler) SetFeatures(enabledFeatures feats.Feature) {
 	a.feats, a.match.feats = enabledFeatures, enabledFeatures
 }
 
@@ -66,7 +87,7 @@ func (a *Assembler) SetFeatures(enabledFeatures Feature) {
 // instructions which have already been encoded.
 //
 // See package x64/feats for all available CPU features.
-func (a *Assembler) DisableFeature(feature Feature) {
+func (a *Assembler) DisableFeature(feature feats.Feature) {
 	a.feats &^= feature
 	a.match.feats = a.feats
 }
@@ -75,18 +96,26 @@ func (a *Assembler) DisableFeature(feature Feature) {
 // instructions which have already been encoded.
 //
 // See package x64/feats for all available CPU features.
-func (a *Assembler) EnableFeature(feature Feature) {
+func (a *Assembler) EnableFeature(feature feats.Feature) {
 	a.feats |= feature
 	a.match.feats = a.feats
 }
 
+// Get the name of the code encoded by the assembler (see SetName).
+func (a *Assembler) Name() string { return a.name }
+
+// Set the name of the code encoded by the assembler. The name will be reported to code hooks when the code is
+// placed in executable memory with Assembler.SetFunctionCode (see RegisterCodeHook), e.g. for profilers.
+func (a *Assembler) SetName(name string) { a.name = name }
+
 // Reset an assembler before encoding a new set of instructions. All existing labels will be cleared,
 // the error will be cleared if one exists, and the PC will be reset to 0. The current set of enabled
-// CPU features will be retained.
+// CPU features and the assembler's name will be retained.
 //
 // If buf is not nil, the assembler's buffer will be replaced with buf; otherwise, the assembler's
 // buffer will be reset and possibly resized.
 func (a *Assembler) Reset(buf []byte) {
+	a.resetSections()
 	if buf != nil {
 		a.b = buffer{b: buf, i: 0, sz: len(buf)}
 	} else {
@@ -95,7 +124,16 @@ func (a *Assembler) Reset(buf []byte) {
 	a.nextLabelId = 0
 	a.err = nil
 	a.labels = a._labels[:0]
+	a.labelNames = nil
+	a.localLabels = nil
+	a.rebinds = nil
 	a.relocs = a._relocs[:0]
+	a.finalized = 0
+	a.data = nil
+	a.inData = false
+	a.holes = nil
+	a.cfi = nil
+	a.usedFeats = 0
 }
 
 // Get the first error which occured while encoding or finalizing instructions, since the assembler
@@ -103,10 +141,17 @@ func (a *Assembler) Reset(buf []byte) {
 func (a *Assembler) Err() error { return a.err }
 
 // Get the current encoded instructions. This method may be called multiple times and does not affect the
-// underlying code buffer.
-func (a *Assembler) Code() []byte { return a.b.Get() }
+// underlying code buffer. If multiple sections have been used, a new slice will be allocated, containing
+// all sections which have not been placed separately (see Section). The code must be loaded at an address
+// aligned to CodeAlign.
+func (a *Assembler) Code() []byte {
+	if a.sections == nil {
+		return a.b.Get()
+	}
+	return a.concatSections()
+}
 
-// Get the current program counter (i.e. number of bytes written to the encoding buffer).
+// Get the current program counter (i.e. number of bytes written to the current section's encoding buffer).
 func (a *Assembler) PC() uint32 { return uint32(a.b.i) }
 
 // Set the current program counter (i.e. number of bytes written to the encoding buffer).
@@ -118,19 +163,18 @@ func (a *Assembler) SetPC(pc uint32) {
 }
 
 // Align the program counter to a power-of-2 offset. Intermediate space will be filled with NOPs.
-func (a *Assembler) AlignPC(pow2 uint8) { a.b.Nop(pow2 - (uint8(a.PC()) & (pow2 - 1))) }
+func (a *Assembler) AlignPC(pow2 uint8) {
+	a.b.requireAlign(uint32(pow2))
+	a.b.Nop(pow2 - (uint8(a.PC()) & (pow2 - 1)))
+}
 
 // Encode inst with args to the encoding buffer. If no matching instruction-encoding is found,
-// ErrNoMatch will be returned.
+// ErrNoMatch will be returned. The arguments are not retained, so encoding does not allocate.
 func (a *Assembler) Inst(inst Inst, args ...Arg) error {
 	if a.err != nil {
 		return a.err
 	}
-	if a.err = a.match.Match(inst, args...); a.err != nil {
-		return a.err
-	}
-	a.err = a.emitInst()
-	return a.err
+	return a.encode(a.match.Match(inst, args...))
 }
 
 // Encode a previously matched instruction to the encoding buffer.
@@ -138,13 +182,49 @@ func (a *Assembler) InstFrom(matcher *InstMatcher) error {
 	if a.err != nil {
 		return a.err
 	}
-	if a.feats&matcher.feats != matcher.feats {
-		a.err = fmt.Errorf("Assembler does not support CPU features for previously matched %s instruction", matcher.inst.Name())
-	}
+	memo := a.match.memo
 	a.match = *matcher
-	a.err = a.emitInst()
-	a.match.feats = a.feats
-	return a.err
+	a.match.memo = memo
+	a.match.args = a.match._args[:len(matcher.args)]
+	a.match.imms = a.match._imms[:len(matcher.imms)]
+	var err error
+	if !a.feats.Has(matcher.enc.features()) {
+		err = a.match.errorf(ReasonFeatures, -1, "Assembler does not support CPU features for previously matched %s instruction", matcher.inst.Name())
+	}
+	err = a.encode(err)
+	a.match.feats, a.match.policy, a.match.diagnostics = a.feats, a.policy, a.diagnostics
+	return err
+}
+
+// Encode the matched instruction, unless matching failed. If an error occurs, it will be retained by the
+// assembler and partially encoded output will be discarded.
+func (a *Assembler) encode(err error) error {
+	pc, holes, relocs := a.PC(), len(a.holes), len(a.relocs)
+	if err == nil {
+		em := emitter{buf: &a.b, match: &a.match, layout: &a.layout, prefix: a.instPrefix, asm: a}
+		err = em.emitInst()
+	}
+	if err != nil {
+		if e, ok := err.(*EncodeError); ok && e.PC < 0 {
+			e.PC = int(pc)
+		}
+		a.b.i = int(pc)
+		a.holes = a.holes[:holes]
+		a.relocs = a.relocs[:relocs]
+		a.err = err
+		return err
+	}
+	for i := holes; i < len(a.holes); i++ {
+		a.holes[i].end = a.PC()
+	}
+	// relative displacements are relative to the end of the instruction, which may be followed by an immediate
+	// (e.g. CMP [RIP+label], imm32):
+	for i := relocs; i < len(a.relocs); i++ {
+		r := &a.relocs[i]
+		r.disp -= int32(a.PC() - (r.loc + uint32(r.width)))
+	}
+	a.usedFeats |= a.match.enc.features()
+	return nil
 }
 
 // Encode length bytes of NOP instructions to the encoding buffer.
@@ -210,11 +290,7 @@ func (a *Assembler) RR(inst Inst, dst, src Reg) error {
 	if a.err != nil {
 		return a.err
 	}
-	if a.err = a.match.RR(inst, dst, src); a.err != nil {
-		return a.err
-	}
-	a.err = a.emitInst()
-	return a.err
+	return a.encode(a.match.RR(inst, dst, src))
 }
 
 // Encode inst with a register destination, register source, and immediate to the encoding buffer.
@@ -223,11 +299,7 @@ func (a *Assembler) RRI(inst Inst, dst, src Reg, imm ImmArg) error {
 	if a.err != nil {
 		return a.err
 	}
-	if a.err = a.match.RRI(inst, dst, src, imm); a.err != nil {
-		return a.err
-	}
-	a.err = a.emitInst()
-	return a.err
+	return a.encode(a.match.RRI(inst, dst, src, imm))
 }
 
 // Encode inst with a register destination and memory source to the encoding buffer.
@@ -236,11 +308,7 @@ func (a *Assembler) RM(inst Inst, dst Reg, src Mem) error {
 	if a.err != nil {
 		return a.err
 	}
-	if a.err = a.match.RM(inst, dst, src); a.err != nil {
-		return a.err
-	}
-	a.err = a.emitInst()
-	return a.err
+	return a.encode(a.match.RM(inst, dst, src))
 }
 
 // Encode inst with a memory destination and register source to the encoding buffer.
@@ -249,11 +317,7 @@ func (a *Assembler) MR(inst Inst, dst Mem, src Reg) error {
 	if a.err != nil {
 		return a.err
 	}
-	if a.err = a.match.MR(inst, dst, src); a.err != nil {
-		return a.err
-	}
-	a.err = a.emitInst()
-	return a.err
+	return a.encode(a.match.MR(inst, dst, src))
 }
 
 // Encode inst with a register destination, memory source, and immediate to the encoding buffer.
@@ -262,11 +326,7 @@ func (a *Assembler) RMI(inst Inst, dst Reg, src Mem, imm ImmArg) error {
 	if a.err != nil {
 		return a.err
 	}
-	if a.err = a.match.RMI(inst, dst, src, imm); a.err != nil {
-		return a.err
-	}
-	a.err = a.emitInst()
-	return a.err
+	return a.encode(a.match.RMI(inst, dst, src, imm))
 }
 
 // Encode inst with a memory destination, register source, and immediate to the encoding buffer.
@@ -275,11 +335,7 @@ func (a *Assembler) MRI(inst Inst, dst Mem, src Reg, imm ImmArg) error {
 	if a.err != nil {
 		return a.err
 	}
-	if a.err = a.match.MRI(inst, dst, src, imm); a.err != nil {
-		return a.err
-	}
-	a.err = a.emitInst()
-	return a.err
+	return a.encode(a.match.MRI(inst, dst, src, imm))
 }
 
 // Encode inst with a register destination and immediate to the encoding buffer.
@@ -288,11 +344,7 @@ func (a *Assembler) RI(inst Inst, dst Reg, imm ImmArg) error {
 	if a.err != nil {
 		return a.err
 	}
-	if a.err = a.match.RI(inst, dst, imm); a.err != nil {
-		return a.err
-	}
-	a.err = a.emitInst()
-	return a.err
+	return a.encode(a.match.RI(inst, dst, imm))
 }
 
 // Encode inst with a memory destination and immediate to the encoding buffer.
@@ -301,11 +353,7 @@ func (a *Assembler) MI(inst Inst, dst Mem, imm ImmArg) error {
 	if a.err != nil {
 		return a.err
 	}
-	if a.err = a.match.MI(inst, dst, imm); a.err != nil {
-		return a.err
-	}
-	a.err = a.emitInst()
-	return a.err
+	return a.encode(a.match.MI(inst, dst, imm))
 }
 
 // Write raw data to the encoding buffer.
@@ -326,74 +374,107 @@ func (a *Assembler) Raw64(i int64) { a.b.Int64(i) }
 // Create a new label at the current PC. To update the PC assigned to the label, call the SetLabel
 // method with the label when the PC reaches the desired offset -- this must be done before calling
 // the Finalize method.
-func (a *Assembler) NewLabel() Label {
-	l := Label{pc: a.PC(), id: a.nextLabelId}
-	a.labels = append(a.labels, l)
-	a.nextLabelId++
-	return l
+func (a *Assembler) NewLabel() Label { return a.newLabel(labelImplicit, "") }
+
+// Update the PC assigned to the label using the current PC. A label may be bound once with SetLabel,
+// after it was created by DeclareLabel, NamedLabel or NewLabel; Finalize will fail if a label is bound
+// more than once.
+func (a *Assembler) SetLabel(label LabelArg) {
+	l := a.labelState(label)
+	if l == nil {
+		return
+	}
+	if l.state == labelBound {
+		a.rebinds = append(a.rebinds, LabelRef{Id: label.label(), Name: l.name, PC: a.PC()})
+	}
+	l.pc, l.state, l.section = a.PC(), labelBound, a.cur
 }
 
-// Update the PC assigned to the label using the current PC.
-func (a *Assembler) SetLabel(label LabelArg) { a.labels[label.label()].pc = a.PC() }
-
-// Get the PC currently assigned to the label.
-func (a *Assembler) GetLabelPC(label LabelArg) uint32 { return a.labels[label.label()].pc }
+// Get the PC currently assigned to the label, relative to the start of the label's section.
+func (a *Assembler) GetLabelPC(label LabelArg) uint32 {
+	if l := a.labelState(label); l != nil {
+		return l.pc
+	}
+	return 0
+}
 
-// Update the PC assigned to the label using the given PC. Finalize must be called to update
+// Update the PC assigned to the label using the given PC within the current section. Finalize must be called to update
 // existing label references after labels have been reassigned to new offsets, though Finalize
 // only needs to be called after a set of updates (i.e. not after each update).
-func (a *Assembler) SetLabelPC(label LabelArg, pc uint32) { a.labels[label.label()].pc = pc }
+func (a *Assembler) SetLabelPC(label LabelArg, pc uint32) {
+	if l := a.labelState(label); l != nil {
+		l.pc, l.state, l.section = pc, labelBound, a.cur
+	}
+}
 
 func (a *Assembler) reloc(labelId uint16, dispSize uint8) {
 	a.relocs = append(a.relocs, reloc{
-		loc:   a.PC() - uint32(dispSize),
-		label: labelId,
-		width: dispSize,
+		loc:     a.PC() - uint32(dispSize),
+		label:   labelId,
+		section: a.cur,
+		width:   dispSize,
 	})
 }
 
-func (a *Assembler) relocDisp(ld LabelDisp) {
-	width := ld.width()
+func (a *Assembler) relocDisp(labelId uint16, disp int32, width uint8) {
 	a.relocs = append(a.relocs, reloc{
-		loc:   a.PC() - uint32(width),
-		disp:  ld.disp,
-		label: ld.labelid,
-		width: width,
+		loc:     a.PC() - uint32(width),
+		disp:    disp,
+		label:   labelId,
+		section: a.cur,
+		width:   width,
 	})
 }
 
 // Process all label references. Each label reference will have its displacement patched with the relative
-// offset to the label (optionally with additional displacement for LabelDisp arguments).
+// offset to the label (optionally with additional displacement for LabelDisp arguments). References between
+// sections are resolved for the layout of Code, with each section aligned as described by Section, so the code
+// must be loaded at an address aligned to CodeAlign.
 func (a *Assembler) Finalize() error {
 	if a.err != nil {
 		return a.err
 	}
+	if err := a.checkLabels(); err != nil {
+		a.err = err
+		return err
+	}
+	bases := a.sectionBases()
 	ls := a.labels
 	rs := a.relocs
 	for _, r := range rs {
 		l := ls[r.label]
 		delta := int(r.loc) + int(r.width) - int(l.pc)
+		if bases != nil {
+			delta += int(bases[r.section] - bases[l.section])
+		}
 		disp := -delta + int(r.disp)
-		switch r.width {
-		case 1:
-			if disp > math.MaxInt8 || disp < math.MinInt8 {
-				a.err = fmt.Errorf("Relative label offset exceeds range for 8-bit immediate")
-				return a.err
-			}
-			a.b.b[r.loc] = byte(disp)
-		case 2:
-			if disp > math.MaxInt16 || disp < math.MinInt16 {
-				a.err = fmt.Errorf("Relative label offset exceeds range for 16-bit immediate")
-				return a.err
-			}
-			binary.LittleEndian.PutUint16(a.b.b[r.loc:], uint16(disp))
-		case 4:
-			if disp > math.MaxInt32 || disp < math.MinInt32 {
-				a.err = fmt.Errorf("Relative label offset exceeds range for 32-bit immediate")
-				return a.err
-			}
-			binary.LittleEndian.PutUint32(a.b.b[r.loc:], uint32(disp))
+		if err := patchRel(a.sectionBuf(r.section).b, r.loc, r.width, disp); err != nil {
+			a.err = err
+			return err
+		}
+	}
+	a.finalized = len(rs)
+	return nil
+}
+
+// Patch a relative displacement of width bytes at loc, checking that disp is within range.
+func patchRel(b []byte, loc uint32, width uint8, disp int) error {
+	switch width {
+	case 1:
+		if disp > math.MaxInt8 || disp < math.MinInt8 {
+			return &EncodeError{PC: int(loc), Operand: -1, Reason: ReasonLabel, Err: fmt.Errorf("Relative label offset exceeds range for 8-bit immediate")}
+		}
+		b[loc] = byte(disp)
+	case 2:
+		if disp > math.MaxInt16 || disp < math.MinInt16 {
+			return &EncodeError{PC: int(loc), Operand: -1, Reason: ReasonLabel, Err: fmt.Errorf("Relative label offset exceeds range for 16-bit immediate")}
+		}
+		binary.LittleEndian.PutUint16(b[loc:], uint16(disp))
+	case 4:
+		if disp > math.MaxInt32 || disp < math.MinInt32 {
+			return &EncodeError{PC: int(loc), Operand: -1, Reason: ReasonLabel, Err: fmt.Errorf("Relative label offset exceeds range for 32-bit immediate")}
 		}
+		binary.LittleEndian.PutUint32(b[loc:], uint32(disp))
 	}
 	return nil
 }
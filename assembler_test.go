@@ -1,12 +1,14 @@
 package x64
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
 	"unsafe"
 
-	. "github.com/wdamron/x64/feats"
+	"github.com/wdamron/x64/feats"
 	"golang.org/x/arch/x86/x86asm"
 )
 
@@ -37,6 +39,8 @@ func TestStaticDataSize(t *testing.T) {
 	size += len(instNames)
 	// for each arg-pattern: 8 bytes for the format + 1 byte for the argp constant:
 	size += len(argpFormats) * int(unsafe.Sizeof(argpFormats[0])+unsafe.Sizeof(argp_))
+	// for each feature-set: 8 bytes for the features:
+	size += len(featSets) * int(unsafe.Sizeof(featSets[0]))
 	t.Logf("static data size %v", size)
 	if size > 0xffff { // this can be revisited if the layout changes
 		t.Fatalf("static data size exceeds %v", 0xfff)
@@ -164,6 +168,22 @@ func TestEncode(t *testing.T) {
 	check("lea rax, ptr [rip+0x10]", LEA, RAX, Mem{Base: RIP, Disp: Rel8(16)})
 	checkregmem("lea rax, ptr [rip+0x10]", LEA, RAX, Mem{Base: RIP, Disp: Rel8(16)})
 
+	// YMM registers are 32 bytes wide:
+	if Y0.Width() != 32 {
+		t.Fatalf("Expected width 32 for ymm0, found %d", Y0.Width())
+	}
+	check("vmovdqa ymm0, ymm1", VMOVDQA, Y0, Y1)
+	checkregreg("vmovdqa ymm0, ymm1", VMOVDQA, Y0, Y1)
+	check("vmovdqa ymm0, ymmword ptr [rdi]", VMOVDQA, Y0, Mem{Base: RDI, Width: 32})
+
+	// m80 operands are 10 bytes wide:
+	check("fld st0, ptr [rbx]", FLD, Mem{Base: RBX, Width: 10})
+	check("fstp ptr [rbx], st0", FSTP, Mem{Base: RBX, Width: 10})
+
+	// immediates with fixed sizes do not conflict:
+	check("enter 0x20, 0x0", ENTER, Imm16(32), Imm8(0))
+	check("enter 0x100, 0x1", ENTER, Imm16(0x100), Imm8(1))
+
 	asm.Reset(nil)
 	if err := asm.Inst(VSHUFPD, X0, X1, Mem{Base: RBX, Width: 16}, Imm8(2)); err != nil {
 		t.Logf("vshufpd xmm0, xmm1, xmmword ptr [rbx], 0x2 = %#x", asm.Code())
@@ -198,8 +218,8 @@ func TestEncode(t *testing.T) {
 	if err := asm.Inst(VSHUFPD, X0, X1, X3, Imm8(1)); err != nil {
 		t.Fatal(err)
 	}
-	asm.DisableFeature(AVX)
-	if err := asm.Inst(VSHUFPD, X0, X1, X3, Imm8(1)); err != ErrNoMatch {
+	asm.DisableFeature(feats.AVX)
+	if err := asm.Inst(VSHUFPD, X0, X1, X3, Imm8(1)); !errors.Is(err, ErrNoMatch) {
 		t.Fatalf("Expected no matching instruction for VSHUFPD with AVX disabled")
 	}
 
@@ -400,6 +420,13 @@ func TestBasicInstructionSet(t *testing.T) {
 		_expect(expect)
 	}
 
+	// the second pass selects memoized encodings:
+	basicInstructionSet(check)
+	basicInstructionSet(check)
+}
+
+// Instructions for TestBasicInstructionSet and BenchmarkBasicInstructionSet.
+func basicInstructionSet(check func(expect string, inst Inst, args ...Arg)) {
 	check("push rax", PUSH, RAX)
 	check("push r9", PUSH, R9)
 	check("pop rax", POP, RAX)
@@ -456,6 +483,25 @@ func TestBasicInstructionSet(t *testing.T) {
 	check("movdqa xmmword ptr [rdi], xmm0", MOVDQA, Mem{Base: RDI, Width: 16}, X0)
 	check("movdqu xmm0, xmmword ptr [rdi]", MOVDQU, X0, Mem{Base: RDI, Width: 16})
 	check("movdqu xmmword ptr [rdi], xmm0", MOVDQU, Mem{Base: RDI, Width: 16}, X0)
+
+	// operands with fixed sizes do not determine the operation size:
+	check("shl rax, cl", SHL, RAX, CL)
+	check("movzx eax, al", MOVZX, EAX, AL)
+	check("movsxd rbx, edi", MOVSXD, RBX, EDI)
+	check("movq xmm1, rax", MOVQ, X1, RAX)
+	check("crc32 rax, byte ptr [rbx]", CRC32, RAX, Mem{Base: RBX, Width: 1})
+	check("invpcid rax, xmmword ptr [rbx]", INVPCID, RAX, Mem{Base: RBX, Width: 16})
+	check("crc32 eax, cl", CRC32, EAX, CL)
+	check("in al, dx", IN, AL, DX)
+	check("out dx, ax", OUT, DX, AX)
+	check("mov rax, cr0", MOV, RAX, CR0)
+	check("pextrw eax, xmm1, 0x1", PEXTRW, EAX, X1, Imm8(1))
+	check("pinsrw xmm1, eax, 0x1", PINSRW, X1, EAX, Imm8(1))
+	check("cvtsi2sd xmm0, rax", CVTSI2SD, X0, RAX)
+	check("cvtsi2sd xmm0, eax", CVTSI2SD, X0, EAX)
+	check("movd xmm1, eax", MOVD, X1, EAX)
+	check("vpbroadcastb xmm0, byte ptr [rbx]", VPBROADCASTB, X0, Mem{Base: RBX, Width: 1})
+	check("vcvtsi2sd xmm0, xmm1, rax", VCVTSI2SD, X0, X1, RAX)
 }
 
 func TestConditionCodes(t *testing.T) {
@@ -604,3 +650,1113 @@ func TestPrefixes(t *testing.T) {
 	checkRep("rep scasq qword ptr [rdi]", SCASQ)
 	checkRepne("repne scasq qword ptr [rdi]", SCASQ)
 }
+
+func TestFeatureLevels(t *testing.T) {
+	asm := NewAssembler(make([]byte, 256))
+	expect := func(ok bool, inst Inst, args ...Arg) {
+		asm.Reset(nil)
+		if err := asm.Inst(inst, args...); (err == nil) != ok {
+			t.Fatalf("%s with features %v: err = %v", inst.Name(), asm.Features(), err)
+		}
+	}
+	asm.SetFeatures(feats.V1)
+	expect(true, PXOR, X1, X2)
+	expect(false, POPCNT, RAX, RBX)
+	expect(false, CRC32, RAX, RBX)
+	asm.SetFeatures(feats.V2)
+	expect(true, POPCNT, RAX, RBX)
+	expect(false, VFMADD132PD, X1, X2, X3)
+	expect(false, MOVBE, RAX, Mem{Base: RBX})
+	asm.SetFeatures(feats.V3)
+	expect(true, VFMADD132PD, X1, X2, X3)
+	expect(true, MOVBE, RAX, Mem{Base: RBX})
+	expect(true, LZCNT, RAX, RBX)
+	expect(false, XBEGIN, Rel32(0))
+	asm.SetFeatures(feats.HASWELL)
+	expect(true, XBEGIN, Rel32(0))
+
+	for _, level := range []feats.Feature{feats.V1, feats.V2, feats.V3, feats.V4, feats.CORE2, feats.HASWELL, feats.K8, feats.ZNVER1} {
+		asm.SetFeatures(level)
+		for _, inst := range []Inst{MFENCE, LFENCE, SFENCE, SYSCALL, SYSRET} {
+			expect(true, inst)
+		}
+	}
+	// instructions beyond any level are rejected by every level:
+	beyond := []struct {
+		inst Inst
+		args []Arg
+	}{
+		{ADCX, []Arg{RAX, RBX}},
+		{ADOX, []Arg{RAX, RBX}},
+		{AESENC, []Arg{X1, X2}},
+		{VAESENC, []Arg{X1, X2, X3}},
+		{PCLMULQDQ, []Arg{X1, X2, Imm8(0)}},
+		{VPCLMULQDQ, []Arg{X1, X2, X3, Imm8(0)}},
+		{RDRAND, []Arg{RAX}},
+		{RDSEED, []Arg{RAX}},
+		{RDPID, []Arg{RAX}},
+		{RDTSCP, nil},
+		{XGETBV, nil},
+		{XSAVE, []Arg{Mem{Base: RBX}}},
+		{XSAVEOPT64, []Arg{Mem{Base: RBX}}},
+	}
+	for _, level := range []feats.Feature{feats.V1, feats.V2, feats.V3, feats.V4} {
+		asm.SetFeatures(level)
+		for _, c := range beyond {
+			expect(false, c.inst, c.args...)
+		}
+	}
+	asm.SetFeatures(feats.ZNVER2)
+	for _, c := range beyond {
+		expect(true, c.inst, c.args...)
+	}
+
+	asm.SetFeatures(feats.ZNVER4)
+	expect(false, SLWPCB, RAX)
+	expect(false, VPPERM, X1, X2, X3, X4)
+	expect(true, EXTRQ, X1, X2)
+	asm.SetFeatures(feats.BDVER1)
+	expect(true, SLWPCB, RAX)
+	expect(true, VPPERM, X1, X2, X3, X4)
+}
+
+func TestEncodings(t *testing.T) {
+	insts := Insts()
+	if len(insts) != len(instNameOffsets) {
+		t.Fatalf("Expected %d instructions, found %d", len(instNameOffsets), len(insts))
+	}
+	for i, inst := range insts {
+		if i > 0 && insts[i-1].Id() >= inst.Id() {
+			t.Fatalf("Instructions are not ordered: %s, %s", insts[i-1].Name(), inst.Name())
+		}
+	}
+	if insts[ADD.Id()-1] != ADD {
+		t.Fatalf("Expected %#x for ADD, found %#x", ADD, insts[ADD.Id()-1])
+	}
+
+	for _, expect := range []string{
+		"ADD al, imm8",
+		"ADD r16|r32|r64, imm8",
+		"ADD ax/eax/rax, imm16|imm32",
+		"ADD r16|r32|r64, r/m16|r/m32|r/m64",
+	} {
+		found := false
+		for _, e := range ADD.Encodings() {
+			found = found || e.String() == expect
+		}
+		if !found {
+			t.Fatalf("Expected encoding %q for ADD", expect)
+		}
+	}
+
+	found := false
+	for _, e := range FLD.Encodings() {
+		if e.String() == "FLD m80" {
+			found = e.Operands[0].Kind == OperandMem && e.Operands[0].Size == 10
+		}
+	}
+	if !found {
+		t.Fatalf("Expected a 10-byte memory operand for FLD m80")
+	}
+
+	vex := VFMADD132PD.Encodings()[0]
+	if !vex.VEX || vex.Map != 2 || vex.Features != feats.FMA || vex.Sizing != SizingVexL || vex.Operands[0].Kind != OperandXMM {
+		t.Fatalf("Unexpected encoding for VFMADD132PD: %+v", vex)
+	}
+
+	var m InstMatcher
+	if err := m.Match(ADD, RAX, Imm8(1)); err != nil {
+		t.Fatal(err)
+	}
+	e := m.Encoding()
+	if e.Id != m.EncodingId() || e.Reg != 0 || len(e.Opcode) != 1 || e.Opcode[0] != 0x83 || e.Sizing != SizingAuto {
+		t.Fatalf("Unexpected encoding for ADD: %+v", e)
+	}
+}
+
+func TestLayout(t *testing.T) {
+	asm := NewAssembler(make([]byte, 256))
+	label := asm.NewLabel()
+	asm.Inst(ADD, RAX, RBX)
+	pc := asm.PC()
+
+	expect := func(expect InstLayout, inst Inst, args ...Arg) {
+		l, err := asm.DryRun(inst, args...)
+		if err != nil {
+			t.Fatal(inst.Name(), "--", err)
+		}
+		if l != expect {
+			t.Fatalf("%s: layout = %+v != %+v", inst.Name(), l, expect)
+		}
+	}
+	expect(InstLayout{Len: 3, RexOffset: 0, RexLen: 1, OpcodeOffset: 1, OpcodeLen: 1, ModRMOffset: 2, SIBOffset: -1, DispOffset: -1, ImmOffset: -1},
+		ADD, RAX, RBX)
+	expect(InstLayout{Len: 8, RexOffset: -1, OpcodeOffset: 0, OpcodeLen: 1, ModRMOffset: 1, SIBOffset: 2, DispOffset: 3, DispLen: 1, ImmOffset: 4, ImmLen: 4},
+		MOV, Mem{Base: RSP, Disp: Rel8(8), Width: 4}, Imm32(1))
+	expect(InstLayout{Len: 6, PrefixLen: 1, RexOffset: 1, RexLen: 3, OpcodeOffset: 4, OpcodeLen: 1, ModRMOffset: 5, SIBOffset: -1, DispOffset: -1, ImmOffset: -1},
+		VFMADD132PD, X1, X2, Mem{Base: EBX, Width: 16})
+	expect(InstLayout{Len: 5, RexOffset: -1, OpcodeOffset: 0, OpcodeLen: 1, ModRMOffset: -1, SIBOffset: -1, DispOffset: -1, ImmOffset: 1, ImmLen: 4},
+		JMP, label.Rel32())
+
+	if asm.PC() != pc || len(asm.relocs) != 0 || fmt.Sprintf("%#x", asm.Code()) != "0x4801d8" {
+		t.Fatalf("Dry-run modified the assembler: pc = %v, relocs = %v, code = %#x", asm.PC(), len(asm.relocs), asm.Code())
+	}
+
+	var m InstMatcher
+	if err := m.Match(MOV, RAX, Imm64(1<<40)); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := m.EncodedLen(); err != nil || n != 10 {
+		t.Fatalf("EncodedLen() = %v, %v", n, err)
+	}
+	// the layout is encoded to the matcher's scratch buffer, without modifying the match:
+	asm.Reset(nil)
+	if err := asm.InstFrom(&m); err != nil || fmt.Sprintf("%x", asm.Code()) != "48b80000000000010000" {
+		t.Fatalf("encoded = %x, %v", asm.Code(), err)
+	}
+}
+
+func TestEncodingPolicy(t *testing.T) {
+	asm := NewAssembler(make([]byte, 256))
+	asm.SetEncodingPolicy(Shortest)
+	expect := func(expect string, inst Inst, args ...Arg) {
+		asm.Reset(nil)
+		if err := asm.Inst(inst, args...); err != nil {
+			t.Fatal(inst.Name(), "--", err)
+		}
+		if fmt.Sprintf("%x", asm.Code()) != expect {
+			t.Fatalf("%s: encoded = %x != %s", inst.Name(), asm.Code(), expect)
+		}
+	}
+	expect("4883c001", ADD, RAX, Imm32(1))
+	expect("83c001", ADD, EAX, Imm32(1))
+	expect("05e8030000", ADD, EAX, Imm32(1000))
+	expect("0401", ADD, AL, Imm8(1))
+	expect("6683f9ff", CMP, CX, Imm16(-1))
+	expect("b801000000", MOV, RAX, Imm64(1))
+	expect("bbffffffff", MOV, RBX, Imm64(0xffffffff))
+	expect("8b4308", MOV, EAX, Mem{Base: RBX, Disp: Rel32(8), Width: 4})
+	expect("e9fbffffff", JMP, Rel32(-5))
+
+	// immediates are sign-extended from the operation size, and zero displacements are dropped:
+	expect("83e080", AND, EAX, Imm64(0xffffff80))
+	expect("6683e180", AND, CX, Imm64(0xff80))
+	expect("66b8ffff", MOV, AX, Imm64(0xffff))
+	expect("04c8", ADD, AL, Imm64(200))
+	expect("80c1c8", ADD, CL, Imm64(200))
+	expect("488d03", LEA, RAX, Mem{Base: RBX, Disp: Rel32(0)})
+	expect("488d4500", LEA, RAX, Mem{Base: RBP, Disp: Rel32(0)})
+	expect("488d0500000000", LEA, RAX, Mem{Base: RIP, Disp: Rel32(0)})
+	asm.Reset(nil)
+	if err := asm.Inst(AND, RAX, Imm64(0xffffff80)); err == nil {
+		t.Fatalf("Expected a range error for a 64-bit operation, found %x", asm.Code())
+	}
+
+	var m InstMatcher
+	m.SetEncodingPolicy(Shortest)
+	if err := m.Match(ADD, RCX, Imm64(1)); err != nil {
+		t.Fatal(err)
+	}
+	if n, _ := m.EncodedLen(); n != 4 || m.EncodingPolicy() != Shortest {
+		t.Fatalf("Expected a 4-byte encoding, found %v bytes", n)
+	}
+}
+
+func TestImmRange(t *testing.T) {
+	asm := NewAssembler(make([]byte, 256))
+	expect := func(expect string, inst Inst, args ...Arg) {
+		asm.Reset(nil)
+		if err := asm.Inst(inst, args...); err != nil {
+			t.Fatal(inst.Name(), "--", err)
+		}
+		if fmt.Sprintf("%x", asm.Code()) != expect {
+			t.Fatalf("%s: encoded = %x != %s", inst.Name(), asm.Code(), expect)
+		}
+	}
+	expectErr := func(inst Inst, args ...Arg) {
+		asm.Reset(nil)
+		err := asm.Inst(inst, args...)
+		if err == nil || !strings.Contains(err.Error(), "operand 2") {
+			t.Fatalf("%s: expected a range error, found %v (encoded = %x)", inst.Name(), err, asm.Code())
+		}
+	}
+	expect("480501000000", ADD, RAX, Imm32(1))
+	expect("4881c1ffffff7f", ADD, RCX, Imm64(0x7fffffff))
+	expect("48b8ffffffffffffffff", MOV, RAX, Imm64(-1))
+	expect("48b80000000000010000", MOV, RAX, Imm64(1<<40))
+	expect("c7c0ffffffff", MOV, EAX, Imm64(0xffffffff))
+	expect("66c7c0ffff", MOV, AX, Imm32(0xffff))
+	expectErr(ADD, RAX, Imm64(1<<40))
+	expectErr(ADD, RCX, Imm64(0xffffffff))
+	expectErr(ADD, AX, Imm32(0x10000))
+}
+
+func TestEncodeErrors(t *testing.T) {
+	asm := NewAssembler(make([]byte, 256))
+	asm.Inst(NOP)
+	asm.DisableFeature(feats.AVX)
+
+	err := asm.Inst(VSHUFPD, X0, X1, X3, Imm8(1))
+	var e *EncodeError
+	if !errors.Is(err, ErrNoMatch) || !errors.As(err, &e) {
+		t.Fatalf("Expected ErrNoMatch, found %v", err)
+	}
+	if e.Inst != VSHUFPD || e.PC != 1 || e.Reason != ReasonNoMatch || len(e.Args) != 4 || len(e.Candidates) == 0 {
+		t.Fatalf("Unexpected error: %+v", e)
+	}
+	for _, c := range e.Candidates {
+		if c.Reason != RejectFeatures && c.Reason != RejectOperandCount {
+			t.Fatalf("Unexpected rejection: %v", c)
+		}
+	}
+	if asm.Err() != err || asm.PC() != 1 {
+		t.Fatalf("Expected the assembler to retain the error")
+	}
+
+	m := NewInstMatcher()
+	expect := func(reason ErrorReason, operand int, inst Inst, args ...Arg) {
+		err := m.Match(inst, args...)
+		if !errors.As(err, &e) || e.Reason != reason || e.Operand != operand || e.PC != -1 {
+			t.Fatalf("%s: expected %v error for operand %d, found %v", inst.Name(), reason, operand, err)
+		}
+	}
+	expect(ReasonMemory, 1, MOV, RAX, Mem{Base: RAX, Index: RSP, Scale: 2})
+	expect(ReasonRange, 1, ADD, RAX, Imm64(1<<40))
+	expect(ReasonOperandSize, 1, ADD, RAX, EBX)
+
+	err = m.Match(ADD, RAX, X1)
+	if !errors.As(err, &e) || !errors.Is(err, ErrNoMatch) {
+		t.Fatalf("Expected ErrNoMatch, found %v", err)
+	}
+	for _, c := range e.Candidates {
+		if c.Reason == RejectOperandKind && c.Operand == 1 {
+			return
+		}
+	}
+	t.Fatalf("Expected a rejected candidate for operand 2: %v", e.Candidates)
+}
+
+func TestDiagnostics(t *testing.T) {
+	asm := NewAssembler(make([]byte, 256))
+	asm.SetDiagnostics(true)
+	var e *EncodeError
+
+	err := asm.Inst(ADD, RAX, Imm64(1<<40))
+	if !errors.As(err, &e) || e.Diagnosis == nil || len(e.Diagnosis.Suggestions) == 0 || e.Diagnosis.FeaturesOnly {
+		t.Fatalf("Expected a diagnosis, found %v", err)
+	}
+	if s := e.Diagnosis.Suggestions[0].String(); s != "ADD rax, imm32: immediate Imm64 too wide: value 0x10000000000 exceeds imm32" {
+		t.Fatalf("Unexpected suggestion: %s", s)
+	}
+
+	asm.Reset(nil)
+	err = asm.Inst(SHL, RAX, Imm32(3))
+	if !errors.As(err, &e) || e.Diagnosis == nil || !strings.Contains(err.Error(), "SHL r/m64, imm8: immediate Imm32 too wide") {
+		t.Fatalf("Expected a diagnosis, found %v", err)
+	}
+
+	asm.Reset(nil)
+	asm.SetFeatures(feats.V2)
+	err = asm.Inst(VPXOR, X1, X2, X3)
+	if !errors.As(err, &e) || e.Diagnosis == nil || !e.Diagnosis.FeaturesOnly || e.Diagnosis.MissingFeatures != feats.AVX {
+		t.Fatalf("Expected a diagnosis for disabled features, found %v", err)
+	}
+	if !strings.Contains(err.Error(), "requires disabled CPU features: AVX") {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var m InstMatcher
+	if d := m.Diagnose(ADD, RAX, RBX); d != nil {
+		t.Fatalf("Expected no diagnosis for a matching instruction, found %v", d)
+	}
+	if d := m.Diagnose(ADD, RAX, X1); d == nil || len(d.Suggestions) == 0 {
+		t.Fatal("Expected a diagnosis")
+	}
+}
+
+func TestLabels(t *testing.T) {
+	asm := NewAssembler(make([]byte, 256))
+
+	// forward reference to a declared label
+	done := asm.DeclareLabel()
+	asm.Inst(JMP, done.Rel8())
+	asm.Inst(ADD, RAX, Imm8(1))
+	asm.SetLabel(done)
+	if err := asm.Finalize(); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprintf("%#x", asm.Code()) != "0xeb044883c001" {
+		t.Fatalf("encoded = %#x != %s", asm.Code(), "0xeb044883c001")
+	}
+
+	// named labels
+	asm.Reset(nil)
+	loop := asm.NamedLabel("loop")
+	if l, ok := asm.LookupLabel("loop"); !ok || l.Id() != loop.Id() {
+		t.Fatal("Expected to find the named label")
+	}
+	if _, ok := asm.LookupLabel("exit"); ok {
+		t.Fatal("Expected no label named exit")
+	}
+	if asm.IsLabelBound(loop) {
+		t.Fatal("Expected the named label to be unbound")
+	}
+	asm.SetLabel(loop)
+	asm.Inst(ADD, RAX, Imm8(1))
+	asm.Inst(JMP, asm.NamedLabel("loop").Rel8())
+	if err := asm.Finalize(); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprintf("%#x", asm.Code()) != "0x4883c001ebfa" {
+		t.Fatalf("encoded = %#x != %s", asm.Code(), "0x4883c001ebfa")
+	}
+
+	// unbound and rebound labels
+	asm.Reset(nil)
+	exit := asm.NamedLabel("exit")
+	asm.Inst(JMP, exit.Rel8())
+	again := asm.NewLabel()
+	asm.SetLabel(again)
+	asm.Inst(JMP, again.Rel8())
+	asm.SetLabel(again)
+	var e *EncodeError
+	var le *LabelError
+	err := asm.Finalize()
+	if !errors.As(err, &e) || e.Reason != ReasonLabel || !errors.As(err, &le) {
+		t.Fatalf("Expected a label error, found %v", err)
+	}
+	if len(le.Unbound) != 1 || le.Unbound[0].Name != "exit" || le.Unbound[0].PC != 1 {
+		t.Fatalf("Unexpected unbound labels: %v", le.Unbound)
+	}
+	if len(le.BoundTwice) != 1 || le.BoundTwice[0].Id != again.Id() || le.BoundTwice[0].PC != 4 {
+		t.Fatalf("Unexpected rebound labels: %v", le.BoundTwice)
+	}
+
+	// unknown labels
+	asm.Reset(nil)
+	asm.SetLabel(Label{id: 7})
+	if err := asm.Err(); !errors.As(err, &e) || e.Reason != ReasonLabel {
+		t.Fatalf("Expected an error for an unknown label, found %v", err)
+	}
+
+	// label ids are 16 bits, and must not wrap:
+	for _, declare := range []func(){
+		func() { asm.DeclareLabel() },
+		func() { asm.NamedLabel("last") },
+		func() { asm.BindLabel("1") },
+	} {
+		asm.Reset(nil)
+		for i := 0; i < 1<<16; i++ {
+			asm.NewLabel()
+		}
+		if err := asm.Err(); err != nil {
+			t.Fatal(err)
+		}
+		declare()
+		if err := asm.Err(); !errors.As(err, &e) || e.Reason != ReasonLabel {
+			t.Fatalf("Expected an error for too many labels, found %v", err)
+		}
+		if _, ok := asm.LookupLabel("last"); ok {
+			t.Fatal("Expected no label for an exhausted label id")
+		}
+	}
+}
+
+func TestLocalLabels(t *testing.T) {
+	asm := NewAssembler(make([]byte, 256))
+
+	// 1: add rax, 1; jmp 1f; 1: jmp 1b; jmp 1b
+	asm.BindLabel("1")
+	asm.Inst(ADD, RAX, Imm8(1))
+	asm.Inst(JMP, asm.LabelRef("1f").Rel8())
+	asm.BindLabel("1")
+	asm.Inst(JMP, asm.LabelRef("1b").Rel8())
+	asm.Inst(JMP, asm.PrevLocalLabel(1).Rel8())
+	if err := asm.Finalize(); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprintf("%#x", asm.Code()) != "0x4883c001eb00ebfeebfc" {
+		t.Fatalf("encoded = %#x != %s", asm.Code(), "0x4883c001eb00ebfeebfc")
+	}
+
+	// local labels which are referenced but never defined
+	asm.Reset(nil)
+	asm.Inst(JMP, asm.NextLocalLabel(2).Rel8())
+	asm.Inst(JMP, asm.PrevLocalLabel(3).Rel8())
+	var le *LabelError
+	if err := asm.Finalize(); !errors.As(err, &le) || len(le.Unbound) != 2 || le.Unbound[0].Name != "2f" || le.Unbound[1].Name != "3b" {
+		t.Fatalf("Expected unbound local labels, found %v", err)
+	}
+
+	// local labels are not imported by fragments, or exported by the linker: each function uses "1f" twice
+	l := NewLinker()
+	for _, name := range []string{"f", "g"} {
+		fn := NewAssembler(nil)
+		fn.BindLabel("1")
+		fn.Inst(JMP, fn.LabelRef("1f").Rel8())
+		fn.BindLabel("1")
+		fn.Inst(JMP, fn.LabelRef("1f").Rel8())
+		fn.BindLabel("1")
+		fn.Inst(RET)
+		if _, ok := fn.LookupLabel("1f"); ok {
+			t.Fatal("Local labels should not be visible by name")
+		}
+		if err := l.Add(name, fn); err != nil {
+			t.Fatal(err)
+		}
+	}
+	img, err := l.Link(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := img.Offset("1f"); ok {
+		t.Fatal("Local labels should not be exported")
+	}
+	if expect := "0xeb00eb00c3cccccccccccccccccccccceb00eb00c3"; fmt.Sprintf("%#x", img.Code) != expect {
+		t.Fatalf("linked = %#x != %s", img.Code, expect)
+	}
+	asm.Reset(nil)
+	asm.Inst(JMP, asm.NextLocalLabel(1).Rel8())
+	if _, err := asm.Fragment(); !errors.As(err, &le) || len(le.Unbound) != 1 || le.Unbound[0].Name != "1f" {
+		t.Fatalf("Expected an unbound local label, found %v", err)
+	}
+}
+
+func TestSections(t *testing.T) {
+	asm := NewAssembler(make([]byte, 256))
+
+	// hot path with an out-of-line slow path and a constant in a data section
+	slow, resume, one := asm.DeclareLabel(), asm.DeclareLabel(), asm.DeclareLabel()
+	asm.Inst(CMP, RAX, Imm8(0))
+	asm.Inst(JE, slow.Rel32())
+	asm.SetLabel(resume)
+	asm.Inst(RET)
+	asm.Section("cold")
+	asm.SetLabel(slow)
+	asm.Inst(MOV, RAX, Mem{Base: RIP, Disp: one, Width: 8})
+	asm.Inst(JMP, resume.Rel32())
+	asm.Section("rodata")
+	asm.SetLabel(one)
+	asm.Raw64(1)
+	asm.Section(DefaultSection)
+	if asm.PC() != 11 || asm.CurrentSection() != DefaultSection {
+		t.Fatalf("Unexpected PC %d in section %s", asm.PC(), asm.CurrentSection())
+	}
+	if err := asm.Finalize(); err != nil {
+		t.Fatal(err)
+	}
+	if off, ok := asm.SectionOffset("cold"); !ok || off != 16 {
+		t.Fatalf("Unexpected offset for cold section: %d", off)
+	}
+	expect := "0x4883f8000f8406000000c3cccccccccc488b0509000000e9eeffffffcccccccc0100000000000000"
+	if fmt.Sprintf("%#x", asm.Code()) != expect {
+		t.Fatalf("encoded = %#x != %s", asm.Code(), expect)
+	}
+	if fmt.Sprintf("%#x", asm.SectionCode("rodata")) != "0x0100000000000000" {
+		t.Fatalf("Unexpected rodata section: %#x", asm.SectionCode("rodata"))
+	}
+
+	// sections placed separately
+	asm.PlaceSection("rodata", 0x1000)
+	if err := asm.Finalize(); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprintf("%#x", asm.SectionCode("cold")) != "0x488b05e90f0000e9eeffffff" {
+		t.Fatalf("Unexpected cold section: %#x", asm.SectionCode("cold"))
+	}
+	if len(asm.Code()) != 28 {
+		t.Fatalf("Unexpected code length: %d", len(asm.Code()))
+	}
+
+	// sections are aligned to the largest alignment required within them:
+	asm.Reset(nil)
+	asm.Inst(VMOVDQA, Y0, Mem{Base: RIP, Disp: asm.NamedLabel("pool"), Width: 32})
+	asm.Inst(RET)
+	asm.Section("pool")
+	asm.AlignFill(32, 0)
+	asm.SetLabel(asm.NamedLabel("pool"))
+	asm.RawInt64s(1, 2, 3, 4)
+	if err := asm.Finalize(); err != nil {
+		t.Fatal(err)
+	}
+	if off, _ := asm.SectionOffset("pool"); off != 32 || asm.CodeAlign() != 32 {
+		t.Fatalf("Unexpected offset for aligned section: %d (code aligned to %d)", off, asm.CodeAlign())
+	}
+	if code := asm.Code(); len(code) != 64 || fmt.Sprintf("%x", code[:9]) != "c5fd6f0518000000c3" || code[32] != 1 {
+		t.Fatalf("encoded = %x", code)
+	}
+
+	asm.Reset(nil)
+	if asm.CurrentSection() != DefaultSection || asm.SectionCode("cold") != nil {
+		t.Fatal("Expected sections to be reset")
+	}
+	for i := 0; i < 256; i++ {
+		asm.Section(fmt.Sprint("s", i))
+	}
+	var e *EncodeError
+	if err := asm.Err(); !errors.As(err, &e) || e.Reason != ReasonRange {
+		t.Fatalf("Expected a range error for too many sections, found %v", err)
+	}
+}
+
+func TestDataDirectives(t *testing.T) {
+	asm := NewAssembler(make([]byte, 8))
+	asm.Inst(RET)
+	asm.AlignFill(8, 0xcc)
+	asm.RawFloat32(1)
+	asm.RawFloat64s(2, -1)
+	asm.RawCString("hi")
+	asm.RawPString("abc", 2)
+	asm.RawZeros(2)
+	asm.Inst(RET)
+	block := asm.BeginData()
+	asm.RawInt16s(1, 2)
+	asm.Raw32(-1)
+	asm.EndData()
+	asm.AlignFill(4, 0)
+	if block.Id() != 0 || asm.GetLabelPC(block) != 39 {
+		t.Fatalf("Unexpected label for data block: %d", asm.GetLabelPC(block))
+	}
+	expect := "0xc3cccccccccccccc0000803f0000000000000040000000000000f0bf68690003006162630000c301000200ffffffff00"
+	if fmt.Sprintf("%#x", asm.Code()) != expect {
+		t.Fatalf("encoded = %#x != %s", asm.Code(), expect)
+	}
+	regions := asm.DataRegions()
+	if fmt.Sprint(regions) != "[{text 1 38} {text 39 48}]" {
+		t.Fatalf("Unexpected data regions: %v", regions)
+	}
+
+	asm.RawPString(string(make([]byte, 256)), 1)
+	var e *EncodeError
+	if err := asm.Err(); !errors.As(err, &e) || e.Reason != ReasonRange {
+		t.Fatalf("Expected a range error, found %v", err)
+	}
+
+	asm.Reset(nil)
+	asm.Inst(RET)
+	asm.RawZeros(-1)
+	if err := asm.Err(); !errors.As(err, &e) || e.Reason != ReasonRange {
+		t.Fatalf("Expected a range error for a negative length, found %v", err)
+	}
+	if asm.PC() != 1 {
+		t.Fatalf("Unexpected PC after a negative fill: %d", asm.PC())
+	}
+}
+
+func TestFragments(t *testing.T) {
+	// loop: dec rcx; jz exit; jmp loop
+	fa := NewAssembler(nil)
+	loop := fa.NewLabel()
+	fa.Inst(DEC, RCX)
+	fa.Inst(JE, fa.NamedLabel("exit").Rel8())
+	fa.Inst(JMP, loop.Rel8())
+	frag, err := fa.Fragment()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	asm := NewAssembler(make([]byte, 64))
+	asm.Inst(NOP)
+	first := asm.AppendFragment(frag)
+	second := asm.AppendFragment(frag)
+	asm.SetLabel(asm.NamedLabel("exit"))
+	asm.Inst(JMP, first.Label(loop).Rel8())
+	asm.Inst(RET)
+	if err := asm.Finalize(); err != nil {
+		t.Fatal(err)
+	}
+	if first.PC != 1 || second.PC != 8 || asm.GetLabelPC(second.Label(loop)) != 8 {
+		t.Fatalf("Unexpected fragment offsets: %d, %d", first.PC, second.PC)
+	}
+	expect := "0x9048ffc97409ebf948ffc97402ebf9ebf0c3"
+	if fmt.Sprintf("%#x", asm.Code()) != expect {
+		t.Fatalf("encoded = %#x != %s", asm.Code(), expect)
+	}
+
+	fa.Reset(nil)
+	fa.Inst(JMP, fa.DeclareLabel().Rel8())
+	if _, err := fa.Fragment(); err == nil {
+		t.Fatal("Expected an error for an unbound label")
+	}
+
+	// named labels within other sections are imported by section fragments:
+	fa.Reset(nil)
+	fa.Inst(LEA, RAX, Mem{Base: RIP, Disp: fa.NamedLabel("tbl")})
+	fa.Section("data")
+	fa.RawZeros(4)
+	fa.SetLabel(fa.NamedLabel("tbl"))
+	fa.RawInt32s(1)
+	if _, err := fa.Fragment(); err == nil {
+		t.Fatal("Expected an error for a fragment with multiple sections")
+	}
+	text, err := fa.SectionFragment(DefaultSection)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if imports := text.Imports(); len(imports) != 1 || imports[0].Name != "tbl" || imports[0].Offset != 3 {
+		t.Fatalf("Unexpected imports: %+v", imports)
+	}
+	data, err := fa.SectionFragment("data")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if off, ok := data.Entry("tbl"); !ok || off != 4 || len(data.Imports()) != 0 || data.Len() != 8 {
+		t.Fatalf("Unexpected data fragment: %d, %v, %+v", off, ok, data.Imports())
+	}
+
+	// RIP-relative references are relative to the end of the instruction, including trailing immediates:
+	fa.Reset(nil)
+	limit := fa.NamedLabel("limit")
+	fa.Inst(CMP, Mem{Base: RIP, Disp: limit, Width: 4}, Imm32(1000))
+	fa.Inst(RET)
+	cmp, err := fa.Fragment()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if imports := cmp.Imports(); len(imports) != 1 || imports[0].Offset != 2 || imports[0].Addend != -8 {
+		t.Fatalf("Unexpected imports for a reference with an immediate: %+v", imports)
+	}
+	fa.SetLabel(limit)
+	fa.RawInt32s(1000)
+	if err := fa.Finalize(); err != nil {
+		t.Fatal(err)
+	}
+	inst, err := x86asm.Decode(fa.Code(), 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mem := inst.Args[0].(x86asm.Mem); inst.Len+int(mem.Disp) != int(fa.GetLabelPC(limit)) {
+		t.Fatalf("RIP-relative target = %d != %d (%v)", inst.Len+int(mem.Disp), fa.GetLabelPC(limit), inst)
+	}
+
+	// fragments requiring disabled features are rejected:
+	fa.Reset(nil)
+	fa.Inst(VZEROUPPER)
+	if frag, err = fa.Fragment(); err != nil {
+		t.Fatal(err)
+	}
+	asm.Reset(nil)
+	asm.DisableFeature(feats.AVX)
+	asm.AppendFragment(frag)
+	var e *EncodeError
+	if err := asm.Err(); !errors.As(err, &e) || e.Reason != ReasonFeatures || len(asm.Code()) != 0 {
+		t.Fatalf("Expected a features error, found %v", err)
+	}
+
+	// fragments are rejected if they would exhaust the label ids of the assembler:
+	fa.Reset(nil)
+	fa.NewLabel()
+	fa.Inst(RET)
+	if frag, err = fa.Fragment(); err != nil {
+		t.Fatal(err)
+	}
+	asm.Reset(nil)
+	for i := 0; i < 1<<16; i++ {
+		asm.AppendFragment(frag)
+	}
+	if err := asm.Err(); err != nil {
+		t.Fatal(err)
+	}
+	asm.AppendFragment(frag)
+	if err := asm.Err(); !errors.As(err, &e) || e.Reason != ReasonLabel || len(asm.Code()) != 1<<16 {
+		t.Fatalf("Expected an error for too many labels, found %v", err)
+	}
+
+	// fragments keep their alignment, so aligned patch sites remain aligned in each copy:
+	fa.Reset(nil)
+	fa.Inst(RET)
+	site := fa.PatchSite(5)
+	if frag, err = fa.Fragment(); err != nil {
+		t.Fatal(err)
+	}
+	if frag.Align() != 8 || site.PC != 8 {
+		t.Fatalf("Unexpected alignment for fragment: %d, %+v", frag.Align(), site)
+	}
+	encoded, err := frag.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cached Fragment
+	if err := cached.UnmarshalBinary(encoded); err != nil || cached.Align() != 8 {
+		t.Fatalf("Unexpected alignment for cached fragment: %d, %v", cached.Align(), err)
+	}
+	asm.Reset(nil)
+	asm.Inst(NOP)
+	ref := asm.AppendFragment(frag)
+	if ref.PC != 8 || len(asm.Code()) != 8+frag.Len() || !bytes.Equal(asm.Code()[8:], frag.Code()) {
+		t.Fatalf("Unexpected aligned copy at %d: %#x", ref.PC, asm.Code())
+	}
+
+	// holes and CFI directives are not carried by fragments:
+	fa.Reset(nil)
+	fa.Inst(ADD, RAX, Hole32("k"))
+	if _, err := fa.Fragment(); err == nil {
+		t.Fatal("Expected an error for a fragment with holes")
+	}
+	fa.Reset(nil)
+	fa.CFIStartProc()
+	fa.Inst(RET)
+	fa.CFIEndProc()
+	if _, err := fa.Fragment(); err == nil {
+		t.Fatal("Expected an error for a fragment with CFI directives")
+	}
+}
+
+func TestTemplates(t *testing.T) {
+	asm := NewAssembler(make([]byte, 64))
+	asm.Inst(MOV, RAX, Mem{Base: RDI, Disp: Hole32("field"), Width: 8})
+	asm.Inst(ADD, RAX, Hole32("k"))
+	asm.Inst(CMP, RAX, Hole8("k8"))
+	asm.Inst(JNE, Hole32("target"))
+	asm.Inst(MOV, RCX, Hole64("k64"))
+	asm.Inst(ADD, RAX, Hole32("k"))
+	tmpl, err := asm.Template()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(tmpl.Names()) != "[field k k8 target k64]" {
+		t.Fatalf("Unexpected holes: %v", tmpl.Names())
+	}
+	if h := tmpl.Holes()[3]; h.Offset != 19 || h.Width != 4 || h.InstEnd != 23 {
+		t.Fatalf("Unexpected hole: %+v", h)
+	}
+
+	dst := make([]byte, tmpl.Len())
+	if err := tmpl.Instantiate(dst, []int64{0x10, 1, -1, 0x20, 0x1122334455667788}); err != nil {
+		t.Fatal(err)
+	}
+	expect := "0x488b87100000004805010000004883f8ff0f852000000048b98877665544332211480501000000"
+	if fmt.Sprintf("%#x", dst) != expect {
+		t.Fatalf("instantiated = %#x != %s", dst, expect)
+	}
+	if err := tmpl.Instantiate(dst, []int64{0x10, 1, 0x100, 0x20, 0}); err == nil {
+		t.Fatal("Expected a range error for an 8-bit hole")
+	}
+
+	// displacements and immediates narrower than the operation size are sign-extended:
+	for i, signed := range []bool{true, true, true, true, false, true} {
+		if tmpl.Holes()[i].SignExtended != signed {
+			t.Fatalf("Unexpected sign-extension for hole %d: %+v", i, tmpl.Holes()[i])
+		}
+	}
+	if err := tmpl.Instantiate(dst, []int64{0x10, 0xffffffff, 0, 0x20, 0}); err == nil {
+		t.Fatal("Expected a range error for a sign-extended 32-bit immediate hole")
+	}
+	if err := tmpl.Instantiate(dst, []int64{0x80000000, 1, 0, 0x20, 0}); err == nil {
+		t.Fatal("Expected a range error for a 32-bit displacement hole")
+	}
+	asm.Reset(nil)
+	asm.Inst(ADD, EAX, Hole32("k"))
+	if tmpl, err = asm.Template(); err != nil {
+		t.Fatal(err)
+	}
+	if err := tmpl.Instantiate(dst, []int64{0xffffffff}); err != nil || fmt.Sprintf("%#x", dst[:5]) != "0x05ffffffff" {
+		t.Fatalf("instantiated = %#x, %v", dst[:5], err)
+	}
+
+	// label references must be resolved by Finalize:
+	asm.Reset(nil)
+	exit := asm.DeclareLabel()
+	asm.Inst(ADD, RAX, Hole32("k"))
+	asm.Inst(JE, exit.Rel8())
+	var le *LabelError
+	if _, err := asm.Template(); !errors.As(err, &le) || len(le.Unbound) != 1 {
+		t.Fatalf("Expected an unbound label error, found %v", err)
+	}
+	asm.SetLabel(exit)
+	var e *EncodeError
+	if _, err := asm.Template(); !errors.As(err, &e) || e.Reason != ReasonLabel || e.PC != 7 {
+		t.Fatalf("Expected an error for an unresolved label reference, found %v", err)
+	}
+	if err := asm.Finalize(); err != nil {
+		t.Fatal(err)
+	}
+	if tmpl, err = asm.Template(); err != nil || fmt.Sprintf("%#x", tmpl.code) != "0x4805000000007400" {
+		t.Fatalf("Unexpected template: %v", err)
+	}
+
+	// holes only match operands of the same width
+	asm.Reset(nil)
+	if err := asm.Inst(SHL, RAX, Hole32("n")); err == nil {
+		t.Fatal("Expected an error for a 32-bit hole as an 8-bit immediate")
+	}
+}
+
+func TestLinker(t *testing.T) {
+	// double: call add; ret
+	double := NewAssembler(nil)
+	double.Inst(MOV, RSI, RDI)
+	double.Inst(CALL, double.NamedLabel("add"))
+	double.Inst(RET)
+
+	// add: lea rax, [rdi+rsi]; add rax, [rip+bias]; ret
+	add := NewAssembler(nil)
+	add.Inst(LEA, RAX, Mem{Base: RDI, Index: RSI, Scale: 1, Width: 8})
+	add.Inst(ADD, RAX, Mem{Base: RIP, Disp: add.NamedLabel("bias"), Width: 8})
+	add.Inst(RET)
+
+	data := NewAssembler(nil)
+	data.SetLabel(data.NamedLabel("bias"))
+	data.Export(data.NamedLabel("bias"))
+	data.Raw64(1)
+
+	l := NewLinker()
+	for _, f := range []struct {
+		name string
+		a    *Assembler
+	}{{"double", double}, {"add", add}, {"data", data}} {
+		if err := l.Add(f.name, f.a); err != nil {
+			t.Fatal(err)
+		}
+	}
+	img, err := l.Link(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if off, ok := img.Offset("add"); !ok || off != 16 {
+		t.Fatalf("Unexpected offset for add: %d", off)
+	}
+	if off, ok := img.Offset("bias"); !ok || off != 32 || img.Addr("bias")-img.Addr("double") != 32 {
+		t.Fatalf("Unexpected offset for bias: %d", off)
+	}
+	expect := "0x4889fee808000000c3cccccccccccccc488d043748030505000000c3cccccccc0100000000000000"
+	if fmt.Sprintf("%#x", img.Code) != expect {
+		t.Fatalf("linked = %#x != %s", img.Code, expect)
+	}
+
+	l = NewLinker()
+	l.Add("add", add)
+	var le *LabelError
+	if _, err := l.Link(nil); !errors.As(err, &le) || len(le.Unbound) != 1 || le.Unbound[0].Name != "bias" {
+		t.Fatalf("Expected an unresolved reference, found %v", err)
+	}
+	l.Add("add", data)
+	if _, err := l.Link(nil); err == nil || !strings.Contains(err.Error(), "Duplicate symbol") {
+		t.Fatalf("Expected a duplicate symbol, found %v", err)
+	}
+
+	// named labels which are not exported are internal to their functions: loop: dec rdi; jnz loop; ret
+	l = NewLinker()
+	for _, name := range []string{"f", "g"} {
+		fn := NewAssembler(nil)
+		fn.SetLabel(fn.NamedLabel("loop"))
+		fn.Inst(DEC, RDI)
+		fn.Inst(JNE, fn.NamedLabel("loop").Rel8())
+		fn.Inst(RET)
+		if err := l.Add(name, fn); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if img, err = l.Link(nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := img.Offset("loop"); ok {
+		t.Fatal("Internal labels should not be exported")
+	}
+	if expect := "0x48ffcf75fbc3cccccccccccccccccccc48ffcf75fbc3"; fmt.Sprintf("%#x", img.Code) != expect {
+		t.Fatalf("linked = %#x != %s", img.Code, expect)
+	}
+
+	// functions are aligned as required by their fragments:
+	l = NewLinker()
+	ret := NewAssembler(nil)
+	ret.Inst(RET)
+	l.Add("ret", ret)
+	aligned := NewAssembler(nil)
+	aligned.AlignFill(64, 0)
+	aligned.Raw64(1)
+	l.Add("aligned", aligned)
+	if img, err = l.Link(nil); err != nil {
+		t.Fatal(err)
+	}
+	if off, _ := img.Offset("aligned"); off != 64 || l.Size() != 72 {
+		t.Fatalf("Unexpected offset for aligned function: %d", off)
+	}
+
+	// only named labels may be exported
+	asm := NewAssembler(nil)
+	asm.Export(asm.NewLabel())
+	var e *EncodeError
+	if err := asm.Err(); !errors.As(err, &e) || e.Reason != ReasonLabel {
+		t.Fatalf("Expected a label error, found %v", err)
+	}
+}
+
+func TestCFI(t *testing.T) {
+	asm := NewAssembler(make([]byte, 64))
+	asm.Prologue(16)
+	asm.Inst(MOV, RAX, RDI)
+	asm.Epilogue()
+	asm.CFIEndProc()
+	if err := asm.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprintf("%#x", asm.Code()) != "0x554889e54881ec100000004889f8c9c3" {
+		t.Fatalf("encoded = %#x", asm.Code())
+	}
+
+	eh, err := asm.EHFrame(0x1000, 0x2000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cie := "1400000000000000017a5200017810011b0c070890010000"
+	fde := "24000000" + "1c000000" + "e0efffff" + "10000000" + "00" +
+		"41" + "0e10" + "8602" + "43" + "0d06" + "4a" + "0a" + "41" + "0c0708" + "c6" + "41" + "0b" + "000000000000"
+	if fmt.Sprintf("%x", eh) != cie+fde+"00000000" {
+		t.Fatalf("Unexpected .eh_frame: %x", eh)
+	}
+
+	df, err := asm.DebugFrame(0x1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprintf("%x", df[:24]) != "14000000ffffffff01000178100c07089001000000000000" || len(df)%8 != 0 {
+		t.Fatalf("Unexpected .debug_frame: %x", df)
+	}
+
+	asm.Reset(nil)
+	asm.CFIStartProc()
+	asm.CFIDefCFA(RBP, -16)
+	asm.CFIDefCFAOffset(-8)
+	asm.Inst(RET)
+	asm.CFIEndProc()
+	if df, err = asm.DebugFrame(0); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprintf("%x", df[48:]) != "1206021301000000" {
+		t.Fatalf("Unexpected instructions for negative CFA offsets: %x", df[48:])
+	}
+
+	asm.Reset(nil)
+	asm.CFIOffset(RBP, -16)
+	var e *EncodeError
+	if err := asm.Err(); !errors.As(err, &e) || e.Reason != ReasonCFI {
+		t.Fatalf("Expected a CFI error, found %v", err)
+	}
+	asm.Reset(nil)
+	asm.CFIStartProc()
+	asm.CFIDefCFAOffset(-12)
+	if err := asm.Err(); !errors.As(err, &e) || e.Reason != ReasonCFI {
+		t.Fatalf("Expected a CFI error, found %v", err)
+	}
+}
+
+func TestFragmentCache(t *testing.T) {
+	asm := NewAssembler(nil)
+	asm.SetLabel(asm.NamedLabel("popcount"))
+	asm.Inst(POPCNT, RAX, RDI)
+	asm.Inst(JMP, asm.NamedLabel("done").Rel32())
+	asm.SetLabel(asm.NamedLabel("abs"))
+	asm.Inst(MOV, RAX, RDI)
+	asm.Inst(CALL, asm.NamedLabel("helper"))
+	asm.SetLabel(asm.NamedLabel("done"))
+	asm.Inst(RET)
+	if asm.UsedFeatures() != feats.POPCNT {
+		t.Fatalf("Unexpected features: %v", asm.UsedFeatures())
+	}
+	frag, err := asm.Fragment()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := frag.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var cached Fragment
+	if err := cached.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if cached.Features() != feats.POPCNT || !bytes.Equal(cached.Code(), frag.Code()) {
+		t.Fatalf("Unexpected cached fragment: %v %#x", cached.Features(), cached.Code())
+	}
+	if pc, ok := cached.Entry("abs"); !ok || pc != 10 {
+		t.Fatalf("Unexpected entry point: %d", pc)
+	}
+
+	dst := make([]byte, cached.Len())
+	helper := uintptr(unsafe.Pointer(&dst[0])) + 0x1000
+	if err := cached.Load(dst, map[string]uintptr{"helper": helper}, feats.V2); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprintf("%#x", dst) != "0xf3480fb8c7e9080000004889f8e8ee0f0000c3" {
+		t.Fatalf("loaded = %#x", dst)
+	}
+	var e *EncodeError
+	if err := cached.Load(dst, nil, feats.V1); !errors.As(err, &e) || e.Reason != ReasonFeatures {
+		t.Fatalf("Expected an error for missing features, found %v", err)
+	}
+	if err := cached.Load(dst, nil, feats.V2); err == nil {
+		t.Fatal("Expected an error for a missing import")
+	}
+	data[8] ^= 1
+	if err := cached.UnmarshalBinary(data); err == nil {
+		t.Fatal("Expected a checksum error")
+	}
+
+	// malformed fragments with valid checksums are rejected:
+	asm.Reset(nil)
+	asm.Inst(JMP, asm.NamedLabel("end").Rel8())
+	asm.BeginData()
+	asm.Raw32(1)
+	asm.EndData()
+	asm.SetLabel(asm.NamedLabel("end"))
+	if frag, err = asm.Fragment(); err != nil {
+		t.Fatal(err)
+	}
+	for _, malform := range []func(f *Fragment){
+		func(f *Fragment) { f.relocs[0].width = 3 },
+		func(f *Fragment) { f.relocs[0].loc = uint32(len(f.code)) },
+		func(f *Fragment) { f.data[0].start, f.data[0].end = 4, 2 },
+		func(f *Fragment) { f.data[0].end = uint32(len(f.code)) + 1 },
+		func(f *Fragment) { f.labels[0].pc = uint32(len(f.code)) + 1 },
+		func(f *Fragment) { f.labels[0].state = 3 },
+	} {
+		g := *frag
+		g.relocs = append([]reloc(nil), frag.relocs...)
+		g.data = append([]dataRegion(nil), frag.data...)
+		g.labels = append([]fragmentLabel(nil), frag.labels...)
+		malform(&g)
+		data, _ := g.MarshalBinary()
+		if err := cached.UnmarshalBinary(data); err == nil {
+			t.Fatalf("Expected an error for a malformed fragment: %+v", g)
+		}
+	}
+	data, _ = frag.MarshalBinary()
+	if err := cached.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFragmentCacheHost(t *testing.T) {
+	if feats.Host() == feats.X64_IMPLICIT {
+		t.Skip("host features are not available")
+	}
+	asm := NewAssembler(nil)
+	asm.Inst(MFENCE)
+	asm.Inst(SFENCE)
+	asm.Inst(LFENCE)
+	asm.Inst(RET)
+	frag, err := asm.Fragment()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := frag.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cached Fragment
+	if err := cached.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if cached.Features() != feats.SSE2 {
+		t.Fatalf("Unexpected features: %v", cached.Features())
+	}
+	dst := make([]byte, cached.Len())
+	if err := cached.Load(dst, nil, feats.Host()); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(dst, frag.Code()) {
+		t.Fatalf("loaded = %#x", dst)
+	}
+}
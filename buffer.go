@@ -5,20 +5,25 @@ import (
 )
 
 type buffer struct {
-	b  []byte
-	i  int
-	sz int
+	b     []byte
+	i     int
+	sz    int
+	align uint32 // the largest alignment requested for offsets within the buffer
 }
 
 func newBuffer(b []byte) *buffer {
-	return &buffer{b, 0, len(b)}
+	return &buffer{b: b, sz: len(b)}
 }
 
 func (b *buffer) extend(length int) {
 	if len(b.b)-b.i >= length {
 		return
 	}
-	bb := make([]byte, len(b.b)*2)
+	n := len(b.b)*2 + 1
+	for n-b.i < length {
+		n *= 2
+	}
+	bb := make([]byte, n)
 	copy(bb, b.b[:b.i])
 	b.b = bb
 }
@@ -32,6 +37,13 @@ func (b *buffer) ResizeReset(capacity int) {
 		b.b = make([]byte, capacity)
 	}
 	b.i = 0
+	b.align = 0
+}
+
+func (b *buffer) requireAlign(pow2 uint32) {
+	if pow2 > b.align {
+		b.align = pow2
+	}
 }
 
 func (b *buffer) Byte(v byte) {
@@ -75,6 +87,14 @@ func (b *buffer) Int64(v int64) {
 	b.i += 8
 }
 
+func (b *buffer) Fill(length int, v byte) {
+	b.extend(length)
+	for i := b.i; i < b.i+length; i++ {
+		b.b[i] = v
+	}
+	b.i += length
+}
+
 func (b *buffer) Nop(length uint8) {
 	maxNop := uint8(len(nops))
 	for length > 0 {
@@ -0,0 +1,227 @@
+package x64
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"unsafe"
+
+	"github.com/wdamron/x64/feats"
+)
+
+const (
+	cacheMagic   = "x64c"
+	cacheVersion = 3
+)
+
+// Encode the fragment in a compact binary format, including its code, alignment, labels, label references, data
+// regions and the CPU features required by its instructions. Named labels which are bound within the fragment
+// serve as its entry points (see Entry). The encoded fragment may be reloaded with UnmarshalBinary, e.g. to
+// cache compiled code across runs.
+func (f *Fragment) MarshalBinary() ([]byte, error) {
+	b := append([]byte(cacheMagic), cacheVersion)
+	b = append64(b, uint64(f.feats))
+	b = appendUvarint(b, uint64(f.align))
+	b = appendUvarint(b, uint64(len(f.code)))
+	b = append(b, f.code...)
+	b = appendUvarint(b, uint64(len(f.labels)))
+	for _, l := range f.labels {
+		flags := l.state
+		if l.imported {
+			flags |= 0x80
+		}
+		if l.exported {
+			flags |= 0x40
+		}
+		b = append(b, flags)
+		b = appendUvarint(b, uint64(l.pc))
+		b = appendUvarint(b, uint64(len(l.name)))
+		b = append(b, l.name...)
+	}
+	b = appendUvarint(b, uint64(len(f.relocs)))
+	for _, r := range f.relocs {
+		b = appendUvarint(b, uint64(r.loc))
+		b = appendVarint(b, int64(r.disp))
+		b = appendUvarint(b, uint64(r.label))
+		b = append(b, r.width)
+	}
+	b = appendUvarint(b, uint64(len(f.data)))
+	for _, d := range f.data {
+		b = appendUvarint(b, uint64(d.start))
+		b = appendUvarint(b, uint64(d.end))
+	}
+	return append32(b, crc32.ChecksumIEEE(b)), nil
+}
+
+// Decode a fragment which was encoded by MarshalBinary.
+func (f *Fragment) UnmarshalBinary(data []byte) error {
+	if len(data) < len(cacheMagic)+13 || string(data[:len(cacheMagic)]) != cacheMagic {
+		return fmt.Errorf("Invalid encoded fragment")
+	}
+	body := data[:len(data)-4]
+	if crc32.ChecksumIEEE(body) != binary.LittleEndian.Uint32(data[len(body):]) {
+		return fmt.Errorf("Checksum mismatch for encoded fragment")
+	}
+	if v := body[len(cacheMagic)]; v != cacheVersion {
+		return fmt.Errorf("Unsupported version for encoded fragment: %d", v)
+	}
+	d := fragmentDecoder{b: body[len(cacheMagic)+1:]}
+	var g Fragment
+	g.feats = feats.Feature(d.u64())
+	if g.align = uint32(d.uvarint()); g.align&(g.align-1) != 0 {
+		d.err = true
+	}
+	g.code = append([]byte(nil), d.bytes(d.uvarint())...)
+	g.labels = make([]fragmentLabel, d.count())
+	for i := range g.labels {
+		flags := d.byte()
+		l := fragmentLabel{state: flags &^ 0xc0, imported: flags&0x80 != 0, exported: flags&0x40 != 0, pc: uint32(d.uvarint())}
+		l.name = string(d.bytes(d.uvarint()))
+		if l.state > labelBound || int(l.pc) > len(g.code) {
+			d.err = true
+		}
+		if !l.imported {
+			l.index = g.locals
+			g.locals++
+		}
+		g.labels[i] = l
+	}
+	g.relocs = make([]reloc, d.count())
+	for i := range g.relocs {
+		r := reloc{loc: uint32(d.uvarint()), disp: int32(d.varint()), label: uint16(d.uvarint())}
+		r.width = d.byte()
+		if r.width != 1 && r.width != 2 && r.width != 4 {
+			d.err = true
+		}
+		if int(r.label) >= len(g.labels) || int(r.loc)+int(r.width) > len(g.code) {
+			d.err = true
+		}
+		g.relocs[i] = r
+	}
+	g.data = make([]dataRegion, d.count())
+	for i := range g.data {
+		g.data[i] = dataRegion{start: uint32(d.uvarint()), end: uint32(d.uvarint())}
+		if g.data[i].start > g.data[i].end || int(g.data[i].end) > len(g.code) {
+			d.err = true
+		}
+	}
+	if d.err || len(d.b) != 0 {
+		return fmt.Errorf("Invalid encoded fragment")
+	}
+	*f = g
+	return nil
+}
+
+// Copy the fragment's code to dst (e.g. executable memory) and resolve all label references. Imported labels
+// (see Fragment) are resolved to the absolute addresses in imports. An error will be returned if the host
+// features do not include all features required by the fragment (see feats.Host), or if dst is too small.
+// dst should be aligned as required by the fragment (see Align) if it will be executed.
+func (f *Fragment) Load(dst []byte, imports map[string]uintptr, host feats.Feature) error {
+	if missing := host.Missing(f.feats); missing != 0 {
+		return &EncodeError{PC: -1, Operand: -1, Reason: ReasonFeatures, Err: fmt.Errorf("Fragment requires CPU features which are not supported by the host: %v", missing)}
+	}
+	if len(dst) < len(f.code) {
+		return fmt.Errorf("Destination for fragment is too small: %d < %d bytes", len(dst), len(f.code))
+	}
+	copy(dst, f.code)
+	var base int64
+	if len(dst) > 0 {
+		base = int64(uintptr(unsafe.Pointer(&dst[0])))
+	}
+	var unbound []LabelRef
+	for _, r := range f.relocs {
+		l := f.labels[r.label]
+		target := base + int64(l.pc)
+		if l.imported {
+			addr, ok := imports[l.name]
+			if !ok {
+				unbound = append(unbound, LabelRef{Id: r.label, Name: l.name, PC: r.loc})
+				continue
+			}
+			target = int64(addr)
+		}
+		disp := target - (base + int64(r.loc) + int64(r.width)) + int64(r.disp)
+		if err := patchRel(dst, r.loc, r.width, int(disp)); err != nil {
+			return err
+		}
+	}
+	if len(unbound) > 0 {
+		return &EncodeError{PC: -1, Operand: -1, Reason: ReasonLabel, Err: &LabelError{Unbound: unbound}}
+	}
+	return nil
+}
+
+func appendUvarint(b []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(b, buf[:binary.PutUvarint(buf[:], v)]...)
+}
+
+func appendVarint(b []byte, v int64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(b, buf[:binary.PutVarint(buf[:], v)]...)
+}
+
+type fragmentDecoder struct {
+	b   []byte
+	err bool
+}
+
+func (d *fragmentDecoder) byte() byte {
+	if len(d.b) < 1 {
+		d.err = true
+		return 0
+	}
+	v := d.b[0]
+	d.b = d.b[1:]
+	return v
+}
+
+func (d *fragmentDecoder) u64() uint64 {
+	if len(d.b) < 8 {
+		d.err = true
+		return 0
+	}
+	v := binary.LittleEndian.Uint64(d.b)
+	d.b = d.b[8:]
+	return v
+}
+
+func (d *fragmentDecoder) uvarint() uint64 {
+	v, n := binary.Uvarint(d.b)
+	if n <= 0 {
+		d.err = true
+		return 0
+	}
+	d.b = d.b[n:]
+	return v
+}
+
+func (d *fragmentDecoder) varint() int64 {
+	v, n := binary.Varint(d.b)
+	if n <= 0 {
+		d.err = true
+		return 0
+	}
+	d.b = d.b[n:]
+	return v
+}
+
+// Decode a count of entries, which can not exceed the remaining length.
+func (d *fragmentDecoder) count() int {
+	n := d.uvarint()
+	if n > uint64(len(d.b)) {
+		d.err = true
+		return 0
+	}
+	return int(n)
+}
+
+func (d *fragmentDecoder) bytes(n uint64) []byte {
+	if n > uint64(len(d.b)) {
+		d.err = true
+		return nil
+	}
+	v := d.b[:n]
+	d.b = d.b[n:]
+	return v
+}
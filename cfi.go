@@ -0,0 +1,374 @@
+package x64
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// DWARF call-frame instructions
+const (
+	dwCFAAdvanceLoc       = 0x40
+	dwCFAOffset           = 0x80
+	dwCFARestore          = 0xc0
+	dwCFANop              = 0x00
+	dwCFAAdvanceLoc1      = 0x02
+	dwCFAAdvanceLoc2      = 0x03
+	dwCFAAdvanceLoc4      = 0x04
+	dwCFARememberState    = 0x0a
+	dwCFARestoreState     = 0x0b
+	dwCFADefCFA           = 0x0c
+	dwCFADefCFARegister   = 0x0d
+	dwCFADefCFAOffset     = 0x0e
+	dwCFAOffsetExtendedSf = 0x11
+	dwCFADefCFASf         = 0x12
+	dwCFADefCFAOffsetSf   = 0x13
+
+	dwRegRA        = 16 // return address (RIP)
+	dwDataAlign    = -8
+	dwEHPEPcrelS32 = 0x1b // DW_EH_PE_pcrel | DW_EH_PE_sdata4
+)
+
+// DWARF register numbers for RAX, RCX, RDX, RBX, RSP, RBP, RSI and RDI
+var dwarfRegs = [8]uint8{0, 2, 1, 3, 7, 6, 4, 5}
+
+// cfiProc is a procedure with call-frame information, which will be encoded as an FDE.
+type cfiProc struct {
+	start, end uint32
+	section    uint8
+	ended      bool
+	ops        []cfiOp
+	cfaOffset  int32
+	saved      []int32 // CFA offsets for CFIRememberState
+}
+
+type cfiOp struct {
+	pc   uint32
+	code uint8
+	reg  uint8
+	off  int32
+}
+
+// Begin a procedure with call-frame information at the current PC. The canonical frame address (CFA) is
+// initially RSP+8, with the return address saved at CFA-8.
+func (a *Assembler) CFIStartProc() {
+	if p := a.cfiProc(); p != nil && !p.ended {
+		a.cfiError("CFIStartProc within a procedure which has not been ended")
+		return
+	}
+	a.cfi = append(a.cfi, cfiProc{start: a.PC(), section: a.cur, cfaOffset: 8})
+}
+
+// End the current procedure at the current PC.
+func (a *Assembler) CFIEndProc() {
+	if p := a.openProc("CFIEndProc"); p != nil {
+		p.end, p.ended = a.PC(), true
+	}
+}
+
+// Define the CFA as reg+offset, from the current PC. A negative offset must be a multiple of 8.
+func (a *Assembler) CFIDefCFA(reg Reg, offset int32) {
+	if p, r := a.openProc("CFIDefCFA"), a.dwarfReg(reg); p != nil && r >= 0 && a.checkCFAOffset("CFIDefCFA", offset) {
+		p.ops = append(p.ops, cfiOp{pc: a.PC(), code: dwCFADefCFA, reg: uint8(r), off: offset})
+		p.cfaOffset = offset
+	}
+}
+
+// Define the CFA as reg plus the current CFA offset, from the current PC.
+func (a *Assembler) CFIDefCFARegister(reg Reg) {
+	if p, r := a.openProc("CFIDefCFARegister"), a.dwarfReg(reg); p != nil && r >= 0 {
+		p.ops = append(p.ops, cfiOp{pc: a.PC(), code: dwCFADefCFARegister, reg: uint8(r)})
+	}
+}
+
+// Define the offset of the CFA from the CFA register, from the current PC. A negative offset must be a multiple
+// of 8.
+func (a *Assembler) CFIDefCFAOffset(offset int32) {
+	if p := a.openProc("CFIDefCFAOffset"); p != nil && a.checkCFAOffset("CFIDefCFAOffset", offset) {
+		p.ops = append(p.ops, cfiOp{pc: a.PC(), code: dwCFADefCFAOffset, off: offset})
+		p.cfaOffset = offset
+	}
+}
+
+// Negative CFA offsets are encoded with DW_CFA_def_cfa_sf or DW_CFA_def_cfa_offset_sf, which are factored by
+// the data alignment factor.
+func (a *Assembler) checkCFAOffset(directive string, offset int32) bool {
+	if offset < 0 && offset%8 != 0 {
+		a.cfiError(fmt.Sprintf("%s with a negative offset must be a multiple of 8: %d", directive, offset))
+		return false
+	}
+	return true
+}
+
+// Adjust the offset of the CFA from the CFA register by delta, from the current PC (e.g. by 8 after PUSH).
+func (a *Assembler) CFIAdjustCFAOffset(delta int32) {
+	if p := a.openProc("CFIAdjustCFAOffset"); p != nil {
+		a.CFIDefCFAOffset(p.cfaOffset + delta)
+	}
+}
+
+// Record that the previous value of reg is saved at CFA+offset, from the current PC. The offset must be a
+// multiple of 8.
+func (a *Assembler) CFIOffset(reg Reg, offset int32) {
+	p, r := a.openProc("CFIOffset"), a.dwarfReg(reg)
+	if p == nil || r < 0 {
+		return
+	}
+	if offset%8 != 0 {
+		a.cfiError(fmt.Sprintf("CFIOffset for %v must be a multiple of 8: %d", reg, offset))
+		return
+	}
+	p.ops = append(p.ops, cfiOp{pc: a.PC(), code: dwCFAOffset, reg: uint8(r), off: offset})
+}
+
+// Record that reg has been restored to its value at the start of the procedure, from the current PC.
+func (a *Assembler) CFIRestore(reg Reg) {
+	if p, r := a.openProc("CFIRestore"), a.dwarfReg(reg); p != nil && r >= 0 {
+		p.ops = append(p.ops, cfiOp{pc: a.PC(), code: dwCFARestore, reg: uint8(r)})
+	}
+}
+
+// Save the current call-frame rules, to be restored by CFIRestoreState.
+func (a *Assembler) CFIRememberState() {
+	if p := a.openProc("CFIRememberState"); p != nil {
+		p.ops = append(p.ops, cfiOp{pc: a.PC(), code: dwCFARememberState})
+		p.saved = append(p.saved, p.cfaOffset)
+	}
+}
+
+// Restore the call-frame rules saved by the most recent CFIRememberState, from the current PC.
+func (a *Assembler) CFIRestoreState() {
+	p := a.openProc("CFIRestoreState")
+	if p == nil {
+		return
+	}
+	if len(p.saved) == 0 {
+		a.cfiError("CFIRestoreState without CFIRememberState")
+		return
+	}
+	p.ops = append(p.ops, cfiOp{pc: a.PC(), code: dwCFARestoreState})
+	p.cfaOffset, p.saved = p.saved[len(p.saved)-1], p.saved[:len(p.saved)-1]
+}
+
+// Emit a frame prologue (PUSH RBP; MOV RBP, RSP; and SUB RSP, frameSize if frameSize is non-zero), beginning a
+// procedure with call-frame information (see CFIStartProc) and recording the CFI for each instruction.
+func (a *Assembler) Prologue(frameSize int32) {
+	a.CFIStartProc()
+	a.Inst(PUSH, RBP)
+	a.CFIAdjustCFAOffset(8)
+	a.CFIOffset(RBP, -16)
+	a.Inst(MOV, RBP, RSP)
+	a.CFIDefCFARegister(RBP)
+	if frameSize != 0 {
+		a.Inst(SUB, RSP, Imm32(frameSize))
+	}
+}
+
+// Emit a frame epilogue for a frame created by Prologue (LEAVE; RET), recording the CFI for each instruction.
+// The call-frame rules are restored after RET, so the procedure may continue after the epilogue; CFIEndProc
+// must be called at the end of the procedure.
+func (a *Assembler) Epilogue() {
+	a.CFIRememberState()
+	a.Inst(LEAVE)
+	a.CFIDefCFA(RSP, 8)
+	a.CFIRestore(RBP)
+	a.Inst(RET)
+	a.CFIRestoreState()
+}
+
+// Encode the call-frame information for all procedures as an .eh_frame section, where codeAddr is the
+// address of the code (see Code) and frameAddr is the address where the .eh_frame section will be placed.
+// Procedures which have not been ended extend to the end of their section. The section is terminated by
+// a zero-length entry.
+func (a *Assembler) EHFrame(codeAddr, frameAddr uint64) ([]byte, error) {
+	return a.encodeFrames(true, codeAddr, frameAddr)
+}
+
+// Encode the call-frame information for all procedures as a .debug_frame section, where codeAddr is the
+// address of the code (see Code). Procedures which have not been ended extend to the end of their section.
+func (a *Assembler) DebugFrame(codeAddr uint64) ([]byte, error) {
+	return a.encodeFrames(false, codeAddr, 0)
+}
+
+func (a *Assembler) encodeFrames(eh bool, codeAddr, frameAddr uint64) ([]byte, error) {
+	if a.err != nil {
+		return nil, a.err
+	}
+	bases := a.sectionBases()
+
+	// CIE:
+	b := []byte{0, 0, 0, 0}
+	if eh {
+		b = append(b, 0, 0, 0, 0, 1, 'z', 'R', 0)
+	} else {
+		b = append(b, 0xff, 0xff, 0xff, 0xff, 1, 0)
+	}
+	b = appendULEB(b, 1)
+	b = appendSLEB(b, dwDataAlign)
+	b = append(b, dwRegRA)
+	if eh {
+		b = append(b, 1, dwEHPEPcrelS32)
+	}
+	b = append(b, dwCFADefCFA, dwarfRegs[4], 8, dwCFAOffset|dwRegRA, 1)
+	b = padFrame(b, 0)
+
+	// FDEs:
+	for i := range a.cfi {
+		p := &a.cfi[i]
+		end := p.end
+		if !p.ended {
+			end = uint32(a.sectionBuf(p.section).Len())
+		}
+		start := codeAddr + uint64(p.start)
+		if bases != nil {
+			start += uint64(bases[p.section])
+		}
+		off := len(b)
+		b = append(b, 0, 0, 0, 0)
+		if eh {
+			b = append32(b, uint32(len(b)))
+			pcrel := int64(start) - int64(frameAddr+uint64(len(b)))
+			if pcrel < -1<<31 || pcrel >= 1<<31 {
+				return nil, &EncodeError{PC: int(p.start), Operand: -1, Reason: ReasonCFI, Err: fmt.Errorf("Code address exceeds the range of a 32-bit offset from .eh_frame")}
+			}
+			b = append32(b, uint32(pcrel))
+			b = append32(b, end-p.start)
+			b = appendULEB(b, 0)
+		} else {
+			b = append32(b, 0)
+			b = append64(b, start)
+			b = append64(b, uint64(end-p.start))
+		}
+		loc := p.start
+		for _, op := range p.ops {
+			b = appendAdvance(b, op.pc-loc)
+			loc = op.pc
+			switch op.code {
+			case dwCFADefCFA:
+				if op.off >= 0 {
+					b = append(b, dwCFADefCFA, op.reg)
+					b = appendULEB(b, uint64(op.off))
+				} else {
+					b = append(b, dwCFADefCFASf, op.reg)
+					b = appendSLEB(b, int64(op.off/dwDataAlign))
+				}
+			case dwCFADefCFARegister:
+				b = append(b, dwCFADefCFARegister, op.reg)
+			case dwCFADefCFAOffset:
+				if op.off >= 0 {
+					b = append(b, dwCFADefCFAOffset)
+					b = appendULEB(b, uint64(op.off))
+				} else {
+					b = append(b, dwCFADefCFAOffsetSf)
+					b = appendSLEB(b, int64(op.off/dwDataAlign))
+				}
+			case dwCFAOffset:
+				if f := op.off / dwDataAlign; f >= 0 {
+					b = append(b, dwCFAOffset|op.reg)
+					b = appendULEB(b, uint64(f))
+				} else {
+					b = append(b, dwCFAOffsetExtendedSf, op.reg)
+					b = appendSLEB(b, int64(f))
+				}
+			case dwCFARestore:
+				b = append(b, dwCFARestore|op.reg)
+			default:
+				b = append(b, op.code)
+			}
+		}
+		b = padFrame(b, off)
+	}
+	if eh {
+		b = append(b, 0, 0, 0, 0)
+	}
+	return b, nil
+}
+
+// Pad the entry beginning at off with DW_CFA_nop to a multiple of 8 bytes, and fill in its length.
+func padFrame(b []byte, off int) []byte {
+	for (len(b)-off)%8 != 0 {
+		b = append(b, dwCFANop)
+	}
+	binary.LittleEndian.PutUint32(b[off:], uint32(len(b)-off-4))
+	return b
+}
+
+func appendAdvance(b []byte, delta uint32) []byte {
+	switch {
+	case delta == 0:
+		return b
+	case delta < 0x40:
+		return append(b, dwCFAAdvanceLoc|byte(delta))
+	case delta <= 0xff:
+		return append(b, dwCFAAdvanceLoc1, byte(delta))
+	case delta <= 0xffff:
+		return append(b, dwCFAAdvanceLoc2, byte(delta), byte(delta>>8))
+	}
+	return append32(append(b, dwCFAAdvanceLoc4), delta)
+}
+
+func append32(b []byte, v uint32) []byte {
+	return append(b, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+}
+
+func append64(b []byte, v uint64) []byte { return append32(append32(b, uint32(v)), uint32(v>>32)) }
+
+func appendULEB(b []byte, v uint64) []byte {
+	for {
+		c := byte(v & 0x7f)
+		v >>= 7
+		if v == 0 {
+			return append(b, c)
+		}
+		b = append(b, c|0x80)
+	}
+}
+
+func appendSLEB(b []byte, v int64) []byte {
+	for {
+		c := byte(v & 0x7f)
+		v >>= 7
+		if (v == 0 && c&0x40 == 0) || (v == -1 && c&0x40 != 0) {
+			return append(b, c)
+		}
+		b = append(b, c|0x80)
+	}
+}
+
+// Get the current procedure, if one has been started.
+func (a *Assembler) cfiProc() *cfiProc {
+	if len(a.cfi) == 0 {
+		return nil
+	}
+	return &a.cfi[len(a.cfi)-1]
+}
+
+// Get the current procedure, or set the assembler's error if no procedure is open within the current section.
+func (a *Assembler) openProc(directive string) *cfiProc {
+	p := a.cfiProc()
+	if p == nil || p.ended || p.section != a.cur {
+		a.cfiError(directive + " outside of a procedure (see CFIStartProc)")
+		return nil
+	}
+	return p
+}
+
+// Get the DWARF register number for reg, or set the assembler's error if reg is not supported.
+func (a *Assembler) dwarfReg(reg Reg) int {
+	switch {
+	case reg.Family() == REG_LEGACY && reg.width() == 8:
+		if reg.Num() < 8 {
+			return int(dwarfRegs[reg.Num()])
+		}
+		return int(reg.Num())
+	case reg.Family() == REG_XMM:
+		return 17 + int(reg.Num())
+	}
+	a.cfiError(fmt.Sprintf("Unsupported register for call-frame information: %v", reg))
+	return -1
+}
+
+func (a *Assembler) cfiError(msg string) {
+	if a.err == nil {
+		a.err = &EncodeError{PC: int(a.PC()), Operand: -1, Reason: ReasonCFI, Err: fmt.Errorf("%s", msg)}
+	}
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"bytes"
+	"debug/elf"
+	"encoding/binary"
+	"sort"
+	"strings"
+
+	"github.com/wdamron/x64"
+)
+
+// elfSection is a section of an ELF object, with the contents of a section of the assembler.
+type elfSection struct {
+	name  string // name of the assembler section
+	code  []byte
+	align uint32 // alignment required by the section's contents (see x64.Fragment.Align)
+	// References to imported labels and to labels within other sections, which are written as relocations
+	imports []x64.Import
+}
+
+// elfSymbol is a symbol defined within a section of an ELF object.
+type elfSymbol struct {
+	name    string
+	section string // name of the assembler section
+	offset  uint32
+	global  bool
+}
+
+// Get the name and flags of the ELF section for an assembler section. The default section is written as
+// .text, sections named "rodata..." are read-only, and other sections are writable.
+func elfSectionHeader(section string) (string, elf.SectionFlag) {
+	switch {
+	case section == x64.DefaultSection:
+		return ".text", elf.SHF_ALLOC | elf.SHF_EXECINSTR
+	case strings.HasPrefix(section, "rodata"):
+		return "." + section, elf.SHF_ALLOC
+	}
+	return "." + section, elf.SHF_ALLOC | elf.SHF_WRITE
+}
+
+// Write a relocatable ELF object with a section for each assembler section. References to imported labels
+// and to labels within other sections are written as R_X86_64_PC32 relocations (or R_X86_64_PLT32 for calls
+// and jumps) against the labels' symbols; imported labels are undefined global symbols.
+func writeELF(sections []elfSection, symbols []elfSymbol) []byte {
+	le := binary.LittleEndian
+
+	// section headers: the null section, each section followed by its relocations (if any), then the tables
+	shndx := make(map[string]uint16)
+	shCount := uint16(1)
+	for _, s := range sections {
+		shndx[s.name] = shCount
+		shCount++
+		if len(s.imports) > 0 {
+			shCount++
+		}
+	}
+	shSymtab, shStrtab, shNote, shShstrtab := shCount, shCount+1, shCount+2, shCount+3
+	shCount += 4
+
+	// symbol table: null symbol, section symbols, local symbols, then global and undefined symbols
+	strtab := []byte{0}
+	addString := func(s string) uint32 {
+		off := uint32(len(strtab))
+		strtab = append(append(strtab, s...), 0)
+		return off
+	}
+	var symtab bytes.Buffer
+	addSymbol := func(name uint32, info uint8, shndx uint16, value uint64) uint32 {
+		binary.Write(&symtab, le, elf.Sym64{Name: name, Info: info, Shndx: shndx, Value: value})
+		return uint32(symtab.Len()/elf.Sym64Size) - 1
+	}
+	addSymbol(0, 0, 0, 0)
+	for _, s := range sections {
+		addSymbol(0, elf.ST_INFO(elf.STB_LOCAL, elf.STT_SECTION), shndx[s.name], 0)
+	}
+	sort.SliceStable(symbols, func(i, j int) bool { return !symbols[i].global && symbols[j].global })
+	firstGlobal := uint32(1 + len(sections))
+	symIndex := make(map[string]uint32)
+	for _, s := range symbols {
+		bind := elf.STB_LOCAL
+		if s.global {
+			bind = elf.STB_GLOBAL
+		} else {
+			firstGlobal++
+		}
+		symIndex[s.name] = addSymbol(addString(s.name), elf.ST_INFO(bind, elf.STT_NOTYPE), shndx[s.section], uint64(s.offset))
+	}
+	relas := make([][]byte, len(sections))
+	for i, s := range sections {
+		var rela bytes.Buffer
+		for _, imp := range s.imports {
+			index, ok := symIndex[imp.Name]
+			if !ok {
+				index = addSymbol(addString(imp.Name), elf.ST_INFO(elf.STB_GLOBAL, elf.STT_NOTYPE), uint16(elf.SHN_UNDEF), 0)
+				symIndex[imp.Name] = index
+			}
+			typ := elf.R_X86_64_PC32
+			if isBranchOpcode(s.code, imp.Offset) {
+				typ = elf.R_X86_64_PLT32
+			}
+			binary.Write(&rela, le, elf.Rela64{Off: uint64(imp.Offset), Info: elf.R_INFO(index, uint32(typ)), Addend: int64(imp.Addend)})
+		}
+		relas[i] = rela.Bytes()
+	}
+
+	shstrtab := []byte{0}
+	names := make([]uint32, shCount)
+	setName := func(sh uint16, name string) {
+		names[sh] = uint32(len(shstrtab))
+		shstrtab = append(append(shstrtab, name...), 0)
+	}
+	for _, s := range sections {
+		name, _ := elfSectionHeader(s.name)
+		setName(shndx[s.name], name)
+		if len(s.imports) > 0 {
+			setName(shndx[s.name]+1, ".rela"+name)
+		}
+	}
+	setName(shSymtab, ".symtab")
+	setName(shStrtab, ".strtab")
+	setName(shNote, ".note.GNU-stack")
+	setName(shShstrtab, ".shstrtab")
+
+	// layout: header, section contents, section headers
+	var out bytes.Buffer
+	out.Write(make([]byte, 64))
+	headers := make([]elf.Section64, shCount)
+	add := func(sh uint16, typ elf.SectionType, flags elf.SectionFlag, data []byte, align uint64) {
+		for uint64(out.Len())&(align-1) != 0 {
+			out.WriteByte(0)
+		}
+		headers[sh] = elf.Section64{Name: names[sh], Type: uint32(typ), Flags: uint64(flags), Off: uint64(out.Len()), Size: uint64(len(data)), Addralign: align}
+		out.Write(data)
+	}
+	for i, s := range sections {
+		sh := shndx[s.name]
+		_, flags := elfSectionHeader(s.name)
+		align := uint64(16)
+		if uint64(s.align) > align {
+			align = uint64(s.align)
+		}
+		add(sh, elf.SHT_PROGBITS, flags, s.code, align)
+		if len(s.imports) > 0 {
+			add(sh+1, elf.SHT_RELA, elf.SHF_INFO_LINK, relas[i], 8)
+			headers[sh+1].Link, headers[sh+1].Info, headers[sh+1].Entsize = uint32(shSymtab), uint32(sh), uint64(binary.Size(elf.Rela64{}))
+		}
+	}
+	add(shSymtab, elf.SHT_SYMTAB, 0, symtab.Bytes(), 8)
+	headers[shSymtab].Link, headers[shSymtab].Info, headers[shSymtab].Entsize = uint32(shStrtab), firstGlobal, elf.Sym64Size
+	add(shStrtab, elf.SHT_STRTAB, 0, strtab, 1)
+	add(shNote, elf.SHT_PROGBITS, 0, nil, 1)
+	add(shShstrtab, elf.SHT_STRTAB, 0, shstrtab, 1)
+	for out.Len()&7 != 0 {
+		out.WriteByte(0)
+	}
+	shoff := out.Len()
+	for _, h := range headers {
+		binary.Write(&out, le, h)
+	}
+
+	b := out.Bytes()
+	hdr := elf.Header64{
+		Type:      uint16(elf.ET_REL),
+		Machine:   uint16(elf.EM_X86_64),
+		Version:   uint32(elf.EV_CURRENT),
+		Shoff:     uint64(shoff),
+		Ehsize:    64,
+		Shentsize: 64,
+		Shnum:     shCount,
+		Shstrndx:  shShstrtab,
+	}
+	copy(hdr.Ident[:], elf.ELFMAG)
+	hdr.Ident[elf.EI_CLASS] = byte(elf.ELFCLASS64)
+	hdr.Ident[elf.EI_DATA] = byte(elf.ELFDATA2LSB)
+	hdr.Ident[elf.EI_VERSION] = byte(elf.EV_CURRENT)
+	var hb bytes.Buffer
+	binary.Write(&hb, le, hdr)
+	copy(b, hb.Bytes())
+	return b
+}
+
+// Check if the 32-bit displacement at offset belongs to a CALL, JMP or Jcc instruction.
+func isBranchOpcode(code []byte, offset uint32) bool {
+	switch {
+	case offset >= 1 && (code[offset-1] == 0xe8 || code[offset-1] == 0xe9):
+		return true
+	case offset >= 2 && code[offset-2] == 0x0f && code[offset-1]&0xf0 == 0x80:
+		return true
+	}
+	return false
+}
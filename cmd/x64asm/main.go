@@ -0,0 +1,376 @@
+// Command x64asm assembles Intel-syntax source files with the x64 package.
+//
+// Usage:
+//
+//	x64asm [flags] [file.asm]
+//
+// The source is read from stdin if no file is given. Output formats (-f) are raw binary (bin), hexadecimal (hex),
+// a Go byte-slice literal (go), Go assembly with a TEXT symbol and Go declarations (goasm), or a relocatable ELF
+// object (elf). Named labels which are referenced but never defined are imported: they become undefined symbols
+// within an ELF object, and must name another function or data symbol within the package for Go assembly.
+//
+// Sections (.text, .data, .rodata and .section) are concatenated for the bin, hex and go formats, each aligned
+// to 16 bytes or its largest .align directive. Each section is written as a separate section of an ELF object (.text is executable, .rodata is
+// read-only, and other sections are writable), and references between sections are written as relocations.
+// Go assembly only supports the .text section.
+//
+// Absolute branch targets (e.g. "call 0x1000") are relative to the base address (-base) of the bin, hex and go
+// formats, and are not supported for the relocatable elf and goasm formats.
+//
+// The source may contain instructions with optional LOCK/REP prefixes, labels ("name:" and local numeric labels
+// "1:" referenced as "1b" or "1f"), and the directives .text, .data, .rodata, .section, .globl, .byte, .word,
+// .long, .quad, .float, .double, .ascii, .asciz, .zero, .align and .p2align. Comments begin with ';', '#' or "//".
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"github.com/wdamron/x64"
+	"github.com/wdamron/x64/feats"
+	"github.com/wdamron/x64/goasm"
+)
+
+func main() {
+	format := flag.String("f", "bin", "output format: bin, hex, go, goasm or elf")
+	output := flag.String("o", "", "output file (default stdout)")
+	listing := flag.String("l", "", "write a listing to the given file (- for stderr)")
+	features := flag.String("feats", "all", "comma-separated CPU features or profiles to enable (e.g. v3, haswell, avx2, host or all)")
+	base := flag.String("base", "0", "base address of the code, for listings and absolute branch targets")
+	name := flag.String("name", "code", "name of the variable (go) or function (goasm)")
+	pkg := flag.String("pkg", "main", "package name (go and goasm)")
+	sig := flag.String("sig", "()", "Go signature of the function, excluding the name (goasm)")
+	args := flag.Int("args", 0, "size of the function's arguments and results in bytes (goasm)")
+	stubs := flag.String("stubs", "", "write Go declarations to the given file (goasm)")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: x64asm [flags] [file.asm]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() > 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	err := run(config{
+		input:    flag.Arg(0),
+		format:   *format,
+		output:   *output,
+		listing:  *listing,
+		features: *features,
+		base:     *base,
+		name:     *name,
+		pkg:      *pkg,
+		sig:      *sig,
+		args:     *args,
+		stubs:    *stubs,
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "x64asm:", err)
+		os.Exit(1)
+	}
+}
+
+type config struct {
+	input, format, output, listing, features, base string
+	name, pkg, sig, stubs                          string
+	args                                           int
+}
+
+func run(c config) error {
+	var src []byte
+	var err error
+	file := c.input
+	if file == "" || file == "-" {
+		file = "<stdin>"
+		src, err = io.ReadAll(os.Stdin)
+	} else {
+		src, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return err
+	}
+	enabled, err := parseFeatures(c.features)
+	if err != nil {
+		return err
+	}
+	base, err := strconv.ParseUint(c.base, 0, 64)
+	if err != nil {
+		return fmt.Errorf("Invalid base address %q", c.base)
+	}
+
+	a := x64.NewAssembler(nil)
+	a.SetFeatures(enabled)
+	a.SetDiagnostics(true)
+	p := newParser(a, file, base)
+	p.relocatable = c.format == "elf" || c.format == "goasm"
+	if err := p.parse(string(src)); err != nil {
+		return err
+	}
+
+	var out []byte
+	var code []byte
+	switch c.format {
+	case "bin", "hex", "go":
+		if err := a.Finalize(); err != nil {
+			return err
+		}
+		code = a.Code()
+		switch c.format {
+		case "bin":
+			out = code
+		case "hex":
+			out = []byte(hex.EncodeToString(code) + "\n")
+		case "go":
+			out = p.goLiteral(code, c.pkg, c.name)
+		}
+	case "elf":
+		sections, err := p.elfSections()
+		if err != nil {
+			return err
+		}
+		out = writeELF(sections, p.symbols())
+		code = p.concatSections(sections)
+	case "goasm":
+		if len(p.sections) > 1 {
+			return fmt.Errorf("Sections other than .text are not supported for Go assembly")
+		}
+		frag, err := a.Fragment()
+		if err != nil {
+			return err
+		}
+		if code, err = loadFragment(frag); err != nil {
+			return err
+		}
+		f := &goasm.File{
+			Package:   c.pkg,
+			Generator: "by x64asm from " + file,
+			Funcs:     []goasm.Func{{Name: c.name, Signature: c.sig, Args: c.args, Code: frag}},
+		}
+		var b bytes.Buffer
+		if err := f.WriteAsm(&b); err != nil {
+			return err
+		}
+		out = b.Bytes()
+		if c.stubs != "" {
+			var b bytes.Buffer
+			if err := f.WriteStubs(&b); err != nil {
+				return err
+			}
+			if err := os.WriteFile(c.stubs, b.Bytes(), 0644); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("Unknown output format %q", c.format)
+	}
+
+	if c.listing != "" {
+		var w io.Writer = os.Stderr
+		if c.listing != "-" {
+			f, err := os.Create(c.listing)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			w = f
+		}
+		if err := p.writeListing(w, code); err != nil {
+			return err
+		}
+	}
+	if c.output == "" {
+		_, err = os.Stdout.Write(out)
+		return err
+	}
+	return os.WriteFile(c.output, out, 0644)
+}
+
+// Copy the fragment's code and resolve references to labels within the fragment. Displacements for imported
+// labels are left as zero.
+func loadFragment(frag *x64.Fragment) ([]byte, error) {
+	code := make([]byte, frag.Len())
+	imports := frag.Imports()
+	addrs := make(map[string]uintptr)
+	for _, imp := range imports {
+		addrs[imp.Name] = uintptr(unsafe.Pointer(&code[0]))
+	}
+	if err := frag.Load(code, addrs, feats.AllFeatures); err != nil {
+		return nil, err
+	}
+	for _, imp := range imports {
+		for i := uint32(0); i < uint32(imp.Width); i++ {
+			code[imp.Offset+i] = 0
+		}
+	}
+	return code, nil
+}
+
+// Parse a comma-separated list of feature names (e.g. "avx2") and profiles (e.g. "v3" or "haswell"). The
+// names "all" and "host" enable all features or the features supported by the host.
+func parseFeatures(s string) (feats.Feature, error) {
+	var enabled feats.Feature
+	for _, name := range strings.Split(s, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if f, ok := feats.Profile(name); ok {
+			enabled |= f
+			continue
+		}
+		switch name {
+		case "all":
+			enabled |= feats.AllFeatures
+			continue
+		case "host":
+			enabled |= feats.Host()
+			continue
+		}
+		found := false
+		for bit := uint(0); bit < 64; bit++ {
+			if f := feats.Feature(1) << bit; strings.ToLower(feats.FeatName(f)) == name {
+				enabled, found = enabled|f, true
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("Unknown CPU feature or profile %q", name)
+		}
+	}
+	return enabled, nil
+}
+
+// Get the contents of each section for an ELF object. References to labels within other sections are
+// imported, so they will be written as relocations.
+func (p *parser) elfSections() ([]elfSection, error) {
+	sections := make([]elfSection, len(p.sections))
+	for i, name := range p.sections {
+		frag, err := p.a.SectionFragment(name)
+		if err != nil {
+			return nil, err
+		}
+		code, err := loadFragment(frag)
+		if err != nil {
+			return nil, err
+		}
+		sections[i] = elfSection{name: name, code: code, align: frag.Align(), imports: frag.Imports()}
+	}
+	return sections, nil
+}
+
+// Concatenate the contents of all sections at their offsets within the assembler's output (see
+// Assembler.SectionOffset), for listings. Padding between sections is filled with zeros.
+func (p *parser) concatSections(sections []elfSection) []byte {
+	var code []byte
+	for _, s := range sections {
+		off := int(p.sectionOffset(s.name))
+		for len(code) < off+len(s.code) {
+			code = append(code, 0)
+		}
+		copy(code[off:], s.code)
+	}
+	return code
+}
+
+// Get the symbols for all named labels which were defined in the source.
+func (p *parser) symbols() []elfSymbol {
+	global := make(map[string]bool)
+	for _, g := range p.globals {
+		global[g] = true
+	}
+	syms := make([]elfSymbol, len(p.labels))
+	for i, dl := range p.labels {
+		l, _ := p.a.LookupLabel(dl.name)
+		syms[i] = elfSymbol{name: dl.name, section: dl.section, offset: p.a.GetLabelPC(l), global: global[dl.name]}
+	}
+	return syms
+}
+
+// Get the offset of a section within the output code.
+func (p *parser) sectionOffset(section string) uint32 {
+	off, _ := p.a.SectionOffset(section)
+	return uint32(off)
+}
+
+// Write a listing with the address and encoded bytes for each line of source.
+func (p *parser) writeListing(w io.Writer, code []byte) error {
+	const perRow = 8
+	for _, l := range p.lines {
+		off := p.sectionOffset(l.section) + l.start
+		b := code[off : off+l.end-l.start]
+		row := b
+		if len(row) > perRow {
+			row = row[:perRow]
+		}
+		if _, err := fmt.Fprintf(w, "%6d  %08x  %-*s  %s\n", l.num, p.base+uint64(off), perRow*3, hexBytes(row), l.text); err != nil {
+			return err
+		}
+		for i := perRow; i < len(b); i += perRow {
+			row := b[i:]
+			if len(row) > perRow {
+				row = row[:perRow]
+			}
+			if _, err := fmt.Fprintf(w, "%6s  %08x  %s\n", "", p.base+uint64(off)+uint64(i), hexBytes(row)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func hexBytes(b []byte) string {
+	var s strings.Builder
+	for i, v := range b {
+		if i > 0 {
+			s.WriteByte(' ')
+		}
+		fmt.Fprintf(&s, "%02x", v)
+	}
+	return s.String()
+}
+
+// Format the code as a Go byte-slice literal, with the source for each line as a comment.
+func (p *parser) goLiteral(code []byte, pkg, name string) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by x64asm from %s. DO NOT EDIT.\n\npackage %s\n\nvar %s = []byte{\n", p.file, pkg, name)
+	type span struct {
+		off, len uint32
+		text     string
+	}
+	var spans []span
+	for _, l := range p.lines {
+		if l.end > l.start {
+			spans = append(spans, span{p.sectionOffset(l.section) + l.start, l.end - l.start, l.text})
+		}
+	}
+	sort.SliceStable(spans, func(i, j int) bool { return spans[i].off < spans[j].off })
+	written := uint32(0)
+	for _, s := range spans {
+		if s.off > written {
+			// padding between sections
+			fmt.Fprintf(&b, "\t%s\n", goBytes(code[written:s.off]))
+		}
+		fmt.Fprintf(&b, "\t%s // %s\n", goBytes(code[s.off:s.off+s.len]), strings.TrimSpace(stripComment(s.text)))
+		written = s.off + s.len
+	}
+	if int(written) < len(code) {
+		fmt.Fprintf(&b, "\t%s\n", goBytes(code[written:]))
+	}
+	b.WriteString("}\n")
+	return b.Bytes()
+}
+
+func goBytes(b []byte) string {
+	var s strings.Builder
+	for i, v := range b {
+		if i > 0 {
+			s.WriteByte(' ')
+		}
+		fmt.Fprintf(&s, "0x%02x,", v)
+	}
+	return s.String()
+}
@@ -0,0 +1,642 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/wdamron/x64"
+	"github.com/wdamron/x64/lookup"
+)
+
+// parser assembles Intel-syntax source with an Assembler.
+type parser struct {
+	a     *x64.Assembler
+	match *x64.InstMatcher
+	file  string
+	// The address of the start of the default section, for absolute branch targets
+	base uint64
+	// The output is relocatable (elf and goasm), so absolute branch targets are not supported
+	relocatable bool
+	// Named labels which were defined in the source
+	labels []definedLabel
+	// Names which were declared with .globl
+	globals []string
+	// All sections, in the order they were created
+	sections []string
+	lines    []sourceLine
+}
+
+func newParser(a *x64.Assembler, file string, base uint64) *parser {
+	m := x64.NewInstMatcher()
+	m.SetFeatures(a.Features())
+	return &parser{a: a, match: m, file: file, base: base, sections: []string{x64.DefaultSection}}
+}
+
+// definedLabel is a named label which was defined within a section.
+type definedLabel struct {
+	name, section string
+}
+
+// sourceLine tracks the output of a line of source, for listings.
+type sourceLine struct {
+	num        int
+	text       string
+	section    string
+	start, end uint32
+}
+
+var memSizes = map[string]uint8{
+	"byte":    1,
+	"word":    2,
+	"dword":   4,
+	"fword":   6,
+	"qword":   8,
+	"tbyte":   10,
+	"tword":   10,
+	"oword":   16,
+	"xmmword": 16,
+	"ymmword": 32,
+}
+
+var segmentPrefixes = map[string]byte{"es": 0x26, "cs": 0x2e, "ss": 0x36, "ds": 0x3e, "fs": 0x64, "gs": 0x65}
+
+// Assemble all lines of the source. Label references are not finalized.
+func (p *parser) parse(src string) error {
+	for i, text := range strings.Split(src, "\n") {
+		text = strings.TrimRight(text, "\r")
+		section, start := p.a.CurrentSection(), p.a.PC()
+		if err := p.parseLine(text); err != nil {
+			return fmt.Errorf("%s:%d: %v", p.file, i+1, err)
+		}
+		if err := p.a.Err(); err != nil {
+			return fmt.Errorf("%s:%d: %v", p.file, i+1, err)
+		}
+		if p.a.CurrentSection() != section {
+			section, start = p.a.CurrentSection(), p.a.PC()
+		}
+		p.lines = append(p.lines, sourceLine{num: i + 1, text: text, section: section, start: start, end: p.a.PC()})
+	}
+	return nil
+}
+
+func (p *parser) parseLine(s string) error {
+	s = strings.TrimSpace(stripComment(s))
+	for {
+		i := labelEnd(s)
+		if i < 0 {
+			break
+		}
+		name := s[:i]
+		if _, _, ok := x64.ParseLocalLabel(name); !ok {
+			if l, ok := p.a.LookupLabel(name); ok && p.a.IsLabelBound(l) {
+				return fmt.Errorf("Label %q is already defined", name)
+			}
+			p.labels = append(p.labels, definedLabel{name: name, section: p.a.CurrentSection()})
+		}
+		p.a.BindLabel(name)
+		s = strings.TrimSpace(s[i+1:])
+	}
+	switch {
+	case s == "":
+		return nil
+	case s[0] == '.':
+		return p.directive(s)
+	}
+	return p.instruction(s)
+}
+
+func (p *parser) instruction(s string) error {
+	mnemonic, rest := cutField(s)
+	prefix := strings.ToLower(mnemonic)
+	switch prefix {
+	case "lock", "rep", "repe", "repz", "repne", "repnz":
+		mnemonic, rest = cutField(rest)
+	default:
+		prefix = ""
+	}
+	inst, ok := x64lookup.Inst(mnemonic)
+	if !ok {
+		return fmt.Errorf("Unknown instruction %q", mnemonic)
+	}
+	branch := isBranch(inst.Name())
+	var args []x64.Arg
+	var seg byte
+	for _, op := range splitOperands(rest) {
+		arg, opSeg, err := p.operand(op, branch)
+		if err != nil {
+			return err
+		}
+		if opSeg != 0 {
+			seg = opSeg
+		}
+		args = append(args, arg)
+	}
+	p.resolveSizes(inst, args)
+	if seg != 0 {
+		p.a.RawByte(seg)
+	}
+	switch prefix {
+	case "lock":
+		return p.a.Lock(inst, args...)
+	case "rep", "repe", "repz":
+		return p.a.Rep(inst, args...)
+	case "repne", "repnz":
+		return p.a.Repne(inst, args...)
+	}
+	return p.a.Inst(inst, args...)
+}
+
+// Resolve sizes which are implied by Intel syntax: immediates which only fit the operand size as unsigned values
+// (e.g. "mov al, 0xff") are truncated, and memory operands without a size take the size of a register operand if
+// the instruction does not match otherwise.
+func (p *parser) resolveSizes(inst x64.Inst, args []x64.Arg) {
+	var opSize uint8
+	mem := -1
+	for i, arg := range args {
+		switch v := arg.(type) {
+		case x64.Reg:
+			if v.Family() == x64.REG_LEGACY && opSize == 0 {
+				opSize = v.Width()
+			}
+		case x64.Mem:
+			mem = i
+			if opSize == 0 {
+				opSize = v.Width
+			}
+		}
+	}
+	for i, arg := range args {
+		imm, ok := arg.(x64.ImmArg)
+		if !ok || opSize == 0 || opSize > 4 {
+			continue
+		}
+		if v := imm.Int64(); v >= 1<<(opSize*8-1) && v < 1<<(opSize*8) {
+			switch opSize {
+			case 1:
+				args[i] = x64.Imm8(int8(v))
+			case 2:
+				args[i] = x64.Imm16(int16(v))
+			case 4:
+				args[i] = x64.Imm32(int32(v))
+			}
+		}
+	}
+	if mem < 0 || args[mem].(x64.Mem).Width != 0 || p.match.Match(inst, args...) == nil {
+		return
+	}
+	for _, arg := range args {
+		if r, ok := arg.(x64.Reg); ok {
+			m := args[mem].(x64.Mem)
+			m.Width = r.Width()
+			args[mem] = m
+			if p.match.Match(inst, args...) == nil {
+				return
+			}
+		}
+	}
+	m := args[mem].(x64.Mem)
+	m.Width = 0
+	args[mem] = m
+}
+
+// Check if a bare number or label operand for the instruction is a branch target.
+func isBranch(name string) bool {
+	switch name {
+	case "CALL", "LOOP", "LOOPE", "LOOPNE", "LOOPZ", "LOOPNZ", "XBEGIN":
+		return true
+	}
+	return name[0] == 'J'
+}
+
+// Parse an operand, returning the argument and a segment-override prefix (if any).
+func (p *parser) operand(s string, branch bool) (x64.Arg, byte, error) {
+	s = strings.TrimSpace(s)
+	var width uint8
+	short := false
+	if f, rest := cutField(s); rest != "" {
+		if w, ok := memSizes[strings.ToLower(f)]; ok {
+			width, s = w, rest
+			if f, rest := cutField(s); strings.ToLower(f) == "ptr" {
+				s = rest
+			}
+		} else if strings.ToLower(f) == "short" && branch {
+			short, s = true, rest
+		}
+	}
+	lower := strings.ToLower(s)
+	var seg byte
+	if len(lower) > 3 && lower[2] == ':' {
+		if prefix, ok := segmentPrefixes[lower[:2]]; ok {
+			seg, s, lower = prefix, strings.TrimSpace(s[3:]), strings.TrimSpace(lower[3:])
+		}
+	}
+	if strings.HasPrefix(s, "[") && strings.HasSuffix(s, "]") {
+		m, err := p.mem(s[1:len(s)-1], width)
+		return m, seg, err
+	}
+	if width != 0 || seg != 0 {
+		return nil, 0, fmt.Errorf("Expected a memory operand: %q", s)
+	}
+	if r, ok := x64lookup.Reg(lower); ok {
+		return r, 0, nil
+	}
+	if v, err := parseInt(s); err == nil {
+		if !branch {
+			return imm(v), 0, nil
+		}
+		if p.relocatable {
+			return nil, 0, fmt.Errorf("Absolute branch target %#x is not supported for relocatable output", uint64(v))
+		}
+		if uint64(v) < p.base || uint64(v)-p.base > math.MaxUint32 {
+			return nil, 0, fmt.Errorf("Branch target %#x is not within range of the base address %#x", uint64(v), p.base)
+		}
+		l := p.a.DeclareLabel()
+		p.a.SetLabelPC(l, uint32(uint64(v)-p.base))
+		return l, 0, nil
+	}
+	if isIdent(s) {
+		if !branch {
+			return nil, 0, fmt.Errorf("Label %q must be referenced by a memory operand or branch", s)
+		}
+		l := p.a.LabelRef(s)
+		if short {
+			return l.Rel8(), 0, nil
+		}
+		return l, 0, nil
+	}
+	return nil, 0, fmt.Errorf("Invalid operand %q", s)
+}
+
+// Parse a memory operand without brackets, e.g. "rax + rcx*8 + 16" or "rip + table".
+func (p *parser) mem(s string, width uint8) (x64.Mem, error) {
+	m := x64.Mem{Width: width}
+	var disp int64
+	var label string
+	for _, term := range splitTerms(s) {
+		neg := term[0] == '-'
+		t := strings.TrimSpace(term[1:])
+		if i := strings.IndexByte(t, '*'); i >= 0 {
+			r, ok := x64lookup.Reg(strings.ToLower(strings.TrimSpace(t[:i])))
+			scale, err := parseInt(strings.TrimSpace(t[i+1:]))
+			if !ok {
+				r, ok = x64lookup.Reg(strings.ToLower(strings.TrimSpace(t[i+1:])))
+				scale, err = parseInt(strings.TrimSpace(t[:i]))
+			}
+			if !ok || err != nil || neg || m.Index != 0 {
+				return m, fmt.Errorf("Invalid index in memory operand: %q", t)
+			}
+			m.Index, m.Scale = r, uint8(scale)
+			continue
+		}
+		if r, ok := x64lookup.Reg(strings.ToLower(t)); ok {
+			switch {
+			case neg:
+				return m, fmt.Errorf("Invalid register in memory operand: %q", term)
+			case m.Base == 0:
+				m.Base = r
+			case m.Index == 0:
+				m.Index, m.Scale = r, 1
+			default:
+				return m, fmt.Errorf("Too many registers in memory operand")
+			}
+			continue
+		}
+		if v, err := parseInt(t); err == nil {
+			if neg {
+				v = -v
+			}
+			disp += v
+			continue
+		}
+		if !isIdent(t) || neg || label != "" {
+			return m, fmt.Errorf("Invalid term in memory operand: %q", term)
+		}
+		label = t
+	}
+	if disp < math.MinInt32 || disp > math.MaxInt32 {
+		return m, fmt.Errorf("Displacement %#x exceeds 32 bits", disp)
+	}
+	switch {
+	case label != "":
+		if m.Index != 0 || (m.Base != 0 && m.Base != x64.RIP) {
+			return m, fmt.Errorf("Labels may only be referenced with RIP-relative addressing")
+		}
+		l := p.a.LabelRef(label)
+		m.Base = x64.RIP
+		if disp != 0 {
+			m.Disp = l.Disp32(int32(disp))
+		} else {
+			m.Disp = l.Rel32()
+		}
+	case disp >= math.MinInt8 && disp <= math.MaxInt8 && m.Base != 0 && m.Base != x64.RIP:
+		if disp != 0 {
+			m.Disp = x64.Rel8(disp)
+		}
+	default:
+		m.Disp = x64.Rel32(disp)
+	}
+	return m, nil
+}
+
+// Switch to the named section, creating the section if it does not exist.
+func (p *parser) section(name string) {
+	found := false
+	for _, s := range p.sections {
+		found = found || s == name
+	}
+	if !found {
+		p.sections = append(p.sections, name)
+	}
+	p.a.Section(name)
+}
+
+func (p *parser) directive(s string) error {
+	name, rest := cutField(s)
+	a := p.a
+	switch strings.ToLower(name) {
+	case ".intel_syntax":
+		if rest != "" && strings.ToLower(rest) != "noprefix" {
+			return fmt.Errorf("Only Intel syntax without register prefixes is supported")
+		}
+	case ".text":
+		p.section(x64.DefaultSection)
+	case ".data", ".rodata":
+		p.section(name[1:])
+	case ".section":
+		section, _ := cutField(rest)
+		section = strings.TrimPrefix(strings.TrimRight(section, ","), ".")
+		if section == "" {
+			return fmt.Errorf("Expected a section name")
+		}
+		p.section(section)
+	case ".globl", ".global":
+		for _, g := range splitOperands(rest) {
+			if !isIdent(g) {
+				return fmt.Errorf("Invalid symbol %q", g)
+			}
+			p.globals = append(p.globals, g)
+		}
+	case ".byte":
+		return p.ints(rest, 8, func(v int64) { a.RawFill(1, byte(v)) })
+	case ".word", ".short", ".2byte":
+		return p.ints(rest, 16, func(v int64) { a.RawInt16s(int16(v)) })
+	case ".long", ".int", ".4byte":
+		return p.ints(rest, 32, func(v int64) { a.RawInt32s(int32(v)) })
+	case ".quad", ".8byte":
+		return p.ints(rest, 64, func(v int64) { a.RawInt64s(v) })
+	case ".float", ".single":
+		return p.floats(rest, func(v float64) { a.RawFloat32(float32(v)) })
+	case ".double":
+		return p.floats(rest, a.RawFloat64)
+	case ".ascii", ".asciz", ".string":
+		for _, q := range splitOperands(rest) {
+			str, err := strconv.Unquote(q)
+			if err != nil {
+				return fmt.Errorf("Invalid string %s", q)
+			}
+			if strings.ToLower(name) == ".ascii" {
+				a.BeginData()
+				a.Raw([]byte(str))
+				a.EndData()
+			} else {
+				a.RawCString(str)
+			}
+		}
+	case ".zero", ".skip", ".space":
+		args, err := p.intArgs(rest, 1, 2)
+		if err != nil {
+			return err
+		}
+		fill := int64(0)
+		if len(args) > 1 {
+			fill = args[1]
+		}
+		if args[0] < 0 || args[0] > math.MaxInt32 {
+			return fmt.Errorf("Invalid length %d", args[0])
+		}
+		a.RawFill(int(args[0]), byte(fill))
+	case ".align", ".balign", ".p2align":
+		args, err := p.intArgs(rest, 1, 2)
+		if err != nil {
+			return err
+		}
+		align := args[0]
+		if strings.ToLower(name) == ".p2align" {
+			if align < 0 || align > 12 {
+				return fmt.Errorf("Invalid alignment 2^%d", align)
+			}
+			align = 1 << uint(align)
+		}
+		if align <= 0 || align > 4096 || align&(align-1) != 0 {
+			return fmt.Errorf("Alignment must be a power of 2: %d", align)
+		}
+		if len(args) > 1 {
+			a.AlignFill(uint32(align), byte(args[1]))
+		} else if a.CurrentSection() != x64.DefaultSection {
+			// pad data with zeros, as gas does:
+			a.AlignFill(uint32(align), 0)
+		} else {
+			// pad code with NOPs:
+			for pad := -a.PC() & uint32(align-1); pad > 0; {
+				n := pad
+				if n > 255 {
+					n = 255
+				}
+				a.Nop(uint8(n))
+				pad -= n
+			}
+		}
+	default:
+		return fmt.Errorf("Unknown directive %q", name)
+	}
+	return nil
+}
+
+// Parse a list of integers which must fit within bits (as signed or unsigned values).
+func (p *parser) ints(s string, bits uint, emit func(int64)) error {
+	for _, op := range splitOperands(s) {
+		v, err := parseInt(op)
+		if err != nil {
+			return err
+		}
+		if bits < 64 && (v < -1<<(bits-1) || v >= 1<<bits) {
+			return fmt.Errorf("Value %#x exceeds %d bits", v, bits)
+		}
+		emit(v)
+	}
+	return nil
+}
+
+func (p *parser) floats(s string, emit func(float64)) error {
+	for _, op := range splitOperands(s) {
+		v, err := strconv.ParseFloat(op, 64)
+		if err != nil {
+			return fmt.Errorf("Invalid number %q", op)
+		}
+		emit(v)
+	}
+	return nil
+}
+
+func (p *parser) intArgs(s string, minArgs, maxArgs int) ([]int64, error) {
+	ops := splitOperands(s)
+	if len(ops) < minArgs || len(ops) > maxArgs {
+		return nil, fmt.Errorf("Expected %d to %d arguments, found %d", minArgs, maxArgs, len(ops))
+	}
+	args := make([]int64, len(ops))
+	for i, op := range ops {
+		v, err := parseInt(op)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = v
+	}
+	return args, nil
+}
+
+// Get the narrowest immediate for the value. Immediates will be resized for the matched encoding.
+func imm(v int64) x64.ImmArg {
+	switch {
+	case v >= math.MinInt8 && v <= math.MaxInt8:
+		return x64.Imm8(v)
+	case v >= math.MinInt16 && v <= math.MaxInt16:
+		return x64.Imm16(v)
+	case v >= math.MinInt32 && v <= math.MaxInt32:
+		return x64.Imm32(v)
+	}
+	return x64.Imm64(v)
+}
+
+// Parse an integer in decimal, hexadecimal (0x), octal (0o), or binary (0b), or a character literal ('a').
+// Unsigned 64-bit values are returned as their two's complement.
+func parseInt(s string) (int64, error) {
+	if len(s) > 2 && s[0] == '\'' {
+		c, _, tail, err := strconv.UnquoteChar(s[1:len(s)-1], '\'')
+		if err == nil && tail == "" && s[len(s)-1] == '\'' {
+			return int64(c), nil
+		}
+	}
+	if v, err := strconv.ParseInt(s, 0, 64); err == nil {
+		return v, nil
+	}
+	v, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return 0, fmt.Errorf("Invalid number %q", s)
+	}
+	return int64(v), nil
+}
+
+func isIdent(s string) bool {
+	if s == "" || (s[0] >= '0' && s[0] <= '9' && !isLocalRef(s)) {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !isIdentChar(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isLocalRef(s string) bool {
+	_, dir, ok := x64.ParseLocalLabel(s)
+	return ok && dir != 0
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c == '.' || c == '$' || c == '@' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// Get the index of the colon following a label at the start of s, or -1.
+func labelEnd(s string) int {
+	i := 0
+	for i < len(s) && isIdentChar(s[i]) {
+		i++
+	}
+	if i == 0 || i >= len(s) || s[i] != ':' {
+		return -1
+	}
+	return i
+}
+
+// Remove a trailing comment (starting with ';', '#' or "//") outside of quotes.
+func stripComment(s string) string {
+	quote := byte(0)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == ';' || c == '#' || (c == '/' && i+1 < len(s) && s[i+1] == '/'):
+			return s[:i]
+		}
+	}
+	return s
+}
+
+// Split s at the first run of whitespace.
+func cutField(s string) (string, string) {
+	s = strings.TrimSpace(s)
+	if i := strings.IndexAny(s, " \t"); i >= 0 {
+		return s[:i], strings.TrimSpace(s[i:])
+	}
+	return s, ""
+}
+
+// Split comma-separated operands, ignoring commas within brackets or quotes.
+func splitOperands(s string) []string {
+	var ops []string
+	depth, quote, start := 0, byte(0), 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '[' || c == '(':
+			depth++
+		case c == ']' || c == ')':
+			depth--
+		case c == ',' && depth == 0:
+			ops = append(ops, strings.TrimSpace(s[start:i]))
+			start = i + 1
+		}
+	}
+	if last := strings.TrimSpace(s[start:]); last != "" || len(ops) > 0 {
+		ops = append(ops, last)
+	}
+	return ops
+}
+
+// Split a memory operand into terms, each beginning with '+' or '-'.
+func splitTerms(s string) []string {
+	var terms []string
+	s = strings.TrimSpace(s)
+	if s != "" && s[0] != '-' && s[0] != '+' {
+		s = "+" + s
+	}
+	start := 0
+	for i := 1; i < len(s); i++ {
+		if s[i] == '+' || s[i] == '-' {
+			terms = append(terms, s[start:i])
+			start = i
+		}
+	}
+	if start < len(s) {
+		terms = append(terms, s[start:])
+	}
+	return terms
+}
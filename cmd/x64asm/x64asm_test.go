@@ -0,0 +1,186 @@
+package main
+
+import (
+	"bytes"
+	"debug/elf"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/wdamron/x64"
+)
+
+const testSource = `
+.intel_syntax noprefix
+.globl sum
+sum:                            ; sum rcx qwords at rdi
+    xor eax, eax
+1:  add rax, qword ptr [rdi + rcx*8 - 8]
+    dec rcx
+    jnz 1b
+    mov al, 0xff
+    add rax, [rip + bias]
+    call report
+    ret
+    .align 8
+bias: .quad 0x10
+`
+
+func assemble(t *testing.T, src string) *parser {
+	a := x64.NewAssembler(nil)
+	p := newParser(a, "test.asm", 0)
+	if err := p.parse(src); err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestAssemble(t *testing.T) {
+	p := assemble(t, testSource)
+	frag, err := p.a.Fragment()
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, err := loadFragment(frag)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := fmt.Sprintf("%x", code); s != "31c0480344cff848ffc90f85f2ffffffb0ff48030507000000e800000000c3"+"90"+"1000000000000000" {
+		t.Fatalf("code = %s", s)
+	}
+
+	sections, err := p.elfSections()
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj, err := elf.NewFile(bytes.NewReader(writeELF(sections, p.symbols())))
+	if err != nil {
+		t.Fatal(err)
+	}
+	syms, err := obj.Symbols()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, s := range syms {
+		names = append(names, fmt.Sprintf("%s:%d:%d", s.Name, elf.ST_BIND(s.Info), s.Value))
+	}
+	if s := fmt.Sprint(names); s != "[:0:0 bias:0:32 sum:1:0 report:1:0]" {
+		t.Fatalf("symbols = %s", s)
+	}
+	text := obj.Section(".text")
+	if data, _ := text.Data(); !bytes.Equal(data, code) {
+		t.Fatalf(".text = %x", data)
+	}
+	rela, _ := obj.Section(".rela.text").Data()
+	if s := fmt.Sprintf("%x", rela); s != "1a00000000000000"+"0400000004000000"+"fcffffffffffffff" {
+		t.Fatalf(".rela.text = %s", s)
+	}
+}
+
+func TestAssembleSections(t *testing.T) {
+	p := assemble(t, `
+.globl f
+f:  mov eax, [rip + tbl]
+    lea rdx, [rip + msg]
+    ret
+.data
+tbl: .long 1
+.section .rodata
+msg: .asciz "hi"
+    .align 4
+    .long 2
+.text
+    ret
+`)
+	sections, err := p.elfSections()
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj, err := elf.NewFile(bytes.NewReader(writeELF(sections, p.symbols())))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, expect := range map[string]string{".text": "8b0500000000488d1500000000c3c3", ".data": "01000000", ".rodata": "68690000" + "02000000"} {
+		s := obj.Section(name)
+		if s == nil {
+			t.Fatalf("Missing section %s", name)
+		}
+		if data, _ := s.Data(); fmt.Sprintf("%x", data) != expect {
+			t.Fatalf("%s = %x", name, data)
+		}
+	}
+	if obj.Section(".data").Flags != elf.SHF_ALLOC|elf.SHF_WRITE || obj.Section(".rodata").Flags != elf.SHF_ALLOC {
+		t.Fatalf("Unexpected section flags: %v, %v", obj.Section(".data").Flags, obj.Section(".rodata").Flags)
+	}
+	syms, err := obj.Symbols()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, s := range syms {
+		if s.Name != "" {
+			names = append(names, fmt.Sprintf("%s:%s:%d", s.Name, obj.Sections[s.Section].Name, s.Value))
+		}
+	}
+	if s := fmt.Sprint(names); s != "[tbl:.data:0 msg:.rodata:0 f:.text:0]" {
+		t.Fatalf("symbols = %s", s)
+	}
+	// references to tbl and msg are relocated against their symbols (4 and 5):
+	rela, _ := obj.Section(".rela.text").Data()
+	if s := fmt.Sprintf("%x", rela); s != "0200000000000000"+"0200000004000000"+"fcffffffffffffff"+"0900000000000000"+"0200000005000000"+"fcffffffffffffff" {
+		t.Fatalf(".rela.text = %s", s)
+	}
+	if obj.Section(".rela.data") != nil {
+		t.Fatalf("Unexpected relocations for .data")
+	}
+
+	// Go assembly only supports the default section:
+	file := filepath.Join(t.TempDir(), "data.asm")
+	if err := os.WriteFile(file, []byte("f: ret\n.data\n.long 1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	err = run(config{input: file, format: "goasm", features: "all", base: "0"})
+	if err == nil || err.Error() != "Sections other than .text are not supported for Go assembly" {
+		t.Fatalf("Unexpected error for goasm with sections: %v", err)
+	}
+}
+
+func TestAbsoluteBranches(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "abs.asm")
+	if err := os.WriteFile(file, []byte("call 0x1000\nret\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	out := filepath.Join(t.TempDir(), "abs.bin")
+	if err := run(config{input: file, output: out, format: "bin", features: "all", base: "0xff0"}); err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := os.ReadFile(out); fmt.Sprintf("%x", b) != "e80b000000c3" {
+		t.Fatalf("Unexpected code: %x", b)
+	}
+	// the code is relocatable, so the absolute address of the target is unknown:
+	for _, format := range []string{"elf", "goasm"} {
+		err := run(config{input: file, output: out, format: format, features: "all", base: "0"})
+		if err == nil || err.Error() != file+":1: Absolute branch target 0x1000 is not supported for relocatable output" {
+			t.Fatalf("Unexpected error for %s: %v", format, err)
+		}
+	}
+}
+
+func TestAssembleErrors(t *testing.T) {
+	for src, expect := range map[string]string{
+		"foo rax":              "test.asm:1: Unknown instruction \"foo\"",
+		"nop\nmov rax, label":  "test.asm:2: Label \"label\" must be referenced by a memory operand or branch",
+		"x:\nx:":               "test.asm:2: Label \"x\" is already defined",
+		".quad 1\n.byte 256":   "test.asm:2: Value 0x100 exceeds 8 bits",
+		"mov rax, [rax+label]": "test.asm:1: Labels may only be referenced with RIP-relative addressing",
+		// numbers with more than 9 digits are named labels (see x64.ParseLocalLabel):
+		"1234567890:\n1234567890:": "test.asm:2: Label \"1234567890\" is already defined",
+	} {
+		a := x64.NewAssembler(nil)
+		if err := newParser(a, "test.asm", 0).parse(src); err == nil || err.Error() != expect {
+			t.Fatalf("Unexpected error for %q: %v", src, err)
+		}
+	}
+}
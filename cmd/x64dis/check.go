@@ -0,0 +1,288 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/wdamron/x64"
+	"github.com/wdamron/x64/lookup"
+	"golang.org/x/arch/x86/x86asm"
+)
+
+// Re-encode a decoded instruction with an Assembler, returning the encoded bytes. Immediates are encoded with
+// each width which can hold their value, preferring the encoding which matches the original bytes (enc).
+func reencode(inst x86asm.Inst, enc []byte) ([]byte, error) {
+	name := strings.TrimSuffix(inst.Op.String(), "_XMM")
+	op, ok := x64lookup.Inst(name)
+	if !ok {
+		return nil, fmt.Errorf("Unsupported instruction %s", name)
+	}
+	var args []x64.Arg
+	var segment byte
+	immIndex, immValue := -1, int64(0)
+	for _, arg := range inst.Args {
+		if arg == nil || stringOps[inst.Op] {
+			// string instructions have implicit operands
+			break
+		}
+		switch v := arg.(type) {
+		case x86asm.Reg:
+			r, ok := convertReg(v)
+			if !ok {
+				return nil, fmt.Errorf("Unsupported register %v", v)
+			}
+			args = append(args, r)
+		case x86asm.Mem:
+			width := inst.MemBytes
+			switch {
+			case inst.Op == x86asm.LEA:
+				// the address is not loaded; its width must match the destination
+				width = inst.DataSize / 8
+			case width == 0 && x87m80Ops[inst.Op]:
+				width = 10
+			}
+			m, err := convertMem(v, width, dispWidth(enc))
+			if err != nil {
+				return nil, err
+			}
+			segment = segmentPrefixes[v.Segment]
+			args = append(args, m)
+		case x86asm.Imm:
+			immIndex, immValue = len(args), immValueOf(int64(v), inst)
+			args = append(args, nil)
+		case x86asm.Rel:
+			if inst.PCRel == 1 {
+				args = append(args, x64.Rel8(v))
+			} else {
+				args = append(args, x64.Rel32(v))
+			}
+		default:
+			return nil, fmt.Errorf("Unsupported argument %v", arg)
+		}
+	}
+
+	var first []byte
+	var firstErr error
+	for _, width := range []uint8{1, 2, 4, 8} {
+		if immIndex >= 0 {
+			if !immFits(immValue, width) {
+				continue
+			}
+			args[immIndex] = immArg(immValue, width)
+		}
+		code, err := encode(inst, op, segment, args)
+		if err == nil && bytes.Equal(code, enc) {
+			return code, nil
+		}
+		if err == nil && first == nil {
+			first = code
+		} else if err != nil && firstErr == nil {
+			firstErr = err
+		}
+		if immIndex < 0 {
+			break
+		}
+	}
+	if first != nil {
+		return first, nil
+	}
+	return nil, firstErr
+}
+
+func encode(inst x86asm.Inst, op x64.Inst, segment byte, args []x64.Arg) ([]byte, error) {
+	a := x64.NewAssembler(nil)
+	if segment != 0 {
+		a.RawByte(segment)
+	}
+	var err error
+	switch {
+	case hasPrefix(inst, x86asm.PrefixLOCK):
+		err = a.Lock(op, args...)
+	case hasPrefix(inst, x86asm.PrefixREP):
+		err = a.Rep(op, args...)
+	case hasPrefix(inst, x86asm.PrefixREPN):
+		err = a.Repne(op, args...)
+	default:
+		err = a.Inst(op, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return a.Code(), nil
+}
+
+// Check if the instruction has an explicit prefix which is not implied by the instruction (e.g. a mandatory prefix).
+func hasPrefix(inst x86asm.Inst, prefix x86asm.Prefix) bool {
+	for _, p := range inst.Prefix {
+		if p == 0 {
+			break
+		}
+		if p&^(x86asm.PrefixImplicit|x86asm.PrefixIgnored|x86asm.PrefixInvalid) == prefix {
+			return p&(x86asm.PrefixImplicit|x86asm.PrefixIgnored|x86asm.PrefixInvalid) == 0
+		}
+	}
+	return false
+}
+
+var stringOps = map[x86asm.Op]bool{
+	x86asm.CMPSB: true, x86asm.CMPSW: true, x86asm.CMPSD: true, x86asm.CMPSQ: true,
+	x86asm.INSB: true, x86asm.INSW: true, x86asm.INSD: true,
+	x86asm.LODSB: true, x86asm.LODSW: true, x86asm.LODSD: true, x86asm.LODSQ: true,
+	x86asm.MOVSB: true, x86asm.MOVSW: true, x86asm.MOVSD: true, x86asm.MOVSQ: true,
+	x86asm.OUTSB: true, x86asm.OUTSW: true, x86asm.OUTSD: true,
+	x86asm.SCASB: true, x86asm.SCASW: true, x86asm.SCASD: true, x86asm.SCASQ: true,
+	x86asm.STOSB: true, x86asm.STOSW: true, x86asm.STOSD: true, x86asm.STOSQ: true,
+}
+
+var segmentPrefixes = map[x86asm.Reg]byte{x86asm.ES: 0x26, x86asm.CS: 0x2e, x86asm.FS: 0x64, x86asm.GS: 0x65}
+
+// x87 instructions with m80 operands, for which x86asm reports no memory width
+var x87m80Ops = map[x86asm.Op]bool{x86asm.FLD: true, x86asm.FSTP: true, x86asm.FBLD: true, x86asm.FBSTP: true}
+
+var legacyPrefixes = map[byte]bool{
+	0x26: true, 0x2e: true, 0x36: true, 0x3e: true, 0x64: true, 0x65: true, 0x66: true, 0x67: true,
+	0xf0: true, 0xf2: true, 0xf3: true,
+}
+
+// Get the width in bytes of the displacement selected by ModRM.mod (and the SIB base) in an encoded instruction
+// with a memory operand.
+func dispWidth(enc []byte) int {
+	i := 0
+	for i < len(enc) && legacyPrefixes[enc[i]] {
+		i++
+	}
+	if i < len(enc) && enc[i]&0xf0 == 0x40 {
+		i++ // REX
+	}
+	switch {
+	case i+1 >= len(enc):
+	case enc[i] == 0xc5:
+		i += 3 // 2-byte VEX and opcode
+	case enc[i] == 0xc4 || (enc[i] == 0x8f && enc[i+1]&0x1f >= 8):
+		i += 4 // 3-byte VEX or XOP and opcode
+	case enc[i] == 0x0f && (enc[i+1] == 0x38 || enc[i+1] == 0x3a):
+		i += 3
+	case enc[i] == 0x0f:
+		i += 2
+	case enc[i] >= 0xa0 && enc[i] <= 0xa3:
+		return 8 // MOV with a 64-bit absolute address (moffs)
+	default:
+		i++
+	}
+	if i >= len(enc) {
+		return 4
+	}
+	modrm := enc[i]
+	switch modrm >> 6 {
+	case 1:
+		return 1
+	case 2:
+		return 4
+	}
+	if modrm&7 == 5 || (modrm&7 == 4 && i+1 < len(enc) && enc[i+1]&7 == 5) {
+		return 4 // RIP-relative, or SIB without a base
+	}
+	return 0
+}
+
+func convertReg(r x86asm.Reg) (x64.Reg, bool) {
+	switch {
+	case r >= x86asm.AL && r <= x86asm.BL:
+		return x64.AL + x64.Reg(r-x86asm.AL), true
+	case r >= x86asm.AH && r <= x86asm.BH:
+		return x64.AH + x64.Reg(r-x86asm.AH), true
+	case r >= x86asm.SPB && r <= x86asm.R15B:
+		return x64.AL + 4 + x64.Reg(r-x86asm.SPB), true
+	case r >= x86asm.AX && r <= x86asm.R15W:
+		return x64.AX + x64.Reg(r-x86asm.AX), true
+	case r >= x86asm.EAX && r <= x86asm.R15L:
+		return x64.EAX + x64.Reg(r-x86asm.EAX), true
+	case r >= x86asm.RAX && r <= x86asm.R15:
+		return x64.RAX + x64.Reg(r-x86asm.RAX), true
+	case r == x86asm.RIP:
+		return x64.RIP, true
+	case r >= x86asm.F0 && r <= x86asm.F7:
+		return x64.F0 + x64.Reg(r-x86asm.F0), true
+	case r >= x86asm.M0 && r <= x86asm.M7:
+		return x64.M0 + x64.Reg(r-x86asm.M0), true
+	case r >= x86asm.X0 && r <= x86asm.X15:
+		return x64.X0 + x64.Reg(r-x86asm.X0), true
+	case r >= x86asm.Y0 && r <= x86asm.Y15:
+		return x64.Y0 + x64.Reg(r-x86asm.Y0), true
+	case r >= x86asm.ES && r <= x86asm.GS:
+		return x64.ES + x64.Reg(r-x86asm.ES), true
+	case r >= x86asm.CR0 && r <= x86asm.CR15:
+		return x64.CR0 + x64.Reg(r-x86asm.CR0), true
+	case r >= x86asm.DR0 && r <= x86asm.DR15:
+		return x64.DR0 + x64.Reg(r-x86asm.DR0), true
+	}
+	return 0, false
+}
+
+// Convert a memory operand. The displacement is encoded with dispWidth bytes, so explicit zero displacements are
+// preserved.
+func convertMem(m x86asm.Mem, width, dispWidth int) (x64.Mem, error) {
+	var mem x64.Mem
+	var ok bool
+	if m.Base != 0 {
+		if mem.Base, ok = convertReg(m.Base); !ok {
+			return mem, fmt.Errorf("Unsupported register %v", m.Base)
+		}
+	}
+	if m.Index != 0 {
+		if mem.Index, ok = convertReg(m.Index); !ok {
+			return mem, fmt.Errorf("Unsupported register %v", m.Index)
+		}
+		mem.Scale = m.Scale
+	}
+	if m.Disp < math.MinInt32 || m.Disp > math.MaxInt32 {
+		return mem, fmt.Errorf("Unsupported displacement %#x", m.Disp)
+	}
+	switch dispWidth {
+	case 0:
+	case 1:
+		mem.Disp = x64.Rel8(m.Disp)
+	default:
+		mem.Disp = x64.Rel32(m.Disp)
+	}
+	if width > 0 && width <= 255 {
+		mem.Width = uint8(width)
+	}
+	return mem, nil
+}
+
+// Get the value of an immediate. Values which only fit the operand size as unsigned values are truncated to
+// the operand size.
+func immValueOf(v int64, inst x86asm.Inst) int64 {
+	size := uint(inst.DataSize)
+	if inst.MemBytes == 1 || (len(inst.Args) > 0 && isByteReg(inst.Args[0])) {
+		size = 8
+	}
+	if size < 64 && v >= 1<<(size-1) && v < 1<<size {
+		v = v << (64 - size) >> (64 - size)
+	}
+	return v
+}
+
+func immFits(v int64, width uint8) bool {
+	return width == 8 || (v >= -1<<(width*8-1) && v < 1<<(width*8-1))
+}
+
+func immArg(v int64, width uint8) x64.Arg {
+	switch width {
+	case 1:
+		return x64.Imm8(v)
+	case 2:
+		return x64.Imm16(v)
+	case 4:
+		return x64.Imm32(v)
+	}
+	return x64.Imm64(v)
+}
+
+func isByteReg(arg x86asm.Arg) bool {
+	r, ok := arg.(x86asm.Reg)
+	return ok && r >= x86asm.AL && r <= x86asm.R15B
+}
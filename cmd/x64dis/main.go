@@ -0,0 +1,243 @@
+// Command x64dis disassembles x86-64 machine code in Intel, AT&T or Go syntax.
+//
+// Usage:
+//
+//	x64dis [flags] [file]
+//
+// The input is read from stdin if no file is given. The input may be raw binary, text with hexadecimal bytes
+// (-hex), or an ELF file, in which case specific symbols may be selected (-sym). With -check, each decoded
+// instruction is re-encoded with the x64 package's Assembler, and instructions which can not be encoded or
+// which are encoded differently are flagged.
+package main
+
+import (
+	"bytes"
+	"debug/elf"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/arch/x86/x86asm"
+)
+
+func main() {
+	hexInput := flag.Bool("hex", false, "read the input as hexadecimal bytes")
+	syntax := flag.String("syntax", "intel", "output syntax: intel, att or go")
+	base := flag.String("base", "0", "base address of the code (raw or hexadecimal input)")
+	syms := flag.String("sym", "", "comma-separated ELF symbols to disassemble (default: the .text section)")
+	check := flag.Bool("check", false, "re-encode each instruction with the x64 package and flag differences")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: x64dis [flags] [file]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() > 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	var input []byte
+	var err error
+	if file := flag.Arg(0); file == "" || file == "-" {
+		input, err = io.ReadAll(os.Stdin)
+	} else {
+		input, err = os.ReadFile(file)
+	}
+	if err == nil {
+		err = run(os.Stdout, input, config{hex: *hexInput, syntax: *syntax, base: *base, syms: *syms, check: *check})
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "x64dis:", err)
+		os.Exit(1)
+	}
+}
+
+type config struct {
+	hex                bool
+	syntax, base, syms string
+	check              bool
+}
+
+// block is a range of code to disassemble.
+type block struct {
+	name string
+	addr uint64
+	code []byte
+}
+
+func run(w io.Writer, input []byte, c config) error {
+	var format func(x86asm.Inst, uint64, x86asm.SymLookup) string
+	switch c.syntax {
+	case "intel":
+		format = x86asm.IntelSyntax
+	case "att", "gnu":
+		format = x86asm.GNUSyntax
+	case "go", "plan9":
+		format = x86asm.GoSyntax
+	default:
+		return fmt.Errorf("Unknown syntax %q", c.syntax)
+	}
+
+	var blocks []block
+	var lookup x86asm.SymLookup
+	if bytes.HasPrefix(input, []byte(elf.ELFMAG)) {
+		f, err := elf.NewFile(bytes.NewReader(input))
+		if err != nil {
+			return err
+		}
+		if blocks, lookup, err = elfBlocks(f, c.syms); err != nil {
+			return err
+		}
+	} else {
+		if c.syms != "" {
+			return fmt.Errorf("Symbols may only be selected within ELF files")
+		}
+		base, err := strconv.ParseUint(c.base, 0, 64)
+		if err != nil {
+			return fmt.Errorf("Invalid base address %q", c.base)
+		}
+		code := input
+		if c.hex {
+			if code, err = parseHex(string(input)); err != nil {
+				return err
+			}
+		}
+		blocks = []block{{addr: base, code: code}}
+	}
+
+	for _, b := range blocks {
+		if b.name != "" {
+			fmt.Fprintf(w, "\n%016x <%s>:\n", b.addr, b.name)
+		}
+		for pc := 0; pc < len(b.code); {
+			addr := b.addr + uint64(pc)
+			inst, err := x86asm.Decode(b.code[pc:], 64)
+			if err != nil || inst.Op == 0 {
+				fmt.Fprintf(w, "%8x:  %-30s  (bad)\n", addr, hexBytes(b.code[pc:pc+1]))
+				pc++
+				continue
+			}
+			enc := b.code[pc : pc+inst.Len]
+			text := format(inst, addr, symbolic(inst, lookup))
+			if c.check {
+				if re, err := reencode(inst, enc); err != nil {
+					text += "\t; !! " + err.Error()
+				} else if !bytes.Equal(re, enc) {
+					text += "\t; !! x64: " + hexBytes(re)
+				}
+			}
+			fmt.Fprintf(w, "%8x:  %-30s  %s\n", addr, hexBytes(enc), text)
+			pc += inst.Len
+		}
+	}
+	return nil
+}
+
+// Get the blocks for the selected symbols (or the .text section) within an ELF file, and a symbol lookup for
+// branch targets.
+func elfBlocks(f *elf.File, selected string) ([]block, x86asm.SymLookup, error) {
+	syms, _ := f.Symbols()
+	sort.Slice(syms, func(i, j int) bool { return syms[i].Value < syms[j].Value })
+	lookup := func(addr uint64) (string, uint64) {
+		i := sort.Search(len(syms), func(i int) bool { return syms[i].Value > addr }) - 1
+		for ; i >= 0; i-- {
+			s := syms[i]
+			if s.Name != "" && elf.ST_TYPE(s.Info) != elf.STT_SECTION && (addr < s.Value+s.Size || (s.Size == 0 && addr == s.Value)) {
+				return s.Name, s.Value
+			}
+			if s.Value < addr && s.Size != 0 {
+				break
+			}
+		}
+		return "", 0
+	}
+
+	if selected == "" {
+		text := f.Section(".text")
+		if text == nil {
+			return nil, nil, fmt.Errorf("No .text section")
+		}
+		data, err := text.Data()
+		if err != nil {
+			return nil, nil, err
+		}
+		return []block{{name: ".text", addr: text.Addr, code: data}}, lookup, nil
+	}
+
+	var blocks []block
+	for _, name := range strings.Split(selected, ",") {
+		found := false
+		for i, s := range syms {
+			if s.Name != name || s.Section == elf.SHN_UNDEF || int(s.Section) >= len(f.Sections) {
+				continue
+			}
+			sect := f.Sections[s.Section]
+			data, err := sect.Data()
+			if err != nil {
+				return nil, nil, err
+			}
+			// symbols without a size extend to the next symbol or the end of the section
+			start, end := s.Value-sect.Addr, s.Value-sect.Addr+s.Size
+			if s.Size == 0 {
+				end = uint64(len(data))
+				for _, next := range syms[i+1:] {
+					if next.Section == s.Section && next.Value > s.Value {
+						end = next.Value - sect.Addr
+						break
+					}
+				}
+			}
+			if start > uint64(len(data)) || end > uint64(len(data)) {
+				return nil, nil, fmt.Errorf("Symbol %q exceeds its section", name)
+			}
+			blocks, found = append(blocks, block{name: name, addr: s.Value, code: data[start:end]}), true
+			break
+		}
+		if !found {
+			return nil, nil, fmt.Errorf("Symbol %q not found", name)
+		}
+	}
+	return blocks, lookup, nil
+}
+
+// Get the symbol lookup for the instruction, if it references an address through a branch target or a
+// RIP-relative displacement. Immediates are never formatted as symbols.
+func symbolic(inst x86asm.Inst, lookup x86asm.SymLookup) x86asm.SymLookup {
+	for _, arg := range inst.Args {
+		switch v := arg.(type) {
+		case x86asm.Rel:
+			return lookup
+		case x86asm.Mem:
+			if v.Base == x86asm.RIP {
+				return lookup
+			}
+		}
+	}
+	return nil
+}
+
+// Parse hexadecimal bytes, ignoring whitespace, commas and 0x prefixes.
+func parseHex(s string) ([]byte, error) {
+	s = strings.NewReplacer("0x", "", "0X", "", ",", "", "\\x", "").Replace(s)
+	s = strings.Join(strings.Fields(s), "")
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid hexadecimal input: %v", err)
+	}
+	return b, nil
+}
+
+func hexBytes(b []byte) string {
+	var s strings.Builder
+	for i, v := range b {
+		if i > 0 {
+			s.WriteByte(' ')
+		}
+		fmt.Fprintf(&s, "%02x", v)
+	}
+	return s.String()
+}
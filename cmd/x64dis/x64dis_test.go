@@ -0,0 +1,72 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"golang.org/x/arch/x86/x86asm"
+)
+
+func TestReencode(t *testing.T) {
+	for _, code := range []string{
+		"4889f8",               // mov rax, rdi
+		"8b03",                 // mov eax, [rbx]
+		"488b4c2408",           // mov rcx, [rsp+8]
+		"4801d8",               // add rax, rbx
+		"4883c0ff",             // add rax, -1
+		"b0ff",                 // mov al, 0xff
+		"f0480fb111",           // lock cmpxchg [rcx], rdx
+		"f348a5",               // rep movsq
+		"eb00",                 // jmp .+2
+		"e800000000",           // call .+5
+		"c3",                   // ret
+		"488d0500000000",       // lea rax, [rip]
+		"8d447f01",             // lea eax, [rdi+rdi*2+1]
+		"48c744246000000000",   // mov qword ptr [rsp+0x60], 0
+		"66410f6f4c2410",       // movdqa xmm1, [r12+16]
+		"48b88877665544332211", // mov rax, 0x1122334455667788
+		"c5fd6fc1",             // vmovdqa ymm0, ymm1
+		"488b4000",             // mov rax, [rax+0]
+		"0f1f440000",           // nop dword ptr [rax+rax*1+0]
+		"db28",                 // fld tbyte ptr [rax]
+		"db7b08",               // fstp tbyte ptr [rbx+8]
+		"df20",                 // fbld tbyte ptr [rax]
+		"8b0424",               // mov eax, [rsp]
+		"8b042500100000",       // mov eax, [0x1000]
+		"8b4500",               // mov eax, [rbp]
+	} {
+		b, err := parseHex(code)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inst, err := x86asm.Decode(b, 64)
+		if err != nil {
+			t.Fatalf("%s: %v", code, err)
+		}
+		re, err := reencode(inst, b)
+		if err != nil {
+			t.Fatalf("%v: %v", inst, err)
+		}
+		if !bytes.Equal(re, b) {
+			t.Fatalf("%v: expected % x, got % x", inst, b, re)
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	var out bytes.Buffer
+	if err := run(&out, []byte("0x48, 0x89, 0xf8, 0xc3, 0xff"), config{hex: true, syntax: "att", base: "0x1000", check: true}); err != nil {
+		t.Fatal(err)
+	}
+	expect := "" +
+		"    1000:  48 89 f8                        mov %rdi,%rax\n" +
+		"    1003:  c3                              retq\n" +
+		"    1004:  ff                              (bad)\n"
+	if got := out.String(); got != expect {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expect, got)
+	}
+	if err := run(&out, nil, config{syntax: "masm", base: "0"}); err == nil || !strings.Contains(err.Error(), "syntax") {
+		t.Fatalf("expected a syntax error, got %v", err)
+	}
+}
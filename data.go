@@ -0,0 +1,210 @@
+package x64
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// DataRegion is a range of data within a section, which was written by one of the data methods
+// (e.g. RawFloat64 or RawCString) or within a data block (see BeginData).
+type DataRegion struct {
+	Section string
+	// The offsets of the first byte and the byte following the region, relative to the start of the section
+	Start, End uint32
+}
+
+type dataRegion struct {
+	start, end uint32
+	section    uint8
+}
+
+// Write a raw 32-bit float to the encoding buffer.
+func (a *Assembler) RawFloat32(f float32) {
+	start := a.PC()
+	a.b.Int32(int32(math.Float32bits(f)))
+	a.markData(start)
+}
+
+// Write a raw 64-bit float to the encoding buffer.
+func (a *Assembler) RawFloat64(f float64) {
+	start := a.PC()
+	a.b.Int64(int64(math.Float64bits(f)))
+	a.markData(start)
+}
+
+// Write a vector of 16-bit integer lanes to the encoding buffer.
+func (a *Assembler) RawInt16s(lanes ...int16) {
+	start := a.PC()
+	for _, v := range lanes {
+		a.b.Int16(v)
+	}
+	a.markData(start)
+}
+
+// Write a vector of 32-bit integer lanes to the encoding buffer.
+func (a *Assembler) RawInt32s(lanes ...int32) {
+	start := a.PC()
+	for _, v := range lanes {
+		a.b.Int32(v)
+	}
+	a.markData(start)
+}
+
+// Write a vector of 64-bit integer lanes to the encoding buffer.
+func (a *Assembler) RawInt64s(lanes ...int64) {
+	start := a.PC()
+	for _, v := range lanes {
+		a.b.Int64(v)
+	}
+	a.markData(start)
+}
+
+// Write a vector of 32-bit float lanes to the encoding buffer.
+func (a *Assembler) RawFloat32s(lanes ...float32) {
+	start := a.PC()
+	for _, f := range lanes {
+		a.b.Int32(int32(math.Float32bits(f)))
+	}
+	a.markData(start)
+}
+
+// Write a vector of 64-bit float lanes to the encoding buffer.
+func (a *Assembler) RawFloat64s(lanes ...float64) {
+	start := a.PC()
+	for _, f := range lanes {
+		a.b.Int64(int64(math.Float64bits(f)))
+	}
+	a.markData(start)
+}
+
+// Write a NUL-terminated string to the encoding buffer.
+func (a *Assembler) RawCString(s string) {
+	start := a.PC()
+	a.b.extend(len(s) + 1)
+	a.b.i += copy(a.b.b[a.b.i:], s)
+	a.b.Byte(0)
+	a.markData(start)
+}
+
+// Write a string to the encoding buffer, prefixed with its length as a little-endian integer of the given
+// width in bytes (1, 2, 4 or 8). If the length exceeds the range of the prefix, the assembler's error will
+// be set.
+func (a *Assembler) RawPString(s string, prefixWidth uint8) {
+	start, n := a.PC(), uint64(len(s))
+	var prefix [8]byte
+	binary.LittleEndian.PutUint64(prefix[:], n)
+	switch {
+	case prefixWidth != 1 && prefixWidth != 2 && prefixWidth != 4 && prefixWidth != 8:
+		a.setDataError(ReasonOperandSize, fmt.Errorf("Invalid length-prefix width for string: %d", prefixWidth))
+		return
+	case prefixWidth < 8 && n >= 1<<(8*uint(prefixWidth)):
+		a.setDataError(ReasonRange, fmt.Errorf("String length %d exceeds range for %d-bit length-prefix", n, 8*prefixWidth))
+		return
+	}
+	a.b.Bytes(prefix[:prefixWidth])
+	a.b.extend(len(s))
+	a.b.i += copy(a.b.b[a.b.i:], s)
+	a.markData(start)
+}
+
+// Write length zero bytes to the encoding buffer.
+func (a *Assembler) RawZeros(length int) { a.RawFill(length, 0) }
+
+// Write length copies of the fill byte to the encoding buffer. If length is negative, the assembler's error
+// will be set.
+func (a *Assembler) RawFill(length int, fill byte) {
+	if length < 0 {
+		a.setDataError(ReasonRange, fmt.Errorf("Negative length for fill: %d", length))
+		return
+	}
+	start := a.PC()
+	a.b.Fill(length, fill)
+	a.markData(start)
+}
+
+// Align the program counter to a power-of-2 offset. Intermediate space will be filled with the fill byte
+// and tracked as data (see DataRegions); the PC will not change if it is already aligned.
+func (a *Assembler) AlignFill(pow2 uint32, fill byte) {
+	if pow2 == 0 || pow2&(pow2-1) != 0 {
+		a.setDataError(ReasonOperandSize, fmt.Errorf("Alignment must be a power of 2: %d", pow2))
+		return
+	}
+	a.b.requireAlign(pow2)
+	if pad := -a.PC() & (pow2 - 1); pad != 0 {
+		a.RawFill(int(pad), fill)
+	}
+}
+
+// Begin a block of data at the current PC, returning a label bound to the start of the block. All output
+// to the current section will be tracked as data (see DataRegions) until EndData is called, including
+// output from Raw and Inst.
+func (a *Assembler) BeginData() Label {
+	if !a.inData {
+		a.inData, a.dataStart, a.dataSection = true, a.PC(), a.cur
+	}
+	return a.NewLabel()
+}
+
+// End the current data block (see BeginData).
+func (a *Assembler) EndData() {
+	if !a.inData {
+		return
+	}
+	a.inData = false
+	a.addDataRegion(dataRegion{start: a.dataStart, end: uint32(a.sectionBuf(a.dataSection).Len()), section: a.dataSection})
+}
+
+// Get all regions of data which have been written to the encoding buffer, ordered by section and offset.
+// Adjacent regions will be merged.
+func (a *Assembler) DataRegions() []DataRegion {
+	regions := make([]dataRegion, len(a.data))
+	copy(regions, a.data)
+	sort.SliceStable(regions, func(i, j int) bool {
+		if regions[i].section != regions[j].section {
+			return regions[i].section < regions[j].section
+		}
+		return regions[i].start < regions[j].start
+	})
+	var out []DataRegion
+	for _, r := range regions {
+		name := DefaultSection
+		if a.sections != nil {
+			name = a.sections[r.section].name
+		}
+		if n := len(out); n > 0 && out[n-1].Section == name && out[n-1].End >= r.start {
+			if r.end > out[n-1].End {
+				out[n-1].End = r.end
+			}
+			continue
+		}
+		out = append(out, DataRegion{Section: name, Start: r.start, End: r.end})
+	}
+	return out
+}
+
+// Track the data written since start, unless a data block is in progress.
+func (a *Assembler) markData(start uint32) {
+	if a.inData && a.dataSection == a.cur {
+		return
+	}
+	a.addDataRegion(dataRegion{start: start, end: a.PC(), section: a.cur})
+}
+
+func (a *Assembler) addDataRegion(r dataRegion) {
+	if r.end <= r.start {
+		return
+	}
+	if n := len(a.data); n > 0 && a.data[n-1].section == r.section && a.data[n-1].end == r.start {
+		a.data[n-1].end = r.end
+		return
+	}
+	a.data = append(a.data, r)
+}
+
+func (a *Assembler) setDataError(reason ErrorReason, err error) {
+	if a.err == nil {
+		a.err = &EncodeError{PC: int(a.PC()), Operand: -1, Reason: reason, Err: err}
+	}
+}
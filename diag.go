@@ -0,0 +1,245 @@
+package x64
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/wdamron/x64/feats"
+)
+
+// Maximum number of suggestions included in a Diagnosis.
+const maxSuggestions = 3
+
+// Diagnosis describes the encodings which most closely match the arguments for an instruction
+// which could not be matched.
+type Diagnosis struct {
+	// The closest encodings, ordered by the number of problems
+	Suggestions []Suggestion
+	// The instruction would match if the CPU features in MissingFeatures were enabled
+	FeaturesOnly    bool
+	MissingFeatures feats.Feature
+}
+
+// Suggestion describes an encoding which does not match the arguments for an instruction, along with
+// the problems which prevented it from matching.
+type Suggestion struct {
+	Encoding Encoding
+	// The encoding's form, with wildcard sizes resolved for the provided arguments (e.g. "ADD r/m64, imm32")
+	Form     string
+	Problems []string
+	// CPU features required by the encoding which are not enabled
+	MissingFeatures feats.Feature
+}
+
+func (s Suggestion) String() string { return s.Form + ": " + strings.Join(s.Problems, "; ") }
+
+func (d *Diagnosis) String() string {
+	if d.FeaturesOnly {
+		return "requires disabled CPU features: " + d.MissingFeatures.String()
+	}
+	forms := make([]string, len(d.Suggestions))
+	for i, s := range d.Suggestions {
+		forms[i] = s.String()
+	}
+	return "did you mean: " + strings.Join(forms, ", or ")
+}
+
+// Check if diagnostics are enabled (see SetDiagnostics).
+func (m *InstMatcher) Diagnostics() bool { return m.diagnostics }
+
+// Enable or disable diagnostics. When enabled, errors which match ErrNoMatch will include a Diagnosis
+// with the closest encodings for the instruction (see EncodeError.Diagnosis).
+func (m *InstMatcher) SetDiagnostics(enabled bool) { m.diagnostics = enabled }
+
+// Enable or disable diagnostics. When enabled, errors which match ErrNoMatch will include a Diagnosis
+// with the closest encodings for the instruction (see EncodeError.Diagnosis).
+func (a *Assembler) SetDiagnostics(enabled bool) {
+	a.diagnostics, a.match.diagnostics = enabled, enabled
+}
+
+// Score all encodings for inst against args, and describe the closest encodings. If an encoding
+// matches, nil will be returned.
+func (m *InstMatcher) Diagnose(inst Inst, args ...Arg) *Diagnosis {
+	if err := m.prepare(inst, args...); err != nil {
+		return nil
+	}
+	defer m.reset()
+	if _, err := m.sanitizeMemArg(); err != nil {
+		return nil
+	}
+	c := *m
+	c.args = c._args[:len(m.args)]
+	c.diagnostics = false
+	if c.matchFrom(0) == nil {
+		return nil
+	}
+	return m.diagnose()
+}
+
+func (m *InstMatcher) diagnose() *Diagnosis {
+	d := &Diagnosis{}
+	inst := m.inst
+	if inst == 0 {
+		return d
+	}
+
+	// check if the instruction would match with all CPU features enabled
+	c := *m
+	c.args = c._args[:len(m.args)]
+	c.feats = feats.AllFeatures
+	c.memo = nil // the memo is shared, and would be reset for the features of the copy
+	c.policy = FirstMatch
+	c.diagnostics = false
+	if c.matchFrom(0) == nil {
+		d.FeaturesOnly = true
+		d.MissingFeatures = c.enc.features() &^ m.feats
+	}
+
+	// each problem adds 2 to an encoding's score, except for out-of-range immediates, which add 1 (the
+	// operand kinds and sizes match for the encoding)
+	opSize := m.argSize()
+	type scored struct {
+		s     Suggestion
+		score int
+	}
+	var candidates []scored
+	for i, e := range inst.encs() {
+		id := uint(inst.offset()) + uint(i)
+		s := Suggestion{Encoding: describeEncoding(inst, id, e)}
+		s.Form = s.Encoding.form(opSize)
+		score := 0
+		if missing := e.features() &^ m.feats; missing != 0 {
+			s.MissingFeatures = missing
+			s.Problems = append(s.Problems, "requires "+missing.String()+" (disabled)")
+			score += 2
+		}
+		p := e.format()
+		pl := len(s.Encoding.Operands) * 2
+		if pl/2 != len(m.args) {
+			s.Problems = append(s.Problems, fmt.Sprintf("expects %d operand(s), found %d", pl/2, len(m.args)))
+			score += 2 + 2*abs(pl/2-len(m.args))
+		}
+		for pi, ai := 0, 0; pi+1 < pl && ai < len(m.args); pi, ai = pi+2, ai+1 {
+			reason := m.matchArg(p[pi], p[pi+1], ai)
+			if reason == 0 {
+				for _, r := range m.rejects[:m.rejectc] {
+					if uint(r.id) == id && r.reason == RejectRange && int(r.operand) == ai {
+						reason = RejectRange
+					}
+				}
+			}
+			if reason != 0 {
+				s.Problems = append(s.Problems, m.describeProblem(reason, ai, s.Encoding.Operands[ai], opSize))
+				score += 2
+				if reason == RejectRange {
+					score--
+				}
+			}
+		}
+		if len(s.Problems) > 0 {
+			candidates = append(candidates, scored{s, score})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].score < candidates[j].score })
+	for i := 0; i < len(candidates) && i < maxSuggestions; i++ {
+		d.Suggestions = append(d.Suggestions, candidates[i].s)
+	}
+	return d
+}
+
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
+
+// Get the operand size implied by the register or memory arguments, or 0 if the size is unknown.
+func (m *InstMatcher) argSize() uint8 {
+	for i, arg := range m.args {
+		switch arg.kind {
+		case opReg:
+			switch arg.reg.Family() {
+			case REG_LEGACY, REG_XMM, REG_YMM:
+				return arg.reg.width()
+			}
+		case opMem:
+			if i == m.memOffset && m.mem.Width != 0 {
+				return m.mem.Width
+			}
+		}
+	}
+	return 0
+}
+
+func (m *InstMatcher) describeProblem(reason RejectReason, ai int, o Operand, opSize uint8) string {
+	arg := m.args[ai]
+	expect := o.form(opSize)
+	found := describeArg(m.argAt(ai))
+	switch reason {
+	case RejectOperandSize:
+		if arg.isImm() && len(o.Sizes()) > 0 {
+			sizes := o.Sizes()
+			if arg.w > sizes[len(sizes)-1] {
+				return fmt.Sprintf("immediate %s too wide", found)
+			}
+		}
+		return fmt.Sprintf("operand %d: expected %s, found %s with a different size", ai+1, expect, found)
+	case RejectRange:
+		return fmt.Sprintf("immediate %s too wide: value %#x exceeds %s", found, arg.val, expect)
+	}
+	return fmt.Sprintf("operand %d: expected %s, found %s", ai+1, expect, found)
+}
+
+// Describe an argument for diagnostics, e.g. "Imm64", "rax" or "m64".
+func describeArg(arg Arg) string {
+	switch v := arg.(type) {
+	case Reg:
+		return v.String()
+	case Mem:
+		if v.Width == 0 {
+			return "m"
+		}
+		return "m" + strconv.Itoa(int(v.Width)*8)
+	}
+	return strings.TrimPrefix(fmt.Sprintf("%T", arg), "x64.")
+}
+
+// Format the encoding with wildcard sizes resolved for the operation size.
+func (e Encoding) form(opSize uint8) string {
+	s := e.Inst.Name()
+	for i, o := range e.Operands {
+		if i == 0 {
+			s += " "
+		} else {
+			s += ", "
+		}
+		s += o.form(opSize)
+	}
+	return s
+}
+
+// Format the operand with a wildcard size resolved for the operation size.
+func (o Operand) form(opSize uint8) string {
+	if !o.Wildcard || opSize == 0 {
+		return o.String()
+	}
+	size := opSize
+	if o.Kind == OperandImm && opSize > 4 {
+		size = 4
+	}
+	for _, sz := range o.Sizes() {
+		if sz == size {
+			r := o
+			r.Wildcard, r.Size = false, size
+			if r.Kind == OperandFixedReg {
+				r.FixedReg = resizeReg(r.FixedReg, size)
+			}
+			return r.String()
+		}
+	}
+	return o.String()
+}
@@ -1,8 +1,6 @@
 package x64
 
 import (
-	"fmt"
-
 	. "github.com/wdamron/x64/internal/flags"
 )
 
@@ -14,10 +12,38 @@ const (
 	modDisp32 uint8 = 2
 )
 
-func (a *Assembler) checkRex(rexW bool) (bool, error) {
-	argp := a.match.argp
+// emitter encodes a matched instruction to a buffer. Label references and holes are recorded by the assembler,
+// which is nil for dry runs (see InstMatcher.Layout). The matcher is not modified.
+type emitter struct {
+	buf    *buffer
+	match  *InstMatcher
+	layout *InstLayout
+	prefix byte
+	asm    *Assembler
+}
+
+func (em *emitter) reloc(labelId uint16, dispSize uint8) {
+	if em.asm != nil {
+		em.asm.reloc(labelId, dispSize)
+	}
+}
+
+func (em *emitter) relocDisp(labelId uint16, disp int32, width uint8) {
+	if em.asm != nil {
+		em.asm.relocDisp(labelId, disp, width)
+	}
+}
+
+func (em *emitter) hole(name string, width uint8, offset int, signed bool) {
+	if em.asm != nil {
+		em.asm.hole(name, width, offset, signed)
+	}
+}
+
+func (em *emitter) checkRex(rexW bool) (bool, error) {
+	argp := em.match.argp
 	plen := len(argp)
-	args := a.match.args
+	args := em.match.args
 	argc := len(args)
 	requiresRex := rexW
 	requiresNoRex := false
@@ -27,15 +53,15 @@ func (a *Assembler) checkRex(rexW bool) (bool, error) {
 		t, arg := argp[pi], args[ai]
 
 		if t >= 'a' && t <= 'z' {
-			switch v := arg.(type) {
-			case Reg:
-				if v.Family() == REG_HIGHBYTE {
+			switch arg.kind {
+			case opReg:
+				if v := arg.reg; v.Family() == REG_HIGHBYTE {
 					requiresNoRex = true
 				} else if v.IsExtended() || (v.width() == 1 && (v.Num() == SP.Num() || v.Num() == BP.Num() || v.Num() == SI.Num() || v.Num() == DI.Num())) {
 					requiresRex = true
 				}
-			case memArgPlaceholder:
-				mem := a.match.mem
+			case opMem:
+				mem := em.match.mem
 				if mem.Base != 0 {
 					requiresRex = requiresRex || mem.Base.IsExtended()
 				}
@@ -47,23 +73,23 @@ func (a *Assembler) checkRex(rexW bool) (bool, error) {
 	}
 
 	if requiresRex && requiresNoRex {
-		return requiresRex, fmt.Errorf("Unsupported high-byte register combined with extended registers or 64-bit argument-size")
+		return requiresRex, em.match.errorf(ReasonRex, -1, "Unsupported high-byte register combined with extended registers or 64-bit argument-size")
 	}
 
 	return requiresRex, nil
 }
 
-func (a *Assembler) emitRex(buf *buffer, r, rm Arg, rexW bool) {
+func (em *emitter) emitRex(buf *buffer, r, rm operand, rexW bool) {
 	regN, indexN, baseN := uint8(0), uint8(0), uint8(0)
 
-	if reg, ok := r.(Reg); ok {
-		regN = reg.Num()
+	if r.kind == opReg {
+		regN = r.reg.Num()
 	}
-	switch v := rm.(type) {
-	case Reg:
-		baseN = v.Num()
-	case memArgPlaceholder:
-		mem := a.match.mem
+	switch rm.kind {
+	case opReg:
+		baseN = rm.reg.Num()
+	case opMem:
+		mem := em.match.mem
 		if mem.Base != 0 {
 			baseN = mem.Base.Num()
 		}
@@ -83,19 +109,19 @@ func emitMSIB(buf *buffer, mode uint8, r, rm Reg) {
 	buf.Byte(byte(mode<<6) | byte((r.Num()&7)<<3) | byte(rm.Num()&7))
 }
 
-func (a *Assembler) emitVexXop(buf *buffer, e enc, mapSel, pref uint8, rexW, vexL bool) {
+func (em *emitter) emitVexXop(buf *buffer, e enc, mapSel, pref uint8, rexW, vexL bool) {
 	var reg, index, base, vvvv Reg
-	match := a.match
+	match := em.match
 
 	var b1, b2 uint8
-	if match.r != nil {
-		if r, ok := match.r.(Reg); ok {
-			reg = r
+	if match.r.kind != opNone {
+		if match.r.kind == opReg {
+			reg = match.r.reg
 		}
-		if r, ok := match.m.(Reg); ok {
-			base = r
-		} else if _, ok := match.m.(memArgPlaceholder); ok {
-			m := a.match.mem
+		if match.m.kind == opReg {
+			base = match.m.reg
+		} else if match.m.kind == opMem {
+			m := match.mem
 			if m.Base != 0 {
 				base = m.Base
 			}
@@ -106,10 +132,8 @@ func (a *Assembler) emitVexXop(buf *buffer, e enc, mapSel, pref uint8, rexW, vex
 		b1 = (mapSel & 0x1f) | ((^reg.Num())&8)<<4 | ((^index.Num())&8)<<3 | ((^base.Num())&8)<<2
 	}
 
-	if match.v != nil {
-		if r, ok := match.v.(Reg); ok {
-			vvvv = r
-		}
+	if match.v.kind == opReg {
+		vvvv = match.v.reg
 	}
 	rexWb := uint8(0)
 	if rexW {
@@ -1,35 +1,38 @@
 package x64
 
 import (
-	"fmt"
 	"math/bits"
 
 	. "github.com/wdamron/x64/internal/flags"
 )
 
-func (a *Assembler) emitInst() error {
-	buf := &a.b
-	match := &a.match
+func (em *emitter) emitInst() error {
+	buf := em.buf
+	match := em.match
 	addrSize, opSize := match.addrSize, match.opSize
 	inst := match.inst
 	enc := match.enc
 	flags := enc.flags
 	op := enc.op[:enc.oplen()]
+	layout := em.layout
+	layout.reset()
+	rm, imms, rels := match.m, match.imms, match.rels
+	start := buf.Len()
 
-	switch a.instPrefix {
+	switch em.prefix {
 	case lockPrefix:
 		if flags&LOCK == 0 {
-			return fmt.Errorf("LOCK prefix unsupported for %s", inst.Name())
+			return match.errorf(ReasonPrefix, -1, "LOCK prefix unsupported for %s", inst.Name())
 		}
 		buf.Byte(lockPrefix)
 	case repPrefix:
 		if flags&(REP|REPE) == 0 {
-			return fmt.Errorf("REP/REPE/REPZ prefix unsupported for %s", inst.Name())
+			return match.errorf(ReasonPrefix, -1, "REP/REPE/REPZ prefix unsupported for %s", inst.Name())
 		}
 		buf.Byte(repPrefix)
 	case repnePrefix:
 		if flags&REPE == 0 {
-			return fmt.Errorf("REPNE/REPNZ prefix unsupported for %s", inst.Name())
+			return match.errorf(ReasonPrefix, -1, "REPNE/REPNZ prefix unsupported for %s", inst.Name())
 		}
 		buf.Byte(repnePrefix)
 	default:
@@ -50,7 +53,7 @@ func (a *Assembler) emitInst() error {
 	// determine if size prefixes are necessary
 	if hasFlag(flags, AUTO_SIZE) || hasFlag(flags, AUTO_NO32) || hasFlag(flags, AUTO_REXW) || hasFlag(flags, AUTO_VEXL) {
 		if opSize < 0 {
-			return fmt.Errorf("Bad formatting data for %s (op size = %v); no wildcard sizes", inst.Name(), opSize)
+			return match.errorf(ReasonInternal, -1, "Bad formatting data for %s (op size = %v); no wildcard sizes", inst.Name(), opSize)
 		}
 
 		if hasFlag(flags, AUTO_NO32) {
@@ -60,28 +63,28 @@ func (a *Assembler) emitInst() error {
 			case 8:
 				// ok
 			default:
-				return fmt.Errorf("Unsupported operation size for 64-bit mode instruction %s: %v", inst.Name(), opSize)
+				return match.errorf(ReasonOperandSize, -1, "Unsupported operation size for 64-bit mode instruction %s: %v", inst.Name(), opSize)
 			}
 		} else if hasFlag(flags, AUTO_REXW) {
 			switch {
 			case opSize == 8:
 				rexW = true
 			case opSize != 4:
-				return fmt.Errorf("16-bit arguments are not supported for %s", inst.Name())
+				return match.errorf(ReasonOperandSize, -1, "16-bit arguments are not supported for %s", inst.Name())
 			}
 		} else if hasFlag(flags, AUTO_VEXL) {
 			switch {
 			case opSize == 32:
 				vexL = true
 			case opSize != 16:
-				return fmt.Errorf("Bad operation size for AUTO_VEXL instruction %s: %v", inst.Name(), opSize)
+				return match.errorf(ReasonOperandSize, -1, "Bad operation size for AUTO_VEXL instruction %s: %v", inst.Name(), opSize)
 			}
 		} else if opSize == 2 {
 			prefSize = true
 		} else if opSize == 8 {
 			rexW = true
 		} else if opSize != 4 {
-			return fmt.Errorf("Bad operation size for instruction %s: %v", inst.Name(), opSize)
+			return match.errorf(ReasonOperandSize, -1, "Bad operation size for instruction %s: %v", inst.Name(), opSize)
 		}
 	}
 
@@ -102,7 +105,7 @@ func (a *Assembler) emitInst() error {
 		hasPrefMod = true
 	}
 
-	needRex, err := a.checkRex(rexW)
+	needRex, err := em.checkRex(rexW)
 	if err != nil {
 		return err
 	}
@@ -135,7 +138,9 @@ func (a *Assembler) emitInst() error {
 		// map_sel is stored in the first byte of the opcode
 		mapSel := uint8(op[0])
 		op = op[1:]
-		a.emitVexXop(buf, enc, mapSel, pref, rexW, vexL)
+		layout.PrefixLen, layout.RexOffset = buf.Len()-start, buf.Len()-start
+		em.emitVexXop(buf, enc, mapSel, pref, rexW, vexL)
+		layout.RexLen = buf.Len() - start - layout.RexOffset
 	} else {
 		if hasPrefMod {
 			buf.Byte(prefMod)
@@ -143,44 +148,46 @@ func (a *Assembler) emitInst() error {
 		if prefSize {
 			buf.Byte(0x66)
 		}
+		layout.PrefixLen = buf.Len() - start
 		if needRex {
-			a.emitRex(buf, match.r, match.m, rexW)
+			layout.RexOffset, layout.RexLen = layout.PrefixLen, 1
+			em.emitRex(buf, match.r, rm, rexW)
 		}
 	}
 
+	layout.OpcodeOffset = buf.Len() - start
+
 	// if rm is embedded in the last opcode byte, push it here
 	if hasFlag(flags, SHORT_ARG) {
 		last := op[len(op)-1]
 		op = op[:len(op)-1]
 		buf.Bytes(op)
 
-		rm := match.m
-		match.m = nil
-		if rm == nil {
-			return fmt.Errorf("Bad formatting data for %s", inst.Name())
-		}
-		reg, ok := rm.(Reg)
-		if !ok {
-			return fmt.Errorf("Bad formatting data for %s", inst.Name())
+		if rm.kind != opReg {
+			return match.errorf(ReasonInternal, -1, "Bad formatting data for %s", inst.Name())
 		}
-		buf.Byte(last + byte(reg.Num())&7)
+		buf.Byte(last + byte(rm.reg.Num())&7)
+		rm = operand{}
 	} else {
 		buf.Bytes(op)
 	}
 
-	if match.m != nil {
+	layout.OpcodeLen = buf.Len() - start - layout.OpcodeOffset
+	modrmStart, dispStart := buf.Len(), -1
+
+	if rm.kind != opNone {
 		// Direct ModRM addressing
-		if r2, ok := match.m.(Reg); ok {
-			r1, ok := match.r.(Reg)
-			if !ok {
+		if rm.kind == opReg {
+			r1 := match.r.reg
+			if match.r.kind != opReg {
 				r1 = Reg(Reg(addrSize)<<16 | REG_LEGACY<<8 | Reg(enc.reg()))
 			}
-			emitMSIB(buf, modDirect, r1, r2)
+			emitMSIB(buf, modDirect, r1, rm.reg)
 			// Indirect ModRM (+SIB) addressing
 		} else if match.memOffset >= 0 {
-			m := match.mem
-			r, ok := match.r.(Reg)
-			if !ok {
+			m, disp := match.mem, match.disp
+			r := match.r.reg
+			if match.r.kind != opReg {
 				r = Reg(Reg(addrSize)<<16 | REG_LEGACY<<8 | Reg(enc.reg()))
 			}
 
@@ -194,7 +201,7 @@ func (a *Assembler) emitInst() error {
 				base := m.Base
 				mode := modDisp8
 				if base != 0 {
-					if m.Disp != nil && m.Disp.width() != 1 {
+					if disp.kind != opNone && disp.w != 1 {
 						mode = modDisp32
 					}
 				} else {
@@ -204,12 +211,13 @@ func (a *Assembler) emitInst() error {
 				// always need a SIB byte for VSIB addressing
 				emitMSIB(buf, mode, r, Reg(4))
 				emitMSIB(buf, uint8(bits.TrailingZeros8(m.Scale)), m.Index, base)
+				dispStart = buf.Len()
 
-				if m.Disp != nil {
+				if disp.kind != opNone {
 					if mode == modDisp8 {
-						buf.Int8(int8(m.Disp.Int32()))
+						buf.Int8(int8(int32(disp.value())))
 					} else {
-						buf.Int32(m.Disp.Int32())
+						buf.Int32(int32(disp.value()))
 					}
 				} else if mode == modDisp8 {
 					// no displacement was asked for, but we have to encode one as there's a base
@@ -223,9 +231,9 @@ func (a *Assembler) emitInst() error {
 				// this register is guaranteed to be present.
 				mode := modNoDisp
 				switch {
-				case m.Disp != nil && m.Disp.width() == 1:
+				case disp.kind != opNone && disp.w == 1:
 					mode = modDisp8
-				case m.Disp != nil:
+				case disp.kind != opNone:
 					mode = modDisp32
 				case modeRbpBase:
 					mode = modDisp8
@@ -233,26 +241,28 @@ func (a *Assembler) emitInst() error {
 
 				// only need a mod.r/m byte for 16-bit addressing
 				emitMSIB(buf, mode, r, m.Base)
+				dispStart = buf.Len()
 
-				if m.Disp != nil {
+				if disp.kind != opNone {
 					if mode == modDisp8 {
-						buf.Int8(int8(m.Disp.Int32()))
+						buf.Int8(int8(int32(disp.value())))
 					} else {
-						buf.Int16(int16(m.Disp.Int32()))
+						buf.Int16(int16(int32(disp.value())))
 					}
 				} else if mode == modDisp8 {
 					buf.Int8(0)
 				}
 			} else if modeRipRel {
 				emitMSIB(buf, modNoDisp, r, Reg(5))
-				if m.Disp != nil {
-					buf.Int32(int32(m.Disp.Int32()))
-					if ld, ok := m.Disp.(LabelDisp); ok {
+				dispStart = buf.Len()
+				if disp.kind != opNone {
+					buf.Int32(int32(disp.value()))
+					if disp.kind == opLabelDisp {
 						// the displacement will be patched with the relative label-offset + displacement during Finalize
-						a.relocDisp(ld)
-					} else if label, ok := m.Disp.(LabelArg); ok {
+						em.relocDisp(disp.label, int32(disp.val), 4)
+					} else if disp.isLabel() {
 						// the displacement will be patched with the relative label-offset during Finalize
-						a.reloc(label.label(), 4)
+						em.reloc(disp.label, 4)
 					}
 				} else {
 					buf.Int32(0)
@@ -262,13 +272,13 @@ func (a *Assembler) emitInst() error {
 				base := m.Base
 				mode := modDisp32
 				switch {
-				case modeRbpBase && m.Disp == nil:
+				case modeRbpBase && disp.kind == opNone:
 					// RBP can only be encoded as base if a displacement is present.
 					mode = modDisp8
-				case m.Disp == nil || base == 0:
+				case disp.kind == opNone || base == 0:
 					// mode_nodisp if no base is to be encoded. note that in these scenarions a 32-bit disp has to be emitted
 					mode = modNoDisp
-				case m.Disp != nil && m.Disp.width() == 1:
+				case disp.kind != opNone && disp.w == 1:
 					mode = modDisp8
 				}
 
@@ -285,22 +295,23 @@ func (a *Assembler) emitInst() error {
 					emitMSIB(buf, mode, r, RSP)
 					emitMSIB(buf, 0, RSP, RBP)
 				}
+				dispStart = buf.Len()
 
 				// displacement
-				if m.Disp != nil {
+				if disp.kind != opNone {
 					width := uint8(1)
 					if mode == modDisp8 {
-						buf.Int8(int8(m.Disp.Int32()))
+						buf.Int8(int8(int32(disp.value())))
 					} else {
-						buf.Int32(m.Disp.Int32())
+						buf.Int32(int32(disp.value()))
 						width = 4
 					}
-					if ld, ok := m.Disp.(LabelDisp); ok {
+					if disp.kind == opLabelDisp {
 						// the displacement will be patched with the relative label-offset + displacement during Finalize
-						a.relocDisp(ld)
-					} else if label, ok := m.Disp.(LabelArg); ok {
+						em.relocDisp(disp.label, int32(disp.val), width)
+					} else if disp.isLabel() {
 						// the displacement will be patched with the relative label-offset during Finalize
-						a.reloc(label.label(), width)
+						em.reloc(disp.label, width)
 					}
 				} else if base == 0 {
 					buf.Int32(0)
@@ -312,53 +323,77 @@ func (a *Assembler) emitInst() error {
 		}
 	}
 
+	if rm.kind != opNone {
+		modrmEnd := buf.Len()
+		if dispStart >= 0 {
+			modrmEnd = dispStart
+			if dispStart < buf.Len() {
+				layout.DispOffset, layout.DispLen = dispStart-start, buf.Len()-dispStart
+			}
+			if h := match.disp; h.kind == opHole {
+				if int(h.w) != buf.Len()-dispStart {
+					return match.errorf(ReasonOperandSize, match.memOffset, "Width of hole %q does not match the encoded displacement", h.name)
+				}
+				em.hole(h.name, h.w, dispStart, true)
+			}
+		}
+		layout.ModRMOffset = modrmStart - start
+		if modrmEnd-modrmStart > 1 {
+			layout.SIBOffset = layout.ModRMOffset + 1
+		}
+	}
+	immStart := buf.Len()
+
 	// opcode encoded after the displacement
 	if hasImmOp {
 		buf.Byte(immOp)
 	}
 
 	// register in immediate argument
-	if match.i != nil {
-		ireg := match.i.(Reg)
-		b := ireg.Num() << 4
+	if match.i.kind != opNone {
+		b := match.i.reg.Num() << 4
 
-		if len(match.imms) > 0 {
+		if len(imms) > 0 {
 			// if immediates are present, the register argument will be merged into the
 			// first immediate byte.
-			imm, ok := match.imms[0].(Imm8)
-			if !ok {
-				return fmt.Errorf("Bad formatting data for %s", inst.Name())
+			imm := imms[0]
+			if imm.kind != opImm || imm.w != 1 {
+				return match.errorf(ReasonInternal, -1, "Bad formatting data for %s", inst.Name())
 			}
-			match.imms = match.imms[1:]
-			b = b | (uint8(imm) & 0xf)
+			imms, rels = imms[1:], rels>>1
+			b = b | (uint8(imm.val) & 0xf)
 		}
 		buf.Byte(byte(b))
 	}
 
 	// immediates
-	for _, arg := range match.imms {
-		if imm, ok := arg.(ImmArg); ok {
-			switch imm.width() {
+	for i, arg := range imms {
+		switch arg.kind {
+		case opImm, opHole:
+			if arg.kind == opHole {
+				em.hole(arg.name, arg.w, buf.Len(), rels&(1<<i) != 0 || int8(arg.w) < int8(opSize))
+			}
+			switch arg.w {
 			case 1:
-				buf.Int8(int8(imm.Int64()))
+				buf.Int8(int8(arg.val))
 			case 2:
-				buf.Int16(int16(imm.Int64()))
+				buf.Int16(int16(arg.val))
 			case 4:
-				buf.Int32(int32(imm.Int64()))
+				buf.Int32(int32(arg.val))
 			case 8:
-				buf.Int64(imm.Int64())
+				buf.Int64(arg.val)
 			}
-		} else if rel, ok := arg.(RelArg); ok {
-			switch rel.width() {
+		case opRel:
+			switch arg.w {
 			case 1:
-				buf.Int8(int8(rel.Int32()))
+				buf.Int8(int8(arg.val))
 			case 2:
-				buf.Int16(int16(rel.Int32()))
+				buf.Int16(int16(arg.val))
 			case 4:
-				buf.Int32(int32(rel.Int32()))
+				buf.Int32(int32(arg.val))
 			}
-		} else if ld, ok := arg.(LabelDisp); ok {
-			width := ld.width()
+		case opLabelDisp, opLabel, opLabelRel:
+			width := arg.w
 			switch width {
 			case 1:
 				buf.Int8(0)
@@ -367,26 +402,22 @@ func (a *Assembler) emitInst() error {
 			case 4:
 				buf.Int32(0)
 			default:
-				return fmt.Errorf("Invalid label displacement (up to 32-bit displacements are supported): %v", width)
+				return match.errorf(ReasonLabel, -1, "Invalid label displacement (up to 32-bit displacements are supported): %v", width)
 			}
-			// the displacement will be patched with the relative label-offset + displacement during Finalize
-			a.relocDisp(ld)
-		} else if label, ok := arg.(LabelArg); ok {
-			width := label.width()
-			switch width {
-			case 1:
-				buf.Int8(0)
-			case 2:
-				buf.Int16(0)
-			case 4:
-				buf.Int32(0)
-			default:
-				return fmt.Errorf("Invalid label displacement (up to 32-bit displacements are supported): %v", width)
+			if arg.kind == opLabelDisp {
+				// the displacement will be patched with the relative label-offset + displacement during Finalize
+				em.relocDisp(arg.label, int32(arg.val), width)
+			} else {
+				// the displacement will be patched with the relative label-offset during Finalize
+				em.reloc(arg.label, width)
 			}
-			// the displacement will be patched with the relative label-offset during Finalize
-			a.reloc(label.label(), width)
 		}
 	}
 
+	if buf.Len() > immStart {
+		layout.ImmOffset, layout.ImmLen = immStart-start, buf.Len()-immStart
+	}
+	layout.Len = buf.Len() - start
+
 	return nil
 }
@@ -0,0 +1,371 @@
+package x64
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/wdamron/x64/feats"
+	flags "github.com/wdamron/x64/internal/flags"
+)
+
+// OperandKind identifies the type of an operand within an instruction-encoding.
+type OperandKind uint8
+
+// Operand kinds
+const (
+	OperandImm      OperandKind = iota + 1 // immediate
+	OperandRel                             // relative displacement / instruction offset
+	OperandMem                             // memory
+	OperandVSIB32                          // VSIB addressing with 32-bit indexes
+	OperandVSIB64                          // VSIB addressing with 64-bit indexes
+	OperandReg                             // legacy (general purpose) register
+	OperandRegMem                          // legacy register or memory
+	OperandFP                              // x87 floating point register
+	OperandMMX                             // MMX register
+	OperandMMXMem                          // MMX register or memory
+	OperandXMM                             // XMM or YMM register
+	OperandXMMMem                          // XMM or YMM register, or memory
+	OperandSegment                         // segment register
+	OperandControl                         // control register
+	OperandDebug                           // debug register
+	OperandBound                           // bound register
+	OperandFixedReg                        // a specific register (see Operand.FixedReg)
+)
+
+var operandKindNames = [...]string{
+	OperandImm:      "imm",
+	OperandRel:      "rel",
+	OperandMem:      "m",
+	OperandVSIB32:   "vm32",
+	OperandVSIB64:   "vm64",
+	OperandReg:      "r",
+	OperandRegMem:   "r/m",
+	OperandFP:       "st",
+	OperandMMX:      "mm",
+	OperandMMXMem:   "mm/m",
+	OperandXMM:      "xmm",
+	OperandXMMMem:   "xmm/m",
+	OperandSegment:  "sreg",
+	OperandControl:  "cr",
+	OperandDebug:    "dr",
+	OperandBound:    "bnd",
+	OperandFixedReg: "reg",
+}
+
+func (k OperandKind) String() string {
+	if int(k) < len(operandKindNames) && operandKindNames[k] != "" {
+		return operandKindNames[k]
+	}
+	return "?"
+}
+
+// Operand describes a single operand within an instruction-encoding.
+type Operand struct {
+	Kind OperandKind
+	// The size of the operand in bytes. Size will be 0 if the operand has no size (e.g. the memory
+	// operand for LEA) or if the operand is sized by the instruction's operand size (see Wildcard).
+	Size uint8
+	// The operand matches all possible sizes for its kind (see Sizes), and participates in the
+	// calculation of the instruction's operand size.
+	Wildcard bool
+	// The register matched by an operand of kind OperandFixedReg. If the operand is a wildcard,
+	// the register will have the widest matching size.
+	FixedReg Reg
+}
+
+// Get all sizes in bytes which are matched by the operand. An empty slice will be returned if the
+// operand has no size, or if a memory operand accepts all sizes.
+func (o Operand) Sizes() []uint8 {
+	if !o.Wildcard {
+		if o.Size == 0 {
+			return nil
+		}
+		return []uint8{o.Size}
+	}
+	switch o.Kind {
+	case OperandImm:
+		return []uint8{2, 4}
+	case OperandXMM, OperandXMMMem, OperandVSIB32, OperandVSIB64:
+		return []uint8{16, 32}
+	case OperandMem:
+		return nil
+	default:
+		return []uint8{2, 4, 8}
+	}
+}
+
+// Format the operand in a notation similar to the Intel manuals, e.g. "r/m64", "imm8", "xmm/m128".
+func (o Operand) String() string {
+	sizes := o.Sizes()
+	if o.Kind == OperandFixedReg {
+		if !o.Wildcard {
+			return o.FixedReg.String()
+		}
+		forms := make([]string, len(sizes))
+		for i, sz := range sizes {
+			forms[i] = resizeReg(o.FixedReg, sz).String()
+		}
+		return strings.Join(forms, "/")
+	}
+	if len(sizes) == 0 {
+		return o.Kind.String()
+	}
+	forms := make([]string, len(sizes))
+	for i, sz := range sizes {
+		forms[i] = formatOperand(o.Kind, sz)
+	}
+	return strings.Join(forms, "|")
+}
+
+func formatOperand(kind OperandKind, size uint8) string {
+	bits := strconv.Itoa(int(size) * 8)
+	switch kind {
+	case OperandImm, OperandRel, OperandMem, OperandReg, OperandRegMem:
+		return kind.String() + bits
+	case OperandMMXMem:
+		return "mm/m" + bits
+	case OperandXMM, OperandXMMMem, OperandVSIB32, OperandVSIB64:
+		reg := "xmm"
+		if size == 32 {
+			reg = "ymm"
+		}
+		switch kind {
+		case OperandXMMMem:
+			return reg + "/m" + bits
+		case OperandVSIB32:
+			return "vm32" + reg[:1]
+		case OperandVSIB64:
+			return "vm64" + reg[:1]
+		}
+		return reg
+	}
+	return kind.String()
+}
+
+// OperandSizing describes how an instruction-encoding derives prefixes from its operand size.
+type OperandSizing uint8
+
+const (
+	SizingFixed OperandSizing = iota // operand size does not affect the encoding
+	SizingAuto                       // 16-bit -> 66 prefix, 32-bit -> none, 64-bit -> REX.W/VEX.W/XOP.W
+	SizingNo32                       // 16-bit -> 66 prefix, 64-bit -> none (32-bit is not encodable)
+	SizingRexW                       // 32-bit -> none, 64-bit -> REX.W/VEX.W/XOP.W (16-bit is not encodable)
+	SizingVexL                       // 128-bit -> none, 256-bit -> VEX.L/XOP.L
+)
+
+// Encoding describes a supported encoding for an instruction.
+type Encoding struct {
+	Inst Inst
+	// The unique encoding ID (see InstMatcher.EncodingId)
+	Id       uint
+	Operands []Operand
+	// CPU features required by the encoding
+	Features feats.Feature
+	// Opcode bytes. For VEX/XOP encodings, the opcode-map selector is excluded (see Map).
+	Opcode []byte
+	// Opcode-map selector for VEX/XOP encodings (0 for legacy encodings)
+	Map uint8
+	// Opcode extension encoded in the reg field of the ModRM byte, or -1 if the field encodes an operand
+	Reg int8
+	// Mandatory prefixes (0x66, 0x67, 0xF0, 0xF2 or 0xF3). For VEX/XOP encodings, these are encoded
+	// within the VEX/XOP prefix.
+	Prefixes []byte
+	Sizing   OperandSizing
+	// The encoding requires a VEX prefix
+	VEX bool
+	// The encoding requires a XOP prefix
+	XOP bool
+	// REX.W/VEX.W/XOP.W is always set
+	RexW bool
+	// VEX.L/XOP.L is always set
+	VexL bool
+	// A register operand is encoded in the last byte of the opcode
+	OpcodeRegArg bool
+	// The final opcode byte is encoded in the immediate position, like 3DNow! ops
+	OpcodeInImmediate bool
+	// The LOCK prefix may be applied (see Assembler.Lock)
+	Lock bool
+	// The REP prefix may be applied (see Assembler.Rep)
+	Rep bool
+	// The REPE/REPNE prefixes may be applied (see Assembler.Repe and Assembler.Repne)
+	Repe bool
+}
+
+// Format the encoding in a notation similar to the Intel manuals, e.g. "ADD r/m16|r/m32|r/m64, imm8".
+func (e Encoding) String() string {
+	s := e.Inst.Name()
+	for i, o := range e.Operands {
+		if i == 0 {
+			s += " "
+		} else {
+			s += ", "
+		}
+		s += o.String()
+	}
+	return s
+}
+
+// Get all supported instruction mnemonics, ordered by their unique identifiers.
+func Insts() []Inst {
+	insts := make([]Inst, 0, len(instNameOffsets))
+	for i := 0; i < len(encs); {
+		id := encs[i].instid()
+		n := 1
+		for i+n < len(encs) && encs[i+n].instid() == id {
+			n++
+		}
+		insts = append(insts, Inst(uint32(id)<<21|uint32(n)<<16|uint32(i)))
+		i += n
+	}
+	return insts
+}
+
+// Get all supported encodings for the instruction, in the order they are considered while matching.
+func (inst Inst) Encodings() []Encoding {
+	if inst == 0 {
+		return nil
+	}
+	es := inst.encs()
+	encodings := make([]Encoding, len(es))
+	for i, e := range es {
+		encodings[i] = describeEncoding(inst, uint(inst.offset())+uint(i), e)
+	}
+	return encodings
+}
+
+// Get the description of the instruction's matched encoding.
+func (m *InstMatcher) Encoding() Encoding {
+	return describeEncoding(m.inst, uint(m.inst.offset())+uint(m.enc.offset()), m.enc)
+}
+
+func describeEncoding(inst Inst, id uint, e enc) Encoding {
+	f := e.flags
+	op := e.op[:e.oplen()]
+	d := Encoding{
+		Inst:              inst,
+		Id:                id,
+		Features:          e.features(),
+		Reg:               e.reg(),
+		VEX:               f&flags.VEX_OP != 0,
+		XOP:               f&flags.XOP_OP != 0,
+		RexW:              f&flags.WITH_REXW != 0,
+		VexL:              f&flags.WITH_VEXL != 0,
+		OpcodeRegArg:      f&flags.SHORT_ARG != 0,
+		OpcodeInImmediate: f&flags.IMM_OP != 0,
+		Lock:              f&flags.LOCK != 0,
+		Rep:               f&flags.REP != 0,
+		Repe:              f&flags.REPE != 0,
+	}
+	if (d.VEX || d.XOP) && len(op) > 0 {
+		d.Map, op = op[0], op[1:]
+	}
+	d.Opcode = append([]byte(nil), op...)
+
+	switch {
+	case f&flags.AUTO_SIZE != 0:
+		d.Sizing = SizingAuto
+	case f&flags.AUTO_NO32 != 0:
+		d.Sizing = SizingNo32
+	case f&flags.AUTO_REXW != 0:
+		d.Sizing = SizingRexW
+	case f&flags.AUTO_VEXL != 0:
+		d.Sizing = SizingVexL
+	}
+
+	if f&(flags.WORD_SIZE|flags.PREF_66) != 0 {
+		d.Prefixes = append(d.Prefixes, 0x66)
+	}
+	if f&flags.PREF_67 != 0 {
+		d.Prefixes = append(d.Prefixes, 0x67)
+	}
+	switch {
+	case f&flags.PREF_F0 != 0:
+		d.Prefixes = append(d.Prefixes, 0xf0)
+	case f&flags.PREF_F2 != 0:
+		d.Prefixes = append(d.Prefixes, 0xf2)
+	case f&flags.PREF_F3 != 0:
+		d.Prefixes = append(d.Prefixes, 0xf3)
+	}
+
+	p := e.format()
+	for i := 0; i+1 < len(p) && p[i] != 0; i += 2 {
+		d.Operands = append(d.Operands, describeOperand(p[i], p[i+1]))
+	}
+	return d
+}
+
+func describeOperand(t, sz byte) Operand {
+	var o Operand
+	switch t {
+	case 'i':
+		o.Kind = OperandImm
+	case 'o':
+		o.Kind = OperandRel
+	case 'm':
+		o.Kind = OperandMem
+	case 'k':
+		o.Kind = OperandVSIB32
+	case 'l':
+		o.Kind = OperandVSIB64
+	case 'r':
+		o.Kind = OperandReg
+	case 'v':
+		o.Kind = OperandRegMem
+	case 'f':
+		o.Kind = OperandFP
+	case 'x':
+		o.Kind = OperandMMX
+	case 'u':
+		o.Kind = OperandMMXMem
+	case 'y':
+		o.Kind = OperandXMM
+	case 'w':
+		o.Kind = OperandXMMMem
+	case 's':
+		o.Kind = OperandSegment
+	case 'c':
+		o.Kind = OperandControl
+	case 'd':
+		o.Kind = OperandDebug
+	case 'b':
+		o.Kind = OperandBound
+	case 'W':
+		o.Kind, o.FixedReg = OperandFixedReg, CR8
+	case 'X':
+		o.Kind, o.FixedReg = OperandFixedReg, F0
+	default:
+		switch {
+		case t >= 'A' && t <= 'P':
+			o.Kind, o.FixedReg = OperandFixedReg, Reg(8<<16|REG_LEGACY<<8|Reg(t-'A'))
+		case t >= 'Q' && t <= 'V':
+			o.Kind, o.FixedReg = OperandFixedReg, Reg(2<<16|REG_SEGMENT<<8|Reg(t-'Q'))
+		}
+	}
+
+	switch sz {
+	case 'b':
+		o.Size = 1
+	case 'w':
+		o.Size = 2
+	case 'd':
+		o.Size = 4
+	case 'q':
+		o.Size = 8
+	case 'p':
+		o.Size = 10
+	case 'f':
+		o.Size = 6
+	case 'o':
+		o.Size = 16
+	case 'h':
+		o.Size = 32
+	case '0':
+		o.Wildcard = true
+	}
+	if o.Kind == OperandFixedReg && o.FixedReg.Family() == REG_LEGACY && !o.Wildcard {
+		o.FixedReg = resizeReg(o.FixedReg, o.Size)
+	}
+	return o
+}
+
+func resizeReg(r Reg, size uint8) Reg { return r&^(0xff<<16) | Reg(size)<<16 }
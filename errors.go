@@ -0,0 +1,193 @@
+package x64
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ErrorReason identifies the cause of an EncodeError.
+type ErrorReason uint8
+
+const (
+	// No encoding matched the instruction's arguments (see EncodeError.Candidates). Errors with this
+	// reason match ErrNoMatch with errors.Is.
+	ReasonNoMatch ErrorReason = iota + 1
+	// A memory argument can not be encoded, e.g. RSP as index or multiple memory arguments
+	ReasonMemory
+	// The address size of a memory argument is not supported
+	ReasonAddrSize
+	// The operand size is unknown, conflicting, or not supported by the matched encoding
+	ReasonOperandSize
+	// An immediate or displacement can not be represented by the matched encoding, or there are too many sections
+	ReasonRange
+	// A LOCK/REP prefix is not supported by the matched encoding
+	ReasonPrefix
+	// A high-byte register is combined with an argument which requires a REX prefix
+	ReasonRex
+	// A label reference has an unsupported width, the label's offset exceeds its range, or labels are
+	// unbound or bound more than once (see LabelError)
+	ReasonLabel
+	// The CPU features required by a previously matched instruction are not enabled
+	ReasonFeatures
+	// The encoding data for the instruction is inconsistent
+	ReasonInternal
+	// A call-frame information (CFI) directive is invalid
+	ReasonCFI
+)
+
+var errorReasonNames = [...]string{
+	ReasonNoMatch:     "no match",
+	ReasonMemory:      "memory argument",
+	ReasonAddrSize:    "address size",
+	ReasonOperandSize: "operand size",
+	ReasonRange:       "range",
+	ReasonPrefix:      "prefix",
+	ReasonRex:         "REX prefix",
+	ReasonLabel:       "label",
+	ReasonFeatures:    "CPU features",
+	ReasonInternal:    "internal",
+	ReasonCFI:         "CFI",
+}
+
+func (r ErrorReason) String() string {
+	if int(r) < len(errorReasonNames) && errorReasonNames[r] != "" {
+		return errorReasonNames[r]
+	}
+	return "unknown"
+}
+
+// RejectReason identifies why a candidate encoding was rejected while matching an instruction.
+type RejectReason uint8
+
+const (
+	// The encoding requires CPU features which are not enabled
+	RejectFeatures RejectReason = iota + 1
+	// The encoding expects a different number of operands
+	RejectOperandCount
+	// An operand has the wrong kind, e.g. an immediate where a register is expected
+	RejectOperandKind
+	// An operand has the wrong size
+	RejectOperandSize
+	// An immediate can not be represented by the encoding
+	RejectRange
+)
+
+var rejectReasonNames = [...]string{
+	RejectFeatures:     "feature disabled",
+	RejectOperandCount: "operand count mismatch",
+	RejectOperandKind:  "wrong operand kind",
+	RejectOperandSize:  "size mismatch",
+	RejectRange:        "immediate out of range",
+}
+
+func (r RejectReason) String() string {
+	if int(r) < len(rejectReasonNames) && rejectReasonNames[r] != "" {
+		return rejectReasonNames[r]
+	}
+	return "unknown"
+}
+
+// Rejection describes a candidate encoding which was rejected while matching an instruction.
+type Rejection struct {
+	Inst Inst
+	// The unique encoding ID (see Encoding.Id)
+	Id uint
+	// The index of the mismatched operand, or -1 if the rejection does not apply to a single operand
+	Operand int
+	Reason  RejectReason
+}
+
+// Get the description of the rejected encoding.
+func (r Rejection) Encoding() Encoding { return describeEncoding(r.Inst, r.Id, encs[r.Id]) }
+
+func (r Rejection) String() string {
+	if r.Operand >= 0 {
+		return fmt.Sprintf("%v: operand %d: %v", r.Encoding(), r.Operand+1, r.Reason)
+	}
+	return fmt.Sprintf("%v: %v", r.Encoding(), r.Reason)
+}
+
+// EncodeError is returned when an instruction can not be matched or encoded, or when a label reference
+// can not be resolved.
+type EncodeError struct {
+	// The instruction, or 0 if the error does not apply to a single instruction (e.g. while finalizing)
+	Inst Inst
+	// The instruction's arguments
+	Args []Arg
+	// The offset of the instruction or label reference, or -1 if the error did not occur while encoding
+	// with an Assembler
+	PC int
+	// The index of the failing operand, or -1 if the error does not apply to a single operand
+	Operand int
+	Reason  ErrorReason
+	// Candidate encodings which were rejected, if no encoding matched the instruction's arguments
+	Candidates []Rejection
+	// The closest encodings, if no encoding matched the instruction's arguments and diagnostics are
+	// enabled (see InstMatcher.SetDiagnostics)
+	Diagnosis *Diagnosis
+	// The underlying error
+	Err error
+}
+
+func (e *EncodeError) Error() string {
+	var ctx []string
+	if e.Inst != 0 {
+		ctx = append(ctx, e.Inst.Name())
+	}
+	if e.Operand >= 0 {
+		ctx = append(ctx, fmt.Sprintf("operand %d", e.Operand+1))
+	}
+	if e.PC >= 0 {
+		ctx = append(ctx, fmt.Sprintf("pc %#x", e.PC))
+	}
+	msg := e.Err.Error()
+	if len(ctx) > 0 {
+		msg += " (" + strings.Join(ctx, ", ") + ")"
+	}
+	if e.Diagnosis != nil && (e.Diagnosis.FeaturesOnly || len(e.Diagnosis.Suggestions) > 0) {
+		msg += "; " + e.Diagnosis.String()
+	}
+	return msg
+}
+
+func (e *EncodeError) Unwrap() error { return e.Err }
+
+// rejection is a compact record of a rejected candidate encoding.
+type rejection struct {
+	id      uint16
+	operand int8
+	reason  RejectReason
+}
+
+func (m *InstMatcher) reject(id uint, reason RejectReason, operand int) {
+	if m.rejectc < len(m.rejects) {
+		m.rejects[m.rejectc] = rejection{id: uint16(id), operand: int8(operand), reason: reason}
+		m.rejectc++
+	}
+}
+
+// Create an error for the current instruction.
+func (m *InstMatcher) error(reason ErrorReason, operand int, err error) *EncodeError {
+	e := &EncodeError{Inst: m.inst, PC: -1, Operand: operand, Reason: reason, Err: err}
+	if len(m.args) > 0 {
+		e.Args = make([]Arg, len(m.args))
+		for i := range e.Args {
+			e.Args[i] = m.argAt(i)
+		}
+	}
+	if reason == ReasonNoMatch && m.rejectc > 0 {
+		e.Candidates = make([]Rejection, m.rejectc)
+		for i, r := range m.rejects[:m.rejectc] {
+			e.Candidates[i] = Rejection{Inst: m.inst, Id: uint(r.id), Operand: int(r.operand), Reason: r.reason}
+		}
+	}
+	if reason == ReasonNoMatch && m.diagnostics {
+		e.Diagnosis = m.diagnose()
+	}
+	return e
+}
+
+// Create an error for the current instruction, with a formatted message.
+func (m *InstMatcher) errorf(reason ErrorReason, operand int, format string, args ...interface{}) *EncodeError {
+	return m.error(reason, operand, fmt.Errorf(format, args...))
+}
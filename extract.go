@@ -22,9 +22,10 @@ func (matcher *InstMatcher) extractArgs() error {
 	flags := matcher.enc.flags
 	memArg := -1
 	regArg := -1
-	var regs [4]Arg
+	var regs [4]operand
 	regc := 0
 	immc := 0
+	matcher.rels = 0
 
 	// scan arg-pattern:
 	for pi, ai := 0, 0; pi+1 < plen && ai < argc; pi, ai = pi+2, ai+1 {
@@ -49,6 +50,9 @@ func (matcher *InstMatcher) extractArgs() error {
 			regs[regc] = arg
 			regc++
 		case 'i', 'o':
+			if t == 'o' {
+				matcher.rels |= 1 << immc
+			}
 			matcher._imms[immc] = arg
 			immc++
 		}
@@ -1,6 +1,8 @@
 package feats
 
-type Feature uint32
+import "fmt"
+
+type Feature uint64
 
 // CPU Features
 const (
@@ -31,12 +33,66 @@ const (
 	// Cyrix instructions are omitted
 	CYRIX
 	AMD
+	CX16
+	POPCNT
+	LZCNT
+	MOVBE
+	F16C
+	ADX
+	AES
+	PCLMULQDQ
+	RDRAND
+	RDSEED
+	RDPID
+	RDTSCP
+	XSAVE
 )
 
-const AllFeatures Feature = 0xffffffff
+const AllFeatures Feature = 0xffffffffffffffff
 
 func FeatName(f Feature) string { return featNames[f] }
 
+// Check if all features in required are present in the feature-set.
+func (f Feature) Has(required Feature) bool { return f&required == required }
+
+// Get the features which are present in both feature-sets.
+func (f Feature) Intersect(other Feature) Feature { return f & other }
+
+// Get the features which are present in either feature-set.
+func (f Feature) Union(other Feature) Feature { return f | other }
+
+// Get the features in required which are not present in the feature-set.
+func (f Feature) Missing(required Feature) Feature { return required &^ f }
+
+// Check if every feature in the feature-set is also present in other.
+func (f Feature) SubsetOf(other Feature) bool { return f&^other == 0 }
+
+// Get the names of all features in the feature-set, joined with "|".
+func (f Feature) String() string {
+	if f == X64_IMPLICIT {
+		return featNames[X64_IMPLICIT]
+	}
+	if f == AllFeatures {
+		return "AllFeatures"
+	}
+	s := ""
+	for i := uint(0); i < 64; i++ {
+		bit := Feature(1) << i
+		if f&bit == 0 {
+			continue
+		}
+		if s != "" {
+			s += "|"
+		}
+		if name, ok := featNames[bit]; ok {
+			s += name
+		} else {
+			s += fmt.Sprintf("%#x", uint64(bit))
+		}
+	}
+	return s
+}
+
 var featNames = map[Feature]string{
 	X64_IMPLICIT: "X64_IMPLICIT",
 	FPU:          "FPU",
@@ -64,4 +120,17 @@ var featNames = map[Feature]string{
 	PREFETCHWT1:  "PREFETCHWT1",
 	CYRIX:        "CYRIX",
 	AMD:          "AMD",
+	CX16:         "CX16",
+	POPCNT:       "POPCNT",
+	LZCNT:        "LZCNT",
+	MOVBE:        "MOVBE",
+	F16C:         "F16C",
+	ADX:          "ADX",
+	AES:          "AES",
+	PCLMULQDQ:    "PCLMULQDQ",
+	RDRAND:       "RDRAND",
+	RDSEED:       "RDSEED",
+	RDPID:        "RDPID",
+	RDTSCP:       "RDTSCP",
+	XSAVE:        "XSAVE",
 }
@@ -0,0 +1,58 @@
+package feats
+
+import (
+	"testing"
+)
+
+func TestLevels(t *testing.T) {
+	if !V2.Has(V1) || !V3.Has(V2) || !V4.Has(V3) {
+		t.Fatal("Expected each level to include the previous level")
+	}
+	if V2.Has(AVX) || !V3.Has(AVX2|FMA|BMI2) {
+		t.Fatalf("Unexpected AVX features for levels: v2 = %v, v3 = %v", V2, V3)
+	}
+	if Level(SSE2) != 1 || Level(POPCNT) != 2 || Level(AVX2|MOVBE) != 3 || Level(TBM) != 0 {
+		t.Fatal("Unexpected minimum level")
+	}
+	if m := V2.Missing(HASWELL); m != AVX|AVX2|BMI1|BMI2|F16C|FMA|LZCNT|MOVBE|RTM|INVPCID|AES|PCLMULQDQ|RDRAND|RDTSCP|XSAVE {
+		t.Fatalf("Unexpected missing features: %v", m)
+	}
+}
+
+func TestProfile(t *testing.T) {
+	for name, expect := range map[string]Feature{"v3": V3, "X86-64-V2": V2, "haswell": HASWELL, "znver2": ZNVER2} {
+		f, ok := Profile(name)
+		if !ok {
+			t.Fatalf("failed to find profile %s", name)
+		}
+		if f != expect {
+			t.Fatalf("Profile(%s) = %v", name, f)
+		}
+	}
+	if K8.Has(SSE3) || !K8SSE3.Has(SSE3) || ZNVER4.Has(AMD) {
+		t.Fatalf("Unexpected AMD profiles: k8 = %v, k8-sse3 = %v, znver4 = %v", K8, K8SSE3, ZNVER4)
+	}
+	if _, ok := Profile("pentium"); ok {
+		t.Fatal("Expected unknown profile")
+	}
+	if ZNVER1.Intersect(HASWELL) != V3|AES|PCLMULQDQ|RDRAND|RDTSCP|XSAVE {
+		t.Fatalf("Unexpected intersection: %v", ZNVER1.Intersect(HASWELL))
+	}
+	if Level(ADX|AES|PCLMULQDQ|RDRAND|RDSEED|RDPID|RDTSCP|XSAVE) != 0 {
+		t.Fatal("Expected features beyond any level")
+	}
+	if s := (SSE | AVX2 | XSAVE).String(); s != "SSE|AVX2|XSAVE" {
+		t.Fatalf("String() = %s", s)
+	}
+}
+
+func TestHost(t *testing.T) {
+	h := Host()
+	if h != X64_IMPLICIT && !h.Has(V1) {
+		t.Fatalf("Expected the host to support the x86-64 baseline, found %v", h)
+	}
+	if h.Has(AMD) && !h.Has(SSE5) {
+		t.Fatalf("Expected AMD extensions only with XOP, found %v", h)
+	}
+	t.Logf("host features: %v (level %d)", h, Level(h))
+}
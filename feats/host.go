@@ -0,0 +1,7 @@
+package feats
+
+// Get the CPU features supported by the host, detected with CPUID. AVX-based features are only reported if the
+// operating system saves YMM state (see XGETBV). On architectures other than amd64, X64_IMPLICIT is returned.
+func Host() Feature { return host }
+
+var host = detect()
@@ -0,0 +1,79 @@
+package feats
+
+func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+
+func xgetbv() (eax, edx uint32)
+
+func detect() Feature {
+	f := X64_IMPLICIT
+	maxLeaf, _, _, _ := cpuid(0, 0)
+	has := func(reg uint32, bit uint, feat Feature) {
+		if reg&(1<<bit) != 0 {
+			f |= feat
+		}
+	}
+
+	_, _, ecx1, edx1 := cpuid(1, 0)
+	has(edx1, 0, FPU)
+	has(edx1, 23, MMX)
+	has(edx1, 25, SSE)
+	has(edx1, 26, SSE2)
+	has(ecx1, 0, SSE3)
+	has(ecx1, 1, PCLMULQDQ)
+	has(ecx1, 5, VMX)
+	has(ecx1, 9, SSSE3)
+	has(ecx1, 13, CX16)
+	has(ecx1, 19, SSE41)
+	has(ecx1, 20, SSE42)
+	has(ecx1, 22, MOVBE)
+	has(ecx1, 23, POPCNT)
+	has(ecx1, 25, AES)
+	has(ecx1, 26, XSAVE)
+	has(ecx1, 30, RDRAND)
+
+	// AVX requires OS support for saving XMM and YMM state
+	osAVX := false
+	if ecx1&(1<<27) != 0 { // OSXSAVE
+		xcr0, _ := xgetbv()
+		osAVX = xcr0&6 == 6
+	}
+	if osAVX {
+		has(ecx1, 12, FMA)
+		has(ecx1, 28, AVX)
+		has(ecx1, 29, F16C)
+	}
+
+	if maxLeaf >= 7 {
+		_, ebx7, ecx7, _ := cpuid(7, 0)
+		has(ebx7, 3, BMI1)
+		has(ebx7, 8, BMI2)
+		has(ebx7, 10, INVPCID)
+		has(ebx7, 11, RTM)
+		has(ebx7, 14, MPX)
+		has(ebx7, 18, RDSEED)
+		has(ebx7, 19, ADX)
+		has(ebx7, 29, SHA)
+		has(ecx7, 0, PREFETCHWT1)
+		has(ecx7, 22, RDPID)
+		if osAVX {
+			has(ebx7, 5, AVX2)
+		}
+	}
+
+	if maxExt, _, _, _ := cpuid(0x80000000, 0); maxExt >= 0x80000001 {
+		_, _, ecxExt, edxExt := cpuid(0x80000001, 0)
+		has(ecxExt, 5, LZCNT)
+		has(ecxExt, 6, SSE4A)
+		has(edxExt, 27, RDTSCP)
+		has(edxExt, 31, TDNOW)
+		if osAVX {
+			has(ecxExt, 11, SSE5) // XOP
+			has(ecxExt, 21, TBM)
+			// AMD enables the Bulldozer-family extensions (XOP, FMA4 and LWP), which Zen does not support:
+			if xop, lwp, fma4 := ecxExt&(1<<11) != 0, ecxExt&(1<<15) != 0, ecxExt&(1<<16) != 0; xop && lwp && fma4 {
+				f |= AMD
+			}
+		}
+	}
+	return f
+}
@@ -0,0 +1,5 @@
+// +build !amd64
+
+package feats
+
+func detect() Feature { return X64_IMPLICIT }
@@ -0,0 +1,105 @@
+package feats
+
+import "strings"
+
+// x86-64 microarchitecture levels, as defined by the x86-64 psABI and selected through GOAMD64.
+//
+// Instructions which the encoder does not associate with any CPU feature (e.g. CMOVcc, CMPXCHG8B,
+// LAHF/SAHF) are always enabled. ADX, AES, PCLMULQDQ, RDRAND, RDSEED, RDPID, RDTSCP and XSAVE are
+// not guaranteed by any level, so they are only enabled by named CPU profiles.
+const (
+	// x86-64 baseline (GOAMD64=v1): CMOV, CX8, FPU, FXSR, MMX, SSE, SSE2
+	V1 Feature = FPU | MMX | SSE | SSE2
+	// x86-64-v2 (GOAMD64=v2): V1 + CMPXCHG16B, POPCNT, SSE3, SSSE3, SSE4.1, SSE4.2
+	V2 Feature = V1 | CX16 | POPCNT | SSE3 | SSSE3 | SSE41 | SSE42
+	// x86-64-v3 (GOAMD64=v3): V2 + AVX, AVX2, BMI1, BMI2, F16C, FMA, LZCNT, MOVBE
+	V3 Feature = V2 | AVX | AVX2 | BMI1 | BMI2 | F16C | FMA | LZCNT | MOVBE
+	// x86-64-v4 (GOAMD64=v4): V3 + AVX-512. AVX-512 instructions are not supported by the encoder,
+	// so V4 does not enable anything beyond V3.
+	V4 Feature = V3
+)
+
+// Named CPU profiles. Privileged virtualization extensions (VMX) are not included.
+//
+// The AMD feature enables LWP, XOP, FMA4, SVM, CLZERO and MONITORX/MWAITX, so it is only included
+// for Bulldozer-family profiles; Zen has no LWP or XOP.
+const (
+	CORE2       Feature = V1 | SSE3 | SSSE3 | CX16
+	NEHALEM     Feature = V2 | RDTSCP
+	WESTMERE    Feature = NEHALEM | AES | PCLMULQDQ
+	SANDYBRIDGE Feature = WESTMERE | AVX | XSAVE
+	IVYBRIDGE   Feature = SANDYBRIDGE | F16C | RDRAND
+	HASWELL     Feature = IVYBRIDGE | V3 | RTM | INVPCID
+	BROADWELL   Feature = HASWELL | ADX | RDSEED
+	SKYLAKE     Feature = BROADWELL | MPX
+	ICELAKE     Feature = V4 | INVPCID | SHA | AES | PCLMULQDQ | XSAVE | RDRAND | RDSEED | ADX | RDTSCP | RDPID
+
+	K8       Feature = V1 | TDNOW
+	K8SSE3   Feature = K8 | SSE3
+	AMDFAM10 Feature = K8SSE3 | SSE4A | CX16 | POPCNT | LZCNT | RDTSCP
+	BDVER1   Feature = V2 | AVX | SSE4A | SSE5 | LZCNT | AMD | AES | PCLMULQDQ | XSAVE | RDTSCP
+	BDVER2   Feature = BDVER1 | FMA | BMI1 | TBM | F16C
+	ZNVER1   Feature = V3 | SHA | SSE4A | AES | PCLMULQDQ | XSAVE | RDRAND | RDSEED | ADX | RDTSCP
+	ZNVER2   Feature = ZNVER1 | RDPID
+	ZNVER3   Feature = ZNVER2 | INVPCID
+	ZNVER4   Feature = ZNVER3 | V4
+)
+
+var profiles = map[string]Feature{
+	"x86-64":         V1,
+	"x86-64-v1":      V1,
+	"x86-64-v2":      V2,
+	"x86-64-v3":      V3,
+	"x86-64-v4":      V4,
+	"v1":             V1,
+	"v2":             V2,
+	"v3":             V3,
+	"v4":             V4,
+	"core2":          CORE2,
+	"nehalem":        NEHALEM,
+	"westmere":       WESTMERE,
+	"sandybridge":    SANDYBRIDGE,
+	"ivybridge":      IVYBRIDGE,
+	"haswell":        HASWELL,
+	"broadwell":      BROADWELL,
+	"skylake":        SKYLAKE,
+	"icelake":        ICELAKE,
+	"icelake-client": ICELAKE,
+	"icelake-server": ICELAKE,
+	"k8":             K8,
+	"k8-sse3":        K8SSE3,
+	"amdfam10":       AMDFAM10,
+	"barcelona":      AMDFAM10,
+	"bdver1":         BDVER1,
+	"bulldozer":      BDVER1,
+	"bdver2":         BDVER2,
+	"piledriver":     BDVER2,
+	"znver1":         ZNVER1,
+	"znver2":         ZNVER2,
+	"znver3":         ZNVER3,
+	"znver4":         ZNVER4,
+}
+
+// Lookup a microarchitecture level or named CPU profile. Names follow GOAMD64 ("v1" through "v4"),
+// the x86-64 psABI ("x86-64-v3") and GCC's -march values ("haswell", "znver2"). The name will be
+// converted to lowercase if necessary.
+func Profile(name string) (Feature, bool) {
+	f, ok := profiles[strings.ToLower(name)]
+	return f, ok
+}
+
+// Get the minimum x86-64 microarchitecture level (1 through 4) which provides all features in f.
+// If f requires features beyond any level, 0 will be returned.
+func Level(f Feature) int {
+	switch {
+	case f.SubsetOf(V1):
+		return 1
+	case f.SubsetOf(V2):
+		return 2
+	case f.SubsetOf(V3):
+		return 3
+	case f.SubsetOf(V4):
+		return 4
+	}
+	return 0
+}
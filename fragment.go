@@ -0,0 +1,266 @@
+package x64
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/wdamron/x64/feats"
+)
+
+// Fragment is a pre-encoded sequence of instructions and data, with its own labels and label references,
+// which may be appended to an Assembler any number of times (see Assembler.AppendFragment). Appending a
+// fragment copies its encoded bytes without matching instructions again.
+//
+// Labels which are bound within the fragment are renamed each time the fragment is appended, so every
+// copy has independent labels. Named labels which are referenced but not bound within the fragment are
+// imported: they will be resolved by name (see Assembler.NamedLabel) within the assembler the fragment
+// is appended to. Local numeric labels (see Assembler.SetLocalLabel) are never imported or exported.
+//
+// A fragment keeps the largest alignment requested while it was encoded (see Assembler.AlignPC, AlignFill and
+// PatchSite), and each copy is aligned accordingly. Fragments may not contain holes or CFI directives.
+type Fragment struct {
+	code   []byte
+	labels []fragmentLabel
+	locals uint16 // number of labels which are not imported
+	relocs []reloc
+	data   []dataRegion
+	feats  feats.Feature // features required by the fragment's instructions
+	align  uint32        // alignment required for the start of the fragment, or 0
+}
+
+type fragmentLabel struct {
+	pc       uint32
+	state    uint8
+	imported bool
+	exported bool
+	index    uint16 // index among the fragment's local labels, if the label is not imported
+	name     string
+}
+
+// FragmentRef identifies a copy of a fragment which has been appended to an assembler.
+type FragmentRef struct {
+	a    *Assembler
+	f    *Fragment
+	base uint16
+	// The PC at the start of the copy
+	PC uint32
+}
+
+// Get the length in bytes of the fragment's encoded output.
+func (f *Fragment) Len() int { return len(f.code) }
+
+// Get the fragment's encoded output. The returned slice must not be modified.
+func (f *Fragment) Code() []byte { return f.code }
+
+// Get the CPU features required by the fragment's instructions.
+func (f *Fragment) Features() feats.Feature { return f.feats }
+
+// Get the alignment (a power of 2) which is required for the start of the fragment, so offsets which were
+// aligned within the fragment remain aligned wherever it is placed.
+func (f *Fragment) Align() uint32 {
+	if f.align == 0 {
+		return 1
+	}
+	return f.align
+}
+
+// Get the offset of a named label which is bound within the fragment (e.g. an entry point).
+func (f *Fragment) Entry(name string) (uint32, bool) {
+	for _, l := range f.labels {
+		if l.name == name && !l.imported && l.state != labelUnbound {
+			return l.pc, true
+		}
+	}
+	return 0, false
+}
+
+// Import is a reference to a label which is imported by a fragment (see Fragment).
+type Import struct {
+	Name string
+	// The offset and width of the relative displacement which references the label
+	Offset uint32
+	Width  uint8
+	// The displacement should be patched with the label's address plus Addend, minus the address of the
+	// displacement (as with an ELF R_X86_64_PC32 relocation). Addend accounts for the distance between the
+	// displacement and the end of the instruction, including any trailing immediate.
+	Addend int32
+}
+
+// Get all references to labels which are imported by the fragment, ordered by offset.
+func (f *Fragment) Imports() []Import {
+	var imports []Import
+	for _, r := range f.relocs {
+		if l := f.labels[r.label]; l.imported {
+			imports = append(imports, Import{Name: l.name, Offset: r.loc, Width: r.width, Addend: r.disp - int32(r.width)})
+		}
+	}
+	sort.Slice(imports, func(i, j int) bool { return imports[i].Offset < imports[j].Offset })
+	return imports
+}
+
+// Get all regions of data within the fragment (see Assembler.DataRegions).
+func (f *Fragment) DataRegions() []DataRegion {
+	regions := make([]DataRegion, len(f.data))
+	for i, d := range f.data {
+		regions[i] = DataRegion{Section: DefaultSection, Start: d.start, End: d.end}
+	}
+	sort.Slice(regions, func(i, j int) bool { return regions[i].Start < regions[j].Start })
+	return regions
+}
+
+// Create a fragment from the instructions and data which have been encoded by the assembler. The
+// assembler must not have used sections other than the default section, and labels may not be bound
+// more than once. Label references will be resolved when the fragment is appended to an assembler, so
+// Finalize does not need to be called beforehand.
+func (a *Assembler) Fragment() (*Fragment, error) {
+	if a.err != nil {
+		return nil, a.err
+	}
+	if a.sections != nil {
+		return nil, &EncodeError{PC: -1, Operand: -1, Reason: ReasonLabel, Err: fmt.Errorf("Fragments may only contain the default section")}
+	}
+	return a.fragment(0)
+}
+
+// Create a fragment from the contents of the named section (see Fragment). Named labels which are bound
+// within other sections are imported, as if they were not bound, so each section may be written separately
+// (e.g. as a section of an object file). Unnamed and local numeric labels which are bound within other
+// sections may not be referenced.
+func (a *Assembler) SectionFragment(name string) (*Fragment, error) {
+	if a.err != nil {
+		return nil, a.err
+	}
+	i := 0
+	if a.sections != nil {
+		i = a.sectionIndex(name)
+	}
+	if i < 0 || (a.sections == nil && name != DefaultSection) {
+		return nil, &EncodeError{PC: -1, Operand: -1, Reason: ReasonLabel, Err: fmt.Errorf("Section %q does not exist", name)}
+	}
+	return a.fragment(uint8(i))
+}
+
+func (a *Assembler) fragment(sec uint8) (*Fragment, error) {
+	for _, h := range a.holes {
+		if h.section == sec {
+			return nil, &EncodeError{PC: int(h.offset), Operand: -1, Reason: ReasonLabel, Err: fmt.Errorf("Fragments may not contain holes (see Template)")}
+		}
+	}
+	for _, p := range a.cfi {
+		if p.section == sec {
+			return nil, &EncodeError{PC: int(p.start), Operand: -1, Reason: ReasonLabel, Err: fmt.Errorf("Fragments may not contain CFI directives")}
+		}
+	}
+	buf := a.sectionBuf(sec)
+	f := &Fragment{
+		code:   append([]byte(nil), buf.Get()...),
+		labels: make([]fragmentLabel, len(a.labels)),
+		feats:  a.usedFeats,
+		align:  buf.align,
+	}
+	for _, r := range a.relocs {
+		if r.section == sec {
+			f.relocs = append(f.relocs, r)
+		}
+	}
+	for _, d := range a.data {
+		if d.section == sec {
+			f.data = append(f.data, d)
+		}
+	}
+	for i, l := range a.labels {
+		if l.state != labelUnbound && l.section != sec {
+			// bound within another section
+			l.state = labelUnbound
+		}
+		if l.local || (l.state == labelUnbound && l.name == "") {
+			// local numeric labels are never imported or resolved by name
+			f.labels[i] = fragmentLabel{pc: l.pc, state: l.state, index: f.locals}
+			f.locals++
+			continue
+		}
+		if l.state == labelUnbound {
+			f.labels[i] = fragmentLabel{name: l.name, imported: true}
+			continue
+		}
+		f.labels[i] = fragmentLabel{pc: l.pc, state: l.state, exported: l.exported, index: f.locals, name: l.name}
+		f.locals++
+	}
+	var unbound []LabelRef
+	for _, r := range f.relocs {
+		if int(r.label) >= len(a.labels) {
+			unbound = append(unbound, LabelRef{Id: r.label, PC: r.loc})
+		} else if l := f.labels[r.label]; l.state == labelUnbound && !l.imported {
+			unbound = append(unbound, LabelRef{Id: r.label, Name: a.labels[r.label].name, PC: r.loc})
+		}
+	}
+	if len(unbound) > 0 || len(a.rebinds) > 0 {
+		return nil, &EncodeError{PC: -1, Operand: -1, Reason: ReasonLabel, Err: &LabelError{Unbound: unbound, BoundTwice: a.rebinds}}
+	}
+	return f, nil
+}
+
+// Append a copy of the fragment at the current PC. If the fragment requires alignment (see Fragment.Align),
+// the PC will first be aligned, padding with NOPs. Labels within the copy may be referenced through the
+// returned FragmentRef. If the fragment requires CPU features which are not enabled for the assembler, or the
+// label ids of the assembler would be exhausted, an error will be retained by the assembler and the fragment
+// will not be appended.
+func (a *Assembler) AppendFragment(f *Fragment) FragmentRef {
+	ref := FragmentRef{a: a, f: f, base: a.nextLabelId, PC: a.PC()}
+	if a.err != nil {
+		return ref
+	}
+	if missing := a.feats.Missing(f.feats); missing != 0 {
+		a.err = &EncodeError{PC: int(ref.PC), Operand: -1, Reason: ReasonFeatures, Err: fmt.Errorf("Fragment requires CPU features which are not enabled for the assembler: %v", missing)}
+		return ref
+	}
+	if !a.allocLabels(int(f.locals)) {
+		return ref
+	}
+	if f.align > 1 {
+		for pad := -a.PC() & (f.align - 1); pad > 0; {
+			n := uint8(255)
+			if pad < 255 {
+				n = uint8(pad)
+			}
+			a.b.Nop(n)
+			pad -= uint32(n)
+		}
+		a.b.requireAlign(f.align)
+	}
+	pc := a.PC()
+	ref.PC = pc
+	a.b.Bytes(f.code)
+	for _, l := range f.labels {
+		if !l.imported {
+			a.labels = append(a.labels, labelState{pc: pc + l.pc, state: l.state, section: a.cur})
+		}
+	}
+	a.nextLabelId += f.locals
+	a.usedFeats |= f.feats
+	for _, r := range f.relocs {
+		r.loc += pc
+		r.section = a.cur
+		r.label = ref.Label(Label{id: r.label}).id
+		a.relocs = append(a.relocs, r)
+	}
+	for _, d := range f.data {
+		a.addDataRegion(dataRegion{start: pc + d.start, end: pc + d.end, section: a.cur})
+	}
+	return ref
+}
+
+// Get the label within the appended copy of the fragment which corresponds to the given label from the
+// assembler used to create the fragment. If the label does not exist within the fragment, the returned
+// label will be invalid.
+func (r FragmentRef) Label(l Label) Label {
+	if int(l.id) >= len(r.f.labels) {
+		return Label{id: 0xffff}
+	}
+	fl := r.f.labels[l.id]
+	if fl.imported {
+		return r.a.NamedLabel(fl.name)
+	}
+	id := r.base + fl.index
+	return Label{pc: r.a.labels[id].pc, id: id}
+}
@@ -0,0 +1,273 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	. "github.com/wdamron/x64/feats"
+	. "github.com/wdamron/x64/internal/flags"
+)
+
+type mnemonic struct {
+	mne    string
+	specs  []spec
+	i      int
+	offset int
+}
+
+type spec struct {
+	pattern string
+	op      []byte
+	reg     int8
+	flags   uint32
+	feats   Feature
+	line    int
+}
+
+// any-reg placeholder
+const X int8 = -1
+
+// Limits imposed by the packed Inst and enc representations:
+const (
+	maxMnemonics = 1<<11 - 1 // [21..31] bits of Inst, excluding 0
+	maxSpecs     = 1<<5 - 1  // [16..20] bits of Inst
+	maxOffset    = 1<<12 - 1 // [0..11] bits of Inst
+	maxPatterns  = 1 << 8    // enc.argp
+	maxFeatSets  = 1 << 8    // enc.featset
+)
+
+const (
+	patternTypes = "iomklrfxyscdbvuwABCDEFGHIJKLMNOPQRSTUVWX"
+	patternSizes = "bwdqohpf*!?"
+)
+
+// Mutually exclusive groups of flags:
+var exclusiveFlags = []uint32{
+	AUTO_SIZE | AUTO_NO32 | AUTO_REXW | AUTO_VEXL,
+	VEX_OP | XOP_OP,
+	ENC_MR | ENC_VM,
+	PREF_F2 | PREF_F3,
+	WITH_VEXL | AUTO_VEXL,
+	WITH_REXW | AUTO_SIZE | AUTO_NO32 | AUTO_REXW,
+}
+
+// Read the instruction-encoding table (see instructions.txt for the format). Encodings which are not available
+// in long mode are dropped, and the remaining encodings are validated.
+func readTable(r io.Reader, file string) ([]mnemonic, error) {
+	flagValues, featValues := make(map[string]uint32), make(map[string]Feature)
+	flagValues[FlagName(DEFAULT)], featValues[FeatName(X64_IMPLICIT)] = DEFAULT, X64_IMPLICIT
+	for bit := uint(0); bit < 32; bit++ {
+		if name := FlagName(1 << bit); name != "" {
+			flagValues[name] = 1 << bit
+		}
+	}
+	for bit := uint(0); bit < 64; bit++ {
+		if name := FeatName(1 << bit); name != "" {
+			featValues[name] = 1 << bit
+		}
+	}
+
+	var ms []mnemonic
+	seen := make(map[string]bool)
+	s := bufio.NewScanner(r)
+	for num := 1; s.Scan(); num++ {
+		line := s.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		errorf := func(format string, args ...interface{}) error {
+			return fmt.Errorf("%s:%d: %s", file, num, fmt.Sprintf(format, args...))
+		}
+		sp, err := parseSpec(fields, flagValues, featValues)
+		if err != nil {
+			return nil, errorf("%v", err)
+		}
+		sp.line = num
+		if longMode(sp) {
+			if err := checkFlags(sp); err != nil {
+				return nil, errorf("%v", err)
+			}
+		}
+		mne := fields[0]
+		if len(ms) == 0 || ms[len(ms)-1].mne != mne {
+			if seen[mne] {
+				return nil, errorf("Encodings for %s are not listed on consecutive lines", mne)
+			}
+			seen[mne] = true
+			ms = append(ms, mnemonic{mne, nil, -1, -1})
+		}
+		m := &ms[len(ms)-1]
+		for _, prev := range m.specs {
+			if prev.pattern == sp.pattern && string(prev.op) == string(sp.op) && prev.reg == sp.reg && prev.flags == sp.flags {
+				return nil, errorf("Duplicate encoding for %s (see line %d)", mne, prev.line)
+			}
+		}
+		m.specs = append(m.specs, sp)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	x64ms := ms[:0]
+	for _, m := range ms {
+		x64specs := m.specs[:0]
+		for _, sp := range m.specs {
+			if longMode(sp) {
+				x64specs = append(x64specs, sp)
+			}
+		}
+		if len(x64specs) == 0 {
+			continue
+		}
+		m.specs = x64specs
+		x64ms = append(x64ms, m)
+	}
+	return x64ms, validateLimits(x64ms, file)
+}
+
+// Parse the fields of one line: mnemonic, pattern, opcode bytes, reg, flags and features.
+func parseSpec(fields []string, flagValues map[string]uint32, featValues map[string]Feature) (spec, error) {
+	sp := spec{reg: X}
+	if len(fields) < 6 {
+		return sp, fmt.Errorf("Expected at least 6 fields, found %d", len(fields))
+	}
+	mne := fields[0]
+	for _, c := range mne {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '_' {
+			return sp, fmt.Errorf("Invalid mnemonic %q", mne)
+		}
+	}
+
+	if fields[1] != "-" {
+		sp.pattern = fields[1]
+	}
+	if err := checkPattern(sp.pattern); err != nil {
+		return sp, err
+	}
+
+	rest := fields[2:]
+	for len(rest) > 3 && len(rest[0]) == 2 {
+		b, err := strconv.ParseUint(rest[0], 16, 8)
+		if err != nil {
+			return sp, fmt.Errorf("Invalid opcode byte %q", rest[0])
+		}
+		sp.op, rest = append(sp.op, byte(b)), rest[1:]
+	}
+	if len(sp.op) == 0 || len(sp.op) > 4 {
+		return sp, fmt.Errorf("Expected 1 to 4 opcode bytes, found %d", len(sp.op))
+	}
+	if len(rest) != 3 {
+		return sp, fmt.Errorf("Expected reg, flags and features after the opcode, found %q", strings.Join(rest, " "))
+	}
+
+	if rest[0] != "-" {
+		reg, err := strconv.ParseUint(rest[0], 10, 8)
+		if err != nil || reg > 7 {
+			return sp, fmt.Errorf("Invalid reg %q", rest[0])
+		}
+		sp.reg = int8(reg)
+	}
+	for _, name := range strings.Split(rest[1], "|") {
+		f, ok := flagValues[name]
+		if !ok {
+			return sp, fmt.Errorf("Unknown flag %q", name)
+		}
+		sp.flags |= f
+	}
+	for _, name := range strings.Split(rest[2], "|") {
+		f, ok := featValues[name]
+		if !ok {
+			return sp, fmt.Errorf("Unknown feature %q", name)
+		}
+		sp.feats |= f
+	}
+	return sp, nil
+}
+
+// Check if the encoding is available in long mode. x86-only and cyrix instructions are skipped.
+func longMode(sp spec) bool { return sp.flags&X86_ONLY == 0 && sp.feats&CYRIX == 0 }
+
+func checkPattern(p string) error {
+	if len(p)%2 != 0 || len(p) > 8 {
+		return fmt.Errorf("Invalid pattern %q: expected up to 4 type/size pairs", p)
+	}
+	for i := 0; i < len(p); i += 2 {
+		if strings.IndexByte(patternTypes, p[i]) < 0 {
+			return fmt.Errorf("Invalid pattern %q: unknown operand type %q", p, p[i])
+		}
+		if strings.IndexByte(patternSizes, p[i+1]) < 0 {
+			return fmt.Errorf("Invalid pattern %q: unknown operand size %q", p, p[i+1])
+		}
+	}
+	return nil
+}
+
+// Check for impossible combinations of flags and operand sizes.
+func checkFlags(sp spec) error {
+	for _, group := range exclusiveFlags {
+		if f := sp.flags & group; f&(f-1) != 0 {
+			return fmt.Errorf("Conflicting flags %s", flagNames(f))
+		}
+	}
+	if sp.flags&(WITH_VEXL|AUTO_VEXL) != 0 && sp.flags&(VEX_OP|XOP_OP) == 0 {
+		return fmt.Errorf("Flag %s requires VEX_OP or XOP_OP", flagNames(sp.flags&(WITH_VEXL|AUTO_VEXL)))
+	}
+	wildcards := 0
+	for i := 1; i < len(sp.pattern); i += 2 {
+		if sp.pattern[i] == '*' && sp.pattern[i-1] != 'i' {
+			wildcards++
+		}
+	}
+	if f := sp.flags & (AUTO_SIZE | AUTO_NO32 | AUTO_REXW | AUTO_VEXL); f != 0 && wildcards == 0 {
+		return fmt.Errorf("Flag %s requires an operand with a wildcard size", flagNames(f))
+	}
+	if strings.Contains(sp.pattern, "i*") && wildcards == 0 {
+		return fmt.Errorf("Immediates with a wildcard size require another operand with a wildcard size")
+	}
+	if sp.flags&SHORT_ARG != 0 && sp.reg != X {
+		return fmt.Errorf("Flag SHORT_ARG can not be combined with a reg")
+	}
+	return nil
+}
+
+func validateLimits(ms []mnemonic, file string) error {
+	patterns, featSets := make(map[string]bool), make(map[Feature]bool)
+	total := 0
+	for _, m := range ms {
+		if len(m.specs) > maxSpecs {
+			return fmt.Errorf("%s: %s has %d encodings (at most %d are supported)", file, m.mne, len(m.specs), maxSpecs)
+		}
+		for _, sp := range m.specs {
+			patterns[sp.pattern], featSets[sp.feats] = true, true
+		}
+		total += len(m.specs)
+	}
+	switch {
+	case total > maxOffset+1:
+		return fmt.Errorf("%s: %d encodings (at most %d are supported)", file, total, maxOffset+1)
+	case len(ms) > maxMnemonics:
+		return fmt.Errorf("%s: %d mnemonics (at most %d are supported)", file, len(ms), maxMnemonics)
+	case len(patterns) > maxPatterns:
+		return fmt.Errorf("%s: %d unique patterns (at most %d are supported)", file, len(patterns), maxPatterns)
+	case len(featSets) > maxFeatSets:
+		return fmt.Errorf("%s: %d unique feature-sets (at most %d are supported)", file, len(featSets), maxFeatSets)
+	}
+	return nil
+}
+
+func flagNames(flags uint32) string {
+	var names []string
+	for f := uint(0); f < 32; f++ {
+		if flags&(1<<f) != 0 {
+			names = append(names, FlagName(1<<f))
+		}
+	}
+	return strings.Join(names, "|")
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestReadTable(t *testing.T) {
+	f, err := os.Open("instructions.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	ms, err := readTable(f, "instructions.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := make(map[string]bool)
+	for _, m := range ms {
+		found[m.mne] = true
+		for _, sp := range m.specs {
+			if !longMode(sp) {
+				t.Fatalf("%s: encoding on line %d is not available in long mode", m.mne, sp.line)
+			}
+		}
+	}
+	for _, mne := range []string{"mov", "invpcid", "prefetchwt1", "vgatherdps"} {
+		if !found[mne] {
+			t.Fatalf("Missing mnemonic %s", mne)
+		}
+	}
+	if found["aaa"] {
+		t.Fatalf("Unexpected x86-only mnemonic aaa")
+	}
+}
+
+func TestReadTableErrors(t *testing.T) {
+	for _, c := range []struct{ table, err string }{
+		{"add r*v* 01 - AUTO_SIZE X64_IMPLICIT\nadd r*v* 01 - AUTO_SIZE X64_IMPLICIT", "t:2: Duplicate encoding for add (see line 1)"},
+		{"add r*v* 01 - AUTO_SIZE X64_IMPLICIT\nsub r*v* 29 - AUTO_SIZE X64_IMPLICIT\nadd v*r* 03 - AUTO_SIZE X64_IMPLICIT", "t:3: Encodings for add are not listed on consecutive lines"},
+		{"add r*v* 01 - AUTO_SIZE|AUTO_REXW X64_IMPLICIT", "t:1: Conflicting flags AUTO_SIZE|AUTO_REXW"},
+		{"vmovdqa y*w* 01 6F - AUTO_VEXL|PREF_66 AVX", "t:1: Flag AUTO_VEXL requires VEX_OP or XOP_OP"},
+		{"add rqvq 01 - AUTO_SIZE X64_IMPLICIT", "t:1: Flag AUTO_SIZE requires an operand with a wildcard size"},
+		{"push i* 68 - DEFAULT X64_IMPLICIT", "t:1: Immediates with a wildcard size require another operand with a wildcard size"},
+		{"inc r* 40 0 SHORT_ARG X64_IMPLICIT", "t:1: Flag SHORT_ARG can not be combined with a reg"},
+		{"add r*v* 01 - AUTO_SIZE AVX512", "t:1: Unknown feature \"AVX512\""},
+		{"add r*z* 01 - AUTO_SIZE X64_IMPLICIT", "t:1: Invalid pattern \"r*z*\": unknown operand type 'z'"},
+		{"add r*v* 01 02 03 04 05 - AUTO_SIZE X64_IMPLICIT", "t:1: Expected 1 to 4 opcode bytes, found 5"},
+		{"add r*v* 01 8 AUTO_SIZE X64_IMPLICIT", "t:1: Invalid reg \"8\""},
+	} {
+		_, err := readTable(strings.NewReader(c.table), "t")
+		if err == nil || err.Error() != c.err {
+			t.Fatalf("Expected error %q, found %v", c.err, err)
+		}
+	}
+
+	// x86-only encodings are skipped without validation:
+	ms, err := readTable(strings.NewReader("inc r* 40 0 SHORT_ARG|X86_ONLY X64_IMPLICIT\ninc v* FF 0 AUTO_SIZE|LOCK X64_IMPLICIT"), "t")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ms) != 1 || len(ms[0].specs) != 1 || ms[0].specs[0].op[0] != 0xFF {
+		t.Fatalf("Unexpected mnemonics %+v", ms)
+	}
+}
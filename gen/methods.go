@@ -0,0 +1,311 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/wdamron/x64/internal/flags"
+)
+
+// operand is a typed operand of a generated instruction method.
+type operand struct {
+	code  string // method-name suffix, e.g. R64 or M128
+	doc   string // Intel-syntax operand form, e.g. r64 or m128
+	param string // Go parameter type (e.g. Reg64, Mem64 or Target8), or "" for fixed registers
+	arg   string // argument expression for fixed registers
+	kind  byte   // 'r' for registers, 'm' for memory, 'i' for immediates, 'o' for displacements
+	width int    // width of memory operands, or 0 if unsized
+}
+
+// method is a generated instruction method for one operand form of an encoding.
+type method struct {
+	Name   string
+	Doc    string
+	Params string
+	Inst   string
+	Offset int
+	Args   string
+	Widths []string
+}
+
+var memOperands = map[byte]operand{
+	'b': {code: "M8", doc: "m8", width: 1},
+	'w': {code: "M16", doc: "m16", width: 2},
+	'd': {code: "M32", doc: "m32", width: 4},
+	'q': {code: "M64", doc: "m64", width: 8},
+	'o': {code: "M128", doc: "m128", width: 16},
+	'h': {code: "M256", doc: "m256", width: 32},
+	'f': {code: "M48", doc: "m48", width: 6},
+	'p': {code: "M80", doc: "m80", width: 10},
+	'!': {code: "M", doc: "m"},
+	'*': {code: "M", doc: "m"},
+}
+
+var legacyRegs = map[byte][16]string{
+	'b': {"AL", "CL", "DL", "BL", "SPB", "BPB", "SIB", "DIB", "R8B", "R9B", "R10B", "R11B", "R12B", "R13B", "R14B", "R15B"},
+	'w': {"AX", "CX", "DX", "BX", "SP", "BP", "SI", "DI", "R8W", "R9W", "R10W", "R11W", "R12W", "R13W", "R14W", "R15W"},
+	'd': {"EAX", "ECX", "EDX", "EBX", "ESP", "EBP", "ESI", "EDI", "R8L", "R9L", "R10L", "R11L", "R12L", "R13L", "R14L", "R15L"},
+	'q': {"RAX", "RCX", "RDX", "RBX", "RSP", "RBP", "RSI", "RDI", "R8", "R9", "R10", "R11", "R12", "R13", "R14", "R15"},
+}
+
+var gpBits = map[byte]string{'b': "8", 'w': "16", 'd': "32", 'q': "64"}
+
+// Get the alternative typed operands for an operand type and size. Wildcard sizes must be resolved by the caller,
+// except for memory operands. No alternatives are returned for unsupported operands.
+func typedOperands(t, sz byte) []operand {
+	fixed := func(name string) []operand {
+		return []operand{{code: name, doc: strings.ToLower(name), arg: name, kind: 'r'}}
+	}
+	reg := func(code, doc, param string) operand {
+		return operand{code: code, doc: doc, param: param, kind: 'r'}
+	}
+	mem := func(sz byte) operand {
+		m := memOperands[sz]
+		m.param, m.kind = "Mem", 'm'
+		if m.width != 0 {
+			m.param += strings.TrimPrefix(m.code, "M")
+		}
+		return m
+	}
+	switch t {
+	case 'r', 'v':
+		bits, ok := gpBits[sz]
+		if !ok {
+			return nil
+		}
+		ops := []operand{reg("R"+bits, "r"+bits, "Reg"+bits)}
+		if t == 'v' {
+			ops = append(ops, mem(sz))
+		}
+		return ops
+	case 'm':
+		if _, ok := memOperands[sz]; !ok {
+			return nil
+		}
+		return []operand{mem(sz)}
+	case 'x', 'u':
+		ops := []operand{reg("MM", "mm", "MMXReg")}
+		if t == 'u' {
+			ops = append(ops, mem(sz))
+		}
+		return ops
+	case 'y', 'w':
+		var ops []operand
+		switch sz {
+		case 'o':
+			ops = []operand{reg("X", "xmm", "XMMReg")}
+		case 'h':
+			ops = []operand{reg("Y", "ymm", "YMMReg")}
+		default:
+			return nil
+		}
+		if t == 'w' {
+			ops = append(ops, mem(sz))
+		}
+		return ops
+	case 'f':
+		return []operand{reg("ST", "st", "FPReg")}
+	case 's':
+		return []operand{reg("SREG", "sreg", "SegReg")}
+	case 'c':
+		return []operand{reg("CR", "cr", "ControlReg")}
+	case 'd':
+		return []operand{reg("DR", "dr", "DebugReg")}
+	case 'W':
+		return fixed("CR8")
+	case 'X':
+		return []operand{{code: "ST0", doc: "st0", arg: "F0", kind: 'r'}}
+	case 'i':
+		bits, ok := gpBits[sz]
+		if !ok {
+			return nil
+		}
+		return []operand{{code: "I" + bits, doc: "imm" + bits, param: "Imm" + bits, kind: 'i'}}
+	case 'o':
+		bits, ok := gpBits[sz]
+		if !ok || sz == 'q' {
+			return nil
+		}
+		return []operand{{code: "REL" + bits, doc: "rel" + bits, param: "Target" + bits, kind: 'o'}}
+	}
+	switch {
+	case t >= 'A' && t <= 'P':
+		if regs, ok := legacyRegs[sz]; ok {
+			return fixed(regs[t-'A'])
+		}
+	case t >= 'Q' && t <= 'V':
+		return fixed([...]string{"ES", "CS", "SS", "DS", "FS", "GS"}[t-'Q'])
+	}
+	return nil
+}
+
+// Get the sizes which a wildcard size may take within an encoding's pattern, or a single '*' if the pattern has no
+// wildcard sizes which must be resolved. Wildcard memory operands are sized by the operation (AUTO_*).
+func wildcardSizes(sp spec) []byte {
+	gp, vec := false, false
+	for i := 0; i+1 < len(sp.pattern); i += 2 {
+		if t := sp.pattern[i]; sp.pattern[i+1] == '*' {
+			switch {
+			case t == 'r' || t == 'v' || (t >= 'A' && t <= 'P'):
+				gp = true
+			case t == 'y' || t == 'w' || t == 'k' || t == 'l':
+				vec = true
+			case t == 'm' && sp.flags&(AUTO_SIZE|AUTO_NO32|AUTO_REXW) != 0:
+				gp = true
+			case t == 'm' && sp.flags&AUTO_VEXL != 0:
+				vec = true
+			}
+		}
+	}
+	switch {
+	case gp && vec:
+		return nil
+	case gp:
+		var sizes []byte
+		for _, sz := range []byte("wdq") {
+			if (sz == 'd' && sp.flags&AUTO_NO32 != 0) || (sz == 'w' && sp.flags&AUTO_REXW != 0) {
+				continue
+			}
+			sizes = append(sizes, sz)
+		}
+		return sizes
+	case vec:
+		return []byte("oh")
+	}
+	return []byte("*")
+}
+
+// Get the typed methods for all operand forms of the encodings for a mnemonic. If multiple encodings share an
+// operand form, the method uses the first encoding, which is also preferred by Assembler.Inst.
+func methodsFor(m mnemonic) []method {
+	var methods []method
+	seen := make(map[string]bool)
+	inst := strings.ToUpper(m.mne)
+	for offset, sp := range m.specs {
+		for _, ws := range wildcardSizes(sp) {
+			// alternatives for each operand:
+			var alts [][]operand
+			for i := 0; i+1 < len(sp.pattern); i += 2 {
+				t, sz := sp.pattern[i], sp.pattern[i+1]
+				if sz == '*' && ws != '*' {
+					sz = ws
+					if t == 'i' && sz == 'q' {
+						// immediates for 64-bit operations are limited to 32 bits, and will be sign-extended
+						sz = 'd'
+					}
+				}
+				ops := typedOperands(t, sz)
+				if len(ops) == 0 {
+					alts = nil
+					break
+				}
+				alts = append(alts, ops)
+			}
+			if len(alts) == 0 && len(sp.pattern) != 0 {
+				continue
+			}
+			for _, form := range product(alts) {
+				meth := newMethod(inst, offset, form)
+				if !seen[meth.Name] {
+					seen[meth.Name] = true
+					methods = append(methods, meth)
+				}
+			}
+		}
+	}
+	return methods
+}
+
+// Get all combinations of alternative operands.
+func product(alts [][]operand) [][]operand {
+	forms := [][]operand{nil}
+	for _, ops := range alts {
+		var next [][]operand
+		for _, form := range forms {
+			for _, op := range ops {
+				next = append(next, append(append([]operand(nil), form...), op))
+			}
+		}
+		forms = next
+	}
+	return forms
+}
+
+func newMethod(inst string, offset int, form []operand) method {
+	meth := method{Name: inst, Inst: inst, Offset: offset}
+	var docs, args []string
+	var names, types []string
+	positional := 0
+	for _, op := range form {
+		if op.param != "" && op.kind != 'i' && op.kind != 'o' {
+			positional++
+		}
+	}
+	imms, pos := 0, 0
+	for _, op := range form {
+		meth.Name += "_" + op.code
+		docs = append(docs, op.doc)
+		if op.param == "" {
+			args = append(args, op.arg)
+			continue
+		}
+		var name string
+		switch op.kind {
+		case 'i':
+			if imms++; imms == 1 {
+				name = "imm"
+			} else {
+				name = fmt.Sprintf("imm%d", imms)
+			}
+		case 'o':
+			name = "rel"
+		default:
+			name = paramName(pos, positional)
+			pos++
+		}
+		names, types = append(names, name), append(types, op.param)
+		switch op.kind {
+		case 'r':
+			args = append(args, "Reg("+name+")")
+		case 'm':
+			if op.width != 0 {
+				args = append(args, "Mem("+name+")")
+			} else {
+				args = append(args, name)
+			}
+			if op.width != 0 {
+				meth.Widths = append(meth.Widths, fmt.Sprintf("%s.Width = %d", name, op.width))
+			}
+		default:
+			args = append(args, name)
+		}
+	}
+	meth.Doc = strings.ToLower(inst)
+	if len(docs) > 0 {
+		meth.Doc += " " + strings.Join(docs, ", ")
+	}
+	// group consecutive parameters of the same type:
+	var params []string
+	for i, name := range names {
+		if i+1 < len(names) && types[i+1] == types[i] {
+			params = append(params, name)
+		} else {
+			params = append(params, name+" "+types[i])
+		}
+	}
+	meth.Params = strings.Join(params, ", ")
+	meth.Args = strings.Join(args, ", ")
+	return meth
+}
+
+func paramName(i, n int) string {
+	switch {
+	case n == 1:
+		return "op"
+	case i == 0:
+		return "dst"
+	case n == 2:
+		return "src"
+	}
+	return fmt.Sprintf("src%d", i)
+}
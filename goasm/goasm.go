@@ -0,0 +1,390 @@
+// Package goasm writes assembled code as Go (Plan 9) assembly, so that code which is generated with the x64
+// package can be shipped within a Go package (e.g. with go:generate).
+package goasm
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unsafe"
+
+	"github.com/wdamron/x64"
+	"github.com/wdamron/x64/feats"
+	"golang.org/x/arch/x86/x86asm"
+)
+
+// Func is a function with a TEXT symbol in the package.
+//
+// Instructions are written as raw BYTE, LONG and QUAD directives, with the disassembled instruction as a comment.
+// Instructions which reference imported labels (see x64.Fragment) are written as Go assembly instructions instead,
+// so the Go assembler and linker resolve the references: an imported label may name a Data symbol or another Func
+// within the file. Functions are rejected if an instruction which references an imported label is not disassembled
+// with a RIP-relative operand or branch target at the label (e.g. VEX-encoded instructions).
+//
+// Instructions written as raw directives are not rewritten by the Go assembler, so RET instructions will not release
+// a stack frame (or restore a frame pointer) set up by the Go assembler. Functions are NOFRAME by default, and Frame
+// should be 0 unless the function manages its own stack frame.
+//
+// Raw instructions keep the relative displacements which were encoded by the x64 package, so they are only correct
+// if the Go assembler encodes each symbolic instruction with its original length. Functions are rejected if a raw
+// relative branch or RIP-relative reference crosses a symbolic instruction. Packages with functions which reference
+// symbols must not be built with -dynlink or -shared (e.g. -buildmode=shared or plugin): references to global
+// symbols are then rewritten through the GOT, which changes the length of the instructions (and clobbers R15).
+type Func struct {
+	// The name of the function within the package
+	Name string
+	// The Go signature of the function, excluding the name (e.g. "(x, y []float32) float32")
+	Signature string
+	// The size of the local stack frame and the size of the arguments and results in bytes
+	Frame, Args int
+	// The flags for the TEXT symbol (NOSPLIT|NOFRAME if empty)
+	Flags string
+	// Mark the Go declaration with go:noescape
+	NoEscape bool
+	// The function's code, which starts at offset 0
+	Code *x64.Fragment
+}
+
+func (fn *Func) signature() string {
+	if fn.Signature == "" {
+		return "()"
+	}
+	return fn.Signature
+}
+
+// Data is a read-only data symbol with DATA and GLOBL directives.
+type Data struct {
+	// The name of the data symbol, which may be referenced through imported labels with the same name
+	Name string
+	// Local symbols are only visible within the .s file (i.e. "name<>(SB)")
+	Local bool
+	Data  []byte
+}
+
+// File is a Go assembly file and its Go declarations.
+type File struct {
+	// The name of the package, for the Go declarations
+	Package string
+	// A description of the generator for the "Code generated ... DO NOT EDIT." header, e.g. "by gen.go"
+	Generator string
+	Funcs     []Func
+	Data      []Data
+}
+
+// Instructions which reference symbols are disassembled as if the function and symbols were placed at these
+// addresses, so the x86asm package may name the symbols referenced by the instructions.
+const (
+	codeOrigin   = 0x1000
+	symbolOrigin = 0x10000000
+)
+
+// Get the symbol for a function or data symbol, e.g. "·name(SB)" or "name<>(SB)" without the "(SB)" suffix.
+func (f *File) symbol(name string) (string, error) {
+	for _, fn := range f.Funcs {
+		if fn.Name == name {
+			return "·" + name, nil
+		}
+	}
+	for _, d := range f.Data {
+		if d.Name == name {
+			if d.Local {
+				return name + "<>", nil
+			}
+			return "·" + name, nil
+		}
+	}
+	return "", fmt.Errorf("Undefined symbol %q", name)
+}
+
+func (f *File) header() string {
+	if f.Generator == "" {
+		return "// Code generated by x64/goasm. DO NOT EDIT.\n\n"
+	}
+	return "// Code generated " + f.Generator + ". DO NOT EDIT.\n\n"
+}
+
+// Write the Go assembly for all functions and data symbols.
+func (f *File) WriteAsm(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString(f.header())
+	bw.WriteString("// +build amd64\n\n#include \"textflag.h\"\n")
+	for _, d := range f.Data {
+		if err := f.writeData(bw, d); err != nil {
+			return err
+		}
+	}
+	for i := range f.Funcs {
+		if err := f.writeFunc(bw, &f.Funcs[i]); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// Write the Go declarations for all functions.
+func (f *File) WriteStubs(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString(f.header())
+	fmt.Fprintf(bw, "// +build amd64\n\npackage %s\n", f.Package)
+	for _, fn := range f.Funcs {
+		bw.WriteString("\n")
+		if fn.NoEscape {
+			bw.WriteString("//go:noescape\n")
+		}
+		fmt.Fprintf(bw, "func %s%s\n", fn.Name, fn.signature())
+	}
+	return bw.Flush()
+}
+
+func (f *File) writeData(w *bufio.Writer, d Data) error {
+	sym, err := f.symbol(d.Name)
+	if err != nil {
+		return err
+	}
+	w.WriteString("\n")
+	b := d.Data
+	for off := 0; off < len(b); {
+		n := 8
+		for n > len(b)-off {
+			n >>= 1
+		}
+		var v uint64
+		for i := n - 1; i >= 0; i-- {
+			v = v<<8 | uint64(b[off+i])
+		}
+		fmt.Fprintf(w, "DATA %s+%d(SB)/%d, $0x%0*x\n", sym, off, n, 2*n, v)
+		off += n
+	}
+	fmt.Fprintf(w, "GLOBL %s(SB), RODATA|NOPTR, $%d\n", sym, len(b))
+	return nil
+}
+
+// Functions are aligned to this boundary by the Go linker on amd64.
+const funcAlign = 32
+
+func (f *File) writeFunc(w *bufio.Writer, fn *Func) error {
+	frag := fn.Code
+	if frag.Align() > funcAlign {
+		return fmt.Errorf("%s requires %d-byte alignment, but Go functions are aligned to %d bytes", fn.Name, frag.Align(), funcAlign)
+	}
+	imports := frag.Imports()
+	addrs := make(map[string]uintptr)
+	code := make([]byte, frag.Len())
+	for _, imp := range imports {
+		if imp.Width != 4 {
+			return fmt.Errorf("Reference to %q at %#x in %s must have a 32-bit displacement", imp.Name, imp.Offset, fn.Name)
+		}
+		if _, err := f.symbol(imp.Name); err != nil {
+			return fmt.Errorf("%v in %s", err, fn.Name)
+		}
+		// resolve all imports to the same address; the symbol is identified by the import's offset:
+		addrs[imp.Name] = codeAddr(code) + symbolOrigin - codeOrigin
+	}
+	if err := frag.Load(code, addrs, feats.AllFeatures); err != nil {
+		return err
+	}
+
+	lines, err := funcLines(fn, code, imports)
+	if err != nil {
+		return err
+	}
+	if err := checkRelative(fn, lines); err != nil {
+		return err
+	}
+	for _, l := range lines {
+		if l.imp != nil {
+			if err := checkSymbolic(fn, l); err != nil {
+				return err
+			}
+		}
+	}
+
+	flags := fn.Flags
+	if flags == "" {
+		flags = "NOSPLIT|NOFRAME"
+	}
+	fmt.Fprintf(w, "\n// func %s%s\nTEXT ·%s(SB), %s, $%d-%d\n", fn.Name, fn.signature(), fn.Name, flags, fn.Frame, fn.Args)
+	for _, l := range lines {
+		switch {
+		case l.data:
+			writeBytes(w, code[l.pc:l.end], "data")
+		case !l.decoded:
+			writeBytes(w, code[l.pc:l.end], "")
+		case l.imp == nil:
+			// comments show branch targets as offsets within the function:
+			writeBytes(w, code[l.pc:l.end], x86asm.GoSyntax(l.inst, uint64(l.pc), nil))
+		default:
+			sym, _ := f.symbol(l.imp.Name)
+			target := symTarget(l)
+			lookup := func(addr uint64) (string, uint64) {
+				if addr != target {
+					return "", 0
+				}
+				return sym, symbolOrigin
+			}
+			fmt.Fprintf(w, "\t%s\n", x86asm.GoSyntax(l.inst, uint64(codeOrigin+l.pc), lookup))
+		}
+	}
+	return nil
+}
+
+// Get the address of the symbol (plus the import's addend) referenced by a symbolic instruction, as disassembled.
+func symTarget(l funcLine) uint64 {
+	return uint64(symbolOrigin + int64(l.imp.Addend) + int64(l.end) - int64(l.imp.Offset))
+}
+
+// Check that the instruction referencing an import was disassembled with a RIP-relative memory operand or a relative
+// branch target which addresses the imported symbol, so the symbolic instruction references the same address. The
+// x86asm package decodes some RIP-relative operands as absolute addresses (e.g. within VEX-encoded instructions).
+func checkSymbolic(fn *Func, l funcLine) error {
+	target := symTarget(l)
+	for _, arg := range l.inst.Args {
+		switch arg := arg.(type) {
+		case x86asm.Rel:
+			// the Go assembler only names branch targets at the start of a symbol:
+			if codeOrigin+uint64(l.end)+uint64(arg) == target && target == symbolOrigin {
+				return nil
+			}
+		case x86asm.Mem:
+			if arg.Base == x86asm.RIP && arg.Segment == 0 && arg.Index == 0 && codeOrigin+uint64(l.end)+uint64(arg.Disp) == target {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("Can not write instruction referencing %q at %#x in %s as Go assembly: %v", l.imp.Name, l.imp.Offset, fn.Name, l.inst)
+}
+
+// funcLine is an instruction, a data region, or a sequence of code which could not be disassembled.
+type funcLine struct {
+	pc, end int
+	inst    x86asm.Inst
+	decoded bool
+	data    bool
+	imp     *x64.Import // the import referenced by a symbolic instruction
+}
+
+// Split the function's code into instructions and data regions.
+func funcLines(fn *Func, code []byte, imports []x64.Import) ([]funcLine, error) {
+	var lines []funcLine
+	data := fn.Code.DataRegions()
+	for pc := 0; pc < len(code); {
+		if len(data) > 0 && uint32(pc) >= data[0].Start {
+			lines = append(lines, funcLine{pc: pc, end: int(data[0].End), data: true})
+			pc, data = int(data[0].End), data[1:]
+			continue
+		}
+		end := len(code)
+		if len(data) > 0 {
+			end = int(data[0].Start)
+		}
+		inst, err := x86asm.Decode(code[pc:end], 64)
+		if err != nil {
+			// the x86asm package does not support all instructions (e.g. EVEX-encoded instructions), so write all
+			// remaining code before the next data region without comments:
+			for _, imp := range imports {
+				if imp.Offset >= uint32(pc) && imp.Offset < uint32(end) {
+					return nil, fmt.Errorf("Can not disassemble instruction referencing %q at %#x in %s", imp.Name, imp.Offset, fn.Name)
+				}
+			}
+			lines = append(lines, funcLine{pc: pc, end: end})
+			pc = end
+			continue
+		}
+		l := funcLine{pc: pc, end: pc + inst.Len, inst: inst, decoded: true}
+		for i := range imports {
+			if imports[i].Offset >= uint32(pc) && imports[i].Offset < uint32(l.end) {
+				l.imp = &imports[i]
+			}
+		}
+		lines = append(lines, l)
+		pc = l.end
+	}
+	return lines, nil
+}
+
+// Check that no relative displacement within a raw instruction crosses a symbolic instruction, which the Go
+// assembler may encode with a different length.
+func checkRelative(fn *Func, lines []funcLine) error {
+	var symbolic []funcLine
+	for _, l := range lines {
+		if l.imp != nil {
+			symbolic = append(symbolic, l)
+		}
+	}
+	if len(symbolic) == 0 {
+		return nil
+	}
+	for _, l := range lines {
+		if l.data || l.imp != nil {
+			continue
+		}
+		if !l.decoded {
+			return fmt.Errorf("Can not disassemble instructions at %#x in %s, which may have relative references across instructions referencing symbols", l.pc, fn.Name)
+		}
+		target, ok := relTarget(l)
+		if !ok {
+			continue
+		}
+		lo, hi := l.end, target
+		if lo > hi {
+			lo, hi = hi, lo
+		}
+		for _, s := range symbolic {
+			if s.pc >= lo && s.pc < hi {
+				return fmt.Errorf("Relative reference at %#x in %s crosses the instruction referencing %q at %#x", l.pc, fn.Name, s.imp.Name, s.pc)
+			}
+		}
+	}
+	return nil
+}
+
+// Get the target of a relative branch or RIP-relative memory operand, as an offset within the function.
+func relTarget(l funcLine) (int, bool) {
+	for _, arg := range l.inst.Args {
+		switch arg := arg.(type) {
+		case x86asm.Rel:
+			return l.end + int(arg), true
+		case x86asm.Mem:
+			if arg.Base == x86asm.RIP {
+				return l.end + int(arg.Disp), true
+			}
+		}
+	}
+	return 0, false
+}
+
+// Write raw bytes as QUAD, LONG and BYTE directives, on a single line with an optional comment.
+func writeBytes(w *bufio.Writer, b []byte, comment string) {
+	for len(b) > 0 {
+		var line []string
+		for len(b) > 0 && len(line) < 4 {
+			switch {
+			case len(b) >= 8:
+				v := uint64(0)
+				for i := 7; i >= 0; i-- {
+					v = v<<8 | uint64(b[i])
+				}
+				line, b = append(line, fmt.Sprintf("QUAD $0x%016x", v)), b[8:]
+			case len(b) >= 4:
+				v := uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24
+				line, b = append(line, fmt.Sprintf("LONG $0x%08x", v)), b[4:]
+			default:
+				line, b = append(line, fmt.Sprintf("BYTE $0x%02x", b[0])), b[1:]
+			}
+		}
+		w.WriteString("\t" + strings.Join(line, "; "))
+		if comment != "" {
+			w.WriteString(" // " + comment)
+			comment = ""
+		}
+		w.WriteString("\n")
+	}
+}
+
+func codeAddr(code []byte) uintptr {
+	if len(code) == 0 {
+		return 0
+	}
+	return uintptr(unsafe.Pointer(&code[0]))
+}
@@ -0,0 +1,178 @@
+package goasm
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/wdamron/x64"
+)
+
+// Build the file as package main with a main function, run it, and get its output.
+func goRun(t *testing.T, f *File, main string) string {
+	if runtime.GOARCH != "amd64" {
+		t.Skip("generated assembly requires amd64")
+	}
+	goTool, err := exec.LookPath(filepath.Join(runtime.GOROOT(), "bin", "go"))
+	if err != nil {
+		t.Skip("go tool is not available")
+	}
+	pkg := *f
+	pkg.Package = "main"
+	var asm, stubs bytes.Buffer
+	if err := pkg.WriteAsm(&asm); err != nil {
+		t.Fatal(err)
+	}
+	if err := pkg.WriteStubs(&stubs); err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":          "module goasmtest\n",
+		"kernels_amd64.s": asm.String(),
+		"stubs_amd64.go":  stubs.String(),
+		"main.go":         main,
+	}
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cmd := exec.Command(goTool, "run", ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=", "GOPROXY=off", "GO111MODULE=on")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("Failed to build generated assembly: %v\n%s", err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func TestWriteAsm(t *testing.T) {
+	a := x64.NewAssembler(nil)
+	zero := a.DeclareLabel()
+	table := a.NamedLabel("table")
+	a.Inst(x64.MOV, x64.RAX, x64.Mem{Base: x64.RIP, Disp: table.Rel32(), Width: 8})
+	a.Inst(x64.ADD, x64.RAX, x64.Mem{Base: x64.RIP, Disp: table.Disp32(8), Width: 8})
+	a.Inst(x64.CMP, x64.Mem{Base: x64.RIP, Disp: table.Disp32(16), Width: 1}, x64.Imm8(3))
+	a.Inst(x64.TEST, x64.RAX, x64.RAX)
+	a.Inst(x64.JE, zero)
+	a.Inst(x64.MOV, x64.Mem{Base: x64.RSP, Disp: x64.Rel8(8), Width: 8}, x64.RAX)
+	a.Inst(x64.RET)
+	a.SetLabel(zero)
+	a.Inst(x64.JMP, a.NamedLabel("fallback"))
+	a.RawInt32s(7)
+	sum, err := a.Fragment()
+	if err != nil {
+		t.Fatal(err)
+	}
+	a = x64.NewAssembler(nil)
+	a.Inst(x64.XOR, x64.EAX, x64.EAX)
+	a.Inst(x64.RET)
+	fallback, err := a.Fragment()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f := &File{
+		Package:   "kernels",
+		Generator: "by gen.go",
+		Funcs: []Func{
+			{Name: "sum", Signature: "() int64", Args: 8, Code: sum},
+			{Name: "fallback", Code: fallback},
+		},
+		Data: []Data{{Name: "table", Local: true, Data: []byte{1, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 3}}},
+	}
+	var asm, stubs bytes.Buffer
+	if err := f.WriteAsm(&asm); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.WriteStubs(&stubs); err != nil {
+		t.Fatal(err)
+	}
+	expectAsm := `// Code generated by gen.go. DO NOT EDIT.
+
+// +build amd64
+
+#include "textflag.h"
+
+DATA table<>+0(SB)/8, $0x0000000000000001
+DATA table<>+8(SB)/8, $0x0000000000000002
+DATA table<>+16(SB)/1, $0x03
+GLOBL table<>(SB), RODATA|NOPTR, $17
+
+// func sum() int64
+TEXT ·sum(SB), NOSPLIT|NOFRAME, $0-8
+	MOVQ table<>(SB), AX
+	ADDQ table<>+8(SB), AX
+	CMPB table<>+16(SB), $0x3
+	BYTE $0x48; BYTE $0x85; BYTE $0xc0 // TESTQ AX, AX
+	LONG $0x0006840f; BYTE $0x00; BYTE $0x00 // JE 0x24
+	LONG $0x24448948; BYTE $0x08 // MOVQ AX, 0x8(SP)
+	BYTE $0xc3 // RET
+	JMP ·fallback(SB)
+	LONG $0x00000007 // data
+
+// func fallback()
+TEXT ·fallback(SB), NOSPLIT|NOFRAME, $0-0
+	BYTE $0x31; BYTE $0xc0 // XORL AX, AX
+	BYTE $0xc3 // RET
+`
+	if asm.String() != expectAsm {
+		t.Fatalf("Unexpected assembly:\n%s", asm.String())
+	}
+	expectStubs := `// Code generated by gen.go. DO NOT EDIT.
+
+// +build amd64
+
+package kernels
+
+func sum() int64
+
+func fallback()
+`
+	if stubs.String() != expectStubs {
+		t.Fatalf("Unexpected declarations:\n%s", stubs.String())
+	}
+	if out := goRun(t, f, "package main\n\nfunc main() { println(sum()) }\n"); out != "3" {
+		t.Fatalf("sum() = %s", out)
+	}
+
+	f.Data = nil
+	if err := f.WriteAsm(&asm); err == nil {
+		t.Fatal("Expected an error for an undefined symbol")
+	}
+
+	// raw branches may not cross symbolic instructions, which the Go assembler may encode with different lengths:
+	a = x64.NewAssembler(nil)
+	done := a.DeclareLabel()
+	a.Inst(x64.TEST, x64.RAX, x64.RAX)
+	a.Inst(x64.JNE, done)
+	a.Inst(x64.CALL, a.NamedLabel("fallback"))
+	a.SetLabel(done)
+	a.Inst(x64.RET)
+	if f.Funcs[0].Code, err = a.Fragment(); err != nil {
+		t.Fatal(err)
+	}
+	err = f.WriteAsm(&asm)
+	if err == nil || err.Error() != "Relative reference at 0x3 in sum crosses the instruction referencing \"fallback\" at 0x9" {
+		t.Fatalf("Expected an error for a branch across a symbolic instruction, found %v", err)
+	}
+
+	// the x86asm package decodes RIP-relative operands of VEX-encoded instructions as absolute addresses:
+	a = x64.NewAssembler(nil)
+	a.Inst(x64.VPADDD, x64.Y0, x64.Y1, x64.Mem{Base: x64.RIP, Disp: a.NamedLabel("table"), Width: 32})
+	a.Inst(x64.RET)
+	if f.Funcs[0].Code, err = a.Fragment(); err != nil {
+		t.Fatal(err)
+	}
+	f.Data = []Data{{Name: "table", Local: true, Data: make([]byte, 32)}}
+	err = f.WriteAsm(&asm)
+	if err == nil || !strings.HasPrefix(err.Error(), "Can not write instruction referencing \"table\" at 0x4 in sum as Go assembly") {
+		t.Fatalf("Expected an error for an instruction which can not be written symbolically, found %v", err)
+	}
+}
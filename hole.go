@@ -0,0 +1,165 @@
+package x64
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Hole is a named placeholder for an immediate or displacement, which will be encoded as zero and patched
+// when a Template is instantiated. A hole will only match encodings with an immediate or displacement of
+// exactly the hole's width.
+//
+// Hole implements ImmArg and DispArg.
+type Hole struct {
+	name string
+	w    uint8
+}
+
+var _ ImmArg = Hole{}
+var _ DispArg = Hole{}
+
+// Create an 8-bit hole.
+func Hole8(name string) Hole { return Hole{name: name, w: 1} }
+
+// Create a 16-bit hole.
+func Hole16(name string) Hole { return Hole{name: name, w: 2} }
+
+// Create a 32-bit hole.
+func Hole32(name string) Hole { return Hole{name: name, w: 4} }
+
+// Create a 64-bit hole.
+func Hole64(name string) Hole { return Hole{name: name, w: 8} }
+
+// Get the name of the hole.
+func (h Hole) Name() string { return h.name }
+
+func (h Hole) isArg()       {}
+func (h Hole) isImm()       {}
+func (h Hole) isDisp()      {}
+func (h Hole) width() uint8 { return h.w }
+
+// Get the placeholder value for the hole, which is always 0.
+func (h Hole) Int64() int64 { return 0 }
+
+// Get the placeholder value for the hole, which is always 0.
+func (h Hole) Int32() int32 { return 0 }
+
+// TemplateHole describes the location of a hole within a template.
+type TemplateHole struct {
+	Name string
+	// The index of the hole's value within the values passed to Template.Instantiate
+	Index int
+	// The offset and width of the hole in bytes
+	Offset uint32
+	Width  uint8
+	// The offset of the end of the instruction containing the hole. Holes used as branch targets or
+	// RIP-relative displacements are relative to the end of the instruction.
+	InstEnd uint32
+	// The hole's value is sign-extended by the instruction, e.g. for displacements, branch targets, and
+	// immediates which are narrower than the operation size.
+	SignExtended bool
+}
+
+type holeSite struct {
+	name    string
+	offset  uint32
+	end     uint32
+	width   uint8
+	signed  bool
+	section uint8
+}
+
+// Template is an encoded sequence of instructions with holes (see Hole), which may be copied and patched
+// without matching instructions again.
+type Template struct {
+	code  []byte
+	holes []TemplateHole
+	names []string
+}
+
+// Create a template from the instructions which have been encoded by the assembler. Finalize must be called
+// beforehand if the instructions include label references, and all labels must be bound within the template;
+// otherwise, an error will be returned (with a LabelError for unbound labels). The assembler must not have used
+// sections other than the default section.
+func (a *Assembler) Template() (*Template, error) {
+	if a.err != nil {
+		return nil, a.err
+	}
+	if a.sections != nil {
+		return nil, &EncodeError{PC: -1, Operand: -1, Reason: ReasonLabel, Err: fmt.Errorf("Templates may only contain the default section")}
+	}
+	if err := a.checkLabels(); err != nil {
+		return nil, err
+	}
+	if a.finalized != len(a.relocs) {
+		return nil, &EncodeError{PC: int(a.relocs[a.finalized].loc), Operand: -1, Reason: ReasonLabel, Err: fmt.Errorf("Label references must be resolved with Finalize before creating a template")}
+	}
+	t := &Template{code: append([]byte(nil), a.b.Get()...), holes: make([]TemplateHole, len(a.holes))}
+	for i, h := range a.holes {
+		index := -1
+		for j, name := range t.names {
+			if name == h.name {
+				index = j
+				break
+			}
+		}
+		if index < 0 {
+			index = len(t.names)
+			t.names = append(t.names, h.name)
+		}
+		t.holes[i] = TemplateHole{Name: h.name, Index: index, Offset: h.offset, Width: h.width, InstEnd: h.end, SignExtended: h.signed}
+	}
+	return t, nil
+}
+
+// Get the length in bytes of the template.
+func (t *Template) Len() int { return len(t.code) }
+
+// Get the names of the template's holes, in the order of their values for Instantiate. Holes which share
+// a name share a value.
+func (t *Template) Names() []string { return t.names }
+
+// Get the locations of all holes within the template.
+func (t *Template) Holes() []TemplateHole { return t.holes }
+
+// Copy the template to dst and patch each hole with its value. Values must be ordered by the names of the
+// template's holes (see Names), and dst must have a length of at least Len() bytes. An error will be returned
+// if a value can not be represented by the width of its hole, or by a sign-extended hole (see
+// TemplateHole.SignExtended).
+func (t *Template) Instantiate(dst []byte, values []int64) error {
+	if len(dst) < len(t.code) {
+		return fmt.Errorf("Destination for template is too small: %d < %d bytes", len(dst), len(t.code))
+	}
+	if len(values) != len(t.names) {
+		return fmt.Errorf("Template requires %d values, found %d", len(t.names), len(values))
+	}
+	copy(dst, t.code)
+	for i := range t.holes {
+		h := &t.holes[i]
+		v := values[h.Index]
+		opSize := int8(0)
+		if h.SignExtended {
+			opSize = 8
+		}
+		if !immFits(v, h.Width, opSize) {
+			return fmt.Errorf("Value %#x exceeds the range of a%s %d-bit hole %q", v, extension(h.SignExtended), h.Width*8, h.Name)
+		}
+		switch h.Width {
+		case 1:
+			dst[h.Offset] = byte(v)
+		case 2:
+			binary.LittleEndian.PutUint16(dst[h.Offset:], uint16(v))
+		case 4:
+			binary.LittleEndian.PutUint32(dst[h.Offset:], uint32(v))
+		case 8:
+			binary.LittleEndian.PutUint64(dst[h.Offset:], uint64(v))
+		}
+	}
+	return nil
+}
+
+// Record a hole at the given offset within the encoding buffer. Values for signed holes will be sign-extended by
+// the instruction.
+func (a *Assembler) hole(name string, width uint8, offset int, signed bool) {
+	a.holes = append(a.holes, holeSite{name: name, offset: uint32(offset), width: width, signed: signed, section: a.cur})
+}
@@ -1,7 +1,7 @@
 package x64
 
 import (
-	. "github.com/wdamron/x64/feats"
+	"github.com/wdamron/x64/feats"
 )
 
 // Get the format bytes for the given arg-pattern identifier:
@@ -44,7 +44,7 @@ func (inst Inst) encs() []enc {
 // * Format:
 //   * opcode: [4]byte
 //   * flags: uint32
-//   * feats: uint32
+//   * feature-set: byte (index into featSets)
 //   * mnemonic: uint16
 //     * [0..10] bits identify the unique mnemonic (reverse mapping to the mnemonic)
 //     * [11..15] bits identify the offset of this encoding w.r.t. the starting offset for the mnemonic within the encodings array
@@ -55,7 +55,7 @@ func (inst Inst) encs() []enc {
 type enc struct {
 	op       [4]byte
 	flags    uint32
-	feats    Feature
+	featset  uint8
 	mne      uint16
 	regoplen uint8
 	argp     uint8
@@ -69,7 +69,8 @@ func (e enc) reg() int8 {
 	return int8(r)
 }
 
-func (e enc) oplen() uint8    { return (e.regoplen >> 4) }
-func (e enc) instid() uint16  { return e.mne & 0x7ff }
-func (e enc) offset() uint8   { return uint8(e.mne >> 11) }
-func (e enc) format() [8]byte { return argpFormats[e.argp] }
+func (e enc) oplen() uint8            { return (e.regoplen >> 4) }
+func (e enc) features() feats.Feature { return featSets[e.featset] }
+func (e enc) instid() uint16          { return e.mne & 0x7ff }
+func (e enc) offset() uint8           { return uint8(e.mne >> 11) }
+func (e enc) format() [8]byte         { return argpFormats[e.argp] }
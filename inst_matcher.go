@@ -1,32 +1,33 @@
 package x64
 
 import (
-	"fmt"
-
 	"github.com/wdamron/x64/feats"
 	flags "github.com/wdamron/x64/internal/flags"
 )
 
-// Placeholder for memory arguments, to avoid allocations when converting Mem to an interface
-type memArgPlaceholder struct{}
-
-func (m memArgPlaceholder) isArg()       {}
-func (m memArgPlaceholder) width() uint8 { return 0 }
-
 // InstMatcher finds valid encodings for an instruction with arguments.
 type InstMatcher struct {
 	// enabled CPU features:
 	feats feats.Feature
+	// policy for selecting among matching encodings:
+	policy EncodingPolicy
+	// include a diagnosis in errors which match ErrNoMatch:
+	diagnostics bool
+	// memoized encodings (see memo.go), allocated on first use:
+	memo *matchMemo
+	// buffer for dry runs (see Layout):
+	scratch [32]byte
 
 	// scratch space for current instruction, arguments, and matched encoding:
 
 	addrSize int
 	opSize   int
 
-	memOffset int   // -1 if no memory argument is present
-	mem       Mem   // memory argument if memOffset >= 0
-	args      []Arg // sized reference to _args
-	_args     [4]Arg
+	memOffset int       // -1 if no memory argument is present
+	mem       Mem       // memory argument if memOffset >= 0, without its displacement
+	disp      operand   // displacement for the memory argument
+	args      []operand // sized reference to _args
+	_args     [4]operand
 
 	inst  Inst
 	encId uint   // offset of the matched encoding
@@ -35,13 +36,19 @@ type InstMatcher struct {
 
 	// extracted arguments:
 
-	r Arg
-	m Arg
-	v Arg
-	i Arg
+	r operand
+	m operand
+	v operand
+	i operand
+
+	imms  []operand
+	_imms [4]operand
+	rels  uint8 // bitmask of imms which are relative offsets
 
-	imms  []Arg
-	_imms [4]Arg
+	// candidate encodings which were rejected while matching:
+
+	rejects [32]rejection
+	rejectc int
 }
 
 // Create an instruction matcher with all CPU features enabled by default.
@@ -55,7 +62,7 @@ func NewInstMatcher() *InstMatcher {
 }
 
 func (m *InstMatcher) reset() {
-	*m = InstMatcher{feats: m.feats, addrSize: -1, opSize: -1, memOffset: -1}
+	*m = InstMatcher{feats: m.feats, policy: m.policy, diagnostics: m.diagnostics, memo: m.memo, addrSize: -1, opSize: -1, memOffset: -1}
 }
 
 // Get the current, allowable CPU feature-set for instruction-matching.
@@ -82,7 +89,7 @@ func (m *InstMatcher) EnableFeature(feature feats.Feature) { m.feats |= feature
 func (m *InstMatcher) EncodingId() uint { return m.encId }
 
 // Get CPU features required by the instruction.
-func (m *InstMatcher) InstFeatures() feats.Feature { return m.enc.feats }
+func (m *InstMatcher) InstFeatures() feats.Feature { return m.enc.features() }
 
 // Get the instruction's address size.
 func (m *InstMatcher) AddrSize() int { return m.addrSize }
@@ -122,17 +129,19 @@ func (m *InstMatcher) AllMatches(inst Inst, args ...Arg) ([]InstMatcher, error)
 		if err := m.prepare(inst, args...); err != nil {
 			return nil, err
 		}
-		if err := m.match(offset); err == nil {
+		if err := m.matchFrom(offset); err == nil {
 			matches = append(matches, *m)
 			offset = uint16(m.EncodingId()) + 1 - start
 			continue
 		}
 		offset++
 	}
-	m.reset()
 	if len(matches) == 0 {
-		return nil, ErrNoMatch
+		err := m.error(ReasonNoMatch, -1, ErrNoMatch)
+		m.reset()
+		return nil, err
 	}
+	m.reset()
 	return matches, nil
 }
 
@@ -142,56 +151,159 @@ func (m *InstMatcher) prepare(inst Inst, args ...Arg) error {
 	for i, arg := range args {
 		if mem, ok := arg.(Mem); ok {
 			if m.memOffset >= 0 {
+				err := m.errorf(ReasonMemory, i, "Multiple memory arguments are not supported")
 				m.reset()
-				return fmt.Errorf("Multiple memory arguments are not supported")
+				return err
 			}
-			m._args[i] = memArgPlaceholder{}
-			m.memOffset = i
-			m.mem = mem
+			m.setMem(i, mem)
 			continue
 		}
-		m._args[i] = arg
+		op, ok := toOperand(arg)
+		if !ok {
+			err := m.error(ReasonNoMatch, i, ErrNoMatch)
+			m.reset()
+			return err
+		}
+		m._args[i] = op
 	}
 	m.args = m._args[:len(args)]
 	return nil
 }
 
+// Set the memory argument at index i. The displacement is stored separately, so the argument is not retained.
+func (m *InstMatcher) setMem(i int, mem Mem) {
+	m.memOffset = i
+	m._args[i] = operand{kind: opMem}
+	m.mem = Mem{Base: mem.Base, Index: mem.Index, Scale: mem.Scale, Width: mem.Width}
+	m.disp, _ = toDispOperand(mem.Disp)
+}
+
 func (m *InstMatcher) match(encodingStartOffset uint16) error {
-	addrSize, err := m.sanitizeMemArg()
+	if m.policy == Shortest {
+		return m.matchShortest(encodingStartOffset)
+	}
+	return m.matchFrom(encodingStartOffset)
+}
+
+func (m *InstMatcher) matchFrom(encodingStartOffset uint16) error {
+	addrSize, err := m.memAddrSize()
 	if err != nil {
-		m.reset()
 		return err
 	}
-	if addrSize < 0 {
-		addrSize = 8
-	}
 
-	if addrSize != 4 && addrSize != 8 {
-		return fmt.Errorf("Impossible address size for %s: %v", m.inst.Name(), addrSize)
+	// find a matching encoding. if an immediate can not be represented by the matched encoding, continue
+	// searching for a wider encoding.
+	args, mem := m._args, m.mem
+	var opSize int8
+	var rangeErr *EncodeError
+	shape, memoizable := m.shape()
+	memoizable = memoizable && encodingStartOffset == 0 && !memoDisabled
+	first := -1
+	if memoizable {
+		if offset, ok := m.memoized(shape); ok {
+			m.selectEnc(offset)
+			if opSize, err = m.resizeArgs(); err == nil {
+				return m.matched(addrSize, opSize)
+			}
+			// search all encodings, to include rejected candidates in errors:
+			m._args, m.mem = args, mem
+		}
 	}
+	for {
+		if ok := m.matchInst(m.feats, encodingStartOffset); !ok {
+			if rangeErr == nil {
+				rangeErr = m.error(ReasonNoMatch, -1, ErrNoMatch)
+			} else if m.diagnostics {
+				rangeErr.Diagnosis = m.diagnose()
+			}
+			m.reset()
+			return rangeErr
+		}
+		if first < 0 {
+			first = int(m.enc.offset())
+		}
+		if opSize, err = m.resizeArgs(); err == nil {
+			break
+		}
+		e, ok := err.(*EncodeError)
+		if !ok || e.Reason != ReasonRange {
+			m.reset()
+			return err
+		}
+		m.reject(m.encId, RejectRange, e.Operand)
+		rangeErr = e
+		encodingStartOffset = uint16(m.enc.offset()) + 1
+		m._args, m.mem = args, mem
+	}
+	if memoizable {
+		m.memoize(shape, uint8(first))
+	}
+	return m.matched(addrSize, opSize)
+}
 
-	// find a matching encoding
-	if ok := m.matchInst(m.feats, encodingStartOffset); !ok {
+// Select the encoding at offset within the instruction's encodings, without checking the arguments. The
+// arg-pattern references the static table, so it does not escape to the heap.
+func (m *InstMatcher) selectEnc(offset uint8) {
+	m.encId = uint(m.inst.offset()) + uint(offset)
+	m.enc = encs[m.encId]
+	m.argp = argpFormats[m.enc.argp][:2*argpCounts[m.enc.argp]]
+}
+
+// Extract the arguments for the selected and resized encoding.
+func (m *InstMatcher) matched(addrSize, opSize int8) error {
+	if err := m.extractArgs(); err != nil {
 		m.reset()
-		return ErrNoMatch
+		return err
 	}
+	m.addrSize, m.opSize = int(addrSize), int(opSize)
+	return nil
+}
 
-	opSize, err := m.resizeArgs()
+// Match a single encoding at offset within the instruction's encodings, skipping the search through all of its
+// encodings. The arguments are still checked against the encoding's arg-pattern.
+func (m *InstMatcher) matchOne(offset uint8) error {
+	addrSize, err := m.memAddrSize()
 	if err != nil {
+		return err
+	}
+	encId := uint(m.inst.offset()) + uint(offset)
+	e := encs[encId]
+	if _, _, reason, operand := m.matchEnc(e, m.feats); reason != 0 {
+		m.reject(encId, reason, operand)
+		err := m.error(ReasonNoMatch, -1, ErrNoMatch)
 		m.reset()
 		return err
 	}
-
-	if err = m.extractArgs(); err != nil {
+	m.selectEnc(offset)
+	opSize, err := m.resizeArgs()
+	if err == nil {
+		err = m.extractArgs()
+	}
+	if err != nil {
 		m.reset()
 		return err
 	}
-
 	m.addrSize, m.opSize = int(addrSize), int(opSize)
-
 	return nil
 }
 
+// Get the address size for the memory argument, or 8 if no memory argument is present.
+func (m *InstMatcher) memAddrSize() (int8, error) {
+	addrSize, err := m.sanitizeMemArg()
+	if err != nil {
+		e := m.error(ReasonMemory, m.memOffset, err)
+		m.reset()
+		return -1, e
+	}
+	if addrSize < 0 {
+		addrSize = 8
+	}
+	if addrSize != 4 && addrSize != 8 {
+		return -1, m.errorf(ReasonAddrSize, m.memOffset, "Impossible address size for %s: %v", m.inst.Name(), addrSize)
+	}
+	return addrSize, nil
+}
+
 // Find an encoding for inst with a register destination and register source.
 // If no matching instruction-encoding is found, ErrNoMatch will be returned.
 func (m *InstMatcher) RR(inst Inst, dst, src Reg) error {
@@ -233,8 +345,9 @@ func (m *InstMatcher) MRI(inst Inst, dst Mem, src Reg, imm ImmArg) error {
 func (m *InstMatcher) RI(inst Inst, dst Reg, imm ImmArg) error {
 	m.reset()
 	m.inst = inst
-	m._args[0], m._args[1] = dst, imm
+	m._args[0] = operand{kind: opReg, reg: dst}
 	if imm != nil {
+		m._args[1], _ = toOperand(imm)
 		m.args = m._args[:2]
 	} else {
 		m.args = m._args[:1]
@@ -246,8 +359,10 @@ func (m *InstMatcher) RI(inst Inst, dst Reg, imm ImmArg) error {
 // If no matching instruction-encoding is found, ErrNoMatch will be returned.
 func (m *InstMatcher) MI(inst Inst, dst Mem, imm ImmArg) error {
 	m.reset()
-	m.inst, m.memOffset, m.mem, m._args[0], m._args[1] = inst, 0, dst, memArgPlaceholder{}, imm
+	m.inst = inst
+	m.setMem(0, dst)
 	if imm != nil {
+		m._args[1], _ = toOperand(imm)
 		m.args = m._args[:2]
 	} else {
 		m.args = m._args[:1]
@@ -257,8 +372,10 @@ func (m *InstMatcher) MI(inst Inst, dst Mem, imm ImmArg) error {
 
 func (m *InstMatcher) regRegImm(inst Inst, dst, src Reg, imm ImmArg) error {
 	m.reset()
-	m.inst, m._args[0], m._args[1], m._args[2] = inst, dst, src, imm
+	m.inst = inst
+	m._args[0], m._args[1] = operand{kind: opReg, reg: dst}, operand{kind: opReg, reg: src}
 	if imm != nil {
+		m._args[2], _ = toOperand(imm)
 		m.args = m._args[:3]
 	} else {
 		m.args = m._args[:2]
@@ -268,16 +385,16 @@ func (m *InstMatcher) regRegImm(inst Inst, dst, src Reg, imm ImmArg) error {
 
 func (m *InstMatcher) regMemImm(inst Inst, r Reg, mem Mem, imm ImmArg, swap bool) error {
 	m.reset()
-	m.inst, m.mem = inst, mem
+	m.inst = inst
 	if swap {
-		m.memOffset = 0
-		m._args[0], m._args[1] = memArgPlaceholder{}, r
+		m.setMem(0, mem)
+		m._args[1] = operand{kind: opReg, reg: r}
 	} else {
-		m.memOffset = 1
-		m._args[0], m._args[1] = r, memArgPlaceholder{}
+		m._args[0] = operand{kind: opReg, reg: r}
+		m.setMem(1, mem)
 	}
 	if imm != nil {
-		m._args[2] = imm
+		m._args[2], _ = toOperand(imm)
 		m.args = m._args[:3]
 	} else {
 		m.args = m._args[:2]
@@ -0,0 +1,270 @@
+package x64
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	labelUnbound  uint8 = iota // declared but not yet bound to a PC
+	labelImplicit              // bound to the PC at which the label was created (see NewLabel)
+	labelBound                 // bound with SetLabel or SetLabelPC
+)
+
+type labelState struct {
+	pc       uint32 // offset within the label's section
+	state    uint8
+	section  uint8
+	local    bool // local numeric label (see SetLocalLabel), which is never resolved by name
+	exported bool // named label which is defined as a symbol by the Linker (see Export)
+	name     string
+}
+
+// LabelRef identifies a label within a LabelError.
+type LabelRef struct {
+	Id   uint16
+	Name string
+	// The offset of the label reference (for unbound labels) or of the repeated binding (for labels bound twice)
+	PC uint32
+}
+
+func (r LabelRef) String() string {
+	if r.Name != "" {
+		return fmt.Sprintf("%q at pc %#x", r.Name, r.PC)
+	}
+	return fmt.Sprintf("label %d at pc %#x", r.Id, r.PC)
+}
+
+// LabelError is returned (wrapped within an EncodeError) by Finalize when label references can not be
+// resolved.
+type LabelError struct {
+	// References to labels which were declared but never bound
+	Unbound []LabelRef
+	// Labels which were bound more than once with SetLabel
+	BoundTwice []LabelRef
+}
+
+func (e *LabelError) Error() string {
+	var msgs []string
+	if len(e.Unbound) > 0 {
+		refs := make([]string, len(e.Unbound))
+		for i, r := range e.Unbound {
+			refs[i] = r.String()
+		}
+		msgs = append(msgs, "References to unbound labels: "+strings.Join(refs, ", "))
+	}
+	if len(e.BoundTwice) > 0 {
+		refs := make([]string, len(e.BoundTwice))
+		for i, r := range e.BoundTwice {
+			refs[i] = r.String()
+		}
+		msgs = append(msgs, "Labels bound more than once: "+strings.Join(refs, ", "))
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Declare a new label which is not bound to a PC. The label must be bound with SetLabel or SetLabelPC
+// before calling the Finalize method, though it may be referenced before it is bound.
+func (a *Assembler) DeclareLabel() Label { return a.newLabel(labelUnbound, "") }
+
+// Get the label with the given name, or declare a new unbound label with the name if none exists.
+func (a *Assembler) NamedLabel(name string) Label {
+	if l, ok := a.LookupLabel(name); ok {
+		return l
+	}
+	if !a.allocLabels(1) {
+		return Label{}
+	}
+	if a.labelNames == nil {
+		a.labelNames = make(map[string]uint16)
+	}
+	a.labelNames[name] = a.nextLabelId
+	return a.newLabel(labelUnbound, name)
+}
+
+// Get the label with the given name, if a label has been declared with the name (see NamedLabel).
+func (a *Assembler) LookupLabel(name string) (Label, bool) {
+	id, ok := a.labelNames[name]
+	if !ok {
+		return Label{}, false
+	}
+	return Label{pc: a.labels[id].pc, id: id}, true
+}
+
+// Check if the label has been bound to a PC (see SetLabel).
+func (a *Assembler) IsLabelBound(label LabelArg) bool {
+	id := label.label()
+	return int(id) < len(a.labels) && a.labels[id].state != labelUnbound
+}
+
+// Label ids are 16 bits.
+const maxLabels = 1 << 16
+
+// Check if n more labels may be declared. If the label ids would be exhausted, the assembler's error will be
+// set and false will be returned.
+func (a *Assembler) allocLabels(n int) bool {
+	if len(a.labels)+n <= maxLabels {
+		return true
+	}
+	if a.err == nil {
+		a.err = &EncodeError{PC: int(a.PC()), Operand: -1, Reason: ReasonLabel, Err: fmt.Errorf("Too many labels: at most %d are supported", maxLabels)}
+	}
+	return false
+}
+
+func (a *Assembler) newLabel(state uint8, name string) Label {
+	if !a.allocLabels(1) {
+		return Label{}
+	}
+	l := Label{pc: a.PC(), id: a.nextLabelId}
+	a.labels = append(a.labels, labelState{pc: l.pc, state: state, section: a.cur, name: name})
+	a.nextLabelId++
+	return l
+}
+
+// Export a named label (see NamedLabel), so the Linker will define a symbol with the label's name when the
+// label is bound within a function. Other named labels are internal to the function they are bound within.
+func (a *Assembler) Export(label LabelArg) {
+	l := a.labelState(label)
+	if l == nil {
+		return
+	}
+	if l.name == "" || l.local {
+		if a.err == nil {
+			a.err = &EncodeError{PC: -1, Operand: -1, Reason: ReasonLabel, Err: fmt.Errorf("Only named labels may be exported")}
+		}
+		return
+	}
+	l.exported = true
+}
+
+// Create a local numeric label. The name is only used to describe references to the label within errors.
+func (a *Assembler) newLocalLabel(state uint8, name string) Label {
+	if !a.allocLabels(1) {
+		return Label{}
+	}
+	l := a.newLabel(state, name)
+	a.labels[l.id].local = true
+	return l
+}
+
+// Get the state for the label, or set the assembler's error if the label does not exist.
+func (a *Assembler) labelState(label LabelArg) *labelState {
+	id := label.label()
+	if int(id) < len(a.labels) {
+		return &a.labels[id]
+	}
+	if a.err == nil {
+		a.err = &EncodeError{PC: -1, Operand: -1, Reason: ReasonLabel, Err: fmt.Errorf("Unknown label %d", id)}
+	}
+	return nil
+}
+
+// Check for references to unbound labels, and labels which were bound more than once.
+func (a *Assembler) checkLabels() error {
+	var unbound []LabelRef
+	for _, r := range a.relocs {
+		if int(r.label) >= len(a.labels) {
+			unbound = append(unbound, LabelRef{Id: r.label, PC: r.loc})
+		} else if l := a.labels[r.label]; l.state == labelUnbound {
+			unbound = append(unbound, LabelRef{Id: r.label, Name: l.name, PC: r.loc})
+		}
+	}
+	if len(unbound) == 0 && len(a.rebinds) == 0 {
+		return nil
+	}
+	return &EncodeError{PC: -1, Operand: -1, Reason: ReasonLabel, Err: &LabelError{Unbound: unbound, BoundTwice: a.rebinds}}
+}
+
+// localLabel tracks the most recent definition of a local numeric label, and the label for the next
+// definition if it has been referenced ahead of time.
+type localLabel struct {
+	prev, next       uint16
+	hasPrev, hasNext bool
+}
+
+// Define the local numeric label n at the current PC (i.e. "1:"). Local labels may be defined any number
+// of times; references resolve to the nearest previous definition (see PrevLocalLabel) or the nearest
+// following definition (see NextLocalLabel). Local labels have no names, so they are not visible to
+// LookupLabel, fragments or the Linker.
+func (a *Assembler) SetLocalLabel(n uint32) {
+	if a.localLabels == nil {
+		a.localLabels = make(map[uint32]localLabel)
+	}
+	ll := a.localLabels[n]
+	if ll.hasNext {
+		ll.prev = ll.next
+		a.labels[ll.prev].pc, a.labels[ll.prev].state, a.labels[ll.prev].section = a.PC(), labelBound, a.cur
+	} else {
+		ll.prev = a.newLocalLabel(labelBound, "").id
+	}
+	ll.hasPrev, ll.hasNext = true, false
+	a.localLabels[n] = ll
+}
+
+// Get the label for the nearest previous definition of the local numeric label n (i.e. "1b"). If n has not
+// been defined, the returned label will be unbound and Finalize will fail.
+func (a *Assembler) PrevLocalLabel(n uint32) Label {
+	ll, ok := a.localLabels[n]
+	if !ok || !ll.hasPrev {
+		return a.newLocalLabel(labelUnbound, strconv.Itoa(int(n))+"b")
+	}
+	return Label{pc: a.labels[ll.prev].pc, id: ll.prev}
+}
+
+// Get the label for the nearest following definition of the local numeric label n (i.e. "1f"). If n is not
+// defined again before Finalize is called, Finalize will fail.
+func (a *Assembler) NextLocalLabel(n uint32) Label {
+	if a.localLabels == nil {
+		a.localLabels = make(map[uint32]localLabel)
+	}
+	ll := a.localLabels[n]
+	if !ll.hasNext {
+		ll.next, ll.hasNext = a.newLocalLabel(labelUnbound, strconv.Itoa(int(n))+"f").id, true
+		a.localLabels[n] = ll
+	}
+	return Label{pc: a.labels[ll.next].pc, id: ll.next}
+}
+
+// Get the label for a label reference in assembler syntax: "1b" and "1f" refer to the previous and next
+// definitions of the local numeric label 1, and other names refer to named labels (see NamedLabel).
+func (a *Assembler) LabelRef(name string) Label {
+	if n, dir, ok := ParseLocalLabel(name); ok {
+		switch dir {
+		case 'b':
+			return a.PrevLocalLabel(n)
+		case 'f':
+			return a.NextLocalLabel(n)
+		}
+	}
+	return a.NamedLabel(name)
+}
+
+// Bind a label definition in assembler syntax (without the trailing colon) to the current PC: a number
+// defines a local numeric label (see SetLocalLabel), and other names bind named labels (see NamedLabel).
+func (a *Assembler) BindLabel(name string) {
+	if n, dir, ok := ParseLocalLabel(name); ok && dir == 0 {
+		a.SetLocalLabel(n)
+		return
+	}
+	a.SetLabel(a.NamedLabel(name))
+}
+
+// Parse a local numeric label definition ("1") or reference ("1b" or "1f"), as accepted by BindLabel and
+// LabelRef. dir is 0 for definitions, or 'b' or 'f' for references. Numbers may have up to 9 digits.
+func ParseLocalLabel(s string) (n uint32, dir byte, ok bool) {
+	if len(s) > 0 && (s[len(s)-1] == 'b' || s[len(s)-1] == 'f') {
+		dir, s = s[len(s)-1], s[:len(s)-1]
+	}
+	if len(s) == 0 || len(s) > 9 {
+		return 0, 0, false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return 0, 0, false
+		}
+		n = n*10 + uint32(s[i]-'0')
+	}
+	return n, dir, true
+}
@@ -0,0 +1,69 @@
+package x64
+
+// InstLayout describes the byte layout of an encoded instruction. Offsets are relative to the first byte
+// of the instruction; offsets for components which are not present in the encoding will be -1.
+type InstLayout struct {
+	// Total length of the instruction in bytes
+	Len int
+	// Length of legacy prefixes (LOCK/REP, address/operand-size overrides and mandatory prefixes),
+	// which always begin at offset 0
+	PrefixLen int
+	// Offset and length of the REX, VEX or XOP prefix
+	RexOffset, RexLen int
+	// Offset and length of the opcode. For VEX/XOP encodings, the opcode-map selector is encoded within
+	// the VEX/XOP prefix.
+	OpcodeOffset, OpcodeLen int
+	// Offset of the ModRM byte
+	ModRMOffset int
+	// Offset of the SIB byte
+	SIBOffset int
+	// Offset and length of the memory displacement
+	DispOffset, DispLen int
+	// Offset and length of immediates and relative offsets. For encodings which place the final opcode
+	// byte in the immediate position (see InstMatcher.HasOpcodeInImmediate), the opcode byte is included.
+	ImmOffset, ImmLen int
+}
+
+func (l *InstLayout) reset() {
+	*l = InstLayout{RexOffset: -1, OpcodeOffset: -1, ModRMOffset: -1, SIBOffset: -1, DispOffset: -1, ImmOffset: -1}
+}
+
+// Encode inst with args to a scratch buffer, returning the layout of the encoded instruction. The
+// assembler's encoding buffer, labels and label references will not be modified, and errors will not
+// be retained by the assembler (see Assembler.Err). Label references are encoded as zero displacements.
+func (a *Assembler) DryRun(inst Inst, args ...Arg) (InstLayout, error) {
+	if a.err != nil {
+		return InstLayout{}, a.err
+	}
+	if err := a.match.Match(inst, args...); err != nil {
+		return InstLayout{}, err
+	}
+	return a.dryRun()
+}
+
+func (a *Assembler) dryRun() (InstLayout, error) {
+	buf := buffer{b: a.scratch[:], sz: len(a.scratch)}
+	em := emitter{buf: &buf, match: &a.match, layout: &a.layout, prefix: a.instPrefix}
+	if err := em.emitInst(); err != nil {
+		return InstLayout{}, err
+	}
+	return a.layout, nil
+}
+
+// Get the layout of the matched instruction when encoded. The instruction will be encoded to a scratch
+// buffer, and label references will be encoded as zero displacements.
+func (m *InstMatcher) Layout() (InstLayout, error) {
+	var layout InstLayout
+	buf := buffer{b: m.scratch[:], sz: len(m.scratch)}
+	em := emitter{buf: &buf, match: m, layout: &layout}
+	if err := em.emitInst(); err != nil {
+		return InstLayout{}, err
+	}
+	return layout, nil
+}
+
+// Get the length in bytes of the matched instruction when encoded.
+func (m *InstMatcher) EncodedLen() (int, error) {
+	l, err := m.Layout()
+	return l.Len, err
+}
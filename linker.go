@@ -0,0 +1,167 @@
+package x64
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// Linker lays out multiple functions (or blocks of data) in a single code region, and resolves references
+// between them.
+//
+// Each function is added from an Assembler or Fragment. A function's name refers to its first byte, and
+// named labels which are bound within a function and marked with Assembler.Export are exported with their
+// names; other labels are internal to their function, so functions may share internal label names. Named
+// labels which are referenced but not bound within a function are resolved by name against the names of all
+// functions and exported labels, so a function may CALL or JMP to another function, or reference
+// data within another function with RIP-relative addressing.
+type Linker struct {
+	units []linkUnit
+}
+
+type linkUnit struct {
+	name string
+	f    *Fragment
+}
+
+// Image is the output of a Linker.
+type Image struct {
+	// The linked code for all functions
+	Code    []byte
+	funcs   []LinkedFunc
+	symbols map[string]uint32
+}
+
+// LinkedFunc describes the location of a function within an Image.
+type LinkedFunc struct {
+	Name   string
+	Offset uint32
+	Len    int
+}
+
+// Functions are aligned to this boundary within a linked image, or to the fragment's alignment if it is larger.
+const linkAlign = 16
+
+func (u linkUnit) align() int {
+	if a := int(u.f.Align()); a > linkAlign {
+		return a
+	}
+	return linkAlign
+}
+
+// Create a new Linker.
+func NewLinker() *Linker { return &Linker{} }
+
+// Add a function with the instructions and data which have been encoded by the assembler. The assembler must
+// not have used sections other than the default section, and references to labels which are bound within the
+// assembler do not need to be finalized beforehand.
+func (l *Linker) Add(name string, a *Assembler) error {
+	f, err := a.Fragment()
+	if err != nil {
+		return err
+	}
+	l.AddFragment(name, f)
+	return nil
+}
+
+// Add a function with the contents of the fragment.
+func (l *Linker) AddFragment(name string, f *Fragment) {
+	l.units = append(l.units, linkUnit{name: name, f: f})
+}
+
+// Get the size of the linked image in bytes.
+func (l *Linker) Size() int {
+	size := 0
+	for _, u := range l.units {
+		align := u.align()
+		size = (size+align-1)&^(align-1) + len(u.f.code)
+	}
+	return size
+}
+
+// Lay out all functions in dst, which must have a length of at least Size() bytes, and resolve all references
+// between functions. If dst is nil, a new slice will be allocated. Functions are aligned relative to the start
+// of dst to 16 bytes, or to their fragment's alignment if it is larger (see Fragment.Align). Padding between
+// functions is filled with INT3 instructions.
+func (l *Linker) Link(dst []byte) (*Image, error) {
+	size := l.Size()
+	if dst == nil {
+		dst = make([]byte, size)
+	} else if len(dst) < size {
+		return nil, fmt.Errorf("Destination for linked image is too small: %d < %d bytes", len(dst), size)
+	}
+	img := &Image{Code: dst[:size], symbols: make(map[string]uint32)}
+
+	// assign offsets to functions and exported labels:
+	bases := make([]uint32, len(l.units))
+	define := func(name string, pc uint32) error {
+		if _, ok := img.symbols[name]; ok {
+			return &EncodeError{PC: -1, Operand: -1, Reason: ReasonLabel, Err: fmt.Errorf("Duplicate symbol %q", name)}
+		}
+		img.symbols[name] = pc
+		return nil
+	}
+	pc := 0
+	for i, u := range l.units {
+		for pc&(u.align()-1) != 0 {
+			img.Code[pc] = 0xcc
+			pc++
+		}
+		bases[i] = uint32(pc)
+		pc += copy(img.Code[pc:], u.f.code)
+		if err := define(u.name, bases[i]); err != nil {
+			return nil, err
+		}
+		img.funcs = append(img.funcs, LinkedFunc{Name: u.name, Offset: bases[i], Len: len(u.f.code)})
+		for _, fl := range u.f.labels {
+			if fl.exported && !fl.imported && fl.state != labelUnbound {
+				if err := define(fl.name, bases[i]+fl.pc); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	// resolve references:
+	var unbound []LabelRef
+	for i, u := range l.units {
+		base := bases[i]
+		for _, r := range u.f.relocs {
+			fl := u.f.labels[r.label]
+			target := base + fl.pc
+			if fl.imported {
+				pc, ok := img.symbols[fl.name]
+				if !ok {
+					unbound = append(unbound, LabelRef{Id: r.label, Name: fl.name, PC: base + r.loc})
+					continue
+				}
+				target = pc
+			}
+			disp := int(target) - int(base+r.loc+uint32(r.width)) + int(r.disp)
+			if err := patchRel(img.Code, base+r.loc, r.width, disp); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(unbound) > 0 {
+		return nil, &EncodeError{PC: -1, Operand: -1, Reason: ReasonLabel, Err: &LabelError{Unbound: unbound}}
+	}
+	return img, nil
+}
+
+// Get the locations of all functions within the image, in the order they were added to the linker.
+func (img *Image) Functions() []LinkedFunc { return img.funcs }
+
+// Get the offset of a function or exported label within the image.
+func (img *Image) Offset(name string) (uint32, bool) {
+	pc, ok := img.symbols[name]
+	return pc, ok
+}
+
+// Get the address of a function or exported label within the image's code, or 0 if the name is not defined.
+func (img *Image) Addr(name string) uintptr {
+	pc, ok := img.symbols[name]
+	if !ok || len(img.Code) == 0 {
+		return 0
+	}
+	return uintptr(unsafe.Pointer(&img.Code[0])) + uintptr(pc)
+}
@@ -1,6 +1,8 @@
 package x64lookup
 
 import (
+	"sort"
+
 	"github.com/wdamron/x64"
 )
 
@@ -8,17 +10,60 @@ const maxMnemonicLength = 16
 
 // Lookup the instruction for a mnemonic. The mnemonic will be converted to uppercase if necessary.
 func Inst(mnemonic string) (x64.Inst, bool) {
-	if len(mnemonic) < maxMnemonicLength {
+	if len(mnemonic) > 0 && len(mnemonic) <= maxMnemonicLength {
 		inst, ok := instMap[upperCase(mnemonic)]
 		return inst, ok
 	}
 	return x64.Inst(0), false
 }
 
+// Get all supported mnemonics in uppercase, sorted alphabetically.
+func Mnemonics() []string {
+	mnemonics := make([]string, 0, len(instMap))
+	for mnemonic := range instMap {
+		mnemonics = append(mnemonics, mnemonic)
+	}
+	sort.Strings(mnemonics)
+	return mnemonics
+}
+
+// Lookup the register for a lowercase Intel-syntax register name, e.g. "rax", "r8d", "xmm3" or "st(1)".
+func Reg(name string) (x64.Reg, bool) {
+	r, ok := regMap[name]
+	return r, ok
+}
+
+var regMap = func() map[string]x64.Reg {
+	m := make(map[string]x64.Reg)
+	add := func(first x64.Reg, count int) {
+		for i := 0; i < count; i++ {
+			r := first + x64.Reg(i)
+			m[r.String()] = r
+		}
+	}
+	add(x64.AL, 16)
+	add(x64.AX, 16)
+	add(x64.EAX, 16)
+	add(x64.RAX, 16)
+	add(x64.AH, 4)
+	add(x64.IP, 1)
+	add(x64.EIP, 1)
+	add(x64.RIP, 1)
+	add(x64.F0, 8)
+	add(x64.M0, 8)
+	add(x64.X0, 16)
+	add(x64.Y0, 16)
+	add(x64.ES, 6)
+	add(x64.CR0, 16)
+	add(x64.DR0, 16)
+	m["st"] = x64.F0
+	return m
+}()
+
 func upperCase(s string) string {
 	var b [maxMnemonicLength]byte
 	var ch byte
-	_ = b[len(s)] // lift bounds-checks out of the loop below (golang.org/issue/14808)
+	_ = b[len(s)-1] // lift bounds-checks out of the loop below (golang.org/issue/14808)
 	i, changed := 0, false
 loop: // functions containing for-loops cannot currently be inlined (golang.org/issue/14768)
 	ch = s[i]
@@ -2,6 +2,8 @@ package x64lookup
 
 import (
 	"testing"
+
+	"github.com/wdamron/x64"
 )
 
 func TestLookup(t *testing.T) {
@@ -14,3 +16,29 @@ func TestLookup(t *testing.T) {
 		t.Fatal("failed to find MOV")
 	}
 }
+
+func TestMnemonics(t *testing.T) {
+	mnemonics := Mnemonics()
+	if len(mnemonics) != len(x64.Insts()) {
+		t.Fatalf("Expected %d mnemonics, found %d", len(x64.Insts()), len(mnemonics))
+	}
+	for i, mnemonic := range mnemonics {
+		if i > 0 && mnemonics[i-1] >= mnemonic {
+			t.Fatalf("Mnemonics are not sorted: %s, %s", mnemonics[i-1], mnemonic)
+		}
+		if inst, ok := Inst(mnemonic); !ok || inst.Name() != mnemonic {
+			t.Fatalf("failed to find %s", mnemonic)
+		}
+	}
+}
+
+func TestReg(t *testing.T) {
+	for name, expect := range map[string]x64.Reg{"rax": x64.RAX, "r8d": x64.R8L, "bh": x64.BH, "st(1)": x64.F1, "xmm15": x64.X15, "ymm3": x64.Y3, "gs": x64.GS, "rip": x64.RIP} {
+		if r, ok := Reg(name); !ok || r != expect {
+			t.Fatalf("Unexpected register for %s: %v", name, r)
+		}
+	}
+	if _, ok := Reg("xmm16"); ok {
+		t.Fatal("Found an unsupported register")
+	}
+}
@@ -1,7 +1,7 @@
 package x64lookup
 
 // THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT!
-// go build -o ./gen/gen ./gen/gen.go && ./gen/gen lookup > $output
+// go build -o ./gen/gen ./gen && ./gen/gen lookup > $output
 
 import (
 	"github.com/wdamron/x64"
@@ -160,6 +160,7 @@ var instMap = map[string]x64.Inst{
 	"INVEPT":           x64.INVEPT,
 	"INVLPG":           x64.INVLPG,
 	"INVLPGA":          x64.INVLPGA,
+	"INVPCID":          x64.INVPCID,
 	"INVVPID":          x64.INVVPID,
 	"IRET":             x64.IRET,
 	"IRETD":            x64.IRETD,
@@ -303,6 +304,7 @@ var instMap = map[string]x64.Inst{
 	"PREFETCHT1":       x64.PREFETCHT1,
 	"PREFETCHT2":       x64.PREFETCHT2,
 	"PREFETCHW":        x64.PREFETCHW,
+	"PREFETCHWT1":      x64.PREFETCHWT1,
 	"PUSH":             x64.PUSH,
 	"PUSHF":            x64.PUSHF,
 	"PUSHFQ":           x64.PUSHFQ,
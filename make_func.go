@@ -3,6 +3,7 @@ package x64
 import (
 	"fmt"
 	"reflect"
+	"sync"
 
 	"unsafe"
 )
@@ -26,3 +27,69 @@ func SetFunctionCode(dstAddr interface{}, executable []byte) error {
 	*header.addr = &executable
 	return nil
 }
+
+// Set the executable code for dstAddr, where executable contains the code which has been encoded by the
+// assembler. See SetFunctionCode.
+//
+// The assembler's code is reported to all registered code hooks with the assembler's name (see SetName and
+// RegisterCodeHook). The function value is set even if a hook returns an error, and the first error will be
+// returned.
+func (a *Assembler) SetFunctionCode(dstAddr interface{}, executable []byte) error {
+	if err := SetFunctionCode(dstAddr, executable); err != nil {
+		return err
+	}
+	if n := len(a.Code()); n < len(executable) {
+		executable = executable[:n]
+	}
+	return runCodeHooks(a.name, executable)
+}
+
+// CodeHook is called whenever code is placed in executable memory with Assembler.SetFunctionCode, e.g. to record
+// symbols for profilers. The name is empty if the assembler has no name.
+type CodeHook func(name string, code []byte) error
+
+var codeHooks struct {
+	sync.Mutex
+	hooks  []codeHook
+	nextId int
+}
+
+type codeHook struct {
+	id int
+	h  CodeHook
+}
+
+// Register a hook which will be called whenever code is placed in executable memory with
+// Assembler.SetFunctionCode. The returned function removes the hook.
+func RegisterCodeHook(h CodeHook) (unregister func()) {
+	codeHooks.Lock()
+	defer codeHooks.Unlock()
+	id := codeHooks.nextId
+	codeHooks.nextId++
+	// hooks are copied on write, so they may be run without holding the lock:
+	codeHooks.hooks = append(codeHooks.hooks[:len(codeHooks.hooks):len(codeHooks.hooks)], codeHook{id: id, h: h})
+	return func() {
+		codeHooks.Lock()
+		defer codeHooks.Unlock()
+		for i, ch := range codeHooks.hooks {
+			if ch.id == id {
+				hooks := make([]codeHook, 0, len(codeHooks.hooks)-1)
+				codeHooks.hooks = append(append(hooks, codeHooks.hooks[:i]...), codeHooks.hooks[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+func runCodeHooks(name string, code []byte) error {
+	codeHooks.Lock()
+	hooks := codeHooks.hooks
+	codeHooks.Unlock()
+	var err error
+	for _, ch := range hooks {
+		if e := ch.h(name, code); err == nil {
+			err = e
+		}
+	}
+	return err
+}
@@ -7,6 +7,8 @@ import (
 )
 
 // ErrNoMatch will be returned if no matching instruction-encoding is found while encoding an instruction.
+// The error will be wrapped by an EncodeError which lists the rejected candidate encodings; use errors.Is
+// to check for ErrNoMatch.
 var ErrNoMatch = errors.New("No matching instruction-encoding was found")
 
 // Operand type/size patterns
@@ -43,233 +45,268 @@ var ErrNoMatch = errors.New("No matching instruction-encoding was found")
 // 1/_ matches a lack of size, only useful in combination with m
 func (matcher *InstMatcher) matchInst(feats feats.Feature, startOffset uint16) bool {
 	inst := matcher.inst
-	args := matcher.args
-	argc := len(args)
 	o := inst.offset() + startOffset
 	c := uint16(inst.count()) - startOffset
-SEARCH:
 	for ei, e := range encs[o : o+c] {
-		if e.feats&feats != e.feats {
-			continue SEARCH
+		_, _, reason, operand := matcher.matchEnc(e, feats)
+		if reason != 0 {
+			matcher.reject(uint(o)+uint(ei), reason, operand)
+			continue
 		}
-		p := argpFormats[e.argp]
-		pl := 0
-		for _, b := range p[:] {
-			if b == 0 {
-				break
-			}
-			pl++
+
+		if e.offset() != uint8(ei)+uint8(startOffset) || e.instid() != inst.Id() {
+			panic("unexpected encoding at offset")
 		}
-		if pl/2 != argc {
-			continue
+
+		// all arguments match for the current encoding
+		matcher.selectEnc(e.offset())
+		return true
+	}
+
+	matcher.enc = enc{}
+	return false
+}
+
+// Check if the arguments match the arg-pattern for an encoding. If the encoding is rejected, the reason
+// and the index of the mismatched operand (or -1) will be returned.
+func (matcher *InstMatcher) matchEnc(e enc, feats feats.Feature) (p [8]byte, pl int, reason RejectReason, operand int) {
+	args := matcher.args
+	argc := len(args)
+	if required := e.features(); required&feats != required {
+		return p, pl, RejectFeatures, -1
+	}
+	p, pl = argpFormats[e.argp], 2*int(argpCounts[e.argp])
+	if pl/2 != argc {
+		return p, pl, RejectOperandCount, -1
+	}
+
+	// scan arg-pattern:
+	for pi, ai := 0, 0; pi+1 < pl && ai < argc; pi, ai = pi+2, ai+1 {
+		if reason := matcher.matchArg(p[pi], p[pi+1], ai); reason != 0 {
+			return p, pl, reason, ai
 		}
+	}
 
-		// scan arg-pattern:
-		for pi, ai := 0, 0; pi+1 < pl && ai < argc; pi, ai = pi+2, ai+1 {
-			t, sz, arg := p[pi], p[pi+1], args[ai]
+	return p, pl, 0, -1
+}
 
-			argsz := arg.width()
+// Check if an argument matches the type and size of an operand within an arg-pattern.
+func (matcher *InstMatcher) matchArg(t, sz byte, ai int) RejectReason {
+	arg := matcher.args[ai]
+	argsz := arg.width()
 
-			// check type
-			switch t {
-			case 'i': // immediate
-				if !isImm(arg) {
-					continue SEARCH
-				}
-			case 'o': // displacement
-				if !isDisp(arg) {
-					continue SEARCH
-				}
-			case 'W': // CR8
-				if r, ok := arg.(Reg); !ok || r != CR8 {
-					continue SEARCH
-				}
-			case 'X': // F0
-				if r, ok := arg.(Reg); !ok || r != F0 {
-					continue SEARCH
-				}
-			case 'r', 'v': // legacy reg or memory
-				switch argv := arg.(type) {
-				case Reg:
-					if argv.Family() != REG_LEGACY && argv.Family() != REG_HIGHBYTE {
-						continue SEARCH
-					}
-				case memArgPlaceholder:
-					mem := matcher.mem
-					if t != 'v' || (mem.Index != 0 && (mem.Index.Family() == REG_XMM || mem.Index.Family() == REG_YMM)) {
-						continue SEARCH
-					}
-					argsz = mem.width()
-				default:
-					continue SEARCH
-				}
-			case 'x', 'u': // mmx reg or memory
-				switch argv := arg.(type) {
-				case Reg:
-					if argv.Family() != REG_MMX {
-						continue SEARCH
-					}
-				case memArgPlaceholder:
-					mem := matcher.mem
-					if t != 'u' || (mem.Index != 0 && (mem.Index.Family() == REG_XMM || mem.Index.Family() == REG_YMM)) {
-						continue SEARCH
-					}
-					argsz = mem.width()
-				default:
-					continue SEARCH
-				}
-			case 'y', 'w': // xmm/ymm reg or memory
-				switch argv := arg.(type) {
-				case Reg:
-					if argv.Family() != REG_XMM && argv.Family() != REG_YMM {
-						continue SEARCH
-					}
-				case memArgPlaceholder:
-					mem := matcher.mem
-					if t != 'w' || (mem.Index != 0 && (mem.Index.Family() == REG_XMM || mem.Index.Family() == REG_YMM)) {
-						continue SEARCH
-					}
-					argsz = mem.width()
-				default:
-					continue SEARCH
-				}
-			case 'm': // memory
-				if matcher.memOffset != ai {
-					continue SEARCH
-				}
-				m := matcher.mem
-				if m.Index != 0 && (m.Index.Family() == REG_XMM || m.Index.Family() == REG_YMM) {
-					continue SEARCH
-				}
-				argsz = m.width()
-			case 'f': // fp reg
-				if r, ok := arg.(Reg); !ok || r.Family() != REG_FP {
-					continue SEARCH
-				}
-			case 's': // segment reg
-				if r, ok := arg.(Reg); !ok || r.Family() != REG_SEGMENT {
-					continue SEARCH
-				}
-			case 'c': // control reg
-				if r, ok := arg.(Reg); !ok || r.Family() != REG_CONTROL {
-					continue SEARCH
-				}
-			case 'd': // debug reg
-				if r, ok := arg.(Reg); !ok || r.Family() != REG_DEBUG {
-					continue SEARCH
-				}
-			case 'b': // bound reg
-				continue SEARCH // TODO(?): bound registers aren't currently handled
-			// k : vsib addressing, 32 bit result, size determines xmm or ymm
-			// l : vsib addressing, 64 bit result, size determines xmm or ymm
-			case 'k', 'l':
-				if matcher.memOffset != ai {
-					continue SEARCH
-				}
-				m := matcher.mem
-				if m.Index.Family() != REG_XMM && m.Index.Family() != REG_YMM {
-					continue SEARCH
-				}
-				argsz = m.Index.width()
-			default:
-				switch {
-				case t >= 'A' && t <= 'P': // rax - r15 (fixed reg)
-					if r, ok := arg.(Reg); !ok || r.Family() != REG_LEGACY || byte(r.Num()) != t-'A' {
-						continue SEARCH
-					}
-				case t >= 'Q' && t <= 'V': // es, cs, ss, ds, fs, gs (fixed reg)
-					if r, ok := arg.(Reg); !ok || r.Family() != REG_SEGMENT || byte(r.Num()) != t-'Q' {
-						continue SEARCH
-					}
-				default:
-					continue SEARCH
-				}
+	// check type
+	switch t {
+	case 'i': // immediate
+		if !arg.isImm() {
+			return RejectOperandKind
+		}
+	case 'o': // displacement
+		if !arg.isDisp() {
+			return RejectOperandKind
+		}
+	case 'W': // CR8
+		if arg.kind != opReg || arg.reg != CR8 {
+			return RejectOperandKind
+		}
+	case 'X': // F0
+		if arg.kind != opReg || arg.reg != F0 {
+			return RejectOperandKind
+		}
+	case 'r', 'v': // legacy reg or memory
+		switch arg.kind {
+		case opReg:
+			if arg.reg.Family() != REG_LEGACY && arg.reg.Family() != REG_HIGHBYTE {
+				return RejectOperandKind
+			}
+		case opMem:
+			mem := matcher.mem
+			if t != 'v' || (mem.Index != 0 && (mem.Index.Family() == REG_XMM || mem.Index.Family() == REG_YMM)) {
+				return RejectOperandKind
+			}
+			argsz = mem.width()
+		default:
+			return RejectOperandKind
+		}
+	case 'x', 'u': // mmx reg or memory
+		switch arg.kind {
+		case opReg:
+			if arg.reg.Family() != REG_MMX {
+				return RejectOperandKind
+			}
+		case opMem:
+			mem := matcher.mem
+			if t != 'u' || (mem.Index != 0 && (mem.Index.Family() == REG_XMM || mem.Index.Family() == REG_YMM)) {
+				return RejectOperandKind
+			}
+			argsz = mem.width()
+		default:
+			return RejectOperandKind
+		}
+	case 'y', 'w': // xmm/ymm reg or memory
+		switch arg.kind {
+		case opReg:
+			if arg.reg.Family() != REG_XMM && arg.reg.Family() != REG_YMM {
+				return RejectOperandKind
+			}
+		case opMem:
+			mem := matcher.mem
+			if t != 'w' || (mem.Index != 0 && (mem.Index.Family() == REG_XMM || mem.Index.Family() == REG_YMM)) {
+				return RejectOperandKind
+			}
+			argsz = mem.width()
+		default:
+			return RejectOperandKind
+		}
+	case 'm': // memory
+		if matcher.memOffset != ai {
+			return RejectOperandKind
+		}
+		m := matcher.mem
+		if m.Index != 0 && (m.Index.Family() == REG_XMM || m.Index.Family() == REG_YMM) {
+			return RejectOperandKind
+		}
+		argsz = m.width()
+	case 'f': // fp reg
+		if arg.kind != opReg || arg.reg.Family() != REG_FP {
+			return RejectOperandKind
+		}
+	case 's': // segment reg
+		if arg.kind != opReg || arg.reg.Family() != REG_SEGMENT {
+			return RejectOperandKind
+		}
+	case 'c': // control reg
+		if arg.kind != opReg || arg.reg.Family() != REG_CONTROL {
+			return RejectOperandKind
+		}
+	case 'd': // debug reg
+		if arg.kind != opReg || arg.reg.Family() != REG_DEBUG {
+			return RejectOperandKind
+		}
+	case 'b': // bound reg
+		return RejectOperandKind // TODO(?): bound registers aren't currently handled
+	// k : vsib addressing, 32 bit result, size determines xmm or ymm
+	// l : vsib addressing, 64 bit result, size determines xmm or ymm
+	case 'k', 'l':
+		if matcher.memOffset != ai {
+			return RejectOperandKind
+		}
+		m := matcher.mem
+		if m.Index.Family() != REG_XMM && m.Index.Family() != REG_YMM {
+			return RejectOperandKind
+		}
+		argsz = m.Index.width()
+	default:
+		switch {
+		case t >= 'A' && t <= 'P': // rax - r15 (fixed reg)
+			if arg.kind != opReg || arg.reg.Family() != REG_LEGACY || byte(arg.reg.Num()) != t-'A' {
+				return RejectOperandKind
+			}
+		case t >= 'Q' && t <= 'V': // es, cs, ss, ds, fs, gs (fixed reg)
+			if arg.kind != opReg || arg.reg.Family() != REG_SEGMENT || byte(arg.reg.Num()) != t-'Q' {
+				return RejectOperandKind
 			}
+		default:
+			return RejectOperandKind
+		}
+	}
 
-			// check size
-			switch sz {
-			case 'b':
-				if argsz > 1 {
-					continue SEARCH
-				}
-			case 'w':
-				if argsz > 2 {
-					continue SEARCH
-				}
-			case 'd':
-				if argsz > 4 {
-					continue SEARCH
-				}
-			case 'q':
-				if argsz > 8 {
-					continue SEARCH
-				}
-			case 'f':
-				if argsz != 10 {
-					continue SEARCH
-				}
-			case 'p':
-				if argsz != 6 {
-					continue SEARCH
-				}
-			case 'o':
-				if argsz != 16 {
-					continue SEARCH
-				}
-			case 'h':
-				if argsz != 32 {
-					continue SEARCH
-				}
-			case '0': // matches all possible sizes for this operand (w/d for i, w/d/q for r/v, o/h for y/w and everything for m)
-				switch t {
-				case 'i': // immediate
-					if argsz != 2 && argsz != 4 && argsz != 8 {
-						continue SEARCH
-					}
-				// k : vsib addressing, 32 bit result, size determines xmm or ymm
-				// l : vsib addressing, 64 bit result, size determines xmm or ymm
-				// y : xmm/ymm reg
-				// w : xmm/ymm reg or memory
-				case 'k', 'l', 'y', 'w':
-					if argsz != 16 && argsz != 32 {
-						continue SEARCH
-					}
-				case 'm': // memory
-					// match
-				case 'r', 'v': // legacy reg or r/m
-					if argsz != 2 && argsz != 4 && argsz != 8 {
-						continue SEARCH
-					}
-				default:
-					switch {
-					case t >= 'A' && t <= 'P': // rax - r15 (fixed reg)
-						if argsz != 2 && argsz != 4 && argsz != 8 {
-							continue SEARCH
-						}
-					default:
-						continue SEARCH
-					}
-				}
-			case '1': // matches a lack of size, only useful in combination with m
-				if t != 'm' {
-					continue SEARCH
+	// general purpose registers must match fixed sizes exactly; otherwise, an operation could be
+	// silently widened (e.g. MOV EAX, imm64 encoded as MOV RAX, imm64)
+	if arg.kind == opReg && (arg.reg.Family() == REG_LEGACY || arg.reg.Family() == REG_HIGHBYTE) {
+		switch sz {
+		case 'b', 'w', 'd', 'q':
+			if argsz != sizeOf(sz) {
+				return RejectOperandSize
+			}
+		}
+	}
+
+	// check size
+	switch sz {
+	case 'b':
+		if argsz > 1 {
+			return RejectOperandSize
+		}
+	case 'w':
+		if argsz > 2 {
+			return RejectOperandSize
+		}
+	case 'd':
+		if argsz > 4 {
+			return RejectOperandSize
+		}
+	case 'q':
+		if argsz > 8 {
+			return RejectOperandSize
+		}
+	case 'f':
+		if argsz != 6 {
+			return RejectOperandSize
+		}
+	case 'p':
+		if argsz != 10 {
+			return RejectOperandSize
+		}
+	case 'o':
+		if argsz != 16 {
+			return RejectOperandSize
+		}
+	case 'h':
+		if argsz != 32 {
+			return RejectOperandSize
+		}
+	case '0': // matches all possible sizes for this operand (w/d for i, w/d/q for r/v, o/h for y/w and everything for m)
+		switch t {
+		case 'i': // immediate
+			if argsz != 2 && argsz != 4 && argsz != 8 {
+				return RejectOperandSize
+			}
+		// k : vsib addressing, 32 bit result, size determines xmm or ymm
+		// l : vsib addressing, 64 bit result, size determines xmm or ymm
+		// y : xmm/ymm reg
+		// w : xmm/ymm reg or memory
+		case 'k', 'l', 'y', 'w':
+			if argsz != 16 && argsz != 32 {
+				return RejectOperandSize
+			}
+		case 'm': // memory
+			// match
+		case 'r', 'v': // legacy reg or r/m
+			if argsz != 2 && argsz != 4 && argsz != 8 {
+				return RejectOperandSize
+			}
+		default:
+			switch {
+			case t >= 'A' && t <= 'P': // rax - r15 (fixed reg)
+				if argsz != 2 && argsz != 4 && argsz != 8 {
+					return RejectOperandSize
 				}
 			default:
-				continue SEARCH
+				return RejectOperandSize
 			}
 		}
-
-		if e.offset() != uint8(ei)+uint8(startOffset) || e.instid() != inst.Id() {
-			panic("unexpected encoding at offset")
+	case '1': // matches a lack of size, only useful in combination with m
+		if t != 'm' {
+			return RejectOperandSize
 		}
-
-		// all arguments match for the current encoding
-		matcher.encId = uint(o) + uint(ei) + uint(startOffset)
-		matcher.enc = e
-		matcher.argp = p[:pl]
-		return true
+	default:
+		return RejectOperandSize
 	}
 
-	matcher.enc = enc{}
-	return false
+	return 0
+}
+
+func sizeOf(sz byte) uint8 {
+	switch sz {
+	case 'b':
+		return 1
+	case 'w':
+		return 2
+	case 'd':
+		return 4
+	case 'q':
+		return 8
+	}
+	return 0
 }
@@ -0,0 +1,141 @@
+package x64
+
+import "github.com/wdamron/x64/feats"
+
+// Selecting an encoding only depends on the shape of each argument (kind, register family, width, and the
+// register number for registers which are matched by fixed-register patterns), so the first matching encoding
+// for an instruction and a shape is memoized for each matcher. Immediates which can not be represented by the
+// memoized encoding fall back to the linear search.
+
+// Shape of an argument (16 bits):
+//
+//	[0..2] bits identify the kind of argument (0 if not present)
+//	[3..6] bits identify the register family
+//	[7..11] bits identify the register number, or shapeAnyReg if the number is irrelevant for matching
+//	[12..15] bits identify the width, as an index into shapeWidths
+const (
+	shapeNone = iota
+	shapeReg
+	shapeMem
+	shapeVSIB // memory with an XMM/YMM index
+	shapeImm
+	shapeDisp
+	shapeImmDisp // holes are accepted as immediates or displacements
+
+	shapeAnyReg = 16
+)
+
+var shapeWidths = [...]uint8{0, 1, 2, 4, 6, 8, 10, 16, 32}
+
+// Register numbers which are matched by fixed-register patterns (A ... P and Q ... V):
+var fixedLegacyRegs, fixedSegmentRegs uint16
+
+// Number of operands for each arg-pattern:
+var argpCounts [len(argpFormats)]uint8
+
+func init() {
+	for i, p := range argpFormats {
+		for pl := 0; pl < len(p) && p[pl] != 0; pl += 2 {
+			switch t := p[pl]; {
+			case t >= 'A' && t <= 'P':
+				fixedLegacyRegs |= 1 << (t - 'A')
+			case t >= 'Q' && t <= 'V':
+				fixedSegmentRegs |= 1 << (t - 'Q')
+			}
+			argpCounts[i]++
+		}
+	}
+}
+
+const memoSize = 256
+
+// Memoization may be disabled to compare with the linear search in benchmarks.
+var memoDisabled bool
+
+// Memoized encodings, indexed by a hash of the instruction and the shape of its arguments.
+type matchMemo struct {
+	feats   feats.Feature // enabled CPU features when the entries were memoized
+	entries [memoSize]memoEntry
+}
+
+type memoEntry struct {
+	shape  uint64
+	id     uint16 // instruction id, or 0 if the entry is unused
+	offset uint8  // offset of the encoding within the instruction's encodings
+}
+
+// Get the shape of the current arguments. False is returned if the shape can not be represented.
+func (m *InstMatcher) shape() (uint64, bool) {
+	var key uint64
+	for i, arg := range m.args {
+		var kind, family, num, width uint8
+		num = shapeAnyReg
+		switch arg.kind {
+		case opReg:
+			v := arg.reg
+			kind, family, width = shapeReg, v.Family(), v.width()
+			switch n := v.Num(); {
+			case family == REG_LEGACY && fixedLegacyRegs&(1<<n) != 0,
+				family == REG_SEGMENT && fixedSegmentRegs&(1<<n) != 0,
+				family == REG_CONTROL && v == CR8,
+				family == REG_FP && v == F0:
+				num = n
+			}
+		case opMem:
+			kind, width = shapeMem, m.mem.Width
+			if idx := m.mem.Index; idx != 0 && (idx.Family() == REG_XMM || idx.Family() == REG_YMM) {
+				kind, width = shapeVSIB, idx.width()
+			}
+		default:
+			imm, disp := arg.isImm(), arg.isDisp()
+			switch {
+			case imm && disp:
+				kind = shapeImmDisp
+			case imm:
+				kind = shapeImm
+			case disp:
+				kind = shapeDisp
+			default:
+				return 0, false
+			}
+			width = arg.width()
+		}
+		w := 0
+		for w < len(shapeWidths) && shapeWidths[w] != width {
+			w++
+		}
+		if w == len(shapeWidths) || family > 15 {
+			return 0, false
+		}
+		key |= uint64(uint16(kind)|uint16(family)<<3|uint16(num)<<7|uint16(w)<<12) << (16 * uint(i))
+	}
+	return key, true
+}
+
+func memoIndex(id uint16, shape uint64) int {
+	return int(((shape ^ uint64(id)<<53) * 0x9e3779b97f4a7c15) >> 56)
+}
+
+// Get the memoized encoding offset for the current instruction and the shape of its arguments.
+func (m *InstMatcher) memoized(shape uint64) (uint8, bool) {
+	if m.memo == nil || m.memo.feats != m.feats {
+		return 0, false
+	}
+	id := m.inst.Id()
+	e := &m.memo.entries[memoIndex(id, shape)]
+	if e.id != id || e.shape != shape {
+		return 0, false
+	}
+	return e.offset, true
+}
+
+// Memoize the encoding offset for the current instruction and the shape of its arguments.
+func (m *InstMatcher) memoize(shape uint64, offset uint8) {
+	if m.memo == nil {
+		m.memo = &matchMemo{feats: m.feats}
+	} else if m.memo.feats != m.feats {
+		*m.memo = matchMemo{feats: m.feats}
+	}
+	id := m.inst.Id()
+	m.memo.entries[memoIndex(id, shape)] = memoEntry{shape: shape, id: id, offset: offset}
+}
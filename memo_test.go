@@ -0,0 +1,95 @@
+package x64
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/wdamron/x64/feats"
+)
+
+func TestMemoizedMatch(t *testing.T) {
+	asm := NewAssembler(make([]byte, 64))
+	// fixed-register encodings must not be selected for other registers with the same shape:
+	for _, c := range []struct {
+		inst   Inst
+		args   []Arg
+		expect string
+	}{
+		{ADD, []Arg{RAX, Imm32(1)}, "48 05 01 00 00 00"},
+		{ADD, []Arg{RCX, Imm32(1)}, "48 81 c1 01 00 00 00"},
+		{ADD, []Arg{RAX, Imm32(1)}, "48 05 01 00 00 00"},
+		{SHL, []Arg{RAX, CL}, "48 d3 e0"},
+		{IN, []Arg{AL, DX}, "ec"},
+	} {
+		for pass := 0; pass < 2; pass++ {
+			asm.Reset(nil)
+			if err := asm.Inst(c.inst, c.args...); err != nil {
+				t.Fatal(err)
+			}
+			if got := fmt.Sprintf("% x", asm.Code()); got != c.expect {
+				t.Fatalf("%s %v: expected %s, got %s", c.inst.Name(), c.args, c.expect, got)
+			}
+		}
+	}
+
+	// immediates which can not be represented by the memoized encoding should report the same error as the
+	// linear search:
+	fresh := NewAssembler(make([]byte, 64))
+	expect := fresh.Inst(ADD, RAX, Imm64(1<<40))
+	asm.Reset(nil)
+	if err := asm.Inst(ADD, RAX, Imm64(1)); err != nil {
+		t.Fatal(err)
+	}
+	asm.Reset(nil)
+	if err := asm.Inst(ADD, RAX, Imm64(1<<40)); err == nil || err.Error() != expect.Error() {
+		t.Fatalf("expected %v, got %v", expect, err)
+	}
+
+	// memoized encodings should not be selected after the required features are disabled:
+	asm.Reset(nil)
+	if err := asm.Inst(VPADDD, Y0, Y1, Y2); err != nil {
+		t.Fatal(err)
+	}
+	asm.Reset(nil)
+	asm.DisableFeature(feats.AVX)
+	if err := asm.Inst(VPADDD, Y0, Y1, Y2); !errors.Is(err, ErrNoMatch) {
+		t.Fatalf("expected ErrNoMatch, got %v", err)
+	}
+
+	// diagnosing an error should not reset memoized encodings:
+	asm.Reset(nil)
+	if err := asm.Inst(ADD, RAX, RCX); err != nil {
+		t.Fatal(err)
+	}
+	memo := *asm.match.memo
+	asm.SetDiagnostics(true)
+	asm.Reset(nil)
+	if err := asm.Inst(VPADDD, Y0, Y1, Y2); !errors.Is(err, ErrNoMatch) {
+		t.Fatalf("expected ErrNoMatch, got %v", err)
+	}
+	if *asm.match.memo != memo {
+		t.Fatal("Memoized encodings were reset by diagnostics")
+	}
+}
+
+func benchmarkBasicInstructionSet(b *testing.B, memo bool) {
+	memoDisabled = !memo
+	defer func() { memoDisabled = false }()
+	asm := NewAssembler(make([]byte, 256))
+	encode := func(expect string, inst Inst, args ...Arg) {
+		asm.Reset(nil)
+		if err := asm.Inst(inst, args...); err != nil {
+			b.Fatal(expect, "--", err)
+		}
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		basicInstructionSet(encode)
+	}
+}
+
+func BenchmarkBasicInstructionSet(b *testing.B) {
+	b.Run("memo", func(b *testing.B) { benchmarkBasicInstructionSet(b, true) })
+	b.Run("search", func(b *testing.B) { benchmarkBasicInstructionSet(b, false) })
+}
@@ -0,0 +1,150 @@
+package x64
+
+import "strings"
+
+// Arguments are converted to operands (a compact value representation) before matching, with type switches on
+// their concrete types. The matcher never retains the interfaces which arguments were passed as, so arguments
+// (and the variadic slice containing them) do not escape, and encoding does not allocate on the heap.
+
+// Kinds of operands:
+const (
+	opNone uint8 = iota
+	opReg
+	opMem // the memory argument and its displacement are stored by the matcher
+	opImm
+	opRel
+	opLabel    // Label
+	opLabelRel // Label8, Label16 or Label32
+	opLabelDisp
+	opHole
+)
+
+// operand is the value representation of an argument.
+type operand struct {
+	name  string // name of a hole
+	val   int64  // value of an immediate or relative displacement, or additional displacement for a label
+	reg   Reg
+	label uint16 // label id
+	kind  uint8
+	w     uint8 // width of an immediate, displacement or hole
+}
+
+// Convert an argument to an operand. False is returned for memory arguments and unsupported arguments.
+func toOperand(arg Arg) (operand, bool) {
+	switch v := arg.(type) {
+	case Reg:
+		return operand{kind: opReg, reg: v}, true
+	case Imm8:
+		return operand{kind: opImm, val: int64(v), w: 1}, true
+	case Imm16:
+		return operand{kind: opImm, val: int64(v), w: 2}, true
+	case Imm32:
+		return operand{kind: opImm, val: int64(v), w: 4}, true
+	case Imm64:
+		return operand{kind: opImm, val: int64(v), w: 8}, true
+	case nil:
+		return operand{}, false
+	}
+	return toDispOperand(arg)
+}
+
+// Convert a displacement (or an immediate hole) to an operand.
+func toDispOperand(arg Arg) (operand, bool) {
+	switch v := arg.(type) {
+	case Rel8:
+		return operand{kind: opRel, val: int64(v), w: 1}, true
+	case Rel16:
+		return operand{kind: opRel, val: int64(v), w: 2}, true
+	case Rel32:
+		return operand{kind: opRel, val: int64(v), w: 4}, true
+	case Label:
+		return operand{kind: opLabel, val: int64(v.pc), label: v.id, w: 4}, true
+	case Label8:
+		return operand{kind: opLabelRel, label: uint16(v), w: 1}, true
+	case Label16:
+		return operand{kind: opLabelRel, label: uint16(v), w: 2}, true
+	case Label32:
+		return operand{kind: opLabelRel, label: uint16(v), w: 4}, true
+	case LabelDisp:
+		return operand{kind: opLabelDisp, val: int64(v.disp), label: v.labelid, w: v.dispsz}, true
+	case Hole:
+		// the name is copied, as retaining it would cause all arguments to escape:
+		return operand{kind: opHole, name: strings.Clone(v.name), w: v.w}, true
+	}
+	return operand{}, false
+}
+
+// Convert the operand back to an argument, e.g. for errors. Memory operands are converted to nil.
+func (o operand) arg() Arg {
+	switch o.kind {
+	case opReg:
+		return o.reg
+	case opImm:
+		return immWithWidth(o.val, o.w)
+	case opRel:
+		switch o.w {
+		case 1:
+			return Rel8(o.val)
+		case 2:
+			return Rel16(o.val)
+		}
+		return Rel32(o.val)
+	case opLabel:
+		return Label{pc: uint32(o.val), id: o.label}
+	case opLabelRel:
+		switch o.w {
+		case 1:
+			return Label8(o.label)
+		case 2:
+			return Label16(o.label)
+		}
+		return Label32(o.label)
+	case opLabelDisp:
+		return LabelDisp{labelid: o.label, disp: int32(o.val), dispsz: o.w}
+	case opHole:
+		return Hole{name: o.name, w: o.w}
+	}
+	return nil
+}
+
+func (o operand) width() uint8 {
+	if o.kind == opReg {
+		return o.reg.width()
+	}
+	return o.w
+}
+
+// Get the value to encode for an immediate or displacement. Label references and holes are encoded as 0.
+func (o operand) value() int64 {
+	switch o.kind {
+	case opImm, opRel, opLabelDisp:
+		return o.val
+	}
+	return 0
+}
+
+func (o operand) isImm() bool { return o.kind == opImm || o.kind == opHole }
+
+func (o operand) isDisp() bool {
+	switch o.kind {
+	case opRel, opLabel, opLabelRel, opLabelDisp, opHole:
+		return true
+	}
+	return false
+}
+
+func (o operand) isLabel() bool {
+	return o.kind == opLabel || o.kind == opLabelRel || o.kind == opLabelDisp
+}
+
+// Get the argument at index i, including the displacement for the memory argument.
+func (m *InstMatcher) argAt(i int) Arg {
+	if i == m.memOffset {
+		mem := m.mem
+		if m.disp.kind != opNone {
+			mem.Disp = m.disp.arg().(DispArg)
+		}
+		return mem
+	}
+	return m.args[i].arg()
+}
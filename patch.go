@@ -0,0 +1,133 @@
+package x64
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// PatchSite identifies a patchable slot within encoded code (see Assembler.PatchSite). The slot begins at
+// an 8-byte aligned PC, so it may be atomically overwritten with a JMP instruction while other threads
+// are executing the code.
+type PatchSite struct {
+	// The offset of the slot
+	PC uint32
+	// The length of the slot in bytes (5 or 8)
+	Len uint8
+}
+
+// Emit a patchable slot of length bytes (5 or 8), filled with a single NOP instruction. The PC will be
+// aligned to 8 bytes beforehand, padding with NOPs. The code must be mapped at an 8-byte aligned address
+// for the slot to be patched atomically. Slots are patched with an 8-byte store, so a 5-byte slot must be
+// followed by at least 3 bytes of code (e.g. the next instruction), which are preserved by the store.
+func (a *Assembler) PatchSite(length uint8) PatchSite {
+	if length != 5 && length != 8 {
+		a.setDataError(ReasonOperandSize, fmt.Errorf("Patch sites must be 5 or 8 bytes: %d", length))
+		return PatchSite{}
+	}
+	a.b.requireAlign(8)
+	a.b.Nop(uint8(-a.PC() & 7))
+	site := PatchSite{PC: a.PC(), Len: length}
+	a.b.Nop(length)
+	return site
+}
+
+// Emit a patchable slot of length bytes (5 or 8), initialized with a JMP to target. The PC will be aligned
+// to 8 bytes beforehand, padding with NOPs.
+func (a *Assembler) PatchJump(length uint8, target Label) PatchSite {
+	if length != 5 && length != 8 {
+		a.setDataError(ReasonOperandSize, fmt.Errorf("Patch sites must be 5 or 8 bytes: %d", length))
+		return PatchSite{}
+	}
+	a.b.requireAlign(8)
+	a.b.Nop(uint8(-a.PC() & 7))
+	site := PatchSite{PC: a.PC(), Len: length}
+	a.Inst(JMP, target.Rel32())
+	a.b.Nop(length - 5)
+	return site
+}
+
+// CodePatcher patches the slots emitted by Assembler.PatchSite within executable code, while other threads
+// may be executing the code. Each patch is a single atomic 8-byte store to the aligned word containing
+// the slot, so other threads observe either the previous or the new instruction.
+type CodePatcher struct {
+	// The executable mapping of the code, beginning at PC 0
+	Exec []byte
+	// An optional writable mapping of the same memory as Exec (i.e. a dual mapping). If Write is nil, the
+	// pages containing each slot will temporarily be made writable with mprotect, then restored to their
+	// original protection. Write is required on platforms other than linux.
+	Write []byte
+}
+
+// Serializes writes to pages which are temporarily made writable.
+var patchLock sync.Mutex
+
+// Overwrite the slot with a JMP to the target address.
+func (p *CodePatcher) Patch(site PatchSite, target uintptr) error {
+	if err := p.check(site); err != nil {
+		return err
+	}
+	end := uintptr(unsafe.Pointer(&p.Exec[0])) + uintptr(site.PC) + 5
+	rel := int64(target) - int64(end)
+	if rel < -1<<31 || rel >= 1<<31 {
+		return fmt.Errorf("Target for patch site at pc %#x exceeds the range of a 32-bit displacement", site.PC)
+	}
+	var slot [8]byte
+	slot[0] = 0xe9
+	binary.LittleEndian.PutUint32(slot[1:], uint32(rel))
+	slot[5], slot[6], slot[7] = 0xcc, 0xcc, 0xcc
+	return p.store(site, slot)
+}
+
+// Restore the slot to a NOP, so execution falls through the slot.
+func (p *CodePatcher) Unpatch(site PatchSite) error {
+	if err := p.check(site); err != nil {
+		return err
+	}
+	var slot [8]byte
+	copy(slot[:], nops[site.Len-1][:site.Len])
+	return p.store(site, slot)
+}
+
+func (p *CodePatcher) check(site PatchSite) error {
+	if site.Len != 5 && site.Len != 8 {
+		return fmt.Errorf("Patch sites must be 5 or 8 bytes: %d", site.Len)
+	}
+	if int(site.PC)+int(site.Len) > len(p.Exec) || (p.Write != nil && len(p.Write) != len(p.Exec)) {
+		return fmt.Errorf("Patch site at pc %#x is out of range for the code", site.PC)
+	}
+	if int(site.PC)+8 > len(p.Exec) {
+		return fmt.Errorf("Patch site at pc %#x must be followed by %d bytes of code for an 8-byte store", site.PC, 8-site.Len)
+	}
+	if (uintptr(unsafe.Pointer(&p.Exec[0]))+uintptr(site.PC))&7 != 0 {
+		return fmt.Errorf("Patch site at pc %#x is not 8-byte aligned", site.PC)
+	}
+	return nil
+}
+
+// Atomically store the first site.Len bytes of slot to the site, preserving the remainder of the aligned word.
+func (p *CodePatcher) store(site PatchSite, slot [8]byte) (err error) {
+	patchLock.Lock()
+	defer patchLock.Unlock()
+	w := p.Write
+	if w == nil {
+		w = p.Exec
+		restore, err := unprotectPage(p.Exec, int(site.PC))
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if rerr := restore(); err == nil {
+				err = rerr
+			}
+		}()
+	}
+	word := (*uint64)(unsafe.Pointer(&w[site.PC]))
+	var cur [8]byte
+	binary.LittleEndian.PutUint64(cur[:], atomic.LoadUint64(word))
+	copy(cur[:site.Len], slot[:site.Len])
+	atomic.StoreUint64(word, binary.LittleEndian.Uint64(cur[:]))
+	return nil
+}
@@ -0,0 +1,71 @@
+// +build linux
+
+package x64
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"unsafe"
+)
+
+// Make the page containing code[pc] writable, returning a function which restores the page's original
+// protection. The page is computed from the address of code[pc], so code need not begin at a page boundary.
+func unprotectPage(code []byte, pc int) (restore func() error, err error) {
+	pageSize := uintptr(syscall.Getpagesize())
+	addr := unsafe.Pointer(&code[pc])
+	offset := uintptr(addr) & (pageSize - 1)
+	page := unsafe.Slice((*byte)(unsafe.Add(addr, -int(offset))), pageSize)
+	prot := pageProtection(uintptr(addr) - offset)
+	if prot&syscall.PROT_WRITE != 0 {
+		return func() error { return nil }, nil
+	}
+	if err := syscall.Mprotect(page, prot|syscall.PROT_WRITE); err != nil {
+		return nil, fmt.Errorf("Mprotect failed for patch site at pc %#x: %v", pc, err)
+	}
+	return func() error {
+		if err := syscall.Mprotect(page, prot); err != nil {
+			return fmt.Errorf("Mprotect failed to restore the protection for patch site at pc %#x: %v", pc, err)
+		}
+		return nil
+	}, nil
+}
+
+// Get the protection of the page at addr from /proc/self/maps. If the protection can not be determined, the page
+// is assumed to be read/execute.
+func pageProtection(addr uintptr) int {
+	prot := syscall.PROT_READ | syscall.PROT_EXEC
+	f, err := os.Open("/proc/self/maps")
+	if err != nil {
+		return prot
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		// e.g. "7f0000000000-7f0000001000 r-xp 00000000 00:00 0":
+		fields := strings.Fields(s.Text())
+		if len(fields) < 2 || len(fields[1]) < 3 {
+			continue
+		}
+		i := strings.IndexByte(fields[0], '-')
+		if i < 0 {
+			continue
+		}
+		lo, err1 := strconv.ParseUint(fields[0][:i], 16, 64)
+		hi, err2 := strconv.ParseUint(fields[0][i+1:], 16, 64)
+		if err1 != nil || err2 != nil || uint64(addr) < lo || uint64(addr) >= hi {
+			continue
+		}
+		prot = syscall.PROT_NONE
+		for j, c := range []byte{'r', 'w', 'x'} {
+			if fields[1][j] == c {
+				prot |= []int{syscall.PROT_READ, syscall.PROT_WRITE, syscall.PROT_EXEC}[j]
+			}
+		}
+		break
+	}
+	return prot
+}
@@ -0,0 +1,119 @@
+package x64
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestCodePatcher(t *testing.T) {
+	mem, err := unix.Mmap(-1, 0, os.Getpagesize(), unix.PROT_READ|unix.PROT_WRITE, unix.MAP_ANON|unix.MAP_PRIVATE)
+	if err != nil {
+		t.Fatalf("sys/unix.Mmap failed: %v", err)
+	}
+
+	defer unix.Munmap(mem)
+
+	asm := NewAssembler(mem)
+	slow := asm.DeclareLabel()
+	asm.Inst(ADD, RAX, Imm8(1))
+	guard := asm.PatchSite(5)
+	asm.Inst(RET)
+	call := asm.PatchJump(8, slow)
+	asm.SetLabel(slow)
+	asm.Inst(RET)
+	if err := asm.Finalize(); err != nil {
+		t.Fatal(err)
+	}
+	if guard.PC != 8 || call.PC != 16 {
+		t.Fatalf("Unexpected patch sites: %+v, %+v", guard, call)
+	}
+	if got := fmt.Sprintf("%#x", asm.Code()[8:]); got != "0x0f1f440000c36690e9030000000f1f00c3" {
+		t.Fatalf("encoded = %s", got)
+	}
+
+	if err := unix.Mprotect(mem, unix.PROT_READ|unix.PROT_EXEC); err != nil {
+		t.Fatalf("sys/unix.Mprotect failed: %v", err)
+	}
+
+	p := CodePatcher{Exec: mem}
+	if err := p.Patch(guard, uintptr(unsafe.Pointer(&mem[24]))); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Unpatch(call); err != nil {
+		t.Fatal(err)
+	}
+	if got := fmt.Sprintf("%#x", mem[8:25]); got != "0xe90b000000c366900f1f840000000000c3" {
+		t.Fatalf("patched = %s", got)
+	}
+	if err := p.Patch(PatchSite{PC: 9, Len: 5}, 0); err == nil {
+		t.Fatal("Expected an error for an unaligned patch site")
+	}
+	// a 5-byte site must be followed by 3 bytes of code for the 8-byte store:
+	tail := CodePatcher{Exec: mem[:guard.PC+7]}
+	if err := tail.Unpatch(guard); err == nil || !strings.Contains(err.Error(), "followed by 3 bytes") {
+		t.Fatalf("Expected an error for a patch site at the end of the code, found %v", err)
+	}
+	if err := (&CodePatcher{Exec: mem[:guard.PC+4]}).Unpatch(guard); err == nil || !strings.Contains(err.Error(), "out of range") {
+		t.Fatalf("Expected a range error for a truncated patch site, found %v", err)
+	}
+
+}
+
+func TestCodePatcherProtection(t *testing.T) {
+	pageSize := os.Getpagesize()
+	mem, err := unix.Mmap(-1, 0, 2*pageSize, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_ANON|unix.MAP_PRIVATE)
+	if err != nil {
+		t.Fatalf("sys/unix.Mmap failed: %v", err)
+	}
+
+	defer unix.Munmap(mem)
+
+	// the code begins within the first page, and the slot is within the second page:
+	code := mem[pageSize-64:]
+	asm := NewAssembler(code)
+	asm.Inst(RET)
+	first := asm.PatchSite(5)
+	asm.RawZeros(int(64 - asm.PC()))
+	second := asm.PatchSite(8)
+	asm.Inst(RET)
+	if err := asm.Finalize(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := unix.Mprotect(mem, unix.PROT_READ|unix.PROT_EXEC); err != nil {
+		t.Fatalf("sys/unix.Mprotect failed: %v", err)
+	}
+
+	p := CodePatcher{Exec: code}
+	if err := p.Patch(first, uintptr(unsafe.Pointer(&code[0]))); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Patch(second, uintptr(unsafe.Pointer(&code[0]))); err != nil {
+		t.Fatal(err)
+	}
+	if code[first.PC] != 0xe9 || code[second.PC] != 0xe9 {
+		t.Fatalf("Unexpected patched code: % x", code[:second.PC+8])
+	}
+
+	// the original protection of each page is restored after patching:
+	for _, addr := range []uintptr{uintptr(unsafe.Pointer(&mem[0])), uintptr(unsafe.Pointer(&mem[pageSize]))} {
+		if prot := pageProtection(addr); prot != unix.PROT_READ|unix.PROT_EXEC {
+			t.Fatalf("Unexpected protection after patching: %#x", prot)
+		}
+	}
+	rwx := unix.PROT_READ | unix.PROT_WRITE | unix.PROT_EXEC
+	if err := unix.Mprotect(mem, rwx); err != nil {
+		t.Fatalf("sys/unix.Mprotect failed: %v", err)
+	}
+	if err := p.Unpatch(first); err != nil {
+		t.Fatal(err)
+	}
+	if prot := pageProtection(uintptr(unsafe.Pointer(&mem[0]))); prot != rwx {
+		t.Fatalf("Unexpected protection after patching: %#x", prot)
+	}
+}
@@ -0,0 +1,11 @@
+// +build !linux
+
+package x64
+
+import "fmt"
+
+// Pages are only made writable on linux, where the original protection of a page can be read from
+// /proc/self/maps and restored after patching.
+func unprotectPage(code []byte, pc int) (restore func() error, err error) {
+	return nil, fmt.Errorf("Changing page protection is not supported; a writable mapping must be provided for patching")
+}
@@ -0,0 +1,160 @@
+package perf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"unsafe"
+)
+
+// See tools/perf/Documentation/jitdump-specification.txt within the Linux source tree.
+const (
+	jitdumpMagic   = 0x4A695444
+	jitdumpVersion = 1
+	jitHeaderSize  = 40
+	emX86_64       = 62
+
+	jitCodeLoad      = 0
+	jitCodeDebugInfo = 2
+	jitCodeClose     = 3
+)
+
+// JitDump writes functions in the jitdump format, which is read by `perf inject --jit` from jit-<pid>.dump.
+// Profiles must be recorded with `perf record -k mono` for timestamps to be correlated.
+type JitDump struct {
+	mu     sync.Mutex
+	w      io.Writer
+	f      *os.File
+	marker []byte // the mapping of the file, which identifies the dump to perf
+	pid    uint32
+	index  uint64
+	buf    bytes.Buffer
+}
+
+// Create jit-<pid>.dump for the current process within dir (or os.TempDir, if dir is empty), and write
+// the jitdump header. The file will be mapped into memory, so perf can find the dump.
+func OpenJitDump(dir string) (*JitDump, error) {
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	f, err := os.OpenFile(filepath.Join(dir, fmt.Sprintf("jit-%d.dump", os.Getpid())), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return nil, err
+	}
+	d, err := NewJitDump(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	d.f = f
+	if d.marker, err = mapMarker(f); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return d, nil
+}
+
+// Create a JitDump which writes to w, and write the jitdump header.
+func NewJitDump(w io.Writer) (*JitDump, error) {
+	d := &JitDump{w: w, pid: uint32(os.Getpid())}
+	var h [jitHeaderSize]byte
+	le := binary.LittleEndian
+	le.PutUint32(h[0:], jitdumpMagic)
+	le.PutUint32(h[4:], jitdumpVersion)
+	le.PutUint32(h[8:], jitHeaderSize)
+	le.PutUint32(h[12:], emX86_64)
+	le.PutUint32(h[20:], d.pid)
+	le.PutUint64(h[24:], timestamp())
+	if _, err := w.Write(h[:]); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Record a function, with optional line information. The debug-info record for the lines will precede the
+// code-load record for the function.
+func (d *JitDump) WriteCode(name string, code []byte, lines []Line) error {
+	if len(code) == 0 {
+		return nil
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	addr := uint64(codeAddr(code))
+	b := &d.buf
+	if len(lines) > 0 {
+		b.Reset()
+		d.recordHeader(jitCodeDebugInfo)
+		d.put64(addr)
+		d.put64(uint64(len(lines)))
+		for _, l := range lines {
+			d.put64(addr + uint64(l.Offset))
+			d.put32(l.Line)
+			d.put32(0) // discriminator
+			b.WriteString(l.File)
+			b.WriteByte(0)
+		}
+		if err := d.flushRecord(); err != nil {
+			return err
+		}
+	}
+	b.Reset()
+	d.recordHeader(jitCodeLoad)
+	d.put32(d.pid)
+	d.put32(uint32(gettid()))
+	d.put64(addr) // vma
+	d.put64(addr)
+	d.put64(uint64(len(code)))
+	d.put64(d.index)
+	b.WriteString(name)
+	b.WriteByte(0)
+	b.Write(code)
+	d.index++
+	return d.flushRecord()
+}
+
+// Write the close record, and close the underlying file if the JitDump was opened with OpenJitDump.
+func (d *JitDump) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.buf.Reset()
+	d.recordHeader(jitCodeClose)
+	err := d.flushRecord()
+	if d.f != nil {
+		unmapMarker(d.marker)
+		if e := d.f.Close(); err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+func (d *JitDump) recordHeader(id uint32) {
+	d.put32(id)
+	d.put32(0) // total size, patched by flushRecord
+	d.put64(timestamp())
+}
+
+func (d *JitDump) flushRecord() error {
+	rec := d.buf.Bytes()
+	binary.LittleEndian.PutUint32(rec[4:], uint32(len(rec)))
+	_, err := d.w.Write(rec)
+	return err
+}
+
+func (d *JitDump) put32(v uint32) {
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], v)
+	d.buf.Write(b[:])
+}
+
+func (d *JitDump) put64(v uint64) {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], v)
+	d.buf.Write(b[:])
+}
+
+func codeAddr(code []byte) uintptr { return uintptr(unsafe.Pointer(&code[0])) }
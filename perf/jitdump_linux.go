@@ -0,0 +1,27 @@
+package perf
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// Get the current time from CLOCK_MONOTONIC, for correlation with `perf record -k mono`.
+func timestamp() uint64 {
+	var ts syscall.Timespec
+	syscall.Syscall(syscall.SYS_CLOCK_GETTIME, 1, uintptr(unsafe.Pointer(&ts)), 0)
+	return uint64(ts.Nano())
+}
+
+func gettid() int { return syscall.Gettid() }
+
+// Map the first page of the dump as executable. perf records the mapping, which identifies the dump file.
+func mapMarker(f *os.File) ([]byte, error) {
+	return syscall.Mmap(int(f.Fd()), 0, os.Getpagesize(), syscall.PROT_READ|syscall.PROT_EXEC, syscall.MAP_PRIVATE)
+}
+
+func unmapMarker(b []byte) {
+	if b != nil {
+		syscall.Munmap(b)
+	}
+}
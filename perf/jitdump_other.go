@@ -0,0 +1,16 @@
+// +build !linux
+
+package perf
+
+import (
+	"os"
+	"time"
+)
+
+func timestamp() uint64 { return uint64(time.Now().UnixNano()) }
+
+func gettid() int { return os.Getpid() }
+
+func mapMarker(f *os.File) ([]byte, error) { return nil, nil }
+
+func unmapMarker(b []byte) {}
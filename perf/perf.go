@@ -0,0 +1,92 @@
+// Package perf provides perf map and jitdump output for profiling code which has been placed in executable
+// memory.
+package perf
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/wdamron/x64"
+)
+
+// Line maps an offset within a function's code to a source or listing line.
+type Line struct {
+	// The offset of the first instruction for the line, relative to the start of the function
+	Offset uint32
+	File   string
+	Line   uint32
+}
+
+// Writer records functions which have been placed in executable memory.
+type Writer interface {
+	// Record a function, where code is the function's executable memory. Line information is optional.
+	WriteCode(name string, code []byte, lines []Line) error
+}
+
+var _ Writer = (*PerfMap)(nil)
+var _ Writer = (*JitDump)(nil)
+
+// Register w as a code hook (see x64.RegisterCodeHook), so all code which is placed in executable memory with
+// Assembler.SetFunctionCode will be recorded, with the assembler's name (see Assembler.SetName). Unnamed code
+// is recorded as "x64_<address>". The returned function removes the hook, and should be called before w is
+// closed.
+func Register(w Writer) (unregister func()) {
+	return x64.RegisterCodeHook(func(name string, code []byte) error {
+		if name == "" && len(code) > 0 {
+			name = fmt.Sprintf("x64_%x", codeAddr(code))
+		}
+		return w.WriteCode(name, code, nil)
+	})
+}
+
+// Record all functions within a linked image, which must have been placed in executable memory.
+func WriteImage(w Writer, img *x64.Image) error {
+	for _, f := range img.Functions() {
+		if err := w.WriteCode(f.Name, img.Code[f.Offset:int(f.Offset)+f.Len], nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PerfMap writes symbols in the perf map format ("START SIZE NAME" per line, in hex), which is read by perf
+// from /tmp/perf-<pid>.map.
+type PerfMap struct {
+	mu sync.Mutex
+	w  io.Writer
+	c  io.Closer
+}
+
+// Open /tmp/perf-<pid>.map for the current process, appending to the file if it exists.
+func OpenPerfMap() (*PerfMap, error) {
+	f, err := os.OpenFile(fmt.Sprintf("/tmp/perf-%d.map", os.Getpid()), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &PerfMap{w: f, c: f}, nil
+}
+
+// Create a PerfMap which writes to w.
+func NewPerfMap(w io.Writer) *PerfMap { return &PerfMap{w: w} }
+
+// Record a function. Line information is not supported by the perf map format, and will be ignored.
+func (m *PerfMap) WriteCode(name string, code []byte, lines []Line) error {
+	if len(code) == 0 {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, err := fmt.Fprintf(m.w, "%x %x %s\n", codeAddr(code), len(code), strings.Replace(name, "\n", " ", -1))
+	return err
+}
+
+// Close the underlying file, if the PerfMap was opened with OpenPerfMap.
+func (m *PerfMap) Close() error {
+	if m.c == nil {
+		return nil
+	}
+	return m.c.Close()
+}
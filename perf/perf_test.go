@@ -0,0 +1,140 @@
+package perf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/wdamron/x64"
+	"golang.org/x/sys/unix"
+)
+
+// Encode a function with the assembler, and place it in executable memory with Assembler.SetFunctionCode.
+func placeFunc(t *testing.T, a *x64.Assembler) []byte {
+	mem, err := unix.Mmap(-1, 0, os.Getpagesize(), unix.PROT_READ|unix.PROT_WRITE, unix.MAP_ANON|unix.MAP_PRIVATE)
+	if err != nil {
+		t.Fatalf("sys/unix.Mmap failed: %v", err)
+	}
+	t.Cleanup(func() { unix.Munmap(mem) })
+	code := a.Code()
+	copy(mem, code)
+	if err := unix.Mprotect(mem, unix.PROT_READ|unix.PROT_EXEC); err != nil {
+		t.Fatalf("sys/unix.Mprotect failed: %v", err)
+	}
+	var f func()
+	if err := a.SetFunctionCode(&f, mem); err != nil {
+		t.Fatal(err)
+	}
+	return mem[:len(code)]
+}
+
+func TestPerfMap(t *testing.T) {
+	a := x64.NewAssembler(nil)
+	a.Inst(x64.RET)
+	l := x64.NewLinker()
+	if err := l.Add("filter", a); err != nil {
+		t.Fatal(err)
+	}
+	img, err := l.Link(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := WriteImage(NewPerfMap(&buf), img); err != nil {
+		t.Fatal(err)
+	}
+	var addr uintptr
+	var size int
+	var name string
+	if _, err := fmt.Sscanf(buf.String(), "%x %x %s\n", &addr, &size, &name); err != nil {
+		t.Fatal(err)
+	}
+	if addr != img.Addr("filter") || size != 1 || name != "filter" {
+		t.Fatalf("Unexpected perf map entry: %q", buf.String())
+	}
+
+	// functions placed in executable memory are recorded while the perf map is registered:
+	buf.Reset()
+	unregister := Register(NewPerfMap(&buf))
+	a.Reset(nil)
+	a.SetName("filter2")
+	a.Inst(x64.ADD, x64.RAX, x64.RBX)
+	a.Inst(x64.RET)
+	code := placeFunc(t, a)
+	a.SetName("")
+	unnamed := placeFunc(t, a)
+	unregister()
+	placeFunc(t, a)
+	expect := fmt.Sprintf("%x 4 filter2\n%x 4 x64_%x\n", codeAddr(code), codeAddr(unnamed), codeAddr(unnamed))
+	if buf.String() != expect {
+		t.Fatalf("Unexpected perf map entries: %q != %q", buf.String(), expect)
+	}
+}
+
+// Code for TestJitDump, which is not allocated on the stack, so its address does not change.
+var addCode = []byte{0x48, 0x01, 0xd8, 0xc3}
+
+func TestJitDump(t *testing.T) {
+	dir := t.TempDir()
+	d, err := OpenJitDump(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := addCode
+	if err := d.WriteCode("add", code, []Line{{Offset: 0, File: "add.s", Line: 1}, {Offset: 3, File: "add.s", Line: 2}}); err != nil {
+		t.Fatal(err)
+	}
+	unregister := Register(d)
+	a := x64.NewAssembler(nil)
+	a.SetName("sub")
+	a.Inst(x64.SUB, x64.RAX, x64.RBX)
+	a.Inst(x64.RET)
+	placed := placeFunc(t, a)
+	unregister()
+	if err := d.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := os.ReadFile(filepath.Join(dir, fmt.Sprintf("jit-%d.dump", os.Getpid())))
+	if err != nil {
+		t.Fatal(err)
+	}
+	le := binary.LittleEndian
+	if le.Uint32(b) != jitdumpMagic || le.Uint32(b[4:]) != 1 || le.Uint32(b[8:]) != jitHeaderSize || le.Uint32(b[12:]) != emX86_64 || le.Uint32(b[20:]) != uint32(os.Getpid()) {
+		t.Fatalf("Unexpected header: %x", b[:jitHeaderSize])
+	}
+	var ids []uint32
+	for rec := b[jitHeaderSize:]; len(rec) > 0; {
+		id, size := le.Uint32(rec), le.Uint32(rec[4:])
+		body := rec[16:size]
+		switch id {
+		case jitCodeDebugInfo:
+			if le.Uint64(body) != uint64(codeAddr(code)) || le.Uint64(body[8:]) != 2 {
+				t.Fatalf("Unexpected debug info: %x", body)
+			}
+			if le.Uint64(body[38:]) != uint64(codeAddr(code))+3 || le.Uint32(body[46:]) != 2 || string(body[54:59]) != "add.s" {
+				t.Fatalf("Unexpected line entry: %x", body)
+			}
+		case jitCodeLoad:
+			index := le.Uint64(body[32:])
+			expectCode, expectName := code, "add"
+			if index == 1 {
+				expectCode, expectName = placed, "sub"
+			}
+			if le.Uint64(body[16:]) != uint64(codeAddr(expectCode)) || le.Uint64(body[24:]) != 4 || index > 1 {
+				t.Fatalf("Unexpected code load: %x", body)
+			}
+			if !bytes.Equal(body[40:], append([]byte(expectName+"\x00"), expectCode...)) {
+				t.Fatalf("Unexpected name and code: %q", body[40:])
+			}
+		}
+		ids = append(ids, id)
+		rec = rec[size:]
+	}
+	if fmt.Sprint(ids) != "[2 0 0 3]" {
+		t.Fatalf("Unexpected records: %v", ids)
+	}
+}
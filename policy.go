@@ -0,0 +1,184 @@
+package x64
+
+import "math"
+
+// EncodingPolicy controls how an encoding is selected when multiple encodings match an instruction's arguments.
+type EncodingPolicy uint8
+
+const (
+	// Select the first matching encoding, in the order of Inst.Encodings. Immediates and displacements are
+	// encoded with the sizes of their argument types. This is the default policy.
+	FirstMatch EncodingPolicy = iota
+	// Select the shortest matching encoding. Immediates and memory displacements will be narrowed to smaller
+	// sizes if their values allow (immediates which fit the operation size as unsigned values are also tried
+	// sign-extended from the operation size, e.g. AND EAX, 0xffffff80 as an 8-bit -128), zero displacements will
+	// be dropped if the base register allows, and MOV with a 64-bit register and an immediate which fits within
+	// 32 bits (unsigned) will be encoded as a 32-bit MOV, which zero-extends the result. Relative displacements
+	// for branches are never narrowed, as their targets depend on the length of the instruction.
+	Shortest
+)
+
+// Get the policy for selecting among matching encodings.
+func (m *InstMatcher) EncodingPolicy() EncodingPolicy { return m.policy }
+
+// Set the policy for selecting among matching encodings.
+func (m *InstMatcher) SetEncodingPolicy(policy EncodingPolicy) { m.policy = policy }
+
+// Get the policy for selecting among matching encodings.
+func (a *Assembler) EncodingPolicy() EncodingPolicy { return a.policy }
+
+// Set the policy for selecting among matching encodings. This will not affect instructions which have
+// already been encoded.
+func (a *Assembler) SetEncodingPolicy(policy EncodingPolicy) {
+	a.policy, a.match.policy = policy, policy
+}
+
+var immWidths = [4]uint8{1, 2, 4, 8}
+
+// Get the index within immWidths of the smallest width which can represent the sign-extended value.
+func minImmWidth(v int64) int {
+	switch {
+	case v >= math.MinInt8 && v <= math.MaxInt8:
+		return 0
+	case v >= math.MinInt16 && v <= math.MaxInt16:
+		return 1
+	case v >= math.MinInt32 && v <= math.MaxInt32:
+		return 2
+	}
+	return 3
+}
+
+func immWithWidth(v int64, width uint8) ImmArg {
+	switch width {
+	case 1:
+		return Imm8(v)
+	case 2:
+		return Imm16(v)
+	case 4:
+		return Imm32(v)
+	}
+	return Imm64(v)
+}
+
+// Narrow a memory displacement (without a label reference) to 8 bits if the value allows, or drop a zero
+// displacement if the memory argument has a base register other than RIP.
+func narrowDisp(mem Mem, disp operand) operand {
+	if disp.kind == opRel && disp.val == 0 && mem.Base != 0 && mem.Base != RIP {
+		return operand{}
+	}
+	if disp.kind == opRel && disp.val >= math.MinInt8 && disp.val <= math.MaxInt8 {
+		disp.w = 1
+	}
+	return disp
+}
+
+// Try all matching encodings, with all sizes of immediates which can represent their values, and select the
+// shortest encoding.
+func (m *InstMatcher) matchShortest(start uint16) error {
+	inst, argc := m.inst, len(m.args)
+	args, memOffset, mem, disp := m._args, m.memOffset, m.mem, m.disp
+	if memOffset >= 0 {
+		disp = narrowDisp(mem, disp)
+	}
+
+	var best InstMatcher
+	bestLen := -1
+	err := ErrNoMatch
+
+	// if opSize is non-zero, only encodings with the given operation size are selected (encodings without
+	// wildcard sizes have a negative operation size, and are sized by their register or memory operands):
+	try := func(args *[4]operand, opSize int) {
+		count := uint16(inst.count())
+		for offset := start; offset < count; {
+			m.reset()
+			m.inst, m._args, m.memOffset, m.mem, m.disp = inst, *args, memOffset, mem, disp
+			m.args = m._args[:argc]
+			if e := m.matchFrom(offset); e != nil {
+				if bestLen < 0 {
+					err = e
+				}
+				return
+			}
+			offset = uint16(m.enc.offset()) + 1
+			size := m.opSize
+			if size < 0 {
+				size = int(m.argSize())
+			}
+			if opSize != 0 && size != opSize {
+				continue
+			}
+			if n, e := m.EncodedLen(); e == nil && (bestLen < 0 || n < bestLen) {
+				best, bestLen = *m, n
+			}
+		}
+	}
+
+	var variant [4]operand
+	tryWidths := func(args *[4]operand, opSize int) {
+		// min/max width for each immediate, as indexes into immWidths:
+		var lo, hi, cur [4]int
+		for i := 0; i < argc; i++ {
+			lo[i], hi[i] = -1, -1
+			if imm := args[i]; imm.kind == opImm {
+				lo[i] = minImmWidth(imm.val)
+				for hi[i] = 3; hi[i] > lo[i] && immWidths[hi[i]] > imm.w; hi[i]-- {
+				}
+			}
+		}
+		for {
+			for i := 0; i < argc; i++ {
+				variant[i] = args[i]
+				if lo[i] >= 0 {
+					variant[i].w = immWidths[lo[i]+cur[i]]
+				}
+			}
+			try(&variant, opSize)
+			// advance to the next combination of immediate sizes:
+			i := 0
+			for ; i < argc; i++ {
+				if lo[i] >= 0 && lo[i]+cur[i] < hi[i] {
+					cur[i]++
+					break
+				}
+				cur[i] = 0
+			}
+			if i == argc {
+				break
+			}
+		}
+	}
+	tryWidths(&args, 0)
+
+	// immediates which only fit 8/16/32-bit operations as unsigned values may be sign-extended from the
+	// operation size, e.g. AND EAX, 0xffffff80 => AND EAX, imm8 -128:
+	for _, opSize := range [3]int{1, 2, 4} {
+		signed, changed := args, false
+		for i := 0; i < argc; i++ {
+			if v, bits := args[i].val, uint(opSize)*8; args[i].kind == opImm && v >= 1<<(bits-1) && v < 1<<bits {
+				signed[i].val, changed = v<<(64-bits)>>(64-bits), true
+			}
+		}
+		if changed {
+			tryWidths(&signed, opSize)
+		}
+	}
+
+	// MOV r64, imm => MOV r32, imm32 (zero-extended)
+	if inst == MOV && argc == 2 {
+		r, imm := args[0].reg, args[1]
+		if args[0].kind == opReg && imm.kind == opImm && r.Family() == REG_LEGACY && r.width() == 8 && imm.val >= 0 && imm.val <= math.MaxUint32 {
+			variant[0] = operand{kind: opReg, reg: resizeReg(r, 4)}
+			variant[1] = operand{kind: opImm, val: int64(int32(uint32(imm.val))), w: 4}
+			try(&variant, 0)
+		}
+	}
+
+	if bestLen < 0 {
+		m.reset()
+		return err
+	}
+	*m = best
+	m.args = m._args[:argc]
+	m.imms = m._imms[:len(best.imms)]
+	return nil
+}
@@ -0,0 +1,188 @@
+// Package reg defines typed register constants for the generated instruction methods of x64.Assembler:
+//
+//	asm.ADD_R64_R64(reg.RAX, reg.RBX)
+//	asm.VPADDD_Y_Y_Y(reg.Y0, reg.Y1, reg.Y2)
+package reg
+
+import (
+	"github.com/wdamron/x64"
+)
+
+// Registers
+const (
+	// 8-bit
+	AH   = x64.Reg8(x64.AH)
+	CH   = x64.Reg8(x64.CH)
+	DH   = x64.Reg8(x64.DH)
+	BH   = x64.Reg8(x64.BH)
+	AL   = x64.Reg8(x64.AL)
+	CL   = x64.Reg8(x64.CL)
+	DL   = x64.Reg8(x64.DL)
+	BL   = x64.Reg8(x64.BL)
+	SPB  = x64.Reg8(x64.SPB)
+	BPB  = x64.Reg8(x64.BPB)
+	SIB  = x64.Reg8(x64.SIB)
+	DIB  = x64.Reg8(x64.DIB)
+	R8B  = x64.Reg8(x64.R8B)
+	R9B  = x64.Reg8(x64.R9B)
+	R10B = x64.Reg8(x64.R10B)
+	R11B = x64.Reg8(x64.R11B)
+	R12B = x64.Reg8(x64.R12B)
+	R13B = x64.Reg8(x64.R13B)
+	R14B = x64.Reg8(x64.R14B)
+	R15B = x64.Reg8(x64.R15B)
+
+	// 16-bit
+	AX   = x64.Reg16(x64.AX)
+	CX   = x64.Reg16(x64.CX)
+	DX   = x64.Reg16(x64.DX)
+	BX   = x64.Reg16(x64.BX)
+	SP   = x64.Reg16(x64.SP)
+	BP   = x64.Reg16(x64.BP)
+	SI   = x64.Reg16(x64.SI)
+	DI   = x64.Reg16(x64.DI)
+	R8W  = x64.Reg16(x64.R8W)
+	R9W  = x64.Reg16(x64.R9W)
+	R10W = x64.Reg16(x64.R10W)
+	R11W = x64.Reg16(x64.R11W)
+	R12W = x64.Reg16(x64.R12W)
+	R13W = x64.Reg16(x64.R13W)
+	R14W = x64.Reg16(x64.R14W)
+	R15W = x64.Reg16(x64.R15W)
+
+	// 32-bit
+	EAX  = x64.Reg32(x64.EAX)
+	ECX  = x64.Reg32(x64.ECX)
+	EDX  = x64.Reg32(x64.EDX)
+	EBX  = x64.Reg32(x64.EBX)
+	ESP  = x64.Reg32(x64.ESP)
+	EBP  = x64.Reg32(x64.EBP)
+	ESI  = x64.Reg32(x64.ESI)
+	EDI  = x64.Reg32(x64.EDI)
+	R8L  = x64.Reg32(x64.R8L)
+	R9L  = x64.Reg32(x64.R9L)
+	R10L = x64.Reg32(x64.R10L)
+	R11L = x64.Reg32(x64.R11L)
+	R12L = x64.Reg32(x64.R12L)
+	R13L = x64.Reg32(x64.R13L)
+	R14L = x64.Reg32(x64.R14L)
+	R15L = x64.Reg32(x64.R15L)
+
+	// 64-bit
+	RAX = x64.Reg64(x64.RAX)
+	RCX = x64.Reg64(x64.RCX)
+	RDX = x64.Reg64(x64.RDX)
+	RBX = x64.Reg64(x64.RBX)
+	RSP = x64.Reg64(x64.RSP)
+	RBP = x64.Reg64(x64.RBP)
+	RSI = x64.Reg64(x64.RSI)
+	RDI = x64.Reg64(x64.RDI)
+	R8  = x64.Reg64(x64.R8)
+	R9  = x64.Reg64(x64.R9)
+	R10 = x64.Reg64(x64.R10)
+	R11 = x64.Reg64(x64.R11)
+	R12 = x64.Reg64(x64.R12)
+	R13 = x64.Reg64(x64.R13)
+	R14 = x64.Reg64(x64.R14)
+	R15 = x64.Reg64(x64.R15)
+
+	// 387 floating point registers.
+	F0 = x64.FPReg(x64.F0)
+	F1 = x64.FPReg(x64.F1)
+	F2 = x64.FPReg(x64.F2)
+	F3 = x64.FPReg(x64.F3)
+	F4 = x64.FPReg(x64.F4)
+	F5 = x64.FPReg(x64.F5)
+	F6 = x64.FPReg(x64.F6)
+	F7 = x64.FPReg(x64.F7)
+
+	// MMX registers.
+	M0 = x64.MMXReg(x64.M0)
+	M1 = x64.MMXReg(x64.M1)
+	M2 = x64.MMXReg(x64.M2)
+	M3 = x64.MMXReg(x64.M3)
+	M4 = x64.MMXReg(x64.M4)
+	M5 = x64.MMXReg(x64.M5)
+	M6 = x64.MMXReg(x64.M6)
+	M7 = x64.MMXReg(x64.M7)
+
+	// XMM registers.
+	X0  = x64.XMMReg(x64.X0)
+	X1  = x64.XMMReg(x64.X1)
+	X2  = x64.XMMReg(x64.X2)
+	X3  = x64.XMMReg(x64.X3)
+	X4  = x64.XMMReg(x64.X4)
+	X5  = x64.XMMReg(x64.X5)
+	X6  = x64.XMMReg(x64.X6)
+	X7  = x64.XMMReg(x64.X7)
+	X8  = x64.XMMReg(x64.X8)
+	X9  = x64.XMMReg(x64.X9)
+	X10 = x64.XMMReg(x64.X10)
+	X11 = x64.XMMReg(x64.X11)
+	X12 = x64.XMMReg(x64.X12)
+	X13 = x64.XMMReg(x64.X13)
+	X14 = x64.XMMReg(x64.X14)
+	X15 = x64.XMMReg(x64.X15)
+
+	// YMM registers.
+	Y0  = x64.YMMReg(x64.Y0)
+	Y1  = x64.YMMReg(x64.Y1)
+	Y2  = x64.YMMReg(x64.Y2)
+	Y3  = x64.YMMReg(x64.Y3)
+	Y4  = x64.YMMReg(x64.Y4)
+	Y5  = x64.YMMReg(x64.Y5)
+	Y6  = x64.YMMReg(x64.Y6)
+	Y7  = x64.YMMReg(x64.Y7)
+	Y8  = x64.YMMReg(x64.Y8)
+	Y9  = x64.YMMReg(x64.Y9)
+	Y10 = x64.YMMReg(x64.Y10)
+	Y11 = x64.YMMReg(x64.Y11)
+	Y12 = x64.YMMReg(x64.Y12)
+	Y13 = x64.YMMReg(x64.Y13)
+	Y14 = x64.YMMReg(x64.Y14)
+	Y15 = x64.YMMReg(x64.Y15)
+
+	// Segment registers.
+	ES = x64.SegReg(x64.ES)
+	CS = x64.SegReg(x64.CS)
+	SS = x64.SegReg(x64.SS)
+	DS = x64.SegReg(x64.DS)
+	FS = x64.SegReg(x64.FS)
+	GS = x64.SegReg(x64.GS)
+
+	// Control registers.
+	CR0  = x64.ControlReg(x64.CR0)
+	CR1  = x64.ControlReg(x64.CR1)
+	CR2  = x64.ControlReg(x64.CR2)
+	CR3  = x64.ControlReg(x64.CR3)
+	CR4  = x64.ControlReg(x64.CR4)
+	CR5  = x64.ControlReg(x64.CR5)
+	CR6  = x64.ControlReg(x64.CR6)
+	CR7  = x64.ControlReg(x64.CR7)
+	CR8  = x64.ControlReg(x64.CR8)
+	CR9  = x64.ControlReg(x64.CR9)
+	CR10 = x64.ControlReg(x64.CR10)
+	CR11 = x64.ControlReg(x64.CR11)
+	CR12 = x64.ControlReg(x64.CR12)
+	CR13 = x64.ControlReg(x64.CR13)
+	CR14 = x64.ControlReg(x64.CR14)
+	CR15 = x64.ControlReg(x64.CR15)
+
+	// Debug registers.
+	DR0  = x64.DebugReg(x64.DR0)
+	DR1  = x64.DebugReg(x64.DR1)
+	DR2  = x64.DebugReg(x64.DR2)
+	DR3  = x64.DebugReg(x64.DR3)
+	DR4  = x64.DebugReg(x64.DR4)
+	DR5  = x64.DebugReg(x64.DR5)
+	DR6  = x64.DebugReg(x64.DR6)
+	DR7  = x64.DebugReg(x64.DR7)
+	DR8  = x64.DebugReg(x64.DR8)
+	DR9  = x64.DebugReg(x64.DR9)
+	DR10 = x64.DebugReg(x64.DR10)
+	DR11 = x64.DebugReg(x64.DR11)
+	DR12 = x64.DebugReg(x64.DR12)
+	DR13 = x64.DebugReg(x64.DR13)
+	DR14 = x64.DebugReg(x64.DR14)
+	DR15 = x64.DebugReg(x64.DR15)
+)
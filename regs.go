@@ -1,5 +1,7 @@
 package x64
 
+import "strconv"
+
 // Register families
 const (
 	REG_LEGACY   = iota
@@ -197,3 +199,64 @@ const (
 	DR14 Reg = Reg(4<<16 | REG_DEBUG<<8 | 14)
 	DR15 Reg = Reg(4<<16 | REG_DEBUG<<8 | 15)
 )
+
+var legacyRegNames = [4][16]string{
+	{"al", "cl", "dl", "bl", "spl", "bpl", "sil", "dil", "r8b", "r9b", "r10b", "r11b", "r12b", "r13b", "r14b", "r15b"},
+	{"ax", "cx", "dx", "bx", "sp", "bp", "si", "di", "r8w", "r9w", "r10w", "r11w", "r12w", "r13w", "r14w", "r15w"},
+	{"eax", "ecx", "edx", "ebx", "esp", "ebp", "esi", "edi", "r8d", "r9d", "r10d", "r11d", "r12d", "r13d", "r14d", "r15d"},
+	{"rax", "rcx", "rdx", "rbx", "rsp", "rbp", "rsi", "rdi", "r8", "r9", "r10", "r11", "r12", "r13", "r14", "r15"},
+}
+
+var segmentRegNames = [6]string{"es", "cs", "ss", "ds", "fs", "gs"}
+
+// Get the lowercase Intel-syntax name of the register, e.g. "rax", "r8d", "xmm3" or "st(1)".
+func (r Reg) String() string {
+	num := r.Num()
+	switch r.Family() {
+	case REG_LEGACY:
+		switch r.width() {
+		case 1:
+			return legacyRegNames[0][num]
+		case 2:
+			return legacyRegNames[1][num]
+		case 4:
+			return legacyRegNames[2][num]
+		case 8:
+			return legacyRegNames[3][num]
+		}
+	case REG_RIP:
+		switch r.width() {
+		case 2:
+			return "ip"
+		case 4:
+			return "eip"
+		case 8:
+			return "rip"
+		}
+	case REG_HIGHBYTE:
+		if num >= 4 && num <= 7 {
+			return [...]string{"ah", "ch", "dh", "bh"}[num-4]
+		}
+	case REG_FP:
+		if num < 8 {
+			return "st(" + strconv.Itoa(int(num)) + ")"
+		}
+	case REG_MMX:
+		if num < 8 {
+			return "mm" + strconv.Itoa(int(num))
+		}
+	case REG_XMM:
+		return "xmm" + strconv.Itoa(int(num))
+	case REG_YMM:
+		return "ymm" + strconv.Itoa(int(num))
+	case REG_SEGMENT:
+		if num < 6 {
+			return segmentRegNames[num]
+		}
+	case REG_CONTROL:
+		return "cr" + strconv.Itoa(int(num))
+	case REG_DEBUG:
+		return "dr" + strconv.Itoa(int(num))
+	}
+	return "Reg(" + strconv.Itoa(int(r)) + ")"
+}
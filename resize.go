@@ -1,7 +1,5 @@
 package x64
 
-import "fmt"
-
 // Resize all arguments to match the arg-pattern for the matched encoding
 func (matcher *InstMatcher) resizeArgs() (int8, error) {
 	argp := matcher.argp
@@ -13,51 +11,50 @@ func (matcher *InstMatcher) resizeArgs() (int8, error) {
 	opSize := int8(-1)
 	immSize := int8(-1)
 
-	// scan arg-pattern:
+	// scan arg-pattern; operands with fixed sizes (e.g. the CL count of SHL, or the byte source of CRC32) were
+	// checked by the matcher, and do not determine the operation size:
 	for pi, ai := 0, 0; pi+1 < plen && ai < argc; pi, ai = pi+2, ai+1 {
-		arg := args[ai]
+		arg, wildcard := args[ai], argp[pi+1] == '0'
 
-		switch v := arg.(type) {
-		case Reg:
+		switch arg.kind {
+		case opReg:
+			if !wildcard {
+				break
+			}
 			hasArg = true
-			width := int8(v.width())
+			width := int8(arg.reg.width())
 			if opSize >= 0 && opSize != width {
-				return -1, fmt.Errorf("Conflicting argument sizes")
+				return -1, matcher.errorf(ReasonOperandSize, ai, "Conflicting argument sizes")
 			}
 			opSize = width
-		case memArgPlaceholder:
-			hasArg = true
+		case opMem:
 			mem := matcher.mem
 			if mem.Index != 0 && (mem.Index.Family() == REG_XMM || mem.Index.Family() == REG_YMM) {
 				mem.Width = mem.Index.Width()
 			}
+			if !wildcard {
+				matcher.mem = mem
+				break
+			}
+			hasArg = true
 			switch inst {
 			case MOVZX, MOVSX, MOVSXD:
 				// use width of register argument
 			default:
 				if opSize >= 0 && opSize != int8(mem.Width) {
-					return -1, fmt.Errorf("Conflicting argument sizes")
+					return -1, matcher.errorf(ReasonOperandSize, ai, "Conflicting argument sizes")
 				}
 				opSize = int8(mem.Width)
 				matcher.mem = mem
 			}
 		default:
-			if imm, ok := arg.(ImmArg); ok {
-				width := int8(imm.width())
-				if immSize >= 0 && immSize != width {
-					return -1, fmt.Errorf("Conflicting argument sizes")
-				}
-				immSize = width
-			} else if rel, ok := arg.(RelArg); ok {
-				width := int8(rel.width())
-				if immSize >= 0 && immSize != width {
-					return -1, fmt.Errorf("Conflicting argument sizes")
-				}
-				immSize = width
-			} else if label, ok := arg.(LabelArg); ok {
-				width := int8(label.width())
+			if !wildcard {
+				break
+			}
+			if arg.isImm() || arg.isDisp() {
+				width := int8(arg.w)
 				if immSize >= 0 && immSize != width {
-					return -1, fmt.Errorf("Conflicting argument sizes")
+					return -1, matcher.errorf(ReasonOperandSize, ai, "Conflicting argument sizes")
 				}
 				immSize = width
 			}
@@ -66,19 +63,18 @@ func (matcher *InstMatcher) resizeArgs() (int8, error) {
 
 	if opSize >= 0 {
 		refImmSize := opSize
-		// if opSize > 4 {
-		// 	refImmSize = 4
-		// }
-		if immSize >= 0 && immSize > refImmSize {
-			return -1, fmt.Errorf("Immediate size mismatch")
+		if opSize > 4 {
+			// immediates for 64-bit operations are limited to 32 bits, and will be sign-extended
+			refImmSize = 4
 		}
+		// wider immediates will be narrowed below if their values allow
 		immSize = refImmSize
 	} else if hasArg {
-		return -1, fmt.Errorf("Unknown operand size")
+		return -1, matcher.errorf(ReasonOperandSize, -1, "Unknown operand size")
 	}
 
 	for pi, ai := 0, 0; pi+1 < plen && ai < argc; pi, ai = pi+2, ai+1 {
-		t, sz, arg := argp[pi], argp[pi+1], args[ai]
+		t, sz := argp[pi], argp[pi+1]
 		size := uint8(0)
 
 		switch {
@@ -91,9 +87,9 @@ func (matcher *InstMatcher) resizeArgs() (int8, error) {
 		case t == 'l' || sz == 'q':
 			size = 8
 		case sz == 'f':
-			size = 10
-		case sz == 'p':
 			size = 6
+		case sz == 'p':
+			size = 10
 		case sz == 'o':
 			size = 16
 		case sz == 'h':
@@ -105,63 +101,63 @@ func (matcher *InstMatcher) resizeArgs() (int8, error) {
 		case sz == '1':
 			size = 1 // placeholder
 		default:
-			return -1, fmt.Errorf("Unexpected arg-pattern combination")
+			return -1, matcher.errorf(ReasonInternal, ai, "Unexpected arg-pattern combination")
 		}
 
-		if imm, ok := arg.(ImmArg); ok {
-			width := imm.width()
-			if width != size {
-				imm64 := imm.Int64()
+		switch arg := &args[ai]; arg.kind {
+		case opHole:
+			if arg.w != size {
+				return -1, matcher.errorf(ReasonRange, ai, "Width of hole %q does not match the %d-bit operand", arg.name, size*8)
+			}
+		case opImm:
+			if arg.w != size {
+				if arg.w > size && !immFits(arg.val, size, opSize) {
+					return -1, matcher.errorf(ReasonRange, ai, "Value %#x exceeds the range of a%s %d-bit immediate", arg.val, extension(int8(size) < opSize), size*8)
+				}
 				switch size {
 				case 1:
-					args[ai] = Imm8(int8(imm64))
+					arg.val, arg.w = int64(int8(arg.val)), size
 				case 2:
-					args[ai] = Imm16(int16(imm64))
+					arg.val, arg.w = int64(int16(arg.val)), size
 				case 4:
-					args[ai] = Imm32(int32(imm64))
+					arg.val, arg.w = int64(int32(arg.val)), size
 				case 8:
-					args[ai] = Imm64(imm64)
+					arg.w = size
 				}
 			}
-		} else if rel, ok := arg.(RelArg); ok {
-			width := rel.width()
-			if width != size {
-				rel32 := rel.Int32()
+		case opRel:
+			if arg.w != size {
+				rel32 := int32(arg.val)
+				if arg.w > size && !immFits(int64(rel32), size, int8(arg.w)) {
+					return -1, matcher.errorf(ReasonRange, ai, "Value %#x exceeds the range of a%s %d-bit displacement", rel32, extension(true), size*8)
+				}
 				switch size {
 				case 1:
-					args[ai] = Rel8(int8(rel32))
+					arg.val, arg.w = int64(int8(rel32)), size
 				case 2:
-					args[ai] = Rel16(int16(rel32))
+					arg.val, arg.w = int64(int16(rel32)), size
 				case 4:
-					args[ai] = Rel32(int32(rel32))
+					arg.w = size
 				case 8:
-					return -1, fmt.Errorf("Unexpected 64-bit displacement")
+					return -1, matcher.errorf(ReasonOperandSize, ai, "Unexpected 64-bit displacement")
 				}
 			}
-		} else if label, ok := arg.(LabelArg); ok {
-			if ld, ok := label.(LabelDisp); ok {
-				width := ld.width()
-				if width != size {
-					switch size {
-					case 1, 2, 4:
-						args[ai] = LabelDisp{labelid: ld.labelid, disp: ld.Int32(), dispsz: size}
-					case 8:
-						return -1, fmt.Errorf("Unexpected 64-bit displacement for label reference")
-					}
+		case opLabelDisp:
+			if arg.w != size {
+				switch size {
+				case 1, 2, 4:
+					arg.w = size
+				case 8:
+					return -1, matcher.errorf(ReasonLabel, ai, "Unexpected 64-bit displacement for label reference")
 				}
-			} else {
-				width := label.width()
-				if width != size {
-					switch size {
-					case 1:
-						args[ai] = Label8(label.label())
-					case 2:
-						args[ai] = Label16(label.label())
-					case 4:
-						args[ai] = Label32(label.label())
-					case 8:
-						return -1, fmt.Errorf("Unexpected 64-bit displacement for label reference")
-					}
+			}
+		case opLabel, opLabelRel:
+			if arg.w != size {
+				switch size {
+				case 1, 2, 4:
+					arg.kind, arg.w = opLabelRel, size
+				case 8:
+					return -1, matcher.errorf(ReasonLabel, ai, "Unexpected 64-bit displacement for label reference")
 				}
 			}
 		}
@@ -169,3 +165,24 @@ func (matcher *InstMatcher) resizeArgs() (int8, error) {
 
 	return opSize, nil
 }
+
+// Check if v can be encoded as an immediate of size bytes for an operation of opSize bytes. Immediates
+// narrower than the operation are sign-extended, so the value must be representable as a signed integer.
+// Otherwise, the value may be represented as either a signed or unsigned integer.
+func immFits(v int64, size uint8, opSize int8) bool {
+	if size >= 8 {
+		return true
+	}
+	bits := uint(size) * 8
+	if int8(size) < opSize {
+		return v >= -1<<(bits-1) && v < 1<<(bits-1)
+	}
+	return v >= -1<<(bits-1) && v < 1<<bits
+}
+
+func extension(signExtended bool) string {
+	if signExtended {
+		return " sign-extended"
+	}
+	return ""
+}
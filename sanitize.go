@@ -13,19 +13,18 @@ func (matcher *InstMatcher) sanitizeMemArg() (addrSize int8, err error) {
 		return
 	}
 	if (mem.Base != 0 && mem.Base.Family() == REG_RIP) || (mem.Index != 0 && mem.Index.Family() == REG_RIP) {
-		if mem.Disp == nil {
-			matcher.mem.Disp = Rel32(0)
-		} else if mem.Disp.width() != 4 {
-			if ld, ok := mem.Disp.(LabelDisp); ok {
-				mem.Disp = LabelDisp{labelid: ld.labelid, disp: ld.Int32(), dispsz: 4}
-			} else if label, ok := mem.Disp.(LabelArg); ok {
-				mem.Disp = Label32(uint16(label.label()))
-			} else {
-				mem.Disp = Rel32(mem.Disp.Int32())
+		disp := &matcher.disp
+		switch {
+		case disp.kind == opNone:
+			*disp = operand{kind: opRel, w: 4}
+		case disp.w != 4 && disp.kind != opHole:
+			if disp.kind == opLabel {
+				disp.kind = opLabelRel
 			}
+			disp.w = 4
 		}
-	} else if mem.Disp != nil {
-		dispsz := mem.Disp.width()
+	} else if matcher.disp.kind != opNone {
+		dispsz := matcher.disp.w
 		if addrSize == 2 {
 			if dispsz != 1 && dispsz != 2 {
 				return addrSize, fmt.Errorf("Only 8/16-bit displacements are allowed with 16-bit addressing")
@@ -0,0 +1,196 @@
+package x64
+
+import "fmt"
+
+// The name of the default section.
+const DefaultSection = "text"
+
+// Sections which are not placed separately are aligned to at least this boundary within the output of Code.
+const sectionAlign = 16
+
+type section struct {
+	name   string
+	b      buffer
+	placed bool  // placed separately (excluded from Code)
+	offset int64 // offset relative to the default section, if placed separately
+}
+
+// Switch to the named section, creating the section if it does not exist. Instructions and data will be
+// encoded to the current section, and each section has an independent PC (see PC). Labels may be referenced
+// from any section.
+//
+// Sections are concatenated in the order they were created, beginning with the default section (see
+// DefaultSection), with each section aligned to 16 bytes or the largest alignment required within the section
+// (e.g. by AlignFill); the resulting code is returned by Code. Sections
+// which are placed separately (see PlaceSection) are excluded from Code, and their contents must be copied
+// from SectionCode.
+func (a *Assembler) Section(name string) {
+	i := a.section(name)
+	if i < 0 || uint8(i) == a.cur {
+		return
+	}
+	a.sections[a.cur].b = a.b
+	a.cur = uint8(i)
+	a.b = a.sections[i].b
+}
+
+// Get the name of the current section.
+func (a *Assembler) CurrentSection() string {
+	if a.sections == nil {
+		return DefaultSection
+	}
+	return a.sections[a.cur].name
+}
+
+// Get the encoded contents of the named section, or nil if the section does not exist.
+func (a *Assembler) SectionCode(name string) []byte {
+	if a.sections == nil {
+		if name == DefaultSection {
+			return a.b.Get()
+		}
+		return nil
+	}
+	if i := a.sectionIndex(name); i >= 0 {
+		return a.sectionBuf(uint8(i)).Get()
+	}
+	return nil
+}
+
+// Place the named section separately, at the given offset relative to the start of the default section
+// (e.g. when the section is mapped at a different address). The section will be excluded from Code, and
+// label references between sections will be resolved relative to the given offset by Finalize.
+func (a *Assembler) PlaceSection(name string, offset int64) {
+	if name == DefaultSection {
+		return
+	}
+	if i := a.section(name); i >= 0 {
+		a.sections[i].placed, a.sections[i].offset = true, offset
+	}
+}
+
+// Get the offset of the named section relative to the start of the default section, or false if the
+// section does not exist.
+func (a *Assembler) SectionOffset(name string) (int64, bool) {
+	if a.sections == nil {
+		return 0, name == DefaultSection
+	}
+	i := a.sectionIndex(name)
+	if i < 0 {
+		return 0, false
+	}
+	return a.sectionBases()[i], true
+}
+
+// Get the alignment required for the start of Code: the largest alignment required within any section which
+// is included in Code (by AlignPC, AlignFill, PatchSite or AppendFragment), and at least 16 if multiple
+// sections have been used. Code must be loaded at an address aligned to this boundary for aligned data and
+// instructions to remain aligned.
+func (a *Assembler) CodeAlign() uint32 {
+	if a.sections == nil {
+		if a.b.align == 0 {
+			return 1
+		}
+		return a.b.align
+	}
+	align := uint32(sectionAlign)
+	for i := range a.sections {
+		if s := a.sectionAlignment(uint8(i)); !a.sections[i].placed && s > align {
+			align = s
+		}
+	}
+	return align
+}
+
+// Get the index of the named section, creating the section if it does not exist. If the section can not
+// be created, the assembler's error will be set and -1 will be returned.
+func (a *Assembler) section(name string) int {
+	if a.sections == nil {
+		if name == DefaultSection {
+			return 0
+		}
+		a.sections = []section{{name: DefaultSection}}
+	}
+	if i := a.sectionIndex(name); i >= 0 {
+		return i
+	}
+	if len(a.sections) > 0xff {
+		if a.err == nil {
+			a.err = &EncodeError{PC: -1, Operand: -1, Reason: ReasonRange, Err: fmt.Errorf("Too many sections: at most 256 are supported")}
+		}
+		return -1
+	}
+	a.sections = append(a.sections, section{name: name, b: buffer{b: make([]byte, 64), sz: 64}})
+	return len(a.sections) - 1
+}
+
+func (a *Assembler) sectionIndex(name string) int {
+	for i := range a.sections {
+		if a.sections[i].name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// Get the buffer for a section. The current section's buffer is always a.b.
+func (a *Assembler) sectionBuf(i uint8) *buffer {
+	if i == a.cur || a.sections == nil {
+		return &a.b
+	}
+	return &a.sections[i].b
+}
+
+// Get the offset of each section relative to the start of the default section, or nil if only the
+// default section has been used.
+func (a *Assembler) sectionBases() []int64 {
+	if a.sections == nil {
+		return nil
+	}
+	bases := make([]int64, len(a.sections))
+	end := int64(0)
+	for i := range a.sections {
+		if a.sections[i].placed {
+			bases[i] = a.sections[i].offset
+			continue
+		}
+		align := int64(a.sectionAlignment(uint8(i)))
+		end = (end + align - 1) &^ (align - 1)
+		bases[i] = end
+		end += int64(a.sectionBuf(uint8(i)).Len())
+	}
+	return bases
+}
+
+// Get the alignment of a section within the output of Code: the largest alignment required within the
+// section (by AlignPC, AlignFill, PatchSite or AppendFragment), and at least sectionAlign.
+func (a *Assembler) sectionAlignment(i uint8) uint32 {
+	if align := a.sectionBuf(i).align; align > sectionAlign {
+		return align
+	}
+	return sectionAlign
+}
+
+// Concatenate all sections which have not been placed separately. Padding between sections is filled
+// with INT3 instructions.
+func (a *Assembler) concatSections() []byte {
+	bases := a.sectionBases()
+	var code []byte
+	for i := range a.sections {
+		if a.sections[i].placed {
+			continue
+		}
+		for int64(len(code)) < bases[i] {
+			code = append(code, 0xcc)
+		}
+		code = append(code, a.sectionBuf(uint8(i)).Get()...)
+	}
+	return code
+}
+
+// Restore the default section's buffer and discard all other sections.
+func (a *Assembler) resetSections() {
+	if a.sections != nil && a.cur != 0 {
+		a.b = a.sections[0].b
+	}
+	a.sections, a.cur = nil, 0
+}
@@ -0,0 +1,140 @@
+package x64
+
+// Typed registers, memory operands and branch targets are accepted by the generated instruction methods
+// (e.g. ADD_R64_R64, CALL_M64 or JMP_REL8), so that operand mistakes are caught by the compiler. Typed register
+// constants are defined in package x64/reg.
+
+// Reg8 is an 8-bit general purpose register (including AH, CH, DH and BH).
+type Reg8 Reg
+
+// Reg16 is a 16-bit general purpose register.
+type Reg16 Reg
+
+// Reg32 is a 32-bit general purpose register.
+type Reg32 Reg
+
+// Reg64 is a 64-bit general purpose register.
+type Reg64 Reg
+
+// FPReg is an x87 floating point register.
+type FPReg Reg
+
+// MMXReg is an MMX register.
+type MMXReg Reg
+
+// XMMReg is a 128-bit XMM register.
+type XMMReg Reg
+
+// YMMReg is a 256-bit YMM register.
+type YMMReg Reg
+
+// SegReg is a segment register.
+type SegReg Reg
+
+// ControlReg is a control register.
+type ControlReg Reg
+
+// DebugReg is a debug register.
+type DebugReg Reg
+
+func (r Reg8) Reg() Reg       { return Reg(r) }
+func (r Reg16) Reg() Reg      { return Reg(r) }
+func (r Reg32) Reg() Reg      { return Reg(r) }
+func (r Reg64) Reg() Reg      { return Reg(r) }
+func (r FPReg) Reg() Reg      { return Reg(r) }
+func (r MMXReg) Reg() Reg     { return Reg(r) }
+func (r XMMReg) Reg() Reg     { return Reg(r) }
+func (r YMMReg) Reg() Reg     { return Reg(r) }
+func (r SegReg) Reg() Reg     { return Reg(r) }
+func (r ControlReg) Reg() Reg { return Reg(r) }
+func (r DebugReg) Reg() Reg   { return Reg(r) }
+
+func (r Reg8) String() string       { return Reg(r).String() }
+func (r Reg16) String() string      { return Reg(r).String() }
+func (r Reg32) String() string      { return Reg(r).String() }
+func (r Reg64) String() string      { return Reg(r).String() }
+func (r FPReg) String() string      { return Reg(r).String() }
+func (r MMXReg) String() string     { return Reg(r).String() }
+func (r XMMReg) String() string     { return Reg(r).String() }
+func (r YMMReg) String() string     { return Reg(r).String() }
+func (r SegReg) String() string     { return Reg(r).String() }
+func (r ControlReg) String() string { return Reg(r).String() }
+func (r DebugReg) String() string   { return Reg(r).String() }
+
+// Mem8 is an 8-bit memory operand. The width of the operand is set by the instruction method.
+type Mem8 Mem
+
+// Mem16 is a 16-bit memory operand. The width of the operand is set by the instruction method.
+type Mem16 Mem
+
+// Mem32 is a 32-bit memory operand. The width of the operand is set by the instruction method.
+type Mem32 Mem
+
+// Mem48 is a 48-bit (far pointer) memory operand. The width of the operand is set by the instruction method.
+type Mem48 Mem
+
+// Mem64 is a 64-bit memory operand. The width of the operand is set by the instruction method.
+type Mem64 Mem
+
+// Mem80 is an 80-bit (x87 extended precision) memory operand. The width of the operand is set by the instruction
+// method.
+type Mem80 Mem
+
+// Mem128 is a 128-bit memory operand. The width of the operand is set by the instruction method.
+type Mem128 Mem
+
+// Mem256 is a 256-bit memory operand. The width of the operand is set by the instruction method.
+type Mem256 Mem
+
+// Target8 is an 8-bit branch target.
+//
+// Rel8 and Label8 implement Target8.
+type Target8 interface {
+	DispArg
+	isTarget8()
+}
+
+// Target16 is a 16-bit branch target.
+//
+// Rel16 and Label16 implement Target16.
+type Target16 interface {
+	DispArg
+	isTarget16()
+}
+
+// Target32 is a 32-bit branch target.
+//
+// Rel32, Label32 and Label implement Target32.
+type Target32 interface {
+	DispArg
+	isTarget32()
+}
+
+var _ Target8 = Rel8(0)
+var _ Target8 = Label8(0)
+var _ Target16 = Rel16(0)
+var _ Target16 = Label16(0)
+var _ Target32 = Rel32(0)
+var _ Target32 = Label32(0)
+var _ Target32 = Label{}
+
+func (r Rel8) isTarget8()     {}
+func (l Label8) isTarget8()   {}
+func (r Rel16) isTarget16()   {}
+func (l Label16) isTarget16() {}
+func (r Rel32) isTarget32()   {}
+func (l Label32) isTarget32() {}
+func (l Label) isTarget32()   {}
+
+// Encode the encoding at offset within inst's encodings, skipping the search for a matching encoding. The
+// arguments are still checked against the encoding's arg-pattern, and the encoding policy does not apply.
+// This is used by the generated instruction methods.
+func (a *Assembler) instEnc(inst Inst, offset uint8, args ...Arg) error {
+	if a.err != nil {
+		return a.err
+	}
+	if err := a.match.prepare(inst, args...); err != nil {
+		return a.encode(err)
+	}
+	return a.encode(a.match.matchOne(offset))
+}
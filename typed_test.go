@@ -0,0 +1,143 @@
+package x64
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTypedMethods(t *testing.T) {
+	insts := make(map[string]Inst)
+	for i := 0; i < len(encs); {
+		count := 1
+		for i+count < len(encs) && encs[i+count].instid() == encs[i].instid() {
+			count++
+		}
+		inst := Inst(uint32(encs[i].instid())<<21 | uint32(count)<<16 | uint32(i))
+		insts[inst.Name()] = inst
+		i += count
+	}
+
+	samples := map[reflect.Type]reflect.Value{
+		reflect.TypeOf(Reg8(0)):       reflect.ValueOf(Reg8(DL)),
+		reflect.TypeOf(Reg16(0)):      reflect.ValueOf(Reg16(DX)),
+		reflect.TypeOf(Reg32(0)):      reflect.ValueOf(Reg32(EDX)),
+		reflect.TypeOf(Reg64(0)):      reflect.ValueOf(Reg64(RDX)),
+		reflect.TypeOf(FPReg(0)):      reflect.ValueOf(FPReg(F2)),
+		reflect.TypeOf(MMXReg(0)):     reflect.ValueOf(MMXReg(M2)),
+		reflect.TypeOf(XMMReg(0)):     reflect.ValueOf(XMMReg(X2)),
+		reflect.TypeOf(YMMReg(0)):     reflect.ValueOf(YMMReg(Y2)),
+		reflect.TypeOf(SegReg(0)):     reflect.ValueOf(SegReg(FS)),
+		reflect.TypeOf(ControlReg(0)): reflect.ValueOf(ControlReg(CR2)),
+		reflect.TypeOf(DebugReg(0)):   reflect.ValueOf(DebugReg(DR2)),
+		reflect.TypeOf(Mem{}):         reflect.ValueOf(Mem{Base: RBX}),
+		reflect.TypeOf(Mem8{}):        reflect.ValueOf(Mem8{Base: RBX}),
+		reflect.TypeOf(Mem16{}):       reflect.ValueOf(Mem16{Base: RBX}),
+		reflect.TypeOf(Mem32{}):       reflect.ValueOf(Mem32{Base: RBX}),
+		reflect.TypeOf(Mem48{}):       reflect.ValueOf(Mem48{Base: RBX}),
+		reflect.TypeOf(Mem64{}):       reflect.ValueOf(Mem64{Base: RBX}),
+		reflect.TypeOf(Mem80{}):       reflect.ValueOf(Mem80{Base: RBX}),
+		reflect.TypeOf(Mem128{}):      reflect.ValueOf(Mem128{Base: RBX}),
+		reflect.TypeOf(Mem256{}):      reflect.ValueOf(Mem256{Base: RBX}),
+		reflect.TypeOf(Imm8(0)):       reflect.ValueOf(Imm8(1)),
+		reflect.TypeOf(Imm16(0)):      reflect.ValueOf(Imm16(1)),
+		reflect.TypeOf(Imm32(0)):      reflect.ValueOf(Imm32(1)),
+		reflect.TypeOf(Imm64(0)):      reflect.ValueOf(Imm64(1)),
+	}
+	errType := reflect.TypeOf((*error)(nil)).Elem()
+	typed, direct := NewAssembler(make([]byte, 64)), NewAssembler(make([]byte, 64))
+	count := 0
+	asm := reflect.ValueOf(typed)
+	for i := 0; i < asm.NumMethod(); i++ {
+		m := asm.Type().Method(i)
+		inst, ok := insts[strings.SplitN(m.Name, "_", 2)[0]]
+		if !ok || m.Type.NumOut() != 1 || m.Type.Out(0) != errType {
+			continue
+		}
+		args := make([]reflect.Value, m.Type.NumIn()-1)
+		mems := false
+		for j := range args {
+			pt := m.Type.In(j + 1)
+			switch {
+			case pt == reflect.TypeOf((*Target8)(nil)).Elem():
+				var rel Target8 = Rel8(0)
+				args[j] = reflect.ValueOf(&rel).Elem()
+			case pt == reflect.TypeOf((*Target16)(nil)).Elem():
+				var rel Target16 = Rel16(0)
+				args[j] = reflect.ValueOf(&rel).Elem()
+			case pt == reflect.TypeOf((*Target32)(nil)).Elem():
+				var rel Target32 = Rel32(0)
+				args[j] = reflect.ValueOf(&rel).Elem()
+			case samples[pt].IsValid():
+				args[j] = samples[pt]
+				mems = mems || pt.Kind() == reflect.Struct
+			default:
+				t.Fatalf("%s: unexpected parameter type %v", m.Name, pt)
+			}
+		}
+		typed.Reset(make([]byte, 64))
+		if err := asm.Method(i).Call(args)[0].Interface(); err != nil {
+			t.Fatalf("%s: %v", m.Name, err)
+		}
+		if len(typed.Code()) == 0 {
+			t.Fatalf("%s: no code", m.Name)
+		}
+		count++
+
+		// without memory operands, the typed method should match Inst if the same encoding is selected (Inst may
+		// select an earlier encoding after widening an immediate):
+		if mems || strings.Contains(m.Name, "_REL") {
+			continue
+		}
+		instArgs := make([]Arg, len(args))
+		for j, arg := range args {
+			switch v := arg.Interface().(type) {
+			case interface{ Reg() Reg }:
+				instArgs[j] = v.Reg()
+			default:
+				instArgs[j] = v.(Arg)
+			}
+		}
+		// fixed registers are not passed to the typed methods:
+		if len(instArgs) != len(strings.Split(m.Name, "_"))-1 {
+			continue
+		}
+		direct.Reset(make([]byte, 64))
+		err := direct.Inst(inst, instArgs...)
+		if err == nil && direct.match.encId == typed.match.encId && !bytes.Equal(typed.Code(), direct.Code()) {
+			t.Fatalf("%s: encoded % x, Inst encoded % x", m.Name, typed.Code(), direct.Code())
+		}
+	}
+	if count < 4000 {
+		t.Fatalf("Only %d typed methods were tested", count)
+	}
+}
+
+func TestTypedEncode(t *testing.T) {
+	asm := NewAssembler(make([]byte, 64))
+	for _, c := range []struct {
+		encode func() error
+		code   string
+	}{
+		{func() error { return asm.ADD_R64_R64(Reg64(RAX), Reg64(RBX)) }, "4801d8"},
+		{func() error { return asm.ADD_M64_I32(Mem64{Base: RSP, Disp: Rel8(8)}, 1) }, "488144240801000000"},
+		{func() error { return asm.VPADDD_Y_Y_Y(YMMReg(Y0), YMMReg(Y1), YMMReg(Y2)) }, "c5f5fec2"},
+		{func() error { return asm.VPADDD_Y_Y_M256(YMMReg(Y0), YMMReg(Y1), Mem256{Base: RAX}) }, "c5f5fe00"},
+		{func() error { return asm.FADD_ST0_ST(FPReg(F1)) }, "d8c1"},
+		{func() error { return asm.FLD_M80(Mem80{Base: RAX}) }, "db28"},
+		{func() error { return asm.SHL_R64_CL(Reg64(RAX)) }, "48d3e0"},
+		{func() error { return asm.JMP_REL8(Rel8(-2)) }, "ebfe"},
+		{func() error { return asm.JMP_REL32(Rel32(-5)) }, "e9fbffffff"},
+		{func() error { return asm.RET() }, "c3"},
+	} {
+		asm.Reset(make([]byte, 64))
+		if err := c.encode(); err != nil {
+			t.Fatal(err)
+		}
+		if got := fmt.Sprintf("%x", asm.Code()); got != c.code {
+			t.Fatalf("expected %s, got %s", c.code, got)
+		}
+	}
+}
@@ -1,2483 +1,2573 @@
 package x64
 
 // THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT!
-// go build -o ./gen/gen ./gen/gen.go && ./gen/gen encodings > $output
+// go build -o ./gen/gen ./gen && ./gen/gen encodings > $output
 
 import (
-	. "github.com/wdamron/x64/feats"
+	"github.com/wdamron/x64/feats"
 	. "github.com/wdamron/x64/internal/flags"
 )
 
-// Instruction-encoding table:
+// Feature-sets required by the encodings
+const (
+	featset_X64_IMPLICIT uint8 = iota
+	featset_FPU
+	featset_MMX
+	featset_TDNOW
+	featset_SSE
+	featset_FPU_SSE
+	featset_MMX_SSE
+	featset_SSE2
+	featset_SSE3
+	featset_VMX
+	featset_SSSE3
+	featset_MMX_SSSE3
+	featset_SSE4A
+	featset_SSE41
+	featset_SSE42
+	featset_AVX
+	featset_AVX2
+	featset_FMA
+	featset_BMI1
+	featset_BMI2
+	featset_TBM
+	featset_RTM
+	featset_INVPCID
+	featset_MPX
+	featset_SHA
+	featset_PREFETCHWT1
+	featset_AMD
+	featset_VMX_AMD
+	featset_SSE5_AMD
+	featset_CX16
+	featset_POPCNT
+	featset_LZCNT
+	featset_MOVBE
+	featset_AVX_F16C
+	featset_ADX
+	featset_AES
+	featset_AVX_AES
+	featset_PCLMULQDQ
+	featset_AVX_PCLMULQDQ
+	featset_RDRAND
+	featset_RDSEED
+	featset_RDPID
+	featset_RDTSCP
+	featset_XSAVE
+)
+
+var featSets = [...]feats.Feature{
+	0,
+	feats.FPU,
+	feats.MMX,
+	feats.TDNOW,
+	feats.SSE,
+	feats.FPU | feats.SSE,
+	feats.MMX | feats.SSE,
+	feats.SSE2,
+	feats.SSE3,
+	feats.VMX,
+	feats.SSSE3,
+	feats.MMX | feats.SSSE3,
+	feats.SSE4A,
+	feats.SSE41,
+	feats.SSE42,
+	feats.AVX,
+	feats.AVX2,
+	feats.FMA,
+	feats.BMI1,
+	feats.BMI2,
+	feats.TBM,
+	feats.RTM,
+	feats.INVPCID,
+	feats.MPX,
+	feats.SHA,
+	feats.PREFETCHWT1,
+	feats.AMD,
+	feats.VMX | feats.AMD,
+	feats.SSE5 | feats.AMD,
+	feats.CX16,
+	feats.POPCNT,
+	feats.LZCNT,
+	feats.MOVBE,
+	feats.AVX | feats.F16C,
+	feats.ADX,
+	feats.AES,
+	feats.AVX | feats.AES,
+	feats.PCLMULQDQ,
+	feats.AVX | feats.PCLMULQDQ,
+	feats.RDRAND,
+	feats.RDSEED,
+	feats.RDPID,
+	feats.RDTSCP,
+	feats.XSAVE,
+}
+
+// Instruction-encoding table. Each encoding spec is a 16-byte struct:
 //
-// * Encoding spec is a 16-byte struct:
-//     * opcode: [4]byte
-//     * flags: uint32
-//     * feats: uint32
-//     * mnemonic: uint16
-//       * [0..10] bits identify the unique mnemonic (reverse mapping to the mnemonic)
-//       * [11..15] bits identify the offset of this encoding w.r.t. the starting offset for the mnemonic within the encodings array
-//     * reg + opcode-length: byte
-//       * [0..3] bits identify the reg
-//       * [4..6] bits specify the opcode length (0 -> 1-byte, 1 -> 2-byte, 2 -> 3-byte, 3 -> 4-byte)
-//     * arg-pattern: byte (254 possible combinations)
+//   - opcode: [4]byte
+//   - flags: uint32
+//   - feature-set: byte (at most 256 unique feature-sets)
+//   - mnemonic: uint16
+//   - [0..10] bits identify the unique mnemonic (reverse mapping to the mnemonic)
+//   - [11..15] bits identify the offset of this encoding w.r.t. the starting offset for the mnemonic within the encodings array
+//   - reg + opcode-length: byte
+//   - [0..3] bits identify the reg
+//   - [4..6] bits specify the opcode length (0 -> 1-byte, 1 -> 2-byte, 2 -> 3-byte, 3 -> 4-byte)
+//   - arg-pattern: byte (at most 256 unique patterns)
 var encs = [...]enc{
-	enc{[4]byte{0x14, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 1, 1<<4 | 15, argp_Abib},                                               // adc (0)
-	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, LOCK, 0, 1<<11 | 1, 1<<4 | 2, argp_mbib},                                             // adc (1)
-	enc{[4]byte{0x10, 0x00, 0x00, 0x00}, LOCK | ENC_MR, 0, 2<<11 | 1, 1<<4 | 15, argp_mbrb},                                   // adc (2)
-	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, 0, 0, 3<<11 | 1, 1<<4 | 2, argp_rbib},                                                // adc (3)
-	enc{[4]byte{0x10, 0x00, 0x00, 0x00}, ENC_MR, 0, 4<<11 | 1, 1<<4 | 15, argp_rbrb},                                          // adc (4)
-	enc{[4]byte{0x12, 0x00, 0x00, 0x00}, 0, 0, 5<<11 | 1, 1<<4 | 15, argp_rbvb},                                               // adc (5)
-	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | EXACT_SIZE, 0, 6<<11 | 1, 1<<4 | 2, argp_r0ib},                           // adc (6)
-	enc{[4]byte{0x15, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 7<<11 | 1, 1<<4 | 15, argp_A0i0},                                       // adc (7)
-	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, 0, 8<<11 | 1, 1<<4 | 2, argp_m0i0},                                 // adc (8)
-	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, 0, 9<<11 | 1, 1<<4 | 2, argp_m0ib},                                 // adc (9)
-	enc{[4]byte{0x11, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK | ENC_MR, 0, 10<<11 | 1, 1<<4 | 15, argp_m0r0},                      // adc (10)
-	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 11<<11 | 1, 1<<4 | 2, argp_r0i0},                                       // adc (11)
-	enc{[4]byte{0x11, 0x00, 0x00, 0x00}, AUTO_SIZE | ENC_MR, 0, 12<<11 | 1, 1<<4 | 15, argp_r0r0},                             // adc (12)
-	enc{[4]byte{0x13, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 13<<11 | 1, 1<<4 | 15, argp_r0v0},                                      // adc (13)
-	enc{[4]byte{0xf, 0x38, 0xf6, 0x00}, WITH_REXW | PREF_66, 0, 0<<11 | 2, 3<<4 | 15, argp_rqvq},                              // adcx (14)
-	enc{[4]byte{0x4, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 3, 1<<4 | 15, argp_Abib},                                                // add (15)
-	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, LOCK, 0, 1<<11 | 3, 1<<4 | 0, argp_mbib},                                             // add (16)
-	enc{[4]byte{0x0, 0x00, 0x00, 0x00}, LOCK | ENC_MR, 0, 2<<11 | 3, 1<<4 | 15, argp_mbrb},                                    // add (17)
-	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, 0, 0, 3<<11 | 3, 1<<4 | 0, argp_rbib},                                                // add (18)
-	enc{[4]byte{0x0, 0x00, 0x00, 0x00}, ENC_MR, 0, 4<<11 | 3, 1<<4 | 15, argp_rbrb},                                           // add (19)
-	enc{[4]byte{0x2, 0x00, 0x00, 0x00}, 0, 0, 5<<11 | 3, 1<<4 | 15, argp_rbvb},                                                // add (20)
-	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | EXACT_SIZE, 0, 6<<11 | 3, 1<<4 | 0, argp_r0ib},                           // add (21)
-	enc{[4]byte{0x5, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 7<<11 | 3, 1<<4 | 15, argp_A0i0},                                        // add (22)
-	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, 0, 8<<11 | 3, 1<<4 | 0, argp_m0i0},                                 // add (23)
-	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, 0, 9<<11 | 3, 1<<4 | 0, argp_m0ib},                                 // add (24)
-	enc{[4]byte{0x1, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK | ENC_MR, 0, 10<<11 | 3, 1<<4 | 15, argp_m0r0},                       // add (25)
-	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 11<<11 | 3, 1<<4 | 0, argp_r0i0},                                       // add (26)
-	enc{[4]byte{0x1, 0x00, 0x00, 0x00}, AUTO_SIZE | ENC_MR, 0, 12<<11 | 3, 1<<4 | 15, argp_r0r0},                              // add (27)
-	enc{[4]byte{0x3, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 13<<11 | 3, 1<<4 | 15, argp_r0v0},                                       // add (28)
-	enc{[4]byte{0xf, 0x58, 0x00, 0x00}, PREF_F2, SSE2, 0<<11 | 4, 2<<4 | 15, argp_yomq},                                       // addsd (29)
-	enc{[4]byte{0xf, 0x58, 0x00, 0x00}, PREF_F2, SSE2, 1<<11 | 4, 2<<4 | 15, argp_yoyo},                                       // addsd (30)
-	enc{[4]byte{0xf, 0x58, 0x00, 0x00}, PREF_F3, SSE, 0<<11 | 5, 2<<4 | 15, argp_yomd},                                        // addss (31)
-	enc{[4]byte{0xf, 0x58, 0x00, 0x00}, PREF_F3, SSE, 1<<11 | 5, 2<<4 | 15, argp_yoyo},                                        // addss (32)
-	enc{[4]byte{0xf, 0x38, 0xf6, 0x00}, WITH_REXW | PREF_F3, 0, 0<<11 | 6, 3<<4 | 15, argp_rqvq},                              // adox (33)
-	enc{[4]byte{0x24, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 7, 1<<4 | 15, argp_Abib},                                               // and (34)
-	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, LOCK, 0, 1<<11 | 7, 1<<4 | 4, argp_mbib},                                             // and (35)
-	enc{[4]byte{0x20, 0x00, 0x00, 0x00}, LOCK | ENC_MR, 0, 2<<11 | 7, 1<<4 | 15, argp_mbrb},                                   // and (36)
-	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, 0, 0, 3<<11 | 7, 1<<4 | 4, argp_rbib},                                                // and (37)
-	enc{[4]byte{0x20, 0x00, 0x00, 0x00}, ENC_MR, 0, 4<<11 | 7, 1<<4 | 15, argp_rbrb},                                          // and (38)
-	enc{[4]byte{0x22, 0x00, 0x00, 0x00}, 0, 0, 5<<11 | 7, 1<<4 | 15, argp_rbvb},                                               // and (39)
-	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | EXACT_SIZE, 0, 6<<11 | 7, 1<<4 | 4, argp_r0ib},                           // and (40)
-	enc{[4]byte{0x25, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 7<<11 | 7, 1<<4 | 15, argp_A0i0},                                       // and (41)
-	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, 0, 8<<11 | 7, 1<<4 | 4, argp_m0i0},                                 // and (42)
-	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, 0, 9<<11 | 7, 1<<4 | 4, argp_m0ib},                                 // and (43)
-	enc{[4]byte{0x21, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK | ENC_MR, 0, 10<<11 | 7, 1<<4 | 15, argp_m0r0},                      // and (44)
-	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 11<<11 | 7, 1<<4 | 4, argp_r0i0},                                       // and (45)
-	enc{[4]byte{0x21, 0x00, 0x00, 0x00}, AUTO_SIZE | ENC_MR, 0, 12<<11 | 7, 1<<4 | 15, argp_r0r0},                             // and (46)
-	enc{[4]byte{0x23, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 13<<11 | 7, 1<<4 | 15, argp_r0v0},                                      // and (47)
-	enc{[4]byte{0x2, 0xf2, 0x00, 0x00}, VEX_OP | AUTO_REXW, BMI1, 0<<11 | 8, 2<<4 | 15, argp_r0r0v0},                          // andn (48)
-	enc{[4]byte{0x10, 0x10, 0x00, 0x00}, XOP_OP | AUTO_REXW, TBM, 0<<11 | 9, 2<<4 | 15, argp_r0v0id},                          // bextr (49)
-	enc{[4]byte{0x2, 0xf7, 0x00, 0x00}, VEX_OP | AUTO_REXW | ENC_MR, BMI1, 1<<11 | 9, 2<<4 | 15, argp_r0v0r0},                 // bextr (50)
-	enc{[4]byte{0x9, 0x1, 0x00, 0x00}, XOP_OP | AUTO_REXW | ENC_VM, TBM, 0<<11 | 10, 2<<4 | 1, argp_r0v0},                     // blcfill (51)
-	enc{[4]byte{0x9, 0x2, 0x00, 0x00}, XOP_OP | AUTO_REXW | ENC_VM, TBM, 0<<11 | 11, 2<<4 | 6, argp_r0v0},                     // blci (52)
-	enc{[4]byte{0x9, 0x1, 0x00, 0x00}, XOP_OP | AUTO_REXW | ENC_VM, TBM, 0<<11 | 12, 2<<4 | 5, argp_r0v0},                     // blcic (53)
-	enc{[4]byte{0x9, 0x2, 0x00, 0x00}, XOP_OP | AUTO_REXW | ENC_VM, TBM, 0<<11 | 13, 2<<4 | 1, argp_r0v0},                     // blcmsk (54)
-	enc{[4]byte{0x9, 0x1, 0x00, 0x00}, XOP_OP | AUTO_REXW | ENC_VM, TBM, 0<<11 | 14, 2<<4 | 3, argp_r0v0},                     // blcs (55)
-	enc{[4]byte{0x9, 0x1, 0x00, 0x00}, XOP_OP | AUTO_REXW | ENC_VM, TBM, 0<<11 | 15, 2<<4 | 2, argp_r0v0},                     // blsfill (56)
-	enc{[4]byte{0x2, 0xf3, 0x00, 0x00}, VEX_OP | AUTO_REXW | ENC_VM, BMI1, 0<<11 | 16, 2<<4 | 3, argp_r0v0},                   // blsi (57)
-	enc{[4]byte{0x9, 0x1, 0x00, 0x00}, XOP_OP | AUTO_REXW | ENC_VM, TBM, 0<<11 | 17, 2<<4 | 6, argp_r0v0},                     // blsic (58)
-	enc{[4]byte{0x2, 0xf3, 0x00, 0x00}, VEX_OP | AUTO_REXW | ENC_VM, BMI1, 0<<11 | 18, 2<<4 | 2, argp_r0v0},                   // blsmsk (59)
-	enc{[4]byte{0x2, 0xf3, 0x00, 0x00}, VEX_OP | AUTO_REXW | ENC_VM, BMI1, 0<<11 | 19, 2<<4 | 1, argp_r0v0},                   // blsr (60)
-	enc{[4]byte{0xf, 0x1a, 0x00, 0x00}, PREF_F3, MPX, 0<<11 | 20, 2<<4 | 15, argp_bom1},                                       // bndcl (61)
-	enc{[4]byte{0xf, 0x1a, 0x00, 0x00}, PREF_F3, MPX, 1<<11 | 20, 2<<4 | 15, argp_borq},                                       // bndcl (62)
-	enc{[4]byte{0xf, 0x1b, 0x00, 0x00}, PREF_F2, MPX, 0<<11 | 21, 2<<4 | 15, argp_bom1},                                       // bndcn (63)
-	enc{[4]byte{0xf, 0x1b, 0x00, 0x00}, PREF_F2, MPX, 1<<11 | 21, 2<<4 | 15, argp_borq},                                       // bndcn (64)
-	enc{[4]byte{0xf, 0x1a, 0x00, 0x00}, PREF_F2, MPX, 0<<11 | 22, 2<<4 | 15, argp_bom1},                                       // bndcu (65)
-	enc{[4]byte{0xf, 0x1a, 0x00, 0x00}, PREF_F2, MPX, 1<<11 | 22, 2<<4 | 15, argp_borq},                                       // bndcu (66)
-	enc{[4]byte{0xf, 0x1a, 0x00, 0x00}, ENC_MIB, MPX, 0<<11 | 23, 2<<4 | 15, argp_bom1},                                       // bndldx (67)
-	enc{[4]byte{0xf, 0x1b, 0x00, 0x00}, PREF_F3 | ENC_MIB, MPX, 0<<11 | 24, 2<<4 | 15, argp_bom1},                             // bndmk (68)
-	enc{[4]byte{0xf, 0x1a, 0x00, 0x00}, PREF_66, MPX, 0<<11 | 25, 2<<4 | 15, argp_bobo},                                       // bndmov (69)
-	enc{[4]byte{0xf, 0x1b, 0x00, 0x00}, PREF_66 | ENC_MR, MPX, 1<<11 | 25, 2<<4 | 15, argp_bobo},                              // bndmov (70)
-	enc{[4]byte{0xf, 0x1a, 0x00, 0x00}, PREF_66, MPX, 2<<11 | 25, 2<<4 | 15, argp_bom1},                                       // bndmov (71)
-	enc{[4]byte{0xf, 0x1b, 0x00, 0x00}, PREF_66 | ENC_MR, MPX, 3<<11 | 25, 2<<4 | 15, argp_m1bo},                              // bndmov (72)
-	enc{[4]byte{0xf, 0x1b, 0x00, 0x00}, ENC_MR | ENC_MIB, MPX, 0<<11 | 26, 2<<4 | 15, argp_m1bo},                              // bndstx (73)
-	enc{[4]byte{0xf, 0xbc, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 27, 2<<4 | 15, argp_r0v0},                                       // bsf (74)
-	enc{[4]byte{0xf, 0xbd, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 28, 2<<4 | 15, argp_r0v0},                                       // bsr (75)
-	enc{[4]byte{0xf, 0xc8, 0x00, 0x00}, AUTO_REXW | SHORT_ARG, 0, 0<<11 | 29, 2<<4 | 15, argp_r0},                             // bswap (76)
-	enc{[4]byte{0xf, 0xba, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 30, 2<<4 | 4, argp_v0ib},                                        // bt (77)
-	enc{[4]byte{0xf, 0xa3, 0x00, 0x00}, AUTO_SIZE | ENC_MR, 0, 1<<11 | 30, 2<<4 | 15, argp_v0r0},                              // bt (78)
-	enc{[4]byte{0xf, 0xba, 0x00, 0x00}, AUTO_SIZE | EXACT_SIZE, 0, 0<<11 | 31, 2<<4 | 7, argp_r0ib},                           // btc (79)
-	enc{[4]byte{0xf, 0xba, 0x00, 0x00}, AUTO_SIZE | LOCK, 0, 1<<11 | 31, 2<<4 | 7, argp_m0ib},                                 // btc (80)
-	enc{[4]byte{0xf, 0xbb, 0x00, 0x00}, AUTO_SIZE | LOCK | ENC_MR, 0, 2<<11 | 31, 2<<4 | 15, argp_m0r0},                       // btc (81)
-	enc{[4]byte{0xf, 0xbb, 0x00, 0x00}, AUTO_SIZE | ENC_MR, 0, 3<<11 | 31, 2<<4 | 15, argp_r0r0},                              // btc (82)
-	enc{[4]byte{0xf, 0xba, 0x00, 0x00}, AUTO_SIZE | EXACT_SIZE, 0, 0<<11 | 32, 2<<4 | 6, argp_r0ib},                           // btr (83)
-	enc{[4]byte{0xf, 0xba, 0x00, 0x00}, AUTO_SIZE | LOCK, 0, 1<<11 | 32, 2<<4 | 6, argp_m0ib},                                 // btr (84)
-	enc{[4]byte{0xf, 0xb3, 0x00, 0x00}, AUTO_SIZE | LOCK | ENC_MR, 0, 2<<11 | 32, 2<<4 | 15, argp_m0r0},                       // btr (85)
-	enc{[4]byte{0xf, 0xb3, 0x00, 0x00}, AUTO_SIZE | ENC_MR, 0, 3<<11 | 32, 2<<4 | 15, argp_r0r0},                              // btr (86)
-	enc{[4]byte{0xf, 0xba, 0x00, 0x00}, AUTO_SIZE | EXACT_SIZE, 0, 0<<11 | 33, 2<<4 | 5, argp_r0ib},                           // bts (87)
-	enc{[4]byte{0xf, 0xba, 0x00, 0x00}, AUTO_SIZE | LOCK, 0, 1<<11 | 33, 2<<4 | 5, argp_m0ib},                                 // bts (88)
-	enc{[4]byte{0xf, 0xab, 0x00, 0x00}, AUTO_SIZE | LOCK | ENC_MR, 0, 2<<11 | 33, 2<<4 | 15, argp_m0r0},                       // bts (89)
-	enc{[4]byte{0xf, 0xab, 0x00, 0x00}, AUTO_SIZE | ENC_MR, 0, 3<<11 | 33, 2<<4 | 15, argp_r0r0},                              // bts (90)
-	enc{[4]byte{0x2, 0xf5, 0x00, 0x00}, VEX_OP | AUTO_REXW | ENC_MR, BMI2, 0<<11 | 34, 2<<4 | 15, argp_r0v0r0},                // bzhi (91)
-	enc{[4]byte{0xe8, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 35, 1<<4 | 15, argp_od},                                                // call (92)
-	enc{[4]byte{0xff, 0x00, 0x00, 0x00}, AUTO_NO32, 0, 1<<11 | 35, 1<<4 | 2, argp_v0},                                         // call (93)
-	enc{[4]byte{0x98, 0x00, 0x00, 0x00}, WORD_SIZE, 0, 0<<11 | 36, 1<<4 | 15, argp_},                                          // cbw (94)
-	enc{[4]byte{0x99, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 37, 1<<4 | 15, argp_},                                                  // cdq (95)
-	enc{[4]byte{0x98, 0x00, 0x00, 0x00}, WITH_REXW, 0, 0<<11 | 38, 1<<4 | 15, argp_},                                          // cdqe (96)
-	enc{[4]byte{0xf, 0x1, 0xca, 0x00}, 0, 0, 0<<11 | 39, 3<<4 | 15, argp_},                                                    // clac (97)
-	enc{[4]byte{0xf8, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 40, 1<<4 | 15, argp_},                                                  // clc (98)
-	enc{[4]byte{0xfc, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 41, 1<<4 | 15, argp_},                                                  // cld (99)
-	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, 0, SSE2, 0<<11 | 42, 2<<4 | 7, argp_mb},                                               // clflush (100)
-	enc{[4]byte{0xf, 0x1, 0xdd, 0x00}, 0, VMX | AMD, 0<<11 | 43, 3<<4 | 15, argp_},                                            // clgi (101)
-	enc{[4]byte{0xfa, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 44, 1<<4 | 15, argp_},                                                  // cli (102)
-	enc{[4]byte{0xf, 0x6, 0x00, 0x00}, 0, 0, 0<<11 | 45, 2<<4 | 15, argp_},                                                    // clts (103)
-	enc{[4]byte{0xf, 0x1, 0xfc, 0x00}, 0, AMD, 0<<11 | 46, 3<<4 | 15, argp_},                                                  // clzero (104)
-	enc{[4]byte{0xf5, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 47, 1<<4 | 15, argp_},                                                  // cmc (105)
-	enc{[4]byte{0xf, 0x47, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 48, 2<<4 | 15, argp_r0v0},                                       // cmova (106)
-	enc{[4]byte{0xf, 0x43, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 49, 2<<4 | 15, argp_r0v0},                                       // cmovae (107)
-	enc{[4]byte{0xf, 0x42, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 50, 2<<4 | 15, argp_r0v0},                                       // cmovb (108)
-	enc{[4]byte{0xf, 0x46, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 51, 2<<4 | 15, argp_r0v0},                                       // cmovbe (109)
-	enc{[4]byte{0xf, 0x42, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 52, 2<<4 | 15, argp_r0v0},                                       // cmovc (110)
-	enc{[4]byte{0xf, 0x44, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 53, 2<<4 | 15, argp_r0v0},                                       // cmove (111)
-	enc{[4]byte{0xf, 0x4f, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 54, 2<<4 | 15, argp_r0v0},                                       // cmovg (112)
-	enc{[4]byte{0xf, 0x4d, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 55, 2<<4 | 15, argp_r0v0},                                       // cmovge (113)
-	enc{[4]byte{0xf, 0x4c, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 56, 2<<4 | 15, argp_r0v0},                                       // cmovl (114)
-	enc{[4]byte{0xf, 0x4e, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 57, 2<<4 | 15, argp_r0v0},                                       // cmovle (115)
-	enc{[4]byte{0xf, 0x46, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 58, 2<<4 | 15, argp_r0v0},                                       // cmovna (116)
-	enc{[4]byte{0xf, 0x42, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 59, 2<<4 | 15, argp_r0v0},                                       // cmovnae (117)
-	enc{[4]byte{0xf, 0x43, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 60, 2<<4 | 15, argp_r0v0},                                       // cmovnb (118)
-	enc{[4]byte{0xf, 0x47, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 61, 2<<4 | 15, argp_r0v0},                                       // cmovnbe (119)
-	enc{[4]byte{0xf, 0x43, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 62, 2<<4 | 15, argp_r0v0},                                       // cmovnc (120)
-	enc{[4]byte{0xf, 0x45, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 63, 2<<4 | 15, argp_r0v0},                                       // cmovne (121)
-	enc{[4]byte{0xf, 0x4e, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 64, 2<<4 | 15, argp_r0v0},                                       // cmovng (122)
-	enc{[4]byte{0xf, 0x4c, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 65, 2<<4 | 15, argp_r0v0},                                       // cmovnge (123)
-	enc{[4]byte{0xf, 0x4d, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 66, 2<<4 | 15, argp_r0v0},                                       // cmovnl (124)
-	enc{[4]byte{0xf, 0x4f, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 67, 2<<4 | 15, argp_r0v0},                                       // cmovnle (125)
-	enc{[4]byte{0xf, 0x41, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 68, 2<<4 | 15, argp_r0v0},                                       // cmovno (126)
-	enc{[4]byte{0xf, 0x4b, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 69, 2<<4 | 15, argp_r0v0},                                       // cmovnp (127)
-	enc{[4]byte{0xf, 0x49, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 70, 2<<4 | 15, argp_r0v0},                                       // cmovns (128)
-	enc{[4]byte{0xf, 0x45, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 71, 2<<4 | 15, argp_r0v0},                                       // cmovnz (129)
-	enc{[4]byte{0xf, 0x40, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 72, 2<<4 | 15, argp_r0v0},                                       // cmovo (130)
-	enc{[4]byte{0xf, 0x4a, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 73, 2<<4 | 15, argp_r0v0},                                       // cmovp (131)
-	enc{[4]byte{0xf, 0x4a, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 74, 2<<4 | 15, argp_r0v0},                                       // cmovpe (132)
-	enc{[4]byte{0xf, 0x4b, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 75, 2<<4 | 15, argp_r0v0},                                       // cmovpo (133)
-	enc{[4]byte{0xf, 0x48, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 76, 2<<4 | 15, argp_r0v0},                                       // cmovs (134)
-	enc{[4]byte{0xf, 0x44, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 77, 2<<4 | 15, argp_r0v0},                                       // cmovz (135)
-	enc{[4]byte{0x3c, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 78, 1<<4 | 15, argp_Abib},                                              // cmp (136)
-	enc{[4]byte{0x3a, 0x00, 0x00, 0x00}, 0, 0, 1<<11 | 78, 1<<4 | 15, argp_rbvb},                                              // cmp (137)
-	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, 0, 0, 2<<11 | 78, 1<<4 | 7, argp_vbib},                                               // cmp (138)
-	enc{[4]byte{0x38, 0x00, 0x00, 0x00}, ENC_MR, 0, 3<<11 | 78, 1<<4 | 15, argp_vbrb},                                         // cmp (139)
-	enc{[4]byte{0x3d, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 4<<11 | 78, 1<<4 | 15, argp_A0i0},                                      // cmp (140)
-	enc{[4]byte{0x3b, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 5<<11 | 78, 1<<4 | 15, argp_r0v0},                                      // cmp (141)
-	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 6<<11 | 78, 1<<4 | 7, argp_v0i0},                                       // cmp (142)
-	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 7<<11 | 78, 1<<4 | 7, argp_v0ib},                                       // cmp (143)
-	enc{[4]byte{0x39, 0x00, 0x00, 0x00}, AUTO_SIZE | ENC_MR, 0, 8<<11 | 78, 1<<4 | 15, argp_v0r0},                             // cmp (144)
-	enc{[4]byte{0xf, 0xc2, 0x0, 0x00}, IMM_OP | PREF_F2, SSE2, 0<<11 | 79, 3<<4 | 15, argp_yomq},                              // cmpeqsd (145)
-	enc{[4]byte{0xf, 0xc2, 0x0, 0x00}, IMM_OP | PREF_F2, SSE2, 1<<11 | 79, 3<<4 | 15, argp_yoyo},                              // cmpeqsd (146)
-	enc{[4]byte{0xf, 0xc2, 0x0, 0x00}, IMM_OP | PREF_F3, SSE, 0<<11 | 80, 3<<4 | 15, argp_yomd},                               // cmpeqss (147)
-	enc{[4]byte{0xf, 0xc2, 0x0, 0x00}, IMM_OP | PREF_F3, SSE, 1<<11 | 80, 3<<4 | 15, argp_yoyo},                               // cmpeqss (148)
-	enc{[4]byte{0xf, 0xc2, 0x2, 0x00}, IMM_OP | PREF_F2, SSE2, 0<<11 | 81, 3<<4 | 15, argp_yomq},                              // cmplesd (149)
-	enc{[4]byte{0xf, 0xc2, 0x2, 0x00}, IMM_OP | PREF_F2, SSE2, 1<<11 | 81, 3<<4 | 15, argp_yoyo},                              // cmplesd (150)
-	enc{[4]byte{0xf, 0xc2, 0x2, 0x00}, IMM_OP | PREF_F3, SSE, 0<<11 | 82, 3<<4 | 15, argp_yomd},                               // cmpless (151)
-	enc{[4]byte{0xf, 0xc2, 0x2, 0x00}, IMM_OP | PREF_F3, SSE, 1<<11 | 82, 3<<4 | 15, argp_yoyo},                               // cmpless (152)
-	enc{[4]byte{0xf, 0xc2, 0x1, 0x00}, IMM_OP | PREF_F2, SSE2, 0<<11 | 83, 3<<4 | 15, argp_yomq},                              // cmpltsd (153)
-	enc{[4]byte{0xf, 0xc2, 0x1, 0x00}, IMM_OP | PREF_F2, SSE2, 1<<11 | 83, 3<<4 | 15, argp_yoyo},                              // cmpltsd (154)
-	enc{[4]byte{0xf, 0xc2, 0x1, 0x00}, IMM_OP | PREF_F3, SSE, 0<<11 | 84, 3<<4 | 15, argp_yomd},                               // cmpltss (155)
-	enc{[4]byte{0xf, 0xc2, 0x1, 0x00}, IMM_OP | PREF_F3, SSE, 1<<11 | 84, 3<<4 | 15, argp_yoyo},                               // cmpltss (156)
-	enc{[4]byte{0xf, 0xc2, 0x4, 0x00}, IMM_OP | PREF_F2, SSE2, 0<<11 | 85, 3<<4 | 15, argp_yomq},                              // cmpneqsd (157)
-	enc{[4]byte{0xf, 0xc2, 0x4, 0x00}, IMM_OP | PREF_F2, SSE2, 1<<11 | 85, 3<<4 | 15, argp_yoyo},                              // cmpneqsd (158)
-	enc{[4]byte{0xf, 0xc2, 0x4, 0x00}, IMM_OP | PREF_F3, SSE, 0<<11 | 86, 3<<4 | 15, argp_yomd},                               // cmpneqss (159)
-	enc{[4]byte{0xf, 0xc2, 0x4, 0x00}, IMM_OP | PREF_F3, SSE, 1<<11 | 86, 3<<4 | 15, argp_yoyo},                               // cmpneqss (160)
-	enc{[4]byte{0xf, 0xc2, 0x6, 0x00}, IMM_OP | PREF_F2, SSE2, 0<<11 | 87, 3<<4 | 15, argp_yomq},                              // cmpnlesd (161)
-	enc{[4]byte{0xf, 0xc2, 0x6, 0x00}, IMM_OP | PREF_F2, SSE2, 1<<11 | 87, 3<<4 | 15, argp_yoyo},                              // cmpnlesd (162)
-	enc{[4]byte{0xf, 0xc2, 0x6, 0x00}, IMM_OP | PREF_F3, SSE, 0<<11 | 88, 3<<4 | 15, argp_yomd},                               // cmpnless (163)
-	enc{[4]byte{0xf, 0xc2, 0x6, 0x00}, IMM_OP | PREF_F3, SSE, 1<<11 | 88, 3<<4 | 15, argp_yoyo},                               // cmpnless (164)
-	enc{[4]byte{0xf, 0xc2, 0x5, 0x00}, IMM_OP | PREF_F2, SSE2, 0<<11 | 89, 3<<4 | 15, argp_yomq},                              // cmpnltsd (165)
-	enc{[4]byte{0xf, 0xc2, 0x5, 0x00}, IMM_OP | PREF_F2, SSE2, 1<<11 | 89, 3<<4 | 15, argp_yoyo},                              // cmpnltsd (166)
-	enc{[4]byte{0xf, 0xc2, 0x5, 0x00}, IMM_OP | PREF_F3, SSE, 0<<11 | 90, 3<<4 | 15, argp_yomd},                               // cmpnltss (167)
-	enc{[4]byte{0xf, 0xc2, 0x5, 0x00}, IMM_OP | PREF_F3, SSE, 1<<11 | 90, 3<<4 | 15, argp_yoyo},                               // cmpnltss (168)
-	enc{[4]byte{0xf, 0xc2, 0x7, 0x00}, IMM_OP | PREF_F2, SSE2, 0<<11 | 91, 3<<4 | 15, argp_yomq},                              // cmpordsd (169)
-	enc{[4]byte{0xf, 0xc2, 0x7, 0x00}, IMM_OP | PREF_F2, SSE2, 1<<11 | 91, 3<<4 | 15, argp_yoyo},                              // cmpordsd (170)
-	enc{[4]byte{0xf, 0xc2, 0x7, 0x00}, IMM_OP | PREF_F3, SSE, 0<<11 | 92, 3<<4 | 15, argp_yomd},                               // cmpordss (171)
-	enc{[4]byte{0xf, 0xc2, 0x7, 0x00}, IMM_OP | PREF_F3, SSE, 1<<11 | 92, 3<<4 | 15, argp_yoyo},                               // cmpordss (172)
-	enc{[4]byte{0xa6, 0x00, 0x00, 0x00}, REPE, 0, 0<<11 | 93, 1<<4 | 15, argp_},                                               // cmpsb (173)
-	enc{[4]byte{0xa7, 0x00, 0x00, 0x00}, REPE, 0, 0<<11 | 94, 1<<4 | 15, argp_},                                               // cmpsd (174)
-	enc{[4]byte{0xf, 0xc2, 0x00, 0x00}, PREF_F2, SSE2, 1<<11 | 94, 2<<4 | 15, argp_yowoib},                                    // cmpsd (175)
-	enc{[4]byte{0xa7, 0x00, 0x00, 0x00}, WITH_REXW | REPE, 0, 0<<11 | 95, 1<<4 | 15, argp_},                                   // cmpsq (176)
-	enc{[4]byte{0xf, 0xc2, 0x00, 0x00}, PREF_F3, SSE, 0<<11 | 96, 2<<4 | 15, argp_yom1ib},                                     // cmpss (177)
-	enc{[4]byte{0xf, 0xc2, 0x00, 0x00}, PREF_F3, SSE, 1<<11 | 96, 2<<4 | 15, argp_yoyoib},                                     // cmpss (178)
-	enc{[4]byte{0xa7, 0x00, 0x00, 0x00}, WORD_SIZE | REPE, 0, 0<<11 | 97, 1<<4 | 15, argp_},                                   // cmpsw (179)
-	enc{[4]byte{0xf, 0xc2, 0x3, 0x00}, IMM_OP | PREF_F2, SSE2, 0<<11 | 98, 3<<4 | 15, argp_yomq},                              // cmpunordsd (180)
-	enc{[4]byte{0xf, 0xc2, 0x3, 0x00}, IMM_OP | PREF_F2, SSE2, 1<<11 | 98, 3<<4 | 15, argp_yoyo},                              // cmpunordsd (181)
-	enc{[4]byte{0xf, 0xc2, 0x3, 0x00}, IMM_OP | PREF_F3, SSE, 0<<11 | 99, 3<<4 | 15, argp_yomd},                               // cmpunordss (182)
-	enc{[4]byte{0xf, 0xc2, 0x3, 0x00}, IMM_OP | PREF_F3, SSE, 1<<11 | 99, 3<<4 | 15, argp_yoyo},                               // cmpunordss (183)
-	enc{[4]byte{0xf, 0xb0, 0x00, 0x00}, LOCK | ENC_MR, 0, 0<<11 | 100, 2<<4 | 15, argp_mbrb},                                  // cmpxchg (184)
-	enc{[4]byte{0xf, 0xb0, 0x00, 0x00}, ENC_MR, 0, 1<<11 | 100, 2<<4 | 15, argp_rbrb},                                         // cmpxchg (185)
-	enc{[4]byte{0xf, 0xb1, 0x00, 0x00}, AUTO_SIZE | LOCK | ENC_MR, 0, 2<<11 | 100, 2<<4 | 15, argp_m0r0},                      // cmpxchg (186)
-	enc{[4]byte{0xf, 0xb1, 0x00, 0x00}, AUTO_SIZE | ENC_MR, 0, 3<<11 | 100, 2<<4 | 15, argp_r0r0},                             // cmpxchg (187)
-	enc{[4]byte{0xf, 0xc7, 0x00, 0x00}, WITH_REXW | LOCK, 0, 0<<11 | 101, 2<<4 | 1, argp_mo},                                  // cmpxchg16b (188)
-	enc{[4]byte{0xf, 0xc7, 0x00, 0x00}, LOCK, 0, 0<<11 | 102, 2<<4 | 1, argp_mq},                                              // cmpxchg8b (189)
-	enc{[4]byte{0xf, 0x2f, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 103, 2<<4 | 15, argp_yomq},                                     // comisd (190)
-	enc{[4]byte{0xf, 0x2f, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 103, 2<<4 | 15, argp_yoyo},                                     // comisd (191)
-	enc{[4]byte{0xf, 0x2f, 0x00, 0x00}, 0, SSE, 0<<11 | 104, 2<<4 | 15, argp_yomd},                                            // comiss (192)
-	enc{[4]byte{0xf, 0x2f, 0x00, 0x00}, 0, SSE, 1<<11 | 104, 2<<4 | 15, argp_yoyo},                                            // comiss (193)
-	enc{[4]byte{0xf, 0xa2, 0x00, 0x00}, 0, 0, 0<<11 | 105, 2<<4 | 15, argp_},                                                  // cpuid (194)
-	enc{[4]byte{0x99, 0x00, 0x00, 0x00}, WITH_REXW, 0, 0<<11 | 106, 1<<4 | 15, argp_},                                         // cqo (195)
-	enc{[4]byte{0xf, 0x38, 0xf0, 0x00}, AUTO_REXW | EXACT_SIZE | PREF_F2, 0, 0<<11 | 107, 3<<4 | 15, argp_r0vb},               // crc32 (196)
-	enc{[4]byte{0xf, 0x38, 0xf1, 0x00}, WORD_SIZE | EXACT_SIZE | PREF_F2, 0, 1<<11 | 107, 3<<4 | 15, argp_rdvw},               // crc32 (197)
-	enc{[4]byte{0xf, 0x38, 0xf1, 0x00}, AUTO_REXW | EXACT_SIZE | PREF_F2, 0, 2<<11 | 107, 3<<4 | 15, argp_r0v0},               // crc32 (198)
-	enc{[4]byte{0xf, 0xe6, 0x00, 0x00}, PREF_F2, SSE2, 0<<11 | 108, 2<<4 | 15, argp_yowo},                                     // cvtpd2dq (199)
-	enc{[4]byte{0xf, 0x2d, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 109, 2<<4 | 15, argp_xqwo},                                     // cvtpd2pi (200)
-	enc{[4]byte{0xf, 0x5b, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 110, 2<<4 | 15, argp_yowo},                                     // cvtps2dq (201)
-	enc{[4]byte{0xf, 0x2d, 0x00, 0x00}, 0, MMX | SSE, 0<<11 | 111, 2<<4 | 15, argp_xqmq},                                      // cvtps2pi (202)
-	enc{[4]byte{0xf, 0x2d, 0x00, 0x00}, 0, MMX | SSE, 1<<11 | 111, 2<<4 | 15, argp_xqyo},                                      // cvtps2pi (203)
-	enc{[4]byte{0xf, 0x2d, 0x00, 0x00}, PREF_F2, SSE2, 0<<11 | 112, 2<<4 | 15, argp_rdmq},                                     // cvtsd2si (204)
-	enc{[4]byte{0xf, 0x2d, 0x00, 0x00}, PREF_F2, SSE2, 1<<11 | 112, 2<<4 | 15, argp_rdyo},                                     // cvtsd2si (205)
-	enc{[4]byte{0xf, 0x2d, 0x00, 0x00}, WITH_REXW | PREF_F2, SSE2, 2<<11 | 112, 2<<4 | 15, argp_rqmq},                         // cvtsd2si (206)
-	enc{[4]byte{0xf, 0x2d, 0x00, 0x00}, WITH_REXW | PREF_F2, SSE2, 3<<11 | 112, 2<<4 | 15, argp_rqyo},                         // cvtsd2si (207)
-	enc{[4]byte{0xf, 0x5a, 0x00, 0x00}, PREF_F2, SSE2, 0<<11 | 113, 2<<4 | 15, argp_yomq},                                     // cvtsd2ss (208)
-	enc{[4]byte{0xf, 0x5a, 0x00, 0x00}, PREF_F2, SSE2, 1<<11 | 113, 2<<4 | 15, argp_yoyo},                                     // cvtsd2ss (209)
-	enc{[4]byte{0xf, 0x2a, 0x00, 0x00}, PREF_F2, SSE2, 0<<11 | 114, 2<<4 | 15, argp_yovd},                                     // cvtsi2sd (210)
-	enc{[4]byte{0xf, 0x2a, 0x00, 0x00}, WITH_REXW | PREF_F2, SSE2, 1<<11 | 114, 2<<4 | 15, argp_yovq},                         // cvtsi2sd (211)
-	enc{[4]byte{0xf, 0x2a, 0x00, 0x00}, PREF_F3, SSE, 0<<11 | 115, 2<<4 | 15, argp_yovd},                                      // cvtsi2ss (212)
-	enc{[4]byte{0xf, 0x2a, 0x00, 0x00}, WITH_REXW | PREF_F3, SSE, 1<<11 | 115, 2<<4 | 15, argp_yovq},                          // cvtsi2ss (213)
-	enc{[4]byte{0xf, 0x5a, 0x00, 0x00}, PREF_F3, SSE2, 0<<11 | 116, 2<<4 | 15, argp_yomd},                                     // cvtss2sd (214)
-	enc{[4]byte{0xf, 0x5a, 0x00, 0x00}, PREF_F3, SSE2, 1<<11 | 116, 2<<4 | 15, argp_yoyo},                                     // cvtss2sd (215)
-	enc{[4]byte{0xf, 0x2d, 0x00, 0x00}, PREF_F3, SSE, 0<<11 | 117, 2<<4 | 15, argp_rdmd},                                      // cvtss2si (216)
-	enc{[4]byte{0xf, 0x2d, 0x00, 0x00}, PREF_F3, SSE, 1<<11 | 117, 2<<4 | 15, argp_rdyo},                                      // cvtss2si (217)
-	enc{[4]byte{0xf, 0x2d, 0x00, 0x00}, WITH_REXW | PREF_F3, SSE, 2<<11 | 117, 2<<4 | 15, argp_rqmd},                          // cvtss2si (218)
-	enc{[4]byte{0xf, 0x2d, 0x00, 0x00}, WITH_REXW | PREF_F3, SSE, 3<<11 | 117, 2<<4 | 15, argp_rqyo},                          // cvtss2si (219)
-	enc{[4]byte{0xf, 0xe6, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 118, 2<<4 | 15, argp_yowo},                                     // cvttpd2dq (220)
-	enc{[4]byte{0xf, 0x2c, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 119, 2<<4 | 15, argp_xqwo},                                     // cvttpd2pi (221)
-	enc{[4]byte{0xf, 0x5b, 0x00, 0x00}, PREF_F3, SSE2, 0<<11 | 120, 2<<4 | 15, argp_yowo},                                     // cvttps2dq (222)
-	enc{[4]byte{0xf, 0x2c, 0x00, 0x00}, 0, MMX | SSE, 0<<11 | 121, 2<<4 | 15, argp_xqmq},                                      // cvttps2pi (223)
-	enc{[4]byte{0xf, 0x2c, 0x00, 0x00}, 0, MMX | SSE, 1<<11 | 121, 2<<4 | 15, argp_xqyo},                                      // cvttps2pi (224)
-	enc{[4]byte{0xf, 0x2c, 0x00, 0x00}, PREF_F2, SSE2, 0<<11 | 122, 2<<4 | 15, argp_rdmq},                                     // cvttsd2si (225)
-	enc{[4]byte{0xf, 0x2c, 0x00, 0x00}, PREF_F2, SSE2, 1<<11 | 122, 2<<4 | 15, argp_rdyo},                                     // cvttsd2si (226)
-	enc{[4]byte{0xf, 0x2c, 0x00, 0x00}, WITH_REXW | PREF_F2, SSE2, 2<<11 | 122, 2<<4 | 15, argp_rqmq},                         // cvttsd2si (227)
-	enc{[4]byte{0xf, 0x2c, 0x00, 0x00}, WITH_REXW | PREF_F2, SSE2, 3<<11 | 122, 2<<4 | 15, argp_rqyo},                         // cvttsd2si (228)
-	enc{[4]byte{0xf, 0x2c, 0x00, 0x00}, PREF_F3, SSE, 0<<11 | 123, 2<<4 | 15, argp_rdmd},                                      // cvttss2si (229)
-	enc{[4]byte{0xf, 0x2c, 0x00, 0x00}, PREF_F3, SSE, 1<<11 | 123, 2<<4 | 15, argp_rdyo},                                      // cvttss2si (230)
-	enc{[4]byte{0xf, 0x2c, 0x00, 0x00}, WITH_REXW | PREF_F3, SSE, 2<<11 | 123, 2<<4 | 15, argp_rqmd},                          // cvttss2si (231)
-	enc{[4]byte{0xf, 0x2c, 0x00, 0x00}, WITH_REXW | PREF_F3, SSE, 3<<11 | 123, 2<<4 | 15, argp_rqyo},                          // cvttss2si (232)
-	enc{[4]byte{0x99, 0x00, 0x00, 0x00}, WORD_SIZE, 0, 0<<11 | 124, 1<<4 | 15, argp_},                                         // cwd (233)
-	enc{[4]byte{0x98, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 125, 1<<4 | 15, argp_},                                                 // cwde (234)
-	enc{[4]byte{0xfe, 0x00, 0x00, 0x00}, LOCK, 0, 0<<11 | 126, 1<<4 | 1, argp_mb},                                             // dec (235)
-	enc{[4]byte{0xfe, 0x00, 0x00, 0x00}, 0, 0, 1<<11 | 126, 1<<4 | 1, argp_rb},                                                // dec (236)
-	enc{[4]byte{0xff, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, 0, 2<<11 | 126, 1<<4 | 1, argp_m0},                                 // dec (237)
-	enc{[4]byte{0xff, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 3<<11 | 126, 1<<4 | 1, argp_r0},                                        // dec (238)
-	enc{[4]byte{0xf6, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 127, 1<<4 | 6, argp_vb},                                                // div (239)
-	enc{[4]byte{0xf7, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 1<<11 | 127, 1<<4 | 6, argp_v0},                                        // div (240)
-	enc{[4]byte{0xf, 0x5e, 0x00, 0x00}, PREF_F2, SSE2, 0<<11 | 128, 2<<4 | 15, argp_yomq},                                     // divsd (241)
-	enc{[4]byte{0xf, 0x5e, 0x00, 0x00}, PREF_F2, SSE2, 1<<11 | 128, 2<<4 | 15, argp_yoyo},                                     // divsd (242)
-	enc{[4]byte{0xf, 0x5e, 0x00, 0x00}, PREF_F3, SSE, 0<<11 | 129, 2<<4 | 15, argp_yomd},                                      // divss (243)
-	enc{[4]byte{0xf, 0x5e, 0x00, 0x00}, PREF_F3, SSE, 1<<11 | 129, 2<<4 | 15, argp_yoyo},                                      // divss (244)
-	enc{[4]byte{0xf, 0x77, 0x00, 0x00}, 0, MMX, 0<<11 | 130, 2<<4 | 15, argp_},                                                // emms (245)
-	enc{[4]byte{0xc8, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 131, 1<<4 | 15, argp_iwib},                                             // enter (246)
-	enc{[4]byte{0xf, 0x78, 0x00, 0x00}, PREF_66, SSE4A | AMD, 0<<11 | 132, 2<<4 | 0, argp_yoibib},                             // extrq (247)
-	enc{[4]byte{0xf, 0x79, 0x00, 0x00}, PREF_66, SSE4A | AMD, 1<<11 | 132, 2<<4 | 15, argp_yoyo},                              // extrq (248)
-	enc{[4]byte{0xf, 0x37, 0x00, 0x00}, 0, 0, 0<<11 | 133, 2<<4 | 15, argp_},                                                  // getsec (249)
-	enc{[4]byte{0xf4, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 134, 1<<4 | 15, argp_},                                                 // hlt (250)
-	enc{[4]byte{0xf1, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 135, 1<<4 | 15, argp_},                                                 // icebp (251)
-	enc{[4]byte{0xf6, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 136, 1<<4 | 7, argp_vb},                                                // idiv (252)
-	enc{[4]byte{0xf7, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 1<<11 | 136, 1<<4 | 7, argp_v0},                                        // idiv (253)
-	enc{[4]byte{0xf7, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 137, 1<<4 | 5, argp_v0},                                        // imul (254)
-	enc{[4]byte{0xf6, 0x00, 0x00, 0x00}, 0, 0, 1<<11 | 137, 1<<4 | 5, argp_vb},                                                // imul (255)
-	enc{[4]byte{0xf, 0xaf, 0x00, 0x00}, AUTO_SIZE, 0, 2<<11 | 137, 2<<4 | 15, argp_r0v0},                                      // imul (256)
-	enc{[4]byte{0x6b, 0x00, 0x00, 0x00}, AUTO_SIZE | EXACT_SIZE, 0, 3<<11 | 137, 1<<4 | 15, argp_r0v0ib},                      // imul (257)
-	enc{[4]byte{0x69, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 4<<11 | 137, 1<<4 | 15, argp_r0v0i0},                                   // imul (258)
-	enc{[4]byte{0xe4, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 138, 1<<4 | 15, argp_Abib},                                             // in (259)
-	enc{[4]byte{0xe5, 0x00, 0x00, 0x00}, WORD_SIZE, 0, 1<<11 | 138, 1<<4 | 15, argp_Awib},                                     // in (260)
-	enc{[4]byte{0xe5, 0x00, 0x00, 0x00}, 0, 0, 2<<11 | 138, 1<<4 | 15, argp_Adib},                                             // in (261)
-	enc{[4]byte{0xec, 0x00, 0x00, 0x00}, 0, 0, 3<<11 | 138, 1<<4 | 15, argp_AbCw},                                             // in (262)
-	enc{[4]byte{0xed, 0x00, 0x00, 0x00}, WORD_SIZE, 0, 4<<11 | 138, 1<<4 | 15, argp_AwCw},                                     // in (263)
-	enc{[4]byte{0xed, 0x00, 0x00, 0x00}, 0, 0, 5<<11 | 138, 1<<4 | 15, argp_AdCw},                                             // in (264)
-	enc{[4]byte{0xfe, 0x00, 0x00, 0x00}, LOCK, 0, 0<<11 | 139, 1<<4 | 0, argp_mb},                                             // inc (265)
-	enc{[4]byte{0xfe, 0x00, 0x00, 0x00}, 0, 0, 1<<11 | 139, 1<<4 | 0, argp_rb},                                                // inc (266)
-	enc{[4]byte{0xff, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, 0, 2<<11 | 139, 1<<4 | 0, argp_m0},                                 // inc (267)
-	enc{[4]byte{0xff, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 3<<11 | 139, 1<<4 | 0, argp_r0},                                        // inc (268)
-	enc{[4]byte{0x6c, 0x00, 0x00, 0x00}, REP, 0, 0<<11 | 140, 1<<4 | 15, argp_},                                               // insb (269)
-	enc{[4]byte{0x6d, 0x00, 0x00, 0x00}, REP, 0, 0<<11 | 141, 1<<4 | 15, argp_},                                               // insd (270)
-	enc{[4]byte{0xf, 0x79, 0x00, 0x00}, PREF_F2, SSE4A | AMD, 0<<11 | 142, 2<<4 | 15, argp_yoyo},                              // insertq (271)
-	enc{[4]byte{0xf, 0x78, 0x00, 0x00}, PREF_F2, SSE4A | AMD, 1<<11 | 142, 2<<4 | 15, argp_yoyoibib},                          // insertq (272)
-	enc{[4]byte{0x6d, 0x00, 0x00, 0x00}, WORD_SIZE | REP, 0, 0<<11 | 143, 1<<4 | 15, argp_},                                   // insw (273)
-	enc{[4]byte{0xcd, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 144, 1<<4 | 15, argp_ib},                                               // int (274)
-	enc{[4]byte{0xf1, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 145, 1<<4 | 15, argp_},                                                 // int01 (275)
-	enc{[4]byte{0xcc, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 146, 1<<4 | 15, argp_},                                                 // int03 (276)
-	enc{[4]byte{0xf1, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 147, 1<<4 | 15, argp_},                                                 // int1 (277)
-	enc{[4]byte{0xcc, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 148, 1<<4 | 15, argp_},                                                 // int3 (278)
-	enc{[4]byte{0xf, 0x8, 0x00, 0x00}, 0, 0, 0<<11 | 149, 2<<4 | 15, argp_},                                                   // invd (279)
-	enc{[4]byte{0xf, 0x38, 0x80, 0x00}, PREF_66, VMX, 0<<11 | 150, 3<<4 | 15, argp_rqmo},                                      // invept (280)
-	enc{[4]byte{0xf, 0x1, 0x00, 0x00}, 0, 0, 0<<11 | 151, 2<<4 | 7, argp_m1},                                                  // invlpg (281)
-	enc{[4]byte{0xf, 0x1, 0xdf, 0x00}, 0, AMD, 0<<11 | 152, 3<<4 | 15, argp_},                                                 // invlpga (282)
-	enc{[4]byte{0xf, 0x1, 0xdf, 0x00}, 0, AMD, 1<<11 | 152, 3<<4 | 15, argp_AqBd},                                             // invlpga (283)
-	enc{[4]byte{0xf, 0x38, 0x81, 0x00}, PREF_66, VMX, 0<<11 | 153, 3<<4 | 15, argp_rqmo},                                      // invvpid (284)
-	enc{[4]byte{0xcf, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 154, 1<<4 | 15, argp_},                                                 // iret (285)
-	enc{[4]byte{0xcf, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 155, 1<<4 | 15, argp_},                                                 // iretd (286)
-	enc{[4]byte{0xcf, 0x00, 0x00, 0x00}, WITH_REXW, 0, 0<<11 | 156, 1<<4 | 15, argp_},                                         // iretq (287)
-	enc{[4]byte{0xcf, 0x00, 0x00, 0x00}, WORD_SIZE, 0, 0<<11 | 157, 1<<4 | 15, argp_},                                         // iretw (288)
-	enc{[4]byte{0x77, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 158, 1<<4 | 15, argp_ob},                                      // ja (289)
-	enc{[4]byte{0xf, 0x87, 0x00, 0x00}, 0, 0, 1<<11 | 158, 2<<4 | 15, argp_od},                                                // ja (290)
-	enc{[4]byte{0x73, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 159, 1<<4 | 15, argp_ob},                                      // jae (291)
-	enc{[4]byte{0xf, 0x83, 0x00, 0x00}, 0, 0, 1<<11 | 159, 2<<4 | 15, argp_od},                                                // jae (292)
-	enc{[4]byte{0x72, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 160, 1<<4 | 15, argp_ob},                                      // jb (293)
-	enc{[4]byte{0xf, 0x82, 0x00, 0x00}, 0, 0, 1<<11 | 160, 2<<4 | 15, argp_od},                                                // jb (294)
-	enc{[4]byte{0x76, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 161, 1<<4 | 15, argp_ob},                                      // jbe (295)
-	enc{[4]byte{0xf, 0x86, 0x00, 0x00}, 0, 0, 1<<11 | 161, 2<<4 | 15, argp_od},                                                // jbe (296)
-	enc{[4]byte{0x72, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 162, 1<<4 | 15, argp_ob},                                      // jc (297)
-	enc{[4]byte{0xf, 0x82, 0x00, 0x00}, 0, 0, 1<<11 | 162, 2<<4 | 15, argp_od},                                                // jc (298)
-	enc{[4]byte{0x74, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 163, 1<<4 | 15, argp_ob},                                      // je (299)
-	enc{[4]byte{0xf, 0x84, 0x00, 0x00}, 0, 0, 1<<11 | 163, 2<<4 | 15, argp_od},                                                // je (300)
-	enc{[4]byte{0xe3, 0x00, 0x00, 0x00}, PREF_67, 0, 0<<11 | 164, 1<<4 | 15, argp_ob},                                         // jecxz (301)
-	enc{[4]byte{0x7f, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 165, 1<<4 | 15, argp_ob},                                      // jg (302)
-	enc{[4]byte{0xf, 0x8f, 0x00, 0x00}, 0, 0, 1<<11 | 165, 2<<4 | 15, argp_od},                                                // jg (303)
-	enc{[4]byte{0x7d, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 166, 1<<4 | 15, argp_ob},                                      // jge (304)
-	enc{[4]byte{0xf, 0x8d, 0x00, 0x00}, 0, 0, 1<<11 | 166, 2<<4 | 15, argp_od},                                                // jge (305)
-	enc{[4]byte{0x7c, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 167, 1<<4 | 15, argp_ob},                                      // jl (306)
-	enc{[4]byte{0xf, 0x8c, 0x00, 0x00}, 0, 0, 1<<11 | 167, 2<<4 | 15, argp_od},                                                // jl (307)
-	enc{[4]byte{0x7e, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 168, 1<<4 | 15, argp_ob},                                      // jle (308)
-	enc{[4]byte{0xf, 0x8e, 0x00, 0x00}, 0, 0, 1<<11 | 168, 2<<4 | 15, argp_od},                                                // jle (309)
-	enc{[4]byte{0xeb, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 169, 1<<4 | 15, argp_ob},                                      // jmp (310)
-	enc{[4]byte{0xe9, 0x00, 0x00, 0x00}, 0, 0, 1<<11 | 169, 1<<4 | 15, argp_od},                                               // jmp (311)
-	enc{[4]byte{0xff, 0x00, 0x00, 0x00}, AUTO_NO32, 0, 2<<11 | 169, 1<<4 | 4, argp_v0},                                        // jmp (312)
-	enc{[4]byte{0x76, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 170, 1<<4 | 15, argp_ob},                                      // jna (313)
-	enc{[4]byte{0xf, 0x86, 0x00, 0x00}, 0, 0, 1<<11 | 170, 2<<4 | 15, argp_od},                                                // jna (314)
-	enc{[4]byte{0x72, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 171, 1<<4 | 15, argp_ob},                                      // jnae (315)
-	enc{[4]byte{0xf, 0x82, 0x00, 0x00}, 0, 0, 1<<11 | 171, 2<<4 | 15, argp_od},                                                // jnae (316)
-	enc{[4]byte{0x73, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 172, 1<<4 | 15, argp_ob},                                      // jnb (317)
-	enc{[4]byte{0xf, 0x83, 0x00, 0x00}, 0, 0, 1<<11 | 172, 2<<4 | 15, argp_od},                                                // jnb (318)
-	enc{[4]byte{0x77, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 173, 1<<4 | 15, argp_ob},                                      // jnbe (319)
-	enc{[4]byte{0xf, 0x87, 0x00, 0x00}, 0, 0, 1<<11 | 173, 2<<4 | 15, argp_od},                                                // jnbe (320)
-	enc{[4]byte{0x73, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 174, 1<<4 | 15, argp_ob},                                      // jnc (321)
-	enc{[4]byte{0xf, 0x83, 0x00, 0x00}, 0, 0, 1<<11 | 174, 2<<4 | 15, argp_od},                                                // jnc (322)
-	enc{[4]byte{0x75, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 175, 1<<4 | 15, argp_ob},                                      // jne (323)
-	enc{[4]byte{0xf, 0x85, 0x00, 0x00}, 0, 0, 1<<11 | 175, 2<<4 | 15, argp_od},                                                // jne (324)
-	enc{[4]byte{0x7e, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 176, 1<<4 | 15, argp_ob},                                      // jng (325)
-	enc{[4]byte{0xf, 0x8e, 0x00, 0x00}, 0, 0, 1<<11 | 176, 2<<4 | 15, argp_od},                                                // jng (326)
-	enc{[4]byte{0x7c, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 177, 1<<4 | 15, argp_ob},                                      // jnge (327)
-	enc{[4]byte{0xf, 0x8c, 0x00, 0x00}, 0, 0, 1<<11 | 177, 2<<4 | 15, argp_od},                                                // jnge (328)
-	enc{[4]byte{0x7d, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 178, 1<<4 | 15, argp_ob},                                      // jnl (329)
-	enc{[4]byte{0xf, 0x8d, 0x00, 0x00}, 0, 0, 1<<11 | 178, 2<<4 | 15, argp_od},                                                // jnl (330)
-	enc{[4]byte{0x7f, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 179, 1<<4 | 15, argp_ob},                                      // jnle (331)
-	enc{[4]byte{0xf, 0x8f, 0x00, 0x00}, 0, 0, 1<<11 | 179, 2<<4 | 15, argp_od},                                                // jnle (332)
-	enc{[4]byte{0x71, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 180, 1<<4 | 15, argp_ob},                                      // jno (333)
-	enc{[4]byte{0xf, 0x81, 0x00, 0x00}, 0, 0, 1<<11 | 180, 2<<4 | 15, argp_od},                                                // jno (334)
-	enc{[4]byte{0x7b, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 181, 1<<4 | 15, argp_ob},                                      // jnp (335)
-	enc{[4]byte{0xf, 0x8b, 0x00, 0x00}, 0, 0, 1<<11 | 181, 2<<4 | 15, argp_od},                                                // jnp (336)
-	enc{[4]byte{0x79, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 182, 1<<4 | 15, argp_ob},                                      // jns (337)
-	enc{[4]byte{0xf, 0x89, 0x00, 0x00}, 0, 0, 1<<11 | 182, 2<<4 | 15, argp_od},                                                // jns (338)
-	enc{[4]byte{0x75, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 183, 1<<4 | 15, argp_ob},                                      // jnz (339)
-	enc{[4]byte{0xf, 0x85, 0x00, 0x00}, 0, 0, 1<<11 | 183, 2<<4 | 15, argp_od},                                                // jnz (340)
-	enc{[4]byte{0x70, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 184, 1<<4 | 15, argp_ob},                                      // jo (341)
-	enc{[4]byte{0xf, 0x80, 0x00, 0x00}, 0, 0, 1<<11 | 184, 2<<4 | 15, argp_od},                                                // jo (342)
-	enc{[4]byte{0x7a, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 185, 1<<4 | 15, argp_ob},                                      // jp (343)
-	enc{[4]byte{0xf, 0x8a, 0x00, 0x00}, 0, 0, 1<<11 | 185, 2<<4 | 15, argp_od},                                                // jp (344)
-	enc{[4]byte{0x7a, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 186, 1<<4 | 15, argp_ob},                                      // jpe (345)
-	enc{[4]byte{0xf, 0x8a, 0x00, 0x00}, 0, 0, 1<<11 | 186, 2<<4 | 15, argp_od},                                                // jpe (346)
-	enc{[4]byte{0x7b, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 187, 1<<4 | 15, argp_ob},                                      // jpo (347)
-	enc{[4]byte{0xf, 0x8b, 0x00, 0x00}, 0, 0, 1<<11 | 187, 2<<4 | 15, argp_od},                                                // jpo (348)
-	enc{[4]byte{0xe3, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 188, 1<<4 | 15, argp_ob},                                               // jrcxz (349)
-	enc{[4]byte{0x78, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 189, 1<<4 | 15, argp_ob},                                      // js (350)
-	enc{[4]byte{0xf, 0x88, 0x00, 0x00}, 0, 0, 1<<11 | 189, 2<<4 | 15, argp_od},                                                // js (351)
-	enc{[4]byte{0x74, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 0<<11 | 190, 1<<4 | 15, argp_ob},                                      // jz (352)
-	enc{[4]byte{0xf, 0x84, 0x00, 0x00}, 0, 0, 1<<11 | 190, 2<<4 | 15, argp_od},                                                // jz (353)
-	enc{[4]byte{0x9f, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 191, 1<<4 | 15, argp_},                                                 // lahf (354)
-	enc{[4]byte{0xf, 0x2, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 192, 2<<4 | 15, argp_r0mw},                                       // lar (355)
-	enc{[4]byte{0xf, 0x2, 0x00, 0x00}, AUTO_SIZE, 0, 1<<11 | 192, 2<<4 | 15, argp_r0r0},                                       // lar (356)
-	enc{[4]byte{0xf, 0xf0, 0x00, 0x00}, PREF_F2, SSE3, 0<<11 | 193, 2<<4 | 15, argp_yomo},                                     // lddqu (357)
-	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, 0, SSE, 0<<11 | 194, 2<<4 | 2, argp_md},                                               // ldmxcsr (358)
-	enc{[4]byte{0x8d, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 195, 1<<4 | 15, argp_r0m1},                                     // lea (359)
-	enc{[4]byte{0xc9, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 196, 1<<4 | 15, argp_},                                                 // leave (360)
-	enc{[4]byte{0xf, 0xae, 0xe8, 0x00}, 0, AMD, 0<<11 | 197, 3<<4 | 15, argp_},                                                // lfence (361)
-	enc{[4]byte{0xf, 0xb4, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 198, 2<<4 | 15, argp_r0m1},                                      // lfs (362)
-	enc{[4]byte{0xf, 0x1, 0x00, 0x00}, 0, 0, 0<<11 | 199, 2<<4 | 2, argp_m1},                                                  // lgdt (363)
-	enc{[4]byte{0xf, 0xb5, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 200, 2<<4 | 15, argp_r0m1},                                      // lgs (364)
-	enc{[4]byte{0xf, 0x1, 0x00, 0x00}, 0, 0, 0<<11 | 201, 2<<4 | 3, argp_m1},                                                  // lidt (365)
-	enc{[4]byte{0xf, 0x0, 0x00, 0x00}, 0, 0, 0<<11 | 202, 2<<4 | 2, argp_m1},                                                  // lldt (366)
-	enc{[4]byte{0xf, 0x0, 0x00, 0x00}, 0, 0, 1<<11 | 202, 2<<4 | 2, argp_rw},                                                  // lldt (367)
-	enc{[4]byte{0x9, 0x12, 0x00, 0x00}, XOP_OP | AUTO_REXW, AMD, 0<<11 | 203, 2<<4 | 0, argp_r0},                              // llwpcb (368)
-	enc{[4]byte{0xf, 0x1, 0x00, 0x00}, 0, 0, 0<<11 | 204, 2<<4 | 6, argp_m1},                                                  // lmsw (369)
-	enc{[4]byte{0xf, 0x1, 0x00, 0x00}, 0, 0, 1<<11 | 204, 2<<4 | 6, argp_rw},                                                  // lmsw (370)
-	enc{[4]byte{0xac, 0x00, 0x00, 0x00}, REP, 0, 0<<11 | 205, 1<<4 | 15, argp_},                                               // lodsb (371)
-	enc{[4]byte{0xad, 0x00, 0x00, 0x00}, REP, 0, 0<<11 | 206, 1<<4 | 15, argp_},                                               // lodsd (372)
-	enc{[4]byte{0xad, 0x00, 0x00, 0x00}, WITH_REXW | REP, 0, 0<<11 | 207, 1<<4 | 15, argp_},                                   // lodsq (373)
-	enc{[4]byte{0xad, 0x00, 0x00, 0x00}, WORD_SIZE | REP, 0, 0<<11 | 208, 1<<4 | 15, argp_},                                   // lodsw (374)
-	enc{[4]byte{0xe2, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 209, 1<<4 | 15, argp_ob},                                               // loop (375)
-	enc{[4]byte{0xe1, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 210, 1<<4 | 15, argp_ob},                                               // loope (376)
-	enc{[4]byte{0xe0, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 211, 1<<4 | 15, argp_ob},                                               // loopne (377)
-	enc{[4]byte{0xe0, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 212, 1<<4 | 15, argp_ob},                                               // loopnz (378)
-	enc{[4]byte{0xe1, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 213, 1<<4 | 15, argp_ob},                                               // loopz (379)
-	enc{[4]byte{0xf, 0x3, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 214, 2<<4 | 15, argp_r0mw},                                       // lsl (380)
-	enc{[4]byte{0xf, 0x3, 0x00, 0x00}, AUTO_SIZE, 0, 1<<11 | 214, 2<<4 | 15, argp_r0r0},                                       // lsl (381)
-	enc{[4]byte{0xf, 0xb2, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 215, 2<<4 | 15, argp_r0m1},                                      // lss (382)
-	enc{[4]byte{0xf, 0x0, 0x00, 0x00}, 0, 0, 0<<11 | 216, 2<<4 | 3, argp_m1},                                                  // ltr (383)
-	enc{[4]byte{0xf, 0x0, 0x00, 0x00}, 0, 0, 1<<11 | 216, 2<<4 | 3, argp_rw},                                                  // ltr (384)
-	enc{[4]byte{0x10, 0x12, 0x00, 0x00}, XOP_OP | AUTO_REXW | ENC_VM, AMD, 0<<11 | 217, 2<<4 | 0, argp_r0v0id},                // lwpins (385)
-	enc{[4]byte{0x10, 0x12, 0x00, 0x00}, XOP_OP | AUTO_REXW | ENC_VM, AMD, 0<<11 | 218, 2<<4 | 1, argp_r0v0id},                // lwpval (386)
-	enc{[4]byte{0xf, 0xbd, 0x00, 0x00}, AUTO_SIZE | PREF_F3, AMD, 0<<11 | 219, 2<<4 | 15, argp_r0v0},                          // lzcnt (387)
-	enc{[4]byte{0xf, 0xf7, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 220, 2<<4 | 15, argp_yoyo},                                     // maskmovdqu (388)
-	enc{[4]byte{0xf, 0xf7, 0x00, 0x00}, 0, MMX, 0<<11 | 221, 2<<4 | 15, argp_xqxq},                                            // maskmovq (389)
-	enc{[4]byte{0xf, 0x5f, 0x00, 0x00}, PREF_F2, SSE2, 0<<11 | 222, 2<<4 | 15, argp_yomq},                                     // maxsd (390)
-	enc{[4]byte{0xf, 0x5f, 0x00, 0x00}, PREF_F2, SSE2, 1<<11 | 222, 2<<4 | 15, argp_yoyo},                                     // maxsd (391)
-	enc{[4]byte{0xf, 0x5f, 0x00, 0x00}, PREF_F3, SSE, 0<<11 | 223, 2<<4 | 15, argp_yomd},                                      // maxss (392)
-	enc{[4]byte{0xf, 0x5f, 0x00, 0x00}, PREF_F3, SSE, 1<<11 | 223, 2<<4 | 15, argp_yoyo},                                      // maxss (393)
-	enc{[4]byte{0xf, 0xae, 0xf0, 0x00}, 0, AMD, 0<<11 | 224, 3<<4 | 15, argp_},                                                // mfence (394)
-	enc{[4]byte{0xf, 0x5d, 0x00, 0x00}, PREF_F2, SSE2, 0<<11 | 225, 2<<4 | 15, argp_yomq},                                     // minsd (395)
-	enc{[4]byte{0xf, 0x5d, 0x00, 0x00}, PREF_F2, SSE2, 1<<11 | 225, 2<<4 | 15, argp_yoyo},                                     // minsd (396)
-	enc{[4]byte{0xf, 0x5d, 0x00, 0x00}, PREF_F3, SSE, 0<<11 | 226, 2<<4 | 15, argp_yomd},                                      // minss (397)
-	enc{[4]byte{0xf, 0x5d, 0x00, 0x00}, PREF_F3, SSE, 1<<11 | 226, 2<<4 | 15, argp_yoyo},                                      // minss (398)
-	enc{[4]byte{0xf, 0x1, 0xc8, 0x00}, 0, 0, 0<<11 | 227, 3<<4 | 15, argp_},                                                   // monitor (399)
-	enc{[4]byte{0xf, 0x1, 0xc8, 0x00}, 0, 0, 1<<11 | 227, 3<<4 | 15, argp_AqBdCd},                                             // monitor (400)
-	enc{[4]byte{0xf, 0x1, 0xfa, 0x00}, 0, AMD, 0<<11 | 228, 3<<4 | 15, argp_},                                                 // monitorx (401)
-	enc{[4]byte{0xf, 0x1, 0xfa, 0x00}, 0, AMD, 1<<11 | 228, 3<<4 | 15, argp_A0BdCd},                                           // monitorx (402)
-	enc{[4]byte{0x89, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 0<<11 | 229, 1<<4 | 15, argp_v0r0},                                     // mov (403)
-	enc{[4]byte{0x88, 0x00, 0x00, 0x00}, 0, 0, 1<<11 | 229, 1<<4 | 15, argp_vbrb},                                             // mov (404)
-	enc{[4]byte{0x8b, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 2<<11 | 229, 1<<4 | 15, argp_r0v0},                                     // mov (405)
-	enc{[4]byte{0x8a, 0x00, 0x00, 0x00}, 0, 0, 3<<11 | 229, 1<<4 | 15, argp_rbvb},                                             // mov (406)
-	enc{[4]byte{0x8c, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 4<<11 | 229, 1<<4 | 15, argp_r0sw},                                     // mov (407)
-	enc{[4]byte{0x8c, 0x00, 0x00, 0x00}, 0, 0, 5<<11 | 229, 1<<4 | 15, argp_mwsw},                                             // mov (408)
-	enc{[4]byte{0x8c, 0x00, 0x00, 0x00}, 0, 0, 6<<11 | 229, 1<<4 | 15, argp_swmw},                                             // mov (409)
-	enc{[4]byte{0x8c, 0x00, 0x00, 0x00}, 0, 0, 7<<11 | 229, 1<<4 | 15, argp_swrw},                                             // mov (410)
-	enc{[4]byte{0xb0, 0x00, 0x00, 0x00}, SHORT_ARG, 0, 8<<11 | 229, 1<<4 | 15, argp_rbib},                                     // mov (411)
-	enc{[4]byte{0xb8, 0x00, 0x00, 0x00}, WORD_SIZE | SHORT_ARG, 0, 9<<11 | 229, 1<<4 | 15, argp_rwiw},                         // mov (412)
-	enc{[4]byte{0xb8, 0x00, 0x00, 0x00}, SHORT_ARG, 0, 10<<11 | 229, 1<<4 | 15, argp_rdid},                                    // mov (413)
-	enc{[4]byte{0xb8, 0x00, 0x00, 0x00}, WITH_REXW | SHORT_ARG, 0, 11<<11 | 229, 1<<4 | 15, argp_rqiq},                        // mov (414)
-	enc{[4]byte{0xc7, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 12<<11 | 229, 1<<4 | 0, argp_v0i0},                                     // mov (415)
-	enc{[4]byte{0xc6, 0x00, 0x00, 0x00}, 0, 0, 13<<11 | 229, 1<<4 | 0, argp_vbib},                                             // mov (416)
-	enc{[4]byte{0xf, 0x22, 0x00, 0x00}, 0, 0, 14<<11 | 229, 2<<4 | 15, argp_cdrd},                                             // mov (417)
-	enc{[4]byte{0xf, 0x22, 0x00, 0x00}, 0, 0, 15<<11 | 229, 2<<4 | 15, argp_cqrq},                                             // mov (418)
-	enc{[4]byte{0xf, 0x20, 0x00, 0x00}, 0, 0, 16<<11 | 229, 2<<4 | 15, argp_rdcd},                                             // mov (419)
-	enc{[4]byte{0xf, 0x20, 0x00, 0x00}, 0, 0, 17<<11 | 229, 2<<4 | 15, argp_rqcq},                                             // mov (420)
-	enc{[4]byte{0xf, 0x22, 0x00, 0x00}, PREF_F0, 0, 18<<11 | 229, 2<<4 | 0, argp_Wdrd},                                        // mov (421)
-	enc{[4]byte{0xf, 0x22, 0x00, 0x00}, PREF_F0, 0, 19<<11 | 229, 2<<4 | 0, argp_Wqrq},                                        // mov (422)
-	enc{[4]byte{0xf, 0x22, 0x00, 0x00}, PREF_F0, 0, 20<<11 | 229, 2<<4 | 0, argp_rdWd},                                        // mov (423)
-	enc{[4]byte{0xf, 0x22, 0x00, 0x00}, PREF_F0, 0, 21<<11 | 229, 2<<4 | 0, argp_rqWq},                                        // mov (424)
-	enc{[4]byte{0xf, 0x23, 0x00, 0x00}, 0, 0, 22<<11 | 229, 2<<4 | 15, argp_ddrd},                                             // mov (425)
-	enc{[4]byte{0xf, 0x23, 0x00, 0x00}, 0, 0, 23<<11 | 229, 2<<4 | 15, argp_dqrq},                                             // mov (426)
-	enc{[4]byte{0xf, 0x21, 0x00, 0x00}, 0, 0, 24<<11 | 229, 2<<4 | 15, argp_rddd},                                             // mov (427)
-	enc{[4]byte{0xf, 0x21, 0x00, 0x00}, 0, 0, 25<<11 | 229, 2<<4 | 15, argp_rqdq},                                             // mov (428)
-	enc{[4]byte{0xa0, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 230, 1<<4 | 15, argp_Abiq},                                             // movabs (429)
-	enc{[4]byte{0xa1, 0x00, 0x00, 0x00}, WORD_SIZE, 0, 1<<11 | 230, 1<<4 | 15, argp_Awiq},                                     // movabs (430)
-	enc{[4]byte{0xa1, 0x00, 0x00, 0x00}, 0, 0, 2<<11 | 230, 1<<4 | 15, argp_Adiq},                                             // movabs (431)
-	enc{[4]byte{0xa1, 0x00, 0x00, 0x00}, WITH_REXW, 0, 3<<11 | 230, 1<<4 | 15, argp_Aqiq},                                     // movabs (432)
-	enc{[4]byte{0xa2, 0x00, 0x00, 0x00}, 0, 0, 4<<11 | 230, 1<<4 | 15, argp_iqAb},                                             // movabs (433)
-	enc{[4]byte{0xa3, 0x00, 0x00, 0x00}, WORD_SIZE, 0, 5<<11 | 230, 1<<4 | 15, argp_iqAw},                                     // movabs (434)
-	enc{[4]byte{0xa3, 0x00, 0x00, 0x00}, 0, 0, 6<<11 | 230, 1<<4 | 15, argp_iqAd},                                             // movabs (435)
-	enc{[4]byte{0xa3, 0x00, 0x00, 0x00}, WITH_REXW, 0, 7<<11 | 230, 1<<4 | 15, argp_iqAq},                                     // movabs (436)
-	enc{[4]byte{0xf, 0x29, 0x00, 0x00}, PREF_66 | ENC_MR, SSE2, 0<<11 | 231, 2<<4 | 15, argp_moyo},                            // movapd (437)
-	enc{[4]byte{0xf, 0x28, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 231, 2<<4 | 15, argp_yomo},                                     // movapd (438)
-	enc{[4]byte{0xf, 0x28, 0x00, 0x00}, PREF_66, SSE2, 2<<11 | 231, 2<<4 | 15, argp_yoyo},                                     // movapd (439)
-	enc{[4]byte{0xf, 0x29, 0x00, 0x00}, PREF_66 | ENC_MR, SSE2, 3<<11 | 231, 2<<4 | 15, argp_yoyo},                            // movapd (440)
-	enc{[4]byte{0xf, 0x28, 0x00, 0x00}, 0, SSE, 0<<11 | 232, 2<<4 | 15, argp_yowo},                                            // movaps (441)
-	enc{[4]byte{0xf, 0x29, 0x00, 0x00}, ENC_MR, SSE, 1<<11 | 232, 2<<4 | 15, argp_woyo},                                       // movaps (442)
-	enc{[4]byte{0xf, 0x38, 0xf1, 0x00}, AUTO_SIZE | ENC_MR, 0, 0<<11 | 233, 3<<4 | 15, argp_m0r0},                             // movbe (443)
-	enc{[4]byte{0xf, 0x38, 0xf0, 0x00}, AUTO_SIZE, 0, 1<<11 | 233, 3<<4 | 15, argp_r0m0},                                      // movbe (444)
-	enc{[4]byte{0xf, 0x7e, 0x00, 0x00}, PREF_66 | ENC_MR, SSE2, 0<<11 | 234, 2<<4 | 15, argp_mdyo},                            // movd (445)
-	enc{[4]byte{0xf, 0x6e, 0x00, 0x00}, 0, MMX, 1<<11 | 234, 2<<4 | 15, argp_xqvd},                                            // movd (446)
-	enc{[4]byte{0xf, 0x6e, 0x00, 0x00}, WITH_REXW, MMX, 2<<11 | 234, 2<<4 | 15, argp_xqvq},                                    // movd (447)
-	enc{[4]byte{0xf, 0x6e, 0x00, 0x00}, PREF_66, SSE2, 3<<11 | 234, 2<<4 | 15, argp_yomd},                                     // movd (448)
-	enc{[4]byte{0xf, 0x6e, 0x00, 0x00}, PREF_66, SSE2, 4<<11 | 234, 2<<4 | 15, argp_yovd},                                     // movd (449)
-	enc{[4]byte{0xf, 0x7e, 0x00, 0x00}, ENC_MR, MMX, 5<<11 | 234, 2<<4 | 15, argp_vdxq},                                       // movd (450)
-	enc{[4]byte{0xf, 0x7e, 0x00, 0x00}, PREF_66 | ENC_MR, SSE2, 6<<11 | 234, 2<<4 | 15, argp_vdyo},                            // movd (451)
-	enc{[4]byte{0xf, 0x7e, 0x00, 0x00}, WITH_REXW | ENC_MR, MMX, 7<<11 | 234, 2<<4 | 15, argp_vqxq},                           // movd (452)
-	enc{[4]byte{0xf, 0x12, 0x00, 0x00}, PREF_F2, SSE3, 0<<11 | 235, 2<<4 | 15, argp_yomq},                                     // movddup (453)
-	enc{[4]byte{0xf, 0x12, 0x00, 0x00}, PREF_F2, SSE3, 1<<11 | 235, 2<<4 | 15, argp_yoyo},                                     // movddup (454)
-	enc{[4]byte{0xf, 0xd6, 0x00, 0x00}, PREF_F2, SSE2, 0<<11 | 236, 2<<4 | 15, argp_xqyo},                                     // movdq2q (455)
-	enc{[4]byte{0xf, 0x7f, 0x00, 0x00}, PREF_66 | ENC_MR, SSE2, 0<<11 | 237, 2<<4 | 15, argp_moyo},                            // movdqa (456)
-	enc{[4]byte{0xf, 0x6f, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 237, 2<<4 | 15, argp_yomo},                                     // movdqa (457)
-	enc{[4]byte{0xf, 0x6f, 0x00, 0x00}, PREF_66, SSE2, 2<<11 | 237, 2<<4 | 15, argp_yoyo},                                     // movdqa (458)
-	enc{[4]byte{0xf, 0x7f, 0x00, 0x00}, PREF_66 | ENC_MR, SSE2, 3<<11 | 237, 2<<4 | 15, argp_yoyo},                            // movdqa (459)
-	enc{[4]byte{0xf, 0x7f, 0x00, 0x00}, PREF_F3 | ENC_MR, SSE2, 0<<11 | 238, 2<<4 | 15, argp_moyo},                            // movdqu (460)
-	enc{[4]byte{0xf, 0x6f, 0x00, 0x00}, PREF_F3, SSE2, 1<<11 | 238, 2<<4 | 15, argp_yomo},                                     // movdqu (461)
-	enc{[4]byte{0xf, 0x6f, 0x00, 0x00}, PREF_F3, SSE2, 2<<11 | 238, 2<<4 | 15, argp_yoyo},                                     // movdqu (462)
-	enc{[4]byte{0xf, 0x7f, 0x00, 0x00}, PREF_F3 | ENC_MR, SSE2, 3<<11 | 238, 2<<4 | 15, argp_yoyo},                            // movdqu (463)
-	enc{[4]byte{0xf, 0x12, 0x00, 0x00}, 0, SSE, 0<<11 | 239, 2<<4 | 15, argp_yoyo},                                            // movhlps (464)
-	enc{[4]byte{0xf, 0x17, 0x00, 0x00}, PREF_66 | ENC_MR, SSE2, 0<<11 | 240, 2<<4 | 15, argp_m1yo},                            // movhpd (465)
-	enc{[4]byte{0xf, 0x16, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 240, 2<<4 | 15, argp_yom1},                                     // movhpd (466)
-	enc{[4]byte{0xf, 0x17, 0x00, 0x00}, ENC_MR, SSE, 0<<11 | 241, 2<<4 | 15, argp_mqyo},                                       // movhps (467)
-	enc{[4]byte{0xf, 0x16, 0x00, 0x00}, 0, SSE, 1<<11 | 241, 2<<4 | 15, argp_yomq},                                            // movhps (468)
-	enc{[4]byte{0xf, 0x16, 0x00, 0x00}, 0, SSE, 0<<11 | 242, 2<<4 | 15, argp_yoyo},                                            // movlhps (469)
-	enc{[4]byte{0xf, 0x13, 0x00, 0x00}, PREF_66 | ENC_MR, SSE2, 0<<11 | 243, 2<<4 | 15, argp_mqyo},                            // movlpd (470)
-	enc{[4]byte{0xf, 0x12, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 243, 2<<4 | 15, argp_yomq},                                     // movlpd (471)
-	enc{[4]byte{0xf, 0x13, 0x00, 0x00}, ENC_MR, SSE, 0<<11 | 244, 2<<4 | 15, argp_mqyo},                                       // movlps (472)
-	enc{[4]byte{0xf, 0x12, 0x00, 0x00}, 0, SSE, 1<<11 | 244, 2<<4 | 15, argp_yomq},                                            // movlps (473)
-	enc{[4]byte{0xf, 0x50, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 245, 2<<4 | 15, argp_rdyo},                                     // movmskpd (474)
-	enc{[4]byte{0xf, 0x50, 0x00, 0x00}, WITH_REXW | PREF_66, SSE2, 1<<11 | 245, 2<<4 | 15, argp_rqyo},                         // movmskpd (475)
-	enc{[4]byte{0xf, 0x50, 0x00, 0x00}, 0, SSE, 0<<11 | 246, 2<<4 | 15, argp_rdyo},                                            // movmskps (476)
-	enc{[4]byte{0xf, 0x50, 0x00, 0x00}, WITH_REXW, SSE, 1<<11 | 246, 2<<4 | 15, argp_rqyo},                                    // movmskps (477)
-	enc{[4]byte{0xf, 0xe7, 0x00, 0x00}, PREF_66 | ENC_MR, SSE2, 0<<11 | 247, 2<<4 | 15, argp_moyo},                            // movntdq (478)
-	enc{[4]byte{0xf, 0x38, 0x2a, 0x00}, PREF_66, SSE41, 0<<11 | 248, 3<<4 | 15, argp_yomo},                                    // movntdqa (479)
-	enc{[4]byte{0xf, 0xc3, 0x00, 0x00}, ENC_MR, 0, 0<<11 | 249, 2<<4 | 15, argp_mdrd},                                         // movnti (480)
-	enc{[4]byte{0xf, 0xc3, 0x00, 0x00}, WITH_REXW | ENC_MR, 0, 1<<11 | 249, 2<<4 | 15, argp_mqrq},                             // movnti (481)
-	enc{[4]byte{0xf, 0x2b, 0x00, 0x00}, PREF_66 | ENC_MR, SSE2, 0<<11 | 250, 2<<4 | 15, argp_moyo},                            // movntpd (482)
-	enc{[4]byte{0xf, 0x2b, 0x00, 0x00}, ENC_MR, SSE, 0<<11 | 251, 2<<4 | 15, argp_moyo},                                       // movntps (483)
-	enc{[4]byte{0xf, 0xe7, 0x00, 0x00}, ENC_MR, MMX, 0<<11 | 252, 2<<4 | 15, argp_mqxq},                                       // movntq (484)
-	enc{[4]byte{0xf, 0x2b, 0x00, 0x00}, PREF_F2 | ENC_MR, SSE4A | AMD, 0<<11 | 253, 2<<4 | 15, argp_mqyo},                     // movntsd (485)
-	enc{[4]byte{0xf, 0x2b, 0x00, 0x00}, PREF_F3 | ENC_MR, SSE4A | AMD, 0<<11 | 254, 2<<4 | 15, argp_mdyo},                     // movntss (486)
-	enc{[4]byte{0xf, 0xd6, 0x00, 0x00}, PREF_66 | ENC_MR, SSE2, 0<<11 | 255, 2<<4 | 15, argp_mqyo},                            // movq (487)
-	enc{[4]byte{0xf, 0x6f, 0x00, 0x00}, 0, MMX, 1<<11 | 255, 2<<4 | 15, argp_xquq},                                            // movq (488)
-	enc{[4]byte{0xf, 0x6e, 0x00, 0x00}, WITH_REXW, MMX, 2<<11 | 255, 2<<4 | 15, argp_xqvq},                                    // movq (489)
-	enc{[4]byte{0xf, 0x7e, 0x00, 0x00}, PREF_F3, SSE2, 3<<11 | 255, 2<<4 | 15, argp_yomq},                                     // movq (490)
-	enc{[4]byte{0xf, 0x6e, 0x00, 0x00}, WITH_REXW | PREF_66, SSE2, 4<<11 | 255, 2<<4 | 15, argp_yovq},                         // movq (491)
-	enc{[4]byte{0xf, 0x7e, 0x00, 0x00}, PREF_F3, SSE2, 5<<11 | 255, 2<<4 | 15, argp_yoyo},                                     // movq (492)
-	enc{[4]byte{0xf, 0xd6, 0x00, 0x00}, PREF_66 | ENC_MR, SSE2, 6<<11 | 255, 2<<4 | 15, argp_yoyo},                            // movq (493)
-	enc{[4]byte{0xf, 0x7f, 0x00, 0x00}, ENC_MR, MMX, 7<<11 | 255, 2<<4 | 15, argp_uqxq},                                       // movq (494)
-	enc{[4]byte{0xf, 0x7e, 0x00, 0x00}, WITH_REXW | ENC_MR, MMX, 8<<11 | 255, 2<<4 | 15, argp_vqxq},                           // movq (495)
-	enc{[4]byte{0xf, 0x7e, 0x00, 0x00}, WITH_REXW | PREF_66 | ENC_MR, SSE2, 9<<11 | 255, 2<<4 | 15, argp_vqyo},                // movq (496)
-	enc{[4]byte{0xf, 0xd6, 0x00, 0x00}, PREF_F3, SSE2, 0<<11 | 256, 2<<4 | 15, argp_yoxq},                                     // movq2dq (497)
-	enc{[4]byte{0xa4, 0x00, 0x00, 0x00}, REP, 0, 0<<11 | 257, 1<<4 | 15, argp_},                                               // movsb (498)
-	enc{[4]byte{0xa5, 0x00, 0x00, 0x00}, REP, 0, 0<<11 | 258, 1<<4 | 15, argp_},                                               // movsd (499)
-	enc{[4]byte{0xf, 0x11, 0x00, 0x00}, PREF_F2 | ENC_MR, SSE2, 1<<11 | 258, 2<<4 | 15, argp_mqyo},                            // movsd (500)
-	enc{[4]byte{0xf, 0x10, 0x00, 0x00}, PREF_F2, SSE2, 2<<11 | 258, 2<<4 | 15, argp_yomq},                                     // movsd (501)
-	enc{[4]byte{0xf, 0x10, 0x00, 0x00}, PREF_F2, SSE2, 3<<11 | 258, 2<<4 | 15, argp_yoyo},                                     // movsd (502)
-	enc{[4]byte{0xf, 0x11, 0x00, 0x00}, PREF_F2 | ENC_MR, SSE2, 4<<11 | 258, 2<<4 | 15, argp_yoyo},                            // movsd (503)
-	enc{[4]byte{0xf, 0x16, 0x00, 0x00}, PREF_F3, SSE3, 0<<11 | 259, 2<<4 | 15, argp_yomq},                                     // movshdup (504)
-	enc{[4]byte{0xf, 0x16, 0x00, 0x00}, PREF_F3, SSE3, 1<<11 | 259, 2<<4 | 15, argp_yoyo},                                     // movshdup (505)
-	enc{[4]byte{0xf, 0x12, 0x00, 0x00}, PREF_F3, SSE3, 0<<11 | 260, 2<<4 | 15, argp_yomq},                                     // movsldup (506)
-	enc{[4]byte{0xf, 0x12, 0x00, 0x00}, PREF_F3, SSE3, 1<<11 | 260, 2<<4 | 15, argp_yoyo},                                     // movsldup (507)
-	enc{[4]byte{0xa5, 0x00, 0x00, 0x00}, WITH_REXW | REP, 0, 0<<11 | 261, 1<<4 | 15, argp_},                                   // movsq (508)
-	enc{[4]byte{0xf, 0x11, 0x00, 0x00}, PREF_F3 | ENC_MR, SSE, 0<<11 | 262, 2<<4 | 15, argp_mdyo},                             // movss (509)
-	enc{[4]byte{0xf, 0x10, 0x00, 0x00}, PREF_F3, SSE, 1<<11 | 262, 2<<4 | 15, argp_yomd},                                      // movss (510)
-	enc{[4]byte{0xf, 0x10, 0x00, 0x00}, PREF_F3, SSE, 2<<11 | 262, 2<<4 | 15, argp_yoyo},                                      // movss (511)
-	enc{[4]byte{0xa5, 0x00, 0x00, 0x00}, WORD_SIZE | REP, 0, 0<<11 | 263, 1<<4 | 15, argp_},                                   // movsw (512)
-	enc{[4]byte{0xf, 0xbe, 0x00, 0x00}, WORD_SIZE, 0, 0<<11 | 264, 2<<4 | 15, argp_rwmb},                                      // movsx (513)
-	enc{[4]byte{0xf, 0xbe, 0x00, 0x00}, AUTO_SIZE, 0, 1<<11 | 264, 2<<4 | 15, argp_r0vb},                                      // movsx (514)
-	enc{[4]byte{0xf, 0xbf, 0x00, 0x00}, AUTO_REXW | EXACT_SIZE, 0, 2<<11 | 264, 2<<4 | 15, argp_r0vw},                         // movsx (515)
-	enc{[4]byte{0x63, 0x00, 0x00, 0x00}, WITH_REXW, 0, 3<<11 | 264, 1<<4 | 15, argp_rqvd},                                     // movsx (516)
-	enc{[4]byte{0x63, 0x00, 0x00, 0x00}, WITH_REXW, 0, 0<<11 | 265, 1<<4 | 15, argp_rqvd},                                     // movsxd (517)
-	enc{[4]byte{0xf, 0x11, 0x00, 0x00}, PREF_66 | ENC_MR, SSE2, 0<<11 | 266, 2<<4 | 15, argp_moyo},                            // movupd (518)
-	enc{[4]byte{0xf, 0x10, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 266, 2<<4 | 15, argp_yomo},                                     // movupd (519)
-	enc{[4]byte{0xf, 0x10, 0x00, 0x00}, PREF_66, SSE2, 2<<11 | 266, 2<<4 | 15, argp_yoyo},                                     // movupd (520)
-	enc{[4]byte{0xf, 0x11, 0x00, 0x00}, PREF_66 | ENC_MR, SSE2, 3<<11 | 266, 2<<4 | 15, argp_yoyo},                            // movupd (521)
-	enc{[4]byte{0xf, 0x10, 0x00, 0x00}, 0, SSE, 0<<11 | 267, 2<<4 | 15, argp_yowo},                                            // movups (522)
-	enc{[4]byte{0xf, 0x11, 0x00, 0x00}, ENC_MR, SSE, 1<<11 | 267, 2<<4 | 15, argp_woyo},                                       // movups (523)
-	enc{[4]byte{0xf, 0xb6, 0x00, 0x00}, WORD_SIZE, 0, 0<<11 | 268, 2<<4 | 15, argp_rwmb},                                      // movzx (524)
-	enc{[4]byte{0xf, 0xb6, 0x00, 0x00}, AUTO_SIZE, 0, 1<<11 | 268, 2<<4 | 15, argp_r0vb},                                      // movzx (525)
-	enc{[4]byte{0xf, 0xb7, 0x00, 0x00}, AUTO_REXW | EXACT_SIZE, 0, 2<<11 | 268, 2<<4 | 15, argp_r0vw},                         // movzx (526)
-	enc{[4]byte{0xf, 0x3a, 0x42, 0x00}, PREF_66, SSE41, 0<<11 | 269, 3<<4 | 15, argp_yomqib},                                  // mpsadbw (527)
-	enc{[4]byte{0xf, 0x3a, 0x42, 0x00}, PREF_66, SSE41, 1<<11 | 269, 3<<4 | 15, argp_yoyoib},                                  // mpsadbw (528)
-	enc{[4]byte{0xf6, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 270, 1<<4 | 4, argp_vb},                                                // mul (529)
-	enc{[4]byte{0xf7, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 1<<11 | 270, 1<<4 | 4, argp_v0},                                        // mul (530)
-	enc{[4]byte{0xf, 0x59, 0x00, 0x00}, PREF_F2, SSE2, 0<<11 | 271, 2<<4 | 15, argp_yomq},                                     // mulsd (531)
-	enc{[4]byte{0xf, 0x59, 0x00, 0x00}, PREF_F2, SSE2, 1<<11 | 271, 2<<4 | 15, argp_yoyo},                                     // mulsd (532)
-	enc{[4]byte{0xf, 0x59, 0x00, 0x00}, PREF_F3, SSE, 0<<11 | 272, 2<<4 | 15, argp_yomd},                                      // mulss (533)
-	enc{[4]byte{0xf, 0x59, 0x00, 0x00}, PREF_F3, SSE, 1<<11 | 272, 2<<4 | 15, argp_yoyo},                                      // mulss (534)
-	enc{[4]byte{0x2, 0xf6, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F2, BMI2, 0<<11 | 273, 2<<4 | 15, argp_r0r0v0},              // mulx (535)
-	enc{[4]byte{0xf, 0x1, 0xc9, 0x00}, 0, 0, 0<<11 | 274, 3<<4 | 15, argp_},                                                   // mwait (536)
-	enc{[4]byte{0xf, 0x1, 0xc9, 0x00}, 0, 0, 1<<11 | 274, 3<<4 | 15, argp_AdBd},                                               // mwait (537)
-	enc{[4]byte{0xf, 0x1, 0xfb, 0x00}, 0, AMD, 0<<11 | 275, 3<<4 | 15, argp_},                                                 // mwaitx (538)
-	enc{[4]byte{0xf, 0x1, 0xfb, 0x00}, 0, AMD, 1<<11 | 275, 3<<4 | 15, argp_AdBd},                                             // mwaitx (539)
-	enc{[4]byte{0xf6, 0x00, 0x00, 0x00}, LOCK, 0, 0<<11 | 276, 1<<4 | 3, argp_mb},                                             // neg (540)
-	enc{[4]byte{0xf6, 0x00, 0x00, 0x00}, 0, 0, 1<<11 | 276, 1<<4 | 3, argp_rb},                                                // neg (541)
-	enc{[4]byte{0xf7, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, 0, 2<<11 | 276, 1<<4 | 3, argp_m0},                                 // neg (542)
-	enc{[4]byte{0xf7, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 3<<11 | 276, 1<<4 | 3, argp_r0},                                        // neg (543)
-	enc{[4]byte{0x90, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 277, 1<<4 | 15, argp_},                                                 // nop (544)
-	enc{[4]byte{0xf, 0x1f, 0x00, 0x00}, AUTO_SIZE, 0, 1<<11 | 277, 2<<4 | 0, argp_v0},                                         // nop (545)
-	enc{[4]byte{0xf6, 0x00, 0x00, 0x00}, LOCK, 0, 0<<11 | 278, 1<<4 | 2, argp_mb},                                             // not (546)
-	enc{[4]byte{0xf6, 0x00, 0x00, 0x00}, 0, 0, 1<<11 | 278, 1<<4 | 2, argp_rb},                                                // not (547)
-	enc{[4]byte{0xf7, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, 0, 2<<11 | 278, 1<<4 | 2, argp_m0},                                 // not (548)
-	enc{[4]byte{0xf7, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 3<<11 | 278, 1<<4 | 2, argp_r0},                                        // not (549)
-	enc{[4]byte{0xc, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 279, 1<<4 | 15, argp_Abib},                                              // or (550)
-	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, LOCK, 0, 1<<11 | 279, 1<<4 | 1, argp_mbib},                                           // or (551)
-	enc{[4]byte{0x8, 0x00, 0x00, 0x00}, LOCK | ENC_MR, 0, 2<<11 | 279, 1<<4 | 15, argp_mbrb},                                  // or (552)
-	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, 0, 0, 3<<11 | 279, 1<<4 | 1, argp_rbib},                                              // or (553)
-	enc{[4]byte{0x8, 0x00, 0x00, 0x00}, ENC_MR, 0, 4<<11 | 279, 1<<4 | 15, argp_rbrb},                                         // or (554)
-	enc{[4]byte{0xa, 0x00, 0x00, 0x00}, 0, 0, 5<<11 | 279, 1<<4 | 15, argp_rbvb},                                              // or (555)
-	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | EXACT_SIZE, 0, 6<<11 | 279, 1<<4 | 1, argp_r0ib},                         // or (556)
-	enc{[4]byte{0xd, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 7<<11 | 279, 1<<4 | 15, argp_A0i0},                                      // or (557)
-	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, 0, 8<<11 | 279, 1<<4 | 1, argp_m0i0},                               // or (558)
-	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, 0, 9<<11 | 279, 1<<4 | 1, argp_m0ib},                               // or (559)
-	enc{[4]byte{0x9, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK | ENC_MR, 0, 10<<11 | 279, 1<<4 | 15, argp_m0r0},                     // or (560)
-	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 11<<11 | 279, 1<<4 | 1, argp_r0i0},                                     // or (561)
-	enc{[4]byte{0x9, 0x00, 0x00, 0x00}, AUTO_SIZE | ENC_MR, 0, 12<<11 | 279, 1<<4 | 15, argp_r0r0},                            // or (562)
-	enc{[4]byte{0xb, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 13<<11 | 279, 1<<4 | 15, argp_r0v0},                                     // or (563)
-	enc{[4]byte{0xe6, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 280, 1<<4 | 15, argp_ibAb},                                             // out (564)
-	enc{[4]byte{0xe7, 0x00, 0x00, 0x00}, 0, 0, 1<<11 | 280, 1<<4 | 15, argp_ibAw},                                             // out (565)
-	enc{[4]byte{0xe7, 0x00, 0x00, 0x00}, 0, 0, 2<<11 | 280, 1<<4 | 15, argp_ibAd},                                             // out (566)
-	enc{[4]byte{0xee, 0x00, 0x00, 0x00}, 0, 0, 3<<11 | 280, 1<<4 | 15, argp_CwAb},                                             // out (567)
-	enc{[4]byte{0xef, 0x00, 0x00, 0x00}, WORD_SIZE, 0, 4<<11 | 280, 1<<4 | 15, argp_CwAw},                                     // out (568)
-	enc{[4]byte{0xef, 0x00, 0x00, 0x00}, 0, 0, 5<<11 | 280, 1<<4 | 15, argp_CwAd},                                             // out (569)
-	enc{[4]byte{0x6e, 0x00, 0x00, 0x00}, REP, 0, 0<<11 | 281, 1<<4 | 15, argp_},                                               // outsb (570)
-	enc{[4]byte{0x6f, 0x00, 0x00, 0x00}, REP, 0, 0<<11 | 282, 1<<4 | 15, argp_},                                               // outsd (571)
-	enc{[4]byte{0x6f, 0x00, 0x00, 0x00}, WORD_SIZE | REP, 0, 0<<11 | 283, 1<<4 | 15, argp_},                                   // outsw (572)
-	enc{[4]byte{0x90, 0x00, 0x00, 0x00}, PREF_F3, 0, 0<<11 | 284, 1<<4 | 15, argp_},                                           // pause (573)
-	enc{[4]byte{0xf, 0xa1, 0x00, 0x00}, 0, 0, 0<<11 | 285, 2<<4 | 15, argp_Uw},                                                // pop (574)
-	enc{[4]byte{0xf, 0xa9, 0x00, 0x00}, 0, 0, 1<<11 | 285, 2<<4 | 15, argp_Vw},                                                // pop (575)
-	enc{[4]byte{0x58, 0x00, 0x00, 0x00}, AUTO_NO32 | SHORT_ARG, 0, 2<<11 | 285, 1<<4 | 15, argp_r0},                           // pop (576)
-	enc{[4]byte{0x8f, 0x00, 0x00, 0x00}, AUTO_NO32, 0, 3<<11 | 285, 1<<4 | 0, argp_v0},                                        // pop (577)
-	enc{[4]byte{0xf, 0xb8, 0x00, 0x00}, AUTO_SIZE | PREF_F3, 0, 0<<11 | 286, 2<<4 | 15, argp_r0v0},                            // popcnt (578)
-	enc{[4]byte{0x9d, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 287, 1<<4 | 15, argp_},                                                 // popf (579)
-	enc{[4]byte{0x9d, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 288, 1<<4 | 15, argp_},                                                 // popfq (580)
-	enc{[4]byte{0x9d, 0x00, 0x00, 0x00}, WORD_SIZE, 0, 0<<11 | 289, 1<<4 | 15, argp_},                                         // popfw (581)
-	enc{[4]byte{0xf, 0xd, 0x00, 0x00}, 0, TDNOW, 0<<11 | 290, 2<<4 | 0, argp_mq},                                              // prefetch (582)
-	enc{[4]byte{0xf, 0x18, 0x00, 0x00}, 0, 0, 0<<11 | 291, 2<<4 | 0, argp_mb},                                                 // prefetchnta (583)
-	enc{[4]byte{0xf, 0x18, 0x00, 0x00}, 0, 0, 0<<11 | 292, 2<<4 | 1, argp_mb},                                                 // prefetcht0 (584)
-	enc{[4]byte{0xf, 0x18, 0x00, 0x00}, 0, 0, 0<<11 | 293, 2<<4 | 2, argp_mb},                                                 // prefetcht1 (585)
-	enc{[4]byte{0xf, 0x18, 0x00, 0x00}, 0, 0, 0<<11 | 294, 2<<4 | 3, argp_mb},                                                 // prefetcht2 (586)
-	enc{[4]byte{0xf, 0xd, 0x00, 0x00}, 0, TDNOW, 0<<11 | 295, 2<<4 | 1, argp_mq},                                              // prefetchw (587)
-	enc{[4]byte{0xf, 0xa0, 0x00, 0x00}, 0, 0, 0<<11 | 296, 2<<4 | 15, argp_Uw},                                                // push (588)
-	enc{[4]byte{0xf, 0xa8, 0x00, 0x00}, 0, 0, 1<<11 | 296, 2<<4 | 15, argp_Vw},                                                // push (589)
-	enc{[4]byte{0x6a, 0x00, 0x00, 0x00}, EXACT_SIZE, 0, 2<<11 | 296, 1<<4 | 15, argp_ib},                                      // push (590)
-	enc{[4]byte{0x68, 0x00, 0x00, 0x00}, WORD_SIZE | EXACT_SIZE, 0, 3<<11 | 296, 1<<4 | 15, argp_iw},                          // push (591)
-	enc{[4]byte{0x68, 0x00, 0x00, 0x00}, 0, 0, 4<<11 | 296, 1<<4 | 15, argp_id},                                               // push (592)
-	enc{[4]byte{0x50, 0x00, 0x00, 0x00}, AUTO_NO32 | SHORT_ARG, 0, 5<<11 | 296, 1<<4 | 15, argp_r0},                           // push (593)
-	enc{[4]byte{0xff, 0x00, 0x00, 0x00}, AUTO_NO32, 0, 6<<11 | 296, 1<<4 | 6, argp_v0},                                        // push (594)
-	enc{[4]byte{0x9c, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 297, 1<<4 | 15, argp_},                                                 // pushf (595)
-	enc{[4]byte{0x9c, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 298, 1<<4 | 15, argp_},                                                 // pushfq (596)
-	enc{[4]byte{0x9c, 0x00, 0x00, 0x00}, WORD_SIZE, 0, 0<<11 | 299, 1<<4 | 15, argp_},                                         // pushfw (597)
-	enc{[4]byte{0xd2, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 300, 1<<4 | 2, argp_vbBb},                                              // rcl (598)
-	enc{[4]byte{0xc0, 0x00, 0x00, 0x00}, 0, 0, 1<<11 | 300, 1<<4 | 2, argp_vbib},                                              // rcl (599)
-	enc{[4]byte{0xd3, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 2<<11 | 300, 1<<4 | 2, argp_v0Bb},                                      // rcl (600)
-	enc{[4]byte{0xc1, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 3<<11 | 300, 1<<4 | 2, argp_v0ib},                                      // rcl (601)
-	enc{[4]byte{0xf, 0x53, 0x00, 0x00}, PREF_F3, SSE, 0<<11 | 301, 2<<4 | 15, argp_yomd},                                      // rcpss (602)
-	enc{[4]byte{0xf, 0x53, 0x00, 0x00}, PREF_F3, SSE, 1<<11 | 301, 2<<4 | 15, argp_yoyo},                                      // rcpss (603)
-	enc{[4]byte{0xd2, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 302, 1<<4 | 3, argp_vbBb},                                              // rcr (604)
-	enc{[4]byte{0xc0, 0x00, 0x00, 0x00}, 0, 0, 1<<11 | 302, 1<<4 | 3, argp_vbib},                                              // rcr (605)
-	enc{[4]byte{0xd3, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 2<<11 | 302, 1<<4 | 3, argp_v0Bb},                                      // rcr (606)
-	enc{[4]byte{0xc1, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 3<<11 | 302, 1<<4 | 3, argp_v0ib},                                      // rcr (607)
-	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, PREF_F3, 0, 0<<11 | 303, 2<<4 | 0, argp_rd},                                           // rdfsbase (608)
-	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, WITH_REXW | PREF_F3, 0, 1<<11 | 303, 2<<4 | 0, argp_rq},                               // rdfsbase (609)
-	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, PREF_F3, 0, 0<<11 | 304, 2<<4 | 1, argp_rd},                                           // rdgsbase (610)
-	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, WITH_REXW | PREF_F3, 0, 1<<11 | 304, 2<<4 | 1, argp_rq},                               // rdgsbase (611)
-	enc{[4]byte{0xf, 0x32, 0x00, 0x00}, 0, 0, 0<<11 | 305, 2<<4 | 15, argp_},                                                  // rdmsr (612)
-	enc{[4]byte{0xf, 0xc7, 0x00, 0x00}, PREF_F3, 0, 0<<11 | 306, 2<<4 | 7, argp_rq},                                           // rdpid (613)
-	enc{[4]byte{0xf, 0x1, 0xee, 0x00}, 0, 0, 0<<11 | 307, 3<<4 | 15, argp_},                                                   // rdpkru (614)
-	enc{[4]byte{0xf, 0x33, 0x00, 0x00}, 0, 0, 0<<11 | 308, 2<<4 | 15, argp_},                                                  // rdpmc (615)
-	enc{[4]byte{0xf, 0xc7, 0x00, 0x00}, WITH_REXW, 0, 0<<11 | 309, 2<<4 | 6, argp_rq},                                         // rdrand (616)
-	enc{[4]byte{0xf, 0xc7, 0x00, 0x00}, WITH_REXW, 0, 0<<11 | 310, 2<<4 | 7, argp_rq},                                         // rdseed (617)
-	enc{[4]byte{0xf, 0x31, 0x00, 0x00}, 0, 0, 0<<11 | 311, 2<<4 | 15, argp_},                                                  // rdtsc (618)
-	enc{[4]byte{0xf, 0x1, 0xf9, 0x00}, 0, 0, 0<<11 | 312, 3<<4 | 15, argp_},                                                   // rdtscp (619)
-	enc{[4]byte{0xc3, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 313, 1<<4 | 15, argp_},                                                 // ret (620)
-	enc{[4]byte{0xc2, 0x00, 0x00, 0x00}, 0, 0, 1<<11 | 313, 1<<4 | 15, argp_iw},                                               // ret (621)
-	enc{[4]byte{0xcb, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 314, 1<<4 | 15, argp_},                                                 // retf (622)
-	enc{[4]byte{0xca, 0x00, 0x00, 0x00}, 0, 0, 1<<11 | 314, 1<<4 | 15, argp_iw},                                               // retf (623)
-	enc{[4]byte{0xc3, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 315, 1<<4 | 15, argp_},                                                 // retn (624)
-	enc{[4]byte{0xc2, 0x00, 0x00, 0x00}, 0, 0, 1<<11 | 315, 1<<4 | 15, argp_iw},                                               // retn (625)
-	enc{[4]byte{0xd2, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 316, 1<<4 | 0, argp_vbBb},                                              // rol (626)
-	enc{[4]byte{0xc0, 0x00, 0x00, 0x00}, 0, 0, 1<<11 | 316, 1<<4 | 0, argp_vbib},                                              // rol (627)
-	enc{[4]byte{0xd3, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 2<<11 | 316, 1<<4 | 0, argp_v0Bb},                                      // rol (628)
-	enc{[4]byte{0xc1, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 3<<11 | 316, 1<<4 | 0, argp_v0ib},                                      // rol (629)
-	enc{[4]byte{0xd2, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 317, 1<<4 | 1, argp_vbBb},                                              // ror (630)
-	enc{[4]byte{0xc0, 0x00, 0x00, 0x00}, 0, 0, 1<<11 | 317, 1<<4 | 1, argp_vbib},                                              // ror (631)
-	enc{[4]byte{0xd3, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 2<<11 | 317, 1<<4 | 1, argp_v0Bb},                                      // ror (632)
-	enc{[4]byte{0xc1, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 3<<11 | 317, 1<<4 | 1, argp_v0ib},                                      // ror (633)
-	enc{[4]byte{0x3, 0xf0, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F2, BMI2, 0<<11 | 318, 2<<4 | 15, argp_r0v0ib},              // rorx (634)
-	enc{[4]byte{0xf, 0x3a, 0xb, 0x00}, PREF_66, SSE41, 0<<11 | 319, 3<<4 | 15, argp_yomqib},                                   // roundsd (635)
-	enc{[4]byte{0xf, 0x3a, 0xb, 0x00}, PREF_66, SSE41, 1<<11 | 319, 3<<4 | 15, argp_yoyoib},                                   // roundsd (636)
-	enc{[4]byte{0xf, 0x3a, 0xa, 0x00}, PREF_66, SSE41, 0<<11 | 320, 3<<4 | 15, argp_yomqib},                                   // roundss (637)
-	enc{[4]byte{0xf, 0x3a, 0xa, 0x00}, PREF_66, SSE41, 1<<11 | 320, 3<<4 | 15, argp_yoyoib},                                   // roundss (638)
-	enc{[4]byte{0xf, 0xaa, 0x00, 0x00}, 0, 0, 0<<11 | 321, 2<<4 | 15, argp_},                                                  // rsm (639)
-	enc{[4]byte{0xf, 0x52, 0x00, 0x00}, PREF_F3, SSE, 0<<11 | 322, 2<<4 | 15, argp_yomd},                                      // rsqrtss (640)
-	enc{[4]byte{0xf, 0x52, 0x00, 0x00}, PREF_F3, SSE, 1<<11 | 322, 2<<4 | 15, argp_yoyo},                                      // rsqrtss (641)
-	enc{[4]byte{0x9e, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 323, 1<<4 | 15, argp_},                                                 // sahf (642)
-	enc{[4]byte{0xd2, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 324, 1<<4 | 4, argp_vbBb},                                              // sal (643)
-	enc{[4]byte{0xc0, 0x00, 0x00, 0x00}, 0, 0, 1<<11 | 324, 1<<4 | 4, argp_vbib},                                              // sal (644)
-	enc{[4]byte{0xd3, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 2<<11 | 324, 1<<4 | 4, argp_v0Bb},                                      // sal (645)
-	enc{[4]byte{0xc1, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 3<<11 | 324, 1<<4 | 4, argp_v0ib},                                      // sal (646)
-	enc{[4]byte{0xd2, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 325, 1<<4 | 7, argp_vbBb},                                              // sar (647)
-	enc{[4]byte{0xc0, 0x00, 0x00, 0x00}, 0, 0, 1<<11 | 325, 1<<4 | 7, argp_vbib},                                              // sar (648)
-	enc{[4]byte{0xd3, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 2<<11 | 325, 1<<4 | 7, argp_v0Bb},                                      // sar (649)
-	enc{[4]byte{0xc1, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 3<<11 | 325, 1<<4 | 7, argp_v0ib},                                      // sar (650)
-	enc{[4]byte{0x2, 0xf7, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F3 | ENC_MR, BMI2, 0<<11 | 326, 2<<4 | 15, argp_r0v0r0},     // sarx (651)
-	enc{[4]byte{0x1c, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 327, 1<<4 | 15, argp_Abib},                                             // sbb (652)
-	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, LOCK, 0, 1<<11 | 327, 1<<4 | 3, argp_mbib},                                           // sbb (653)
-	enc{[4]byte{0x18, 0x00, 0x00, 0x00}, LOCK | ENC_MR, 0, 2<<11 | 327, 1<<4 | 15, argp_mbrb},                                 // sbb (654)
-	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, 0, 0, 3<<11 | 327, 1<<4 | 3, argp_rbib},                                              // sbb (655)
-	enc{[4]byte{0x18, 0x00, 0x00, 0x00}, ENC_MR, 0, 4<<11 | 327, 1<<4 | 15, argp_rbrb},                                        // sbb (656)
-	enc{[4]byte{0x1a, 0x00, 0x00, 0x00}, 0, 0, 5<<11 | 327, 1<<4 | 15, argp_rbvb},                                             // sbb (657)
-	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | EXACT_SIZE, 0, 6<<11 | 327, 1<<4 | 3, argp_r0ib},                         // sbb (658)
-	enc{[4]byte{0x1d, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 7<<11 | 327, 1<<4 | 15, argp_A0i0},                                     // sbb (659)
-	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, 0, 8<<11 | 327, 1<<4 | 3, argp_m0i0},                               // sbb (660)
-	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, 0, 9<<11 | 327, 1<<4 | 3, argp_m0ib},                               // sbb (661)
-	enc{[4]byte{0x19, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK | ENC_MR, 0, 10<<11 | 327, 1<<4 | 15, argp_m0r0},                    // sbb (662)
-	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 11<<11 | 327, 1<<4 | 3, argp_r0i0},                                     // sbb (663)
-	enc{[4]byte{0x19, 0x00, 0x00, 0x00}, AUTO_SIZE | ENC_MR, 0, 12<<11 | 327, 1<<4 | 15, argp_r0r0},                           // sbb (664)
-	enc{[4]byte{0x1b, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 13<<11 | 327, 1<<4 | 15, argp_r0v0},                                    // sbb (665)
-	enc{[4]byte{0xae, 0x00, 0x00, 0x00}, REPE, 0, 0<<11 | 328, 1<<4 | 15, argp_},                                              // scasb (666)
-	enc{[4]byte{0xaf, 0x00, 0x00, 0x00}, REPE, 0, 0<<11 | 329, 1<<4 | 15, argp_},                                              // scasd (667)
-	enc{[4]byte{0xaf, 0x00, 0x00, 0x00}, WITH_REXW | REPE, 0, 0<<11 | 330, 1<<4 | 15, argp_},                                  // scasq (668)
-	enc{[4]byte{0xaf, 0x00, 0x00, 0x00}, WORD_SIZE | REPE, 0, 0<<11 | 331, 1<<4 | 15, argp_},                                  // scasw (669)
-	enc{[4]byte{0xf, 0x97, 0x00, 0x00}, 0, 0, 0<<11 | 332, 2<<4 | 0, argp_vb},                                                 // seta (670)
-	enc{[4]byte{0xf, 0x93, 0x00, 0x00}, 0, 0, 0<<11 | 333, 2<<4 | 0, argp_vb},                                                 // setae (671)
-	enc{[4]byte{0xf, 0x92, 0x00, 0x00}, 0, 0, 0<<11 | 334, 2<<4 | 0, argp_vb},                                                 // setb (672)
-	enc{[4]byte{0xf, 0x96, 0x00, 0x00}, 0, 0, 0<<11 | 335, 2<<4 | 0, argp_vb},                                                 // setbe (673)
-	enc{[4]byte{0xf, 0x92, 0x00, 0x00}, 0, 0, 0<<11 | 336, 2<<4 | 0, argp_vb},                                                 // setc (674)
-	enc{[4]byte{0xf, 0x94, 0x00, 0x00}, 0, 0, 0<<11 | 337, 2<<4 | 0, argp_vb},                                                 // sete (675)
-	enc{[4]byte{0xf, 0x9f, 0x00, 0x00}, 0, 0, 0<<11 | 338, 2<<4 | 0, argp_vb},                                                 // setg (676)
-	enc{[4]byte{0xf, 0x9d, 0x00, 0x00}, 0, 0, 0<<11 | 339, 2<<4 | 0, argp_vb},                                                 // setge (677)
-	enc{[4]byte{0xf, 0x9c, 0x00, 0x00}, 0, 0, 0<<11 | 340, 2<<4 | 0, argp_vb},                                                 // setl (678)
-	enc{[4]byte{0xf, 0x9e, 0x00, 0x00}, 0, 0, 0<<11 | 341, 2<<4 | 0, argp_vb},                                                 // setle (679)
-	enc{[4]byte{0xf, 0x96, 0x00, 0x00}, 0, 0, 0<<11 | 342, 2<<4 | 0, argp_vb},                                                 // setna (680)
-	enc{[4]byte{0xf, 0x92, 0x00, 0x00}, 0, 0, 0<<11 | 343, 2<<4 | 0, argp_vb},                                                 // setnae (681)
-	enc{[4]byte{0xf, 0x93, 0x00, 0x00}, 0, 0, 0<<11 | 344, 2<<4 | 0, argp_vb},                                                 // setnb (682)
-	enc{[4]byte{0xf, 0x97, 0x00, 0x00}, 0, 0, 0<<11 | 345, 2<<4 | 0, argp_vb},                                                 // setnbe (683)
-	enc{[4]byte{0xf, 0x93, 0x00, 0x00}, 0, 0, 0<<11 | 346, 2<<4 | 0, argp_vb},                                                 // setnc (684)
-	enc{[4]byte{0xf, 0x95, 0x00, 0x00}, 0, 0, 0<<11 | 347, 2<<4 | 0, argp_vb},                                                 // setne (685)
-	enc{[4]byte{0xf, 0x9e, 0x00, 0x00}, 0, 0, 0<<11 | 348, 2<<4 | 0, argp_vb},                                                 // setng (686)
-	enc{[4]byte{0xf, 0x9c, 0x00, 0x00}, 0, 0, 0<<11 | 349, 2<<4 | 0, argp_vb},                                                 // setnge (687)
-	enc{[4]byte{0xf, 0x9d, 0x00, 0x00}, 0, 0, 0<<11 | 350, 2<<4 | 0, argp_vb},                                                 // setnl (688)
-	enc{[4]byte{0xf, 0x9f, 0x00, 0x00}, 0, 0, 0<<11 | 351, 2<<4 | 0, argp_vb},                                                 // setnle (689)
-	enc{[4]byte{0xf, 0x91, 0x00, 0x00}, 0, 0, 0<<11 | 352, 2<<4 | 0, argp_vb},                                                 // setno (690)
-	enc{[4]byte{0xf, 0x9b, 0x00, 0x00}, 0, 0, 0<<11 | 353, 2<<4 | 0, argp_vb},                                                 // setnp (691)
-	enc{[4]byte{0xf, 0x99, 0x00, 0x00}, 0, 0, 0<<11 | 354, 2<<4 | 0, argp_vb},                                                 // setns (692)
-	enc{[4]byte{0xf, 0x95, 0x00, 0x00}, 0, 0, 0<<11 | 355, 2<<4 | 0, argp_vb},                                                 // setnz (693)
-	enc{[4]byte{0xf, 0x90, 0x00, 0x00}, 0, 0, 0<<11 | 356, 2<<4 | 0, argp_vb},                                                 // seto (694)
-	enc{[4]byte{0xf, 0x9a, 0x00, 0x00}, 0, 0, 0<<11 | 357, 2<<4 | 0, argp_vb},                                                 // setp (695)
-	enc{[4]byte{0xf, 0x9a, 0x00, 0x00}, 0, 0, 0<<11 | 358, 2<<4 | 0, argp_vb},                                                 // setpe (696)
-	enc{[4]byte{0xf, 0x9b, 0x00, 0x00}, 0, 0, 0<<11 | 359, 2<<4 | 0, argp_vb},                                                 // setpo (697)
-	enc{[4]byte{0xf, 0x98, 0x00, 0x00}, 0, 0, 0<<11 | 360, 2<<4 | 0, argp_vb},                                                 // sets (698)
-	enc{[4]byte{0xf, 0x94, 0x00, 0x00}, 0, 0, 0<<11 | 361, 2<<4 | 0, argp_vb},                                                 // setz (699)
-	enc{[4]byte{0xf, 0xae, 0xf8, 0x00}, 0, AMD, 0<<11 | 362, 3<<4 | 15, argp_},                                                // sfence (700)
-	enc{[4]byte{0xf, 0x1, 0x00, 0x00}, 0, 0, 0<<11 | 363, 2<<4 | 0, argp_m1},                                                  // sgdt (701)
-	enc{[4]byte{0xd2, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 364, 1<<4 | 4, argp_vbBb},                                              // shl (702)
-	enc{[4]byte{0xc0, 0x00, 0x00, 0x00}, 0, 0, 1<<11 | 364, 1<<4 | 4, argp_vbib},                                              // shl (703)
-	enc{[4]byte{0xd3, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 2<<11 | 364, 1<<4 | 4, argp_v0Bb},                                      // shl (704)
-	enc{[4]byte{0xc1, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 3<<11 | 364, 1<<4 | 4, argp_v0ib},                                      // shl (705)
-	enc{[4]byte{0xf, 0xa5, 0x00, 0x00}, AUTO_SIZE | ENC_MR, 0, 0<<11 | 365, 2<<4 | 15, argp_v0r0Bb},                           // shld (706)
-	enc{[4]byte{0xf, 0xa4, 0x00, 0x00}, AUTO_SIZE | ENC_MR, 0, 1<<11 | 365, 2<<4 | 15, argp_v0r0ib},                           // shld (707)
-	enc{[4]byte{0x2, 0xf7, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_66 | ENC_MR, BMI2, 0<<11 | 366, 2<<4 | 15, argp_r0v0r0},     // shlx (708)
-	enc{[4]byte{0xd2, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 367, 1<<4 | 5, argp_vbBb},                                              // shr (709)
-	enc{[4]byte{0xc0, 0x00, 0x00, 0x00}, 0, 0, 1<<11 | 367, 1<<4 | 5, argp_vbib},                                              // shr (710)
-	enc{[4]byte{0xd3, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 2<<11 | 367, 1<<4 | 5, argp_v0Bb},                                      // shr (711)
-	enc{[4]byte{0xc1, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 3<<11 | 367, 1<<4 | 5, argp_v0ib},                                      // shr (712)
-	enc{[4]byte{0xf, 0xad, 0x00, 0x00}, AUTO_SIZE | ENC_MR, 0, 0<<11 | 368, 2<<4 | 15, argp_v0r0Bb},                           // shrd (713)
-	enc{[4]byte{0xf, 0xac, 0x00, 0x00}, AUTO_SIZE | ENC_MR, 0, 1<<11 | 368, 2<<4 | 15, argp_v0r0ib},                           // shrd (714)
-	enc{[4]byte{0x2, 0xf7, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F2 | ENC_MR, BMI2, 0<<11 | 369, 2<<4 | 15, argp_r0v0r0},     // shrx (715)
-	enc{[4]byte{0xf, 0x1, 0x00, 0x00}, 0, 0, 0<<11 | 370, 2<<4 | 1, argp_m1},                                                  // sidt (716)
-	enc{[4]byte{0xf, 0x1, 0xde, 0x00}, 0, 0, 0<<11 | 371, 3<<4 | 15, argp_},                                                   // skinit (717)
-	enc{[4]byte{0xf, 0x0, 0x00, 0x00}, 0, 0, 0<<11 | 372, 2<<4 | 0, argp_m1},                                                  // sldt (718)
-	enc{[4]byte{0xf, 0x0, 0x00, 0x00}, AUTO_SIZE, 0, 1<<11 | 372, 2<<4 | 0, argp_r0},                                          // sldt (719)
-	enc{[4]byte{0x9, 0x12, 0x00, 0x00}, XOP_OP | AUTO_REXW, AMD, 0<<11 | 373, 2<<4 | 1, argp_r0},                              // slwpcb (720)
-	enc{[4]byte{0xf, 0x1, 0x00, 0x00}, 0, 0, 0<<11 | 374, 2<<4 | 4, argp_m1},                                                  // smsw (721)
-	enc{[4]byte{0xf, 0x1, 0x00, 0x00}, AUTO_SIZE, 0, 1<<11 | 374, 2<<4 | 4, argp_r0},                                          // smsw (722)
-	enc{[4]byte{0xf, 0x51, 0x00, 0x00}, PREF_F2, SSE2, 0<<11 | 375, 2<<4 | 15, argp_yomq},                                     // sqrtsd (723)
-	enc{[4]byte{0xf, 0x51, 0x00, 0x00}, PREF_F2, SSE2, 1<<11 | 375, 2<<4 | 15, argp_yoyo},                                     // sqrtsd (724)
-	enc{[4]byte{0xf, 0x51, 0x00, 0x00}, PREF_F3, SSE, 0<<11 | 376, 2<<4 | 15, argp_yomd},                                      // sqrtss (725)
-	enc{[4]byte{0xf, 0x51, 0x00, 0x00}, PREF_F3, SSE, 1<<11 | 376, 2<<4 | 15, argp_yoyo},                                      // sqrtss (726)
-	enc{[4]byte{0xf, 0x1, 0xcb, 0x00}, 0, 0, 0<<11 | 377, 3<<4 | 15, argp_},                                                   // stac (727)
-	enc{[4]byte{0xf9, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 378, 1<<4 | 15, argp_},                                                 // stc (728)
-	enc{[4]byte{0xfd, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 379, 1<<4 | 15, argp_},                                                 // std (729)
-	enc{[4]byte{0xf, 0x1, 0xdc, 0x00}, 0, VMX | AMD, 0<<11 | 380, 3<<4 | 15, argp_},                                           // stgi (730)
-	enc{[4]byte{0xfb, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 381, 1<<4 | 15, argp_},                                                 // sti (731)
-	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, 0, SSE, 0<<11 | 382, 2<<4 | 3, argp_md},                                               // stmxcsr (732)
-	enc{[4]byte{0xaa, 0x00, 0x00, 0x00}, REP, 0, 0<<11 | 383, 1<<4 | 15, argp_},                                               // stosb (733)
-	enc{[4]byte{0xab, 0x00, 0x00, 0x00}, REP, 0, 0<<11 | 384, 1<<4 | 15, argp_},                                               // stosd (734)
-	enc{[4]byte{0xab, 0x00, 0x00, 0x00}, WITH_REXW | REP, 0, 0<<11 | 385, 1<<4 | 15, argp_},                                   // stosq (735)
-	enc{[4]byte{0xab, 0x00, 0x00, 0x00}, WORD_SIZE | REP, 0, 0<<11 | 386, 1<<4 | 15, argp_},                                   // stosw (736)
-	enc{[4]byte{0xf, 0x0, 0x00, 0x00}, 0, 0, 0<<11 | 387, 2<<4 | 1, argp_m1},                                                  // str (737)
-	enc{[4]byte{0xf, 0x0, 0x00, 0x00}, AUTO_SIZE, 0, 1<<11 | 387, 2<<4 | 1, argp_r0},                                          // str (738)
-	enc{[4]byte{0x2c, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 388, 1<<4 | 15, argp_Abib},                                             // sub (739)
-	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, LOCK, 0, 1<<11 | 388, 1<<4 | 5, argp_mbib},                                           // sub (740)
-	enc{[4]byte{0x28, 0x00, 0x00, 0x00}, LOCK | ENC_MR, 0, 2<<11 | 388, 1<<4 | 15, argp_mbrb},                                 // sub (741)
-	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, 0, 0, 3<<11 | 388, 1<<4 | 5, argp_rbib},                                              // sub (742)
-	enc{[4]byte{0x28, 0x00, 0x00, 0x00}, ENC_MR, 0, 4<<11 | 388, 1<<4 | 15, argp_rbrb},                                        // sub (743)
-	enc{[4]byte{0x2a, 0x00, 0x00, 0x00}, 0, 0, 5<<11 | 388, 1<<4 | 15, argp_rbvb},                                             // sub (744)
-	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | EXACT_SIZE, 0, 6<<11 | 388, 1<<4 | 5, argp_r0ib},                         // sub (745)
-	enc{[4]byte{0x2d, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 7<<11 | 388, 1<<4 | 15, argp_A0i0},                                     // sub (746)
-	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, 0, 8<<11 | 388, 1<<4 | 5, argp_m0i0},                               // sub (747)
-	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, 0, 9<<11 | 388, 1<<4 | 5, argp_m0ib},                               // sub (748)
-	enc{[4]byte{0x29, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK | ENC_MR, 0, 10<<11 | 388, 1<<4 | 15, argp_m0r0},                    // sub (749)
-	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 11<<11 | 388, 1<<4 | 5, argp_r0i0},                                     // sub (750)
-	enc{[4]byte{0x29, 0x00, 0x00, 0x00}, AUTO_SIZE | ENC_MR, 0, 12<<11 | 388, 1<<4 | 15, argp_r0r0},                           // sub (751)
-	enc{[4]byte{0x2b, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 13<<11 | 388, 1<<4 | 15, argp_r0v0},                                    // sub (752)
-	enc{[4]byte{0xf, 0x5c, 0x00, 0x00}, PREF_F2, SSE2, 0<<11 | 389, 2<<4 | 15, argp_yomq},                                     // subsd (753)
-	enc{[4]byte{0xf, 0x5c, 0x00, 0x00}, PREF_F2, SSE2, 1<<11 | 389, 2<<4 | 15, argp_yoyo},                                     // subsd (754)
-	enc{[4]byte{0xf, 0x5c, 0x00, 0x00}, PREF_F3, SSE, 0<<11 | 390, 2<<4 | 15, argp_yomd},                                      // subss (755)
-	enc{[4]byte{0xf, 0x5c, 0x00, 0x00}, PREF_F3, SSE, 1<<11 | 390, 2<<4 | 15, argp_yoyo},                                      // subss (756)
-	enc{[4]byte{0xf, 0x1, 0xf8, 0x00}, 0, 0, 0<<11 | 391, 3<<4 | 15, argp_},                                                   // swapgs (757)
-	enc{[4]byte{0xf, 0x5, 0x00, 0x00}, 0, AMD, 0<<11 | 392, 2<<4 | 15, argp_},                                                 // syscall (758)
-	enc{[4]byte{0xf, 0x7, 0x00, 0x00}, 0, AMD, 0<<11 | 393, 2<<4 | 15, argp_},                                                 // sysret (759)
-	enc{[4]byte{0x9, 0x1, 0x00, 0x00}, XOP_OP | AUTO_REXW | ENC_VM, TBM, 0<<11 | 394, 2<<4 | 7, argp_r0v0},                    // t1mskc (760)
-	enc{[4]byte{0xa8, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 395, 1<<4 | 15, argp_Abib},                                             // test (761)
-	enc{[4]byte{0x84, 0x00, 0x00, 0x00}, 0, 0, 1<<11 | 395, 1<<4 | 15, argp_rbmb},                                             // test (762)
-	enc{[4]byte{0xf6, 0x00, 0x00, 0x00}, 0, 0, 2<<11 | 395, 1<<4 | 0, argp_vbib},                                              // test (763)
-	enc{[4]byte{0x84, 0x00, 0x00, 0x00}, ENC_MR, 0, 3<<11 | 395, 1<<4 | 15, argp_vbrb},                                        // test (764)
-	enc{[4]byte{0xa9, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 4<<11 | 395, 1<<4 | 15, argp_A0i0},                                     // test (765)
-	enc{[4]byte{0x85, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 5<<11 | 395, 1<<4 | 15, argp_r0m0},                                     // test (766)
-	enc{[4]byte{0xf7, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 6<<11 | 395, 1<<4 | 0, argp_v0i0},                                      // test (767)
-	enc{[4]byte{0x85, 0x00, 0x00, 0x00}, AUTO_SIZE | ENC_MR, 0, 7<<11 | 395, 1<<4 | 15, argp_v0r0},                            // test (768)
-	enc{[4]byte{0xf, 0xbc, 0x00, 0x00}, AUTO_SIZE | PREF_F3, BMI1, 0<<11 | 396, 2<<4 | 15, argp_r0v0},                         // tzcnt (769)
-	enc{[4]byte{0x9, 0x1, 0x00, 0x00}, XOP_OP | AUTO_REXW | ENC_VM, TBM, 0<<11 | 397, 2<<4 | 4, argp_r0v0},                    // tzmsk (770)
-	enc{[4]byte{0xf, 0x2e, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 398, 2<<4 | 15, argp_yomq},                                     // ucomisd (771)
-	enc{[4]byte{0xf, 0x2e, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 398, 2<<4 | 15, argp_yoyo},                                     // ucomisd (772)
-	enc{[4]byte{0xf, 0x2e, 0x00, 0x00}, 0, SSE, 0<<11 | 399, 2<<4 | 15, argp_yomd},                                            // ucomiss (773)
-	enc{[4]byte{0xf, 0x2e, 0x00, 0x00}, 0, SSE, 1<<11 | 399, 2<<4 | 15, argp_yoyo},                                            // ucomiss (774)
-	enc{[4]byte{0xf, 0xb, 0x00, 0x00}, 0, 0, 0<<11 | 400, 2<<4 | 15, argp_},                                                   // ud2 (775)
-	enc{[4]byte{0xf, 0xb, 0x00, 0x00}, 0, 0, 0<<11 | 401, 2<<4 | 15, argp_},                                                   // ud2a (776)
-	enc{[4]byte{0xf, 0x9, 0x00, 0x00}, 0, 0, 0<<11 | 402, 2<<4 | 15, argp_},                                                   // wbinvd (777)
-	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, PREF_F3, 0, 0<<11 | 403, 2<<4 | 2, argp_rd},                                           // wrfsbase (778)
-	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, WITH_REXW | PREF_F3, 0, 1<<11 | 403, 2<<4 | 2, argp_rq},                               // wrfsbase (779)
-	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, PREF_F3, 0, 0<<11 | 404, 2<<4 | 3, argp_rd},                                           // wrgsbase (780)
-	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, WITH_REXW | PREF_F3, 0, 1<<11 | 404, 2<<4 | 3, argp_rq},                               // wrgsbase (781)
-	enc{[4]byte{0xf, 0x30, 0x00, 0x00}, 0, 0, 0<<11 | 405, 2<<4 | 15, argp_},                                                  // wrmsr (782)
-	enc{[4]byte{0xf, 0x1, 0xef, 0x00}, 0, 0, 0<<11 | 406, 3<<4 | 15, argp_},                                                   // wrpkru (783)
-	enc{[4]byte{0x34, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 407, 1<<4 | 15, argp_Abib},                                             // xor (784)
-	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, LOCK, 0, 1<<11 | 407, 1<<4 | 6, argp_mbib},                                           // xor (785)
-	enc{[4]byte{0x30, 0x00, 0x00, 0x00}, LOCK | ENC_MR, 0, 2<<11 | 407, 1<<4 | 15, argp_mbrb},                                 // xor (786)
-	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, 0, 0, 3<<11 | 407, 1<<4 | 6, argp_rbib},                                              // xor (787)
-	enc{[4]byte{0x30, 0x00, 0x00, 0x00}, ENC_MR, 0, 4<<11 | 407, 1<<4 | 15, argp_rbrb},                                        // xor (788)
-	enc{[4]byte{0x32, 0x00, 0x00, 0x00}, 0, 0, 5<<11 | 407, 1<<4 | 15, argp_rbvb},                                             // xor (789)
-	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | EXACT_SIZE, 0, 6<<11 | 407, 1<<4 | 6, argp_r0ib},                         // xor (790)
-	enc{[4]byte{0x35, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 7<<11 | 407, 1<<4 | 15, argp_A0i0},                                     // xor (791)
-	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, 0, 8<<11 | 407, 1<<4 | 6, argp_m0i0},                               // xor (792)
-	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, 0, 9<<11 | 407, 1<<4 | 6, argp_m0ib},                               // xor (793)
-	enc{[4]byte{0x31, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK | ENC_MR, 0, 10<<11 | 407, 1<<4 | 15, argp_m0r0},                    // xor (794)
-	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 11<<11 | 407, 1<<4 | 6, argp_r0i0},                                     // xor (795)
-	enc{[4]byte{0x31, 0x00, 0x00, 0x00}, AUTO_SIZE | ENC_MR, 0, 12<<11 | 407, 1<<4 | 15, argp_r0r0},                           // xor (796)
-	enc{[4]byte{0x33, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 13<<11 | 407, 1<<4 | 15, argp_r0v0},                                    // xor (797)
-	enc{[4]byte{0xf, 0x38, 0xde, 0x00}, PREF_66, SSE, 0<<11 | 408, 3<<4 | 15, argp_yowo},                                      // aesdec (798)
-	enc{[4]byte{0xf, 0x38, 0xdf, 0x00}, PREF_66, SSE, 0<<11 | 409, 3<<4 | 15, argp_yowo},                                      // aesdeclast (799)
-	enc{[4]byte{0xf, 0x38, 0xdc, 0x00}, PREF_66, SSE, 0<<11 | 410, 3<<4 | 15, argp_yowo},                                      // aesenc (800)
-	enc{[4]byte{0xf, 0x38, 0xdd, 0x00}, PREF_66, SSE, 0<<11 | 411, 3<<4 | 15, argp_yowo},                                      // aesenclast (801)
-	enc{[4]byte{0xf, 0x38, 0xdb, 0x00}, PREF_66, SSE, 0<<11 | 412, 3<<4 | 15, argp_yowo},                                      // aesimc (802)
-	enc{[4]byte{0xf, 0x3a, 0xdf, 0x00}, PREF_66, SSE, 0<<11 | 413, 3<<4 | 15, argp_yowoib},                                    // aeskeygenassist (803)
-	enc{[4]byte{0xf, 0x38, 0xc9, 0x00}, 0, SHA, 0<<11 | 414, 3<<4 | 15, argp_yowo},                                            // sha1msg1 (804)
-	enc{[4]byte{0xf, 0x38, 0xca, 0x00}, 0, SHA, 0<<11 | 415, 3<<4 | 15, argp_yowo},                                            // sha1msg2 (805)
-	enc{[4]byte{0xf, 0x38, 0xc8, 0x00}, 0, SHA, 0<<11 | 416, 3<<4 | 15, argp_yowo},                                            // sha1nexte (806)
-	enc{[4]byte{0xf, 0x3a, 0xcc, 0x00}, 0, SHA, 0<<11 | 417, 3<<4 | 15, argp_yowoib},                                          // sha1rnds4 (807)
-	enc{[4]byte{0xf, 0x38, 0xcc, 0x00}, 0, SHA, 0<<11 | 418, 3<<4 | 15, argp_yowo},                                            // sha256msg1 (808)
-	enc{[4]byte{0xf, 0x38, 0xcd, 0x00}, 0, SHA, 0<<11 | 419, 3<<4 | 15, argp_yowo},                                            // sha256msg2 (809)
-	enc{[4]byte{0xf, 0x38, 0xcb, 0x00}, 0, SHA, 0<<11 | 420, 3<<4 | 15, argp_yowo},                                            // sha256rnds2 (810)
-	enc{[4]byte{0xc6, 0xf8, 0x00, 0x00}, 0, RTM, 0<<11 | 421, 2<<4 | 15, argp_ib},                                             // xabort (811)
-	enc{[4]byte{0xf, 0xc0, 0x00, 0x00}, LOCK | ENC_MR, 0, 0<<11 | 422, 2<<4 | 15, argp_mbrb},                                  // xadd (812)
-	enc{[4]byte{0xf, 0xc0, 0x00, 0x00}, ENC_MR, 0, 1<<11 | 422, 2<<4 | 15, argp_rbrb},                                         // xadd (813)
-	enc{[4]byte{0xf, 0xc1, 0x00, 0x00}, AUTO_SIZE | LOCK | ENC_MR, 0, 2<<11 | 422, 2<<4 | 15, argp_m0r0},                      // xadd (814)
-	enc{[4]byte{0xf, 0xc1, 0x00, 0x00}, AUTO_SIZE | ENC_MR, 0, 3<<11 | 422, 2<<4 | 15, argp_r0r0},                             // xadd (815)
-	enc{[4]byte{0xc7, 0xf8, 0x00, 0x00}, 0, RTM, 0<<11 | 423, 2<<4 | 15, argp_od},                                             // xbegin (816)
-	enc{[4]byte{0x86, 0x00, 0x00, 0x00}, LOCK | ENC_MR, 0, 0<<11 | 424, 1<<4 | 15, argp_mbrb},                                 // xchg (817)
-	enc{[4]byte{0x86, 0x00, 0x00, 0x00}, LOCK, 0, 1<<11 | 424, 1<<4 | 15, argp_rbmb},                                          // xchg (818)
-	enc{[4]byte{0x86, 0x00, 0x00, 0x00}, 0, 0, 2<<11 | 424, 1<<4 | 15, argp_rbrb},                                             // xchg (819)
-	enc{[4]byte{0x86, 0x00, 0x00, 0x00}, ENC_MR, 0, 3<<11 | 424, 1<<4 | 15, argp_rbrb},                                        // xchg (820)
-	enc{[4]byte{0x90, 0x00, 0x00, 0x00}, AUTO_SIZE | SHORT_ARG, 0, 4<<11 | 424, 1<<4 | 15, argp_A0r0},                         // xchg (821)
-	enc{[4]byte{0x87, 0x00, 0x00, 0x00}, AUTO_SIZE | ENC_MR, 0, 5<<11 | 424, 1<<4 | 15, argp_m0r0},                            // xchg (822)
-	enc{[4]byte{0x90, 0x00, 0x00, 0x00}, AUTO_SIZE | SHORT_ARG, 0, 6<<11 | 424, 1<<4 | 15, argp_r0A0},                         // xchg (823)
-	enc{[4]byte{0x87, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 7<<11 | 424, 1<<4 | 15, argp_r0m0},                                     // xchg (824)
-	enc{[4]byte{0x87, 0x00, 0x00, 0x00}, AUTO_SIZE, 0, 8<<11 | 424, 1<<4 | 15, argp_r0r0},                                     // xchg (825)
-	enc{[4]byte{0x87, 0x00, 0x00, 0x00}, AUTO_SIZE | ENC_MR, 0, 9<<11 | 424, 1<<4 | 15, argp_r0r0},                            // xchg (826)
-	enc{[4]byte{0xf, 0x1, 0xd5, 0x00}, 0, RTM, 0<<11 | 425, 3<<4 | 15, argp_},                                                 // xend (827)
-	enc{[4]byte{0xf, 0x1, 0xd0, 0x00}, 0, 0, 0<<11 | 426, 3<<4 | 15, argp_},                                                   // xgetbv (828)
-	enc{[4]byte{0xd7, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 427, 1<<4 | 15, argp_},                                                 // xlat (829)
-	enc{[4]byte{0xd7, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 428, 1<<4 | 15, argp_},                                                 // xlatb (830)
-	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, 0, 0, 0<<11 | 429, 2<<4 | 5, argp_m1},                                                 // xrstor (831)
-	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, WITH_REXW, 0, 0<<11 | 430, 2<<4 | 5, argp_m1},                                         // xrstor64 (832)
-	enc{[4]byte{0xf, 0xc7, 0x00, 0x00}, WITH_REXW, 0, 0<<11 | 431, 2<<4 | 3, argp_m1},                                         // xrstors64 (833)
-	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, 0, 0, 0<<11 | 432, 2<<4 | 4, argp_m1},                                                 // xsave (834)
-	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, WITH_REXW, 0, 0<<11 | 433, 2<<4 | 4, argp_m1},                                         // xsave64 (835)
-	enc{[4]byte{0xf, 0xc7, 0x00, 0x00}, WITH_REXW, 0, 0<<11 | 434, 2<<4 | 4, argp_m1},                                         // xsavec64 (836)
-	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, WITH_REXW, 0, 0<<11 | 435, 2<<4 | 6, argp_m1},                                         // xsaveopt64 (837)
-	enc{[4]byte{0xf, 0xc7, 0x00, 0x00}, WITH_REXW, 0, 0<<11 | 436, 2<<4 | 5, argp_m1},                                         // xsaves64 (838)
-	enc{[4]byte{0xf, 0x1, 0xd1, 0x00}, 0, 0, 0<<11 | 437, 3<<4 | 15, argp_},                                                   // xsetbv (839)
-	enc{[4]byte{0xf, 0x1, 0xd6, 0x00}, 0, RTM, 0<<11 | 438, 3<<4 | 15, argp_},                                                 // xtest (840)
-	enc{[4]byte{0xd9, 0xf0, 0x00, 0x00}, 0, FPU, 0<<11 | 439, 2<<4 | 15, argp_},                                               // f2xm1 (841)
-	enc{[4]byte{0xd9, 0xe1, 0x00, 0x00}, 0, FPU, 0<<11 | 440, 2<<4 | 15, argp_},                                               // fabs (842)
-	enc{[4]byte{0xde, 0xc1, 0x00, 0x00}, 0, FPU, 0<<11 | 441, 2<<4 | 15, argp_},                                               // fadd (843)
-	enc{[4]byte{0xd8, 0xc0, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 441, 2<<4 | 15, argp_Xpfp},                                   // fadd (844)
-	enc{[4]byte{0xd8, 0xc0, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 441, 2<<4 | 15, argp_fp},                                     // fadd (845)
-	enc{[4]byte{0xdc, 0xc0, 0x00, 0x00}, SHORT_ARG, FPU, 3<<11 | 441, 2<<4 | 15, argp_fpXp},                                   // fadd (846)
-	enc{[4]byte{0xdc, 0xc0, 0x00, 0x00}, SHORT_ARG, FPU, 4<<11 | 441, 2<<4 | 15, argp_fpXp},                                   // fadd (847)
-	enc{[4]byte{0xd8, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 5<<11 | 441, 1<<4 | 0, argp_md},                                     // fadd (848)
-	enc{[4]byte{0xdc, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 6<<11 | 441, 1<<4 | 0, argp_mq},                                     // fadd (849)
-	enc{[4]byte{0xde, 0xc1, 0x00, 0x00}, 0, FPU, 0<<11 | 442, 2<<4 | 15, argp_},                                               // faddp (850)
-	enc{[4]byte{0xde, 0xc0, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 442, 2<<4 | 15, argp_fp},                                     // faddp (851)
-	enc{[4]byte{0xde, 0xc0, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 442, 2<<4 | 15, argp_fpXp},                                   // faddp (852)
-	enc{[4]byte{0xdf, 0x00, 0x00, 0x00}, 0, FPU, 0<<11 | 443, 1<<4 | 4, argp_m1},                                              // fbld (853)
-	enc{[4]byte{0xdf, 0x00, 0x00, 0x00}, 0, FPU, 0<<11 | 444, 1<<4 | 6, argp_m1},                                              // fbstp (854)
-	enc{[4]byte{0xd9, 0xe0, 0x00, 0x00}, 0, FPU, 0<<11 | 445, 2<<4 | 15, argp_},                                               // fchs (855)
-	enc{[4]byte{0x9b, 0xdb, 0xe2, 0x00}, 0, FPU, 0<<11 | 446, 3<<4 | 15, argp_},                                               // fclex (856)
-	enc{[4]byte{0xda, 0xc1, 0x00, 0x00}, 0, FPU, 0<<11 | 447, 2<<4 | 15, argp_},                                               // fcmovb (857)
-	enc{[4]byte{0xda, 0xc0, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 447, 2<<4 | 15, argp_Xpfp},                                   // fcmovb (858)
-	enc{[4]byte{0xda, 0xc0, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 447, 2<<4 | 15, argp_fp},                                     // fcmovb (859)
-	enc{[4]byte{0xda, 0xd1, 0x00, 0x00}, 0, FPU, 0<<11 | 448, 2<<4 | 15, argp_},                                               // fcmovbe (860)
-	enc{[4]byte{0xda, 0xd0, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 448, 2<<4 | 15, argp_Xpfp},                                   // fcmovbe (861)
-	enc{[4]byte{0xda, 0xd0, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 448, 2<<4 | 15, argp_fp},                                     // fcmovbe (862)
-	enc{[4]byte{0xda, 0xc9, 0x00, 0x00}, 0, FPU, 0<<11 | 449, 2<<4 | 15, argp_},                                               // fcmove (863)
-	enc{[4]byte{0xda, 0xc8, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 449, 2<<4 | 15, argp_Xpfp},                                   // fcmove (864)
-	enc{[4]byte{0xda, 0xc8, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 449, 2<<4 | 15, argp_fp},                                     // fcmove (865)
-	enc{[4]byte{0xdb, 0xc1, 0x00, 0x00}, 0, FPU, 0<<11 | 450, 2<<4 | 15, argp_},                                               // fcmovnb (866)
-	enc{[4]byte{0xdb, 0xc0, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 450, 2<<4 | 15, argp_Xpfp},                                   // fcmovnb (867)
-	enc{[4]byte{0xdb, 0xc0, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 450, 2<<4 | 15, argp_fp},                                     // fcmovnb (868)
-	enc{[4]byte{0xdb, 0xd1, 0x00, 0x00}, 0, FPU, 0<<11 | 451, 2<<4 | 15, argp_},                                               // fcmovnbe (869)
-	enc{[4]byte{0xdb, 0xd0, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 451, 2<<4 | 15, argp_Xpfp},                                   // fcmovnbe (870)
-	enc{[4]byte{0xdb, 0xd0, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 451, 2<<4 | 15, argp_fp},                                     // fcmovnbe (871)
-	enc{[4]byte{0xdb, 0xc9, 0x00, 0x00}, 0, FPU, 0<<11 | 452, 2<<4 | 15, argp_},                                               // fcmovne (872)
-	enc{[4]byte{0xdb, 0xc8, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 452, 2<<4 | 15, argp_Xpfp},                                   // fcmovne (873)
-	enc{[4]byte{0xdb, 0xc8, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 452, 2<<4 | 15, argp_fp},                                     // fcmovne (874)
-	enc{[4]byte{0xdb, 0xd9, 0x00, 0x00}, 0, FPU, 0<<11 | 453, 2<<4 | 15, argp_},                                               // fcmovnu (875)
-	enc{[4]byte{0xdb, 0xd8, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 453, 2<<4 | 15, argp_Xpfp},                                   // fcmovnu (876)
-	enc{[4]byte{0xdb, 0xd8, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 453, 2<<4 | 15, argp_fp},                                     // fcmovnu (877)
-	enc{[4]byte{0xda, 0xd9, 0x00, 0x00}, 0, FPU, 0<<11 | 454, 2<<4 | 15, argp_},                                               // fcmovu (878)
-	enc{[4]byte{0xda, 0xd8, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 454, 2<<4 | 15, argp_Xpfp},                                   // fcmovu (879)
-	enc{[4]byte{0xda, 0xd8, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 454, 2<<4 | 15, argp_fp},                                     // fcmovu (880)
-	enc{[4]byte{0xd8, 0xd1, 0x00, 0x00}, 0, FPU, 0<<11 | 455, 2<<4 | 15, argp_},                                               // fcom (881)
-	enc{[4]byte{0xd8, 0xd0, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 455, 2<<4 | 15, argp_Xpfp},                                   // fcom (882)
-	enc{[4]byte{0xd8, 0xd0, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 455, 2<<4 | 15, argp_fp},                                     // fcom (883)
-	enc{[4]byte{0xd8, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 3<<11 | 455, 1<<4 | 2, argp_md},                                     // fcom (884)
-	enc{[4]byte{0xdc, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 4<<11 | 455, 1<<4 | 2, argp_mq},                                     // fcom (885)
-	enc{[4]byte{0xdb, 0xf1, 0x00, 0x00}, 0, FPU, 0<<11 | 456, 2<<4 | 15, argp_},                                               // fcomi (886)
-	enc{[4]byte{0xdb, 0xf0, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 456, 2<<4 | 15, argp_Xpfp},                                   // fcomi (887)
-	enc{[4]byte{0xdb, 0xf0, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 456, 2<<4 | 15, argp_fp},                                     // fcomi (888)
-	enc{[4]byte{0xdf, 0xf1, 0x00, 0x00}, 0, FPU, 0<<11 | 457, 2<<4 | 15, argp_},                                               // fcomip (889)
-	enc{[4]byte{0xdf, 0xf0, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 457, 2<<4 | 15, argp_Xpfp},                                   // fcomip (890)
-	enc{[4]byte{0xdf, 0xf0, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 457, 2<<4 | 15, argp_fp},                                     // fcomip (891)
-	enc{[4]byte{0xd8, 0xd9, 0x00, 0x00}, 0, FPU, 0<<11 | 458, 2<<4 | 15, argp_},                                               // fcomp (892)
-	enc{[4]byte{0xd8, 0xd8, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 458, 2<<4 | 15, argp_Xpfp},                                   // fcomp (893)
-	enc{[4]byte{0xd8, 0xd8, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 458, 2<<4 | 15, argp_fp},                                     // fcomp (894)
-	enc{[4]byte{0xd8, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 3<<11 | 458, 1<<4 | 3, argp_md},                                     // fcomp (895)
-	enc{[4]byte{0xdc, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 4<<11 | 458, 1<<4 | 3, argp_mq},                                     // fcomp (896)
-	enc{[4]byte{0xde, 0xd9, 0x00, 0x00}, 0, FPU, 0<<11 | 459, 2<<4 | 15, argp_},                                               // fcompp (897)
-	enc{[4]byte{0xd9, 0xff, 0x00, 0x00}, 0, FPU, 0<<11 | 460, 2<<4 | 15, argp_},                                               // fcos (898)
-	enc{[4]byte{0xd9, 0xf6, 0x00, 0x00}, 0, FPU, 0<<11 | 461, 2<<4 | 15, argp_},                                               // fdecstp (899)
-	enc{[4]byte{0x9b, 0xdb, 0xe1, 0x00}, 0, FPU, 0<<11 | 462, 3<<4 | 15, argp_},                                               // fdisi (900)
-	enc{[4]byte{0xde, 0xf9, 0x00, 0x00}, 0, FPU, 0<<11 | 463, 2<<4 | 15, argp_},                                               // fdiv (901)
-	enc{[4]byte{0xd8, 0xf0, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 463, 2<<4 | 15, argp_Xpfp},                                   // fdiv (902)
-	enc{[4]byte{0xd8, 0xf0, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 463, 2<<4 | 15, argp_fp},                                     // fdiv (903)
-	enc{[4]byte{0xdc, 0xf8, 0x00, 0x00}, SHORT_ARG, FPU, 3<<11 | 463, 2<<4 | 15, argp_fpXp},                                   // fdiv (904)
-	enc{[4]byte{0xdc, 0xf8, 0x00, 0x00}, SHORT_ARG, FPU, 4<<11 | 463, 2<<4 | 15, argp_fpXp},                                   // fdiv (905)
-	enc{[4]byte{0xd8, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 5<<11 | 463, 1<<4 | 6, argp_md},                                     // fdiv (906)
-	enc{[4]byte{0xdc, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 6<<11 | 463, 1<<4 | 6, argp_mq},                                     // fdiv (907)
-	enc{[4]byte{0xde, 0xf9, 0x00, 0x00}, 0, FPU, 0<<11 | 464, 2<<4 | 15, argp_},                                               // fdivp (908)
-	enc{[4]byte{0xde, 0xf8, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 464, 2<<4 | 15, argp_fp},                                     // fdivp (909)
-	enc{[4]byte{0xde, 0xf8, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 464, 2<<4 | 15, argp_fpXp},                                   // fdivp (910)
-	enc{[4]byte{0xde, 0xf1, 0x00, 0x00}, 0, FPU, 0<<11 | 465, 2<<4 | 15, argp_},                                               // fdivr (911)
-	enc{[4]byte{0xd8, 0xf8, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 465, 2<<4 | 15, argp_Xpfp},                                   // fdivr (912)
-	enc{[4]byte{0xd8, 0xf8, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 465, 2<<4 | 15, argp_fp},                                     // fdivr (913)
-	enc{[4]byte{0xdc, 0xf0, 0x00, 0x00}, SHORT_ARG, FPU, 3<<11 | 465, 2<<4 | 15, argp_fpXp},                                   // fdivr (914)
-	enc{[4]byte{0xdc, 0xf0, 0x00, 0x00}, SHORT_ARG, FPU, 4<<11 | 465, 2<<4 | 15, argp_fpXp},                                   // fdivr (915)
-	enc{[4]byte{0xd8, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 5<<11 | 465, 1<<4 | 7, argp_md},                                     // fdivr (916)
-	enc{[4]byte{0xdc, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 6<<11 | 465, 1<<4 | 7, argp_mq},                                     // fdivr (917)
-	enc{[4]byte{0xde, 0xf1, 0x00, 0x00}, 0, FPU, 0<<11 | 466, 2<<4 | 15, argp_},                                               // fdivrp (918)
-	enc{[4]byte{0xde, 0xf0, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 466, 2<<4 | 15, argp_fp},                                     // fdivrp (919)
-	enc{[4]byte{0xde, 0xf0, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 466, 2<<4 | 15, argp_fpXp},                                   // fdivrp (920)
-	enc{[4]byte{0xf, 0xe, 0x00, 0x00}, 0, TDNOW, 0<<11 | 467, 2<<4 | 15, argp_},                                               // femms (921)
-	enc{[4]byte{0x9b, 0xdb, 0xe0, 0x00}, 0, FPU, 0<<11 | 468, 3<<4 | 15, argp_},                                               // feni (922)
-	enc{[4]byte{0xdd, 0xc1, 0x00, 0x00}, 0, FPU, 0<<11 | 469, 2<<4 | 15, argp_},                                               // ffree (923)
-	enc{[4]byte{0xdd, 0xc0, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 469, 2<<4 | 15, argp_fp},                                     // ffree (924)
-	enc{[4]byte{0xda, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 0<<11 | 470, 1<<4 | 0, argp_md},                                     // fiadd (925)
-	enc{[4]byte{0xde, 0x00, 0x00, 0x00}, 0, FPU, 1<<11 | 470, 1<<4 | 0, argp_mw},                                              // fiadd (926)
-	enc{[4]byte{0xda, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 0<<11 | 471, 1<<4 | 2, argp_md},                                     // ficom (927)
-	enc{[4]byte{0xde, 0x00, 0x00, 0x00}, 0, FPU, 1<<11 | 471, 1<<4 | 2, argp_mw},                                              // ficom (928)
-	enc{[4]byte{0xda, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 0<<11 | 472, 1<<4 | 3, argp_md},                                     // ficomp (929)
-	enc{[4]byte{0xde, 0x00, 0x00, 0x00}, 0, FPU, 1<<11 | 472, 1<<4 | 3, argp_mw},                                              // ficomp (930)
-	enc{[4]byte{0xda, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 0<<11 | 473, 1<<4 | 6, argp_md},                                     // fidiv (931)
-	enc{[4]byte{0xde, 0x00, 0x00, 0x00}, 0, FPU, 1<<11 | 473, 1<<4 | 6, argp_mw},                                              // fidiv (932)
-	enc{[4]byte{0xda, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 0<<11 | 474, 1<<4 | 7, argp_md},                                     // fidivr (933)
-	enc{[4]byte{0xde, 0x00, 0x00, 0x00}, 0, FPU, 1<<11 | 474, 1<<4 | 7, argp_mw},                                              // fidivr (934)
-	enc{[4]byte{0xdb, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 0<<11 | 475, 1<<4 | 0, argp_md},                                     // fild (935)
-	enc{[4]byte{0xdf, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 1<<11 | 475, 1<<4 | 5, argp_mq},                                     // fild (936)
-	enc{[4]byte{0xdf, 0x00, 0x00, 0x00}, 0, FPU, 2<<11 | 475, 1<<4 | 0, argp_mw},                                              // fild (937)
-	enc{[4]byte{0xda, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 0<<11 | 476, 1<<4 | 1, argp_md},                                     // fimul (938)
-	enc{[4]byte{0xde, 0x00, 0x00, 0x00}, 0, FPU, 1<<11 | 476, 1<<4 | 1, argp_mw},                                              // fimul (939)
-	enc{[4]byte{0xd9, 0xf7, 0x00, 0x00}, 0, FPU, 0<<11 | 477, 2<<4 | 15, argp_},                                               // fincstp (940)
-	enc{[4]byte{0x9b, 0xdb, 0xe3, 0x00}, 0, FPU, 0<<11 | 478, 3<<4 | 15, argp_},                                               // finit (941)
-	enc{[4]byte{0xdb, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 0<<11 | 479, 1<<4 | 2, argp_md},                                     // fist (942)
-	enc{[4]byte{0xdf, 0x00, 0x00, 0x00}, 0, FPU, 1<<11 | 479, 1<<4 | 2, argp_mw},                                              // fist (943)
-	enc{[4]byte{0xdb, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 0<<11 | 480, 1<<4 | 3, argp_md},                                     // fistp (944)
-	enc{[4]byte{0xdf, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 1<<11 | 480, 1<<4 | 7, argp_mq},                                     // fistp (945)
-	enc{[4]byte{0xdf, 0x00, 0x00, 0x00}, 0, FPU, 2<<11 | 480, 1<<4 | 3, argp_mw},                                              // fistp (946)
-	enc{[4]byte{0xdb, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 0<<11 | 481, 1<<4 | 1, argp_md},                                     // fisttp (947)
-	enc{[4]byte{0xdd, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 1<<11 | 481, 1<<4 | 1, argp_mq},                                     // fisttp (948)
-	enc{[4]byte{0xdf, 0x00, 0x00, 0x00}, 0, FPU, 2<<11 | 481, 1<<4 | 1, argp_mw},                                              // fisttp (949)
-	enc{[4]byte{0xda, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 0<<11 | 482, 1<<4 | 4, argp_md},                                     // fisub (950)
-	enc{[4]byte{0xde, 0x00, 0x00, 0x00}, 0, FPU, 1<<11 | 482, 1<<4 | 4, argp_mw},                                              // fisub (951)
-	enc{[4]byte{0xda, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 0<<11 | 483, 1<<4 | 5, argp_md},                                     // fisubr (952)
-	enc{[4]byte{0xde, 0x00, 0x00, 0x00}, 0, FPU, 1<<11 | 483, 1<<4 | 5, argp_mw},                                              // fisubr (953)
-	enc{[4]byte{0xd9, 0xc1, 0x00, 0x00}, 0, FPU, 0<<11 | 484, 2<<4 | 15, argp_},                                               // fld (954)
-	enc{[4]byte{0xd9, 0xc0, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 484, 2<<4 | 15, argp_fp},                                     // fld (955)
-	enc{[4]byte{0xd9, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 2<<11 | 484, 1<<4 | 0, argp_md},                                     // fld (956)
-	enc{[4]byte{0xdb, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 3<<11 | 484, 1<<4 | 5, argp_mp},                                     // fld (957)
-	enc{[4]byte{0xdd, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 4<<11 | 484, 1<<4 | 0, argp_mq},                                     // fld (958)
-	enc{[4]byte{0xd9, 0xe8, 0x00, 0x00}, 0, FPU, 0<<11 | 485, 2<<4 | 15, argp_},                                               // fld1 (959)
-	enc{[4]byte{0xd9, 0x00, 0x00, 0x00}, 0, FPU, 0<<11 | 486, 1<<4 | 5, argp_mw},                                              // fldcw (960)
-	enc{[4]byte{0xd9, 0x00, 0x00, 0x00}, 0, FPU, 0<<11 | 487, 1<<4 | 4, argp_m1},                                              // fldenv (961)
-	enc{[4]byte{0xd9, 0xea, 0x00, 0x00}, 0, FPU, 0<<11 | 488, 2<<4 | 15, argp_},                                               // fldl2e (962)
-	enc{[4]byte{0xd9, 0xe9, 0x00, 0x00}, 0, FPU, 0<<11 | 489, 2<<4 | 15, argp_},                                               // fldl2t (963)
-	enc{[4]byte{0xd9, 0xec, 0x00, 0x00}, 0, FPU, 0<<11 | 490, 2<<4 | 15, argp_},                                               // fldlg2 (964)
-	enc{[4]byte{0xd9, 0xed, 0x00, 0x00}, 0, FPU, 0<<11 | 491, 2<<4 | 15, argp_},                                               // fldln2 (965)
-	enc{[4]byte{0xd9, 0xeb, 0x00, 0x00}, 0, FPU, 0<<11 | 492, 2<<4 | 15, argp_},                                               // fldpi (966)
-	enc{[4]byte{0xd9, 0xee, 0x00, 0x00}, 0, FPU, 0<<11 | 493, 2<<4 | 15, argp_},                                               // fldz (967)
-	enc{[4]byte{0xde, 0xc9, 0x00, 0x00}, 0, FPU, 0<<11 | 494, 2<<4 | 15, argp_},                                               // fmul (968)
-	enc{[4]byte{0xd8, 0xc8, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 494, 2<<4 | 15, argp_Xpfp},                                   // fmul (969)
-	enc{[4]byte{0xd8, 0xc8, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 494, 2<<4 | 15, argp_fp},                                     // fmul (970)
-	enc{[4]byte{0xdc, 0xc8, 0x00, 0x00}, SHORT_ARG, FPU, 3<<11 | 494, 2<<4 | 15, argp_fpXp},                                   // fmul (971)
-	enc{[4]byte{0xdc, 0xc8, 0x00, 0x00}, SHORT_ARG, FPU, 4<<11 | 494, 2<<4 | 15, argp_fpXp},                                   // fmul (972)
-	enc{[4]byte{0xd8, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 5<<11 | 494, 1<<4 | 1, argp_md},                                     // fmul (973)
-	enc{[4]byte{0xdc, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 6<<11 | 494, 1<<4 | 1, argp_mq},                                     // fmul (974)
-	enc{[4]byte{0xde, 0xc9, 0x00, 0x00}, 0, FPU, 0<<11 | 495, 2<<4 | 15, argp_},                                               // fmulp (975)
-	enc{[4]byte{0xde, 0xc8, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 495, 2<<4 | 15, argp_fp},                                     // fmulp (976)
-	enc{[4]byte{0xde, 0xc8, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 495, 2<<4 | 15, argp_fpXp},                                   // fmulp (977)
-	enc{[4]byte{0xdb, 0xe2, 0x00, 0x00}, 0, FPU, 0<<11 | 496, 2<<4 | 15, argp_},                                               // fnclex (978)
-	enc{[4]byte{0xdb, 0xe1, 0x00, 0x00}, 0, FPU, 0<<11 | 497, 2<<4 | 15, argp_},                                               // fndisi (979)
-	enc{[4]byte{0xdb, 0xe0, 0x00, 0x00}, 0, FPU, 0<<11 | 498, 2<<4 | 15, argp_},                                               // fneni (980)
-	enc{[4]byte{0xdb, 0xe3, 0x00, 0x00}, 0, FPU, 0<<11 | 499, 2<<4 | 15, argp_},                                               // fninit (981)
-	enc{[4]byte{0xd9, 0xd0, 0x00, 0x00}, 0, FPU, 0<<11 | 500, 2<<4 | 15, argp_},                                               // fnop (982)
-	enc{[4]byte{0xdd, 0x00, 0x00, 0x00}, 0, FPU, 0<<11 | 501, 1<<4 | 6, argp_m1},                                              // fnsave (983)
-	enc{[4]byte{0xd9, 0x00, 0x00, 0x00}, 0, FPU, 0<<11 | 502, 1<<4 | 7, argp_mw},                                              // fnstcw (984)
-	enc{[4]byte{0xd9, 0x00, 0x00, 0x00}, 0, FPU, 0<<11 | 503, 1<<4 | 6, argp_m1},                                              // fnstenv (985)
-	enc{[4]byte{0xdf, 0xe0, 0x00, 0x00}, 0, FPU, 0<<11 | 504, 2<<4 | 15, argp_Aw},                                             // fnstsw (986)
-	enc{[4]byte{0xdd, 0x00, 0x00, 0x00}, 0, FPU, 1<<11 | 504, 1<<4 | 7, argp_mw},                                              // fnstsw (987)
-	enc{[4]byte{0xd9, 0xf3, 0x00, 0x00}, 0, FPU, 0<<11 | 505, 2<<4 | 15, argp_},                                               // fpatan (988)
-	enc{[4]byte{0xd9, 0xf8, 0x00, 0x00}, 0, FPU, 0<<11 | 506, 2<<4 | 15, argp_},                                               // fprem (989)
-	enc{[4]byte{0xd9, 0xf5, 0x00, 0x00}, 0, FPU, 0<<11 | 507, 2<<4 | 15, argp_},                                               // fprem1 (990)
-	enc{[4]byte{0xd9, 0xf2, 0x00, 0x00}, 0, FPU, 0<<11 | 508, 2<<4 | 15, argp_},                                               // fptan (991)
-	enc{[4]byte{0xd9, 0xfc, 0x00, 0x00}, 0, FPU, 0<<11 | 509, 2<<4 | 15, argp_},                                               // frndint (992)
-	enc{[4]byte{0xdd, 0x00, 0x00, 0x00}, 0, FPU, 0<<11 | 510, 1<<4 | 4, argp_m1},                                              // frstor (993)
-	enc{[4]byte{0x9b, 0xdd, 0x00, 0x00}, 0, FPU, 0<<11 | 511, 2<<4 | 6, argp_m1},                                              // fsave (994)
-	enc{[4]byte{0xd9, 0xfd, 0x00, 0x00}, 0, FPU, 0<<11 | 512, 2<<4 | 15, argp_},                                               // fscale (995)
-	enc{[4]byte{0xdb, 0xe4, 0x00, 0x00}, 0, FPU, 0<<11 | 513, 2<<4 | 15, argp_},                                               // fsetpm (996)
-	enc{[4]byte{0xd9, 0xfe, 0x00, 0x00}, 0, FPU, 0<<11 | 514, 2<<4 | 15, argp_},                                               // fsin (997)
-	enc{[4]byte{0xd9, 0xfb, 0x00, 0x00}, 0, FPU, 0<<11 | 515, 2<<4 | 15, argp_},                                               // fsincos (998)
-	enc{[4]byte{0xd9, 0xfa, 0x00, 0x00}, 0, FPU, 0<<11 | 516, 2<<4 | 15, argp_},                                               // fsqrt (999)
-	enc{[4]byte{0xdd, 0xd1, 0x00, 0x00}, 0, FPU, 0<<11 | 517, 2<<4 | 15, argp_},                                               // fst (1000)
-	enc{[4]byte{0xdd, 0xd0, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 517, 2<<4 | 15, argp_fp},                                     // fst (1001)
-	enc{[4]byte{0xd9, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 2<<11 | 517, 1<<4 | 2, argp_md},                                     // fst (1002)
-	enc{[4]byte{0xdd, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 3<<11 | 517, 1<<4 | 2, argp_mq},                                     // fst (1003)
-	enc{[4]byte{0x9b, 0xd9, 0x00, 0x00}, 0, FPU, 0<<11 | 518, 2<<4 | 7, argp_mw},                                              // fstcw (1004)
-	enc{[4]byte{0x9b, 0xd9, 0x00, 0x00}, 0, FPU, 0<<11 | 519, 2<<4 | 6, argp_m1},                                              // fstenv (1005)
-	enc{[4]byte{0xdd, 0xd9, 0x00, 0x00}, 0, FPU, 0<<11 | 520, 2<<4 | 15, argp_},                                               // fstp (1006)
-	enc{[4]byte{0xdd, 0xd8, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 520, 2<<4 | 15, argp_fp},                                     // fstp (1007)
-	enc{[4]byte{0xd9, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 2<<11 | 520, 1<<4 | 3, argp_md},                                     // fstp (1008)
-	enc{[4]byte{0xdb, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 3<<11 | 520, 1<<4 | 7, argp_mp},                                     // fstp (1009)
-	enc{[4]byte{0xdd, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 4<<11 | 520, 1<<4 | 3, argp_mq},                                     // fstp (1010)
-	enc{[4]byte{0x9b, 0xdf, 0xe0, 0x00}, 0, FPU, 0<<11 | 521, 3<<4 | 15, argp_Aw},                                             // fstsw (1011)
-	enc{[4]byte{0x9b, 0xdd, 0x00, 0x00}, 0, FPU, 1<<11 | 521, 2<<4 | 7, argp_mw},                                              // fstsw (1012)
-	enc{[4]byte{0xde, 0xe9, 0x00, 0x00}, 0, FPU, 0<<11 | 522, 2<<4 | 15, argp_},                                               // fsub (1013)
-	enc{[4]byte{0xd8, 0xe0, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 522, 2<<4 | 15, argp_Xpfp},                                   // fsub (1014)
-	enc{[4]byte{0xd8, 0xe0, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 522, 2<<4 | 15, argp_fp},                                     // fsub (1015)
-	enc{[4]byte{0xdc, 0xe8, 0x00, 0x00}, SHORT_ARG, FPU, 3<<11 | 522, 2<<4 | 15, argp_fpXp},                                   // fsub (1016)
-	enc{[4]byte{0xdc, 0xe8, 0x00, 0x00}, SHORT_ARG, FPU, 4<<11 | 522, 2<<4 | 15, argp_fpXp},                                   // fsub (1017)
-	enc{[4]byte{0xd8, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 5<<11 | 522, 1<<4 | 4, argp_md},                                     // fsub (1018)
-	enc{[4]byte{0xdc, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 6<<11 | 522, 1<<4 | 4, argp_mq},                                     // fsub (1019)
-	enc{[4]byte{0xde, 0xe9, 0x00, 0x00}, 0, FPU, 0<<11 | 523, 2<<4 | 15, argp_},                                               // fsubp (1020)
-	enc{[4]byte{0xde, 0xe8, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 523, 2<<4 | 15, argp_fp},                                     // fsubp (1021)
-	enc{[4]byte{0xde, 0xe8, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 523, 2<<4 | 15, argp_fpXp},                                   // fsubp (1022)
-	enc{[4]byte{0xde, 0xe1, 0x00, 0x00}, 0, FPU, 0<<11 | 524, 2<<4 | 15, argp_},                                               // fsubr (1023)
-	enc{[4]byte{0xd8, 0xe8, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 524, 2<<4 | 15, argp_Xpfp},                                   // fsubr (1024)
-	enc{[4]byte{0xd8, 0xe8, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 524, 2<<4 | 15, argp_fp},                                     // fsubr (1025)
-	enc{[4]byte{0xdc, 0xe0, 0x00, 0x00}, SHORT_ARG, FPU, 3<<11 | 524, 2<<4 | 15, argp_fpXp},                                   // fsubr (1026)
-	enc{[4]byte{0xdc, 0xe0, 0x00, 0x00}, SHORT_ARG, FPU, 4<<11 | 524, 2<<4 | 15, argp_fpXp},                                   // fsubr (1027)
-	enc{[4]byte{0xd8, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 5<<11 | 524, 1<<4 | 5, argp_md},                                     // fsubr (1028)
-	enc{[4]byte{0xdc, 0x00, 0x00, 0x00}, EXACT_SIZE, FPU, 6<<11 | 524, 1<<4 | 5, argp_mq},                                     // fsubr (1029)
-	enc{[4]byte{0xde, 0xe1, 0x00, 0x00}, 0, FPU, 0<<11 | 525, 2<<4 | 15, argp_},                                               // fsubrp (1030)
-	enc{[4]byte{0xde, 0xe0, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 525, 2<<4 | 15, argp_fp},                                     // fsubrp (1031)
-	enc{[4]byte{0xde, 0xe0, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 525, 2<<4 | 15, argp_fpXp},                                   // fsubrp (1032)
-	enc{[4]byte{0xd9, 0xe4, 0x00, 0x00}, 0, FPU, 0<<11 | 526, 2<<4 | 15, argp_},                                               // ftst (1033)
-	enc{[4]byte{0xdd, 0xe1, 0x00, 0x00}, 0, FPU, 0<<11 | 527, 2<<4 | 15, argp_},                                               // fucom (1034)
-	enc{[4]byte{0xdd, 0xe0, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 527, 2<<4 | 15, argp_Xpfp},                                   // fucom (1035)
-	enc{[4]byte{0xdd, 0xe0, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 527, 2<<4 | 15, argp_fp},                                     // fucom (1036)
-	enc{[4]byte{0xdb, 0xe9, 0x00, 0x00}, 0, FPU, 0<<11 | 528, 2<<4 | 15, argp_},                                               // fucomi (1037)
-	enc{[4]byte{0xdb, 0xe8, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 528, 2<<4 | 15, argp_Xpfp},                                   // fucomi (1038)
-	enc{[4]byte{0xdb, 0xe8, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 528, 2<<4 | 15, argp_fp},                                     // fucomi (1039)
-	enc{[4]byte{0xdf, 0xe9, 0x00, 0x00}, 0, FPU, 0<<11 | 529, 2<<4 | 15, argp_},                                               // fucomip (1040)
-	enc{[4]byte{0xdf, 0xe8, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 529, 2<<4 | 15, argp_Xpfp},                                   // fucomip (1041)
-	enc{[4]byte{0xdf, 0xe8, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 529, 2<<4 | 15, argp_fp},                                     // fucomip (1042)
-	enc{[4]byte{0xdd, 0xe9, 0x00, 0x00}, 0, FPU, 0<<11 | 530, 2<<4 | 15, argp_},                                               // fucomp (1043)
-	enc{[4]byte{0xdd, 0xe8, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 530, 2<<4 | 15, argp_Xpfp},                                   // fucomp (1044)
-	enc{[4]byte{0xdd, 0xe8, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 530, 2<<4 | 15, argp_fp},                                     // fucomp (1045)
-	enc{[4]byte{0xda, 0xe9, 0x00, 0x00}, 0, FPU, 0<<11 | 531, 2<<4 | 15, argp_},                                               // fucompp (1046)
-	enc{[4]byte{0x9b, 0x00, 0x00, 0x00}, 0, 0, 0<<11 | 532, 1<<4 | 15, argp_},                                                 // fwait (1047)
-	enc{[4]byte{0xd9, 0xe5, 0x00, 0x00}, 0, FPU, 0<<11 | 533, 2<<4 | 15, argp_},                                               // fxam (1048)
-	enc{[4]byte{0xd9, 0xc9, 0x00, 0x00}, 0, FPU, 0<<11 | 534, 2<<4 | 15, argp_},                                               // fxch (1049)
-	enc{[4]byte{0xd9, 0xc8, 0x00, 0x00}, SHORT_ARG, FPU, 1<<11 | 534, 2<<4 | 15, argp_Xpfp},                                   // fxch (1050)
-	enc{[4]byte{0xd9, 0xc8, 0x00, 0x00}, SHORT_ARG, FPU, 2<<11 | 534, 2<<4 | 15, argp_fp},                                     // fxch (1051)
-	enc{[4]byte{0xd9, 0xc8, 0x00, 0x00}, SHORT_ARG, FPU, 3<<11 | 534, 2<<4 | 15, argp_fpXp},                                   // fxch (1052)
-	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, 0, FPU | SSE, 0<<11 | 535, 2<<4 | 1, argp_m1},                                         // fxrstor (1053)
-	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, WITH_REXW, FPU | SSE, 0<<11 | 536, 2<<4 | 1, argp_m1},                                 // fxrstor64 (1054)
-	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, 0, FPU | SSE, 0<<11 | 537, 2<<4 | 0, argp_m1},                                         // fxsave (1055)
-	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, WITH_REXW, FPU | SSE, 0<<11 | 538, 2<<4 | 0, argp_m1},                                 // fxsave64 (1056)
-	enc{[4]byte{0xd9, 0xf4, 0x00, 0x00}, 0, FPU, 0<<11 | 539, 2<<4 | 15, argp_},                                               // fxtract (1057)
-	enc{[4]byte{0xd9, 0xf1, 0x00, 0x00}, 0, FPU, 0<<11 | 540, 2<<4 | 15, argp_},                                               // fyl2x (1058)
-	enc{[4]byte{0xd9, 0xf9, 0x00, 0x00}, 0, FPU, 0<<11 | 541, 2<<4 | 15, argp_},                                               // fyl2xp1 (1059)
-	enc{[4]byte{0xf, 0x58, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 542, 2<<4 | 15, argp_yowo},                                     // addpd (1060)
-	enc{[4]byte{0xf, 0x58, 0x00, 0x00}, 0, SSE, 0<<11 | 543, 2<<4 | 15, argp_yowo},                                            // addps (1061)
-	enc{[4]byte{0xf, 0xd0, 0x00, 0x00}, PREF_66, SSE3, 0<<11 | 544, 2<<4 | 15, argp_yowo},                                     // addsubpd (1062)
-	enc{[4]byte{0xf, 0xd0, 0x00, 0x00}, PREF_F2, SSE3, 0<<11 | 545, 2<<4 | 15, argp_yowo},                                     // addsubps (1063)
-	enc{[4]byte{0xf, 0x55, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 546, 2<<4 | 15, argp_yowo},                                     // andnpd (1064)
-	enc{[4]byte{0xf, 0x55, 0x00, 0x00}, 0, SSE, 0<<11 | 547, 2<<4 | 15, argp_yowo},                                            // andnps (1065)
-	enc{[4]byte{0xf, 0x54, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 548, 2<<4 | 15, argp_yowo},                                     // andpd (1066)
-	enc{[4]byte{0xf, 0x54, 0x00, 0x00}, 0, SSE, 0<<11 | 549, 2<<4 | 15, argp_yowo},                                            // andps (1067)
-	enc{[4]byte{0xf, 0x3a, 0xd, 0x00}, PREF_66, SSE41, 0<<11 | 550, 3<<4 | 15, argp_yomqib},                                   // blendpd (1068)
-	enc{[4]byte{0xf, 0x3a, 0xd, 0x00}, PREF_66, SSE41, 1<<11 | 550, 3<<4 | 15, argp_yoyoib},                                   // blendpd (1069)
-	enc{[4]byte{0xf, 0x3a, 0xc, 0x00}, PREF_66, SSE41, 0<<11 | 551, 3<<4 | 15, argp_yomqib},                                   // blendps (1070)
-	enc{[4]byte{0xf, 0x3a, 0xc, 0x00}, PREF_66, SSE41, 1<<11 | 551, 3<<4 | 15, argp_yoyoib},                                   // blendps (1071)
-	enc{[4]byte{0xf, 0x38, 0x15, 0x00}, PREF_66, SSE41, 0<<11 | 552, 3<<4 | 15, argp_yomq},                                    // blendvpd (1072)
-	enc{[4]byte{0xf, 0x38, 0x15, 0x00}, PREF_66, SSE41, 1<<11 | 552, 3<<4 | 15, argp_yoyo},                                    // blendvpd (1073)
-	enc{[4]byte{0xf, 0x38, 0x14, 0x00}, PREF_66, SSE41, 0<<11 | 553, 3<<4 | 15, argp_yomq},                                    // blendvps (1074)
-	enc{[4]byte{0xf, 0x38, 0x14, 0x00}, PREF_66, SSE41, 1<<11 | 553, 3<<4 | 15, argp_yoyo},                                    // blendvps (1075)
-	enc{[4]byte{0xf, 0xc2, 0x0, 0x00}, IMM_OP | PREF_66, SSE2, 0<<11 | 554, 3<<4 | 15, argp_yowo},                             // cmpeqpd (1076)
-	enc{[4]byte{0xf, 0xc2, 0x0, 0x00}, IMM_OP, SSE, 0<<11 | 555, 3<<4 | 15, argp_yowo},                                        // cmpeqps (1077)
-	enc{[4]byte{0xf, 0xc2, 0x2, 0x00}, IMM_OP | PREF_66, SSE2, 0<<11 | 556, 3<<4 | 15, argp_yowo},                             // cmplepd (1078)
-	enc{[4]byte{0xf, 0xc2, 0x2, 0x00}, IMM_OP, SSE, 0<<11 | 557, 3<<4 | 15, argp_yowo},                                        // cmpleps (1079)
-	enc{[4]byte{0xf, 0xc2, 0x1, 0x00}, IMM_OP | PREF_66, SSE2, 0<<11 | 558, 3<<4 | 15, argp_yowo},                             // cmpltpd (1080)
-	enc{[4]byte{0xf, 0xc2, 0x1, 0x00}, IMM_OP, SSE, 0<<11 | 559, 3<<4 | 15, argp_yowo},                                        // cmpltps (1081)
-	enc{[4]byte{0xf, 0xc2, 0x4, 0x00}, IMM_OP | PREF_66, SSE2, 0<<11 | 560, 3<<4 | 15, argp_yowo},                             // cmpneqpd (1082)
-	enc{[4]byte{0xf, 0xc2, 0x4, 0x00}, IMM_OP, SSE, 0<<11 | 561, 3<<4 | 15, argp_yowo},                                        // cmpneqps (1083)
-	enc{[4]byte{0xf, 0xc2, 0x6, 0x00}, IMM_OP | PREF_66, SSE2, 0<<11 | 562, 3<<4 | 15, argp_yowo},                             // cmpnlepd (1084)
-	enc{[4]byte{0xf, 0xc2, 0x6, 0x00}, IMM_OP, SSE, 0<<11 | 563, 3<<4 | 15, argp_yowo},                                        // cmpnleps (1085)
-	enc{[4]byte{0xf, 0xc2, 0x5, 0x00}, IMM_OP | PREF_66, SSE2, 0<<11 | 564, 3<<4 | 15, argp_yowo},                             // cmpnltpd (1086)
-	enc{[4]byte{0xf, 0xc2, 0x5, 0x00}, IMM_OP, SSE, 0<<11 | 565, 3<<4 | 15, argp_yowo},                                        // cmpnltps (1087)
-	enc{[4]byte{0xf, 0xc2, 0x7, 0x00}, IMM_OP | PREF_66, SSE2, 0<<11 | 566, 3<<4 | 15, argp_yowo},                             // cmpordpd (1088)
-	enc{[4]byte{0xf, 0xc2, 0x7, 0x00}, IMM_OP, SSE, 0<<11 | 567, 3<<4 | 15, argp_yowo},                                        // cmpordps (1089)
-	enc{[4]byte{0xf, 0xc2, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 568, 2<<4 | 15, argp_yowoib},                                   // cmppd (1090)
-	enc{[4]byte{0xf, 0xc2, 0x00, 0x00}, 0, SSE, 0<<11 | 569, 2<<4 | 15, argp_yom1ib},                                          // cmpps (1091)
-	enc{[4]byte{0xf, 0xc2, 0x00, 0x00}, 0, SSE, 1<<11 | 569, 2<<4 | 15, argp_yoyoib},                                          // cmpps (1092)
-	enc{[4]byte{0xf, 0xc2, 0x3, 0x00}, IMM_OP | PREF_66, SSE2, 0<<11 | 570, 3<<4 | 15, argp_yowo},                             // cmpunordpd (1093)
-	enc{[4]byte{0xf, 0xc2, 0x3, 0x00}, IMM_OP, SSE, 0<<11 | 571, 3<<4 | 15, argp_yowo},                                        // cmpunordps (1094)
-	enc{[4]byte{0xf, 0xe6, 0x00, 0x00}, PREF_F3, SSE2, 0<<11 | 572, 2<<4 | 15, argp_yomq},                                     // cvtdq2pd (1095)
-	enc{[4]byte{0xf, 0xe6, 0x00, 0x00}, PREF_F3, SSE2, 1<<11 | 572, 2<<4 | 15, argp_yoyo},                                     // cvtdq2pd (1096)
-	enc{[4]byte{0xf, 0x5b, 0x00, 0x00}, 0, SSE2, 0<<11 | 573, 2<<4 | 15, argp_yowo},                                           // cvtdq2ps (1097)
-	enc{[4]byte{0xf, 0x5a, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 574, 2<<4 | 15, argp_yowo},                                     // cvtpd2ps (1098)
-	enc{[4]byte{0xf, 0x2a, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 575, 2<<4 | 15, argp_youq},                                     // cvtpi2pd (1099)
-	enc{[4]byte{0xf, 0x2a, 0x00, 0x00}, 0, MMX | SSE, 0<<11 | 576, 2<<4 | 15, argp_youq},                                      // cvtpi2ps (1100)
-	enc{[4]byte{0xf, 0x5a, 0x00, 0x00}, 0, SSE2, 0<<11 | 577, 2<<4 | 15, argp_yomq},                                           // cvtps2pd (1101)
-	enc{[4]byte{0xf, 0x5a, 0x00, 0x00}, 0, SSE2, 1<<11 | 577, 2<<4 | 15, argp_yoyo},                                           // cvtps2pd (1102)
-	enc{[4]byte{0xf, 0x5e, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 578, 2<<4 | 15, argp_yowo},                                     // divpd (1103)
-	enc{[4]byte{0xf, 0x5e, 0x00, 0x00}, 0, SSE, 0<<11 | 579, 2<<4 | 15, argp_yowo},                                            // divps (1104)
-	enc{[4]byte{0xf, 0x3a, 0x41, 0x00}, PREF_66, SSE41, 0<<11 | 580, 3<<4 | 15, argp_yomqib},                                  // dppd (1105)
-	enc{[4]byte{0xf, 0x3a, 0x41, 0x00}, PREF_66, SSE41, 1<<11 | 580, 3<<4 | 15, argp_yoyoib},                                  // dppd (1106)
-	enc{[4]byte{0xf, 0x3a, 0x40, 0x00}, PREF_66, SSE41, 0<<11 | 581, 3<<4 | 15, argp_yomqib},                                  // dpps (1107)
-	enc{[4]byte{0xf, 0x3a, 0x40, 0x00}, PREF_66, SSE41, 1<<11 | 581, 3<<4 | 15, argp_yoyoib},                                  // dpps (1108)
-	enc{[4]byte{0xf, 0x3a, 0x17, 0x00}, WITH_REXW | PREF_66 | ENC_MR, SSE41, 0<<11 | 582, 3<<4 | 15, argp_rqyoib},             // extractps (1109)
-	enc{[4]byte{0xf, 0x3a, 0x17, 0x00}, PREF_66 | ENC_MR, SSE41, 1<<11 | 582, 3<<4 | 15, argp_vdyoib},                         // extractps (1110)
-	enc{[4]byte{0xf, 0x7c, 0x00, 0x00}, PREF_66, SSE3, 0<<11 | 583, 2<<4 | 15, argp_yowo},                                     // haddpd (1111)
-	enc{[4]byte{0xf, 0x7c, 0x00, 0x00}, PREF_F2, SSE3, 0<<11 | 584, 2<<4 | 15, argp_yowo},                                     // haddps (1112)
-	enc{[4]byte{0xf, 0x7d, 0x00, 0x00}, PREF_66, SSE3, 0<<11 | 585, 2<<4 | 15, argp_yowo},                                     // hsubpd (1113)
-	enc{[4]byte{0xf, 0x7d, 0x00, 0x00}, PREF_F2, SSE3, 0<<11 | 586, 2<<4 | 15, argp_yowo},                                     // hsubps (1114)
-	enc{[4]byte{0xf, 0x3a, 0x21, 0x00}, PREF_66, SSE41, 0<<11 | 587, 3<<4 | 15, argp_yomdib},                                  // insertps (1115)
-	enc{[4]byte{0xf, 0x3a, 0x21, 0x00}, PREF_66, SSE41, 1<<11 | 587, 3<<4 | 15, argp_yoyoib},                                  // insertps (1116)
-	enc{[4]byte{0xf, 0x5f, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 588, 2<<4 | 15, argp_yowo},                                     // maxpd (1117)
-	enc{[4]byte{0xf, 0x5f, 0x00, 0x00}, 0, SSE, 0<<11 | 589, 2<<4 | 15, argp_yowo},                                            // maxps (1118)
-	enc{[4]byte{0xf, 0x5d, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 590, 2<<4 | 15, argp_yowo},                                     // minpd (1119)
-	enc{[4]byte{0xf, 0x5d, 0x00, 0x00}, 0, SSE, 0<<11 | 591, 2<<4 | 15, argp_yowo},                                            // minps (1120)
-	enc{[4]byte{0xf, 0x59, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 592, 2<<4 | 15, argp_yowo},                                     // mulpd (1121)
-	enc{[4]byte{0xf, 0x59, 0x00, 0x00}, 0, SSE, 0<<11 | 593, 2<<4 | 15, argp_yowo},                                            // mulps (1122)
-	enc{[4]byte{0xf, 0x56, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 594, 2<<4 | 15, argp_yowo},                                     // orpd (1123)
-	enc{[4]byte{0xf, 0x56, 0x00, 0x00}, 0, SSE, 0<<11 | 595, 2<<4 | 15, argp_yowo},                                            // orps (1124)
-	enc{[4]byte{0xf, 0x53, 0x00, 0x00}, 0, SSE, 0<<11 | 596, 2<<4 | 15, argp_yowo},                                            // rcpps (1125)
-	enc{[4]byte{0xf, 0x3a, 0x9, 0x00}, PREF_66, SSE41, 0<<11 | 597, 3<<4 | 15, argp_yomqib},                                   // roundpd (1126)
-	enc{[4]byte{0xf, 0x3a, 0x9, 0x00}, PREF_66, SSE41, 1<<11 | 597, 3<<4 | 15, argp_yoyoib},                                   // roundpd (1127)
-	enc{[4]byte{0xf, 0x3a, 0x8, 0x00}, PREF_66, SSE41, 0<<11 | 598, 3<<4 | 15, argp_yomqib},                                   // roundps (1128)
-	enc{[4]byte{0xf, 0x3a, 0x8, 0x00}, PREF_66, SSE41, 1<<11 | 598, 3<<4 | 15, argp_yoyoib},                                   // roundps (1129)
-	enc{[4]byte{0xf, 0x52, 0x00, 0x00}, 0, SSE, 0<<11 | 599, 2<<4 | 15, argp_yowo},                                            // rsqrtps (1130)
-	enc{[4]byte{0xf, 0xc6, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 600, 2<<4 | 15, argp_yowoib},                                   // shufpd (1131)
-	enc{[4]byte{0xf, 0xc6, 0x00, 0x00}, 0, SSE, 0<<11 | 601, 2<<4 | 15, argp_yowoib},                                          // shufps (1132)
-	enc{[4]byte{0xf, 0x51, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 602, 2<<4 | 15, argp_yowo},                                     // sqrtpd (1133)
-	enc{[4]byte{0xf, 0x51, 0x00, 0x00}, 0, SSE, 0<<11 | 603, 2<<4 | 15, argp_yowo},                                            // sqrtps (1134)
-	enc{[4]byte{0xf, 0x5c, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 604, 2<<4 | 15, argp_yowo},                                     // subpd (1135)
-	enc{[4]byte{0xf, 0x5c, 0x00, 0x00}, 0, SSE, 0<<11 | 605, 2<<4 | 15, argp_yowo},                                            // subps (1136)
-	enc{[4]byte{0xf, 0x15, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 606, 2<<4 | 15, argp_yowo},                                     // unpckhpd (1137)
-	enc{[4]byte{0xf, 0x15, 0x00, 0x00}, 0, SSE, 0<<11 | 607, 2<<4 | 15, argp_yowo},                                            // unpckhps (1138)
-	enc{[4]byte{0xf, 0x14, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 608, 2<<4 | 15, argp_yowo},                                     // unpcklpd (1139)
-	enc{[4]byte{0xf, 0x14, 0x00, 0x00}, 0, SSE, 0<<11 | 609, 2<<4 | 15, argp_yowo},                                            // unpcklps (1140)
-	enc{[4]byte{0xf, 0x57, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 610, 2<<4 | 15, argp_yowo},                                     // xorpd (1141)
-	enc{[4]byte{0xf, 0x57, 0x00, 0x00}, 0, SSE, 0<<11 | 611, 2<<4 | 15, argp_yowo},                                            // xorps (1142)
-	enc{[4]byte{0xf, 0x38, 0x1c, 0x00}, 0, MMX | SSSE3, 0<<11 | 612, 3<<4 | 15, argp_xquq},                                    // pabsb (1143)
-	enc{[4]byte{0xf, 0x38, 0x1c, 0x00}, PREF_66, SSSE3, 1<<11 | 612, 3<<4 | 15, argp_yomq},                                    // pabsb (1144)
-	enc{[4]byte{0xf, 0x38, 0x1c, 0x00}, PREF_66, SSSE3, 2<<11 | 612, 3<<4 | 15, argp_yoyo},                                    // pabsb (1145)
-	enc{[4]byte{0xf, 0x38, 0x1e, 0x00}, 0, MMX | SSSE3, 0<<11 | 613, 3<<4 | 15, argp_xquq},                                    // pabsd (1146)
-	enc{[4]byte{0xf, 0x38, 0x1e, 0x00}, PREF_66, SSSE3, 1<<11 | 613, 3<<4 | 15, argp_yomq},                                    // pabsd (1147)
-	enc{[4]byte{0xf, 0x38, 0x1e, 0x00}, PREF_66, SSSE3, 2<<11 | 613, 3<<4 | 15, argp_yoyo},                                    // pabsd (1148)
-	enc{[4]byte{0xf, 0x38, 0x1d, 0x00}, 0, MMX | SSSE3, 0<<11 | 614, 3<<4 | 15, argp_xquq},                                    // pabsw (1149)
-	enc{[4]byte{0xf, 0x38, 0x1d, 0x00}, PREF_66, SSSE3, 1<<11 | 614, 3<<4 | 15, argp_yomq},                                    // pabsw (1150)
-	enc{[4]byte{0xf, 0x38, 0x1d, 0x00}, PREF_66, SSSE3, 2<<11 | 614, 3<<4 | 15, argp_yoyo},                                    // pabsw (1151)
-	enc{[4]byte{0xf, 0x6b, 0x00, 0x00}, 0, MMX, 0<<11 | 615, 2<<4 | 15, argp_xquq},                                            // packssdw (1152)
-	enc{[4]byte{0xf, 0x6b, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 615, 2<<4 | 15, argp_yowo},                                     // packssdw (1153)
-	enc{[4]byte{0xf, 0x63, 0x00, 0x00}, 0, MMX, 0<<11 | 616, 2<<4 | 15, argp_xquq},                                            // packsswb (1154)
-	enc{[4]byte{0xf, 0x63, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 616, 2<<4 | 15, argp_yowo},                                     // packsswb (1155)
-	enc{[4]byte{0xf, 0x38, 0x2b, 0x00}, PREF_66, SSE41, 0<<11 | 617, 3<<4 | 15, argp_yomq},                                    // packusdw (1156)
-	enc{[4]byte{0xf, 0x38, 0x2b, 0x00}, PREF_66, SSE41, 1<<11 | 617, 3<<4 | 15, argp_yoyo},                                    // packusdw (1157)
-	enc{[4]byte{0xf, 0x67, 0x00, 0x00}, 0, MMX, 0<<11 | 618, 2<<4 | 15, argp_xquq},                                            // packuswb (1158)
-	enc{[4]byte{0xf, 0x67, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 618, 2<<4 | 15, argp_yowo},                                     // packuswb (1159)
-	enc{[4]byte{0xf, 0xfc, 0x00, 0x00}, 0, MMX, 0<<11 | 619, 2<<4 | 15, argp_xquq},                                            // paddb (1160)
-	enc{[4]byte{0xf, 0xfc, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 619, 2<<4 | 15, argp_yowo},                                     // paddb (1161)
-	enc{[4]byte{0xf, 0xfe, 0x00, 0x00}, 0, MMX, 0<<11 | 620, 2<<4 | 15, argp_xquq},                                            // paddd (1162)
-	enc{[4]byte{0xf, 0xfe, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 620, 2<<4 | 15, argp_yowo},                                     // paddd (1163)
-	enc{[4]byte{0xf, 0xd4, 0x00, 0x00}, 0, MMX, 0<<11 | 621, 2<<4 | 15, argp_xquq},                                            // paddq (1164)
-	enc{[4]byte{0xf, 0xd4, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 621, 2<<4 | 15, argp_yowo},                                     // paddq (1165)
-	enc{[4]byte{0xf, 0xec, 0x00, 0x00}, 0, MMX, 0<<11 | 622, 2<<4 | 15, argp_xquq},                                            // paddsb (1166)
-	enc{[4]byte{0xf, 0xec, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 622, 2<<4 | 15, argp_yowo},                                     // paddsb (1167)
-	enc{[4]byte{0xf, 0xed, 0x00, 0x00}, 0, MMX, 0<<11 | 623, 2<<4 | 15, argp_xquq},                                            // paddsw (1168)
-	enc{[4]byte{0xf, 0xed, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 623, 2<<4 | 15, argp_yowo},                                     // paddsw (1169)
-	enc{[4]byte{0xf, 0xdc, 0x00, 0x00}, 0, MMX, 0<<11 | 624, 2<<4 | 15, argp_xquq},                                            // paddusb (1170)
-	enc{[4]byte{0xf, 0xdc, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 624, 2<<4 | 15, argp_yowo},                                     // paddusb (1171)
-	enc{[4]byte{0xf, 0xdd, 0x00, 0x00}, 0, MMX, 0<<11 | 625, 2<<4 | 15, argp_xquq},                                            // paddusw (1172)
-	enc{[4]byte{0xf, 0xdd, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 625, 2<<4 | 15, argp_yowo},                                     // paddusw (1173)
-	enc{[4]byte{0xf, 0xfd, 0x00, 0x00}, 0, MMX, 0<<11 | 626, 2<<4 | 15, argp_xquq},                                            // paddw (1174)
-	enc{[4]byte{0xf, 0xfd, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 626, 2<<4 | 15, argp_yowo},                                     // paddw (1175)
-	enc{[4]byte{0xf, 0x3a, 0xf, 0x00}, 0, MMX | SSSE3, 0<<11 | 627, 3<<4 | 15, argp_xquqib},                                   // palignr (1176)
-	enc{[4]byte{0xf, 0x3a, 0xf, 0x00}, PREF_66, SSSE3, 1<<11 | 627, 3<<4 | 15, argp_yomqib},                                   // palignr (1177)
-	enc{[4]byte{0xf, 0x3a, 0xf, 0x00}, PREF_66, SSSE3, 2<<11 | 627, 3<<4 | 15, argp_yoyoib},                                   // palignr (1178)
-	enc{[4]byte{0xf, 0xdb, 0x00, 0x00}, 0, MMX, 0<<11 | 628, 2<<4 | 15, argp_xquq},                                            // pand (1179)
-	enc{[4]byte{0xf, 0xdb, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 628, 2<<4 | 15, argp_yowo},                                     // pand (1180)
-	enc{[4]byte{0xf, 0xdf, 0x00, 0x00}, 0, MMX, 0<<11 | 629, 2<<4 | 15, argp_xquq},                                            // pandn (1181)
-	enc{[4]byte{0xf, 0xdf, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 629, 2<<4 | 15, argp_yowo},                                     // pandn (1182)
-	enc{[4]byte{0xf, 0xe0, 0x00, 0x00}, 0, MMX, 0<<11 | 630, 2<<4 | 15, argp_xquq},                                            // pavgb (1183)
-	enc{[4]byte{0xf, 0xe0, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 630, 2<<4 | 15, argp_yowo},                                     // pavgb (1184)
-	enc{[4]byte{0xf, 0xf, 0xbf, 0x00}, IMM_OP, TDNOW, 0<<11 | 631, 3<<4 | 15, argp_xquq},                                      // pavgusb (1185)
-	enc{[4]byte{0xf, 0xe3, 0x00, 0x00}, 0, MMX, 0<<11 | 632, 2<<4 | 15, argp_xquq},                                            // pavgw (1186)
-	enc{[4]byte{0xf, 0xe3, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 632, 2<<4 | 15, argp_yowo},                                     // pavgw (1187)
-	enc{[4]byte{0xf, 0x38, 0x10, 0x00}, PREF_66, SSE41, 0<<11 | 633, 3<<4 | 15, argp_yomq},                                    // pblendvb (1188)
-	enc{[4]byte{0xf, 0x38, 0x10, 0x00}, PREF_66, SSE41, 1<<11 | 633, 3<<4 | 15, argp_yoyo},                                    // pblendvb (1189)
-	enc{[4]byte{0xf, 0x3a, 0xe, 0x00}, PREF_66, SSE41, 0<<11 | 634, 3<<4 | 15, argp_yomqib},                                   // pblendw (1190)
-	enc{[4]byte{0xf, 0x3a, 0xe, 0x00}, PREF_66, SSE41, 1<<11 | 634, 3<<4 | 15, argp_yoyoib},                                   // pblendw (1191)
-	enc{[4]byte{0xf, 0x3a, 0x44, 0x11}, IMM_OP | PREF_66, SSE, 0<<11 | 635, 4<<4 | 15, argp_yowo},                             // pclmulhqhqdq (1192)
-	enc{[4]byte{0xf, 0x3a, 0x44, 0x1}, IMM_OP | PREF_66, SSE, 0<<11 | 636, 4<<4 | 15, argp_yowo},                              // pclmulhqlqdq (1193)
-	enc{[4]byte{0xf, 0x3a, 0x44, 0x10}, IMM_OP | PREF_66, SSE, 0<<11 | 637, 4<<4 | 15, argp_yowo},                             // pclmullqhqdq (1194)
-	enc{[4]byte{0xf, 0x3a, 0x44, 0x0}, IMM_OP | PREF_66, SSE, 0<<11 | 638, 4<<4 | 15, argp_yowo},                              // pclmullqlqdq (1195)
-	enc{[4]byte{0xf, 0x3a, 0x44, 0x00}, PREF_66, SSE, 0<<11 | 639, 3<<4 | 15, argp_yowoib},                                    // pclmulqdq (1196)
-	enc{[4]byte{0xf, 0x74, 0x00, 0x00}, 0, MMX, 0<<11 | 640, 2<<4 | 15, argp_xquq},                                            // pcmpeqb (1197)
-	enc{[4]byte{0xf, 0x74, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 640, 2<<4 | 15, argp_yowo},                                     // pcmpeqb (1198)
-	enc{[4]byte{0xf, 0x76, 0x00, 0x00}, 0, MMX, 0<<11 | 641, 2<<4 | 15, argp_xquq},                                            // pcmpeqd (1199)
-	enc{[4]byte{0xf, 0x76, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 641, 2<<4 | 15, argp_yowo},                                     // pcmpeqd (1200)
-	enc{[4]byte{0xf, 0x38, 0x29, 0x00}, PREF_66, SSE41, 0<<11 | 642, 3<<4 | 15, argp_yomq},                                    // pcmpeqq (1201)
-	enc{[4]byte{0xf, 0x38, 0x29, 0x00}, PREF_66, SSE41, 1<<11 | 642, 3<<4 | 15, argp_yoyo},                                    // pcmpeqq (1202)
-	enc{[4]byte{0xf, 0x75, 0x00, 0x00}, 0, MMX, 0<<11 | 643, 2<<4 | 15, argp_xquq},                                            // pcmpeqw (1203)
-	enc{[4]byte{0xf, 0x75, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 643, 2<<4 | 15, argp_yowo},                                     // pcmpeqw (1204)
-	enc{[4]byte{0xf, 0x3a, 0x61, 0x00}, PREF_66, SSE42, 0<<11 | 644, 3<<4 | 15, argp_yomqib},                                  // pcmpestri (1205)
-	enc{[4]byte{0xf, 0x3a, 0x61, 0x00}, PREF_66, SSE42, 1<<11 | 644, 3<<4 | 15, argp_yoyoib},                                  // pcmpestri (1206)
-	enc{[4]byte{0xf, 0x3a, 0x60, 0x00}, PREF_66, SSE42, 0<<11 | 645, 3<<4 | 15, argp_yomqib},                                  // pcmpestrm (1207)
-	enc{[4]byte{0xf, 0x3a, 0x60, 0x00}, PREF_66, SSE42, 1<<11 | 645, 3<<4 | 15, argp_yoyoib},                                  // pcmpestrm (1208)
-	enc{[4]byte{0xf, 0x64, 0x00, 0x00}, 0, MMX, 0<<11 | 646, 2<<4 | 15, argp_xquq},                                            // pcmpgtb (1209)
-	enc{[4]byte{0xf, 0x64, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 646, 2<<4 | 15, argp_yowo},                                     // pcmpgtb (1210)
-	enc{[4]byte{0xf, 0x66, 0x00, 0x00}, 0, MMX, 0<<11 | 647, 2<<4 | 15, argp_xquq},                                            // pcmpgtd (1211)
-	enc{[4]byte{0xf, 0x66, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 647, 2<<4 | 15, argp_yowo},                                     // pcmpgtd (1212)
-	enc{[4]byte{0xf, 0x38, 0x37, 0x00}, PREF_66, SSE42, 0<<11 | 648, 3<<4 | 15, argp_yomq},                                    // pcmpgtq (1213)
-	enc{[4]byte{0xf, 0x38, 0x37, 0x00}, PREF_66, SSE42, 1<<11 | 648, 3<<4 | 15, argp_yoyo},                                    // pcmpgtq (1214)
-	enc{[4]byte{0xf, 0x65, 0x00, 0x00}, 0, MMX, 0<<11 | 649, 2<<4 | 15, argp_xquq},                                            // pcmpgtw (1215)
-	enc{[4]byte{0xf, 0x65, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 649, 2<<4 | 15, argp_yowo},                                     // pcmpgtw (1216)
-	enc{[4]byte{0xf, 0x3a, 0x63, 0x00}, PREF_66, SSE42, 0<<11 | 650, 3<<4 | 15, argp_yomqib},                                  // pcmpistri (1217)
-	enc{[4]byte{0xf, 0x3a, 0x63, 0x00}, PREF_66, SSE42, 1<<11 | 650, 3<<4 | 15, argp_yoyoib},                                  // pcmpistri (1218)
-	enc{[4]byte{0xf, 0x3a, 0x62, 0x00}, PREF_66, SSE42, 0<<11 | 651, 3<<4 | 15, argp_yomqib},                                  // pcmpistrm (1219)
-	enc{[4]byte{0xf, 0x3a, 0x62, 0x00}, PREF_66, SSE42, 1<<11 | 651, 3<<4 | 15, argp_yoyoib},                                  // pcmpistrm (1220)
-	enc{[4]byte{0x2, 0xf5, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F2, BMI2, 0<<11 | 652, 2<<4 | 15, argp_r0r0v0},              // pdep (1221)
-	enc{[4]byte{0x2, 0xf5, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F3, BMI2, 0<<11 | 653, 2<<4 | 15, argp_r0r0v0},              // pext (1222)
-	enc{[4]byte{0xf, 0x3a, 0x14, 0x00}, PREF_66 | ENC_MR, SSE41, 0<<11 | 654, 3<<4 | 15, argp_mbyoib},                         // pextrb (1223)
-	enc{[4]byte{0xf, 0x3a, 0x14, 0x00}, PREF_66 | ENC_MR, SSE41, 1<<11 | 654, 3<<4 | 15, argp_rdyoib},                         // pextrb (1224)
-	enc{[4]byte{0xf, 0x3a, 0x14, 0x00}, WITH_REXW | PREF_66 | ENC_MR, SSE41, 2<<11 | 654, 3<<4 | 15, argp_rqyoib},             // pextrb (1225)
-	enc{[4]byte{0xf, 0x3a, 0x16, 0x00}, PREF_66 | ENC_MR, SSE41, 0<<11 | 655, 3<<4 | 15, argp_vdyoib},                         // pextrd (1226)
-	enc{[4]byte{0xf, 0x3a, 0x16, 0x00}, WITH_REXW | PREF_66 | ENC_MR, SSE41, 0<<11 | 656, 3<<4 | 15, argp_vqyoib},             // pextrq (1227)
-	enc{[4]byte{0xf, 0x3a, 0x15, 0x00}, PREF_66 | ENC_MR, SSE41, 0<<11 | 657, 3<<4 | 15, argp_mwyoib},                         // pextrw (1228)
-	enc{[4]byte{0xf, 0xc5, 0x00, 0x00}, 0, MMX, 1<<11 | 657, 2<<4 | 15, argp_rdxqib},                                          // pextrw (1229)
-	enc{[4]byte{0xf, 0xc5, 0x00, 0x00}, PREF_66, SSE2, 2<<11 | 657, 2<<4 | 15, argp_rdyoib},                                   // pextrw (1230)
-	enc{[4]byte{0xf, 0x3a, 0x15, 0x00}, PREF_66 | ENC_MR, SSE41, 3<<11 | 657, 3<<4 | 15, argp_rdyoib},                         // pextrw (1231)
-	enc{[4]byte{0xf, 0x3a, 0x15, 0x00}, WITH_REXW | PREF_66 | ENC_MR, SSE41, 4<<11 | 657, 3<<4 | 15, argp_rqyoib},             // pextrw (1232)
-	enc{[4]byte{0xf, 0xf, 0x1d, 0x00}, IMM_OP, TDNOW, 0<<11 | 658, 3<<4 | 15, argp_xquq},                                      // pf2id (1233)
-	enc{[4]byte{0xf, 0xf, 0x1c, 0x00}, IMM_OP, TDNOW, 0<<11 | 659, 3<<4 | 15, argp_xquq},                                      // pf2iw (1234)
-	enc{[4]byte{0xf, 0xf, 0xae, 0x00}, IMM_OP, TDNOW, 0<<11 | 660, 3<<4 | 15, argp_xquq},                                      // pfacc (1235)
-	enc{[4]byte{0xf, 0xf, 0x9e, 0x00}, IMM_OP, TDNOW, 0<<11 | 661, 3<<4 | 15, argp_xquq},                                      // pfadd (1236)
-	enc{[4]byte{0xf, 0xf, 0xb0, 0x00}, IMM_OP, TDNOW, 0<<11 | 662, 3<<4 | 15, argp_xquq},                                      // pfcmpeq (1237)
-	enc{[4]byte{0xf, 0xf, 0x90, 0x00}, IMM_OP, TDNOW, 0<<11 | 663, 3<<4 | 15, argp_xquq},                                      // pfcmpge (1238)
-	enc{[4]byte{0xf, 0xf, 0xa0, 0x00}, IMM_OP, TDNOW, 0<<11 | 664, 3<<4 | 15, argp_xquq},                                      // pfcmpgt (1239)
-	enc{[4]byte{0xf, 0xf, 0xa4, 0x00}, IMM_OP, TDNOW, 0<<11 | 665, 3<<4 | 15, argp_xquq},                                      // pfmax (1240)
-	enc{[4]byte{0xf, 0xf, 0x94, 0x00}, IMM_OP, TDNOW, 0<<11 | 666, 3<<4 | 15, argp_xquq},                                      // pfmin (1241)
-	enc{[4]byte{0xf, 0xf, 0xb4, 0x00}, IMM_OP, TDNOW, 0<<11 | 667, 3<<4 | 15, argp_xquq},                                      // pfmul (1242)
-	enc{[4]byte{0xf, 0xf, 0x8a, 0x00}, IMM_OP, TDNOW, 0<<11 | 668, 3<<4 | 15, argp_xquq},                                      // pfnacc (1243)
-	enc{[4]byte{0xf, 0xf, 0x8e, 0x00}, IMM_OP, TDNOW, 0<<11 | 669, 3<<4 | 15, argp_xquq},                                      // pfpnacc (1244)
-	enc{[4]byte{0xf, 0xf, 0x96, 0x00}, IMM_OP, TDNOW, 0<<11 | 670, 3<<4 | 15, argp_xquq},                                      // pfrcp (1245)
-	enc{[4]byte{0xf, 0xf, 0xa6, 0x00}, IMM_OP, TDNOW, 0<<11 | 671, 3<<4 | 15, argp_xquq},                                      // pfrcpit1 (1246)
-	enc{[4]byte{0xf, 0xf, 0xb6, 0x00}, IMM_OP, TDNOW, 0<<11 | 672, 3<<4 | 15, argp_xquq},                                      // pfrcpit2 (1247)
-	enc{[4]byte{0xf, 0xf, 0xa7, 0x00}, IMM_OP, TDNOW, 0<<11 | 673, 3<<4 | 15, argp_xquq},                                      // pfrsqit1 (1248)
-	enc{[4]byte{0xf, 0xf, 0x97, 0x00}, IMM_OP, TDNOW, 0<<11 | 674, 3<<4 | 15, argp_xquq},                                      // pfrsqrt (1249)
-	enc{[4]byte{0xf, 0xf, 0x9a, 0x00}, IMM_OP, TDNOW, 0<<11 | 675, 3<<4 | 15, argp_xquq},                                      // pfsub (1250)
-	enc{[4]byte{0xf, 0xf, 0xaa, 0x00}, IMM_OP, TDNOW, 0<<11 | 676, 3<<4 | 15, argp_xquq},                                      // pfsubr (1251)
-	enc{[4]byte{0xf, 0x38, 0x2, 0x00}, 0, MMX | SSSE3, 0<<11 | 677, 3<<4 | 15, argp_xquq},                                     // phaddd (1252)
-	enc{[4]byte{0xf, 0x38, 0x2, 0x00}, PREF_66, SSSE3, 1<<11 | 677, 3<<4 | 15, argp_yomq},                                     // phaddd (1253)
-	enc{[4]byte{0xf, 0x38, 0x2, 0x00}, PREF_66, SSSE3, 2<<11 | 677, 3<<4 | 15, argp_yoyo},                                     // phaddd (1254)
-	enc{[4]byte{0xf, 0x38, 0x3, 0x00}, 0, MMX | SSSE3, 0<<11 | 678, 3<<4 | 15, argp_xquq},                                     // phaddsw (1255)
-	enc{[4]byte{0xf, 0x38, 0x3, 0x00}, PREF_66, SSSE3, 1<<11 | 678, 3<<4 | 15, argp_yomq},                                     // phaddsw (1256)
-	enc{[4]byte{0xf, 0x38, 0x3, 0x00}, PREF_66, SSSE3, 2<<11 | 678, 3<<4 | 15, argp_yoyo},                                     // phaddsw (1257)
-	enc{[4]byte{0xf, 0x38, 0x1, 0x00}, 0, MMX | SSSE3, 0<<11 | 679, 3<<4 | 15, argp_xquq},                                     // phaddw (1258)
-	enc{[4]byte{0xf, 0x38, 0x1, 0x00}, PREF_66, SSSE3, 1<<11 | 679, 3<<4 | 15, argp_yomq},                                     // phaddw (1259)
-	enc{[4]byte{0xf, 0x38, 0x1, 0x00}, PREF_66, SSSE3, 2<<11 | 679, 3<<4 | 15, argp_yoyo},                                     // phaddw (1260)
-	enc{[4]byte{0xf, 0x38, 0x41, 0x00}, PREF_66, SSE41, 0<<11 | 680, 3<<4 | 15, argp_yomq},                                    // phminposuw (1261)
-	enc{[4]byte{0xf, 0x38, 0x41, 0x00}, PREF_66, SSE41, 1<<11 | 680, 3<<4 | 15, argp_yoyo},                                    // phminposuw (1262)
-	enc{[4]byte{0xf, 0x38, 0x6, 0x00}, 0, MMX | SSSE3, 0<<11 | 681, 3<<4 | 15, argp_xquq},                                     // phsubd (1263)
-	enc{[4]byte{0xf, 0x38, 0x6, 0x00}, PREF_66, SSSE3, 1<<11 | 681, 3<<4 | 15, argp_yomq},                                     // phsubd (1264)
-	enc{[4]byte{0xf, 0x38, 0x6, 0x00}, PREF_66, SSSE3, 2<<11 | 681, 3<<4 | 15, argp_yoyo},                                     // phsubd (1265)
-	enc{[4]byte{0xf, 0x38, 0x7, 0x00}, 0, MMX | SSSE3, 0<<11 | 682, 3<<4 | 15, argp_xquq},                                     // phsubsw (1266)
-	enc{[4]byte{0xf, 0x38, 0x7, 0x00}, PREF_66, SSSE3, 1<<11 | 682, 3<<4 | 15, argp_yomq},                                     // phsubsw (1267)
-	enc{[4]byte{0xf, 0x38, 0x7, 0x00}, PREF_66, SSSE3, 2<<11 | 682, 3<<4 | 15, argp_yoyo},                                     // phsubsw (1268)
-	enc{[4]byte{0xf, 0x38, 0x5, 0x00}, 0, MMX | SSSE3, 0<<11 | 683, 3<<4 | 15, argp_xquq},                                     // phsubw (1269)
-	enc{[4]byte{0xf, 0x38, 0x5, 0x00}, PREF_66, SSSE3, 1<<11 | 683, 3<<4 | 15, argp_yomq},                                     // phsubw (1270)
-	enc{[4]byte{0xf, 0x38, 0x5, 0x00}, PREF_66, SSSE3, 2<<11 | 683, 3<<4 | 15, argp_yoyo},                                     // phsubw (1271)
-	enc{[4]byte{0xf, 0xf, 0xd, 0x00}, IMM_OP, TDNOW, 0<<11 | 684, 3<<4 | 15, argp_xquq},                                       // pi2fd (1272)
-	enc{[4]byte{0xf, 0xf, 0xc, 0x00}, IMM_OP, TDNOW, 0<<11 | 685, 3<<4 | 15, argp_xquq},                                       // pi2fw (1273)
-	enc{[4]byte{0xf, 0x3a, 0x20, 0x00}, PREF_66, SSE41, 0<<11 | 686, 3<<4 | 15, argp_yom1ib},                                  // pinsrb (1274)
-	enc{[4]byte{0xf, 0x3a, 0x20, 0x00}, PREF_66, SSE41, 1<<11 | 686, 3<<4 | 15, argp_yordib},                                  // pinsrb (1275)
-	enc{[4]byte{0xf, 0x3a, 0x20, 0x00}, PREF_66, SSE41, 2<<11 | 686, 3<<4 | 15, argp_yovbib},                                  // pinsrb (1276)
-	enc{[4]byte{0xf, 0x3a, 0x22, 0x00}, PREF_66, SSE41, 0<<11 | 687, 3<<4 | 15, argp_yom1ib},                                  // pinsrd (1277)
-	enc{[4]byte{0xf, 0x3a, 0x22, 0x00}, PREF_66, SSE41, 1<<11 | 687, 3<<4 | 15, argp_yovdib},                                  // pinsrd (1278)
-	enc{[4]byte{0xf, 0x3a, 0x22, 0x00}, WITH_REXW | PREF_66, SSE41, 0<<11 | 688, 3<<4 | 15, argp_yom1ib},                      // pinsrq (1279)
-	enc{[4]byte{0xf, 0x3a, 0x22, 0x00}, WITH_REXW | PREF_66, SSE41, 1<<11 | 688, 3<<4 | 15, argp_yovqib},                      // pinsrq (1280)
-	enc{[4]byte{0xf, 0xc4, 0x00, 0x00}, 0, MMX, 0<<11 | 689, 2<<4 | 15, argp_xqm1ib},                                          // pinsrw (1281)
-	enc{[4]byte{0xf, 0xc4, 0x00, 0x00}, 0, MMX, 1<<11 | 689, 2<<4 | 15, argp_xqrdib},                                          // pinsrw (1282)
-	enc{[4]byte{0xf, 0xc4, 0x00, 0x00}, 0, MMX, 2<<11 | 689, 2<<4 | 15, argp_xqvwib},                                          // pinsrw (1283)
-	enc{[4]byte{0xf, 0xc4, 0x00, 0x00}, PREF_66, SSE2, 3<<11 | 689, 2<<4 | 15, argp_yom1ib},                                   // pinsrw (1284)
-	enc{[4]byte{0xf, 0xc4, 0x00, 0x00}, PREF_66, SSE2, 4<<11 | 689, 2<<4 | 15, argp_yomwib},                                   // pinsrw (1285)
-	enc{[4]byte{0xf, 0xc4, 0x00, 0x00}, PREF_66, SSE2, 5<<11 | 689, 2<<4 | 15, argp_yordib},                                   // pinsrw (1286)
-	enc{[4]byte{0xf, 0xc4, 0x00, 0x00}, PREF_66, SSE2, 6<<11 | 689, 2<<4 | 15, argp_yorwib},                                   // pinsrw (1287)
-	enc{[4]byte{0xf, 0x38, 0x4, 0x00}, 0, MMX | SSSE3, 0<<11 | 690, 3<<4 | 15, argp_xquq},                                     // pmaddubsw (1288)
-	enc{[4]byte{0xf, 0x38, 0x4, 0x00}, PREF_66, SSSE3, 1<<11 | 690, 3<<4 | 15, argp_yomq},                                     // pmaddubsw (1289)
-	enc{[4]byte{0xf, 0x38, 0x4, 0x00}, PREF_66, SSSE3, 2<<11 | 690, 3<<4 | 15, argp_yoyo},                                     // pmaddubsw (1290)
-	enc{[4]byte{0xf, 0xf5, 0x00, 0x00}, 0, MMX, 0<<11 | 691, 2<<4 | 15, argp_xquq},                                            // pmaddwd (1291)
-	enc{[4]byte{0xf, 0xf5, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 691, 2<<4 | 15, argp_yowo},                                     // pmaddwd (1292)
-	enc{[4]byte{0xf, 0x38, 0x3c, 0x00}, PREF_66, SSE41, 0<<11 | 692, 3<<4 | 15, argp_yomq},                                    // pmaxsb (1293)
-	enc{[4]byte{0xf, 0x38, 0x3c, 0x00}, PREF_66, SSE41, 1<<11 | 692, 3<<4 | 15, argp_yoyo},                                    // pmaxsb (1294)
-	enc{[4]byte{0xf, 0x38, 0x3d, 0x00}, PREF_66, SSE41, 0<<11 | 693, 3<<4 | 15, argp_yomq},                                    // pmaxsd (1295)
-	enc{[4]byte{0xf, 0x38, 0x3d, 0x00}, PREF_66, SSE41, 1<<11 | 693, 3<<4 | 15, argp_yoyo},                                    // pmaxsd (1296)
-	enc{[4]byte{0xf, 0xee, 0x00, 0x00}, 0, MMX, 0<<11 | 694, 2<<4 | 15, argp_xquq},                                            // pmaxsw (1297)
-	enc{[4]byte{0xf, 0xee, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 694, 2<<4 | 15, argp_yowo},                                     // pmaxsw (1298)
-	enc{[4]byte{0xf, 0xde, 0x00, 0x00}, 0, MMX, 0<<11 | 695, 2<<4 | 15, argp_xquq},                                            // pmaxub (1299)
-	enc{[4]byte{0xf, 0xde, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 695, 2<<4 | 15, argp_yowo},                                     // pmaxub (1300)
-	enc{[4]byte{0xf, 0x38, 0x3f, 0x00}, PREF_66, SSE41, 0<<11 | 696, 3<<4 | 15, argp_yomq},                                    // pmaxud (1301)
-	enc{[4]byte{0xf, 0x38, 0x3f, 0x00}, PREF_66, SSE41, 1<<11 | 696, 3<<4 | 15, argp_yoyo},                                    // pmaxud (1302)
-	enc{[4]byte{0xf, 0x38, 0x3e, 0x00}, PREF_66, SSE41, 0<<11 | 697, 3<<4 | 15, argp_yomq},                                    // pmaxuw (1303)
-	enc{[4]byte{0xf, 0x38, 0x3e, 0x00}, PREF_66, SSE41, 1<<11 | 697, 3<<4 | 15, argp_yoyo},                                    // pmaxuw (1304)
-	enc{[4]byte{0xf, 0x38, 0x38, 0x00}, PREF_66, SSE41, 0<<11 | 698, 3<<4 | 15, argp_yomq},                                    // pminsb (1305)
-	enc{[4]byte{0xf, 0x38, 0x38, 0x00}, PREF_66, SSE41, 1<<11 | 698, 3<<4 | 15, argp_yoyo},                                    // pminsb (1306)
-	enc{[4]byte{0xf, 0x38, 0x39, 0x00}, PREF_66, SSE41, 0<<11 | 699, 3<<4 | 15, argp_yomq},                                    // pminsd (1307)
-	enc{[4]byte{0xf, 0x38, 0x39, 0x00}, PREF_66, SSE41, 1<<11 | 699, 3<<4 | 15, argp_yoyo},                                    // pminsd (1308)
-	enc{[4]byte{0xf, 0xea, 0x00, 0x00}, 0, MMX, 0<<11 | 700, 2<<4 | 15, argp_xquq},                                            // pminsw (1309)
-	enc{[4]byte{0xf, 0xea, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 700, 2<<4 | 15, argp_yowo},                                     // pminsw (1310)
-	enc{[4]byte{0xf, 0xda, 0x00, 0x00}, 0, MMX, 0<<11 | 701, 2<<4 | 15, argp_xquq},                                            // pminub (1311)
-	enc{[4]byte{0xf, 0xda, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 701, 2<<4 | 15, argp_yowo},                                     // pminub (1312)
-	enc{[4]byte{0xf, 0x38, 0x3b, 0x00}, PREF_66, SSE41, 0<<11 | 702, 3<<4 | 15, argp_yomq},                                    // pminud (1313)
-	enc{[4]byte{0xf, 0x38, 0x3b, 0x00}, PREF_66, SSE41, 1<<11 | 702, 3<<4 | 15, argp_yoyo},                                    // pminud (1314)
-	enc{[4]byte{0xf, 0x38, 0x3a, 0x00}, PREF_66, SSE41, 0<<11 | 703, 3<<4 | 15, argp_yomq},                                    // pminuw (1315)
-	enc{[4]byte{0xf, 0x38, 0x3a, 0x00}, PREF_66, SSE41, 1<<11 | 703, 3<<4 | 15, argp_yoyo},                                    // pminuw (1316)
-	enc{[4]byte{0xf, 0xd7, 0x00, 0x00}, 0, MMX, 0<<11 | 704, 2<<4 | 15, argp_rdxq},                                            // pmovmskb (1317)
-	enc{[4]byte{0xf, 0xd7, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 704, 2<<4 | 15, argp_rdyo},                                     // pmovmskb (1318)
-	enc{[4]byte{0xf, 0x38, 0x21, 0x00}, PREF_66, SSE41, 0<<11 | 705, 3<<4 | 15, argp_yomd},                                    // pmovsxbd (1319)
-	enc{[4]byte{0xf, 0x38, 0x21, 0x00}, PREF_66, SSE41, 1<<11 | 705, 3<<4 | 15, argp_yoyo},                                    // pmovsxbd (1320)
-	enc{[4]byte{0xf, 0x38, 0x22, 0x00}, PREF_66, SSE41, 0<<11 | 706, 3<<4 | 15, argp_yomw},                                    // pmovsxbq (1321)
-	enc{[4]byte{0xf, 0x38, 0x22, 0x00}, PREF_66, SSE41, 1<<11 | 706, 3<<4 | 15, argp_yoyo},                                    // pmovsxbq (1322)
-	enc{[4]byte{0xf, 0x38, 0x20, 0x00}, PREF_66, SSE41, 0<<11 | 707, 3<<4 | 15, argp_yomq},                                    // pmovsxbw (1323)
-	enc{[4]byte{0xf, 0x38, 0x20, 0x00}, PREF_66, SSE41, 1<<11 | 707, 3<<4 | 15, argp_yoyo},                                    // pmovsxbw (1324)
-	enc{[4]byte{0xf, 0x38, 0x25, 0x00}, PREF_66, SSE41, 0<<11 | 708, 3<<4 | 15, argp_yomq},                                    // pmovsxdq (1325)
-	enc{[4]byte{0xf, 0x38, 0x25, 0x00}, PREF_66, SSE41, 1<<11 | 708, 3<<4 | 15, argp_yoyo},                                    // pmovsxdq (1326)
-	enc{[4]byte{0xf, 0x38, 0x23, 0x00}, PREF_66, SSE41, 0<<11 | 709, 3<<4 | 15, argp_yomq},                                    // pmovsxwd (1327)
-	enc{[4]byte{0xf, 0x38, 0x23, 0x00}, PREF_66, SSE41, 1<<11 | 709, 3<<4 | 15, argp_yoyo},                                    // pmovsxwd (1328)
-	enc{[4]byte{0xf, 0x38, 0x24, 0x00}, PREF_66, SSE41, 0<<11 | 710, 3<<4 | 15, argp_yomd},                                    // pmovsxwq (1329)
-	enc{[4]byte{0xf, 0x38, 0x24, 0x00}, PREF_66, SSE41, 1<<11 | 710, 3<<4 | 15, argp_yoyo},                                    // pmovsxwq (1330)
-	enc{[4]byte{0xf, 0x38, 0x31, 0x00}, PREF_66, SSE41, 0<<11 | 711, 3<<4 | 15, argp_yomd},                                    // pmovzxbd (1331)
-	enc{[4]byte{0xf, 0x38, 0x31, 0x00}, PREF_66, SSE41, 1<<11 | 711, 3<<4 | 15, argp_yoyo},                                    // pmovzxbd (1332)
-	enc{[4]byte{0xf, 0x38, 0x32, 0x00}, PREF_66, SSE41, 0<<11 | 712, 3<<4 | 15, argp_yomw},                                    // pmovzxbq (1333)
-	enc{[4]byte{0xf, 0x38, 0x32, 0x00}, PREF_66, SSE41, 1<<11 | 712, 3<<4 | 15, argp_yoyo},                                    // pmovzxbq (1334)
-	enc{[4]byte{0xf, 0x38, 0x30, 0x00}, PREF_66, SSE41, 0<<11 | 713, 3<<4 | 15, argp_yomq},                                    // pmovzxbw (1335)
-	enc{[4]byte{0xf, 0x38, 0x30, 0x00}, PREF_66, SSE41, 1<<11 | 713, 3<<4 | 15, argp_yoyo},                                    // pmovzxbw (1336)
-	enc{[4]byte{0xf, 0x38, 0x35, 0x00}, PREF_66, SSE41, 0<<11 | 714, 3<<4 | 15, argp_yomq},                                    // pmovzxdq (1337)
-	enc{[4]byte{0xf, 0x38, 0x35, 0x00}, PREF_66, SSE41, 1<<11 | 714, 3<<4 | 15, argp_yoyo},                                    // pmovzxdq (1338)
-	enc{[4]byte{0xf, 0x38, 0x33, 0x00}, PREF_66, SSE41, 0<<11 | 715, 3<<4 | 15, argp_yomq},                                    // pmovzxwd (1339)
-	enc{[4]byte{0xf, 0x38, 0x33, 0x00}, PREF_66, SSE41, 1<<11 | 715, 3<<4 | 15, argp_yoyo},                                    // pmovzxwd (1340)
-	enc{[4]byte{0xf, 0x38, 0x34, 0x00}, PREF_66, SSE41, 0<<11 | 716, 3<<4 | 15, argp_yomd},                                    // pmovzxwq (1341)
-	enc{[4]byte{0xf, 0x38, 0x34, 0x00}, PREF_66, SSE41, 1<<11 | 716, 3<<4 | 15, argp_yoyo},                                    // pmovzxwq (1342)
-	enc{[4]byte{0xf, 0x38, 0x28, 0x00}, PREF_66, SSE41, 0<<11 | 717, 3<<4 | 15, argp_yomq},                                    // pmuldq (1343)
-	enc{[4]byte{0xf, 0x38, 0x28, 0x00}, PREF_66, SSE41, 1<<11 | 717, 3<<4 | 15, argp_yoyo},                                    // pmuldq (1344)
-	enc{[4]byte{0xf, 0x38, 0xb, 0x00}, 0, MMX | SSSE3, 0<<11 | 718, 3<<4 | 15, argp_xquq},                                     // pmulhrsw (1345)
-	enc{[4]byte{0xf, 0x38, 0xb, 0x00}, PREF_66, SSSE3, 1<<11 | 718, 3<<4 | 15, argp_yomq},                                     // pmulhrsw (1346)
-	enc{[4]byte{0xf, 0x38, 0xb, 0x00}, PREF_66, SSSE3, 2<<11 | 718, 3<<4 | 15, argp_yoyo},                                     // pmulhrsw (1347)
-	enc{[4]byte{0xf, 0xf, 0xb7, 0x00}, IMM_OP, TDNOW, 0<<11 | 719, 3<<4 | 15, argp_xquq},                                      // pmulhrwa (1348)
-	enc{[4]byte{0xf, 0xe4, 0x00, 0x00}, 0, MMX, 0<<11 | 720, 2<<4 | 15, argp_xquq},                                            // pmulhuw (1349)
-	enc{[4]byte{0xf, 0xe4, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 720, 2<<4 | 15, argp_yowo},                                     // pmulhuw (1350)
-	enc{[4]byte{0xf, 0xe5, 0x00, 0x00}, 0, MMX, 0<<11 | 721, 2<<4 | 15, argp_xquq},                                            // pmulhw (1351)
-	enc{[4]byte{0xf, 0xe5, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 721, 2<<4 | 15, argp_yowo},                                     // pmulhw (1352)
-	enc{[4]byte{0xf, 0x38, 0x40, 0x00}, PREF_66, SSE41, 0<<11 | 722, 3<<4 | 15, argp_yomq},                                    // pmulld (1353)
-	enc{[4]byte{0xf, 0x38, 0x40, 0x00}, PREF_66, SSE41, 1<<11 | 722, 3<<4 | 15, argp_yoyo},                                    // pmulld (1354)
-	enc{[4]byte{0xf, 0xd5, 0x00, 0x00}, 0, MMX, 0<<11 | 723, 2<<4 | 15, argp_xquq},                                            // pmullw (1355)
-	enc{[4]byte{0xf, 0xd5, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 723, 2<<4 | 15, argp_yowo},                                     // pmullw (1356)
-	enc{[4]byte{0xf, 0xf4, 0x00, 0x00}, 0, SSE2, 0<<11 | 724, 2<<4 | 15, argp_xquq},                                           // pmuludq (1357)
-	enc{[4]byte{0xf, 0xf4, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 724, 2<<4 | 15, argp_yowo},                                     // pmuludq (1358)
-	enc{[4]byte{0xf, 0xeb, 0x00, 0x00}, 0, MMX, 0<<11 | 725, 2<<4 | 15, argp_xquq},                                            // por (1359)
-	enc{[4]byte{0xf, 0xeb, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 725, 2<<4 | 15, argp_yowo},                                     // por (1360)
-	enc{[4]byte{0xf, 0xf6, 0x00, 0x00}, 0, MMX, 0<<11 | 726, 2<<4 | 15, argp_xquq},                                            // psadbw (1361)
-	enc{[4]byte{0xf, 0xf6, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 726, 2<<4 | 15, argp_yowo},                                     // psadbw (1362)
-	enc{[4]byte{0xf, 0x38, 0x0, 0x00}, 0, MMX | SSSE3, 0<<11 | 727, 3<<4 | 15, argp_xquq},                                     // pshufb (1363)
-	enc{[4]byte{0xf, 0x38, 0x0, 0x00}, PREF_66, SSSE3, 1<<11 | 727, 3<<4 | 15, argp_yomq},                                     // pshufb (1364)
-	enc{[4]byte{0xf, 0x38, 0x0, 0x00}, PREF_66, SSSE3, 2<<11 | 727, 3<<4 | 15, argp_yoyo},                                     // pshufb (1365)
-	enc{[4]byte{0xf, 0x70, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 728, 2<<4 | 15, argp_yowoib},                                   // pshufd (1366)
-	enc{[4]byte{0xf, 0x70, 0x00, 0x00}, PREF_F3, SSE2, 0<<11 | 729, 2<<4 | 15, argp_yowoib},                                   // pshufhw (1367)
-	enc{[4]byte{0xf, 0x70, 0x00, 0x00}, PREF_F2, SSE2, 0<<11 | 730, 2<<4 | 15, argp_yowoib},                                   // pshuflw (1368)
-	enc{[4]byte{0xf, 0x70, 0x00, 0x00}, 0, MMX, 0<<11 | 731, 2<<4 | 15, argp_xquqib},                                          // pshufw (1369)
-	enc{[4]byte{0xf, 0x38, 0x8, 0x00}, 0, MMX | SSSE3, 0<<11 | 732, 3<<4 | 15, argp_xquq},                                     // psignb (1370)
-	enc{[4]byte{0xf, 0x38, 0x8, 0x00}, PREF_66, SSSE3, 1<<11 | 732, 3<<4 | 15, argp_yomq},                                     // psignb (1371)
-	enc{[4]byte{0xf, 0x38, 0x8, 0x00}, PREF_66, SSSE3, 2<<11 | 732, 3<<4 | 15, argp_yoyo},                                     // psignb (1372)
-	enc{[4]byte{0xf, 0x38, 0xa, 0x00}, 0, MMX | SSSE3, 0<<11 | 733, 3<<4 | 15, argp_xquq},                                     // psignd (1373)
-	enc{[4]byte{0xf, 0x38, 0xa, 0x00}, PREF_66, SSSE3, 1<<11 | 733, 3<<4 | 15, argp_yomq},                                     // psignd (1374)
-	enc{[4]byte{0xf, 0x38, 0xa, 0x00}, PREF_66, SSSE3, 2<<11 | 733, 3<<4 | 15, argp_yoyo},                                     // psignd (1375)
-	enc{[4]byte{0xf, 0x38, 0x9, 0x00}, 0, MMX | SSSE3, 0<<11 | 734, 3<<4 | 15, argp_xquq},                                     // psignw (1376)
-	enc{[4]byte{0xf, 0x38, 0x9, 0x00}, PREF_66, SSSE3, 1<<11 | 734, 3<<4 | 15, argp_yomq},                                     // psignw (1377)
-	enc{[4]byte{0xf, 0x38, 0x9, 0x00}, PREF_66, SSSE3, 2<<11 | 734, 3<<4 | 15, argp_yoyo},                                     // psignw (1378)
-	enc{[4]byte{0xf, 0x72, 0x00, 0x00}, 0, MMX, 0<<11 | 735, 2<<4 | 6, argp_xqib},                                             // pslld (1379)
-	enc{[4]byte{0xf, 0xf2, 0x00, 0x00}, 0, MMX, 1<<11 | 735, 2<<4 | 15, argp_xquq},                                            // pslld (1380)
-	enc{[4]byte{0xf, 0x72, 0x00, 0x00}, PREF_66, SSE2, 2<<11 | 735, 2<<4 | 6, argp_yoib},                                      // pslld (1381)
-	enc{[4]byte{0xf, 0xf2, 0x00, 0x00}, PREF_66, SSE2, 3<<11 | 735, 2<<4 | 15, argp_yowo},                                     // pslld (1382)
-	enc{[4]byte{0xf, 0x73, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 736, 2<<4 | 7, argp_yoib},                                      // pslldq (1383)
-	enc{[4]byte{0xf, 0x73, 0x00, 0x00}, 0, MMX, 0<<11 | 737, 2<<4 | 6, argp_xqib},                                             // psllq (1384)
-	enc{[4]byte{0xf, 0xf3, 0x00, 0x00}, 0, MMX, 1<<11 | 737, 2<<4 | 15, argp_xquq},                                            // psllq (1385)
-	enc{[4]byte{0xf, 0x73, 0x00, 0x00}, PREF_66, SSE2, 2<<11 | 737, 2<<4 | 6, argp_yoib},                                      // psllq (1386)
-	enc{[4]byte{0xf, 0xf3, 0x00, 0x00}, PREF_66, SSE2, 3<<11 | 737, 2<<4 | 15, argp_yowo},                                     // psllq (1387)
-	enc{[4]byte{0xf, 0x71, 0x00, 0x00}, 0, MMX, 0<<11 | 738, 2<<4 | 6, argp_xqib},                                             // psllw (1388)
-	enc{[4]byte{0xf, 0xf1, 0x00, 0x00}, 0, MMX, 1<<11 | 738, 2<<4 | 15, argp_xquq},                                            // psllw (1389)
-	enc{[4]byte{0xf, 0x71, 0x00, 0x00}, PREF_66, SSE2, 2<<11 | 738, 2<<4 | 6, argp_yoib},                                      // psllw (1390)
-	enc{[4]byte{0xf, 0xf1, 0x00, 0x00}, PREF_66, SSE2, 3<<11 | 738, 2<<4 | 15, argp_yowo},                                     // psllw (1391)
-	enc{[4]byte{0xf, 0x72, 0x00, 0x00}, 0, MMX, 0<<11 | 739, 2<<4 | 4, argp_xqib},                                             // psrad (1392)
-	enc{[4]byte{0xf, 0xe2, 0x00, 0x00}, 0, MMX, 1<<11 | 739, 2<<4 | 15, argp_xquq},                                            // psrad (1393)
-	enc{[4]byte{0xf, 0x72, 0x00, 0x00}, PREF_66, SSE2, 2<<11 | 739, 2<<4 | 4, argp_yoib},                                      // psrad (1394)
-	enc{[4]byte{0xf, 0xe2, 0x00, 0x00}, PREF_66, SSE2, 3<<11 | 739, 2<<4 | 15, argp_yowo},                                     // psrad (1395)
-	enc{[4]byte{0xf, 0x71, 0x00, 0x00}, 0, MMX, 0<<11 | 740, 2<<4 | 4, argp_xqib},                                             // psraw (1396)
-	enc{[4]byte{0xf, 0xe1, 0x00, 0x00}, 0, MMX, 1<<11 | 740, 2<<4 | 15, argp_xquq},                                            // psraw (1397)
-	enc{[4]byte{0xf, 0x71, 0x00, 0x00}, PREF_66, SSE2, 2<<11 | 740, 2<<4 | 4, argp_yoib},                                      // psraw (1398)
-	enc{[4]byte{0xf, 0xe1, 0x00, 0x00}, PREF_66, SSE2, 3<<11 | 740, 2<<4 | 15, argp_yowo},                                     // psraw (1399)
-	enc{[4]byte{0xf, 0x72, 0x00, 0x00}, 0, MMX, 0<<11 | 741, 2<<4 | 2, argp_xqib},                                             // psrld (1400)
-	enc{[4]byte{0xf, 0xd2, 0x00, 0x00}, 0, MMX, 1<<11 | 741, 2<<4 | 15, argp_xquq},                                            // psrld (1401)
-	enc{[4]byte{0xf, 0x72, 0x00, 0x00}, PREF_66, SSE2, 2<<11 | 741, 2<<4 | 2, argp_yoib},                                      // psrld (1402)
-	enc{[4]byte{0xf, 0xd2, 0x00, 0x00}, PREF_66, SSE2, 3<<11 | 741, 2<<4 | 15, argp_yowo},                                     // psrld (1403)
-	enc{[4]byte{0xf, 0x73, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 742, 2<<4 | 3, argp_yoib},                                      // psrldq (1404)
-	enc{[4]byte{0xf, 0x73, 0x00, 0x00}, 0, MMX, 0<<11 | 743, 2<<4 | 2, argp_xqib},                                             // psrlq (1405)
-	enc{[4]byte{0xf, 0xd3, 0x00, 0x00}, 0, MMX, 1<<11 | 743, 2<<4 | 15, argp_xquq},                                            // psrlq (1406)
-	enc{[4]byte{0xf, 0x73, 0x00, 0x00}, PREF_66, SSE2, 2<<11 | 743, 2<<4 | 2, argp_yoib},                                      // psrlq (1407)
-	enc{[4]byte{0xf, 0xd3, 0x00, 0x00}, PREF_66, SSE2, 3<<11 | 743, 2<<4 | 15, argp_yowo},                                     // psrlq (1408)
-	enc{[4]byte{0xf, 0x71, 0x00, 0x00}, 0, MMX, 0<<11 | 744, 2<<4 | 2, argp_xqib},                                             // psrlw (1409)
-	enc{[4]byte{0xf, 0xd1, 0x00, 0x00}, 0, MMX, 1<<11 | 744, 2<<4 | 15, argp_xquq},                                            // psrlw (1410)
-	enc{[4]byte{0xf, 0x71, 0x00, 0x00}, PREF_66, SSE2, 2<<11 | 744, 2<<4 | 2, argp_yoib},                                      // psrlw (1411)
-	enc{[4]byte{0xf, 0xd1, 0x00, 0x00}, PREF_66, SSE2, 3<<11 | 744, 2<<4 | 15, argp_yowo},                                     // psrlw (1412)
-	enc{[4]byte{0xf, 0xf8, 0x00, 0x00}, 0, MMX, 0<<11 | 745, 2<<4 | 15, argp_xquq},                                            // psubb (1413)
-	enc{[4]byte{0xf, 0xf8, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 745, 2<<4 | 15, argp_yowo},                                     // psubb (1414)
-	enc{[4]byte{0xf, 0xfa, 0x00, 0x00}, 0, MMX, 0<<11 | 746, 2<<4 | 15, argp_xquq},                                            // psubd (1415)
-	enc{[4]byte{0xf, 0xfa, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 746, 2<<4 | 15, argp_yowo},                                     // psubd (1416)
-	enc{[4]byte{0xf, 0xfb, 0x00, 0x00}, 0, SSE2, 0<<11 | 747, 2<<4 | 15, argp_xquq},                                           // psubq (1417)
-	enc{[4]byte{0xf, 0xfb, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 747, 2<<4 | 15, argp_yowo},                                     // psubq (1418)
-	enc{[4]byte{0xf, 0xe8, 0x00, 0x00}, 0, MMX, 0<<11 | 748, 2<<4 | 15, argp_xquq},                                            // psubsb (1419)
-	enc{[4]byte{0xf, 0xe8, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 748, 2<<4 | 15, argp_yowo},                                     // psubsb (1420)
-	enc{[4]byte{0xf, 0xe9, 0x00, 0x00}, 0, MMX, 0<<11 | 749, 2<<4 | 15, argp_xquq},                                            // psubsw (1421)
-	enc{[4]byte{0xf, 0xe9, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 749, 2<<4 | 15, argp_yowo},                                     // psubsw (1422)
-	enc{[4]byte{0xf, 0xd8, 0x00, 0x00}, 0, MMX, 0<<11 | 750, 2<<4 | 15, argp_xquq},                                            // psubusb (1423)
-	enc{[4]byte{0xf, 0xd8, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 750, 2<<4 | 15, argp_yowo},                                     // psubusb (1424)
-	enc{[4]byte{0xf, 0xd9, 0x00, 0x00}, 0, MMX, 0<<11 | 751, 2<<4 | 15, argp_xquq},                                            // psubusw (1425)
-	enc{[4]byte{0xf, 0xd9, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 751, 2<<4 | 15, argp_yowo},                                     // psubusw (1426)
-	enc{[4]byte{0xf, 0xf9, 0x00, 0x00}, 0, MMX, 0<<11 | 752, 2<<4 | 15, argp_xquq},                                            // psubw (1427)
-	enc{[4]byte{0xf, 0xf9, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 752, 2<<4 | 15, argp_yowo},                                     // psubw (1428)
-	enc{[4]byte{0xf, 0xf, 0xbb, 0x00}, IMM_OP, TDNOW, 0<<11 | 753, 3<<4 | 15, argp_xquq},                                      // pswapd (1429)
-	enc{[4]byte{0xf, 0x38, 0x17, 0x00}, PREF_66, SSE41, 0<<11 | 754, 3<<4 | 15, argp_yomq},                                    // ptest (1430)
-	enc{[4]byte{0xf, 0x38, 0x17, 0x00}, PREF_66, SSE41, 1<<11 | 754, 3<<4 | 15, argp_yoyo},                                    // ptest (1431)
-	enc{[4]byte{0xf, 0x68, 0x00, 0x00}, 0, MMX, 0<<11 | 755, 2<<4 | 15, argp_xquq},                                            // punpckhbw (1432)
-	enc{[4]byte{0xf, 0x68, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 755, 2<<4 | 15, argp_yowo},                                     // punpckhbw (1433)
-	enc{[4]byte{0xf, 0x6a, 0x00, 0x00}, 0, MMX, 0<<11 | 756, 2<<4 | 15, argp_xquq},                                            // punpckhdq (1434)
-	enc{[4]byte{0xf, 0x6a, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 756, 2<<4 | 15, argp_yowo},                                     // punpckhdq (1435)
-	enc{[4]byte{0xf, 0x6d, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 757, 2<<4 | 15, argp_yowo},                                     // punpckhqdq (1436)
-	enc{[4]byte{0xf, 0x69, 0x00, 0x00}, 0, MMX, 0<<11 | 758, 2<<4 | 15, argp_xquq},                                            // punpckhwd (1437)
-	enc{[4]byte{0xf, 0x69, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 758, 2<<4 | 15, argp_yowo},                                     // punpckhwd (1438)
-	enc{[4]byte{0xf, 0x60, 0x00, 0x00}, 0, MMX, 0<<11 | 759, 2<<4 | 15, argp_xquq},                                            // punpcklbw (1439)
-	enc{[4]byte{0xf, 0x60, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 759, 2<<4 | 15, argp_yowo},                                     // punpcklbw (1440)
-	enc{[4]byte{0xf, 0x62, 0x00, 0x00}, 0, MMX, 0<<11 | 760, 2<<4 | 15, argp_xquq},                                            // punpckldq (1441)
-	enc{[4]byte{0xf, 0x62, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 760, 2<<4 | 15, argp_yowo},                                     // punpckldq (1442)
-	enc{[4]byte{0xf, 0x6c, 0x00, 0x00}, PREF_66, SSE2, 0<<11 | 761, 2<<4 | 15, argp_yowo},                                     // punpcklqdq (1443)
-	enc{[4]byte{0xf, 0x61, 0x00, 0x00}, 0, MMX, 0<<11 | 762, 2<<4 | 15, argp_xquq},                                            // punpcklwd (1444)
-	enc{[4]byte{0xf, 0x61, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 762, 2<<4 | 15, argp_yowo},                                     // punpcklwd (1445)
-	enc{[4]byte{0xf, 0xef, 0x00, 0x00}, 0, MMX, 0<<11 | 763, 2<<4 | 15, argp_xquq},                                            // pxor (1446)
-	enc{[4]byte{0xf, 0xef, 0x00, 0x00}, PREF_66, SSE2, 1<<11 | 763, 2<<4 | 15, argp_yowo},                                     // pxor (1447)
-	enc{[4]byte{0x1, 0x58, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 764, 2<<4 | 15, argp_y0y0w0},               // vaddpd (1448)
-	enc{[4]byte{0x1, 0x58, 0x00, 0x00}, VEX_OP | AUTO_VEXL, AVX, 0<<11 | 765, 2<<4 | 15, argp_y0y0w0},                         // vaddps (1449)
-	enc{[4]byte{0x1, 0x58, 0x00, 0x00}, VEX_OP | PREF_F2, AVX, 0<<11 | 766, 2<<4 | 15, argp_yoyomq},                           // vaddsd (1450)
-	enc{[4]byte{0x1, 0x58, 0x00, 0x00}, VEX_OP | PREF_F2, AVX, 1<<11 | 766, 2<<4 | 15, argp_yoyoyo},                           // vaddsd (1451)
-	enc{[4]byte{0x1, 0x58, 0x00, 0x00}, VEX_OP | PREF_F3, AVX, 0<<11 | 767, 2<<4 | 15, argp_yoyomd},                           // vaddss (1452)
-	enc{[4]byte{0x1, 0x58, 0x00, 0x00}, VEX_OP | PREF_F3, AVX, 1<<11 | 767, 2<<4 | 15, argp_yoyoyo},                           // vaddss (1453)
-	enc{[4]byte{0x1, 0xd0, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 768, 2<<4 | 15, argp_y0y0w0},               // vaddsubpd (1454)
-	enc{[4]byte{0x1, 0xd0, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F2, AVX, 0<<11 | 769, 2<<4 | 15, argp_y0y0w0},               // vaddsubps (1455)
-	enc{[4]byte{0x2, 0xde, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 770, 2<<4 | 15, argp_yoyowo},                           // vaesdec (1456)
-	enc{[4]byte{0x2, 0xdf, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 771, 2<<4 | 15, argp_yoyowo},                           // vaesdeclast (1457)
-	enc{[4]byte{0x2, 0xdc, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 772, 2<<4 | 15, argp_yoyowo},                           // vaesenc (1458)
-	enc{[4]byte{0x2, 0xdd, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 773, 2<<4 | 15, argp_yoyowo},                           // vaesenclast (1459)
-	enc{[4]byte{0x2, 0xdb, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 774, 2<<4 | 15, argp_yowo},                             // vaesimc (1460)
-	enc{[4]byte{0x3, 0xdf, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 775, 2<<4 | 15, argp_yowoib},                           // vaeskeygenassist (1461)
-	enc{[4]byte{0x1, 0x55, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 776, 2<<4 | 15, argp_y0y0w0},               // vandnpd (1462)
-	enc{[4]byte{0x1, 0x55, 0x00, 0x00}, VEX_OP | AUTO_VEXL, AVX, 0<<11 | 777, 2<<4 | 15, argp_y0y0w0},                         // vandnps (1463)
-	enc{[4]byte{0x1, 0x54, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 778, 2<<4 | 15, argp_y0y0w0},               // vandpd (1464)
-	enc{[4]byte{0x1, 0x54, 0x00, 0x00}, VEX_OP | AUTO_VEXL, AVX, 0<<11 | 779, 2<<4 | 15, argp_y0y0w0},                         // vandps (1465)
-	enc{[4]byte{0x3, 0xd, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, AVX, 0<<11 | 780, 2<<4 | 15, argp_y0y0w0ib},     // vblendpd (1466)
-	enc{[4]byte{0x3, 0xc, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, AVX, 0<<11 | 781, 2<<4 | 15, argp_y0y0w0ib},     // vblendps (1467)
-	enc{[4]byte{0x3, 0x4b, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 782, 2<<4 | 15, argp_y0y0w0y0},             // vblendvpd (1468)
-	enc{[4]byte{0x3, 0x4a, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 783, 2<<4 | 15, argp_y0y0w0y0},             // vblendvps (1469)
-	enc{[4]byte{0x2, 0x1a, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66, AVX, 0<<11 | 784, 2<<4 | 15, argp_yhmo},                 // vbroadcastf128 (1470)
-	enc{[4]byte{0x2, 0x5a, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66, AVX2, 0<<11 | 785, 2<<4 | 15, argp_yhmo},                // vbroadcasti128 (1471)
-	enc{[4]byte{0x2, 0x19, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66, AVX, 0<<11 | 786, 2<<4 | 15, argp_yhmq},                 // vbroadcastsd (1472)
-	enc{[4]byte{0x2, 0x19, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66, AVX, 1<<11 | 786, 2<<4 | 15, argp_yhyo},                 // vbroadcastsd (1473)
-	enc{[4]byte{0x2, 0x18, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 787, 2<<4 | 15, argp_y0md},                             // vbroadcastss (1474)
-	enc{[4]byte{0x2, 0x18, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 787, 2<<4 | 15, argp_y0yo},                 // vbroadcastss (1475)
-	enc{[4]byte{0x1, 0xc2, 0x10, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 0<<11 | 788, 3<<4 | 15, argp_y0y0w0},                  // vcmpeq_ospd (1476)
-	enc{[4]byte{0x1, 0xc2, 0x10, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 1<<11 | 788, 3<<4 | 15, argp_yoyowo},                  // vcmpeq_ospd (1477)
-	enc{[4]byte{0x1, 0xc2, 0x10, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 789, 3<<4 | 15, argp_y0y0w0},                // vcmpeq_osps (1478)
-	enc{[4]byte{0x1, 0xc2, 0x10, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 790, 3<<4 | 15, argp_yoyomq},                  // vcmpeq_ossd (1479)
-	enc{[4]byte{0x1, 0xc2, 0x10, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 790, 3<<4 | 15, argp_yoyoyo},                  // vcmpeq_ossd (1480)
-	enc{[4]byte{0x1, 0xc2, 0x10, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 791, 3<<4 | 15, argp_yoyomq},                  // vcmpeq_osss (1481)
-	enc{[4]byte{0x1, 0xc2, 0x10, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 791, 3<<4 | 15, argp_yoyoyo},                  // vcmpeq_osss (1482)
-	enc{[4]byte{0x1, 0xc2, 0x8, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, AVX, 0<<11 | 792, 3<<4 | 15, argp_yhyhwh},       // vcmpeq_uqpd (1483)
-	enc{[4]byte{0x1, 0xc2, 0x8, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 1<<11 | 792, 3<<4 | 15, argp_yoyowo},                   // vcmpeq_uqpd (1484)
-	enc{[4]byte{0x1, 0xc2, 0x8, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 793, 3<<4 | 15, argp_y0y0w0},                 // vcmpeq_uqps (1485)
-	enc{[4]byte{0x1, 0xc2, 0x8, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 794, 3<<4 | 15, argp_yoyomq},                   // vcmpeq_uqsd (1486)
-	enc{[4]byte{0x1, 0xc2, 0x8, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 794, 3<<4 | 15, argp_yoyoyo},                   // vcmpeq_uqsd (1487)
-	enc{[4]byte{0x1, 0xc2, 0x8, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 795, 3<<4 | 15, argp_yoyomq},                   // vcmpeq_uqss (1488)
-	enc{[4]byte{0x1, 0xc2, 0x8, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 795, 3<<4 | 15, argp_yoyoyo},                   // vcmpeq_uqss (1489)
-	enc{[4]byte{0x1, 0xc2, 0x18, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, AVX, 0<<11 | 796, 3<<4 | 15, argp_yhyhwh},      // vcmpeq_uspd (1490)
-	enc{[4]byte{0x1, 0xc2, 0x18, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 1<<11 | 796, 3<<4 | 15, argp_yoyowo},                  // vcmpeq_uspd (1491)
-	enc{[4]byte{0x1, 0xc2, 0x18, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 797, 3<<4 | 15, argp_y0y0w0},                // vcmpeq_usps (1492)
-	enc{[4]byte{0x1, 0xc2, 0x18, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 798, 3<<4 | 15, argp_yoyomq},                  // vcmpeq_ussd (1493)
-	enc{[4]byte{0x1, 0xc2, 0x18, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 798, 3<<4 | 15, argp_yoyoyo},                  // vcmpeq_ussd (1494)
-	enc{[4]byte{0x1, 0xc2, 0x18, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 799, 3<<4 | 15, argp_yoyomq},                  // vcmpeq_usss (1495)
-	enc{[4]byte{0x1, 0xc2, 0x18, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 799, 3<<4 | 15, argp_yoyoyo},                  // vcmpeq_usss (1496)
-	enc{[4]byte{0x1, 0xc2, 0x0, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 800, 3<<4 | 15, argp_y0y0w0},       // vcmpeqpd (1497)
-	enc{[4]byte{0x1, 0xc2, 0x0, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 801, 3<<4 | 15, argp_y0y0w0},                 // vcmpeqps (1498)
-	enc{[4]byte{0x1, 0xc2, 0x0, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 802, 3<<4 | 15, argp_yoyomq},                   // vcmpeqsd (1499)
-	enc{[4]byte{0x1, 0xc2, 0x0, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 802, 3<<4 | 15, argp_yoyoyo},                   // vcmpeqsd (1500)
-	enc{[4]byte{0x1, 0xc2, 0x0, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 803, 3<<4 | 15, argp_yoyomq},                   // vcmpeqss (1501)
-	enc{[4]byte{0x1, 0xc2, 0x0, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 803, 3<<4 | 15, argp_yoyoyo},                   // vcmpeqss (1502)
-	enc{[4]byte{0x1, 0xc2, 0xb, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 804, 3<<4 | 15, argp_y0y0w0},       // vcmpfalse_oqpd (1503)
-	enc{[4]byte{0x1, 0xc2, 0xb, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 805, 3<<4 | 15, argp_y0y0w0},                 // vcmpfalse_oqps (1504)
-	enc{[4]byte{0x1, 0xc2, 0xb, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 806, 3<<4 | 15, argp_yoyomq},                   // vcmpfalse_oqsd (1505)
-	enc{[4]byte{0x1, 0xc2, 0xb, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 806, 3<<4 | 15, argp_yoyoyo},                   // vcmpfalse_oqsd (1506)
-	enc{[4]byte{0x1, 0xc2, 0xb, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 807, 3<<4 | 15, argp_yoyomq},                   // vcmpfalse_oqss (1507)
-	enc{[4]byte{0x1, 0xc2, 0xb, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 807, 3<<4 | 15, argp_yoyoyo},                   // vcmpfalse_oqss (1508)
-	enc{[4]byte{0x1, 0xc2, 0x1b, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 808, 3<<4 | 15, argp_y0y0w0},      // vcmpfalse_ospd (1509)
-	enc{[4]byte{0x1, 0xc2, 0x1b, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 809, 3<<4 | 15, argp_y0y0w0},                // vcmpfalse_osps (1510)
-	enc{[4]byte{0x1, 0xc2, 0x1b, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 810, 3<<4 | 15, argp_yoyomq},                  // vcmpfalse_ossd (1511)
-	enc{[4]byte{0x1, 0xc2, 0x1b, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 810, 3<<4 | 15, argp_yoyoyo},                  // vcmpfalse_ossd (1512)
-	enc{[4]byte{0x1, 0xc2, 0x1b, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 811, 3<<4 | 15, argp_yoyomq},                  // vcmpfalse_osss (1513)
-	enc{[4]byte{0x1, 0xc2, 0x1b, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 811, 3<<4 | 15, argp_yoyoyo},                  // vcmpfalse_osss (1514)
-	enc{[4]byte{0x1, 0xc2, 0xb, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, AVX, 0<<11 | 812, 3<<4 | 15, argp_yhyhwh},       // vcmpfalsepd (1515)
-	enc{[4]byte{0x1, 0xc2, 0xb, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 1<<11 | 812, 3<<4 | 15, argp_yoyowo},                   // vcmpfalsepd (1516)
-	enc{[4]byte{0x1, 0xc2, 0xb, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 813, 3<<4 | 15, argp_y0y0w0},                 // vcmpfalseps (1517)
-	enc{[4]byte{0x1, 0xc2, 0xb, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 814, 3<<4 | 15, argp_yoyomq},                   // vcmpfalsesd (1518)
-	enc{[4]byte{0x1, 0xc2, 0xb, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 814, 3<<4 | 15, argp_yoyoyo},                   // vcmpfalsesd (1519)
-	enc{[4]byte{0x1, 0xc2, 0xb, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 815, 3<<4 | 15, argp_yoyomq},                   // vcmpfalsess (1520)
-	enc{[4]byte{0x1, 0xc2, 0xb, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 815, 3<<4 | 15, argp_yoyoyo},                   // vcmpfalsess (1521)
-	enc{[4]byte{0x1, 0xc2, 0x1d, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, AVX, 0<<11 | 816, 3<<4 | 15, argp_yhyhwh},      // vcmpge_oqpd (1522)
-	enc{[4]byte{0x1, 0xc2, 0x1d, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 1<<11 | 816, 3<<4 | 15, argp_yoyowo},                  // vcmpge_oqpd (1523)
-	enc{[4]byte{0x1, 0xc2, 0x1d, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 817, 3<<4 | 15, argp_y0y0w0},                // vcmpge_oqps (1524)
-	enc{[4]byte{0x1, 0xc2, 0x1d, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 818, 3<<4 | 15, argp_yoyomq},                  // vcmpge_oqsd (1525)
-	enc{[4]byte{0x1, 0xc2, 0x1d, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 818, 3<<4 | 15, argp_yoyoyo},                  // vcmpge_oqsd (1526)
-	enc{[4]byte{0x1, 0xc2, 0x1d, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 819, 3<<4 | 15, argp_yoyomq},                  // vcmpge_oqss (1527)
-	enc{[4]byte{0x1, 0xc2, 0x1d, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 819, 3<<4 | 15, argp_yoyoyo},                  // vcmpge_oqss (1528)
-	enc{[4]byte{0x1, 0xc2, 0xd, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 820, 3<<4 | 15, argp_y0y0w0},       // vcmpge_ospd (1529)
-	enc{[4]byte{0x1, 0xc2, 0xd, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 821, 3<<4 | 15, argp_y0y0w0},                 // vcmpge_osps (1530)
-	enc{[4]byte{0x1, 0xc2, 0xd, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 822, 3<<4 | 15, argp_yoyomq},                   // vcmpge_ossd (1531)
-	enc{[4]byte{0x1, 0xc2, 0xd, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 822, 3<<4 | 15, argp_yoyoyo},                   // vcmpge_ossd (1532)
-	enc{[4]byte{0x1, 0xc2, 0xd, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 823, 3<<4 | 15, argp_yoyomq},                   // vcmpge_osss (1533)
-	enc{[4]byte{0x1, 0xc2, 0xd, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 823, 3<<4 | 15, argp_yoyoyo},                   // vcmpge_osss (1534)
-	enc{[4]byte{0x1, 0xc2, 0xd, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 824, 3<<4 | 15, argp_y0y0w0},       // vcmpgepd (1535)
-	enc{[4]byte{0x1, 0xc2, 0xd, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 825, 3<<4 | 15, argp_y0y0w0},                 // vcmpgeps (1536)
-	enc{[4]byte{0x1, 0xc2, 0xd, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 826, 3<<4 | 15, argp_yoyomq},                   // vcmpgesd (1537)
-	enc{[4]byte{0x1, 0xc2, 0xd, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 826, 3<<4 | 15, argp_yoyoyo},                   // vcmpgesd (1538)
-	enc{[4]byte{0x1, 0xc2, 0xd, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 827, 3<<4 | 15, argp_yoyomq},                   // vcmpgess (1539)
-	enc{[4]byte{0x1, 0xc2, 0xd, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 827, 3<<4 | 15, argp_yoyoyo},                   // vcmpgess (1540)
-	enc{[4]byte{0x1, 0xc2, 0x1e, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 828, 3<<4 | 15, argp_y0y0w0},      // vcmpgt_oqpd (1541)
-	enc{[4]byte{0x1, 0xc2, 0x1e, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 829, 3<<4 | 15, argp_y0y0w0},                // vcmpgt_oqps (1542)
-	enc{[4]byte{0x1, 0xc2, 0x1e, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 830, 3<<4 | 15, argp_yoyomq},                  // vcmpgt_oqsd (1543)
-	enc{[4]byte{0x1, 0xc2, 0x1e, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 830, 3<<4 | 15, argp_yoyoyo},                  // vcmpgt_oqsd (1544)
-	enc{[4]byte{0x1, 0xc2, 0x1e, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 831, 3<<4 | 15, argp_yoyomq},                  // vcmpgt_oqss (1545)
-	enc{[4]byte{0x1, 0xc2, 0x1e, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 831, 3<<4 | 15, argp_yoyoyo},                  // vcmpgt_oqss (1546)
-	enc{[4]byte{0x1, 0xc2, 0xe, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 832, 3<<4 | 15, argp_y0y0w0},       // vcmpgt_ospd (1547)
-	enc{[4]byte{0x1, 0xc2, 0xe, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 833, 3<<4 | 15, argp_y0y0w0},                 // vcmpgt_osps (1548)
-	enc{[4]byte{0x1, 0xc2, 0xe, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 834, 3<<4 | 15, argp_yoyomq},                   // vcmpgt_ossd (1549)
-	enc{[4]byte{0x1, 0xc2, 0xe, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 834, 3<<4 | 15, argp_yoyoyo},                   // vcmpgt_ossd (1550)
-	enc{[4]byte{0x1, 0xc2, 0xe, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 835, 3<<4 | 15, argp_yoyomq},                   // vcmpgt_osss (1551)
-	enc{[4]byte{0x1, 0xc2, 0xe, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 835, 3<<4 | 15, argp_yoyoyo},                   // vcmpgt_osss (1552)
-	enc{[4]byte{0x1, 0xc2, 0xe, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, AVX, 0<<11 | 836, 3<<4 | 15, argp_yhyhwh},       // vcmpgtpd (1553)
-	enc{[4]byte{0x1, 0xc2, 0xe, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 1<<11 | 836, 3<<4 | 15, argp_yoyowo},                   // vcmpgtpd (1554)
-	enc{[4]byte{0x1, 0xc2, 0xe, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 837, 3<<4 | 15, argp_y0y0w0},                 // vcmpgtps (1555)
-	enc{[4]byte{0x1, 0xc2, 0xe, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 838, 3<<4 | 15, argp_yoyomq},                   // vcmpgtsd (1556)
-	enc{[4]byte{0x1, 0xc2, 0xe, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 838, 3<<4 | 15, argp_yoyoyo},                   // vcmpgtsd (1557)
-	enc{[4]byte{0x1, 0xc2, 0xe, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 839, 3<<4 | 15, argp_yoyomq},                   // vcmpgtss (1558)
-	enc{[4]byte{0x1, 0xc2, 0xe, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 839, 3<<4 | 15, argp_yoyoyo},                   // vcmpgtss (1559)
-	enc{[4]byte{0x1, 0xc2, 0x12, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, AVX, 0<<11 | 840, 3<<4 | 15, argp_yhyhwh},      // vcmple_oqpd (1560)
-	enc{[4]byte{0x1, 0xc2, 0x12, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 1<<11 | 840, 3<<4 | 15, argp_yoyowo},                  // vcmple_oqpd (1561)
-	enc{[4]byte{0x1, 0xc2, 0x12, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 841, 3<<4 | 15, argp_y0y0w0},                // vcmple_oqps (1562)
-	enc{[4]byte{0x1, 0xc2, 0x12, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 842, 3<<4 | 15, argp_yoyomq},                  // vcmple_oqsd (1563)
-	enc{[4]byte{0x1, 0xc2, 0x12, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 842, 3<<4 | 15, argp_yoyoyo},                  // vcmple_oqsd (1564)
-	enc{[4]byte{0x1, 0xc2, 0x12, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 843, 3<<4 | 15, argp_yoyomq},                  // vcmple_oqss (1565)
-	enc{[4]byte{0x1, 0xc2, 0x12, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 843, 3<<4 | 15, argp_yoyoyo},                  // vcmple_oqss (1566)
-	enc{[4]byte{0x1, 0xc2, 0x2, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 844, 3<<4 | 15, argp_y0y0w0},       // vcmple_ospd (1567)
-	enc{[4]byte{0x1, 0xc2, 0x2, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 845, 3<<4 | 15, argp_y0y0w0},                 // vcmple_osps (1568)
-	enc{[4]byte{0x1, 0xc2, 0x2, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 846, 3<<4 | 15, argp_yoyomq},                   // vcmple_ossd (1569)
-	enc{[4]byte{0x1, 0xc2, 0x2, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 846, 3<<4 | 15, argp_yoyoyo},                   // vcmple_ossd (1570)
-	enc{[4]byte{0x1, 0xc2, 0x2, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 847, 3<<4 | 15, argp_yoyomq},                   // vcmple_osss (1571)
-	enc{[4]byte{0x1, 0xc2, 0x2, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 847, 3<<4 | 15, argp_yoyoyo},                   // vcmple_osss (1572)
-	enc{[4]byte{0x1, 0xc2, 0x2, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, AVX, 0<<11 | 848, 3<<4 | 15, argp_yhyhwh},       // vcmplepd (1573)
-	enc{[4]byte{0x1, 0xc2, 0x2, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 1<<11 | 848, 3<<4 | 15, argp_yoyowo},                   // vcmplepd (1574)
-	enc{[4]byte{0x1, 0xc2, 0x2, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 849, 3<<4 | 15, argp_y0y0w0},                 // vcmpleps (1575)
-	enc{[4]byte{0x1, 0xc2, 0x2, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 850, 3<<4 | 15, argp_yoyomq},                   // vcmplesd (1576)
-	enc{[4]byte{0x1, 0xc2, 0x2, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 850, 3<<4 | 15, argp_yoyoyo},                   // vcmplesd (1577)
-	enc{[4]byte{0x1, 0xc2, 0x2, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 851, 3<<4 | 15, argp_yoyomq},                   // vcmpless (1578)
-	enc{[4]byte{0x1, 0xc2, 0x2, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 851, 3<<4 | 15, argp_yoyoyo},                   // vcmpless (1579)
-	enc{[4]byte{0x1, 0xc2, 0x11, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 852, 3<<4 | 15, argp_y0y0w0},      // vcmplt_oqpd (1580)
-	enc{[4]byte{0x1, 0xc2, 0x11, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 853, 3<<4 | 15, argp_y0y0w0},                // vcmplt_oqps (1581)
-	enc{[4]byte{0x1, 0xc2, 0x11, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 854, 3<<4 | 15, argp_yoyomq},                  // vcmplt_oqsd (1582)
-	enc{[4]byte{0x1, 0xc2, 0x11, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 854, 3<<4 | 15, argp_yoyoyo},                  // vcmplt_oqsd (1583)
-	enc{[4]byte{0x1, 0xc2, 0x11, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 855, 3<<4 | 15, argp_yoyomq},                  // vcmplt_oqss (1584)
-	enc{[4]byte{0x1, 0xc2, 0x11, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 855, 3<<4 | 15, argp_yoyoyo},                  // vcmplt_oqss (1585)
-	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, AVX, 0<<11 | 856, 3<<4 | 15, argp_yhyhwh},       // vcmplt_ospd (1586)
-	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 1<<11 | 856, 3<<4 | 15, argp_yoyowo},                   // vcmplt_ospd (1587)
-	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 857, 3<<4 | 15, argp_y0y0w0},                 // vcmplt_osps (1588)
-	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 858, 3<<4 | 15, argp_yoyomq},                   // vcmplt_ossd (1589)
-	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 858, 3<<4 | 15, argp_yoyoyo},                   // vcmplt_ossd (1590)
-	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 859, 3<<4 | 15, argp_yoyomq},                   // vcmplt_osss (1591)
-	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 859, 3<<4 | 15, argp_yoyoyo},                   // vcmplt_osss (1592)
-	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, AVX, 0<<11 | 860, 3<<4 | 15, argp_yhyhwh},       // vcmpltpd (1593)
-	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 1<<11 | 860, 3<<4 | 15, argp_yoyowo},                   // vcmpltpd (1594)
-	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 861, 3<<4 | 15, argp_y0y0w0},                 // vcmpltps (1595)
-	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 862, 3<<4 | 15, argp_yoyomq},                   // vcmpltsd (1596)
-	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 862, 3<<4 | 15, argp_yoyoyo},                   // vcmpltsd (1597)
-	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 863, 3<<4 | 15, argp_yoyomq},                   // vcmpltss (1598)
-	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 863, 3<<4 | 15, argp_yoyoyo},                   // vcmpltss (1599)
-	enc{[4]byte{0x1, 0xc2, 0xc, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, AVX, 0<<11 | 864, 3<<4 | 15, argp_yhyhwh},       // vcmpneq_oqpd (1600)
-	enc{[4]byte{0x1, 0xc2, 0xc, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 1<<11 | 864, 3<<4 | 15, argp_yoyowo},                   // vcmpneq_oqpd (1601)
-	enc{[4]byte{0x1, 0xc2, 0xc, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 865, 3<<4 | 15, argp_y0y0w0},                 // vcmpneq_oqps (1602)
-	enc{[4]byte{0x1, 0xc2, 0xc, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 866, 3<<4 | 15, argp_yoyomq},                   // vcmpneq_oqsd (1603)
-	enc{[4]byte{0x1, 0xc2, 0xc, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 866, 3<<4 | 15, argp_yoyoyo},                   // vcmpneq_oqsd (1604)
-	enc{[4]byte{0x1, 0xc2, 0xc, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 867, 3<<4 | 15, argp_yoyomq},                   // vcmpneq_oqss (1605)
-	enc{[4]byte{0x1, 0xc2, 0xc, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 867, 3<<4 | 15, argp_yoyoyo},                   // vcmpneq_oqss (1606)
-	enc{[4]byte{0x1, 0xc2, 0x1c, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, AVX, 0<<11 | 868, 3<<4 | 15, argp_yhyhwh},      // vcmpneq_ospd (1607)
-	enc{[4]byte{0x1, 0xc2, 0x1c, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 1<<11 | 868, 3<<4 | 15, argp_yoyowo},                  // vcmpneq_ospd (1608)
-	enc{[4]byte{0x1, 0xc2, 0x1c, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 869, 3<<4 | 15, argp_y0y0w0},                // vcmpneq_osps (1609)
-	enc{[4]byte{0x1, 0xc2, 0x1c, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 870, 3<<4 | 15, argp_yoyomq},                  // vcmpneq_ossd (1610)
-	enc{[4]byte{0x1, 0xc2, 0x1c, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 870, 3<<4 | 15, argp_yoyoyo},                  // vcmpneq_ossd (1611)
-	enc{[4]byte{0x1, 0xc2, 0x1c, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 871, 3<<4 | 15, argp_yoyomq},                  // vcmpneq_osss (1612)
-	enc{[4]byte{0x1, 0xc2, 0x1c, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 871, 3<<4 | 15, argp_yoyoyo},                  // vcmpneq_osss (1613)
-	enc{[4]byte{0x1, 0xc2, 0x4, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 872, 3<<4 | 15, argp_y0y0w0},       // vcmpneq_uqpd (1614)
-	enc{[4]byte{0x1, 0xc2, 0x4, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 873, 3<<4 | 15, argp_y0y0w0},                 // vcmpneq_uqps (1615)
-	enc{[4]byte{0x1, 0xc2, 0x4, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 874, 3<<4 | 15, argp_yoyomq},                   // vcmpneq_uqsd (1616)
-	enc{[4]byte{0x1, 0xc2, 0x4, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 874, 3<<4 | 15, argp_yoyoyo},                   // vcmpneq_uqsd (1617)
-	enc{[4]byte{0x1, 0xc2, 0x4, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 875, 3<<4 | 15, argp_yoyomq},                   // vcmpneq_uqss (1618)
-	enc{[4]byte{0x1, 0xc2, 0x4, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 875, 3<<4 | 15, argp_yoyoyo},                   // vcmpneq_uqss (1619)
-	enc{[4]byte{0x1, 0xc2, 0x14, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, AVX, 0<<11 | 876, 3<<4 | 15, argp_yhyhwh},      // vcmpneq_uspd (1620)
-	enc{[4]byte{0x1, 0xc2, 0x14, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 1<<11 | 876, 3<<4 | 15, argp_yoyowo},                  // vcmpneq_uspd (1621)
-	enc{[4]byte{0x1, 0xc2, 0x14, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 877, 3<<4 | 15, argp_y0y0w0},                // vcmpneq_usps (1622)
-	enc{[4]byte{0x1, 0xc2, 0x14, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 878, 3<<4 | 15, argp_yoyomq},                  // vcmpneq_ussd (1623)
-	enc{[4]byte{0x1, 0xc2, 0x14, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 878, 3<<4 | 15, argp_yoyoyo},                  // vcmpneq_ussd (1624)
-	enc{[4]byte{0x1, 0xc2, 0x14, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 879, 3<<4 | 15, argp_yoyomq},                  // vcmpneq_usss (1625)
-	enc{[4]byte{0x1, 0xc2, 0x14, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 879, 3<<4 | 15, argp_yoyoyo},                  // vcmpneq_usss (1626)
-	enc{[4]byte{0x1, 0xc2, 0x4, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, AVX, 0<<11 | 880, 3<<4 | 15, argp_yhyhwh},       // vcmpneqpd (1627)
-	enc{[4]byte{0x1, 0xc2, 0x4, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 1<<11 | 880, 3<<4 | 15, argp_yoyowo},                   // vcmpneqpd (1628)
-	enc{[4]byte{0x1, 0xc2, 0x4, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 881, 3<<4 | 15, argp_y0y0w0},                 // vcmpneqps (1629)
-	enc{[4]byte{0x1, 0xc2, 0x4, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 882, 3<<4 | 15, argp_yoyomq},                   // vcmpneqsd (1630)
-	enc{[4]byte{0x1, 0xc2, 0x4, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 882, 3<<4 | 15, argp_yoyoyo},                   // vcmpneqsd (1631)
-	enc{[4]byte{0x1, 0xc2, 0x4, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 883, 3<<4 | 15, argp_yoyomq},                   // vcmpneqss (1632)
-	enc{[4]byte{0x1, 0xc2, 0x4, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 883, 3<<4 | 15, argp_yoyoyo},                   // vcmpneqss (1633)
-	enc{[4]byte{0x1, 0xc2, 0x19, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 884, 3<<4 | 15, argp_y0y0w0},      // vcmpnge_uqpd (1634)
-	enc{[4]byte{0x1, 0xc2, 0x19, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 885, 3<<4 | 15, argp_y0y0w0},                // vcmpnge_uqps (1635)
-	enc{[4]byte{0x1, 0xc2, 0x19, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 886, 3<<4 | 15, argp_yoyomq},                  // vcmpnge_uqsd (1636)
-	enc{[4]byte{0x1, 0xc2, 0x19, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 886, 3<<4 | 15, argp_yoyoyo},                  // vcmpnge_uqsd (1637)
-	enc{[4]byte{0x1, 0xc2, 0x19, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 887, 3<<4 | 15, argp_yoyomq},                  // vcmpnge_uqss (1638)
-	enc{[4]byte{0x1, 0xc2, 0x19, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 887, 3<<4 | 15, argp_yoyoyo},                  // vcmpnge_uqss (1639)
-	enc{[4]byte{0x1, 0xc2, 0x9, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 888, 3<<4 | 15, argp_y0y0w0},       // vcmpnge_uspd (1640)
-	enc{[4]byte{0x1, 0xc2, 0x9, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 889, 3<<4 | 15, argp_y0y0w0},                 // vcmpnge_usps (1641)
-	enc{[4]byte{0x1, 0xc2, 0x9, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 890, 3<<4 | 15, argp_yoyomq},                   // vcmpnge_ussd (1642)
-	enc{[4]byte{0x1, 0xc2, 0x9, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 890, 3<<4 | 15, argp_yoyoyo},                   // vcmpnge_ussd (1643)
-	enc{[4]byte{0x1, 0xc2, 0x9, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 891, 3<<4 | 15, argp_yoyomq},                   // vcmpnge_usss (1644)
-	enc{[4]byte{0x1, 0xc2, 0x9, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 891, 3<<4 | 15, argp_yoyoyo},                   // vcmpnge_usss (1645)
-	enc{[4]byte{0x1, 0xc2, 0x9, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, AVX, 0<<11 | 892, 3<<4 | 15, argp_yhyhwh},       // vcmpngepd (1646)
-	enc{[4]byte{0x1, 0xc2, 0x9, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 1<<11 | 892, 3<<4 | 15, argp_yoyowo},                   // vcmpngepd (1647)
-	enc{[4]byte{0x1, 0xc2, 0x9, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 893, 3<<4 | 15, argp_y0y0w0},                 // vcmpngeps (1648)
-	enc{[4]byte{0x1, 0xc2, 0x9, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 894, 3<<4 | 15, argp_yoyomq},                   // vcmpngesd (1649)
-	enc{[4]byte{0x1, 0xc2, 0x9, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 894, 3<<4 | 15, argp_yoyoyo},                   // vcmpngesd (1650)
-	enc{[4]byte{0x1, 0xc2, 0x9, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 895, 3<<4 | 15, argp_yoyomq},                   // vcmpngess (1651)
-	enc{[4]byte{0x1, 0xc2, 0x9, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 895, 3<<4 | 15, argp_yoyoyo},                   // vcmpngess (1652)
-	enc{[4]byte{0x1, 0xc2, 0x1a, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 896, 3<<4 | 15, argp_y0y0w0},      // vcmpngt_uqpd (1653)
-	enc{[4]byte{0x1, 0xc2, 0x1a, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 897, 3<<4 | 15, argp_y0y0w0},                // vcmpngt_uqps (1654)
-	enc{[4]byte{0x1, 0xc2, 0x1a, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 898, 3<<4 | 15, argp_yoyomq},                  // vcmpngt_uqsd (1655)
-	enc{[4]byte{0x1, 0xc2, 0x1a, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 898, 3<<4 | 15, argp_yoyoyo},                  // vcmpngt_uqsd (1656)
-	enc{[4]byte{0x1, 0xc2, 0x1a, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 899, 3<<4 | 15, argp_yoyomq},                  // vcmpngt_uqss (1657)
-	enc{[4]byte{0x1, 0xc2, 0x1a, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 899, 3<<4 | 15, argp_yoyoyo},                  // vcmpngt_uqss (1658)
-	enc{[4]byte{0x1, 0xc2, 0xa, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 900, 3<<4 | 15, argp_y0y0w0},       // vcmpngt_uspd (1659)
-	enc{[4]byte{0x1, 0xc2, 0xa, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 901, 3<<4 | 15, argp_y0y0w0},                 // vcmpngt_usps (1660)
-	enc{[4]byte{0x1, 0xc2, 0xa, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 902, 3<<4 | 15, argp_yoyomq},                   // vcmpngt_ussd (1661)
-	enc{[4]byte{0x1, 0xc2, 0xa, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 902, 3<<4 | 15, argp_yoyoyo},                   // vcmpngt_ussd (1662)
-	enc{[4]byte{0x1, 0xc2, 0xa, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 903, 3<<4 | 15, argp_yoyomq},                   // vcmpngt_usss (1663)
-	enc{[4]byte{0x1, 0xc2, 0xa, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 903, 3<<4 | 15, argp_yoyoyo},                   // vcmpngt_usss (1664)
-	enc{[4]byte{0x1, 0xc2, 0xa, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 904, 3<<4 | 15, argp_y0y0w0},       // vcmpngtpd (1665)
-	enc{[4]byte{0x1, 0xc2, 0xa, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 905, 3<<4 | 15, argp_y0y0w0},                 // vcmpngtps (1666)
-	enc{[4]byte{0x1, 0xc2, 0xa, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 906, 3<<4 | 15, argp_yoyomq},                   // vcmpngtsd (1667)
-	enc{[4]byte{0x1, 0xc2, 0xa, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 906, 3<<4 | 15, argp_yoyoyo},                   // vcmpngtsd (1668)
-	enc{[4]byte{0x1, 0xc2, 0xa, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 907, 3<<4 | 15, argp_yoyomq},                   // vcmpngtss (1669)
-	enc{[4]byte{0x1, 0xc2, 0xa, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 907, 3<<4 | 15, argp_yoyoyo},                   // vcmpngtss (1670)
-	enc{[4]byte{0x1, 0xc2, 0x16, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 908, 3<<4 | 15, argp_y0y0w0},      // vcmpnle_uqpd (1671)
-	enc{[4]byte{0x1, 0xc2, 0x16, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 909, 3<<4 | 15, argp_y0y0w0},                // vcmpnle_uqps (1672)
-	enc{[4]byte{0x1, 0xc2, 0x16, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 910, 3<<4 | 15, argp_yoyomq},                  // vcmpnle_uqsd (1673)
-	enc{[4]byte{0x1, 0xc2, 0x16, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 910, 3<<4 | 15, argp_yoyoyo},                  // vcmpnle_uqsd (1674)
-	enc{[4]byte{0x1, 0xc2, 0x16, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 911, 3<<4 | 15, argp_yoyomq},                  // vcmpnle_uqss (1675)
-	enc{[4]byte{0x1, 0xc2, 0x16, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 911, 3<<4 | 15, argp_yoyoyo},                  // vcmpnle_uqss (1676)
-	enc{[4]byte{0x1, 0xc2, 0x6, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, AVX, 0<<11 | 912, 3<<4 | 15, argp_yhyhwh},       // vcmpnle_uspd (1677)
-	enc{[4]byte{0x1, 0xc2, 0x6, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 1<<11 | 912, 3<<4 | 15, argp_yoyowo},                   // vcmpnle_uspd (1678)
-	enc{[4]byte{0x1, 0xc2, 0x6, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 913, 3<<4 | 15, argp_y0y0w0},                 // vcmpnle_usps (1679)
-	enc{[4]byte{0x1, 0xc2, 0x6, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 914, 3<<4 | 15, argp_yoyomq},                   // vcmpnle_ussd (1680)
-	enc{[4]byte{0x1, 0xc2, 0x6, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 914, 3<<4 | 15, argp_yoyoyo},                   // vcmpnle_ussd (1681)
-	enc{[4]byte{0x1, 0xc2, 0x6, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 915, 3<<4 | 15, argp_yoyomq},                   // vcmpnle_usss (1682)
-	enc{[4]byte{0x1, 0xc2, 0x6, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 915, 3<<4 | 15, argp_yoyoyo},                   // vcmpnle_usss (1683)
-	enc{[4]byte{0x1, 0xc2, 0x6, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 916, 3<<4 | 15, argp_y0y0w0},       // vcmpnlepd (1684)
-	enc{[4]byte{0x1, 0xc2, 0x6, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 917, 3<<4 | 15, argp_y0y0w0},                 // vcmpnleps (1685)
-	enc{[4]byte{0x1, 0xc2, 0x6, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 918, 3<<4 | 15, argp_yoyomq},                   // vcmpnlesd (1686)
-	enc{[4]byte{0x1, 0xc2, 0x6, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 918, 3<<4 | 15, argp_yoyoyo},                   // vcmpnlesd (1687)
-	enc{[4]byte{0x1, 0xc2, 0x6, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 919, 3<<4 | 15, argp_yoyomq},                   // vcmpnless (1688)
-	enc{[4]byte{0x1, 0xc2, 0x6, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 919, 3<<4 | 15, argp_yoyoyo},                   // vcmpnless (1689)
-	enc{[4]byte{0x1, 0xc2, 0x15, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, AVX, 0<<11 | 920, 3<<4 | 15, argp_yhyhwh},      // vcmpnlt_uqpd (1690)
-	enc{[4]byte{0x1, 0xc2, 0x15, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 1<<11 | 920, 3<<4 | 15, argp_yoyowo},                  // vcmpnlt_uqpd (1691)
-	enc{[4]byte{0x1, 0xc2, 0x15, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 921, 3<<4 | 15, argp_y0y0w0},                // vcmpnlt_uqps (1692)
-	enc{[4]byte{0x1, 0xc2, 0x15, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 922, 3<<4 | 15, argp_yoyomq},                  // vcmpnlt_uqsd (1693)
-	enc{[4]byte{0x1, 0xc2, 0x15, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 922, 3<<4 | 15, argp_yoyoyo},                  // vcmpnlt_uqsd (1694)
-	enc{[4]byte{0x1, 0xc2, 0x15, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 923, 3<<4 | 15, argp_yoyomq},                  // vcmpnlt_uqss (1695)
-	enc{[4]byte{0x1, 0xc2, 0x15, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 923, 3<<4 | 15, argp_yoyoyo},                  // vcmpnlt_uqss (1696)
-	enc{[4]byte{0x1, 0xc2, 0x5, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 924, 3<<4 | 15, argp_y0y0w0},       // vcmpnlt_uspd (1697)
-	enc{[4]byte{0x1, 0xc2, 0x5, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 925, 3<<4 | 15, argp_y0y0w0},                 // vcmpnlt_usps (1698)
-	enc{[4]byte{0x1, 0xc2, 0x5, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 926, 3<<4 | 15, argp_yoyomq},                   // vcmpnlt_ussd (1699)
-	enc{[4]byte{0x1, 0xc2, 0x5, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 926, 3<<4 | 15, argp_yoyoyo},                   // vcmpnlt_ussd (1700)
-	enc{[4]byte{0x1, 0xc2, 0x5, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 927, 3<<4 | 15, argp_yoyomq},                   // vcmpnlt_usss (1701)
-	enc{[4]byte{0x1, 0xc2, 0x5, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 927, 3<<4 | 15, argp_yoyoyo},                   // vcmpnlt_usss (1702)
-	enc{[4]byte{0x1, 0xc2, 0x5, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, AVX, 0<<11 | 928, 3<<4 | 15, argp_yhyhwh},       // vcmpnltpd (1703)
-	enc{[4]byte{0x1, 0xc2, 0x5, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 1<<11 | 928, 3<<4 | 15, argp_yoyowo},                   // vcmpnltpd (1704)
-	enc{[4]byte{0x1, 0xc2, 0x5, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 929, 3<<4 | 15, argp_y0y0w0},                 // vcmpnltps (1705)
-	enc{[4]byte{0x1, 0xc2, 0x5, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 930, 3<<4 | 15, argp_yoyomq},                   // vcmpnltsd (1706)
-	enc{[4]byte{0x1, 0xc2, 0x5, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 930, 3<<4 | 15, argp_yoyoyo},                   // vcmpnltsd (1707)
-	enc{[4]byte{0x1, 0xc2, 0x5, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 931, 3<<4 | 15, argp_yoyomq},                   // vcmpnltss (1708)
-	enc{[4]byte{0x1, 0xc2, 0x5, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 931, 3<<4 | 15, argp_yoyoyo},                   // vcmpnltss (1709)
-	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, AVX, 0<<11 | 932, 3<<4 | 15, argp_yhyhwh},       // vcmpord_qpd (1710)
-	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 1<<11 | 932, 3<<4 | 15, argp_yoyowo},                   // vcmpord_qpd (1711)
-	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 933, 3<<4 | 15, argp_y0y0w0},                 // vcmpord_qps (1712)
-	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 934, 3<<4 | 15, argp_yoyomq},                   // vcmpord_qsd (1713)
-	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 934, 3<<4 | 15, argp_yoyoyo},                   // vcmpord_qsd (1714)
-	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 935, 3<<4 | 15, argp_yoyomq},                   // vcmpord_qss (1715)
-	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 935, 3<<4 | 15, argp_yoyoyo},                   // vcmpord_qss (1716)
-	enc{[4]byte{0x1, 0xc2, 0x17, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, AVX, 0<<11 | 936, 3<<4 | 15, argp_yhyhwh},      // vcmpord_spd (1717)
-	enc{[4]byte{0x1, 0xc2, 0x17, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 1<<11 | 936, 3<<4 | 15, argp_yoyowo},                  // vcmpord_spd (1718)
-	enc{[4]byte{0x1, 0xc2, 0x17, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 937, 3<<4 | 15, argp_y0y0w0},                // vcmpord_sps (1719)
-	enc{[4]byte{0x1, 0xc2, 0x17, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 938, 3<<4 | 15, argp_yoyomq},                  // vcmpord_ssd (1720)
-	enc{[4]byte{0x1, 0xc2, 0x17, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 938, 3<<4 | 15, argp_yoyoyo},                  // vcmpord_ssd (1721)
-	enc{[4]byte{0x1, 0xc2, 0x17, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 939, 3<<4 | 15, argp_yoyomq},                  // vcmpord_sss (1722)
-	enc{[4]byte{0x1, 0xc2, 0x17, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 939, 3<<4 | 15, argp_yoyoyo},                  // vcmpord_sss (1723)
-	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, AVX, 0<<11 | 940, 3<<4 | 15, argp_yhyhwh},       // vcmpordpd (1724)
-	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 1<<11 | 940, 3<<4 | 15, argp_yoyowo},                   // vcmpordpd (1725)
-	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 941, 3<<4 | 15, argp_y0y0w0},                 // vcmpordps (1726)
-	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 942, 3<<4 | 15, argp_yoyomq},                   // vcmpordsd (1727)
-	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 942, 3<<4 | 15, argp_yoyoyo},                   // vcmpordsd (1728)
-	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 943, 3<<4 | 15, argp_yoyomq},                   // vcmpordss (1729)
-	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 943, 3<<4 | 15, argp_yoyoyo},                   // vcmpordss (1730)
-	enc{[4]byte{0x1, 0xc2, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, AVX, 0<<11 | 944, 2<<4 | 15, argp_y0y0w0ib},    // vcmppd (1731)
-	enc{[4]byte{0x1, 0xc2, 0x00, 0x00}, VEX_OP | AUTO_VEXL | ENC_MR, AVX, 0<<11 | 945, 2<<4 | 15, argp_y0y0w0ib},              // vcmpps (1732)
-	enc{[4]byte{0x1, 0xc2, 0x00, 0x00}, VEX_OP | PREF_F2, AVX, 0<<11 | 946, 2<<4 | 15, argp_yoyomqib},                         // vcmpsd (1733)
-	enc{[4]byte{0x1, 0xc2, 0x00, 0x00}, VEX_OP | PREF_F2, AVX, 1<<11 | 946, 2<<4 | 15, argp_yoyoyoib},                         // vcmpsd (1734)
-	enc{[4]byte{0x1, 0xc2, 0x00, 0x00}, VEX_OP | PREF_F3, AVX, 0<<11 | 947, 2<<4 | 15, argp_yoyomqib},                         // vcmpss (1735)
-	enc{[4]byte{0x1, 0xc2, 0x00, 0x00}, VEX_OP | PREF_F3, AVX, 1<<11 | 947, 2<<4 | 15, argp_yoyoyoib},                         // vcmpss (1736)
-	enc{[4]byte{0x1, 0xc2, 0xf, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, AVX, 0<<11 | 948, 3<<4 | 15, argp_yhyhwh},       // vcmptrue_uqpd (1737)
-	enc{[4]byte{0x1, 0xc2, 0xf, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 1<<11 | 948, 3<<4 | 15, argp_yoyowo},                   // vcmptrue_uqpd (1738)
-	enc{[4]byte{0x1, 0xc2, 0xf, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 949, 3<<4 | 15, argp_y0y0w0},                 // vcmptrue_uqps (1739)
-	enc{[4]byte{0x1, 0xc2, 0xf, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 950, 3<<4 | 15, argp_yoyomq},                   // vcmptrue_uqsd (1740)
-	enc{[4]byte{0x1, 0xc2, 0xf, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 950, 3<<4 | 15, argp_yoyoyo},                   // vcmptrue_uqsd (1741)
-	enc{[4]byte{0x1, 0xc2, 0xf, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 951, 3<<4 | 15, argp_yoyomq},                   // vcmptrue_uqss (1742)
-	enc{[4]byte{0x1, 0xc2, 0xf, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 951, 3<<4 | 15, argp_yoyoyo},                   // vcmptrue_uqss (1743)
-	enc{[4]byte{0x1, 0xc2, 0x1f, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 952, 3<<4 | 15, argp_y0y0w0},      // vcmptrue_uspd (1744)
-	enc{[4]byte{0x1, 0xc2, 0x1f, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 953, 3<<4 | 15, argp_y0y0w0},                // vcmptrue_usps (1745)
-	enc{[4]byte{0x1, 0xc2, 0x1f, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 954, 3<<4 | 15, argp_yoyomq},                  // vcmptrue_ussd (1746)
-	enc{[4]byte{0x1, 0xc2, 0x1f, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 954, 3<<4 | 15, argp_yoyoyo},                  // vcmptrue_ussd (1747)
-	enc{[4]byte{0x1, 0xc2, 0x1f, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 955, 3<<4 | 15, argp_yoyomq},                  // vcmptrue_usss (1748)
-	enc{[4]byte{0x1, 0xc2, 0x1f, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 955, 3<<4 | 15, argp_yoyoyo},                  // vcmptrue_usss (1749)
-	enc{[4]byte{0x1, 0xc2, 0xf, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 956, 3<<4 | 15, argp_y0y0w0},       // vcmptruepd (1750)
-	enc{[4]byte{0x1, 0xc2, 0xf, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 957, 3<<4 | 15, argp_y0y0w0},                 // vcmptrueps (1751)
-	enc{[4]byte{0x1, 0xc2, 0xf, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 958, 3<<4 | 15, argp_yoyomq},                   // vcmptruesd (1752)
-	enc{[4]byte{0x1, 0xc2, 0xf, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 958, 3<<4 | 15, argp_yoyoyo},                   // vcmptruesd (1753)
-	enc{[4]byte{0x1, 0xc2, 0xf, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 959, 3<<4 | 15, argp_yoyomq},                   // vcmptruess (1754)
-	enc{[4]byte{0x1, 0xc2, 0xf, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 959, 3<<4 | 15, argp_yoyoyo},                   // vcmptruess (1755)
-	enc{[4]byte{0x1, 0xc2, 0x3, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 960, 3<<4 | 15, argp_y0y0w0},       // vcmpunord_qpd (1756)
-	enc{[4]byte{0x1, 0xc2, 0x3, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 961, 3<<4 | 15, argp_y0y0w0},                 // vcmpunord_qps (1757)
-	enc{[4]byte{0x1, 0xc2, 0x3, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 962, 3<<4 | 15, argp_yoyomq},                   // vcmpunord_qsd (1758)
-	enc{[4]byte{0x1, 0xc2, 0x3, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 962, 3<<4 | 15, argp_yoyoyo},                   // vcmpunord_qsd (1759)
-	enc{[4]byte{0x1, 0xc2, 0x3, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 963, 3<<4 | 15, argp_yoyomq},                   // vcmpunord_qss (1760)
-	enc{[4]byte{0x1, 0xc2, 0x3, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 963, 3<<4 | 15, argp_yoyoyo},                   // vcmpunord_qss (1761)
-	enc{[4]byte{0x1, 0xc2, 0x13, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 964, 3<<4 | 15, argp_y0y0w0},      // vcmpunord_spd (1762)
-	enc{[4]byte{0x1, 0xc2, 0x13, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 965, 3<<4 | 15, argp_y0y0w0},                // vcmpunord_sps (1763)
-	enc{[4]byte{0x1, 0xc2, 0x13, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 966, 3<<4 | 15, argp_yoyomq},                  // vcmpunord_ssd (1764)
-	enc{[4]byte{0x1, 0xc2, 0x13, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 966, 3<<4 | 15, argp_yoyoyo},                  // vcmpunord_ssd (1765)
-	enc{[4]byte{0x1, 0xc2, 0x13, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 967, 3<<4 | 15, argp_yoyomq},                  // vcmpunord_sss (1766)
-	enc{[4]byte{0x1, 0xc2, 0x13, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 967, 3<<4 | 15, argp_yoyoyo},                  // vcmpunord_sss (1767)
-	enc{[4]byte{0x1, 0xc2, 0x3, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, AVX, 0<<11 | 968, 3<<4 | 15, argp_yhyhwh},       // vcmpunordpd (1768)
-	enc{[4]byte{0x1, 0xc2, 0x3, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 1<<11 | 968, 3<<4 | 15, argp_yoyowo},                   // vcmpunordpd (1769)
-	enc{[4]byte{0x1, 0xc2, 0x3, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, AVX, 0<<11 | 969, 3<<4 | 15, argp_y0y0w0},                 // vcmpunordps (1770)
-	enc{[4]byte{0x1, 0xc2, 0x3, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 0<<11 | 970, 3<<4 | 15, argp_yoyomq},                   // vcmpunordsd (1771)
-	enc{[4]byte{0x1, 0xc2, 0x3, 0x00}, VEX_OP | IMM_OP | PREF_F2, AVX, 1<<11 | 970, 3<<4 | 15, argp_yoyoyo},                   // vcmpunordsd (1772)
-	enc{[4]byte{0x1, 0xc2, 0x3, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 0<<11 | 971, 3<<4 | 15, argp_yoyomq},                   // vcmpunordss (1773)
-	enc{[4]byte{0x1, 0xc2, 0x3, 0x00}, VEX_OP | IMM_OP | PREF_F3, AVX, 1<<11 | 971, 3<<4 | 15, argp_yoyoyo},                   // vcmpunordss (1774)
-	enc{[4]byte{0x1, 0x2f, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 972, 2<<4 | 15, argp_yomq},                             // vcomisd (1775)
-	enc{[4]byte{0x1, 0x2f, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 1<<11 | 972, 2<<4 | 15, argp_yoyo},                             // vcomisd (1776)
-	enc{[4]byte{0x1, 0x2f, 0x00, 0x00}, VEX_OP, AVX, 0<<11 | 973, 2<<4 | 15, argp_yomd},                                       // vcomiss (1777)
-	enc{[4]byte{0x1, 0x2f, 0x00, 0x00}, VEX_OP, AVX, 1<<11 | 973, 2<<4 | 15, argp_yoyo},                                       // vcomiss (1778)
-	enc{[4]byte{0x1, 0xe6, 0x00, 0x00}, VEX_OP | PREF_F3, AVX, 0<<11 | 974, 2<<4 | 15, argp_yomq},                             // vcvtdq2pd (1779)
-	enc{[4]byte{0x1, 0xe6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F3, AVX, 1<<11 | 974, 2<<4 | 15, argp_y0wo},                 // vcvtdq2pd (1780)
-	enc{[4]byte{0x1, 0x5b, 0x00, 0x00}, VEX_OP | AUTO_VEXL, AVX, 0<<11 | 975, 2<<4 | 15, argp_y0w0},                           // vcvtdq2ps (1781)
-	enc{[4]byte{0x1, 0xe6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F2, AVX, 0<<11 | 976, 2<<4 | 15, argp_yom0},                 // vcvtpd2dq (1782)
-	enc{[4]byte{0x1, 0xe6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F2, AVX, 1<<11 | 976, 2<<4 | 15, argp_yoy0},                 // vcvtpd2dq (1783)
-	enc{[4]byte{0x1, 0x5a, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 977, 2<<4 | 15, argp_yom0},                 // vcvtpd2ps (1784)
-	enc{[4]byte{0x1, 0x5a, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 977, 2<<4 | 15, argp_yoy0},                 // vcvtpd2ps (1785)
-	enc{[4]byte{0x2, 0x13, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 978, 2<<4 | 15, argp_yomq},                             // vcvtph2ps (1786)
-	enc{[4]byte{0x2, 0x13, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 978, 2<<4 | 15, argp_y0wo},                 // vcvtph2ps (1787)
-	enc{[4]byte{0x1, 0x5b, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 979, 2<<4 | 15, argp_y0w0},                 // vcvtps2dq (1788)
-	enc{[4]byte{0x1, 0x5a, 0x00, 0x00}, VEX_OP, AVX, 0<<11 | 980, 2<<4 | 15, argp_yomq},                                       // vcvtps2pd (1789)
-	enc{[4]byte{0x1, 0x5a, 0x00, 0x00}, VEX_OP | AUTO_VEXL, AVX, 1<<11 | 980, 2<<4 | 15, argp_y0wo},                           // vcvtps2pd (1790)
-	enc{[4]byte{0x3, 0x1d, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, AVX, 0<<11 | 981, 2<<4 | 15, argp_mqyoib},                  // vcvtps2ph (1791)
-	enc{[4]byte{0x3, 0x1d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, AVX, 1<<11 | 981, 2<<4 | 15, argp_woy0ib},      // vcvtps2ph (1792)
-	enc{[4]byte{0x1, 0x2d, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F2, AVX, 0<<11 | 982, 2<<4 | 15, argp_r0mq},                 // vcvtsd2si (1793)
-	enc{[4]byte{0x1, 0x2d, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F2, AVX, 1<<11 | 982, 2<<4 | 15, argp_r0yo},                 // vcvtsd2si (1794)
-	enc{[4]byte{0x1, 0x5a, 0x00, 0x00}, VEX_OP | PREF_F2, AVX, 0<<11 | 983, 2<<4 | 15, argp_yoyomq},                           // vcvtsd2ss (1795)
-	enc{[4]byte{0x1, 0x5a, 0x00, 0x00}, VEX_OP | PREF_F2, AVX, 1<<11 | 983, 2<<4 | 15, argp_yoyoyo},                           // vcvtsd2ss (1796)
-	enc{[4]byte{0x1, 0x2a, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F2, AVX, 0<<11 | 984, 2<<4 | 15, argp_yoyov0},               // vcvtsi2sd (1797)
-	enc{[4]byte{0x1, 0x2a, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F3, AVX, 0<<11 | 985, 2<<4 | 15, argp_yoyov0},               // vcvtsi2ss (1798)
-	enc{[4]byte{0x1, 0x5a, 0x00, 0x00}, VEX_OP | PREF_F3, AVX, 0<<11 | 986, 2<<4 | 15, argp_yoyomd},                           // vcvtss2sd (1799)
-	enc{[4]byte{0x1, 0x5a, 0x00, 0x00}, VEX_OP | PREF_F3, AVX, 1<<11 | 986, 2<<4 | 15, argp_yoyoyo},                           // vcvtss2sd (1800)
-	enc{[4]byte{0x1, 0x2d, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F3, AVX, 0<<11 | 987, 2<<4 | 15, argp_r0md},                 // vcvtss2si (1801)
-	enc{[4]byte{0x1, 0x2d, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F3, AVX, 1<<11 | 987, 2<<4 | 15, argp_r0yo},                 // vcvtss2si (1802)
-	enc{[4]byte{0x1, 0xe6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 988, 2<<4 | 15, argp_yom0},                 // vcvttpd2dq (1803)
-	enc{[4]byte{0x1, 0xe6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 988, 2<<4 | 15, argp_yoy0},                 // vcvttpd2dq (1804)
-	enc{[4]byte{0x1, 0x5b, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F3, AVX, 0<<11 | 989, 2<<4 | 15, argp_y0w0},                 // vcvttps2dq (1805)
-	enc{[4]byte{0x1, 0x2c, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F2, AVX, 0<<11 | 990, 2<<4 | 15, argp_r0mq},                 // vcvttsd2si (1806)
-	enc{[4]byte{0x1, 0x2c, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F2, AVX, 1<<11 | 990, 2<<4 | 15, argp_r0yo},                 // vcvttsd2si (1807)
-	enc{[4]byte{0x1, 0x2c, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F3, AVX, 0<<11 | 991, 2<<4 | 15, argp_r0md},                 // vcvttss2si (1808)
-	enc{[4]byte{0x1, 0x2c, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F3, AVX, 1<<11 | 991, 2<<4 | 15, argp_r0yo},                 // vcvttss2si (1809)
-	enc{[4]byte{0x1, 0x5e, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 992, 2<<4 | 15, argp_y0y0w0},               // vdivpd (1810)
-	enc{[4]byte{0x1, 0x5e, 0x00, 0x00}, VEX_OP | AUTO_VEXL, AVX, 0<<11 | 993, 2<<4 | 15, argp_y0y0w0},                         // vdivps (1811)
-	enc{[4]byte{0x1, 0x5e, 0x00, 0x00}, VEX_OP | PREF_F2, AVX, 0<<11 | 994, 2<<4 | 15, argp_yoyomq},                           // vdivsd (1812)
-	enc{[4]byte{0x1, 0x5e, 0x00, 0x00}, VEX_OP | PREF_F2, AVX, 1<<11 | 994, 2<<4 | 15, argp_yoyoyo},                           // vdivsd (1813)
-	enc{[4]byte{0x1, 0x5e, 0x00, 0x00}, VEX_OP | PREF_F3, AVX, 0<<11 | 995, 2<<4 | 15, argp_yoyomd},                           // vdivss (1814)
-	enc{[4]byte{0x1, 0x5e, 0x00, 0x00}, VEX_OP | PREF_F3, AVX, 1<<11 | 995, 2<<4 | 15, argp_yoyoyo},                           // vdivss (1815)
-	enc{[4]byte{0x3, 0x41, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 996, 2<<4 | 15, argp_yoyowoib},                         // vdppd (1816)
-	enc{[4]byte{0x3, 0x40, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, AVX, 0<<11 | 997, 2<<4 | 15, argp_y0y0w0ib},    // vdpps (1817)
-	enc{[4]byte{0xf, 0x0, 0x00, 0x00}, 0, 0, 0<<11 | 998, 2<<4 | 4, argp_m1},                                                  // verr (1818)
-	enc{[4]byte{0xf, 0x0, 0x00, 0x00}, 0, 0, 1<<11 | 998, 2<<4 | 4, argp_rw},                                                  // verr (1819)
-	enc{[4]byte{0xf, 0x0, 0x00, 0x00}, 0, 0, 0<<11 | 999, 2<<4 | 5, argp_m1},                                                  // verw (1820)
-	enc{[4]byte{0xf, 0x0, 0x00, 0x00}, 0, 0, 1<<11 | 999, 2<<4 | 5, argp_rw},                                                  // verw (1821)
-	enc{[4]byte{0x3, 0x19, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66 | ENC_MR, AVX, 0<<11 | 1000, 2<<4 | 15, argp_woyhib},     // vextractf128 (1822)
-	enc{[4]byte{0x3, 0x39, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66 | ENC_MR, AVX2, 0<<11 | 1001, 2<<4 | 15, argp_woyhib},    // vextracti128 (1823)
-	enc{[4]byte{0x3, 0x17, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, AVX, 0<<11 | 1002, 2<<4 | 15, argp_vdyoib},                 // vextractps (1824)
-	enc{[4]byte{0x2, 0xa8, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1003, 2<<4 | 15, argp_y0y0w0},              // vfmadd123pd (1825)
-	enc{[4]byte{0x2, 0xa8, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1004, 2<<4 | 15, argp_y0y0w0},              // vfmadd123ps (1826)
-	enc{[4]byte{0x2, 0xa9, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 0<<11 | 1005, 2<<4 | 15, argp_yoyomq},              // vfmadd123sd (1827)
-	enc{[4]byte{0x2, 0xa9, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 1<<11 | 1005, 2<<4 | 15, argp_yoyoyo},              // vfmadd123sd (1828)
-	enc{[4]byte{0x2, 0xa9, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 0<<11 | 1006, 2<<4 | 15, argp_yoyomd},                          // vfmadd123ss (1829)
-	enc{[4]byte{0x2, 0xa9, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 1<<11 | 1006, 2<<4 | 15, argp_yoyoyo},                          // vfmadd123ss (1830)
-	enc{[4]byte{0x2, 0x98, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1007, 2<<4 | 15, argp_y0y0w0},              // vfmadd132pd (1831)
-	enc{[4]byte{0x2, 0x98, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1008, 2<<4 | 15, argp_y0y0w0},              // vfmadd132ps (1832)
-	enc{[4]byte{0x2, 0x99, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 0<<11 | 1009, 2<<4 | 15, argp_yoyomq},              // vfmadd132sd (1833)
-	enc{[4]byte{0x2, 0x99, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 1<<11 | 1009, 2<<4 | 15, argp_yoyoyo},              // vfmadd132sd (1834)
-	enc{[4]byte{0x2, 0x99, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 0<<11 | 1010, 2<<4 | 15, argp_yoyomd},                          // vfmadd132ss (1835)
-	enc{[4]byte{0x2, 0x99, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 1<<11 | 1010, 2<<4 | 15, argp_yoyoyo},                          // vfmadd132ss (1836)
-	enc{[4]byte{0x2, 0xa8, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1011, 2<<4 | 15, argp_y0y0w0},              // vfmadd213pd (1837)
-	enc{[4]byte{0x2, 0xa8, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1012, 2<<4 | 15, argp_y0y0w0},              // vfmadd213ps (1838)
-	enc{[4]byte{0x2, 0xa9, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 0<<11 | 1013, 2<<4 | 15, argp_yoyomq},              // vfmadd213sd (1839)
-	enc{[4]byte{0x2, 0xa9, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 1<<11 | 1013, 2<<4 | 15, argp_yoyoyo},              // vfmadd213sd (1840)
-	enc{[4]byte{0x2, 0xa9, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 0<<11 | 1014, 2<<4 | 15, argp_yoyomd},                          // vfmadd213ss (1841)
-	enc{[4]byte{0x2, 0xa9, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 1<<11 | 1014, 2<<4 | 15, argp_yoyoyo},                          // vfmadd213ss (1842)
-	enc{[4]byte{0x2, 0xb8, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1015, 2<<4 | 15, argp_y0y0w0},              // vfmadd231pd (1843)
-	enc{[4]byte{0x2, 0xb8, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1016, 2<<4 | 15, argp_y0y0w0},              // vfmadd231ps (1844)
-	enc{[4]byte{0x2, 0xb9, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 0<<11 | 1017, 2<<4 | 15, argp_yoyomq},              // vfmadd231sd (1845)
-	enc{[4]byte{0x2, 0xb9, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 1<<11 | 1017, 2<<4 | 15, argp_yoyoyo},              // vfmadd231sd (1846)
-	enc{[4]byte{0x2, 0xb9, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 0<<11 | 1018, 2<<4 | 15, argp_yoyomd},                          // vfmadd231ss (1847)
-	enc{[4]byte{0x2, 0xb9, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 1<<11 | 1018, 2<<4 | 15, argp_yoyoyo},                          // vfmadd231ss (1848)
-	enc{[4]byte{0x2, 0x98, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1019, 2<<4 | 15, argp_y0y0w0},              // vfmadd312pd (1849)
-	enc{[4]byte{0x2, 0x98, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1020, 2<<4 | 15, argp_y0y0w0},              // vfmadd312ps (1850)
-	enc{[4]byte{0x2, 0x99, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 0<<11 | 1021, 2<<4 | 15, argp_yoyomq},              // vfmadd312sd (1851)
-	enc{[4]byte{0x2, 0x99, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 1<<11 | 1021, 2<<4 | 15, argp_yoyoyo},              // vfmadd312sd (1852)
-	enc{[4]byte{0x2, 0x99, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 0<<11 | 1022, 2<<4 | 15, argp_yoyomd},                          // vfmadd312ss (1853)
-	enc{[4]byte{0x2, 0x99, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 1<<11 | 1022, 2<<4 | 15, argp_yoyoyo},                          // vfmadd312ss (1854)
-	enc{[4]byte{0x2, 0xb8, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1023, 2<<4 | 15, argp_y0y0w0},              // vfmadd321pd (1855)
-	enc{[4]byte{0x2, 0xb8, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1024, 2<<4 | 15, argp_y0y0w0},              // vfmadd321ps (1856)
-	enc{[4]byte{0x2, 0xb9, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 0<<11 | 1025, 2<<4 | 15, argp_yoyomq},              // vfmadd321sd (1857)
-	enc{[4]byte{0x2, 0xb9, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 1<<11 | 1025, 2<<4 | 15, argp_yoyoyo},              // vfmadd321sd (1858)
-	enc{[4]byte{0x2, 0xb9, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 0<<11 | 1026, 2<<4 | 15, argp_yoyomd},                          // vfmadd321ss (1859)
-	enc{[4]byte{0x2, 0xb9, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 1<<11 | 1026, 2<<4 | 15, argp_yoyoyo},                          // vfmadd321ss (1860)
-	enc{[4]byte{0x3, 0x69, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, SSE5 | AMD, 0<<11 | 1027, 2<<4 | 15, argp_y0y0y0w0},     // vfmaddpd (1861)
-	enc{[4]byte{0x3, 0x69, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, SSE5 | AMD, 1<<11 | 1027, 2<<4 | 15, argp_y0y0w0y0},     // vfmaddpd (1862)
-	enc{[4]byte{0x3, 0x68, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, SSE5 | AMD, 0<<11 | 1028, 2<<4 | 15, argp_y0y0y0w0},     // vfmaddps (1863)
-	enc{[4]byte{0x3, 0x68, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, SSE5 | AMD, 1<<11 | 1028, 2<<4 | 15, argp_y0y0w0y0},     // vfmaddps (1864)
-	enc{[4]byte{0x3, 0x6b, 0x00, 0x00}, VEX_OP | PREF_66, SSE5 | AMD, 0<<11 | 1029, 2<<4 | 15, argp_yoyomqyo},                 // vfmaddsd (1865)
-	enc{[4]byte{0x3, 0x6b, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, SSE5 | AMD, 1<<11 | 1029, 2<<4 | 15, argp_yoyoyomq},     // vfmaddsd (1866)
-	enc{[4]byte{0x3, 0x6b, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, SSE5 | AMD, 2<<11 | 1029, 2<<4 | 15, argp_yoyoyoyo},     // vfmaddsd (1867)
-	enc{[4]byte{0x3, 0x6a, 0x00, 0x00}, VEX_OP | PREF_66, SSE5 | AMD, 0<<11 | 1030, 2<<4 | 15, argp_yoyomdyo},                 // vfmaddss (1868)
-	enc{[4]byte{0x3, 0x6a, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, SSE5 | AMD, 1<<11 | 1030, 2<<4 | 15, argp_yoyoyomd},     // vfmaddss (1869)
-	enc{[4]byte{0x3, 0x6a, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, SSE5 | AMD, 2<<11 | 1030, 2<<4 | 15, argp_yoyoyoyo},     // vfmaddss (1870)
-	enc{[4]byte{0x2, 0xa6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1031, 2<<4 | 15, argp_y0y0w0},              // vfmaddsub123pd (1871)
-	enc{[4]byte{0x2, 0xa6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1032, 2<<4 | 15, argp_y0y0w0},              // vfmaddsub123ps (1872)
-	enc{[4]byte{0x2, 0x96, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1033, 2<<4 | 15, argp_y0y0w0},              // vfmaddsub132pd (1873)
-	enc{[4]byte{0x2, 0x96, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1034, 2<<4 | 15, argp_y0y0w0},              // vfmaddsub132ps (1874)
-	enc{[4]byte{0x2, 0xa6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1035, 2<<4 | 15, argp_y0y0w0},              // vfmaddsub213pd (1875)
-	enc{[4]byte{0x2, 0xa6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1036, 2<<4 | 15, argp_y0y0w0},              // vfmaddsub213ps (1876)
-	enc{[4]byte{0x2, 0xb6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1037, 2<<4 | 15, argp_y0y0w0},              // vfmaddsub231pd (1877)
-	enc{[4]byte{0x2, 0xb6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1038, 2<<4 | 15, argp_y0y0w0},              // vfmaddsub231ps (1878)
-	enc{[4]byte{0x2, 0x96, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1039, 2<<4 | 15, argp_y0y0w0},              // vfmaddsub312pd (1879)
-	enc{[4]byte{0x2, 0x96, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1040, 2<<4 | 15, argp_y0y0w0},              // vfmaddsub312ps (1880)
-	enc{[4]byte{0x2, 0xb6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1041, 2<<4 | 15, argp_y0y0w0},              // vfmaddsub321pd (1881)
-	enc{[4]byte{0x2, 0xb6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1042, 2<<4 | 15, argp_y0y0w0},              // vfmaddsub321ps (1882)
-	enc{[4]byte{0x3, 0x5d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, SSE5 | AMD, 0<<11 | 1043, 2<<4 | 15, argp_y0y0y0w0},     // vfmaddsubpd (1883)
-	enc{[4]byte{0x3, 0x5d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, SSE5 | AMD, 1<<11 | 1043, 2<<4 | 15, argp_y0y0w0y0},     // vfmaddsubpd (1884)
-	enc{[4]byte{0x3, 0x5c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, SSE5 | AMD, 0<<11 | 1044, 2<<4 | 15, argp_y0y0y0w0},     // vfmaddsubps (1885)
-	enc{[4]byte{0x3, 0x5c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, SSE5 | AMD, 1<<11 | 1044, 2<<4 | 15, argp_y0y0w0y0},     // vfmaddsubps (1886)
-	enc{[4]byte{0x2, 0xaa, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1045, 2<<4 | 15, argp_y0y0w0},              // vfmsub123pd (1887)
-	enc{[4]byte{0x2, 0xaa, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1046, 2<<4 | 15, argp_y0y0w0},              // vfmsub123ps (1888)
-	enc{[4]byte{0x2, 0xab, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 0<<11 | 1047, 2<<4 | 15, argp_yoyomq},              // vfmsub123sd (1889)
-	enc{[4]byte{0x2, 0xab, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 1<<11 | 1047, 2<<4 | 15, argp_yoyoyo},              // vfmsub123sd (1890)
-	enc{[4]byte{0x2, 0xab, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 0<<11 | 1048, 2<<4 | 15, argp_yoyomd},                          // vfmsub123ss (1891)
-	enc{[4]byte{0x2, 0xab, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 1<<11 | 1048, 2<<4 | 15, argp_yoyoyo},                          // vfmsub123ss (1892)
-	enc{[4]byte{0x2, 0x9a, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1049, 2<<4 | 15, argp_y0y0w0},              // vfmsub132pd (1893)
-	enc{[4]byte{0x2, 0x9a, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1050, 2<<4 | 15, argp_y0y0w0},              // vfmsub132ps (1894)
-	enc{[4]byte{0x2, 0x9b, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 0<<11 | 1051, 2<<4 | 15, argp_yoyomq},              // vfmsub132sd (1895)
-	enc{[4]byte{0x2, 0x9b, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 1<<11 | 1051, 2<<4 | 15, argp_yoyoyo},              // vfmsub132sd (1896)
-	enc{[4]byte{0x2, 0x9b, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 0<<11 | 1052, 2<<4 | 15, argp_yoyomd},                          // vfmsub132ss (1897)
-	enc{[4]byte{0x2, 0x9b, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 1<<11 | 1052, 2<<4 | 15, argp_yoyoyo},                          // vfmsub132ss (1898)
-	enc{[4]byte{0x2, 0xaa, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1053, 2<<4 | 15, argp_y0y0w0},              // vfmsub213pd (1899)
-	enc{[4]byte{0x2, 0xaa, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1054, 2<<4 | 15, argp_y0y0w0},              // vfmsub213ps (1900)
-	enc{[4]byte{0x2, 0xab, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 0<<11 | 1055, 2<<4 | 15, argp_yoyomq},              // vfmsub213sd (1901)
-	enc{[4]byte{0x2, 0xab, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 1<<11 | 1055, 2<<4 | 15, argp_yoyoyo},              // vfmsub213sd (1902)
-	enc{[4]byte{0x2, 0xab, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 0<<11 | 1056, 2<<4 | 15, argp_yoyomd},                          // vfmsub213ss (1903)
-	enc{[4]byte{0x2, 0xab, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 1<<11 | 1056, 2<<4 | 15, argp_yoyoyo},                          // vfmsub213ss (1904)
-	enc{[4]byte{0x2, 0xba, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1057, 2<<4 | 15, argp_y0y0w0},              // vfmsub231pd (1905)
-	enc{[4]byte{0x2, 0xba, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1058, 2<<4 | 15, argp_y0y0w0},              // vfmsub231ps (1906)
-	enc{[4]byte{0x2, 0xbb, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 0<<11 | 1059, 2<<4 | 15, argp_yoyomq},              // vfmsub231sd (1907)
-	enc{[4]byte{0x2, 0xbb, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 1<<11 | 1059, 2<<4 | 15, argp_yoyoyo},              // vfmsub231sd (1908)
-	enc{[4]byte{0x2, 0xbb, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 0<<11 | 1060, 2<<4 | 15, argp_yoyomd},                          // vfmsub231ss (1909)
-	enc{[4]byte{0x2, 0xbb, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 1<<11 | 1060, 2<<4 | 15, argp_yoyoyo},                          // vfmsub231ss (1910)
-	enc{[4]byte{0x2, 0x9a, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1061, 2<<4 | 15, argp_y0y0w0},              // vfmsub312pd (1911)
-	enc{[4]byte{0x2, 0x9a, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1062, 2<<4 | 15, argp_y0y0w0},              // vfmsub312ps (1912)
-	enc{[4]byte{0x2, 0x9b, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 0<<11 | 1063, 2<<4 | 15, argp_yoyomq},              // vfmsub312sd (1913)
-	enc{[4]byte{0x2, 0x9b, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 1<<11 | 1063, 2<<4 | 15, argp_yoyoyo},              // vfmsub312sd (1914)
-	enc{[4]byte{0x2, 0x9b, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 0<<11 | 1064, 2<<4 | 15, argp_yoyomd},                          // vfmsub312ss (1915)
-	enc{[4]byte{0x2, 0x9b, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 1<<11 | 1064, 2<<4 | 15, argp_yoyoyo},                          // vfmsub312ss (1916)
-	enc{[4]byte{0x2, 0xba, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1065, 2<<4 | 15, argp_y0y0w0},              // vfmsub321pd (1917)
-	enc{[4]byte{0x2, 0xba, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1066, 2<<4 | 15, argp_y0y0w0},              // vfmsub321ps (1918)
-	enc{[4]byte{0x2, 0xbb, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 0<<11 | 1067, 2<<4 | 15, argp_yoyomq},              // vfmsub321sd (1919)
-	enc{[4]byte{0x2, 0xbb, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 1<<11 | 1067, 2<<4 | 15, argp_yoyoyo},              // vfmsub321sd (1920)
-	enc{[4]byte{0x2, 0xbb, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 0<<11 | 1068, 2<<4 | 15, argp_yoyomd},                          // vfmsub321ss (1921)
-	enc{[4]byte{0x2, 0xbb, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 1<<11 | 1068, 2<<4 | 15, argp_yoyoyo},                          // vfmsub321ss (1922)
-	enc{[4]byte{0x2, 0xa7, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1069, 2<<4 | 15, argp_y0y0w0},              // vfmsubadd123pd (1923)
-	enc{[4]byte{0x2, 0xa7, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1070, 2<<4 | 15, argp_y0y0w0},              // vfmsubadd123ps (1924)
-	enc{[4]byte{0x2, 0x97, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1071, 2<<4 | 15, argp_y0y0w0},              // vfmsubadd132pd (1925)
-	enc{[4]byte{0x2, 0x97, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1072, 2<<4 | 15, argp_y0y0w0},              // vfmsubadd132ps (1926)
-	enc{[4]byte{0x2, 0xa7, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1073, 2<<4 | 15, argp_y0y0w0},              // vfmsubadd213pd (1927)
-	enc{[4]byte{0x2, 0xa7, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1074, 2<<4 | 15, argp_y0y0w0},              // vfmsubadd213ps (1928)
-	enc{[4]byte{0x2, 0xb7, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1075, 2<<4 | 15, argp_y0y0w0},              // vfmsubadd231pd (1929)
-	enc{[4]byte{0x2, 0xb7, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1076, 2<<4 | 15, argp_y0y0w0},              // vfmsubadd231ps (1930)
-	enc{[4]byte{0x2, 0x97, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1077, 2<<4 | 15, argp_y0y0w0},              // vfmsubadd312pd (1931)
-	enc{[4]byte{0x2, 0x97, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1078, 2<<4 | 15, argp_y0y0w0},              // vfmsubadd312ps (1932)
-	enc{[4]byte{0x2, 0xb7, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1079, 2<<4 | 15, argp_y0y0w0},              // vfmsubadd321pd (1933)
-	enc{[4]byte{0x2, 0xb7, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1080, 2<<4 | 15, argp_y0y0w0},              // vfmsubadd321ps (1934)
-	enc{[4]byte{0x3, 0x5f, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, SSE5 | AMD, 0<<11 | 1081, 2<<4 | 15, argp_y0y0y0w0},     // vfmsubaddpd (1935)
-	enc{[4]byte{0x3, 0x5f, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, SSE5 | AMD, 1<<11 | 1081, 2<<4 | 15, argp_y0y0w0y0},     // vfmsubaddpd (1936)
-	enc{[4]byte{0x3, 0x5e, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, SSE5 | AMD, 0<<11 | 1082, 2<<4 | 15, argp_y0y0y0w0},     // vfmsubaddps (1937)
-	enc{[4]byte{0x3, 0x5e, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, SSE5 | AMD, 1<<11 | 1082, 2<<4 | 15, argp_y0y0w0y0},     // vfmsubaddps (1938)
-	enc{[4]byte{0x3, 0x6d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, SSE5 | AMD, 0<<11 | 1083, 2<<4 | 15, argp_y0y0y0w0},     // vfmsubpd (1939)
-	enc{[4]byte{0x3, 0x6d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, SSE5 | AMD, 1<<11 | 1083, 2<<4 | 15, argp_y0y0w0y0},     // vfmsubpd (1940)
-	enc{[4]byte{0x3, 0x6c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, SSE5 | AMD, 0<<11 | 1084, 2<<4 | 15, argp_y0y0y0w0},     // vfmsubps (1941)
-	enc{[4]byte{0x3, 0x6c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, SSE5 | AMD, 1<<11 | 1084, 2<<4 | 15, argp_y0y0w0y0},     // vfmsubps (1942)
-	enc{[4]byte{0x3, 0x6f, 0x00, 0x00}, VEX_OP | PREF_66, SSE5 | AMD, 0<<11 | 1085, 2<<4 | 15, argp_yoyomqyo},                 // vfmsubsd (1943)
-	enc{[4]byte{0x3, 0x6f, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, SSE5 | AMD, 1<<11 | 1085, 2<<4 | 15, argp_yoyoyomq},     // vfmsubsd (1944)
-	enc{[4]byte{0x3, 0x6f, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, SSE5 | AMD, 2<<11 | 1085, 2<<4 | 15, argp_yoyoyoyo},     // vfmsubsd (1945)
-	enc{[4]byte{0x3, 0x6e, 0x00, 0x00}, VEX_OP | PREF_66, SSE5 | AMD, 0<<11 | 1086, 2<<4 | 15, argp_yoyomdyo},                 // vfmsubss (1946)
-	enc{[4]byte{0x3, 0x6e, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, SSE5 | AMD, 1<<11 | 1086, 2<<4 | 15, argp_yoyoyomd},     // vfmsubss (1947)
-	enc{[4]byte{0x3, 0x6e, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, SSE5 | AMD, 2<<11 | 1086, 2<<4 | 15, argp_yoyoyoyo},     // vfmsubss (1948)
-	enc{[4]byte{0x2, 0xac, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1087, 2<<4 | 15, argp_y0y0w0},              // vfnmadd123pd (1949)
-	enc{[4]byte{0x2, 0xac, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1088, 2<<4 | 15, argp_y0y0w0},              // vfnmadd123ps (1950)
-	enc{[4]byte{0x2, 0xad, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 0<<11 | 1089, 2<<4 | 15, argp_yoyomq},              // vfnmadd123sd (1951)
-	enc{[4]byte{0x2, 0xad, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 1<<11 | 1089, 2<<4 | 15, argp_yoyoyo},              // vfnmadd123sd (1952)
-	enc{[4]byte{0x2, 0xad, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 0<<11 | 1090, 2<<4 | 15, argp_yoyomd},                          // vfnmadd123ss (1953)
-	enc{[4]byte{0x2, 0xad, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 1<<11 | 1090, 2<<4 | 15, argp_yoyoyo},                          // vfnmadd123ss (1954)
-	enc{[4]byte{0x2, 0x9c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1091, 2<<4 | 15, argp_y0y0w0},              // vfnmadd132pd (1955)
-	enc{[4]byte{0x2, 0x9c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1092, 2<<4 | 15, argp_y0y0w0},              // vfnmadd132ps (1956)
-	enc{[4]byte{0x2, 0x9d, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 0<<11 | 1093, 2<<4 | 15, argp_yoyomq},              // vfnmadd132sd (1957)
-	enc{[4]byte{0x2, 0x9d, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 1<<11 | 1093, 2<<4 | 15, argp_yoyoyo},              // vfnmadd132sd (1958)
-	enc{[4]byte{0x2, 0x9d, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 0<<11 | 1094, 2<<4 | 15, argp_yoyomd},                          // vfnmadd132ss (1959)
-	enc{[4]byte{0x2, 0x9d, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 1<<11 | 1094, 2<<4 | 15, argp_yoyoyo},                          // vfnmadd132ss (1960)
-	enc{[4]byte{0x2, 0xac, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1095, 2<<4 | 15, argp_y0y0w0},              // vfnmadd213pd (1961)
-	enc{[4]byte{0x2, 0xac, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1096, 2<<4 | 15, argp_y0y0w0},              // vfnmadd213ps (1962)
-	enc{[4]byte{0x2, 0xad, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 0<<11 | 1097, 2<<4 | 15, argp_yoyomq},              // vfnmadd213sd (1963)
-	enc{[4]byte{0x2, 0xad, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 1<<11 | 1097, 2<<4 | 15, argp_yoyoyo},              // vfnmadd213sd (1964)
-	enc{[4]byte{0x2, 0xad, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 0<<11 | 1098, 2<<4 | 15, argp_yoyomd},                          // vfnmadd213ss (1965)
-	enc{[4]byte{0x2, 0xad, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 1<<11 | 1098, 2<<4 | 15, argp_yoyoyo},                          // vfnmadd213ss (1966)
-	enc{[4]byte{0x2, 0xbc, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1099, 2<<4 | 15, argp_y0y0w0},              // vfnmadd231pd (1967)
-	enc{[4]byte{0x2, 0xbc, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1100, 2<<4 | 15, argp_y0y0w0},              // vfnmadd231ps (1968)
-	enc{[4]byte{0x2, 0xbd, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 0<<11 | 1101, 2<<4 | 15, argp_yoyomq},              // vfnmadd231sd (1969)
-	enc{[4]byte{0x2, 0xbd, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 1<<11 | 1101, 2<<4 | 15, argp_yoyoyo},              // vfnmadd231sd (1970)
-	enc{[4]byte{0x2, 0xbd, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 0<<11 | 1102, 2<<4 | 15, argp_yoyomd},                          // vfnmadd231ss (1971)
-	enc{[4]byte{0x2, 0xbd, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 1<<11 | 1102, 2<<4 | 15, argp_yoyoyo},                          // vfnmadd231ss (1972)
-	enc{[4]byte{0x2, 0x9c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1103, 2<<4 | 15, argp_y0y0w0},              // vfnmadd312pd (1973)
-	enc{[4]byte{0x2, 0x9c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1104, 2<<4 | 15, argp_y0y0w0},              // vfnmadd312ps (1974)
-	enc{[4]byte{0x2, 0x9d, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 0<<11 | 1105, 2<<4 | 15, argp_yoyomq},              // vfnmadd312sd (1975)
-	enc{[4]byte{0x2, 0x9d, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 1<<11 | 1105, 2<<4 | 15, argp_yoyoyo},              // vfnmadd312sd (1976)
-	enc{[4]byte{0x2, 0x9d, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 0<<11 | 1106, 2<<4 | 15, argp_yoyomd},                          // vfnmadd312ss (1977)
-	enc{[4]byte{0x2, 0x9d, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 1<<11 | 1106, 2<<4 | 15, argp_yoyoyo},                          // vfnmadd312ss (1978)
-	enc{[4]byte{0x2, 0xbc, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1107, 2<<4 | 15, argp_y0y0w0},              // vfnmadd321pd (1979)
-	enc{[4]byte{0x2, 0xbc, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1108, 2<<4 | 15, argp_y0y0w0},              // vfnmadd321ps (1980)
-	enc{[4]byte{0x2, 0xbd, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 0<<11 | 1109, 2<<4 | 15, argp_yoyomq},              // vfnmadd321sd (1981)
-	enc{[4]byte{0x2, 0xbd, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 1<<11 | 1109, 2<<4 | 15, argp_yoyoyo},              // vfnmadd321sd (1982)
-	enc{[4]byte{0x2, 0xbd, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 0<<11 | 1110, 2<<4 | 15, argp_yoyomd},                          // vfnmadd321ss (1983)
-	enc{[4]byte{0x2, 0xbd, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 1<<11 | 1110, 2<<4 | 15, argp_yoyoyo},                          // vfnmadd321ss (1984)
-	enc{[4]byte{0x3, 0x79, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, SSE5 | AMD, 0<<11 | 1111, 2<<4 | 15, argp_y0y0y0w0},     // vfnmaddpd (1985)
-	enc{[4]byte{0x3, 0x79, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, SSE5 | AMD, 1<<11 | 1111, 2<<4 | 15, argp_y0y0w0y0},     // vfnmaddpd (1986)
-	enc{[4]byte{0x3, 0x78, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, SSE5 | AMD, 0<<11 | 1112, 2<<4 | 15, argp_y0y0y0w0},     // vfnmaddps (1987)
-	enc{[4]byte{0x3, 0x78, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, SSE5 | AMD, 1<<11 | 1112, 2<<4 | 15, argp_y0y0w0y0},     // vfnmaddps (1988)
-	enc{[4]byte{0x3, 0x7b, 0x00, 0x00}, VEX_OP | PREF_66, SSE5 | AMD, 0<<11 | 1113, 2<<4 | 15, argp_yoyomqyo},                 // vfnmaddsd (1989)
-	enc{[4]byte{0x3, 0x7b, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, SSE5 | AMD, 1<<11 | 1113, 2<<4 | 15, argp_yoyoyomq},     // vfnmaddsd (1990)
-	enc{[4]byte{0x3, 0x7b, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, SSE5 | AMD, 2<<11 | 1113, 2<<4 | 15, argp_yoyoyoyo},     // vfnmaddsd (1991)
-	enc{[4]byte{0x3, 0x7a, 0x00, 0x00}, VEX_OP | PREF_66, SSE5 | AMD, 0<<11 | 1114, 2<<4 | 15, argp_yoyomdyo},                 // vfnmaddss (1992)
-	enc{[4]byte{0x3, 0x7a, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, SSE5 | AMD, 1<<11 | 1114, 2<<4 | 15, argp_yoyoyomd},     // vfnmaddss (1993)
-	enc{[4]byte{0x3, 0x7a, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, SSE5 | AMD, 2<<11 | 1114, 2<<4 | 15, argp_yoyoyoyo},     // vfnmaddss (1994)
-	enc{[4]byte{0x2, 0xae, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1115, 2<<4 | 15, argp_y0y0w0},              // vfnmsub123pd (1995)
-	enc{[4]byte{0x2, 0xae, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1116, 2<<4 | 15, argp_y0y0w0},              // vfnmsub123ps (1996)
-	enc{[4]byte{0x2, 0xaf, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 0<<11 | 1117, 2<<4 | 15, argp_yoyomq},              // vfnmsub123sd (1997)
-	enc{[4]byte{0x2, 0xaf, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 1<<11 | 1117, 2<<4 | 15, argp_yoyoyo},              // vfnmsub123sd (1998)
-	enc{[4]byte{0x2, 0xaf, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 0<<11 | 1118, 2<<4 | 15, argp_yoyomd},                          // vfnmsub123ss (1999)
-	enc{[4]byte{0x2, 0xaf, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 1<<11 | 1118, 2<<4 | 15, argp_yoyoyo},                          // vfnmsub123ss (2000)
-	enc{[4]byte{0x2, 0x9e, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1119, 2<<4 | 15, argp_y0y0w0},              // vfnmsub132pd (2001)
-	enc{[4]byte{0x2, 0x9e, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1120, 2<<4 | 15, argp_y0y0w0},              // vfnmsub132ps (2002)
-	enc{[4]byte{0x2, 0x9f, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 0<<11 | 1121, 2<<4 | 15, argp_yoyomq},              // vfnmsub132sd (2003)
-	enc{[4]byte{0x2, 0x9f, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 1<<11 | 1121, 2<<4 | 15, argp_yoyoyo},              // vfnmsub132sd (2004)
-	enc{[4]byte{0x2, 0x9f, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 0<<11 | 1122, 2<<4 | 15, argp_yoyomd},                          // vfnmsub132ss (2005)
-	enc{[4]byte{0x2, 0x9f, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 1<<11 | 1122, 2<<4 | 15, argp_yoyoyo},                          // vfnmsub132ss (2006)
-	enc{[4]byte{0x2, 0xae, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1123, 2<<4 | 15, argp_y0y0w0},              // vfnmsub213pd (2007)
-	enc{[4]byte{0x2, 0xae, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1124, 2<<4 | 15, argp_y0y0w0},              // vfnmsub213ps (2008)
-	enc{[4]byte{0x2, 0xaf, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 0<<11 | 1125, 2<<4 | 15, argp_yoyomq},              // vfnmsub213sd (2009)
-	enc{[4]byte{0x2, 0xaf, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 1<<11 | 1125, 2<<4 | 15, argp_yoyoyo},              // vfnmsub213sd (2010)
-	enc{[4]byte{0x2, 0xaf, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 0<<11 | 1126, 2<<4 | 15, argp_yoyomd},                          // vfnmsub213ss (2011)
-	enc{[4]byte{0x2, 0xaf, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 1<<11 | 1126, 2<<4 | 15, argp_yoyoyo},                          // vfnmsub213ss (2012)
-	enc{[4]byte{0x2, 0xbe, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1127, 2<<4 | 15, argp_y0y0w0},              // vfnmsub231pd (2013)
-	enc{[4]byte{0x2, 0xbe, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1128, 2<<4 | 15, argp_y0y0w0},              // vfnmsub231ps (2014)
-	enc{[4]byte{0x2, 0xbf, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 0<<11 | 1129, 2<<4 | 15, argp_yoyomq},              // vfnmsub231sd (2015)
-	enc{[4]byte{0x2, 0xbf, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 1<<11 | 1129, 2<<4 | 15, argp_yoyoyo},              // vfnmsub231sd (2016)
-	enc{[4]byte{0x2, 0xbf, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 0<<11 | 1130, 2<<4 | 15, argp_yoyomd},                          // vfnmsub231ss (2017)
-	enc{[4]byte{0x2, 0xbf, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 1<<11 | 1130, 2<<4 | 15, argp_yoyoyo},                          // vfnmsub231ss (2018)
-	enc{[4]byte{0x2, 0x9e, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1131, 2<<4 | 15, argp_y0y0w0},              // vfnmsub312pd (2019)
-	enc{[4]byte{0x2, 0x9e, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1132, 2<<4 | 15, argp_y0y0w0},              // vfnmsub312ps (2020)
-	enc{[4]byte{0x2, 0x9f, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 0<<11 | 1133, 2<<4 | 15, argp_yoyomq},              // vfnmsub312sd (2021)
-	enc{[4]byte{0x2, 0x9f, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 1<<11 | 1133, 2<<4 | 15, argp_yoyoyo},              // vfnmsub312sd (2022)
-	enc{[4]byte{0x2, 0x9f, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 0<<11 | 1134, 2<<4 | 15, argp_yoyomd},                          // vfnmsub312ss (2023)
-	enc{[4]byte{0x2, 0x9f, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 1<<11 | 1134, 2<<4 | 15, argp_yoyoyo},                          // vfnmsub312ss (2024)
-	enc{[4]byte{0x2, 0xbe, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1135, 2<<4 | 15, argp_y0y0w0},              // vfnmsub321pd (2025)
-	enc{[4]byte{0x2, 0xbe, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, FMA, 0<<11 | 1136, 2<<4 | 15, argp_y0y0w0},              // vfnmsub321ps (2026)
-	enc{[4]byte{0x2, 0xbf, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 0<<11 | 1137, 2<<4 | 15, argp_yoyomq},              // vfnmsub321sd (2027)
-	enc{[4]byte{0x2, 0xbf, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, FMA, 1<<11 | 1137, 2<<4 | 15, argp_yoyoyo},              // vfnmsub321sd (2028)
-	enc{[4]byte{0x2, 0xbf, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 0<<11 | 1138, 2<<4 | 15, argp_yoyomd},                          // vfnmsub321ss (2029)
-	enc{[4]byte{0x2, 0xbf, 0x00, 0x00}, VEX_OP | PREF_66, FMA, 1<<11 | 1138, 2<<4 | 15, argp_yoyoyo},                          // vfnmsub321ss (2030)
-	enc{[4]byte{0x3, 0x7d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, SSE5 | AMD, 0<<11 | 1139, 2<<4 | 15, argp_y0y0y0w0},     // vfnmsubpd (2031)
-	enc{[4]byte{0x3, 0x7d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, SSE5 | AMD, 1<<11 | 1139, 2<<4 | 15, argp_y0y0w0y0},     // vfnmsubpd (2032)
-	enc{[4]byte{0x3, 0x7c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, SSE5 | AMD, 0<<11 | 1140, 2<<4 | 15, argp_y0y0y0w0},     // vfnmsubps (2033)
-	enc{[4]byte{0x3, 0x7c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, SSE5 | AMD, 1<<11 | 1140, 2<<4 | 15, argp_y0y0w0y0},     // vfnmsubps (2034)
-	enc{[4]byte{0x3, 0x7f, 0x00, 0x00}, VEX_OP | PREF_66, SSE5 | AMD, 0<<11 | 1141, 2<<4 | 15, argp_yoyomqyo},                 // vfnmsubsd (2035)
-	enc{[4]byte{0x3, 0x7f, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, SSE5 | AMD, 1<<11 | 1141, 2<<4 | 15, argp_yoyoyomq},     // vfnmsubsd (2036)
-	enc{[4]byte{0x3, 0x7f, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, SSE5 | AMD, 2<<11 | 1141, 2<<4 | 15, argp_yoyoyoyo},     // vfnmsubsd (2037)
-	enc{[4]byte{0x3, 0x7e, 0x00, 0x00}, VEX_OP | PREF_66, SSE5 | AMD, 0<<11 | 1142, 2<<4 | 15, argp_yoyomdyo},                 // vfnmsubss (2038)
-	enc{[4]byte{0x3, 0x7e, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, SSE5 | AMD, 1<<11 | 1142, 2<<4 | 15, argp_yoyoyomd},     // vfnmsubss (2039)
-	enc{[4]byte{0x3, 0x7e, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, SSE5 | AMD, 2<<11 | 1142, 2<<4 | 15, argp_yoyoyoyo},     // vfnmsubss (2040)
-	enc{[4]byte{0x9, 0x81, 0x00, 0x00}, XOP_OP | AUTO_VEXL, SSE5 | AMD, 0<<11 | 1143, 2<<4 | 15, argp_y0w0},                   // vfrczpd (2041)
-	enc{[4]byte{0x9, 0x80, 0x00, 0x00}, XOP_OP | AUTO_VEXL, SSE5 | AMD, 0<<11 | 1144, 2<<4 | 15, argp_y0w0},                   // vfrczps (2042)
-	enc{[4]byte{0x9, 0x83, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1145, 2<<4 | 15, argp_yomq},                               // vfrczsd (2043)
-	enc{[4]byte{0x9, 0x83, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 1<<11 | 1145, 2<<4 | 15, argp_yoyo},                               // vfrczsd (2044)
-	enc{[4]byte{0x9, 0x82, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1146, 2<<4 | 15, argp_yomd},                               // vfrczss (2045)
-	enc{[4]byte{0x9, 0x82, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 1<<11 | 1146, 2<<4 | 15, argp_yoyo},                               // vfrczss (2046)
-	enc{[4]byte{0x2, 0x92, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, AVX2, 0<<11 | 1147, 2<<4 | 15, argp_y0loy0},    // vgatherdpd (2047)
-	enc{[4]byte{0x2, 0x92, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, AVX2, 0<<11 | 1148, 2<<4 | 15, argp_y0k0y0},    // vgatherdps (2048)
-	enc{[4]byte{0x2, 0x93, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, AVX2, 0<<11 | 1149, 2<<4 | 15, argp_y0l0y0},    // vgatherqpd (2049)
-	enc{[4]byte{0x2, 0x93, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, AVX2, 0<<11 | 1150, 2<<4 | 15, argp_yok0yo},    // vgatherqps (2050)
-	enc{[4]byte{0x1, 0x7c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1151, 2<<4 | 15, argp_y0y0w0},              // vhaddpd (2051)
-	enc{[4]byte{0x1, 0x7c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F2, AVX, 0<<11 | 1152, 2<<4 | 15, argp_y0y0w0},              // vhaddps (2052)
-	enc{[4]byte{0x1, 0x7d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1153, 2<<4 | 15, argp_y0y0w0},              // vhsubpd (2053)
-	enc{[4]byte{0x1, 0x7d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F2, AVX, 0<<11 | 1154, 2<<4 | 15, argp_y0y0w0},              // vhsubps (2054)
-	enc{[4]byte{0x3, 0x18, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66, AVX, 0<<11 | 1155, 2<<4 | 15, argp_yhyhwoib},            // vinsertf128 (2055)
-	enc{[4]byte{0x3, 0x38, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66, AVX2, 0<<11 | 1156, 2<<4 | 15, argp_yhyhwoib},           // vinserti128 (2056)
-	enc{[4]byte{0x3, 0x21, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 1157, 2<<4 | 15, argp_yoyomdib},                        // vinsertps (2057)
-	enc{[4]byte{0x3, 0x21, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 1<<11 | 1157, 2<<4 | 15, argp_yoyoyoib},                        // vinsertps (2058)
-	enc{[4]byte{0x1, 0xf0, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F2, AVX, 0<<11 | 1158, 2<<4 | 15, argp_y0m0},                // vlddqu (2059)
-	enc{[4]byte{0x1, 0xae, 0x00, 0x00}, VEX_OP, AVX, 0<<11 | 1159, 2<<4 | 2, argp_md},                                         // vldmxcsr (2060)
-	enc{[4]byte{0x1, 0xf0, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_F2, AVX, 0<<11 | 1160, 2<<4 | 15, argp_yhmh},                // vldqqu (2061)
-	enc{[4]byte{0x1, 0xf7, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 1161, 2<<4 | 15, argp_yoyo},                            // vmaskmovdqu (2062)
-	enc{[4]byte{0x2, 0x2f, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_VM, AVX, 0<<11 | 1162, 2<<4 | 15, argp_m0y0y0},     // vmaskmovpd (2063)
-	enc{[4]byte{0x2, 0x2d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 1162, 2<<4 | 15, argp_y0y0m0},              // vmaskmovpd (2064)
-	enc{[4]byte{0x2, 0x2e, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_VM, AVX, 0<<11 | 1163, 2<<4 | 15, argp_m0y0y0},     // vmaskmovps (2065)
-	enc{[4]byte{0x2, 0x2c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 1163, 2<<4 | 15, argp_y0y0m0},              // vmaskmovps (2066)
-	enc{[4]byte{0x1, 0x5f, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1164, 2<<4 | 15, argp_y0y0w0},              // vmaxpd (2067)
-	enc{[4]byte{0x1, 0x5f, 0x00, 0x00}, VEX_OP | AUTO_VEXL, AVX, 0<<11 | 1165, 2<<4 | 15, argp_y0y0w0},                        // vmaxps (2068)
-	enc{[4]byte{0x1, 0x5f, 0x00, 0x00}, VEX_OP | PREF_F2, AVX, 0<<11 | 1166, 2<<4 | 15, argp_yoyomq},                          // vmaxsd (2069)
-	enc{[4]byte{0x1, 0x5f, 0x00, 0x00}, VEX_OP | PREF_F2, AVX, 1<<11 | 1166, 2<<4 | 15, argp_yoyoyo},                          // vmaxsd (2070)
-	enc{[4]byte{0x1, 0x5f, 0x00, 0x00}, VEX_OP | PREF_F3, AVX, 0<<11 | 1167, 2<<4 | 15, argp_yoyomd},                          // vmaxss (2071)
-	enc{[4]byte{0x1, 0x5f, 0x00, 0x00}, VEX_OP | PREF_F3, AVX, 1<<11 | 1167, 2<<4 | 15, argp_yoyoyo},                          // vmaxss (2072)
-	enc{[4]byte{0xf, 0x1, 0xc1, 0x00}, 0, VMX, 0<<11 | 1168, 3<<4 | 15, argp_},                                                // vmcall (2073)
-	enc{[4]byte{0xf, 0xc7, 0x00, 0x00}, PREF_66, VMX, 0<<11 | 1169, 2<<4 | 6, argp_m1},                                        // vmclear (2074)
-	enc{[4]byte{0xf, 0x1, 0xd4, 0x00}, 0, VMX, 0<<11 | 1170, 3<<4 | 15, argp_},                                                // vmfunc (2075)
-	enc{[4]byte{0x1, 0x5d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1171, 2<<4 | 15, argp_y0y0w0},              // vminpd (2076)
-	enc{[4]byte{0x1, 0x5d, 0x00, 0x00}, VEX_OP | AUTO_VEXL, AVX, 0<<11 | 1172, 2<<4 | 15, argp_y0y0w0},                        // vminps (2077)
-	enc{[4]byte{0x1, 0x5d, 0x00, 0x00}, VEX_OP | PREF_F2, AVX, 0<<11 | 1173, 2<<4 | 15, argp_yoyomq},                          // vminsd (2078)
-	enc{[4]byte{0x1, 0x5d, 0x00, 0x00}, VEX_OP | PREF_F2, AVX, 1<<11 | 1173, 2<<4 | 15, argp_yoyoyo},                          // vminsd (2079)
-	enc{[4]byte{0x1, 0x5d, 0x00, 0x00}, VEX_OP | PREF_F3, AVX, 0<<11 | 1174, 2<<4 | 15, argp_yoyomd},                          // vminss (2080)
-	enc{[4]byte{0x1, 0x5d, 0x00, 0x00}, VEX_OP | PREF_F3, AVX, 1<<11 | 1174, 2<<4 | 15, argp_yoyoyo},                          // vminss (2081)
-	enc{[4]byte{0xf, 0x1, 0xc2, 0x00}, 0, VMX, 0<<11 | 1175, 3<<4 | 15, argp_},                                                // vmlaunch (2082)
-	enc{[4]byte{0xf, 0x1, 0xda, 0x00}, 0, VMX | AMD, 0<<11 | 1176, 3<<4 | 15, argp_},                                          // vmload (2083)
-	enc{[4]byte{0xf, 0x1, 0xd9, 0x00}, 0, VMX | AMD, 0<<11 | 1177, 3<<4 | 15, argp_},                                          // vmmcall (2084)
-	enc{[4]byte{0x1, 0x28, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1178, 2<<4 | 15, argp_y0w0},                // vmovapd (2085)
-	enc{[4]byte{0x1, 0x29, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66 | ENC_MR, AVX, 1<<11 | 1178, 2<<4 | 15, argp_whyh},       // vmovapd (2086)
-	enc{[4]byte{0x1, 0x29, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, AVX, 2<<11 | 1178, 2<<4 | 15, argp_woyo},                   // vmovapd (2087)
-	enc{[4]byte{0x1, 0x28, 0x00, 0x00}, VEX_OP | AUTO_VEXL, AVX, 0<<11 | 1179, 2<<4 | 15, argp_y0w0},                          // vmovaps (2088)
-	enc{[4]byte{0x1, 0x29, 0x00, 0x00}, VEX_OP | WITH_VEXL | ENC_MR, AVX, 1<<11 | 1179, 2<<4 | 15, argp_whyh},                 // vmovaps (2089)
-	enc{[4]byte{0x1, 0x29, 0x00, 0x00}, VEX_OP | ENC_MR, AVX, 2<<11 | 1179, 2<<4 | 15, argp_woyo},                             // vmovaps (2090)
-	enc{[4]byte{0x1, 0x6e, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 1180, 2<<4 | 15, argp_yovd},                            // vmovd (2091)
-	enc{[4]byte{0x1, 0x7e, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, AVX, 1<<11 | 1180, 2<<4 | 15, argp_vdyo},                   // vmovd (2092)
-	enc{[4]byte{0x1, 0x12, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F2, AVX, 0<<11 | 1181, 2<<4 | 15, argp_y0w0},                // vmovddup (2093)
-	enc{[4]byte{0x1, 0x12, 0x00, 0x00}, VEX_OP | PREF_F2, AVX, 1<<11 | 1181, 2<<4 | 15, argp_yomq},                            // vmovddup (2094)
-	enc{[4]byte{0x1, 0x6f, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1182, 2<<4 | 15, argp_y0w0},                // vmovdqa (2095)
-	enc{[4]byte{0x1, 0x7f, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66 | ENC_MR, AVX, 1<<11 | 1182, 2<<4 | 15, argp_whyh},       // vmovdqa (2096)
-	enc{[4]byte{0x1, 0x7f, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, AVX, 2<<11 | 1182, 2<<4 | 15, argp_woyo},                   // vmovdqa (2097)
-	enc{[4]byte{0x1, 0x6f, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F3, AVX, 0<<11 | 1183, 2<<4 | 15, argp_y0w0},                // vmovdqu (2098)
-	enc{[4]byte{0x1, 0x7f, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_F3 | ENC_MR, AVX, 1<<11 | 1183, 2<<4 | 15, argp_whyh},       // vmovdqu (2099)
-	enc{[4]byte{0x1, 0x7f, 0x00, 0x00}, VEX_OP | PREF_F3 | ENC_MR, AVX, 2<<11 | 1183, 2<<4 | 15, argp_woyo},                   // vmovdqu (2100)
-	enc{[4]byte{0x1, 0x12, 0x00, 0x00}, VEX_OP, AVX, 0<<11 | 1184, 2<<4 | 15, argp_yoyoyo},                                    // vmovhlps (2101)
-	enc{[4]byte{0x1, 0x17, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, AVX, 0<<11 | 1185, 2<<4 | 15, argp_mqyo},                   // vmovhpd (2102)
-	enc{[4]byte{0x1, 0x16, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 1<<11 | 1185, 2<<4 | 15, argp_yoyomq},                          // vmovhpd (2103)
-	enc{[4]byte{0x1, 0x17, 0x00, 0x00}, VEX_OP | ENC_MR, AVX, 0<<11 | 1186, 2<<4 | 15, argp_mqyo},                             // vmovhps (2104)
-	enc{[4]byte{0x1, 0x16, 0x00, 0x00}, VEX_OP, AVX, 1<<11 | 1186, 2<<4 | 15, argp_yoyomq},                                    // vmovhps (2105)
-	enc{[4]byte{0x1, 0x16, 0x00, 0x00}, VEX_OP, AVX, 0<<11 | 1187, 2<<4 | 15, argp_yoyoyo},                                    // vmovlhps (2106)
-	enc{[4]byte{0x1, 0x13, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, AVX, 0<<11 | 1188, 2<<4 | 15, argp_mqyo},                   // vmovlpd (2107)
-	enc{[4]byte{0x1, 0x12, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 1<<11 | 1188, 2<<4 | 15, argp_yoyomq},                          // vmovlpd (2108)
-	enc{[4]byte{0x1, 0x13, 0x00, 0x00}, VEX_OP | ENC_MR, AVX, 0<<11 | 1189, 2<<4 | 15, argp_mqyo},                             // vmovlps (2109)
-	enc{[4]byte{0x1, 0x12, 0x00, 0x00}, VEX_OP, AVX, 1<<11 | 1189, 2<<4 | 15, argp_yoyomq},                                    // vmovlps (2110)
-	enc{[4]byte{0x1, 0x50, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 1190, 2<<4 | 15, argp_r0y0},                            // vmovmskpd (2111)
-	enc{[4]byte{0x1, 0x50, 0x00, 0x00}, VEX_OP, AVX, 0<<11 | 1191, 2<<4 | 15, argp_r0y0},                                      // vmovmskps (2112)
-	enc{[4]byte{0x1, 0xe7, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, AVX, 0<<11 | 1192, 2<<4 | 15, argp_m0y0},       // vmovntdq (2113)
-	enc{[4]byte{0x2, 0x2a, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1193, 2<<4 | 15, argp_y0m0},                // vmovntdqa (2114)
-	enc{[4]byte{0x1, 0x2b, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, AVX, 0<<11 | 1194, 2<<4 | 15, argp_m0y0},       // vmovntpd (2115)
-	enc{[4]byte{0x1, 0x2b, 0x00, 0x00}, VEX_OP | AUTO_VEXL | ENC_MR, AVX, 0<<11 | 1195, 2<<4 | 15, argp_m0y0},                 // vmovntps (2116)
-	enc{[4]byte{0x1, 0xe7, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66 | ENC_MR, AVX, 0<<11 | 1196, 2<<4 | 15, argp_mhyh},       // vmovntqq (2117)
-	enc{[4]byte{0x1, 0xd6, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, AVX, 0<<11 | 1197, 2<<4 | 15, argp_mqyo},                   // vmovq (2118)
-	enc{[4]byte{0x1, 0x7e, 0x00, 0x00}, VEX_OP | PREF_F3, AVX, 1<<11 | 1197, 2<<4 | 15, argp_yomq},                            // vmovq (2119)
-	enc{[4]byte{0x1, 0x6e, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, AVX, 2<<11 | 1197, 2<<4 | 15, argp_yovq},                // vmovq (2120)
-	enc{[4]byte{0x1, 0x7e, 0x00, 0x00}, VEX_OP | PREF_F3, AVX, 3<<11 | 1197, 2<<4 | 15, argp_yoyo},                            // vmovq (2121)
-	enc{[4]byte{0x1, 0xd6, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, AVX, 4<<11 | 1197, 2<<4 | 15, argp_yoyo},                   // vmovq (2122)
-	enc{[4]byte{0x1, 0x7e, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66 | ENC_MR, AVX, 5<<11 | 1197, 2<<4 | 15, argp_vqyo},       // vmovq (2123)
-	enc{[4]byte{0x1, 0x6f, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66, AVX, 0<<11 | 1198, 2<<4 | 15, argp_yhwh},                // vmovqqa (2124)
-	enc{[4]byte{0x1, 0x7f, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66 | ENC_MR, AVX, 1<<11 | 1198, 2<<4 | 15, argp_whyh},       // vmovqqa (2125)
-	enc{[4]byte{0x1, 0x6f, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_F3, AVX, 0<<11 | 1199, 2<<4 | 15, argp_yhwh},                // vmovqqu (2126)
-	enc{[4]byte{0x1, 0x7f, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_F3 | ENC_MR, AVX, 1<<11 | 1199, 2<<4 | 15, argp_whyh},       // vmovqqu (2127)
-	enc{[4]byte{0x1, 0x11, 0x00, 0x00}, VEX_OP | PREF_F2 | ENC_MR, AVX, 0<<11 | 1200, 2<<4 | 15, argp_mqyo},                   // vmovsd (2128)
-	enc{[4]byte{0x1, 0x10, 0x00, 0x00}, VEX_OP | PREF_F2, AVX, 1<<11 | 1200, 2<<4 | 15, argp_yomq},                            // vmovsd (2129)
-	enc{[4]byte{0x1, 0x10, 0x00, 0x00}, VEX_OP | PREF_F2, AVX, 2<<11 | 1200, 2<<4 | 15, argp_yoyoyo},                          // vmovsd (2130)
-	enc{[4]byte{0x1, 0x11, 0x00, 0x00}, VEX_OP | PREF_F2 | ENC_VM, AVX, 3<<11 | 1200, 2<<4 | 15, argp_yoyoyo},                 // vmovsd (2131)
-	enc{[4]byte{0x1, 0x16, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F3, AVX, 0<<11 | 1201, 2<<4 | 15, argp_y0w0},                // vmovshdup (2132)
-	enc{[4]byte{0x1, 0x12, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F3, AVX, 0<<11 | 1202, 2<<4 | 15, argp_y0w0},                // vmovsldup (2133)
-	enc{[4]byte{0x1, 0x11, 0x00, 0x00}, VEX_OP | PREF_F3 | ENC_MR, AVX, 0<<11 | 1203, 2<<4 | 15, argp_mdyo},                   // vmovss (2134)
-	enc{[4]byte{0x1, 0x10, 0x00, 0x00}, VEX_OP | PREF_F3, AVX, 1<<11 | 1203, 2<<4 | 15, argp_yomd},                            // vmovss (2135)
-	enc{[4]byte{0x1, 0x10, 0x00, 0x00}, VEX_OP | PREF_F3, AVX, 2<<11 | 1203, 2<<4 | 15, argp_yoyoyo},                          // vmovss (2136)
-	enc{[4]byte{0x1, 0x11, 0x00, 0x00}, VEX_OP | PREF_F3 | ENC_VM, AVX, 3<<11 | 1203, 2<<4 | 15, argp_yoyoyo},                 // vmovss (2137)
-	enc{[4]byte{0x1, 0x10, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1204, 2<<4 | 15, argp_y0w0},                // vmovupd (2138)
-	enc{[4]byte{0x1, 0x11, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66 | ENC_MR, AVX, 1<<11 | 1204, 2<<4 | 15, argp_whyh},       // vmovupd (2139)
-	enc{[4]byte{0x1, 0x11, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, AVX, 2<<11 | 1204, 2<<4 | 15, argp_woyo},                   // vmovupd (2140)
-	enc{[4]byte{0x1, 0x10, 0x00, 0x00}, VEX_OP | AUTO_VEXL, AVX, 0<<11 | 1205, 2<<4 | 15, argp_y0w0},                          // vmovups (2141)
-	enc{[4]byte{0x1, 0x11, 0x00, 0x00}, VEX_OP | WITH_VEXL | ENC_MR, AVX, 1<<11 | 1205, 2<<4 | 15, argp_whyh},                 // vmovups (2142)
-	enc{[4]byte{0x1, 0x11, 0x00, 0x00}, VEX_OP | ENC_MR, AVX, 2<<11 | 1205, 2<<4 | 15, argp_woyo},                             // vmovups (2143)
-	enc{[4]byte{0x3, 0x42, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, AVX, 0<<11 | 1206, 2<<4 | 15, argp_y0y0w0ib},   // vmpsadbw (2144)
-	enc{[4]byte{0xf, 0xc7, 0x00, 0x00}, 0, VMX, 0<<11 | 1207, 2<<4 | 6, argp_m1},                                              // vmptrld (2145)
-	enc{[4]byte{0xf, 0xc7, 0x00, 0x00}, 0, VMX, 0<<11 | 1208, 2<<4 | 7, argp_m1},                                              // vmptrst (2146)
-	enc{[4]byte{0xf, 0x78, 0x00, 0x00}, ENC_MR, VMX, 0<<11 | 1209, 2<<4 | 15, argp_vqrq},                                      // vmread (2147)
-	enc{[4]byte{0xf, 0x1, 0xc3, 0x00}, 0, VMX, 0<<11 | 1210, 3<<4 | 15, argp_},                                                // vmresume (2148)
-	enc{[4]byte{0xf, 0x1, 0xd8, 0x00}, 0, VMX | AMD, 0<<11 | 1211, 3<<4 | 15, argp_},                                          // vmrun (2149)
-	enc{[4]byte{0xf, 0x1, 0xdb, 0x00}, 0, VMX | AMD, 0<<11 | 1212, 3<<4 | 15, argp_},                                          // vmsave (2150)
-	enc{[4]byte{0x1, 0x59, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1213, 2<<4 | 15, argp_y0y0w0},              // vmulpd (2151)
-	enc{[4]byte{0x1, 0x59, 0x00, 0x00}, VEX_OP | AUTO_VEXL, AVX, 0<<11 | 1214, 2<<4 | 15, argp_y0y0w0},                        // vmulps (2152)
-	enc{[4]byte{0x1, 0x59, 0x00, 0x00}, VEX_OP | PREF_F2, AVX, 0<<11 | 1215, 2<<4 | 15, argp_yoyomq},                          // vmulsd (2153)
-	enc{[4]byte{0x1, 0x59, 0x00, 0x00}, VEX_OP | PREF_F2, AVX, 1<<11 | 1215, 2<<4 | 15, argp_yoyoyo},                          // vmulsd (2154)
-	enc{[4]byte{0x1, 0x59, 0x00, 0x00}, VEX_OP | PREF_F3, AVX, 0<<11 | 1216, 2<<4 | 15, argp_yoyomd},                          // vmulss (2155)
-	enc{[4]byte{0x1, 0x59, 0x00, 0x00}, VEX_OP | PREF_F3, AVX, 1<<11 | 1216, 2<<4 | 15, argp_yoyoyo},                          // vmulss (2156)
-	enc{[4]byte{0xf, 0x79, 0x00, 0x00}, 0, VMX, 0<<11 | 1217, 2<<4 | 15, argp_rqvq},                                           // vmwrite (2157)
-	enc{[4]byte{0xf, 0x1, 0xc4, 0x00}, 0, VMX, 0<<11 | 1218, 3<<4 | 15, argp_},                                                // vmxoff (2158)
-	enc{[4]byte{0xf, 0xc7, 0x00, 0x00}, PREF_F3, VMX, 0<<11 | 1219, 2<<4 | 6, argp_m1},                                        // vmxon (2159)
-	enc{[4]byte{0x1, 0x56, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1220, 2<<4 | 15, argp_y0y0w0},              // vorpd (2160)
-	enc{[4]byte{0x1, 0x56, 0x00, 0x00}, VEX_OP | AUTO_VEXL, AVX, 0<<11 | 1221, 2<<4 | 15, argp_y0y0w0},                        // vorps (2161)
-	enc{[4]byte{0x2, 0x1c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1222, 2<<4 | 15, argp_y0w0},                // vpabsb (2162)
-	enc{[4]byte{0x2, 0x1e, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1223, 2<<4 | 15, argp_y0w0},                // vpabsd (2163)
-	enc{[4]byte{0x2, 0x1d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1224, 2<<4 | 15, argp_y0w0},                // vpabsw (2164)
-	enc{[4]byte{0x1, 0x6b, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1225, 2<<4 | 15, argp_y0y0w0},              // vpackssdw (2165)
-	enc{[4]byte{0x1, 0x63, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1226, 2<<4 | 15, argp_y0y0w0},              // vpacksswb (2166)
-	enc{[4]byte{0x2, 0x2b, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1227, 2<<4 | 15, argp_y0y0w0},              // vpackusdw (2167)
-	enc{[4]byte{0x1, 0x67, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1228, 2<<4 | 15, argp_y0y0w0},              // vpackuswb (2168)
-	enc{[4]byte{0x1, 0xfc, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1229, 2<<4 | 15, argp_y0y0w0},              // vpaddb (2169)
-	enc{[4]byte{0x1, 0xfe, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1230, 2<<4 | 15, argp_y0y0w0},              // vpaddd (2170)
-	enc{[4]byte{0x1, 0xd4, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1231, 2<<4 | 15, argp_y0y0w0},              // vpaddq (2171)
-	enc{[4]byte{0x1, 0xec, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1232, 2<<4 | 15, argp_y0y0w0},              // vpaddsb (2172)
-	enc{[4]byte{0x1, 0xed, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1233, 2<<4 | 15, argp_y0y0w0},              // vpaddsw (2173)
-	enc{[4]byte{0x1, 0xdc, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1234, 2<<4 | 15, argp_y0y0w0},              // vpaddusb (2174)
-	enc{[4]byte{0x1, 0xdd, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1235, 2<<4 | 15, argp_y0y0w0},              // vpaddusw (2175)
-	enc{[4]byte{0x1, 0xfd, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1236, 2<<4 | 15, argp_y0y0w0},              // vpaddw (2176)
-	enc{[4]byte{0x3, 0xf, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, AVX, 0<<11 | 1237, 2<<4 | 15, argp_y0y0w0ib},    // vpalignr (2177)
-	enc{[4]byte{0x1, 0xdb, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1238, 2<<4 | 15, argp_y0y0w0},              // vpand (2178)
-	enc{[4]byte{0x1, 0xdf, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1239, 2<<4 | 15, argp_y0y0w0},              // vpandn (2179)
-	enc{[4]byte{0x1, 0xe0, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1240, 2<<4 | 15, argp_y0y0w0},              // vpavgb (2180)
-	enc{[4]byte{0x1, 0xe3, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1241, 2<<4 | 15, argp_y0y0w0},              // vpavgw (2181)
-	enc{[4]byte{0x3, 0x2, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, AVX2, 0<<11 | 1242, 2<<4 | 15, argp_y0y0w0ib},   // vpblendd (2182)
-	enc{[4]byte{0x3, 0x4c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1243, 2<<4 | 15, argp_y0y0w0y0},            // vpblendvb (2183)
-	enc{[4]byte{0x3, 0xe, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, AVX, 0<<11 | 1244, 2<<4 | 15, argp_y0y0w0ib},    // vpblendw (2184)
-	enc{[4]byte{0x2, 0x78, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX2, 0<<11 | 1245, 2<<4 | 15, argp_y0mb},               // vpbroadcastb (2185)
-	enc{[4]byte{0x2, 0x78, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX2, 1<<11 | 1245, 2<<4 | 15, argp_y0yo},               // vpbroadcastb (2186)
-	enc{[4]byte{0x2, 0x58, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX2, 0<<11 | 1246, 2<<4 | 15, argp_y0md},               // vpbroadcastd (2187)
-	enc{[4]byte{0x2, 0x58, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX2, 1<<11 | 1246, 2<<4 | 15, argp_y0yo},               // vpbroadcastd (2188)
-	enc{[4]byte{0x2, 0x59, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66, AVX2, 0<<11 | 1247, 2<<4 | 15, argp_yhmq},               // vpbroadcastq (2189)
-	enc{[4]byte{0x2, 0x59, 0x00, 0x00}, VEX_OP | PREF_66, AVX2, 1<<11 | 1247, 2<<4 | 15, argp_yomq},                           // vpbroadcastq (2190)
-	enc{[4]byte{0x2, 0x59, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX2, 2<<11 | 1247, 2<<4 | 15, argp_y0yo},               // vpbroadcastq (2191)
-	enc{[4]byte{0x2, 0x79, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX2, 0<<11 | 1248, 2<<4 | 15, argp_y0mw},               // vpbroadcastw (2192)
-	enc{[4]byte{0x2, 0x79, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX2, 1<<11 | 1248, 2<<4 | 15, argp_y0yo},               // vpbroadcastw (2193)
-	enc{[4]byte{0x3, 0x44, 0x11, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 0<<11 | 1249, 3<<4 | 15, argp_yoyowo},                 // vpclmulhqhqdq (2194)
-	enc{[4]byte{0x3, 0x44, 0x1, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 0<<11 | 1250, 3<<4 | 15, argp_yoyowo},                  // vpclmulhqlqdq (2195)
-	enc{[4]byte{0x3, 0x44, 0x10, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 0<<11 | 1251, 3<<4 | 15, argp_yoyowo},                 // vpclmullqhqdq (2196)
-	enc{[4]byte{0x3, 0x44, 0x0, 0x00}, VEX_OP | IMM_OP | PREF_66, AVX, 0<<11 | 1252, 3<<4 | 15, argp_yoyowo},                  // vpclmullqlqdq (2197)
-	enc{[4]byte{0x3, 0x44, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 1253, 2<<4 | 15, argp_yoyowoib},                        // vpclmulqdq (2198)
-	enc{[4]byte{0x8, 0xa2, 0x00, 0x00}, XOP_OP | AUTO_VEXL, SSE5 | AMD, 0<<11 | 1254, 2<<4 | 15, argp_y0y0w0y0},               // vpcmov (2199)
-	enc{[4]byte{0x8, 0xa2, 0x00, 0x00}, XOP_OP | AUTO_VEXL, SSE5 | AMD, 1<<11 | 1254, 2<<4 | 15, argp_y0y0y0w0},               // vpcmov (2200)
-	enc{[4]byte{0x1, 0x74, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1255, 2<<4 | 15, argp_y0y0w0},              // vpcmpeqb (2201)
-	enc{[4]byte{0x1, 0x76, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1256, 2<<4 | 15, argp_y0y0w0},              // vpcmpeqd (2202)
-	enc{[4]byte{0x2, 0x29, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1257, 2<<4 | 15, argp_y0y0w0},              // vpcmpeqq (2203)
-	enc{[4]byte{0x1, 0x75, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1258, 2<<4 | 15, argp_y0y0w0},              // vpcmpeqw (2204)
-	enc{[4]byte{0x3, 0x61, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 1259, 2<<4 | 15, argp_yowoib},                          // vpcmpestri (2205)
-	enc{[4]byte{0x3, 0x60, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 1260, 2<<4 | 15, argp_yowoib},                          // vpcmpestrm (2206)
-	enc{[4]byte{0x1, 0x64, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1261, 2<<4 | 15, argp_y0y0w0},              // vpcmpgtb (2207)
-	enc{[4]byte{0x1, 0x66, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1262, 2<<4 | 15, argp_y0y0w0},              // vpcmpgtd (2208)
-	enc{[4]byte{0x2, 0x37, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1263, 2<<4 | 15, argp_y0y0w0},              // vpcmpgtq (2209)
-	enc{[4]byte{0x1, 0x65, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1264, 2<<4 | 15, argp_y0y0w0},              // vpcmpgtw (2210)
-	enc{[4]byte{0x3, 0x63, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 1265, 2<<4 | 15, argp_yowoib},                          // vpcmpistri (2211)
-	enc{[4]byte{0x3, 0x62, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 1266, 2<<4 | 15, argp_yowoib},                          // vpcmpistrm (2212)
-	enc{[4]byte{0x8, 0xcc, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1267, 2<<4 | 15, argp_yoyowoib},                           // vpcomb (2213)
-	enc{[4]byte{0x8, 0xce, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1268, 2<<4 | 15, argp_yoyowoib},                           // vpcomd (2214)
-	enc{[4]byte{0x8, 0xcf, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1269, 2<<4 | 15, argp_yoyowoib},                           // vpcomq (2215)
-	enc{[4]byte{0x8, 0xec, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1270, 2<<4 | 15, argp_yoyowoib},                           // vpcomub (2216)
-	enc{[4]byte{0x8, 0xee, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1271, 2<<4 | 15, argp_yoyowoib},                           // vpcomud (2217)
-	enc{[4]byte{0x8, 0xef, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1272, 2<<4 | 15, argp_yoyowoib},                           // vpcomuq (2218)
-	enc{[4]byte{0x8, 0xed, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1273, 2<<4 | 15, argp_yoyowoib},                           // vpcomuw (2219)
-	enc{[4]byte{0x8, 0xcd, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1274, 2<<4 | 15, argp_yoyowoib},                           // vpcomw (2220)
-	enc{[4]byte{0x3, 0x6, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66, AVX, 0<<11 | 1275, 2<<4 | 15, argp_yhyhwhib},             // vperm2f128 (2221)
-	enc{[4]byte{0x3, 0x46, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66, AVX2, 0<<11 | 1276, 2<<4 | 15, argp_yhyhwhib},           // vperm2i128 (2222)
-	enc{[4]byte{0x2, 0x36, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66, AVX2, 0<<11 | 1277, 2<<4 | 15, argp_yhyhwh},             // vpermd (2223)
-	enc{[4]byte{0x2, 0xd, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1278, 2<<4 | 15, argp_y0y0w0},               // vpermilpd (2224)
-	enc{[4]byte{0x3, 0x5, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 1278, 2<<4 | 15, argp_y0w0ib},               // vpermilpd (2225)
-	enc{[4]byte{0x2, 0xc, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1279, 2<<4 | 15, argp_y0y0w0},               // vpermilps (2226)
-	enc{[4]byte{0x3, 0x4, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 1279, 2<<4 | 15, argp_y0w0ib},               // vpermilps (2227)
-	enc{[4]byte{0x3, 0x1, 0x00, 0x00}, VEX_OP | WITH_REXW | WITH_VEXL | PREF_66, AVX2, 0<<11 | 1280, 2<<4 | 15, argp_yhwhib},  // vpermpd (2228)
-	enc{[4]byte{0x2, 0x16, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66, AVX2, 0<<11 | 1281, 2<<4 | 15, argp_yhyhwh},             // vpermps (2229)
-	enc{[4]byte{0x3, 0x0, 0x00, 0x00}, VEX_OP | WITH_REXW | WITH_VEXL | PREF_66, AVX2, 0<<11 | 1282, 2<<4 | 15, argp_yhwhib},  // vpermq (2230)
-	enc{[4]byte{0x3, 0x14, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, AVX, 0<<11 | 1283, 2<<4 | 15, argp_mbyoib},                 // vpextrb (2231)
-	enc{[4]byte{0x3, 0x14, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, AVX, 1<<11 | 1283, 2<<4 | 15, argp_rdyoib},                 // vpextrb (2232)
-	enc{[4]byte{0x3, 0x14, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, AVX, 2<<11 | 1283, 2<<4 | 15, argp_rqyoib},                 // vpextrb (2233)
-	enc{[4]byte{0x3, 0x16, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, AVX, 0<<11 | 1284, 2<<4 | 15, argp_rqyoib},                 // vpextrd (2234)
-	enc{[4]byte{0x3, 0x16, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, AVX, 1<<11 | 1284, 2<<4 | 15, argp_vdyoib},                 // vpextrd (2235)
-	enc{[4]byte{0x3, 0x16, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66 | ENC_MR, AVX, 0<<11 | 1285, 2<<4 | 15, argp_vqyoib},     // vpextrq (2236)
-	enc{[4]byte{0x3, 0x15, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, AVX, 0<<11 | 1286, 2<<4 | 15, argp_mwyoib},                 // vpextrw (2237)
-	enc{[4]byte{0x1, 0xc5, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 1<<11 | 1286, 2<<4 | 15, argp_rdyoib},                          // vpextrw (2238)
-	enc{[4]byte{0x3, 0x15, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, AVX, 2<<11 | 1286, 2<<4 | 15, argp_rdyoib},                 // vpextrw (2239)
-	enc{[4]byte{0x1, 0xc5, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 3<<11 | 1286, 2<<4 | 15, argp_rqyoib},                          // vpextrw (2240)
-	enc{[4]byte{0x3, 0x15, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, AVX, 4<<11 | 1286, 2<<4 | 15, argp_rqyoib},                 // vpextrw (2241)
-	enc{[4]byte{0x2, 0x90, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, AVX2, 0<<11 | 1287, 2<<4 | 15, argp_y0k0y0},    // vpgatherdd (2242)
-	enc{[4]byte{0x2, 0x90, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, AVX2, 0<<11 | 1288, 2<<4 | 15, argp_y0loy0},    // vpgatherdq (2243)
-	enc{[4]byte{0x2, 0x91, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, AVX2, 0<<11 | 1289, 2<<4 | 15, argp_yok0yo},    // vpgatherqd (2244)
-	enc{[4]byte{0x2, 0x91, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, AVX2, 0<<11 | 1290, 2<<4 | 15, argp_y0l0y0},    // vpgatherqq (2245)
-	enc{[4]byte{0x9, 0xc2, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1291, 2<<4 | 15, argp_yowo},                               // vphaddbd (2246)
-	enc{[4]byte{0x9, 0xc3, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1292, 2<<4 | 15, argp_yowo},                               // vphaddbq (2247)
-	enc{[4]byte{0x9, 0xc1, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1293, 2<<4 | 15, argp_yowo},                               // vphaddbw (2248)
-	enc{[4]byte{0x2, 0x2, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1294, 2<<4 | 15, argp_y0y0w0},               // vphaddd (2249)
-	enc{[4]byte{0x9, 0xcb, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1295, 2<<4 | 15, argp_yowo},                               // vphadddq (2250)
-	enc{[4]byte{0x2, 0x3, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1296, 2<<4 | 15, argp_y0y0w0},               // vphaddsw (2251)
-	enc{[4]byte{0x9, 0xd2, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1297, 2<<4 | 15, argp_yowo},                               // vphaddubd (2252)
-	enc{[4]byte{0x9, 0xd3, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1298, 2<<4 | 15, argp_yowo},                               // vphaddubq (2253)
-	enc{[4]byte{0x9, 0xd1, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1299, 2<<4 | 15, argp_yowo},                               // vphaddubw (2254)
-	enc{[4]byte{0x9, 0xdb, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1300, 2<<4 | 15, argp_yowo},                               // vphaddudq (2255)
-	enc{[4]byte{0x9, 0xd6, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1301, 2<<4 | 15, argp_yowo},                               // vphadduwd (2256)
-	enc{[4]byte{0x9, 0xd7, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1302, 2<<4 | 15, argp_yowo},                               // vphadduwq (2257)
-	enc{[4]byte{0x2, 0x1, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1303, 2<<4 | 15, argp_y0y0w0},               // vphaddw (2258)
-	enc{[4]byte{0x9, 0xc6, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1304, 2<<4 | 15, argp_yowo},                               // vphaddwd (2259)
-	enc{[4]byte{0x9, 0xc7, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1305, 2<<4 | 15, argp_yowo},                               // vphaddwq (2260)
-	enc{[4]byte{0x2, 0x41, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 1306, 2<<4 | 15, argp_yowo},                            // vphminposuw (2261)
-	enc{[4]byte{0x9, 0xe1, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1307, 2<<4 | 15, argp_yowo},                               // vphsubbw (2262)
-	enc{[4]byte{0x2, 0x6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1308, 2<<4 | 15, argp_y0y0w0},               // vphsubd (2263)
-	enc{[4]byte{0x9, 0xe3, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1309, 2<<4 | 15, argp_yowo},                               // vphsubdq (2264)
-	enc{[4]byte{0x2, 0x7, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1310, 2<<4 | 15, argp_y0y0w0},               // vphsubsw (2265)
-	enc{[4]byte{0x2, 0x5, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1311, 2<<4 | 15, argp_y0y0w0},               // vphsubw (2266)
-	enc{[4]byte{0x9, 0xe2, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1312, 2<<4 | 15, argp_yowo},                               // vphsubwd (2267)
-	enc{[4]byte{0x3, 0x20, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 1313, 2<<4 | 15, argp_yoyordib},                        // vpinsrb (2268)
-	enc{[4]byte{0x3, 0x20, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 1<<11 | 1313, 2<<4 | 15, argp_yoyovbib},                        // vpinsrb (2269)
-	enc{[4]byte{0x3, 0x22, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 1314, 2<<4 | 15, argp_yoyovdib},                        // vpinsrd (2270)
-	enc{[4]byte{0x3, 0x22, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, AVX, 0<<11 | 1315, 2<<4 | 15, argp_yoyovqib},            // vpinsrq (2271)
-	enc{[4]byte{0x1, 0xc4, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 1316, 2<<4 | 15, argp_yoyordib},                        // vpinsrw (2272)
-	enc{[4]byte{0x1, 0xc4, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 1<<11 | 1316, 2<<4 | 15, argp_yoyovwib},                        // vpinsrw (2273)
-	enc{[4]byte{0x8, 0x9e, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1317, 2<<4 | 15, argp_yoyowoyo},                           // vpmacsdd (2274)
-	enc{[4]byte{0x8, 0x9f, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1318, 2<<4 | 15, argp_yoyowoyo},                           // vpmacsdqh (2275)
-	enc{[4]byte{0x8, 0x97, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1319, 2<<4 | 15, argp_yoyowoyo},                           // vpmacsdql (2276)
-	enc{[4]byte{0x8, 0x8e, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1320, 2<<4 | 15, argp_yoyowoyo},                           // vpmacssdd (2277)
-	enc{[4]byte{0x8, 0x8f, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1321, 2<<4 | 15, argp_yoyowoyo},                           // vpmacssdqh (2278)
-	enc{[4]byte{0x8, 0x87, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1322, 2<<4 | 15, argp_yoyowoyo},                           // vpmacssdql (2279)
-	enc{[4]byte{0x8, 0x86, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1323, 2<<4 | 15, argp_yoyowoyo},                           // vpmacsswd (2280)
-	enc{[4]byte{0x8, 0x85, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1324, 2<<4 | 15, argp_yoyowoyo},                           // vpmacssww (2281)
-	enc{[4]byte{0x8, 0x96, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1325, 2<<4 | 15, argp_yoyowoyo},                           // vpmacswd (2282)
-	enc{[4]byte{0x8, 0x95, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1326, 2<<4 | 15, argp_yoyowoyo},                           // vpmacsww (2283)
-	enc{[4]byte{0x8, 0xa6, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1327, 2<<4 | 15, argp_yoyowoyo},                           // vpmadcsswd (2284)
-	enc{[4]byte{0x8, 0xb6, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1328, 2<<4 | 15, argp_yoyowoyo},                           // vpmadcswd (2285)
-	enc{[4]byte{0x2, 0x4, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1329, 2<<4 | 15, argp_y0y0w0},               // vpmaddubsw (2286)
-	enc{[4]byte{0x1, 0xf5, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1330, 2<<4 | 15, argp_y0y0w0},              // vpmaddwd (2287)
-	enc{[4]byte{0x2, 0x8e, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_VM, AVX2, 0<<11 | 1331, 2<<4 | 15, argp_m0y0y0},    // vpmaskmovd (2288)
-	enc{[4]byte{0x2, 0x8c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX2, 1<<11 | 1331, 2<<4 | 15, argp_y0y0m0},             // vpmaskmovd (2289)
-	enc{[4]byte{0x2, 0x8e, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_VM, AVX2, 0<<11 | 1332, 2<<4 | 15, argp_m0y0y0},    // vpmaskmovq (2290)
-	enc{[4]byte{0x2, 0x8c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX2, 1<<11 | 1332, 2<<4 | 15, argp_y0y0m0},             // vpmaskmovq (2291)
-	enc{[4]byte{0x2, 0x3c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1333, 2<<4 | 15, argp_y0y0w0},              // vpmaxsb (2292)
-	enc{[4]byte{0x2, 0x3d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1334, 2<<4 | 15, argp_y0y0w0},              // vpmaxsd (2293)
-	enc{[4]byte{0x1, 0xee, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1335, 2<<4 | 15, argp_y0y0w0},              // vpmaxsw (2294)
-	enc{[4]byte{0x1, 0xde, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1336, 2<<4 | 15, argp_y0y0w0},              // vpmaxub (2295)
-	enc{[4]byte{0x2, 0x3f, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1337, 2<<4 | 15, argp_y0y0w0},              // vpmaxud (2296)
-	enc{[4]byte{0x2, 0x3e, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1338, 2<<4 | 15, argp_y0y0w0},              // vpmaxuw (2297)
-	enc{[4]byte{0x2, 0x38, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1339, 2<<4 | 15, argp_y0y0w0},              // vpminsb (2298)
-	enc{[4]byte{0x2, 0x39, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1340, 2<<4 | 15, argp_y0y0w0},              // vpminsd (2299)
-	enc{[4]byte{0x1, 0xea, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1341, 2<<4 | 15, argp_y0y0w0},              // vpminsw (2300)
-	enc{[4]byte{0x1, 0xda, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1342, 2<<4 | 15, argp_y0y0w0},              // vpminub (2301)
-	enc{[4]byte{0x2, 0x3b, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1343, 2<<4 | 15, argp_y0y0w0},              // vpminud (2302)
-	enc{[4]byte{0x2, 0x3a, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1344, 2<<4 | 15, argp_y0y0w0},              // vpminuw (2303)
-	enc{[4]byte{0x1, 0xd7, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 1345, 2<<4 | 15, argp_r0y0},                            // vpmovmskb (2304)
-	enc{[4]byte{0x2, 0x21, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 1346, 2<<4 | 15, argp_yomd},                            // vpmovsxbd (2305)
-	enc{[4]byte{0x2, 0x21, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 1346, 2<<4 | 15, argp_y0yo},                // vpmovsxbd (2306)
-	enc{[4]byte{0x2, 0x22, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1347, 2<<4 | 15, argp_y0mw},                // vpmovsxbq (2307)
-	enc{[4]byte{0x2, 0x22, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 1347, 2<<4 | 15, argp_y0yo},                // vpmovsxbq (2308)
-	enc{[4]byte{0x2, 0x20, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 1348, 2<<4 | 15, argp_yomq},                            // vpmovsxbw (2309)
-	enc{[4]byte{0x2, 0x20, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 1348, 2<<4 | 15, argp_y0wo},                // vpmovsxbw (2310)
-	enc{[4]byte{0x2, 0x25, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 1349, 2<<4 | 15, argp_yomq},                            // vpmovsxdq (2311)
-	enc{[4]byte{0x2, 0x25, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 1349, 2<<4 | 15, argp_y0wo},                // vpmovsxdq (2312)
-	enc{[4]byte{0x2, 0x23, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 1350, 2<<4 | 15, argp_yomq},                            // vpmovsxwd (2313)
-	enc{[4]byte{0x2, 0x23, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 1350, 2<<4 | 15, argp_y0wo},                // vpmovsxwd (2314)
-	enc{[4]byte{0x2, 0x24, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 1351, 2<<4 | 15, argp_yomd},                            // vpmovsxwq (2315)
-	enc{[4]byte{0x2, 0x24, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 1351, 2<<4 | 15, argp_y0yo},                // vpmovsxwq (2316)
-	enc{[4]byte{0x2, 0x31, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 1352, 2<<4 | 15, argp_yomd},                            // vpmovzxbd (2317)
-	enc{[4]byte{0x2, 0x31, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 1352, 2<<4 | 15, argp_y0yo},                // vpmovzxbd (2318)
-	enc{[4]byte{0x2, 0x32, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1353, 2<<4 | 15, argp_y0mw},                // vpmovzxbq (2319)
-	enc{[4]byte{0x2, 0x32, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 1353, 2<<4 | 15, argp_y0yo},                // vpmovzxbq (2320)
-	enc{[4]byte{0x2, 0x30, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 1354, 2<<4 | 15, argp_yomq},                            // vpmovzxbw (2321)
-	enc{[4]byte{0x2, 0x30, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 1354, 2<<4 | 15, argp_y0wo},                // vpmovzxbw (2322)
-	enc{[4]byte{0x2, 0x35, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 1355, 2<<4 | 15, argp_yomq},                            // vpmovzxdq (2323)
-	enc{[4]byte{0x2, 0x35, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 1355, 2<<4 | 15, argp_y0wo},                // vpmovzxdq (2324)
-	enc{[4]byte{0x2, 0x33, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 1356, 2<<4 | 15, argp_yomq},                            // vpmovzxwd (2325)
-	enc{[4]byte{0x2, 0x33, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 1356, 2<<4 | 15, argp_y0wo},                // vpmovzxwd (2326)
-	enc{[4]byte{0x2, 0x34, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 1357, 2<<4 | 15, argp_yomd},                            // vpmovzxwq (2327)
-	enc{[4]byte{0x2, 0x34, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 1357, 2<<4 | 15, argp_y0yo},                // vpmovzxwq (2328)
-	enc{[4]byte{0x2, 0x28, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1358, 2<<4 | 15, argp_y0y0w0},              // vpmuldq (2329)
-	enc{[4]byte{0x2, 0xb, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1359, 2<<4 | 15, argp_y0y0w0},               // vpmulhrsw (2330)
-	enc{[4]byte{0x1, 0xe4, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1360, 2<<4 | 15, argp_y0y0w0},              // vpmulhuw (2331)
-	enc{[4]byte{0x1, 0xe5, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1361, 2<<4 | 15, argp_y0y0w0},              // vpmulhw (2332)
-	enc{[4]byte{0x2, 0x40, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1362, 2<<4 | 15, argp_y0y0w0},              // vpmulld (2333)
-	enc{[4]byte{0x1, 0xd5, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1363, 2<<4 | 15, argp_y0y0w0},              // vpmullw (2334)
-	enc{[4]byte{0x1, 0xf4, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1364, 2<<4 | 15, argp_y0y0w0},              // vpmuludq (2335)
-	enc{[4]byte{0x1, 0xeb, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1365, 2<<4 | 15, argp_y0y0w0},              // vpor (2336)
-	enc{[4]byte{0x8, 0xa3, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1366, 2<<4 | 15, argp_yoyowoyo},                           // vpperm (2337)
-	enc{[4]byte{0x8, 0xa3, 0x00, 0x00}, XOP_OP | WITH_REXW, SSE5 | AMD, 1<<11 | 1366, 2<<4 | 15, argp_yoyoyowo},               // vpperm (2338)
-	enc{[4]byte{0x8, 0xc0, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1367, 2<<4 | 15, argp_yowoib},                             // vprotb (2339)
-	enc{[4]byte{0x9, 0x90, 0x00, 0x00}, XOP_OP | ENC_MR, SSE5 | AMD, 1<<11 | 1367, 2<<4 | 15, argp_yowoyo},                    // vprotb (2340)
-	enc{[4]byte{0x9, 0x90, 0x00, 0x00}, XOP_OP | WITH_REXW, SSE5 | AMD, 2<<11 | 1367, 2<<4 | 15, argp_yoyowo},                 // vprotb (2341)
-	enc{[4]byte{0x8, 0xc2, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1368, 2<<4 | 15, argp_yowoib},                             // vprotd (2342)
-	enc{[4]byte{0x9, 0x92, 0x00, 0x00}, XOP_OP | ENC_MR, SSE5 | AMD, 1<<11 | 1368, 2<<4 | 15, argp_yowoyo},                    // vprotd (2343)
-	enc{[4]byte{0x9, 0x92, 0x00, 0x00}, XOP_OP | WITH_REXW, SSE5 | AMD, 2<<11 | 1368, 2<<4 | 15, argp_yoyowo},                 // vprotd (2344)
-	enc{[4]byte{0x8, 0xc3, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1369, 2<<4 | 15, argp_yowoib},                             // vprotq (2345)
-	enc{[4]byte{0x9, 0x93, 0x00, 0x00}, XOP_OP | ENC_MR, SSE5 | AMD, 1<<11 | 1369, 2<<4 | 15, argp_yowoyo},                    // vprotq (2346)
-	enc{[4]byte{0x9, 0x93, 0x00, 0x00}, XOP_OP | WITH_REXW, SSE5 | AMD, 2<<11 | 1369, 2<<4 | 15, argp_yoyowo},                 // vprotq (2347)
-	enc{[4]byte{0x8, 0xc1, 0x00, 0x00}, XOP_OP, SSE5 | AMD, 0<<11 | 1370, 2<<4 | 15, argp_yowoib},                             // vprotw (2348)
-	enc{[4]byte{0x9, 0x91, 0x00, 0x00}, XOP_OP | ENC_MR, SSE5 | AMD, 1<<11 | 1370, 2<<4 | 15, argp_yowoyo},                    // vprotw (2349)
-	enc{[4]byte{0x9, 0x91, 0x00, 0x00}, XOP_OP | WITH_REXW, SSE5 | AMD, 2<<11 | 1370, 2<<4 | 15, argp_yoyowo},                 // vprotw (2350)
-	enc{[4]byte{0x1, 0xf6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1371, 2<<4 | 15, argp_y0y0w0},              // vpsadbw (2351)
-	enc{[4]byte{0x9, 0x98, 0x00, 0x00}, XOP_OP | ENC_MR, SSE5 | AMD, 0<<11 | 1372, 2<<4 | 15, argp_yowoyo},                    // vpshab (2352)
-	enc{[4]byte{0x9, 0x98, 0x00, 0x00}, XOP_OP | WITH_REXW, SSE5 | AMD, 1<<11 | 1372, 2<<4 | 15, argp_yoyowo},                 // vpshab (2353)
-	enc{[4]byte{0x9, 0x9a, 0x00, 0x00}, XOP_OP | ENC_MR, SSE5 | AMD, 0<<11 | 1373, 2<<4 | 15, argp_yowoyo},                    // vpshad (2354)
-	enc{[4]byte{0x9, 0x9a, 0x00, 0x00}, XOP_OP | WITH_REXW, SSE5 | AMD, 1<<11 | 1373, 2<<4 | 15, argp_yoyowo},                 // vpshad (2355)
-	enc{[4]byte{0x9, 0x9b, 0x00, 0x00}, XOP_OP | ENC_MR, SSE5 | AMD, 0<<11 | 1374, 2<<4 | 15, argp_yowoyo},                    // vpshaq (2356)
-	enc{[4]byte{0x9, 0x9b, 0x00, 0x00}, XOP_OP | WITH_REXW, SSE5 | AMD, 1<<11 | 1374, 2<<4 | 15, argp_yoyowo},                 // vpshaq (2357)
-	enc{[4]byte{0x9, 0x99, 0x00, 0x00}, XOP_OP | ENC_MR, SSE5 | AMD, 0<<11 | 1375, 2<<4 | 15, argp_yowoyo},                    // vpshaw (2358)
-	enc{[4]byte{0x9, 0x99, 0x00, 0x00}, XOP_OP | WITH_REXW, SSE5 | AMD, 1<<11 | 1375, 2<<4 | 15, argp_yoyowo},                 // vpshaw (2359)
-	enc{[4]byte{0x9, 0x94, 0x00, 0x00}, XOP_OP | ENC_MR, SSE5 | AMD, 0<<11 | 1376, 2<<4 | 15, argp_yowoyo},                    // vpshlb (2360)
-	enc{[4]byte{0x9, 0x94, 0x00, 0x00}, XOP_OP | WITH_REXW, SSE5 | AMD, 1<<11 | 1376, 2<<4 | 15, argp_yoyowo},                 // vpshlb (2361)
-	enc{[4]byte{0x9, 0x96, 0x00, 0x00}, XOP_OP | ENC_MR, SSE5 | AMD, 0<<11 | 1377, 2<<4 | 15, argp_yowoyo},                    // vpshld (2362)
-	enc{[4]byte{0x9, 0x96, 0x00, 0x00}, XOP_OP | WITH_REXW, SSE5 | AMD, 1<<11 | 1377, 2<<4 | 15, argp_yoyowo},                 // vpshld (2363)
-	enc{[4]byte{0x9, 0x97, 0x00, 0x00}, XOP_OP | ENC_MR, SSE5 | AMD, 0<<11 | 1378, 2<<4 | 15, argp_yowoyo},                    // vpshlq (2364)
-	enc{[4]byte{0x9, 0x97, 0x00, 0x00}, XOP_OP | WITH_REXW, SSE5 | AMD, 1<<11 | 1378, 2<<4 | 15, argp_yoyowo},                 // vpshlq (2365)
-	enc{[4]byte{0x9, 0x95, 0x00, 0x00}, XOP_OP | ENC_MR, SSE5 | AMD, 0<<11 | 1379, 2<<4 | 15, argp_yowoyo},                    // vpshlw (2366)
-	enc{[4]byte{0x9, 0x95, 0x00, 0x00}, XOP_OP | WITH_REXW, SSE5 | AMD, 1<<11 | 1379, 2<<4 | 15, argp_yoyowo},                 // vpshlw (2367)
-	enc{[4]byte{0x2, 0x0, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1380, 2<<4 | 15, argp_y0y0w0},               // vpshufb (2368)
-	enc{[4]byte{0x1, 0x70, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1381, 2<<4 | 15, argp_y0w0ib},              // vpshufd (2369)
-	enc{[4]byte{0x1, 0x70, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F3, AVX, 0<<11 | 1382, 2<<4 | 15, argp_y0w0ib},              // vpshufhw (2370)
-	enc{[4]byte{0x1, 0x70, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F2, AVX, 0<<11 | 1383, 2<<4 | 15, argp_y0w0ib},              // vpshuflw (2371)
-	enc{[4]byte{0x2, 0x8, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1384, 2<<4 | 15, argp_y0y0w0},               // vpsignb (2372)
-	enc{[4]byte{0x2, 0xa, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1385, 2<<4 | 15, argp_y0y0w0},               // vpsignd (2373)
-	enc{[4]byte{0x2, 0x9, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1386, 2<<4 | 15, argp_y0y0w0},               // vpsignw (2374)
-	enc{[4]byte{0x1, 0x72, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_VM, AVX, 0<<11 | 1387, 2<<4 | 6, argp_y0y0ib},      // vpslld (2375)
-	enc{[4]byte{0x1, 0xf2, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 1387, 2<<4 | 15, argp_y0y0wo},              // vpslld (2376)
-	enc{[4]byte{0x1, 0x73, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_VM, AVX, 0<<11 | 1388, 2<<4 | 7, argp_y0y0ib},      // vpslldq (2377)
-	enc{[4]byte{0x1, 0x73, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_VM, AVX, 0<<11 | 1389, 2<<4 | 6, argp_y0y0ib},      // vpsllq (2378)
-	enc{[4]byte{0x1, 0xf3, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 1389, 2<<4 | 15, argp_y0y0wo},              // vpsllq (2379)
-	enc{[4]byte{0x2, 0x47, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX2, 0<<11 | 1390, 2<<4 | 15, argp_y0y0w0},             // vpsllvd (2380)
-	enc{[4]byte{0x2, 0x47, 0x00, 0x00}, VEX_OP | AUTO_VEXL | WITH_REXW | PREF_66, AVX2, 0<<11 | 1391, 2<<4 | 15, argp_y0y0w0}, // vpsllvq (2381)
-	enc{[4]byte{0x1, 0x71, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_VM, AVX, 0<<11 | 1392, 2<<4 | 6, argp_y0y0ib},      // vpsllw (2382)
-	enc{[4]byte{0x1, 0xf1, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 1392, 2<<4 | 15, argp_y0y0wo},              // vpsllw (2383)
-	enc{[4]byte{0x1, 0x72, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_VM, AVX, 0<<11 | 1393, 2<<4 | 4, argp_y0y0ib},      // vpsrad (2384)
-	enc{[4]byte{0x1, 0xe2, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 1393, 2<<4 | 15, argp_y0y0wo},              // vpsrad (2385)
-	enc{[4]byte{0x2, 0x46, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX2, 0<<11 | 1394, 2<<4 | 15, argp_y0y0w0},             // vpsravd (2386)
-	enc{[4]byte{0x1, 0x71, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_VM, AVX, 0<<11 | 1395, 2<<4 | 4, argp_y0y0ib},      // vpsraw (2387)
-	enc{[4]byte{0x1, 0xe1, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 1395, 2<<4 | 15, argp_y0y0wo},              // vpsraw (2388)
-	enc{[4]byte{0x1, 0x72, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_VM, AVX, 0<<11 | 1396, 2<<4 | 2, argp_y0y0ib},      // vpsrld (2389)
-	enc{[4]byte{0x1, 0xd2, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 1396, 2<<4 | 15, argp_y0y0wo},              // vpsrld (2390)
-	enc{[4]byte{0x1, 0x73, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_VM, AVX, 0<<11 | 1397, 2<<4 | 3, argp_y0y0ib},      // vpsrldq (2391)
-	enc{[4]byte{0x1, 0x73, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_VM, AVX, 0<<11 | 1398, 2<<4 | 2, argp_y0y0ib},                  // vpsrlq (2392)
-	enc{[4]byte{0x1, 0xd3, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 1398, 2<<4 | 15, argp_y0y0wo},              // vpsrlq (2393)
-	enc{[4]byte{0x2, 0x45, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX2, 0<<11 | 1399, 2<<4 | 15, argp_y0y0w0},             // vpsrlvd (2394)
-	enc{[4]byte{0x2, 0x45, 0x00, 0x00}, VEX_OP | AUTO_VEXL | WITH_REXW | PREF_66, AVX2, 0<<11 | 1400, 2<<4 | 15, argp_y0y0w0}, // vpsrlvq (2395)
-	enc{[4]byte{0x1, 0x71, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_VM, AVX, 0<<11 | 1401, 2<<4 | 2, argp_y0y0ib},      // vpsrlw (2396)
-	enc{[4]byte{0x1, 0xd1, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 1<<11 | 1401, 2<<4 | 15, argp_y0y0wo},              // vpsrlw (2397)
-	enc{[4]byte{0x1, 0xf8, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1402, 2<<4 | 15, argp_y0y0w0},              // vpsubb (2398)
-	enc{[4]byte{0x1, 0xfa, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1403, 2<<4 | 15, argp_y0y0w0},              // vpsubd (2399)
-	enc{[4]byte{0x1, 0xfb, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1404, 2<<4 | 15, argp_y0y0w0},              // vpsubq (2400)
-	enc{[4]byte{0x1, 0xe8, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1405, 2<<4 | 15, argp_y0y0w0},              // vpsubsb (2401)
-	enc{[4]byte{0x1, 0xe9, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1406, 2<<4 | 15, argp_y0y0w0},              // vpsubsw (2402)
-	enc{[4]byte{0x1, 0xd8, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1407, 2<<4 | 15, argp_y0y0w0},              // vpsubusb (2403)
-	enc{[4]byte{0x1, 0xd9, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1408, 2<<4 | 15, argp_y0y0w0},              // vpsubusw (2404)
-	enc{[4]byte{0x1, 0xf9, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1409, 2<<4 | 15, argp_y0y0w0},              // vpsubw (2405)
-	enc{[4]byte{0x2, 0x17, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1410, 2<<4 | 15, argp_y0w0},                // vptest (2406)
-	enc{[4]byte{0x1, 0x68, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1411, 2<<4 | 15, argp_y0y0w0},              // vpunpckhbw (2407)
-	enc{[4]byte{0x1, 0x6a, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1412, 2<<4 | 15, argp_y0y0w0},              // vpunpckhdq (2408)
-	enc{[4]byte{0x1, 0x6d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1413, 2<<4 | 15, argp_y0y0w0},              // vpunpckhqdq (2409)
-	enc{[4]byte{0x1, 0x69, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1414, 2<<4 | 15, argp_y0y0w0},              // vpunpckhwd (2410)
-	enc{[4]byte{0x1, 0x60, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1415, 2<<4 | 15, argp_y0y0w0},              // vpunpcklbw (2411)
-	enc{[4]byte{0x1, 0x62, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1416, 2<<4 | 15, argp_y0y0w0},              // vpunpckldq (2412)
-	enc{[4]byte{0x1, 0x6c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1417, 2<<4 | 15, argp_y0y0w0},              // vpunpcklqdq (2413)
-	enc{[4]byte{0x1, 0x61, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1418, 2<<4 | 15, argp_y0y0w0},              // vpunpcklwd (2414)
-	enc{[4]byte{0x1, 0xef, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1419, 2<<4 | 15, argp_y0y0w0},              // vpxor (2415)
-	enc{[4]byte{0x1, 0x53, 0x00, 0x00}, VEX_OP | AUTO_VEXL, AVX, 0<<11 | 1420, 2<<4 | 15, argp_y0w0},                          // vrcpps (2416)
-	enc{[4]byte{0x1, 0x53, 0x00, 0x00}, VEX_OP | PREF_F3, AVX, 0<<11 | 1421, 2<<4 | 15, argp_yoyomd},                          // vrcpss (2417)
-	enc{[4]byte{0x1, 0x53, 0x00, 0x00}, VEX_OP | PREF_F3, AVX, 1<<11 | 1421, 2<<4 | 15, argp_yoyoyo},                          // vrcpss (2418)
-	enc{[4]byte{0x3, 0x9, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1422, 2<<4 | 15, argp_y0w0ib},               // vroundpd (2419)
-	enc{[4]byte{0x3, 0x8, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1423, 2<<4 | 15, argp_y0w0ib},               // vroundps (2420)
-	enc{[4]byte{0x3, 0xb, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 1424, 2<<4 | 15, argp_yoyomqib},                         // vroundsd (2421)
-	enc{[4]byte{0x3, 0xb, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 1<<11 | 1424, 2<<4 | 15, argp_yoyoyoib},                         // vroundsd (2422)
-	enc{[4]byte{0x3, 0xa, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 1425, 2<<4 | 15, argp_yoyomdib},                         // vroundss (2423)
-	enc{[4]byte{0x3, 0xa, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 1<<11 | 1425, 2<<4 | 15, argp_yoyoyoib},                         // vroundss (2424)
-	enc{[4]byte{0x1, 0x52, 0x00, 0x00}, VEX_OP | AUTO_VEXL, AVX, 0<<11 | 1426, 2<<4 | 15, argp_y0w0},                          // vrsqrtps (2425)
-	enc{[4]byte{0x1, 0x52, 0x00, 0x00}, VEX_OP | PREF_F3, AVX, 0<<11 | 1427, 2<<4 | 15, argp_yoyomd},                          // vrsqrtss (2426)
-	enc{[4]byte{0x1, 0x52, 0x00, 0x00}, VEX_OP | PREF_F3, AVX, 1<<11 | 1427, 2<<4 | 15, argp_yoyoyo},                          // vrsqrtss (2427)
-	enc{[4]byte{0x1, 0xc6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, AVX, 0<<11 | 1428, 2<<4 | 15, argp_y0y0w0ib},   // vshufpd (2428)
-	enc{[4]byte{0x1, 0xc6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | ENC_MR, AVX, 0<<11 | 1429, 2<<4 | 15, argp_y0y0w0ib},             // vshufps (2429)
-	enc{[4]byte{0x1, 0x51, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1430, 2<<4 | 15, argp_y0w0},                // vsqrtpd (2430)
-	enc{[4]byte{0x1, 0x51, 0x00, 0x00}, VEX_OP | AUTO_VEXL, AVX, 0<<11 | 1431, 2<<4 | 15, argp_y0w0},                          // vsqrtps (2431)
-	enc{[4]byte{0x1, 0x51, 0x00, 0x00}, VEX_OP | PREF_F2, AVX, 0<<11 | 1432, 2<<4 | 15, argp_yoyomq},                          // vsqrtsd (2432)
-	enc{[4]byte{0x1, 0x51, 0x00, 0x00}, VEX_OP | PREF_F2, AVX, 1<<11 | 1432, 2<<4 | 15, argp_yoyoyo},                          // vsqrtsd (2433)
-	enc{[4]byte{0x1, 0x51, 0x00, 0x00}, VEX_OP | PREF_F3, AVX, 0<<11 | 1433, 2<<4 | 15, argp_yoyomd},                          // vsqrtss (2434)
-	enc{[4]byte{0x1, 0x51, 0x00, 0x00}, VEX_OP | PREF_F3, AVX, 1<<11 | 1433, 2<<4 | 15, argp_yoyoyo},                          // vsqrtss (2435)
-	enc{[4]byte{0x1, 0xae, 0x00, 0x00}, VEX_OP, AVX, 0<<11 | 1434, 2<<4 | 3, argp_md},                                         // vstmxcsr (2436)
-	enc{[4]byte{0x1, 0x5c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1435, 2<<4 | 15, argp_y0y0w0},              // vsubpd (2437)
-	enc{[4]byte{0x1, 0x5c, 0x00, 0x00}, VEX_OP | AUTO_VEXL, AVX, 0<<11 | 1436, 2<<4 | 15, argp_y0y0w0},                        // vsubps (2438)
-	enc{[4]byte{0x1, 0x5c, 0x00, 0x00}, VEX_OP | PREF_F2, AVX, 0<<11 | 1437, 2<<4 | 15, argp_yoyomq},                          // vsubsd (2439)
-	enc{[4]byte{0x1, 0x5c, 0x00, 0x00}, VEX_OP | PREF_F2, AVX, 1<<11 | 1437, 2<<4 | 15, argp_yoyoyo},                          // vsubsd (2440)
-	enc{[4]byte{0x1, 0x5c, 0x00, 0x00}, VEX_OP | PREF_F3, AVX, 0<<11 | 1438, 2<<4 | 15, argp_yoyomd},                          // vsubss (2441)
-	enc{[4]byte{0x1, 0x5c, 0x00, 0x00}, VEX_OP | PREF_F3, AVX, 1<<11 | 1438, 2<<4 | 15, argp_yoyoyo},                          // vsubss (2442)
-	enc{[4]byte{0x2, 0xf, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1439, 2<<4 | 15, argp_y0w0},                 // vtestpd (2443)
-	enc{[4]byte{0x2, 0xe, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1440, 2<<4 | 15, argp_y0w0},                 // vtestps (2444)
-	enc{[4]byte{0x1, 0x2e, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 0<<11 | 1441, 2<<4 | 15, argp_yomq},                            // vucomisd (2445)
-	enc{[4]byte{0x1, 0x2e, 0x00, 0x00}, VEX_OP | PREF_66, AVX, 1<<11 | 1441, 2<<4 | 15, argp_yoyo},                            // vucomisd (2446)
-	enc{[4]byte{0x1, 0x2e, 0x00, 0x00}, VEX_OP, AVX, 0<<11 | 1442, 2<<4 | 15, argp_yomd},                                      // vucomiss (2447)
-	enc{[4]byte{0x1, 0x2e, 0x00, 0x00}, VEX_OP, AVX, 1<<11 | 1442, 2<<4 | 15, argp_yoyo},                                      // vucomiss (2448)
-	enc{[4]byte{0x1, 0x15, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1443, 2<<4 | 15, argp_y0y0w0},              // vunpckhpd (2449)
-	enc{[4]byte{0x1, 0x15, 0x00, 0x00}, VEX_OP | AUTO_VEXL, AVX, 0<<11 | 1444, 2<<4 | 15, argp_y0y0w0},                        // vunpckhps (2450)
-	enc{[4]byte{0x1, 0x14, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1445, 2<<4 | 15, argp_y0y0w0},              // vunpcklpd (2451)
-	enc{[4]byte{0x1, 0x14, 0x00, 0x00}, VEX_OP | AUTO_VEXL, AVX, 0<<11 | 1446, 2<<4 | 15, argp_y0y0w0},                        // vunpcklps (2452)
-	enc{[4]byte{0x1, 0x57, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, AVX, 0<<11 | 1447, 2<<4 | 15, argp_y0y0w0},              // vxorpd (2453)
-	enc{[4]byte{0x1, 0x57, 0x00, 0x00}, VEX_OP | AUTO_VEXL, AVX, 0<<11 | 1448, 2<<4 | 15, argp_y0y0w0},                        // vxorps (2454)
-	enc{[4]byte{0x1, 0x77, 0x00, 0x00}, VEX_OP | WITH_VEXL, AVX, 0<<11 | 1449, 2<<4 | 15, argp_},                              // vzeroall (2455)
-	enc{[4]byte{0x1, 0x77, 0x00, 0x00}, VEX_OP, AVX, 0<<11 | 1450, 2<<4 | 15, argp_},                                          // vzeroupper (2456)
+	enc{[4]byte{0x14, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 1, 1<<4 | 15, argp_Abib},                                    // adc (0)
+	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, LOCK, featset_X64_IMPLICIT, 1<<11 | 1, 1<<4 | 2, argp_mbib},                                  // adc (1)
+	enc{[4]byte{0x10, 0x00, 0x00, 0x00}, LOCK | ENC_MR, featset_X64_IMPLICIT, 2<<11 | 1, 1<<4 | 15, argp_mbrb},                        // adc (2)
+	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 3<<11 | 1, 1<<4 | 2, argp_rbib},                                     // adc (3)
+	enc{[4]byte{0x10, 0x00, 0x00, 0x00}, ENC_MR, featset_X64_IMPLICIT, 4<<11 | 1, 1<<4 | 15, argp_rbrb},                               // adc (4)
+	enc{[4]byte{0x12, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 5<<11 | 1, 1<<4 | 15, argp_rbvb},                                    // adc (5)
+	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | EXACT_SIZE, featset_X64_IMPLICIT, 6<<11 | 1, 1<<4 | 2, argp_r0ib},                // adc (6)
+	enc{[4]byte{0x15, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 7<<11 | 1, 1<<4 | 15, argp_A0i0},                            // adc (7)
+	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, featset_X64_IMPLICIT, 8<<11 | 1, 1<<4 | 2, argp_m0i0},                      // adc (8)
+	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, featset_X64_IMPLICIT, 9<<11 | 1, 1<<4 | 2, argp_m0ib},                      // adc (9)
+	enc{[4]byte{0x11, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK | ENC_MR, featset_X64_IMPLICIT, 10<<11 | 1, 1<<4 | 15, argp_m0r0},           // adc (10)
+	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 11<<11 | 1, 1<<4 | 2, argp_r0i0},                            // adc (11)
+	enc{[4]byte{0x11, 0x00, 0x00, 0x00}, AUTO_SIZE | ENC_MR, featset_X64_IMPLICIT, 12<<11 | 1, 1<<4 | 15, argp_r0r0},                  // adc (12)
+	enc{[4]byte{0x13, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 13<<11 | 1, 1<<4 | 15, argp_r0v0},                           // adc (13)
+	enc{[4]byte{0xf, 0x38, 0xf6, 0x00}, WITH_REXW | PREF_66, featset_ADX, 0<<11 | 2, 3<<4 | 15, argp_rqvq},                            // adcx (14)
+	enc{[4]byte{0x4, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 3, 1<<4 | 15, argp_Abib},                                     // add (15)
+	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, LOCK, featset_X64_IMPLICIT, 1<<11 | 3, 1<<4 | 0, argp_mbib},                                  // add (16)
+	enc{[4]byte{0x0, 0x00, 0x00, 0x00}, LOCK | ENC_MR, featset_X64_IMPLICIT, 2<<11 | 3, 1<<4 | 15, argp_mbrb},                         // add (17)
+	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 3<<11 | 3, 1<<4 | 0, argp_rbib},                                     // add (18)
+	enc{[4]byte{0x0, 0x00, 0x00, 0x00}, ENC_MR, featset_X64_IMPLICIT, 4<<11 | 3, 1<<4 | 15, argp_rbrb},                                // add (19)
+	enc{[4]byte{0x2, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 5<<11 | 3, 1<<4 | 15, argp_rbvb},                                     // add (20)
+	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | EXACT_SIZE, featset_X64_IMPLICIT, 6<<11 | 3, 1<<4 | 0, argp_r0ib},                // add (21)
+	enc{[4]byte{0x5, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 7<<11 | 3, 1<<4 | 15, argp_A0i0},                             // add (22)
+	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, featset_X64_IMPLICIT, 8<<11 | 3, 1<<4 | 0, argp_m0i0},                      // add (23)
+	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, featset_X64_IMPLICIT, 9<<11 | 3, 1<<4 | 0, argp_m0ib},                      // add (24)
+	enc{[4]byte{0x1, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK | ENC_MR, featset_X64_IMPLICIT, 10<<11 | 3, 1<<4 | 15, argp_m0r0},            // add (25)
+	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 11<<11 | 3, 1<<4 | 0, argp_r0i0},                            // add (26)
+	enc{[4]byte{0x1, 0x00, 0x00, 0x00}, AUTO_SIZE | ENC_MR, featset_X64_IMPLICIT, 12<<11 | 3, 1<<4 | 15, argp_r0r0},                   // add (27)
+	enc{[4]byte{0x3, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 13<<11 | 3, 1<<4 | 15, argp_r0v0},                            // add (28)
+	enc{[4]byte{0xf, 0x58, 0x00, 0x00}, PREF_F2, featset_SSE2, 0<<11 | 4, 2<<4 | 15, argp_yomq},                                       // addsd (29)
+	enc{[4]byte{0xf, 0x58, 0x00, 0x00}, PREF_F2, featset_SSE2, 1<<11 | 4, 2<<4 | 15, argp_yoyo},                                       // addsd (30)
+	enc{[4]byte{0xf, 0x58, 0x00, 0x00}, PREF_F3, featset_SSE, 0<<11 | 5, 2<<4 | 15, argp_yomd},                                        // addss (31)
+	enc{[4]byte{0xf, 0x58, 0x00, 0x00}, PREF_F3, featset_SSE, 1<<11 | 5, 2<<4 | 15, argp_yoyo},                                        // addss (32)
+	enc{[4]byte{0xf, 0x38, 0xf6, 0x00}, WITH_REXW | PREF_F3, featset_ADX, 0<<11 | 6, 3<<4 | 15, argp_rqvq},                            // adox (33)
+	enc{[4]byte{0x24, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 7, 1<<4 | 15, argp_Abib},                                    // and (34)
+	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, LOCK, featset_X64_IMPLICIT, 1<<11 | 7, 1<<4 | 4, argp_mbib},                                  // and (35)
+	enc{[4]byte{0x20, 0x00, 0x00, 0x00}, LOCK | ENC_MR, featset_X64_IMPLICIT, 2<<11 | 7, 1<<4 | 15, argp_mbrb},                        // and (36)
+	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 3<<11 | 7, 1<<4 | 4, argp_rbib},                                     // and (37)
+	enc{[4]byte{0x20, 0x00, 0x00, 0x00}, ENC_MR, featset_X64_IMPLICIT, 4<<11 | 7, 1<<4 | 15, argp_rbrb},                               // and (38)
+	enc{[4]byte{0x22, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 5<<11 | 7, 1<<4 | 15, argp_rbvb},                                    // and (39)
+	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | EXACT_SIZE, featset_X64_IMPLICIT, 6<<11 | 7, 1<<4 | 4, argp_r0ib},                // and (40)
+	enc{[4]byte{0x25, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 7<<11 | 7, 1<<4 | 15, argp_A0i0},                            // and (41)
+	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, featset_X64_IMPLICIT, 8<<11 | 7, 1<<4 | 4, argp_m0i0},                      // and (42)
+	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, featset_X64_IMPLICIT, 9<<11 | 7, 1<<4 | 4, argp_m0ib},                      // and (43)
+	enc{[4]byte{0x21, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK | ENC_MR, featset_X64_IMPLICIT, 10<<11 | 7, 1<<4 | 15, argp_m0r0},           // and (44)
+	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 11<<11 | 7, 1<<4 | 4, argp_r0i0},                            // and (45)
+	enc{[4]byte{0x21, 0x00, 0x00, 0x00}, AUTO_SIZE | ENC_MR, featset_X64_IMPLICIT, 12<<11 | 7, 1<<4 | 15, argp_r0r0},                  // and (46)
+	enc{[4]byte{0x23, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 13<<11 | 7, 1<<4 | 15, argp_r0v0},                           // and (47)
+	enc{[4]byte{0x2, 0xf2, 0x00, 0x00}, VEX_OP | AUTO_REXW, featset_BMI1, 0<<11 | 8, 2<<4 | 15, argp_r0r0v0},                          // andn (48)
+	enc{[4]byte{0x10, 0x10, 0x00, 0x00}, XOP_OP | AUTO_REXW, featset_TBM, 0<<11 | 9, 2<<4 | 15, argp_r0v0id},                          // bextr (49)
+	enc{[4]byte{0x2, 0xf7, 0x00, 0x00}, VEX_OP | AUTO_REXW | ENC_MR, featset_BMI1, 1<<11 | 9, 2<<4 | 15, argp_r0v0r0},                 // bextr (50)
+	enc{[4]byte{0x9, 0x1, 0x00, 0x00}, XOP_OP | AUTO_REXW | ENC_VM, featset_TBM, 0<<11 | 10, 2<<4 | 1, argp_r0v0},                     // blcfill (51)
+	enc{[4]byte{0x9, 0x2, 0x00, 0x00}, XOP_OP | AUTO_REXW | ENC_VM, featset_TBM, 0<<11 | 11, 2<<4 | 6, argp_r0v0},                     // blci (52)
+	enc{[4]byte{0x9, 0x1, 0x00, 0x00}, XOP_OP | AUTO_REXW | ENC_VM, featset_TBM, 0<<11 | 12, 2<<4 | 5, argp_r0v0},                     // blcic (53)
+	enc{[4]byte{0x9, 0x2, 0x00, 0x00}, XOP_OP | AUTO_REXW | ENC_VM, featset_TBM, 0<<11 | 13, 2<<4 | 1, argp_r0v0},                     // blcmsk (54)
+	enc{[4]byte{0x9, 0x1, 0x00, 0x00}, XOP_OP | AUTO_REXW | ENC_VM, featset_TBM, 0<<11 | 14, 2<<4 | 3, argp_r0v0},                     // blcs (55)
+	enc{[4]byte{0x9, 0x1, 0x00, 0x00}, XOP_OP | AUTO_REXW | ENC_VM, featset_TBM, 0<<11 | 15, 2<<4 | 2, argp_r0v0},                     // blsfill (56)
+	enc{[4]byte{0x2, 0xf3, 0x00, 0x00}, VEX_OP | AUTO_REXW | ENC_VM, featset_BMI1, 0<<11 | 16, 2<<4 | 3, argp_r0v0},                   // blsi (57)
+	enc{[4]byte{0x9, 0x1, 0x00, 0x00}, XOP_OP | AUTO_REXW | ENC_VM, featset_TBM, 0<<11 | 17, 2<<4 | 6, argp_r0v0},                     // blsic (58)
+	enc{[4]byte{0x2, 0xf3, 0x00, 0x00}, VEX_OP | AUTO_REXW | ENC_VM, featset_BMI1, 0<<11 | 18, 2<<4 | 2, argp_r0v0},                   // blsmsk (59)
+	enc{[4]byte{0x2, 0xf3, 0x00, 0x00}, VEX_OP | AUTO_REXW | ENC_VM, featset_BMI1, 0<<11 | 19, 2<<4 | 1, argp_r0v0},                   // blsr (60)
+	enc{[4]byte{0xf, 0x1a, 0x00, 0x00}, PREF_F3, featset_MPX, 0<<11 | 20, 2<<4 | 15, argp_bom1},                                       // bndcl (61)
+	enc{[4]byte{0xf, 0x1a, 0x00, 0x00}, PREF_F3, featset_MPX, 1<<11 | 20, 2<<4 | 15, argp_borq},                                       // bndcl (62)
+	enc{[4]byte{0xf, 0x1b, 0x00, 0x00}, PREF_F2, featset_MPX, 0<<11 | 21, 2<<4 | 15, argp_bom1},                                       // bndcn (63)
+	enc{[4]byte{0xf, 0x1b, 0x00, 0x00}, PREF_F2, featset_MPX, 1<<11 | 21, 2<<4 | 15, argp_borq},                                       // bndcn (64)
+	enc{[4]byte{0xf, 0x1a, 0x00, 0x00}, PREF_F2, featset_MPX, 0<<11 | 22, 2<<4 | 15, argp_bom1},                                       // bndcu (65)
+	enc{[4]byte{0xf, 0x1a, 0x00, 0x00}, PREF_F2, featset_MPX, 1<<11 | 22, 2<<4 | 15, argp_borq},                                       // bndcu (66)
+	enc{[4]byte{0xf, 0x1a, 0x00, 0x00}, ENC_MIB, featset_MPX, 0<<11 | 23, 2<<4 | 15, argp_bom1},                                       // bndldx (67)
+	enc{[4]byte{0xf, 0x1b, 0x00, 0x00}, PREF_F3 | ENC_MIB, featset_MPX, 0<<11 | 24, 2<<4 | 15, argp_bom1},                             // bndmk (68)
+	enc{[4]byte{0xf, 0x1a, 0x00, 0x00}, PREF_66, featset_MPX, 0<<11 | 25, 2<<4 | 15, argp_bobo},                                       // bndmov (69)
+	enc{[4]byte{0xf, 0x1b, 0x00, 0x00}, PREF_66 | ENC_MR, featset_MPX, 1<<11 | 25, 2<<4 | 15, argp_bobo},                              // bndmov (70)
+	enc{[4]byte{0xf, 0x1a, 0x00, 0x00}, PREF_66, featset_MPX, 2<<11 | 25, 2<<4 | 15, argp_bom1},                                       // bndmov (71)
+	enc{[4]byte{0xf, 0x1b, 0x00, 0x00}, PREF_66 | ENC_MR, featset_MPX, 3<<11 | 25, 2<<4 | 15, argp_m1bo},                              // bndmov (72)
+	enc{[4]byte{0xf, 0x1b, 0x00, 0x00}, ENC_MR | ENC_MIB, featset_MPX, 0<<11 | 26, 2<<4 | 15, argp_m1bo},                              // bndstx (73)
+	enc{[4]byte{0xf, 0xbc, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 27, 2<<4 | 15, argp_r0v0},                            // bsf (74)
+	enc{[4]byte{0xf, 0xbd, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 28, 2<<4 | 15, argp_r0v0},                            // bsr (75)
+	enc{[4]byte{0xf, 0xc8, 0x00, 0x00}, AUTO_REXW | SHORT_ARG, featset_X64_IMPLICIT, 0<<11 | 29, 2<<4 | 15, argp_r0},                  // bswap (76)
+	enc{[4]byte{0xf, 0xba, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 30, 2<<4 | 4, argp_v0ib},                             // bt (77)
+	enc{[4]byte{0xf, 0xa3, 0x00, 0x00}, AUTO_SIZE | ENC_MR, featset_X64_IMPLICIT, 1<<11 | 30, 2<<4 | 15, argp_v0r0},                   // bt (78)
+	enc{[4]byte{0xf, 0xba, 0x00, 0x00}, AUTO_SIZE | EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 31, 2<<4 | 7, argp_r0ib},                // btc (79)
+	enc{[4]byte{0xf, 0xba, 0x00, 0x00}, AUTO_SIZE | LOCK, featset_X64_IMPLICIT, 1<<11 | 31, 2<<4 | 7, argp_m0ib},                      // btc (80)
+	enc{[4]byte{0xf, 0xbb, 0x00, 0x00}, AUTO_SIZE | LOCK | ENC_MR, featset_X64_IMPLICIT, 2<<11 | 31, 2<<4 | 15, argp_m0r0},            // btc (81)
+	enc{[4]byte{0xf, 0xbb, 0x00, 0x00}, AUTO_SIZE | ENC_MR, featset_X64_IMPLICIT, 3<<11 | 31, 2<<4 | 15, argp_r0r0},                   // btc (82)
+	enc{[4]byte{0xf, 0xba, 0x00, 0x00}, AUTO_SIZE | EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 32, 2<<4 | 6, argp_r0ib},                // btr (83)
+	enc{[4]byte{0xf, 0xba, 0x00, 0x00}, AUTO_SIZE | LOCK, featset_X64_IMPLICIT, 1<<11 | 32, 2<<4 | 6, argp_m0ib},                      // btr (84)
+	enc{[4]byte{0xf, 0xb3, 0x00, 0x00}, AUTO_SIZE | LOCK | ENC_MR, featset_X64_IMPLICIT, 2<<11 | 32, 2<<4 | 15, argp_m0r0},            // btr (85)
+	enc{[4]byte{0xf, 0xb3, 0x00, 0x00}, AUTO_SIZE | ENC_MR, featset_X64_IMPLICIT, 3<<11 | 32, 2<<4 | 15, argp_r0r0},                   // btr (86)
+	enc{[4]byte{0xf, 0xba, 0x00, 0x00}, AUTO_SIZE | EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 33, 2<<4 | 5, argp_r0ib},                // bts (87)
+	enc{[4]byte{0xf, 0xba, 0x00, 0x00}, AUTO_SIZE | LOCK, featset_X64_IMPLICIT, 1<<11 | 33, 2<<4 | 5, argp_m0ib},                      // bts (88)
+	enc{[4]byte{0xf, 0xab, 0x00, 0x00}, AUTO_SIZE | LOCK | ENC_MR, featset_X64_IMPLICIT, 2<<11 | 33, 2<<4 | 15, argp_m0r0},            // bts (89)
+	enc{[4]byte{0xf, 0xab, 0x00, 0x00}, AUTO_SIZE | ENC_MR, featset_X64_IMPLICIT, 3<<11 | 33, 2<<4 | 15, argp_r0r0},                   // bts (90)
+	enc{[4]byte{0x2, 0xf5, 0x00, 0x00}, VEX_OP | AUTO_REXW | ENC_MR, featset_BMI2, 0<<11 | 34, 2<<4 | 15, argp_r0v0r0},                // bzhi (91)
+	enc{[4]byte{0xe8, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 35, 1<<4 | 15, argp_od},                                     // call (92)
+	enc{[4]byte{0xff, 0x00, 0x00, 0x00}, AUTO_NO32, featset_X64_IMPLICIT, 1<<11 | 35, 1<<4 | 2, argp_v0},                              // call (93)
+	enc{[4]byte{0x98, 0x00, 0x00, 0x00}, WORD_SIZE, featset_X64_IMPLICIT, 0<<11 | 36, 1<<4 | 15, argp_},                               // cbw (94)
+	enc{[4]byte{0x99, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 37, 1<<4 | 15, argp_},                                       // cdq (95)
+	enc{[4]byte{0x98, 0x00, 0x00, 0x00}, WITH_REXW, featset_X64_IMPLICIT, 0<<11 | 38, 1<<4 | 15, argp_},                               // cdqe (96)
+	enc{[4]byte{0xf, 0x1, 0xca, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 39, 3<<4 | 15, argp_},                                         // clac (97)
+	enc{[4]byte{0xf8, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 40, 1<<4 | 15, argp_},                                       // clc (98)
+	enc{[4]byte{0xfc, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 41, 1<<4 | 15, argp_},                                       // cld (99)
+	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, 0, featset_SSE2, 0<<11 | 42, 2<<4 | 7, argp_mb},                                               // clflush (100)
+	enc{[4]byte{0xf, 0x1, 0xdd, 0x00}, 0, featset_VMX_AMD, 0<<11 | 43, 3<<4 | 15, argp_},                                              // clgi (101)
+	enc{[4]byte{0xfa, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 44, 1<<4 | 15, argp_},                                       // cli (102)
+	enc{[4]byte{0xf, 0x6, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 45, 2<<4 | 15, argp_},                                         // clts (103)
+	enc{[4]byte{0xf, 0x1, 0xfc, 0x00}, 0, featset_AMD, 0<<11 | 46, 3<<4 | 15, argp_},                                                  // clzero (104)
+	enc{[4]byte{0xf5, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 47, 1<<4 | 15, argp_},                                       // cmc (105)
+	enc{[4]byte{0xf, 0x47, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 48, 2<<4 | 15, argp_r0v0},                            // cmova (106)
+	enc{[4]byte{0xf, 0x43, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 49, 2<<4 | 15, argp_r0v0},                            // cmovae (107)
+	enc{[4]byte{0xf, 0x42, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 50, 2<<4 | 15, argp_r0v0},                            // cmovb (108)
+	enc{[4]byte{0xf, 0x46, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 51, 2<<4 | 15, argp_r0v0},                            // cmovbe (109)
+	enc{[4]byte{0xf, 0x42, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 52, 2<<4 | 15, argp_r0v0},                            // cmovc (110)
+	enc{[4]byte{0xf, 0x44, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 53, 2<<4 | 15, argp_r0v0},                            // cmove (111)
+	enc{[4]byte{0xf, 0x4f, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 54, 2<<4 | 15, argp_r0v0},                            // cmovg (112)
+	enc{[4]byte{0xf, 0x4d, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 55, 2<<4 | 15, argp_r0v0},                            // cmovge (113)
+	enc{[4]byte{0xf, 0x4c, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 56, 2<<4 | 15, argp_r0v0},                            // cmovl (114)
+	enc{[4]byte{0xf, 0x4e, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 57, 2<<4 | 15, argp_r0v0},                            // cmovle (115)
+	enc{[4]byte{0xf, 0x46, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 58, 2<<4 | 15, argp_r0v0},                            // cmovna (116)
+	enc{[4]byte{0xf, 0x42, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 59, 2<<4 | 15, argp_r0v0},                            // cmovnae (117)
+	enc{[4]byte{0xf, 0x43, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 60, 2<<4 | 15, argp_r0v0},                            // cmovnb (118)
+	enc{[4]byte{0xf, 0x47, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 61, 2<<4 | 15, argp_r0v0},                            // cmovnbe (119)
+	enc{[4]byte{0xf, 0x43, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 62, 2<<4 | 15, argp_r0v0},                            // cmovnc (120)
+	enc{[4]byte{0xf, 0x45, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 63, 2<<4 | 15, argp_r0v0},                            // cmovne (121)
+	enc{[4]byte{0xf, 0x4e, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 64, 2<<4 | 15, argp_r0v0},                            // cmovng (122)
+	enc{[4]byte{0xf, 0x4c, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 65, 2<<4 | 15, argp_r0v0},                            // cmovnge (123)
+	enc{[4]byte{0xf, 0x4d, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 66, 2<<4 | 15, argp_r0v0},                            // cmovnl (124)
+	enc{[4]byte{0xf, 0x4f, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 67, 2<<4 | 15, argp_r0v0},                            // cmovnle (125)
+	enc{[4]byte{0xf, 0x41, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 68, 2<<4 | 15, argp_r0v0},                            // cmovno (126)
+	enc{[4]byte{0xf, 0x4b, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 69, 2<<4 | 15, argp_r0v0},                            // cmovnp (127)
+	enc{[4]byte{0xf, 0x49, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 70, 2<<4 | 15, argp_r0v0},                            // cmovns (128)
+	enc{[4]byte{0xf, 0x45, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 71, 2<<4 | 15, argp_r0v0},                            // cmovnz (129)
+	enc{[4]byte{0xf, 0x40, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 72, 2<<4 | 15, argp_r0v0},                            // cmovo (130)
+	enc{[4]byte{0xf, 0x4a, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 73, 2<<4 | 15, argp_r0v0},                            // cmovp (131)
+	enc{[4]byte{0xf, 0x4a, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 74, 2<<4 | 15, argp_r0v0},                            // cmovpe (132)
+	enc{[4]byte{0xf, 0x4b, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 75, 2<<4 | 15, argp_r0v0},                            // cmovpo (133)
+	enc{[4]byte{0xf, 0x48, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 76, 2<<4 | 15, argp_r0v0},                            // cmovs (134)
+	enc{[4]byte{0xf, 0x44, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 77, 2<<4 | 15, argp_r0v0},                            // cmovz (135)
+	enc{[4]byte{0x3c, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 78, 1<<4 | 15, argp_Abib},                                   // cmp (136)
+	enc{[4]byte{0x3a, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 78, 1<<4 | 15, argp_rbvb},                                   // cmp (137)
+	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 2<<11 | 78, 1<<4 | 7, argp_vbib},                                    // cmp (138)
+	enc{[4]byte{0x38, 0x00, 0x00, 0x00}, ENC_MR, featset_X64_IMPLICIT, 3<<11 | 78, 1<<4 | 15, argp_vbrb},                              // cmp (139)
+	enc{[4]byte{0x3d, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 4<<11 | 78, 1<<4 | 15, argp_A0i0},                           // cmp (140)
+	enc{[4]byte{0x3b, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 5<<11 | 78, 1<<4 | 15, argp_r0v0},                           // cmp (141)
+	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 6<<11 | 78, 1<<4 | 7, argp_v0i0},                            // cmp (142)
+	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 7<<11 | 78, 1<<4 | 7, argp_v0ib},                            // cmp (143)
+	enc{[4]byte{0x39, 0x00, 0x00, 0x00}, AUTO_SIZE | ENC_MR, featset_X64_IMPLICIT, 8<<11 | 78, 1<<4 | 15, argp_v0r0},                  // cmp (144)
+	enc{[4]byte{0xf, 0xc2, 0x0, 0x00}, IMM_OP | PREF_F2, featset_SSE2, 0<<11 | 79, 3<<4 | 15, argp_yomq},                              // cmpeqsd (145)
+	enc{[4]byte{0xf, 0xc2, 0x0, 0x00}, IMM_OP | PREF_F2, featset_SSE2, 1<<11 | 79, 3<<4 | 15, argp_yoyo},                              // cmpeqsd (146)
+	enc{[4]byte{0xf, 0xc2, 0x0, 0x00}, IMM_OP | PREF_F3, featset_SSE, 0<<11 | 80, 3<<4 | 15, argp_yomd},                               // cmpeqss (147)
+	enc{[4]byte{0xf, 0xc2, 0x0, 0x00}, IMM_OP | PREF_F3, featset_SSE, 1<<11 | 80, 3<<4 | 15, argp_yoyo},                               // cmpeqss (148)
+	enc{[4]byte{0xf, 0xc2, 0x2, 0x00}, IMM_OP | PREF_F2, featset_SSE2, 0<<11 | 81, 3<<4 | 15, argp_yomq},                              // cmplesd (149)
+	enc{[4]byte{0xf, 0xc2, 0x2, 0x00}, IMM_OP | PREF_F2, featset_SSE2, 1<<11 | 81, 3<<4 | 15, argp_yoyo},                              // cmplesd (150)
+	enc{[4]byte{0xf, 0xc2, 0x2, 0x00}, IMM_OP | PREF_F3, featset_SSE, 0<<11 | 82, 3<<4 | 15, argp_yomd},                               // cmpless (151)
+	enc{[4]byte{0xf, 0xc2, 0x2, 0x00}, IMM_OP | PREF_F3, featset_SSE, 1<<11 | 82, 3<<4 | 15, argp_yoyo},                               // cmpless (152)
+	enc{[4]byte{0xf, 0xc2, 0x1, 0x00}, IMM_OP | PREF_F2, featset_SSE2, 0<<11 | 83, 3<<4 | 15, argp_yomq},                              // cmpltsd (153)
+	enc{[4]byte{0xf, 0xc2, 0x1, 0x00}, IMM_OP | PREF_F2, featset_SSE2, 1<<11 | 83, 3<<4 | 15, argp_yoyo},                              // cmpltsd (154)
+	enc{[4]byte{0xf, 0xc2, 0x1, 0x00}, IMM_OP | PREF_F3, featset_SSE, 0<<11 | 84, 3<<4 | 15, argp_yomd},                               // cmpltss (155)
+	enc{[4]byte{0xf, 0xc2, 0x1, 0x00}, IMM_OP | PREF_F3, featset_SSE, 1<<11 | 84, 3<<4 | 15, argp_yoyo},                               // cmpltss (156)
+	enc{[4]byte{0xf, 0xc2, 0x4, 0x00}, IMM_OP | PREF_F2, featset_SSE2, 0<<11 | 85, 3<<4 | 15, argp_yomq},                              // cmpneqsd (157)
+	enc{[4]byte{0xf, 0xc2, 0x4, 0x00}, IMM_OP | PREF_F2, featset_SSE2, 1<<11 | 85, 3<<4 | 15, argp_yoyo},                              // cmpneqsd (158)
+	enc{[4]byte{0xf, 0xc2, 0x4, 0x00}, IMM_OP | PREF_F3, featset_SSE, 0<<11 | 86, 3<<4 | 15, argp_yomd},                               // cmpneqss (159)
+	enc{[4]byte{0xf, 0xc2, 0x4, 0x00}, IMM_OP | PREF_F3, featset_SSE, 1<<11 | 86, 3<<4 | 15, argp_yoyo},                               // cmpneqss (160)
+	enc{[4]byte{0xf, 0xc2, 0x6, 0x00}, IMM_OP | PREF_F2, featset_SSE2, 0<<11 | 87, 3<<4 | 15, argp_yomq},                              // cmpnlesd (161)
+	enc{[4]byte{0xf, 0xc2, 0x6, 0x00}, IMM_OP | PREF_F2, featset_SSE2, 1<<11 | 87, 3<<4 | 15, argp_yoyo},                              // cmpnlesd (162)
+	enc{[4]byte{0xf, 0xc2, 0x6, 0x00}, IMM_OP | PREF_F3, featset_SSE, 0<<11 | 88, 3<<4 | 15, argp_yomd},                               // cmpnless (163)
+	enc{[4]byte{0xf, 0xc2, 0x6, 0x00}, IMM_OP | PREF_F3, featset_SSE, 1<<11 | 88, 3<<4 | 15, argp_yoyo},                               // cmpnless (164)
+	enc{[4]byte{0xf, 0xc2, 0x5, 0x00}, IMM_OP | PREF_F2, featset_SSE2, 0<<11 | 89, 3<<4 | 15, argp_yomq},                              // cmpnltsd (165)
+	enc{[4]byte{0xf, 0xc2, 0x5, 0x00}, IMM_OP | PREF_F2, featset_SSE2, 1<<11 | 89, 3<<4 | 15, argp_yoyo},                              // cmpnltsd (166)
+	enc{[4]byte{0xf, 0xc2, 0x5, 0x00}, IMM_OP | PREF_F3, featset_SSE, 0<<11 | 90, 3<<4 | 15, argp_yomd},                               // cmpnltss (167)
+	enc{[4]byte{0xf, 0xc2, 0x5, 0x00}, IMM_OP | PREF_F3, featset_SSE, 1<<11 | 90, 3<<4 | 15, argp_yoyo},                               // cmpnltss (168)
+	enc{[4]byte{0xf, 0xc2, 0x7, 0x00}, IMM_OP | PREF_F2, featset_SSE2, 0<<11 | 91, 3<<4 | 15, argp_yomq},                              // cmpordsd (169)
+	enc{[4]byte{0xf, 0xc2, 0x7, 0x00}, IMM_OP | PREF_F2, featset_SSE2, 1<<11 | 91, 3<<4 | 15, argp_yoyo},                              // cmpordsd (170)
+	enc{[4]byte{0xf, 0xc2, 0x7, 0x00}, IMM_OP | PREF_F3, featset_SSE, 0<<11 | 92, 3<<4 | 15, argp_yomd},                               // cmpordss (171)
+	enc{[4]byte{0xf, 0xc2, 0x7, 0x00}, IMM_OP | PREF_F3, featset_SSE, 1<<11 | 92, 3<<4 | 15, argp_yoyo},                               // cmpordss (172)
+	enc{[4]byte{0xa6, 0x00, 0x00, 0x00}, REPE, featset_X64_IMPLICIT, 0<<11 | 93, 1<<4 | 15, argp_},                                    // cmpsb (173)
+	enc{[4]byte{0xa7, 0x00, 0x00, 0x00}, REPE, featset_X64_IMPLICIT, 0<<11 | 94, 1<<4 | 15, argp_},                                    // cmpsd (174)
+	enc{[4]byte{0xf, 0xc2, 0x00, 0x00}, PREF_F2, featset_SSE2, 1<<11 | 94, 2<<4 | 15, argp_yowoib},                                    // cmpsd (175)
+	enc{[4]byte{0xa7, 0x00, 0x00, 0x00}, WITH_REXW | REPE, featset_X64_IMPLICIT, 0<<11 | 95, 1<<4 | 15, argp_},                        // cmpsq (176)
+	enc{[4]byte{0xf, 0xc2, 0x00, 0x00}, PREF_F3, featset_SSE, 0<<11 | 96, 2<<4 | 15, argp_yom1ib},                                     // cmpss (177)
+	enc{[4]byte{0xf, 0xc2, 0x00, 0x00}, PREF_F3, featset_SSE, 1<<11 | 96, 2<<4 | 15, argp_yoyoib},                                     // cmpss (178)
+	enc{[4]byte{0xa7, 0x00, 0x00, 0x00}, WORD_SIZE | REPE, featset_X64_IMPLICIT, 0<<11 | 97, 1<<4 | 15, argp_},                        // cmpsw (179)
+	enc{[4]byte{0xf, 0xc2, 0x3, 0x00}, IMM_OP | PREF_F2, featset_SSE2, 0<<11 | 98, 3<<4 | 15, argp_yomq},                              // cmpunordsd (180)
+	enc{[4]byte{0xf, 0xc2, 0x3, 0x00}, IMM_OP | PREF_F2, featset_SSE2, 1<<11 | 98, 3<<4 | 15, argp_yoyo},                              // cmpunordsd (181)
+	enc{[4]byte{0xf, 0xc2, 0x3, 0x00}, IMM_OP | PREF_F3, featset_SSE, 0<<11 | 99, 3<<4 | 15, argp_yomd},                               // cmpunordss (182)
+	enc{[4]byte{0xf, 0xc2, 0x3, 0x00}, IMM_OP | PREF_F3, featset_SSE, 1<<11 | 99, 3<<4 | 15, argp_yoyo},                               // cmpunordss (183)
+	enc{[4]byte{0xf, 0xb0, 0x00, 0x00}, LOCK | ENC_MR, featset_X64_IMPLICIT, 0<<11 | 100, 2<<4 | 15, argp_mbrb},                       // cmpxchg (184)
+	enc{[4]byte{0xf, 0xb0, 0x00, 0x00}, ENC_MR, featset_X64_IMPLICIT, 1<<11 | 100, 2<<4 | 15, argp_rbrb},                              // cmpxchg (185)
+	enc{[4]byte{0xf, 0xb1, 0x00, 0x00}, AUTO_SIZE | LOCK | ENC_MR, featset_X64_IMPLICIT, 2<<11 | 100, 2<<4 | 15, argp_m0r0},           // cmpxchg (186)
+	enc{[4]byte{0xf, 0xb1, 0x00, 0x00}, AUTO_SIZE | ENC_MR, featset_X64_IMPLICIT, 3<<11 | 100, 2<<4 | 15, argp_r0r0},                  // cmpxchg (187)
+	enc{[4]byte{0xf, 0xc7, 0x00, 0x00}, WITH_REXW | LOCK, featset_CX16, 0<<11 | 101, 2<<4 | 1, argp_mo},                               // cmpxchg16b (188)
+	enc{[4]byte{0xf, 0xc7, 0x00, 0x00}, LOCK, featset_X64_IMPLICIT, 0<<11 | 102, 2<<4 | 1, argp_mq},                                   // cmpxchg8b (189)
+	enc{[4]byte{0xf, 0x2f, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 103, 2<<4 | 15, argp_yomq},                                     // comisd (190)
+	enc{[4]byte{0xf, 0x2f, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 103, 2<<4 | 15, argp_yoyo},                                     // comisd (191)
+	enc{[4]byte{0xf, 0x2f, 0x00, 0x00}, 0, featset_SSE, 0<<11 | 104, 2<<4 | 15, argp_yomd},                                            // comiss (192)
+	enc{[4]byte{0xf, 0x2f, 0x00, 0x00}, 0, featset_SSE, 1<<11 | 104, 2<<4 | 15, argp_yoyo},                                            // comiss (193)
+	enc{[4]byte{0xf, 0xa2, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 105, 2<<4 | 15, argp_},                                       // cpuid (194)
+	enc{[4]byte{0x99, 0x00, 0x00, 0x00}, WITH_REXW, featset_X64_IMPLICIT, 0<<11 | 106, 1<<4 | 15, argp_},                              // cqo (195)
+	enc{[4]byte{0xf, 0x38, 0xf0, 0x00}, AUTO_REXW | EXACT_SIZE | PREF_F2, featset_SSE42, 0<<11 | 107, 3<<4 | 15, argp_r0vb},           // crc32 (196)
+	enc{[4]byte{0xf, 0x38, 0xf1, 0x00}, WORD_SIZE | EXACT_SIZE | PREF_F2, featset_SSE42, 1<<11 | 107, 3<<4 | 15, argp_rdvw},           // crc32 (197)
+	enc{[4]byte{0xf, 0x38, 0xf1, 0x00}, AUTO_REXW | EXACT_SIZE | PREF_F2, featset_SSE42, 2<<11 | 107, 3<<4 | 15, argp_r0v0},           // crc32 (198)
+	enc{[4]byte{0xf, 0xe6, 0x00, 0x00}, PREF_F2, featset_SSE2, 0<<11 | 108, 2<<4 | 15, argp_yowo},                                     // cvtpd2dq (199)
+	enc{[4]byte{0xf, 0x2d, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 109, 2<<4 | 15, argp_xqwo},                                     // cvtpd2pi (200)
+	enc{[4]byte{0xf, 0x5b, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 110, 2<<4 | 15, argp_yowo},                                     // cvtps2dq (201)
+	enc{[4]byte{0xf, 0x2d, 0x00, 0x00}, 0, featset_MMX_SSE, 0<<11 | 111, 2<<4 | 15, argp_xqmq},                                        // cvtps2pi (202)
+	enc{[4]byte{0xf, 0x2d, 0x00, 0x00}, 0, featset_MMX_SSE, 1<<11 | 111, 2<<4 | 15, argp_xqyo},                                        // cvtps2pi (203)
+	enc{[4]byte{0xf, 0x2d, 0x00, 0x00}, PREF_F2, featset_SSE2, 0<<11 | 112, 2<<4 | 15, argp_rdmq},                                     // cvtsd2si (204)
+	enc{[4]byte{0xf, 0x2d, 0x00, 0x00}, PREF_F2, featset_SSE2, 1<<11 | 112, 2<<4 | 15, argp_rdyo},                                     // cvtsd2si (205)
+	enc{[4]byte{0xf, 0x2d, 0x00, 0x00}, WITH_REXW | PREF_F2, featset_SSE2, 2<<11 | 112, 2<<4 | 15, argp_rqmq},                         // cvtsd2si (206)
+	enc{[4]byte{0xf, 0x2d, 0x00, 0x00}, WITH_REXW | PREF_F2, featset_SSE2, 3<<11 | 112, 2<<4 | 15, argp_rqyo},                         // cvtsd2si (207)
+	enc{[4]byte{0xf, 0x5a, 0x00, 0x00}, PREF_F2, featset_SSE2, 0<<11 | 113, 2<<4 | 15, argp_yomq},                                     // cvtsd2ss (208)
+	enc{[4]byte{0xf, 0x5a, 0x00, 0x00}, PREF_F2, featset_SSE2, 1<<11 | 113, 2<<4 | 15, argp_yoyo},                                     // cvtsd2ss (209)
+	enc{[4]byte{0xf, 0x2a, 0x00, 0x00}, PREF_F2, featset_SSE2, 0<<11 | 114, 2<<4 | 15, argp_yovd},                                     // cvtsi2sd (210)
+	enc{[4]byte{0xf, 0x2a, 0x00, 0x00}, WITH_REXW | PREF_F2, featset_SSE2, 1<<11 | 114, 2<<4 | 15, argp_yovq},                         // cvtsi2sd (211)
+	enc{[4]byte{0xf, 0x2a, 0x00, 0x00}, PREF_F3, featset_SSE, 0<<11 | 115, 2<<4 | 15, argp_yovd},                                      // cvtsi2ss (212)
+	enc{[4]byte{0xf, 0x2a, 0x00, 0x00}, WITH_REXW | PREF_F3, featset_SSE, 1<<11 | 115, 2<<4 | 15, argp_yovq},                          // cvtsi2ss (213)
+	enc{[4]byte{0xf, 0x5a, 0x00, 0x00}, PREF_F3, featset_SSE2, 0<<11 | 116, 2<<4 | 15, argp_yomd},                                     // cvtss2sd (214)
+	enc{[4]byte{0xf, 0x5a, 0x00, 0x00}, PREF_F3, featset_SSE2, 1<<11 | 116, 2<<4 | 15, argp_yoyo},                                     // cvtss2sd (215)
+	enc{[4]byte{0xf, 0x2d, 0x00, 0x00}, PREF_F3, featset_SSE, 0<<11 | 117, 2<<4 | 15, argp_rdmd},                                      // cvtss2si (216)
+	enc{[4]byte{0xf, 0x2d, 0x00, 0x00}, PREF_F3, featset_SSE, 1<<11 | 117, 2<<4 | 15, argp_rdyo},                                      // cvtss2si (217)
+	enc{[4]byte{0xf, 0x2d, 0x00, 0x00}, WITH_REXW | PREF_F3, featset_SSE, 2<<11 | 117, 2<<4 | 15, argp_rqmd},                          // cvtss2si (218)
+	enc{[4]byte{0xf, 0x2d, 0x00, 0x00}, WITH_REXW | PREF_F3, featset_SSE, 3<<11 | 117, 2<<4 | 15, argp_rqyo},                          // cvtss2si (219)
+	enc{[4]byte{0xf, 0xe6, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 118, 2<<4 | 15, argp_yowo},                                     // cvttpd2dq (220)
+	enc{[4]byte{0xf, 0x2c, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 119, 2<<4 | 15, argp_xqwo},                                     // cvttpd2pi (221)
+	enc{[4]byte{0xf, 0x5b, 0x00, 0x00}, PREF_F3, featset_SSE2, 0<<11 | 120, 2<<4 | 15, argp_yowo},                                     // cvttps2dq (222)
+	enc{[4]byte{0xf, 0x2c, 0x00, 0x00}, 0, featset_MMX_SSE, 0<<11 | 121, 2<<4 | 15, argp_xqmq},                                        // cvttps2pi (223)
+	enc{[4]byte{0xf, 0x2c, 0x00, 0x00}, 0, featset_MMX_SSE, 1<<11 | 121, 2<<4 | 15, argp_xqyo},                                        // cvttps2pi (224)
+	enc{[4]byte{0xf, 0x2c, 0x00, 0x00}, PREF_F2, featset_SSE2, 0<<11 | 122, 2<<4 | 15, argp_rdmq},                                     // cvttsd2si (225)
+	enc{[4]byte{0xf, 0x2c, 0x00, 0x00}, PREF_F2, featset_SSE2, 1<<11 | 122, 2<<4 | 15, argp_rdyo},                                     // cvttsd2si (226)
+	enc{[4]byte{0xf, 0x2c, 0x00, 0x00}, WITH_REXW | PREF_F2, featset_SSE2, 2<<11 | 122, 2<<4 | 15, argp_rqmq},                         // cvttsd2si (227)
+	enc{[4]byte{0xf, 0x2c, 0x00, 0x00}, WITH_REXW | PREF_F2, featset_SSE2, 3<<11 | 122, 2<<4 | 15, argp_rqyo},                         // cvttsd2si (228)
+	enc{[4]byte{0xf, 0x2c, 0x00, 0x00}, PREF_F3, featset_SSE, 0<<11 | 123, 2<<4 | 15, argp_rdmd},                                      // cvttss2si (229)
+	enc{[4]byte{0xf, 0x2c, 0x00, 0x00}, PREF_F3, featset_SSE, 1<<11 | 123, 2<<4 | 15, argp_rdyo},                                      // cvttss2si (230)
+	enc{[4]byte{0xf, 0x2c, 0x00, 0x00}, WITH_REXW | PREF_F3, featset_SSE, 2<<11 | 123, 2<<4 | 15, argp_rqmd},                          // cvttss2si (231)
+	enc{[4]byte{0xf, 0x2c, 0x00, 0x00}, WITH_REXW | PREF_F3, featset_SSE, 3<<11 | 123, 2<<4 | 15, argp_rqyo},                          // cvttss2si (232)
+	enc{[4]byte{0x99, 0x00, 0x00, 0x00}, WORD_SIZE, featset_X64_IMPLICIT, 0<<11 | 124, 1<<4 | 15, argp_},                              // cwd (233)
+	enc{[4]byte{0x98, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 125, 1<<4 | 15, argp_},                                      // cwde (234)
+	enc{[4]byte{0xfe, 0x00, 0x00, 0x00}, LOCK, featset_X64_IMPLICIT, 0<<11 | 126, 1<<4 | 1, argp_mb},                                  // dec (235)
+	enc{[4]byte{0xfe, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 126, 1<<4 | 1, argp_rb},                                     // dec (236)
+	enc{[4]byte{0xff, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, featset_X64_IMPLICIT, 2<<11 | 126, 1<<4 | 1, argp_m0},                      // dec (237)
+	enc{[4]byte{0xff, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 3<<11 | 126, 1<<4 | 1, argp_r0},                             // dec (238)
+	enc{[4]byte{0xf6, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 127, 1<<4 | 6, argp_vb},                                     // div (239)
+	enc{[4]byte{0xf7, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 1<<11 | 127, 1<<4 | 6, argp_v0},                             // div (240)
+	enc{[4]byte{0xf, 0x5e, 0x00, 0x00}, PREF_F2, featset_SSE2, 0<<11 | 128, 2<<4 | 15, argp_yomq},                                     // divsd (241)
+	enc{[4]byte{0xf, 0x5e, 0x00, 0x00}, PREF_F2, featset_SSE2, 1<<11 | 128, 2<<4 | 15, argp_yoyo},                                     // divsd (242)
+	enc{[4]byte{0xf, 0x5e, 0x00, 0x00}, PREF_F3, featset_SSE, 0<<11 | 129, 2<<4 | 15, argp_yomd},                                      // divss (243)
+	enc{[4]byte{0xf, 0x5e, 0x00, 0x00}, PREF_F3, featset_SSE, 1<<11 | 129, 2<<4 | 15, argp_yoyo},                                      // divss (244)
+	enc{[4]byte{0xf, 0x77, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 130, 2<<4 | 15, argp_},                                                // emms (245)
+	enc{[4]byte{0xc8, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 131, 1<<4 | 15, argp_iwib},                                  // enter (246)
+	enc{[4]byte{0xf, 0x78, 0x00, 0x00}, PREF_66, featset_SSE4A, 0<<11 | 132, 2<<4 | 0, argp_yoibib},                                   // extrq (247)
+	enc{[4]byte{0xf, 0x79, 0x00, 0x00}, PREF_66, featset_SSE4A, 1<<11 | 132, 2<<4 | 15, argp_yoyo},                                    // extrq (248)
+	enc{[4]byte{0xf, 0x37, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 133, 2<<4 | 15, argp_},                                       // getsec (249)
+	enc{[4]byte{0xf4, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 134, 1<<4 | 15, argp_},                                      // hlt (250)
+	enc{[4]byte{0xf1, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 135, 1<<4 | 15, argp_},                                      // icebp (251)
+	enc{[4]byte{0xf6, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 136, 1<<4 | 7, argp_vb},                                     // idiv (252)
+	enc{[4]byte{0xf7, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 1<<11 | 136, 1<<4 | 7, argp_v0},                             // idiv (253)
+	enc{[4]byte{0xf7, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 137, 1<<4 | 5, argp_v0},                             // imul (254)
+	enc{[4]byte{0xf6, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 137, 1<<4 | 5, argp_vb},                                     // imul (255)
+	enc{[4]byte{0xf, 0xaf, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 2<<11 | 137, 2<<4 | 15, argp_r0v0},                           // imul (256)
+	enc{[4]byte{0x6b, 0x00, 0x00, 0x00}, AUTO_SIZE | EXACT_SIZE, featset_X64_IMPLICIT, 3<<11 | 137, 1<<4 | 15, argp_r0v0ib},           // imul (257)
+	enc{[4]byte{0x69, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 4<<11 | 137, 1<<4 | 15, argp_r0v0i0},                        // imul (258)
+	enc{[4]byte{0xe4, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 138, 1<<4 | 15, argp_Abib},                                  // in (259)
+	enc{[4]byte{0xe5, 0x00, 0x00, 0x00}, WORD_SIZE, featset_X64_IMPLICIT, 1<<11 | 138, 1<<4 | 15, argp_Awib},                          // in (260)
+	enc{[4]byte{0xe5, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 2<<11 | 138, 1<<4 | 15, argp_Adib},                                  // in (261)
+	enc{[4]byte{0xec, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 3<<11 | 138, 1<<4 | 15, argp_AbCw},                                  // in (262)
+	enc{[4]byte{0xed, 0x00, 0x00, 0x00}, WORD_SIZE, featset_X64_IMPLICIT, 4<<11 | 138, 1<<4 | 15, argp_AwCw},                          // in (263)
+	enc{[4]byte{0xed, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 5<<11 | 138, 1<<4 | 15, argp_AdCw},                                  // in (264)
+	enc{[4]byte{0xfe, 0x00, 0x00, 0x00}, LOCK, featset_X64_IMPLICIT, 0<<11 | 139, 1<<4 | 0, argp_mb},                                  // inc (265)
+	enc{[4]byte{0xfe, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 139, 1<<4 | 0, argp_rb},                                     // inc (266)
+	enc{[4]byte{0xff, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, featset_X64_IMPLICIT, 2<<11 | 139, 1<<4 | 0, argp_m0},                      // inc (267)
+	enc{[4]byte{0xff, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 3<<11 | 139, 1<<4 | 0, argp_r0},                             // inc (268)
+	enc{[4]byte{0x6c, 0x00, 0x00, 0x00}, REP, featset_X64_IMPLICIT, 0<<11 | 140, 1<<4 | 15, argp_},                                    // insb (269)
+	enc{[4]byte{0x6d, 0x00, 0x00, 0x00}, REP, featset_X64_IMPLICIT, 0<<11 | 141, 1<<4 | 15, argp_},                                    // insd (270)
+	enc{[4]byte{0xf, 0x79, 0x00, 0x00}, PREF_F2, featset_SSE4A, 0<<11 | 142, 2<<4 | 15, argp_yoyo},                                    // insertq (271)
+	enc{[4]byte{0xf, 0x78, 0x00, 0x00}, PREF_F2, featset_SSE4A, 1<<11 | 142, 2<<4 | 15, argp_yoyoibib},                                // insertq (272)
+	enc{[4]byte{0x6d, 0x00, 0x00, 0x00}, WORD_SIZE | REP, featset_X64_IMPLICIT, 0<<11 | 143, 1<<4 | 15, argp_},                        // insw (273)
+	enc{[4]byte{0xcd, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 144, 1<<4 | 15, argp_ib},                                    // int (274)
+	enc{[4]byte{0xf1, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 145, 1<<4 | 15, argp_},                                      // int01 (275)
+	enc{[4]byte{0xcc, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 146, 1<<4 | 15, argp_},                                      // int03 (276)
+	enc{[4]byte{0xf1, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 147, 1<<4 | 15, argp_},                                      // int1 (277)
+	enc{[4]byte{0xcc, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 148, 1<<4 | 15, argp_},                                      // int3 (278)
+	enc{[4]byte{0xf, 0x8, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 149, 2<<4 | 15, argp_},                                        // invd (279)
+	enc{[4]byte{0xf, 0x38, 0x80, 0x00}, PREF_66, featset_VMX, 0<<11 | 150, 3<<4 | 15, argp_rqmo},                                      // invept (280)
+	enc{[4]byte{0xf, 0x1, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 151, 2<<4 | 7, argp_m1},                                       // invlpg (281)
+	enc{[4]byte{0xf, 0x1, 0xdf, 0x00}, 0, featset_AMD, 0<<11 | 152, 3<<4 | 15, argp_},                                                 // invlpga (282)
+	enc{[4]byte{0xf, 0x1, 0xdf, 0x00}, 0, featset_AMD, 1<<11 | 152, 3<<4 | 15, argp_AqBd},                                             // invlpga (283)
+	enc{[4]byte{0xf, 0x38, 0x82, 0x00}, PREF_66, featset_INVPCID, 0<<11 | 153, 3<<4 | 15, argp_rqmo},                                  // invpcid (284)
+	enc{[4]byte{0xf, 0x38, 0x81, 0x00}, PREF_66, featset_VMX, 0<<11 | 154, 3<<4 | 15, argp_rqmo},                                      // invvpid (285)
+	enc{[4]byte{0xcf, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 155, 1<<4 | 15, argp_},                                      // iret (286)
+	enc{[4]byte{0xcf, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 156, 1<<4 | 15, argp_},                                      // iretd (287)
+	enc{[4]byte{0xcf, 0x00, 0x00, 0x00}, WITH_REXW, featset_X64_IMPLICIT, 0<<11 | 157, 1<<4 | 15, argp_},                              // iretq (288)
+	enc{[4]byte{0xcf, 0x00, 0x00, 0x00}, WORD_SIZE, featset_X64_IMPLICIT, 0<<11 | 158, 1<<4 | 15, argp_},                              // iretw (289)
+	enc{[4]byte{0x77, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 159, 1<<4 | 15, argp_ob},                           // ja (290)
+	enc{[4]byte{0xf, 0x87, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 159, 2<<4 | 15, argp_od},                                     // ja (291)
+	enc{[4]byte{0x73, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 160, 1<<4 | 15, argp_ob},                           // jae (292)
+	enc{[4]byte{0xf, 0x83, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 160, 2<<4 | 15, argp_od},                                     // jae (293)
+	enc{[4]byte{0x72, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 161, 1<<4 | 15, argp_ob},                           // jb (294)
+	enc{[4]byte{0xf, 0x82, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 161, 2<<4 | 15, argp_od},                                     // jb (295)
+	enc{[4]byte{0x76, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 162, 1<<4 | 15, argp_ob},                           // jbe (296)
+	enc{[4]byte{0xf, 0x86, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 162, 2<<4 | 15, argp_od},                                     // jbe (297)
+	enc{[4]byte{0x72, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 163, 1<<4 | 15, argp_ob},                           // jc (298)
+	enc{[4]byte{0xf, 0x82, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 163, 2<<4 | 15, argp_od},                                     // jc (299)
+	enc{[4]byte{0x74, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 164, 1<<4 | 15, argp_ob},                           // je (300)
+	enc{[4]byte{0xf, 0x84, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 164, 2<<4 | 15, argp_od},                                     // je (301)
+	enc{[4]byte{0xe3, 0x00, 0x00, 0x00}, PREF_67, featset_X64_IMPLICIT, 0<<11 | 165, 1<<4 | 15, argp_ob},                              // jecxz (302)
+	enc{[4]byte{0x7f, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 166, 1<<4 | 15, argp_ob},                           // jg (303)
+	enc{[4]byte{0xf, 0x8f, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 166, 2<<4 | 15, argp_od},                                     // jg (304)
+	enc{[4]byte{0x7d, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 167, 1<<4 | 15, argp_ob},                           // jge (305)
+	enc{[4]byte{0xf, 0x8d, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 167, 2<<4 | 15, argp_od},                                     // jge (306)
+	enc{[4]byte{0x7c, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 168, 1<<4 | 15, argp_ob},                           // jl (307)
+	enc{[4]byte{0xf, 0x8c, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 168, 2<<4 | 15, argp_od},                                     // jl (308)
+	enc{[4]byte{0x7e, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 169, 1<<4 | 15, argp_ob},                           // jle (309)
+	enc{[4]byte{0xf, 0x8e, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 169, 2<<4 | 15, argp_od},                                     // jle (310)
+	enc{[4]byte{0xeb, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 170, 1<<4 | 15, argp_ob},                           // jmp (311)
+	enc{[4]byte{0xe9, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 170, 1<<4 | 15, argp_od},                                    // jmp (312)
+	enc{[4]byte{0xff, 0x00, 0x00, 0x00}, AUTO_NO32, featset_X64_IMPLICIT, 2<<11 | 170, 1<<4 | 4, argp_v0},                             // jmp (313)
+	enc{[4]byte{0x76, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 171, 1<<4 | 15, argp_ob},                           // jna (314)
+	enc{[4]byte{0xf, 0x86, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 171, 2<<4 | 15, argp_od},                                     // jna (315)
+	enc{[4]byte{0x72, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 172, 1<<4 | 15, argp_ob},                           // jnae (316)
+	enc{[4]byte{0xf, 0x82, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 172, 2<<4 | 15, argp_od},                                     // jnae (317)
+	enc{[4]byte{0x73, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 173, 1<<4 | 15, argp_ob},                           // jnb (318)
+	enc{[4]byte{0xf, 0x83, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 173, 2<<4 | 15, argp_od},                                     // jnb (319)
+	enc{[4]byte{0x77, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 174, 1<<4 | 15, argp_ob},                           // jnbe (320)
+	enc{[4]byte{0xf, 0x87, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 174, 2<<4 | 15, argp_od},                                     // jnbe (321)
+	enc{[4]byte{0x73, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 175, 1<<4 | 15, argp_ob},                           // jnc (322)
+	enc{[4]byte{0xf, 0x83, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 175, 2<<4 | 15, argp_od},                                     // jnc (323)
+	enc{[4]byte{0x75, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 176, 1<<4 | 15, argp_ob},                           // jne (324)
+	enc{[4]byte{0xf, 0x85, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 176, 2<<4 | 15, argp_od},                                     // jne (325)
+	enc{[4]byte{0x7e, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 177, 1<<4 | 15, argp_ob},                           // jng (326)
+	enc{[4]byte{0xf, 0x8e, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 177, 2<<4 | 15, argp_od},                                     // jng (327)
+	enc{[4]byte{0x7c, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 178, 1<<4 | 15, argp_ob},                           // jnge (328)
+	enc{[4]byte{0xf, 0x8c, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 178, 2<<4 | 15, argp_od},                                     // jnge (329)
+	enc{[4]byte{0x7d, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 179, 1<<4 | 15, argp_ob},                           // jnl (330)
+	enc{[4]byte{0xf, 0x8d, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 179, 2<<4 | 15, argp_od},                                     // jnl (331)
+	enc{[4]byte{0x7f, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 180, 1<<4 | 15, argp_ob},                           // jnle (332)
+	enc{[4]byte{0xf, 0x8f, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 180, 2<<4 | 15, argp_od},                                     // jnle (333)
+	enc{[4]byte{0x71, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 181, 1<<4 | 15, argp_ob},                           // jno (334)
+	enc{[4]byte{0xf, 0x81, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 181, 2<<4 | 15, argp_od},                                     // jno (335)
+	enc{[4]byte{0x7b, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 182, 1<<4 | 15, argp_ob},                           // jnp (336)
+	enc{[4]byte{0xf, 0x8b, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 182, 2<<4 | 15, argp_od},                                     // jnp (337)
+	enc{[4]byte{0x79, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 183, 1<<4 | 15, argp_ob},                           // jns (338)
+	enc{[4]byte{0xf, 0x89, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 183, 2<<4 | 15, argp_od},                                     // jns (339)
+	enc{[4]byte{0x75, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 184, 1<<4 | 15, argp_ob},                           // jnz (340)
+	enc{[4]byte{0xf, 0x85, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 184, 2<<4 | 15, argp_od},                                     // jnz (341)
+	enc{[4]byte{0x70, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 185, 1<<4 | 15, argp_ob},                           // jo (342)
+	enc{[4]byte{0xf, 0x80, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 185, 2<<4 | 15, argp_od},                                     // jo (343)
+	enc{[4]byte{0x7a, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 186, 1<<4 | 15, argp_ob},                           // jp (344)
+	enc{[4]byte{0xf, 0x8a, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 186, 2<<4 | 15, argp_od},                                     // jp (345)
+	enc{[4]byte{0x7a, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 187, 1<<4 | 15, argp_ob},                           // jpe (346)
+	enc{[4]byte{0xf, 0x8a, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 187, 2<<4 | 15, argp_od},                                     // jpe (347)
+	enc{[4]byte{0x7b, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 188, 1<<4 | 15, argp_ob},                           // jpo (348)
+	enc{[4]byte{0xf, 0x8b, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 188, 2<<4 | 15, argp_od},                                     // jpo (349)
+	enc{[4]byte{0xe3, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 189, 1<<4 | 15, argp_ob},                                    // jrcxz (350)
+	enc{[4]byte{0x78, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 190, 1<<4 | 15, argp_ob},                           // js (351)
+	enc{[4]byte{0xf, 0x88, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 190, 2<<4 | 15, argp_od},                                     // js (352)
+	enc{[4]byte{0x74, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 0<<11 | 191, 1<<4 | 15, argp_ob},                           // jz (353)
+	enc{[4]byte{0xf, 0x84, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 191, 2<<4 | 15, argp_od},                                     // jz (354)
+	enc{[4]byte{0x9f, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 192, 1<<4 | 15, argp_},                                      // lahf (355)
+	enc{[4]byte{0xf, 0x2, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 193, 2<<4 | 15, argp_r0mw},                            // lar (356)
+	enc{[4]byte{0xf, 0x2, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 1<<11 | 193, 2<<4 | 15, argp_r0r0},                            // lar (357)
+	enc{[4]byte{0xf, 0xf0, 0x00, 0x00}, PREF_F2, featset_SSE3, 0<<11 | 194, 2<<4 | 15, argp_yomo},                                     // lddqu (358)
+	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, 0, featset_SSE, 0<<11 | 195, 2<<4 | 2, argp_md},                                               // ldmxcsr (359)
+	enc{[4]byte{0x8d, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 196, 1<<4 | 15, argp_r0m1},                          // lea (360)
+	enc{[4]byte{0xc9, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 197, 1<<4 | 15, argp_},                                      // leave (361)
+	enc{[4]byte{0xf, 0xae, 0xe8, 0x00}, 0, featset_SSE2, 0<<11 | 198, 3<<4 | 15, argp_},                                               // lfence (362)
+	enc{[4]byte{0xf, 0xb4, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 199, 2<<4 | 15, argp_r0m1},                           // lfs (363)
+	enc{[4]byte{0xf, 0x1, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 200, 2<<4 | 2, argp_m1},                                       // lgdt (364)
+	enc{[4]byte{0xf, 0xb5, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 201, 2<<4 | 15, argp_r0m1},                           // lgs (365)
+	enc{[4]byte{0xf, 0x1, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 202, 2<<4 | 3, argp_m1},                                       // lidt (366)
+	enc{[4]byte{0xf, 0x0, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 203, 2<<4 | 2, argp_m1},                                       // lldt (367)
+	enc{[4]byte{0xf, 0x0, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 203, 2<<4 | 2, argp_rw},                                       // lldt (368)
+	enc{[4]byte{0x9, 0x12, 0x00, 0x00}, XOP_OP | AUTO_REXW, featset_AMD, 0<<11 | 204, 2<<4 | 0, argp_r0},                              // llwpcb (369)
+	enc{[4]byte{0xf, 0x1, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 205, 2<<4 | 6, argp_m1},                                       // lmsw (370)
+	enc{[4]byte{0xf, 0x1, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 205, 2<<4 | 6, argp_rw},                                       // lmsw (371)
+	enc{[4]byte{0xac, 0x00, 0x00, 0x00}, REP, featset_X64_IMPLICIT, 0<<11 | 206, 1<<4 | 15, argp_},                                    // lodsb (372)
+	enc{[4]byte{0xad, 0x00, 0x00, 0x00}, REP, featset_X64_IMPLICIT, 0<<11 | 207, 1<<4 | 15, argp_},                                    // lodsd (373)
+	enc{[4]byte{0xad, 0x00, 0x00, 0x00}, WITH_REXW | REP, featset_X64_IMPLICIT, 0<<11 | 208, 1<<4 | 15, argp_},                        // lodsq (374)
+	enc{[4]byte{0xad, 0x00, 0x00, 0x00}, WORD_SIZE | REP, featset_X64_IMPLICIT, 0<<11 | 209, 1<<4 | 15, argp_},                        // lodsw (375)
+	enc{[4]byte{0xe2, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 210, 1<<4 | 15, argp_ob},                                    // loop (376)
+	enc{[4]byte{0xe1, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 211, 1<<4 | 15, argp_ob},                                    // loope (377)
+	enc{[4]byte{0xe0, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 212, 1<<4 | 15, argp_ob},                                    // loopne (378)
+	enc{[4]byte{0xe0, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 213, 1<<4 | 15, argp_ob},                                    // loopnz (379)
+	enc{[4]byte{0xe1, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 214, 1<<4 | 15, argp_ob},                                    // loopz (380)
+	enc{[4]byte{0xf, 0x3, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 215, 2<<4 | 15, argp_r0mw},                            // lsl (381)
+	enc{[4]byte{0xf, 0x3, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 1<<11 | 215, 2<<4 | 15, argp_r0r0},                            // lsl (382)
+	enc{[4]byte{0xf, 0xb2, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 216, 2<<4 | 15, argp_r0m1},                           // lss (383)
+	enc{[4]byte{0xf, 0x0, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 217, 2<<4 | 3, argp_m1},                                       // ltr (384)
+	enc{[4]byte{0xf, 0x0, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 217, 2<<4 | 3, argp_rw},                                       // ltr (385)
+	enc{[4]byte{0x10, 0x12, 0x00, 0x00}, XOP_OP | AUTO_REXW | ENC_VM, featset_AMD, 0<<11 | 218, 2<<4 | 0, argp_r0v0id},                // lwpins (386)
+	enc{[4]byte{0x10, 0x12, 0x00, 0x00}, XOP_OP | AUTO_REXW | ENC_VM, featset_AMD, 0<<11 | 219, 2<<4 | 1, argp_r0v0id},                // lwpval (387)
+	enc{[4]byte{0xf, 0xbd, 0x00, 0x00}, AUTO_SIZE | PREF_F3, featset_LZCNT, 0<<11 | 220, 2<<4 | 15, argp_r0v0},                        // lzcnt (388)
+	enc{[4]byte{0xf, 0xf7, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 221, 2<<4 | 15, argp_yoyo},                                     // maskmovdqu (389)
+	enc{[4]byte{0xf, 0xf7, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 222, 2<<4 | 15, argp_xqxq},                                            // maskmovq (390)
+	enc{[4]byte{0xf, 0x5f, 0x00, 0x00}, PREF_F2, featset_SSE2, 0<<11 | 223, 2<<4 | 15, argp_yomq},                                     // maxsd (391)
+	enc{[4]byte{0xf, 0x5f, 0x00, 0x00}, PREF_F2, featset_SSE2, 1<<11 | 223, 2<<4 | 15, argp_yoyo},                                     // maxsd (392)
+	enc{[4]byte{0xf, 0x5f, 0x00, 0x00}, PREF_F3, featset_SSE, 0<<11 | 224, 2<<4 | 15, argp_yomd},                                      // maxss (393)
+	enc{[4]byte{0xf, 0x5f, 0x00, 0x00}, PREF_F3, featset_SSE, 1<<11 | 224, 2<<4 | 15, argp_yoyo},                                      // maxss (394)
+	enc{[4]byte{0xf, 0xae, 0xf0, 0x00}, 0, featset_SSE2, 0<<11 | 225, 3<<4 | 15, argp_},                                               // mfence (395)
+	enc{[4]byte{0xf, 0x5d, 0x00, 0x00}, PREF_F2, featset_SSE2, 0<<11 | 226, 2<<4 | 15, argp_yomq},                                     // minsd (396)
+	enc{[4]byte{0xf, 0x5d, 0x00, 0x00}, PREF_F2, featset_SSE2, 1<<11 | 226, 2<<4 | 15, argp_yoyo},                                     // minsd (397)
+	enc{[4]byte{0xf, 0x5d, 0x00, 0x00}, PREF_F3, featset_SSE, 0<<11 | 227, 2<<4 | 15, argp_yomd},                                      // minss (398)
+	enc{[4]byte{0xf, 0x5d, 0x00, 0x00}, PREF_F3, featset_SSE, 1<<11 | 227, 2<<4 | 15, argp_yoyo},                                      // minss (399)
+	enc{[4]byte{0xf, 0x1, 0xc8, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 228, 3<<4 | 15, argp_},                                        // monitor (400)
+	enc{[4]byte{0xf, 0x1, 0xc8, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 228, 3<<4 | 15, argp_AqBdCd},                                  // monitor (401)
+	enc{[4]byte{0xf, 0x1, 0xfa, 0x00}, 0, featset_AMD, 0<<11 | 229, 3<<4 | 15, argp_},                                                 // monitorx (402)
+	enc{[4]byte{0xf, 0x1, 0xfa, 0x00}, 0, featset_AMD, 1<<11 | 229, 3<<4 | 15, argp_A0BdCd},                                           // monitorx (403)
+	enc{[4]byte{0x89, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 0<<11 | 230, 1<<4 | 15, argp_v0r0},                          // mov (404)
+	enc{[4]byte{0x88, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 230, 1<<4 | 15, argp_vbrb},                                  // mov (405)
+	enc{[4]byte{0x8b, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 2<<11 | 230, 1<<4 | 15, argp_r0v0},                          // mov (406)
+	enc{[4]byte{0x8a, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 3<<11 | 230, 1<<4 | 15, argp_rbvb},                                  // mov (407)
+	enc{[4]byte{0x8c, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 4<<11 | 230, 1<<4 | 15, argp_r0sw},                          // mov (408)
+	enc{[4]byte{0x8c, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 5<<11 | 230, 1<<4 | 15, argp_mwsw},                                  // mov (409)
+	enc{[4]byte{0x8c, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 6<<11 | 230, 1<<4 | 15, argp_swmw},                                  // mov (410)
+	enc{[4]byte{0x8c, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 7<<11 | 230, 1<<4 | 15, argp_swrw},                                  // mov (411)
+	enc{[4]byte{0xb0, 0x00, 0x00, 0x00}, SHORT_ARG, featset_X64_IMPLICIT, 8<<11 | 230, 1<<4 | 15, argp_rbib},                          // mov (412)
+	enc{[4]byte{0xb8, 0x00, 0x00, 0x00}, WORD_SIZE | SHORT_ARG, featset_X64_IMPLICIT, 9<<11 | 230, 1<<4 | 15, argp_rwiw},              // mov (413)
+	enc{[4]byte{0xb8, 0x00, 0x00, 0x00}, SHORT_ARG, featset_X64_IMPLICIT, 10<<11 | 230, 1<<4 | 15, argp_rdid},                         // mov (414)
+	enc{[4]byte{0xb8, 0x00, 0x00, 0x00}, WITH_REXW | SHORT_ARG, featset_X64_IMPLICIT, 11<<11 | 230, 1<<4 | 15, argp_rqiq},             // mov (415)
+	enc{[4]byte{0xc7, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 12<<11 | 230, 1<<4 | 0, argp_v0i0},                          // mov (416)
+	enc{[4]byte{0xc6, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 13<<11 | 230, 1<<4 | 0, argp_vbib},                                  // mov (417)
+	enc{[4]byte{0xf, 0x22, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 14<<11 | 230, 2<<4 | 15, argp_cdrd},                                  // mov (418)
+	enc{[4]byte{0xf, 0x22, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 15<<11 | 230, 2<<4 | 15, argp_cqrq},                                  // mov (419)
+	enc{[4]byte{0xf, 0x20, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 16<<11 | 230, 2<<4 | 15, argp_rdcd},                                  // mov (420)
+	enc{[4]byte{0xf, 0x20, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 17<<11 | 230, 2<<4 | 15, argp_rqcq},                                  // mov (421)
+	enc{[4]byte{0xf, 0x22, 0x00, 0x00}, PREF_F0, featset_X64_IMPLICIT, 18<<11 | 230, 2<<4 | 0, argp_Wdrd},                             // mov (422)
+	enc{[4]byte{0xf, 0x22, 0x00, 0x00}, PREF_F0, featset_X64_IMPLICIT, 19<<11 | 230, 2<<4 | 0, argp_Wqrq},                             // mov (423)
+	enc{[4]byte{0xf, 0x22, 0x00, 0x00}, PREF_F0, featset_X64_IMPLICIT, 20<<11 | 230, 2<<4 | 0, argp_rdWd},                             // mov (424)
+	enc{[4]byte{0xf, 0x22, 0x00, 0x00}, PREF_F0, featset_X64_IMPLICIT, 21<<11 | 230, 2<<4 | 0, argp_rqWq},                             // mov (425)
+	enc{[4]byte{0xf, 0x23, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 22<<11 | 230, 2<<4 | 15, argp_ddrd},                                  // mov (426)
+	enc{[4]byte{0xf, 0x23, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 23<<11 | 230, 2<<4 | 15, argp_dqrq},                                  // mov (427)
+	enc{[4]byte{0xf, 0x21, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 24<<11 | 230, 2<<4 | 15, argp_rddd},                                  // mov (428)
+	enc{[4]byte{0xf, 0x21, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 25<<11 | 230, 2<<4 | 15, argp_rqdq},                                  // mov (429)
+	enc{[4]byte{0xa0, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 231, 1<<4 | 15, argp_Abiq},                                  // movabs (430)
+	enc{[4]byte{0xa1, 0x00, 0x00, 0x00}, WORD_SIZE, featset_X64_IMPLICIT, 1<<11 | 231, 1<<4 | 15, argp_Awiq},                          // movabs (431)
+	enc{[4]byte{0xa1, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 2<<11 | 231, 1<<4 | 15, argp_Adiq},                                  // movabs (432)
+	enc{[4]byte{0xa1, 0x00, 0x00, 0x00}, WITH_REXW, featset_X64_IMPLICIT, 3<<11 | 231, 1<<4 | 15, argp_Aqiq},                          // movabs (433)
+	enc{[4]byte{0xa2, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 4<<11 | 231, 1<<4 | 15, argp_iqAb},                                  // movabs (434)
+	enc{[4]byte{0xa3, 0x00, 0x00, 0x00}, WORD_SIZE, featset_X64_IMPLICIT, 5<<11 | 231, 1<<4 | 15, argp_iqAw},                          // movabs (435)
+	enc{[4]byte{0xa3, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 6<<11 | 231, 1<<4 | 15, argp_iqAd},                                  // movabs (436)
+	enc{[4]byte{0xa3, 0x00, 0x00, 0x00}, WITH_REXW, featset_X64_IMPLICIT, 7<<11 | 231, 1<<4 | 15, argp_iqAq},                          // movabs (437)
+	enc{[4]byte{0xf, 0x29, 0x00, 0x00}, PREF_66 | ENC_MR, featset_SSE2, 0<<11 | 232, 2<<4 | 15, argp_moyo},                            // movapd (438)
+	enc{[4]byte{0xf, 0x28, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 232, 2<<4 | 15, argp_yomo},                                     // movapd (439)
+	enc{[4]byte{0xf, 0x28, 0x00, 0x00}, PREF_66, featset_SSE2, 2<<11 | 232, 2<<4 | 15, argp_yoyo},                                     // movapd (440)
+	enc{[4]byte{0xf, 0x29, 0x00, 0x00}, PREF_66 | ENC_MR, featset_SSE2, 3<<11 | 232, 2<<4 | 15, argp_yoyo},                            // movapd (441)
+	enc{[4]byte{0xf, 0x28, 0x00, 0x00}, 0, featset_SSE, 0<<11 | 233, 2<<4 | 15, argp_yowo},                                            // movaps (442)
+	enc{[4]byte{0xf, 0x29, 0x00, 0x00}, ENC_MR, featset_SSE, 1<<11 | 233, 2<<4 | 15, argp_woyo},                                       // movaps (443)
+	enc{[4]byte{0xf, 0x38, 0xf1, 0x00}, AUTO_SIZE | ENC_MR, featset_MOVBE, 0<<11 | 234, 3<<4 | 15, argp_m0r0},                         // movbe (444)
+	enc{[4]byte{0xf, 0x38, 0xf0, 0x00}, AUTO_SIZE, featset_MOVBE, 1<<11 | 234, 3<<4 | 15, argp_r0m0},                                  // movbe (445)
+	enc{[4]byte{0xf, 0x7e, 0x00, 0x00}, PREF_66 | ENC_MR, featset_SSE2, 0<<11 | 235, 2<<4 | 15, argp_mdyo},                            // movd (446)
+	enc{[4]byte{0xf, 0x6e, 0x00, 0x00}, 0, featset_MMX, 1<<11 | 235, 2<<4 | 15, argp_xqvd},                                            // movd (447)
+	enc{[4]byte{0xf, 0x6e, 0x00, 0x00}, WITH_REXW, featset_MMX, 2<<11 | 235, 2<<4 | 15, argp_xqvq},                                    // movd (448)
+	enc{[4]byte{0xf, 0x6e, 0x00, 0x00}, PREF_66, featset_SSE2, 3<<11 | 235, 2<<4 | 15, argp_yomd},                                     // movd (449)
+	enc{[4]byte{0xf, 0x6e, 0x00, 0x00}, PREF_66, featset_SSE2, 4<<11 | 235, 2<<4 | 15, argp_yovd},                                     // movd (450)
+	enc{[4]byte{0xf, 0x7e, 0x00, 0x00}, ENC_MR, featset_MMX, 5<<11 | 235, 2<<4 | 15, argp_vdxq},                                       // movd (451)
+	enc{[4]byte{0xf, 0x7e, 0x00, 0x00}, PREF_66 | ENC_MR, featset_SSE2, 6<<11 | 235, 2<<4 | 15, argp_vdyo},                            // movd (452)
+	enc{[4]byte{0xf, 0x7e, 0x00, 0x00}, WITH_REXW | ENC_MR, featset_MMX, 7<<11 | 235, 2<<4 | 15, argp_vqxq},                           // movd (453)
+	enc{[4]byte{0xf, 0x12, 0x00, 0x00}, PREF_F2, featset_SSE3, 0<<11 | 236, 2<<4 | 15, argp_yomq},                                     // movddup (454)
+	enc{[4]byte{0xf, 0x12, 0x00, 0x00}, PREF_F2, featset_SSE3, 1<<11 | 236, 2<<4 | 15, argp_yoyo},                                     // movddup (455)
+	enc{[4]byte{0xf, 0xd6, 0x00, 0x00}, PREF_F2, featset_SSE2, 0<<11 | 237, 2<<4 | 15, argp_xqyo},                                     // movdq2q (456)
+	enc{[4]byte{0xf, 0x7f, 0x00, 0x00}, PREF_66 | ENC_MR, featset_SSE2, 0<<11 | 238, 2<<4 | 15, argp_moyo},                            // movdqa (457)
+	enc{[4]byte{0xf, 0x6f, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 238, 2<<4 | 15, argp_yomo},                                     // movdqa (458)
+	enc{[4]byte{0xf, 0x6f, 0x00, 0x00}, PREF_66, featset_SSE2, 2<<11 | 238, 2<<4 | 15, argp_yoyo},                                     // movdqa (459)
+	enc{[4]byte{0xf, 0x7f, 0x00, 0x00}, PREF_66 | ENC_MR, featset_SSE2, 3<<11 | 238, 2<<4 | 15, argp_yoyo},                            // movdqa (460)
+	enc{[4]byte{0xf, 0x7f, 0x00, 0x00}, PREF_F3 | ENC_MR, featset_SSE2, 0<<11 | 239, 2<<4 | 15, argp_moyo},                            // movdqu (461)
+	enc{[4]byte{0xf, 0x6f, 0x00, 0x00}, PREF_F3, featset_SSE2, 1<<11 | 239, 2<<4 | 15, argp_yomo},                                     // movdqu (462)
+	enc{[4]byte{0xf, 0x6f, 0x00, 0x00}, PREF_F3, featset_SSE2, 2<<11 | 239, 2<<4 | 15, argp_yoyo},                                     // movdqu (463)
+	enc{[4]byte{0xf, 0x7f, 0x00, 0x00}, PREF_F3 | ENC_MR, featset_SSE2, 3<<11 | 239, 2<<4 | 15, argp_yoyo},                            // movdqu (464)
+	enc{[4]byte{0xf, 0x12, 0x00, 0x00}, 0, featset_SSE, 0<<11 | 240, 2<<4 | 15, argp_yoyo},                                            // movhlps (465)
+	enc{[4]byte{0xf, 0x17, 0x00, 0x00}, PREF_66 | ENC_MR, featset_SSE2, 0<<11 | 241, 2<<4 | 15, argp_m1yo},                            // movhpd (466)
+	enc{[4]byte{0xf, 0x16, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 241, 2<<4 | 15, argp_yom1},                                     // movhpd (467)
+	enc{[4]byte{0xf, 0x17, 0x00, 0x00}, ENC_MR, featset_SSE, 0<<11 | 242, 2<<4 | 15, argp_mqyo},                                       // movhps (468)
+	enc{[4]byte{0xf, 0x16, 0x00, 0x00}, 0, featset_SSE, 1<<11 | 242, 2<<4 | 15, argp_yomq},                                            // movhps (469)
+	enc{[4]byte{0xf, 0x16, 0x00, 0x00}, 0, featset_SSE, 0<<11 | 243, 2<<4 | 15, argp_yoyo},                                            // movlhps (470)
+	enc{[4]byte{0xf, 0x13, 0x00, 0x00}, PREF_66 | ENC_MR, featset_SSE2, 0<<11 | 244, 2<<4 | 15, argp_mqyo},                            // movlpd (471)
+	enc{[4]byte{0xf, 0x12, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 244, 2<<4 | 15, argp_yomq},                                     // movlpd (472)
+	enc{[4]byte{0xf, 0x13, 0x00, 0x00}, ENC_MR, featset_SSE, 0<<11 | 245, 2<<4 | 15, argp_mqyo},                                       // movlps (473)
+	enc{[4]byte{0xf, 0x12, 0x00, 0x00}, 0, featset_SSE, 1<<11 | 245, 2<<4 | 15, argp_yomq},                                            // movlps (474)
+	enc{[4]byte{0xf, 0x50, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 246, 2<<4 | 15, argp_rdyo},                                     // movmskpd (475)
+	enc{[4]byte{0xf, 0x50, 0x00, 0x00}, WITH_REXW | PREF_66, featset_SSE2, 1<<11 | 246, 2<<4 | 15, argp_rqyo},                         // movmskpd (476)
+	enc{[4]byte{0xf, 0x50, 0x00, 0x00}, 0, featset_SSE, 0<<11 | 247, 2<<4 | 15, argp_rdyo},                                            // movmskps (477)
+	enc{[4]byte{0xf, 0x50, 0x00, 0x00}, WITH_REXW, featset_SSE, 1<<11 | 247, 2<<4 | 15, argp_rqyo},                                    // movmskps (478)
+	enc{[4]byte{0xf, 0xe7, 0x00, 0x00}, PREF_66 | ENC_MR, featset_SSE2, 0<<11 | 248, 2<<4 | 15, argp_moyo},                            // movntdq (479)
+	enc{[4]byte{0xf, 0x38, 0x2a, 0x00}, PREF_66, featset_SSE41, 0<<11 | 249, 3<<4 | 15, argp_yomo},                                    // movntdqa (480)
+	enc{[4]byte{0xf, 0xc3, 0x00, 0x00}, ENC_MR, featset_X64_IMPLICIT, 0<<11 | 250, 2<<4 | 15, argp_mdrd},                              // movnti (481)
+	enc{[4]byte{0xf, 0xc3, 0x00, 0x00}, WITH_REXW | ENC_MR, featset_X64_IMPLICIT, 1<<11 | 250, 2<<4 | 15, argp_mqrq},                  // movnti (482)
+	enc{[4]byte{0xf, 0x2b, 0x00, 0x00}, PREF_66 | ENC_MR, featset_SSE2, 0<<11 | 251, 2<<4 | 15, argp_moyo},                            // movntpd (483)
+	enc{[4]byte{0xf, 0x2b, 0x00, 0x00}, ENC_MR, featset_SSE, 0<<11 | 252, 2<<4 | 15, argp_moyo},                                       // movntps (484)
+	enc{[4]byte{0xf, 0xe7, 0x00, 0x00}, ENC_MR, featset_MMX, 0<<11 | 253, 2<<4 | 15, argp_mqxq},                                       // movntq (485)
+	enc{[4]byte{0xf, 0x2b, 0x00, 0x00}, PREF_F2 | ENC_MR, featset_SSE4A, 0<<11 | 254, 2<<4 | 15, argp_mqyo},                           // movntsd (486)
+	enc{[4]byte{0xf, 0x2b, 0x00, 0x00}, PREF_F3 | ENC_MR, featset_SSE4A, 0<<11 | 255, 2<<4 | 15, argp_mdyo},                           // movntss (487)
+	enc{[4]byte{0xf, 0xd6, 0x00, 0x00}, PREF_66 | ENC_MR, featset_SSE2, 0<<11 | 256, 2<<4 | 15, argp_mqyo},                            // movq (488)
+	enc{[4]byte{0xf, 0x6f, 0x00, 0x00}, 0, featset_MMX, 1<<11 | 256, 2<<4 | 15, argp_xquq},                                            // movq (489)
+	enc{[4]byte{0xf, 0x6e, 0x00, 0x00}, WITH_REXW, featset_MMX, 2<<11 | 256, 2<<4 | 15, argp_xqvq},                                    // movq (490)
+	enc{[4]byte{0xf, 0x7e, 0x00, 0x00}, PREF_F3, featset_SSE2, 3<<11 | 256, 2<<4 | 15, argp_yomq},                                     // movq (491)
+	enc{[4]byte{0xf, 0x6e, 0x00, 0x00}, WITH_REXW | PREF_66, featset_SSE2, 4<<11 | 256, 2<<4 | 15, argp_yovq},                         // movq (492)
+	enc{[4]byte{0xf, 0x7e, 0x00, 0x00}, PREF_F3, featset_SSE2, 5<<11 | 256, 2<<4 | 15, argp_yoyo},                                     // movq (493)
+	enc{[4]byte{0xf, 0xd6, 0x00, 0x00}, PREF_66 | ENC_MR, featset_SSE2, 6<<11 | 256, 2<<4 | 15, argp_yoyo},                            // movq (494)
+	enc{[4]byte{0xf, 0x7f, 0x00, 0x00}, ENC_MR, featset_MMX, 7<<11 | 256, 2<<4 | 15, argp_uqxq},                                       // movq (495)
+	enc{[4]byte{0xf, 0x7e, 0x00, 0x00}, WITH_REXW | ENC_MR, featset_MMX, 8<<11 | 256, 2<<4 | 15, argp_vqxq},                           // movq (496)
+	enc{[4]byte{0xf, 0x7e, 0x00, 0x00}, WITH_REXW | PREF_66 | ENC_MR, featset_SSE2, 9<<11 | 256, 2<<4 | 15, argp_vqyo},                // movq (497)
+	enc{[4]byte{0xf, 0xd6, 0x00, 0x00}, PREF_F3, featset_SSE2, 0<<11 | 257, 2<<4 | 15, argp_yoxq},                                     // movq2dq (498)
+	enc{[4]byte{0xa4, 0x00, 0x00, 0x00}, REP, featset_X64_IMPLICIT, 0<<11 | 258, 1<<4 | 15, argp_},                                    // movsb (499)
+	enc{[4]byte{0xa5, 0x00, 0x00, 0x00}, REP, featset_X64_IMPLICIT, 0<<11 | 259, 1<<4 | 15, argp_},                                    // movsd (500)
+	enc{[4]byte{0xf, 0x11, 0x00, 0x00}, PREF_F2 | ENC_MR, featset_SSE2, 1<<11 | 259, 2<<4 | 15, argp_mqyo},                            // movsd (501)
+	enc{[4]byte{0xf, 0x10, 0x00, 0x00}, PREF_F2, featset_SSE2, 2<<11 | 259, 2<<4 | 15, argp_yomq},                                     // movsd (502)
+	enc{[4]byte{0xf, 0x10, 0x00, 0x00}, PREF_F2, featset_SSE2, 3<<11 | 259, 2<<4 | 15, argp_yoyo},                                     // movsd (503)
+	enc{[4]byte{0xf, 0x11, 0x00, 0x00}, PREF_F2 | ENC_MR, featset_SSE2, 4<<11 | 259, 2<<4 | 15, argp_yoyo},                            // movsd (504)
+	enc{[4]byte{0xf, 0x16, 0x00, 0x00}, PREF_F3, featset_SSE3, 0<<11 | 260, 2<<4 | 15, argp_yomq},                                     // movshdup (505)
+	enc{[4]byte{0xf, 0x16, 0x00, 0x00}, PREF_F3, featset_SSE3, 1<<11 | 260, 2<<4 | 15, argp_yoyo},                                     // movshdup (506)
+	enc{[4]byte{0xf, 0x12, 0x00, 0x00}, PREF_F3, featset_SSE3, 0<<11 | 261, 2<<4 | 15, argp_yomq},                                     // movsldup (507)
+	enc{[4]byte{0xf, 0x12, 0x00, 0x00}, PREF_F3, featset_SSE3, 1<<11 | 261, 2<<4 | 15, argp_yoyo},                                     // movsldup (508)
+	enc{[4]byte{0xa5, 0x00, 0x00, 0x00}, WITH_REXW | REP, featset_X64_IMPLICIT, 0<<11 | 262, 1<<4 | 15, argp_},                        // movsq (509)
+	enc{[4]byte{0xf, 0x11, 0x00, 0x00}, PREF_F3 | ENC_MR, featset_SSE, 0<<11 | 263, 2<<4 | 15, argp_mdyo},                             // movss (510)
+	enc{[4]byte{0xf, 0x10, 0x00, 0x00}, PREF_F3, featset_SSE, 1<<11 | 263, 2<<4 | 15, argp_yomd},                                      // movss (511)
+	enc{[4]byte{0xf, 0x10, 0x00, 0x00}, PREF_F3, featset_SSE, 2<<11 | 263, 2<<4 | 15, argp_yoyo},                                      // movss (512)
+	enc{[4]byte{0xa5, 0x00, 0x00, 0x00}, WORD_SIZE | REP, featset_X64_IMPLICIT, 0<<11 | 264, 1<<4 | 15, argp_},                        // movsw (513)
+	enc{[4]byte{0xf, 0xbe, 0x00, 0x00}, WORD_SIZE, featset_X64_IMPLICIT, 0<<11 | 265, 2<<4 | 15, argp_rwmb},                           // movsx (514)
+	enc{[4]byte{0xf, 0xbe, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 1<<11 | 265, 2<<4 | 15, argp_r0vb},                           // movsx (515)
+	enc{[4]byte{0xf, 0xbf, 0x00, 0x00}, AUTO_REXW | EXACT_SIZE, featset_X64_IMPLICIT, 2<<11 | 265, 2<<4 | 15, argp_r0vw},              // movsx (516)
+	enc{[4]byte{0x63, 0x00, 0x00, 0x00}, WITH_REXW, featset_X64_IMPLICIT, 3<<11 | 265, 1<<4 | 15, argp_rqvd},                          // movsx (517)
+	enc{[4]byte{0x63, 0x00, 0x00, 0x00}, WITH_REXW, featset_X64_IMPLICIT, 0<<11 | 266, 1<<4 | 15, argp_rqvd},                          // movsxd (518)
+	enc{[4]byte{0xf, 0x11, 0x00, 0x00}, PREF_66 | ENC_MR, featset_SSE2, 0<<11 | 267, 2<<4 | 15, argp_moyo},                            // movupd (519)
+	enc{[4]byte{0xf, 0x10, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 267, 2<<4 | 15, argp_yomo},                                     // movupd (520)
+	enc{[4]byte{0xf, 0x10, 0x00, 0x00}, PREF_66, featset_SSE2, 2<<11 | 267, 2<<4 | 15, argp_yoyo},                                     // movupd (521)
+	enc{[4]byte{0xf, 0x11, 0x00, 0x00}, PREF_66 | ENC_MR, featset_SSE2, 3<<11 | 267, 2<<4 | 15, argp_yoyo},                            // movupd (522)
+	enc{[4]byte{0xf, 0x10, 0x00, 0x00}, 0, featset_SSE, 0<<11 | 268, 2<<4 | 15, argp_yowo},                                            // movups (523)
+	enc{[4]byte{0xf, 0x11, 0x00, 0x00}, ENC_MR, featset_SSE, 1<<11 | 268, 2<<4 | 15, argp_woyo},                                       // movups (524)
+	enc{[4]byte{0xf, 0xb6, 0x00, 0x00}, WORD_SIZE, featset_X64_IMPLICIT, 0<<11 | 269, 2<<4 | 15, argp_rwmb},                           // movzx (525)
+	enc{[4]byte{0xf, 0xb6, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 1<<11 | 269, 2<<4 | 15, argp_r0vb},                           // movzx (526)
+	enc{[4]byte{0xf, 0xb7, 0x00, 0x00}, AUTO_REXW | EXACT_SIZE, featset_X64_IMPLICIT, 2<<11 | 269, 2<<4 | 15, argp_r0vw},              // movzx (527)
+	enc{[4]byte{0xf, 0x3a, 0x42, 0x00}, PREF_66, featset_SSE41, 0<<11 | 270, 3<<4 | 15, argp_yomqib},                                  // mpsadbw (528)
+	enc{[4]byte{0xf, 0x3a, 0x42, 0x00}, PREF_66, featset_SSE41, 1<<11 | 270, 3<<4 | 15, argp_yoyoib},                                  // mpsadbw (529)
+	enc{[4]byte{0xf6, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 271, 1<<4 | 4, argp_vb},                                     // mul (530)
+	enc{[4]byte{0xf7, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 1<<11 | 271, 1<<4 | 4, argp_v0},                             // mul (531)
+	enc{[4]byte{0xf, 0x59, 0x00, 0x00}, PREF_F2, featset_SSE2, 0<<11 | 272, 2<<4 | 15, argp_yomq},                                     // mulsd (532)
+	enc{[4]byte{0xf, 0x59, 0x00, 0x00}, PREF_F2, featset_SSE2, 1<<11 | 272, 2<<4 | 15, argp_yoyo},                                     // mulsd (533)
+	enc{[4]byte{0xf, 0x59, 0x00, 0x00}, PREF_F3, featset_SSE, 0<<11 | 273, 2<<4 | 15, argp_yomd},                                      // mulss (534)
+	enc{[4]byte{0xf, 0x59, 0x00, 0x00}, PREF_F3, featset_SSE, 1<<11 | 273, 2<<4 | 15, argp_yoyo},                                      // mulss (535)
+	enc{[4]byte{0x2, 0xf6, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F2, featset_BMI2, 0<<11 | 274, 2<<4 | 15, argp_r0r0v0},              // mulx (536)
+	enc{[4]byte{0xf, 0x1, 0xc9, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 275, 3<<4 | 15, argp_},                                        // mwait (537)
+	enc{[4]byte{0xf, 0x1, 0xc9, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 275, 3<<4 | 15, argp_AdBd},                                    // mwait (538)
+	enc{[4]byte{0xf, 0x1, 0xfb, 0x00}, 0, featset_AMD, 0<<11 | 276, 3<<4 | 15, argp_},                                                 // mwaitx (539)
+	enc{[4]byte{0xf, 0x1, 0xfb, 0x00}, 0, featset_AMD, 1<<11 | 276, 3<<4 | 15, argp_AdBd},                                             // mwaitx (540)
+	enc{[4]byte{0xf6, 0x00, 0x00, 0x00}, LOCK, featset_X64_IMPLICIT, 0<<11 | 277, 1<<4 | 3, argp_mb},                                  // neg (541)
+	enc{[4]byte{0xf6, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 277, 1<<4 | 3, argp_rb},                                     // neg (542)
+	enc{[4]byte{0xf7, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, featset_X64_IMPLICIT, 2<<11 | 277, 1<<4 | 3, argp_m0},                      // neg (543)
+	enc{[4]byte{0xf7, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 3<<11 | 277, 1<<4 | 3, argp_r0},                             // neg (544)
+	enc{[4]byte{0x90, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 278, 1<<4 | 15, argp_},                                      // nop (545)
+	enc{[4]byte{0xf, 0x1f, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 1<<11 | 278, 2<<4 | 0, argp_v0},                              // nop (546)
+	enc{[4]byte{0xf6, 0x00, 0x00, 0x00}, LOCK, featset_X64_IMPLICIT, 0<<11 | 279, 1<<4 | 2, argp_mb},                                  // not (547)
+	enc{[4]byte{0xf6, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 279, 1<<4 | 2, argp_rb},                                     // not (548)
+	enc{[4]byte{0xf7, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, featset_X64_IMPLICIT, 2<<11 | 279, 1<<4 | 2, argp_m0},                      // not (549)
+	enc{[4]byte{0xf7, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 3<<11 | 279, 1<<4 | 2, argp_r0},                             // not (550)
+	enc{[4]byte{0xc, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 280, 1<<4 | 15, argp_Abib},                                   // or (551)
+	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, LOCK, featset_X64_IMPLICIT, 1<<11 | 280, 1<<4 | 1, argp_mbib},                                // or (552)
+	enc{[4]byte{0x8, 0x00, 0x00, 0x00}, LOCK | ENC_MR, featset_X64_IMPLICIT, 2<<11 | 280, 1<<4 | 15, argp_mbrb},                       // or (553)
+	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 3<<11 | 280, 1<<4 | 1, argp_rbib},                                   // or (554)
+	enc{[4]byte{0x8, 0x00, 0x00, 0x00}, ENC_MR, featset_X64_IMPLICIT, 4<<11 | 280, 1<<4 | 15, argp_rbrb},                              // or (555)
+	enc{[4]byte{0xa, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 5<<11 | 280, 1<<4 | 15, argp_rbvb},                                   // or (556)
+	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | EXACT_SIZE, featset_X64_IMPLICIT, 6<<11 | 280, 1<<4 | 1, argp_r0ib},              // or (557)
+	enc{[4]byte{0xd, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 7<<11 | 280, 1<<4 | 15, argp_A0i0},                           // or (558)
+	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, featset_X64_IMPLICIT, 8<<11 | 280, 1<<4 | 1, argp_m0i0},                    // or (559)
+	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, featset_X64_IMPLICIT, 9<<11 | 280, 1<<4 | 1, argp_m0ib},                    // or (560)
+	enc{[4]byte{0x9, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK | ENC_MR, featset_X64_IMPLICIT, 10<<11 | 280, 1<<4 | 15, argp_m0r0},          // or (561)
+	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 11<<11 | 280, 1<<4 | 1, argp_r0i0},                          // or (562)
+	enc{[4]byte{0x9, 0x00, 0x00, 0x00}, AUTO_SIZE | ENC_MR, featset_X64_IMPLICIT, 12<<11 | 280, 1<<4 | 15, argp_r0r0},                 // or (563)
+	enc{[4]byte{0xb, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 13<<11 | 280, 1<<4 | 15, argp_r0v0},                          // or (564)
+	enc{[4]byte{0xe6, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 281, 1<<4 | 15, argp_ibAb},                                  // out (565)
+	enc{[4]byte{0xe7, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 281, 1<<4 | 15, argp_ibAw},                                  // out (566)
+	enc{[4]byte{0xe7, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 2<<11 | 281, 1<<4 | 15, argp_ibAd},                                  // out (567)
+	enc{[4]byte{0xee, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 3<<11 | 281, 1<<4 | 15, argp_CwAb},                                  // out (568)
+	enc{[4]byte{0xef, 0x00, 0x00, 0x00}, WORD_SIZE, featset_X64_IMPLICIT, 4<<11 | 281, 1<<4 | 15, argp_CwAw},                          // out (569)
+	enc{[4]byte{0xef, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 5<<11 | 281, 1<<4 | 15, argp_CwAd},                                  // out (570)
+	enc{[4]byte{0x6e, 0x00, 0x00, 0x00}, REP, featset_X64_IMPLICIT, 0<<11 | 282, 1<<4 | 15, argp_},                                    // outsb (571)
+	enc{[4]byte{0x6f, 0x00, 0x00, 0x00}, REP, featset_X64_IMPLICIT, 0<<11 | 283, 1<<4 | 15, argp_},                                    // outsd (572)
+	enc{[4]byte{0x6f, 0x00, 0x00, 0x00}, WORD_SIZE | REP, featset_X64_IMPLICIT, 0<<11 | 284, 1<<4 | 15, argp_},                        // outsw (573)
+	enc{[4]byte{0x90, 0x00, 0x00, 0x00}, PREF_F3, featset_X64_IMPLICIT, 0<<11 | 285, 1<<4 | 15, argp_},                                // pause (574)
+	enc{[4]byte{0xf, 0xa1, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 286, 2<<4 | 15, argp_Uw},                                     // pop (575)
+	enc{[4]byte{0xf, 0xa9, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 286, 2<<4 | 15, argp_Vw},                                     // pop (576)
+	enc{[4]byte{0x58, 0x00, 0x00, 0x00}, AUTO_NO32 | SHORT_ARG, featset_X64_IMPLICIT, 2<<11 | 286, 1<<4 | 15, argp_r0},                // pop (577)
+	enc{[4]byte{0x8f, 0x00, 0x00, 0x00}, AUTO_NO32, featset_X64_IMPLICIT, 3<<11 | 286, 1<<4 | 0, argp_v0},                             // pop (578)
+	enc{[4]byte{0xf, 0xb8, 0x00, 0x00}, AUTO_SIZE | PREF_F3, featset_POPCNT, 0<<11 | 287, 2<<4 | 15, argp_r0v0},                       // popcnt (579)
+	enc{[4]byte{0x9d, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 288, 1<<4 | 15, argp_},                                      // popf (580)
+	enc{[4]byte{0x9d, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 289, 1<<4 | 15, argp_},                                      // popfq (581)
+	enc{[4]byte{0x9d, 0x00, 0x00, 0x00}, WORD_SIZE, featset_X64_IMPLICIT, 0<<11 | 290, 1<<4 | 15, argp_},                              // popfw (582)
+	enc{[4]byte{0xf, 0xd, 0x00, 0x00}, 0, featset_TDNOW, 0<<11 | 291, 2<<4 | 0, argp_mq},                                              // prefetch (583)
+	enc{[4]byte{0xf, 0x18, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 292, 2<<4 | 0, argp_mb},                                      // prefetchnta (584)
+	enc{[4]byte{0xf, 0x18, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 293, 2<<4 | 1, argp_mb},                                      // prefetcht0 (585)
+	enc{[4]byte{0xf, 0x18, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 294, 2<<4 | 2, argp_mb},                                      // prefetcht1 (586)
+	enc{[4]byte{0xf, 0x18, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 295, 2<<4 | 3, argp_mb},                                      // prefetcht2 (587)
+	enc{[4]byte{0xf, 0xd, 0x00, 0x00}, 0, featset_TDNOW, 0<<11 | 296, 2<<4 | 1, argp_mq},                                              // prefetchw (588)
+	enc{[4]byte{0xf, 0xd, 0x00, 0x00}, 0, featset_PREFETCHWT1, 0<<11 | 297, 2<<4 | 2, argp_mb},                                        // prefetchwt1 (589)
+	enc{[4]byte{0xf, 0xa0, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 298, 2<<4 | 15, argp_Uw},                                     // push (590)
+	enc{[4]byte{0xf, 0xa8, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 298, 2<<4 | 15, argp_Vw},                                     // push (591)
+	enc{[4]byte{0x6a, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_X64_IMPLICIT, 2<<11 | 298, 1<<4 | 15, argp_ib},                           // push (592)
+	enc{[4]byte{0x68, 0x00, 0x00, 0x00}, WORD_SIZE | EXACT_SIZE, featset_X64_IMPLICIT, 3<<11 | 298, 1<<4 | 15, argp_iw},               // push (593)
+	enc{[4]byte{0x68, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 4<<11 | 298, 1<<4 | 15, argp_id},                                    // push (594)
+	enc{[4]byte{0x50, 0x00, 0x00, 0x00}, AUTO_NO32 | SHORT_ARG, featset_X64_IMPLICIT, 5<<11 | 298, 1<<4 | 15, argp_r0},                // push (595)
+	enc{[4]byte{0xff, 0x00, 0x00, 0x00}, AUTO_NO32, featset_X64_IMPLICIT, 6<<11 | 298, 1<<4 | 6, argp_v0},                             // push (596)
+	enc{[4]byte{0x9c, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 299, 1<<4 | 15, argp_},                                      // pushf (597)
+	enc{[4]byte{0x9c, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 300, 1<<4 | 15, argp_},                                      // pushfq (598)
+	enc{[4]byte{0x9c, 0x00, 0x00, 0x00}, WORD_SIZE, featset_X64_IMPLICIT, 0<<11 | 301, 1<<4 | 15, argp_},                              // pushfw (599)
+	enc{[4]byte{0xd2, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 302, 1<<4 | 2, argp_vbBb},                                   // rcl (600)
+	enc{[4]byte{0xc0, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 302, 1<<4 | 2, argp_vbib},                                   // rcl (601)
+	enc{[4]byte{0xd3, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 2<<11 | 302, 1<<4 | 2, argp_v0Bb},                           // rcl (602)
+	enc{[4]byte{0xc1, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 3<<11 | 302, 1<<4 | 2, argp_v0ib},                           // rcl (603)
+	enc{[4]byte{0xf, 0x53, 0x00, 0x00}, PREF_F3, featset_SSE, 0<<11 | 303, 2<<4 | 15, argp_yomd},                                      // rcpss (604)
+	enc{[4]byte{0xf, 0x53, 0x00, 0x00}, PREF_F3, featset_SSE, 1<<11 | 303, 2<<4 | 15, argp_yoyo},                                      // rcpss (605)
+	enc{[4]byte{0xd2, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 304, 1<<4 | 3, argp_vbBb},                                   // rcr (606)
+	enc{[4]byte{0xc0, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 304, 1<<4 | 3, argp_vbib},                                   // rcr (607)
+	enc{[4]byte{0xd3, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 2<<11 | 304, 1<<4 | 3, argp_v0Bb},                           // rcr (608)
+	enc{[4]byte{0xc1, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 3<<11 | 304, 1<<4 | 3, argp_v0ib},                           // rcr (609)
+	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, PREF_F3, featset_X64_IMPLICIT, 0<<11 | 305, 2<<4 | 0, argp_rd},                                // rdfsbase (610)
+	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, WITH_REXW | PREF_F3, featset_X64_IMPLICIT, 1<<11 | 305, 2<<4 | 0, argp_rq},                    // rdfsbase (611)
+	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, PREF_F3, featset_X64_IMPLICIT, 0<<11 | 306, 2<<4 | 1, argp_rd},                                // rdgsbase (612)
+	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, WITH_REXW | PREF_F3, featset_X64_IMPLICIT, 1<<11 | 306, 2<<4 | 1, argp_rq},                    // rdgsbase (613)
+	enc{[4]byte{0xf, 0x32, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 307, 2<<4 | 15, argp_},                                       // rdmsr (614)
+	enc{[4]byte{0xf, 0xc7, 0x00, 0x00}, PREF_F3, featset_RDPID, 0<<11 | 308, 2<<4 | 7, argp_rq},                                       // rdpid (615)
+	enc{[4]byte{0xf, 0x1, 0xee, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 309, 3<<4 | 15, argp_},                                        // rdpkru (616)
+	enc{[4]byte{0xf, 0x33, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 310, 2<<4 | 15, argp_},                                       // rdpmc (617)
+	enc{[4]byte{0xf, 0xc7, 0x00, 0x00}, WITH_REXW, featset_RDRAND, 0<<11 | 311, 2<<4 | 6, argp_rq},                                    // rdrand (618)
+	enc{[4]byte{0xf, 0xc7, 0x00, 0x00}, WITH_REXW, featset_RDSEED, 0<<11 | 312, 2<<4 | 7, argp_rq},                                    // rdseed (619)
+	enc{[4]byte{0xf, 0x31, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 313, 2<<4 | 15, argp_},                                       // rdtsc (620)
+	enc{[4]byte{0xf, 0x1, 0xf9, 0x00}, 0, featset_RDTSCP, 0<<11 | 314, 3<<4 | 15, argp_},                                              // rdtscp (621)
+	enc{[4]byte{0xc3, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 315, 1<<4 | 15, argp_},                                      // ret (622)
+	enc{[4]byte{0xc2, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 315, 1<<4 | 15, argp_iw},                                    // ret (623)
+	enc{[4]byte{0xcb, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 316, 1<<4 | 15, argp_},                                      // retf (624)
+	enc{[4]byte{0xca, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 316, 1<<4 | 15, argp_iw},                                    // retf (625)
+	enc{[4]byte{0xc3, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 317, 1<<4 | 15, argp_},                                      // retn (626)
+	enc{[4]byte{0xc2, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 317, 1<<4 | 15, argp_iw},                                    // retn (627)
+	enc{[4]byte{0xd2, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 318, 1<<4 | 0, argp_vbBb},                                   // rol (628)
+	enc{[4]byte{0xc0, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 318, 1<<4 | 0, argp_vbib},                                   // rol (629)
+	enc{[4]byte{0xd3, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 2<<11 | 318, 1<<4 | 0, argp_v0Bb},                           // rol (630)
+	enc{[4]byte{0xc1, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 3<<11 | 318, 1<<4 | 0, argp_v0ib},                           // rol (631)
+	enc{[4]byte{0xd2, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 319, 1<<4 | 1, argp_vbBb},                                   // ror (632)
+	enc{[4]byte{0xc0, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 319, 1<<4 | 1, argp_vbib},                                   // ror (633)
+	enc{[4]byte{0xd3, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 2<<11 | 319, 1<<4 | 1, argp_v0Bb},                           // ror (634)
+	enc{[4]byte{0xc1, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 3<<11 | 319, 1<<4 | 1, argp_v0ib},                           // ror (635)
+	enc{[4]byte{0x3, 0xf0, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F2, featset_BMI2, 0<<11 | 320, 2<<4 | 15, argp_r0v0ib},              // rorx (636)
+	enc{[4]byte{0xf, 0x3a, 0xb, 0x00}, PREF_66, featset_SSE41, 0<<11 | 321, 3<<4 | 15, argp_yomqib},                                   // roundsd (637)
+	enc{[4]byte{0xf, 0x3a, 0xb, 0x00}, PREF_66, featset_SSE41, 1<<11 | 321, 3<<4 | 15, argp_yoyoib},                                   // roundsd (638)
+	enc{[4]byte{0xf, 0x3a, 0xa, 0x00}, PREF_66, featset_SSE41, 0<<11 | 322, 3<<4 | 15, argp_yomqib},                                   // roundss (639)
+	enc{[4]byte{0xf, 0x3a, 0xa, 0x00}, PREF_66, featset_SSE41, 1<<11 | 322, 3<<4 | 15, argp_yoyoib},                                   // roundss (640)
+	enc{[4]byte{0xf, 0xaa, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 323, 2<<4 | 15, argp_},                                       // rsm (641)
+	enc{[4]byte{0xf, 0x52, 0x00, 0x00}, PREF_F3, featset_SSE, 0<<11 | 324, 2<<4 | 15, argp_yomd},                                      // rsqrtss (642)
+	enc{[4]byte{0xf, 0x52, 0x00, 0x00}, PREF_F3, featset_SSE, 1<<11 | 324, 2<<4 | 15, argp_yoyo},                                      // rsqrtss (643)
+	enc{[4]byte{0x9e, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 325, 1<<4 | 15, argp_},                                      // sahf (644)
+	enc{[4]byte{0xd2, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 326, 1<<4 | 4, argp_vbBb},                                   // sal (645)
+	enc{[4]byte{0xc0, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 326, 1<<4 | 4, argp_vbib},                                   // sal (646)
+	enc{[4]byte{0xd3, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 2<<11 | 326, 1<<4 | 4, argp_v0Bb},                           // sal (647)
+	enc{[4]byte{0xc1, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 3<<11 | 326, 1<<4 | 4, argp_v0ib},                           // sal (648)
+	enc{[4]byte{0xd2, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 327, 1<<4 | 7, argp_vbBb},                                   // sar (649)
+	enc{[4]byte{0xc0, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 327, 1<<4 | 7, argp_vbib},                                   // sar (650)
+	enc{[4]byte{0xd3, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 2<<11 | 327, 1<<4 | 7, argp_v0Bb},                           // sar (651)
+	enc{[4]byte{0xc1, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 3<<11 | 327, 1<<4 | 7, argp_v0ib},                           // sar (652)
+	enc{[4]byte{0x2, 0xf7, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F3 | ENC_MR, featset_BMI2, 0<<11 | 328, 2<<4 | 15, argp_r0v0r0},     // sarx (653)
+	enc{[4]byte{0x1c, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 329, 1<<4 | 15, argp_Abib},                                  // sbb (654)
+	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, LOCK, featset_X64_IMPLICIT, 1<<11 | 329, 1<<4 | 3, argp_mbib},                                // sbb (655)
+	enc{[4]byte{0x18, 0x00, 0x00, 0x00}, LOCK | ENC_MR, featset_X64_IMPLICIT, 2<<11 | 329, 1<<4 | 15, argp_mbrb},                      // sbb (656)
+	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 3<<11 | 329, 1<<4 | 3, argp_rbib},                                   // sbb (657)
+	enc{[4]byte{0x18, 0x00, 0x00, 0x00}, ENC_MR, featset_X64_IMPLICIT, 4<<11 | 329, 1<<4 | 15, argp_rbrb},                             // sbb (658)
+	enc{[4]byte{0x1a, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 5<<11 | 329, 1<<4 | 15, argp_rbvb},                                  // sbb (659)
+	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | EXACT_SIZE, featset_X64_IMPLICIT, 6<<11 | 329, 1<<4 | 3, argp_r0ib},              // sbb (660)
+	enc{[4]byte{0x1d, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 7<<11 | 329, 1<<4 | 15, argp_A0i0},                          // sbb (661)
+	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, featset_X64_IMPLICIT, 8<<11 | 329, 1<<4 | 3, argp_m0i0},                    // sbb (662)
+	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, featset_X64_IMPLICIT, 9<<11 | 329, 1<<4 | 3, argp_m0ib},                    // sbb (663)
+	enc{[4]byte{0x19, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK | ENC_MR, featset_X64_IMPLICIT, 10<<11 | 329, 1<<4 | 15, argp_m0r0},         // sbb (664)
+	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 11<<11 | 329, 1<<4 | 3, argp_r0i0},                          // sbb (665)
+	enc{[4]byte{0x19, 0x00, 0x00, 0x00}, AUTO_SIZE | ENC_MR, featset_X64_IMPLICIT, 12<<11 | 329, 1<<4 | 15, argp_r0r0},                // sbb (666)
+	enc{[4]byte{0x1b, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 13<<11 | 329, 1<<4 | 15, argp_r0v0},                         // sbb (667)
+	enc{[4]byte{0xae, 0x00, 0x00, 0x00}, REPE, featset_X64_IMPLICIT, 0<<11 | 330, 1<<4 | 15, argp_},                                   // scasb (668)
+	enc{[4]byte{0xaf, 0x00, 0x00, 0x00}, REPE, featset_X64_IMPLICIT, 0<<11 | 331, 1<<4 | 15, argp_},                                   // scasd (669)
+	enc{[4]byte{0xaf, 0x00, 0x00, 0x00}, WITH_REXW | REPE, featset_X64_IMPLICIT, 0<<11 | 332, 1<<4 | 15, argp_},                       // scasq (670)
+	enc{[4]byte{0xaf, 0x00, 0x00, 0x00}, WORD_SIZE | REPE, featset_X64_IMPLICIT, 0<<11 | 333, 1<<4 | 15, argp_},                       // scasw (671)
+	enc{[4]byte{0xf, 0x97, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 334, 2<<4 | 0, argp_vb},                                      // seta (672)
+	enc{[4]byte{0xf, 0x93, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 335, 2<<4 | 0, argp_vb},                                      // setae (673)
+	enc{[4]byte{0xf, 0x92, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 336, 2<<4 | 0, argp_vb},                                      // setb (674)
+	enc{[4]byte{0xf, 0x96, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 337, 2<<4 | 0, argp_vb},                                      // setbe (675)
+	enc{[4]byte{0xf, 0x92, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 338, 2<<4 | 0, argp_vb},                                      // setc (676)
+	enc{[4]byte{0xf, 0x94, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 339, 2<<4 | 0, argp_vb},                                      // sete (677)
+	enc{[4]byte{0xf, 0x9f, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 340, 2<<4 | 0, argp_vb},                                      // setg (678)
+	enc{[4]byte{0xf, 0x9d, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 341, 2<<4 | 0, argp_vb},                                      // setge (679)
+	enc{[4]byte{0xf, 0x9c, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 342, 2<<4 | 0, argp_vb},                                      // setl (680)
+	enc{[4]byte{0xf, 0x9e, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 343, 2<<4 | 0, argp_vb},                                      // setle (681)
+	enc{[4]byte{0xf, 0x96, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 344, 2<<4 | 0, argp_vb},                                      // setna (682)
+	enc{[4]byte{0xf, 0x92, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 345, 2<<4 | 0, argp_vb},                                      // setnae (683)
+	enc{[4]byte{0xf, 0x93, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 346, 2<<4 | 0, argp_vb},                                      // setnb (684)
+	enc{[4]byte{0xf, 0x97, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 347, 2<<4 | 0, argp_vb},                                      // setnbe (685)
+	enc{[4]byte{0xf, 0x93, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 348, 2<<4 | 0, argp_vb},                                      // setnc (686)
+	enc{[4]byte{0xf, 0x95, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 349, 2<<4 | 0, argp_vb},                                      // setne (687)
+	enc{[4]byte{0xf, 0x9e, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 350, 2<<4 | 0, argp_vb},                                      // setng (688)
+	enc{[4]byte{0xf, 0x9c, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 351, 2<<4 | 0, argp_vb},                                      // setnge (689)
+	enc{[4]byte{0xf, 0x9d, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 352, 2<<4 | 0, argp_vb},                                      // setnl (690)
+	enc{[4]byte{0xf, 0x9f, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 353, 2<<4 | 0, argp_vb},                                      // setnle (691)
+	enc{[4]byte{0xf, 0x91, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 354, 2<<4 | 0, argp_vb},                                      // setno (692)
+	enc{[4]byte{0xf, 0x9b, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 355, 2<<4 | 0, argp_vb},                                      // setnp (693)
+	enc{[4]byte{0xf, 0x99, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 356, 2<<4 | 0, argp_vb},                                      // setns (694)
+	enc{[4]byte{0xf, 0x95, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 357, 2<<4 | 0, argp_vb},                                      // setnz (695)
+	enc{[4]byte{0xf, 0x90, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 358, 2<<4 | 0, argp_vb},                                      // seto (696)
+	enc{[4]byte{0xf, 0x9a, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 359, 2<<4 | 0, argp_vb},                                      // setp (697)
+	enc{[4]byte{0xf, 0x9a, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 360, 2<<4 | 0, argp_vb},                                      // setpe (698)
+	enc{[4]byte{0xf, 0x9b, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 361, 2<<4 | 0, argp_vb},                                      // setpo (699)
+	enc{[4]byte{0xf, 0x98, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 362, 2<<4 | 0, argp_vb},                                      // sets (700)
+	enc{[4]byte{0xf, 0x94, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 363, 2<<4 | 0, argp_vb},                                      // setz (701)
+	enc{[4]byte{0xf, 0xae, 0xf8, 0x00}, 0, featset_SSE2, 0<<11 | 364, 3<<4 | 15, argp_},                                               // sfence (702)
+	enc{[4]byte{0xf, 0x1, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 365, 2<<4 | 0, argp_m1},                                       // sgdt (703)
+	enc{[4]byte{0xd2, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 366, 1<<4 | 4, argp_vbBb},                                   // shl (704)
+	enc{[4]byte{0xc0, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 366, 1<<4 | 4, argp_vbib},                                   // shl (705)
+	enc{[4]byte{0xd3, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 2<<11 | 366, 1<<4 | 4, argp_v0Bb},                           // shl (706)
+	enc{[4]byte{0xc1, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 3<<11 | 366, 1<<4 | 4, argp_v0ib},                           // shl (707)
+	enc{[4]byte{0xf, 0xa5, 0x00, 0x00}, AUTO_SIZE | ENC_MR, featset_X64_IMPLICIT, 0<<11 | 367, 2<<4 | 15, argp_v0r0Bb},                // shld (708)
+	enc{[4]byte{0xf, 0xa4, 0x00, 0x00}, AUTO_SIZE | ENC_MR, featset_X64_IMPLICIT, 1<<11 | 367, 2<<4 | 15, argp_v0r0ib},                // shld (709)
+	enc{[4]byte{0x2, 0xf7, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_66 | ENC_MR, featset_BMI2, 0<<11 | 368, 2<<4 | 15, argp_r0v0r0},     // shlx (710)
+	enc{[4]byte{0xd2, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 369, 1<<4 | 5, argp_vbBb},                                   // shr (711)
+	enc{[4]byte{0xc0, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 369, 1<<4 | 5, argp_vbib},                                   // shr (712)
+	enc{[4]byte{0xd3, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 2<<11 | 369, 1<<4 | 5, argp_v0Bb},                           // shr (713)
+	enc{[4]byte{0xc1, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 3<<11 | 369, 1<<4 | 5, argp_v0ib},                           // shr (714)
+	enc{[4]byte{0xf, 0xad, 0x00, 0x00}, AUTO_SIZE | ENC_MR, featset_X64_IMPLICIT, 0<<11 | 370, 2<<4 | 15, argp_v0r0Bb},                // shrd (715)
+	enc{[4]byte{0xf, 0xac, 0x00, 0x00}, AUTO_SIZE | ENC_MR, featset_X64_IMPLICIT, 1<<11 | 370, 2<<4 | 15, argp_v0r0ib},                // shrd (716)
+	enc{[4]byte{0x2, 0xf7, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F2 | ENC_MR, featset_BMI2, 0<<11 | 371, 2<<4 | 15, argp_r0v0r0},     // shrx (717)
+	enc{[4]byte{0xf, 0x1, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 372, 2<<4 | 1, argp_m1},                                       // sidt (718)
+	enc{[4]byte{0xf, 0x1, 0xde, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 373, 3<<4 | 15, argp_},                                        // skinit (719)
+	enc{[4]byte{0xf, 0x0, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 374, 2<<4 | 0, argp_m1},                                       // sldt (720)
+	enc{[4]byte{0xf, 0x0, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 1<<11 | 374, 2<<4 | 0, argp_r0},                               // sldt (721)
+	enc{[4]byte{0x9, 0x12, 0x00, 0x00}, XOP_OP | AUTO_REXW, featset_AMD, 0<<11 | 375, 2<<4 | 1, argp_r0},                              // slwpcb (722)
+	enc{[4]byte{0xf, 0x1, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 376, 2<<4 | 4, argp_m1},                                       // smsw (723)
+	enc{[4]byte{0xf, 0x1, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 1<<11 | 376, 2<<4 | 4, argp_r0},                               // smsw (724)
+	enc{[4]byte{0xf, 0x51, 0x00, 0x00}, PREF_F2, featset_SSE2, 0<<11 | 377, 2<<4 | 15, argp_yomq},                                     // sqrtsd (725)
+	enc{[4]byte{0xf, 0x51, 0x00, 0x00}, PREF_F2, featset_SSE2, 1<<11 | 377, 2<<4 | 15, argp_yoyo},                                     // sqrtsd (726)
+	enc{[4]byte{0xf, 0x51, 0x00, 0x00}, PREF_F3, featset_SSE, 0<<11 | 378, 2<<4 | 15, argp_yomd},                                      // sqrtss (727)
+	enc{[4]byte{0xf, 0x51, 0x00, 0x00}, PREF_F3, featset_SSE, 1<<11 | 378, 2<<4 | 15, argp_yoyo},                                      // sqrtss (728)
+	enc{[4]byte{0xf, 0x1, 0xcb, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 379, 3<<4 | 15, argp_},                                        // stac (729)
+	enc{[4]byte{0xf9, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 380, 1<<4 | 15, argp_},                                      // stc (730)
+	enc{[4]byte{0xfd, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 381, 1<<4 | 15, argp_},                                      // std (731)
+	enc{[4]byte{0xf, 0x1, 0xdc, 0x00}, 0, featset_VMX_AMD, 0<<11 | 382, 3<<4 | 15, argp_},                                             // stgi (732)
+	enc{[4]byte{0xfb, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 383, 1<<4 | 15, argp_},                                      // sti (733)
+	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, 0, featset_SSE, 0<<11 | 384, 2<<4 | 3, argp_md},                                               // stmxcsr (734)
+	enc{[4]byte{0xaa, 0x00, 0x00, 0x00}, REP, featset_X64_IMPLICIT, 0<<11 | 385, 1<<4 | 15, argp_},                                    // stosb (735)
+	enc{[4]byte{0xab, 0x00, 0x00, 0x00}, REP, featset_X64_IMPLICIT, 0<<11 | 386, 1<<4 | 15, argp_},                                    // stosd (736)
+	enc{[4]byte{0xab, 0x00, 0x00, 0x00}, WITH_REXW | REP, featset_X64_IMPLICIT, 0<<11 | 387, 1<<4 | 15, argp_},                        // stosq (737)
+	enc{[4]byte{0xab, 0x00, 0x00, 0x00}, WORD_SIZE | REP, featset_X64_IMPLICIT, 0<<11 | 388, 1<<4 | 15, argp_},                        // stosw (738)
+	enc{[4]byte{0xf, 0x0, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 389, 2<<4 | 1, argp_m1},                                       // str (739)
+	enc{[4]byte{0xf, 0x0, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 1<<11 | 389, 2<<4 | 1, argp_r0},                               // str (740)
+	enc{[4]byte{0x2c, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 390, 1<<4 | 15, argp_Abib},                                  // sub (741)
+	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, LOCK, featset_X64_IMPLICIT, 1<<11 | 390, 1<<4 | 5, argp_mbib},                                // sub (742)
+	enc{[4]byte{0x28, 0x00, 0x00, 0x00}, LOCK | ENC_MR, featset_X64_IMPLICIT, 2<<11 | 390, 1<<4 | 15, argp_mbrb},                      // sub (743)
+	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 3<<11 | 390, 1<<4 | 5, argp_rbib},                                   // sub (744)
+	enc{[4]byte{0x28, 0x00, 0x00, 0x00}, ENC_MR, featset_X64_IMPLICIT, 4<<11 | 390, 1<<4 | 15, argp_rbrb},                             // sub (745)
+	enc{[4]byte{0x2a, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 5<<11 | 390, 1<<4 | 15, argp_rbvb},                                  // sub (746)
+	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | EXACT_SIZE, featset_X64_IMPLICIT, 6<<11 | 390, 1<<4 | 5, argp_r0ib},              // sub (747)
+	enc{[4]byte{0x2d, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 7<<11 | 390, 1<<4 | 15, argp_A0i0},                          // sub (748)
+	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, featset_X64_IMPLICIT, 8<<11 | 390, 1<<4 | 5, argp_m0i0},                    // sub (749)
+	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, featset_X64_IMPLICIT, 9<<11 | 390, 1<<4 | 5, argp_m0ib},                    // sub (750)
+	enc{[4]byte{0x29, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK | ENC_MR, featset_X64_IMPLICIT, 10<<11 | 390, 1<<4 | 15, argp_m0r0},         // sub (751)
+	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 11<<11 | 390, 1<<4 | 5, argp_r0i0},                          // sub (752)
+	enc{[4]byte{0x29, 0x00, 0x00, 0x00}, AUTO_SIZE | ENC_MR, featset_X64_IMPLICIT, 12<<11 | 390, 1<<4 | 15, argp_r0r0},                // sub (753)
+	enc{[4]byte{0x2b, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 13<<11 | 390, 1<<4 | 15, argp_r0v0},                         // sub (754)
+	enc{[4]byte{0xf, 0x5c, 0x00, 0x00}, PREF_F2, featset_SSE2, 0<<11 | 391, 2<<4 | 15, argp_yomq},                                     // subsd (755)
+	enc{[4]byte{0xf, 0x5c, 0x00, 0x00}, PREF_F2, featset_SSE2, 1<<11 | 391, 2<<4 | 15, argp_yoyo},                                     // subsd (756)
+	enc{[4]byte{0xf, 0x5c, 0x00, 0x00}, PREF_F3, featset_SSE, 0<<11 | 392, 2<<4 | 15, argp_yomd},                                      // subss (757)
+	enc{[4]byte{0xf, 0x5c, 0x00, 0x00}, PREF_F3, featset_SSE, 1<<11 | 392, 2<<4 | 15, argp_yoyo},                                      // subss (758)
+	enc{[4]byte{0xf, 0x1, 0xf8, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 393, 3<<4 | 15, argp_},                                        // swapgs (759)
+	enc{[4]byte{0xf, 0x5, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 394, 2<<4 | 15, argp_},                                        // syscall (760)
+	enc{[4]byte{0xf, 0x7, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 395, 2<<4 | 15, argp_},                                        // sysret (761)
+	enc{[4]byte{0x9, 0x1, 0x00, 0x00}, XOP_OP | AUTO_REXW | ENC_VM, featset_TBM, 0<<11 | 396, 2<<4 | 7, argp_r0v0},                    // t1mskc (762)
+	enc{[4]byte{0xa8, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 397, 1<<4 | 15, argp_Abib},                                  // test (763)
+	enc{[4]byte{0x84, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 397, 1<<4 | 15, argp_rbmb},                                  // test (764)
+	enc{[4]byte{0xf6, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 2<<11 | 397, 1<<4 | 0, argp_vbib},                                   // test (765)
+	enc{[4]byte{0x84, 0x00, 0x00, 0x00}, ENC_MR, featset_X64_IMPLICIT, 3<<11 | 397, 1<<4 | 15, argp_vbrb},                             // test (766)
+	enc{[4]byte{0xa9, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 4<<11 | 397, 1<<4 | 15, argp_A0i0},                          // test (767)
+	enc{[4]byte{0x85, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 5<<11 | 397, 1<<4 | 15, argp_r0m0},                          // test (768)
+	enc{[4]byte{0xf7, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 6<<11 | 397, 1<<4 | 0, argp_v0i0},                           // test (769)
+	enc{[4]byte{0x85, 0x00, 0x00, 0x00}, AUTO_SIZE | ENC_MR, featset_X64_IMPLICIT, 7<<11 | 397, 1<<4 | 15, argp_v0r0},                 // test (770)
+	enc{[4]byte{0xf, 0xbc, 0x00, 0x00}, AUTO_SIZE | PREF_F3, featset_BMI1, 0<<11 | 398, 2<<4 | 15, argp_r0v0},                         // tzcnt (771)
+	enc{[4]byte{0x9, 0x1, 0x00, 0x00}, XOP_OP | AUTO_REXW | ENC_VM, featset_TBM, 0<<11 | 399, 2<<4 | 4, argp_r0v0},                    // tzmsk (772)
+	enc{[4]byte{0xf, 0x2e, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 400, 2<<4 | 15, argp_yomq},                                     // ucomisd (773)
+	enc{[4]byte{0xf, 0x2e, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 400, 2<<4 | 15, argp_yoyo},                                     // ucomisd (774)
+	enc{[4]byte{0xf, 0x2e, 0x00, 0x00}, 0, featset_SSE, 0<<11 | 401, 2<<4 | 15, argp_yomd},                                            // ucomiss (775)
+	enc{[4]byte{0xf, 0x2e, 0x00, 0x00}, 0, featset_SSE, 1<<11 | 401, 2<<4 | 15, argp_yoyo},                                            // ucomiss (776)
+	enc{[4]byte{0xf, 0xb, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 402, 2<<4 | 15, argp_},                                        // ud2 (777)
+	enc{[4]byte{0xf, 0xb, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 403, 2<<4 | 15, argp_},                                        // ud2a (778)
+	enc{[4]byte{0xf, 0x9, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 404, 2<<4 | 15, argp_},                                        // wbinvd (779)
+	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, PREF_F3, featset_X64_IMPLICIT, 0<<11 | 405, 2<<4 | 2, argp_rd},                                // wrfsbase (780)
+	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, WITH_REXW | PREF_F3, featset_X64_IMPLICIT, 1<<11 | 405, 2<<4 | 2, argp_rq},                    // wrfsbase (781)
+	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, PREF_F3, featset_X64_IMPLICIT, 0<<11 | 406, 2<<4 | 3, argp_rd},                                // wrgsbase (782)
+	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, WITH_REXW | PREF_F3, featset_X64_IMPLICIT, 1<<11 | 406, 2<<4 | 3, argp_rq},                    // wrgsbase (783)
+	enc{[4]byte{0xf, 0x30, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 407, 2<<4 | 15, argp_},                                       // wrmsr (784)
+	enc{[4]byte{0xf, 0x1, 0xef, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 408, 3<<4 | 15, argp_},                                        // wrpkru (785)
+	enc{[4]byte{0x34, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 409, 1<<4 | 15, argp_Abib},                                  // xor (786)
+	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, LOCK, featset_X64_IMPLICIT, 1<<11 | 409, 1<<4 | 6, argp_mbib},                                // xor (787)
+	enc{[4]byte{0x30, 0x00, 0x00, 0x00}, LOCK | ENC_MR, featset_X64_IMPLICIT, 2<<11 | 409, 1<<4 | 15, argp_mbrb},                      // xor (788)
+	enc{[4]byte{0x80, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 3<<11 | 409, 1<<4 | 6, argp_rbib},                                   // xor (789)
+	enc{[4]byte{0x30, 0x00, 0x00, 0x00}, ENC_MR, featset_X64_IMPLICIT, 4<<11 | 409, 1<<4 | 15, argp_rbrb},                             // xor (790)
+	enc{[4]byte{0x32, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 5<<11 | 409, 1<<4 | 15, argp_rbvb},                                  // xor (791)
+	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | EXACT_SIZE, featset_X64_IMPLICIT, 6<<11 | 409, 1<<4 | 6, argp_r0ib},              // xor (792)
+	enc{[4]byte{0x35, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 7<<11 | 409, 1<<4 | 15, argp_A0i0},                          // xor (793)
+	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, featset_X64_IMPLICIT, 8<<11 | 409, 1<<4 | 6, argp_m0i0},                    // xor (794)
+	enc{[4]byte{0x83, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK, featset_X64_IMPLICIT, 9<<11 | 409, 1<<4 | 6, argp_m0ib},                    // xor (795)
+	enc{[4]byte{0x31, 0x00, 0x00, 0x00}, AUTO_SIZE | LOCK | ENC_MR, featset_X64_IMPLICIT, 10<<11 | 409, 1<<4 | 15, argp_m0r0},         // xor (796)
+	enc{[4]byte{0x81, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 11<<11 | 409, 1<<4 | 6, argp_r0i0},                          // xor (797)
+	enc{[4]byte{0x31, 0x00, 0x00, 0x00}, AUTO_SIZE | ENC_MR, featset_X64_IMPLICIT, 12<<11 | 409, 1<<4 | 15, argp_r0r0},                // xor (798)
+	enc{[4]byte{0x33, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 13<<11 | 409, 1<<4 | 15, argp_r0v0},                         // xor (799)
+	enc{[4]byte{0xf, 0x38, 0xde, 0x00}, PREF_66, featset_AES, 0<<11 | 410, 3<<4 | 15, argp_yowo},                                      // aesdec (800)
+	enc{[4]byte{0xf, 0x38, 0xdf, 0x00}, PREF_66, featset_AES, 0<<11 | 411, 3<<4 | 15, argp_yowo},                                      // aesdeclast (801)
+	enc{[4]byte{0xf, 0x38, 0xdc, 0x00}, PREF_66, featset_AES, 0<<11 | 412, 3<<4 | 15, argp_yowo},                                      // aesenc (802)
+	enc{[4]byte{0xf, 0x38, 0xdd, 0x00}, PREF_66, featset_AES, 0<<11 | 413, 3<<4 | 15, argp_yowo},                                      // aesenclast (803)
+	enc{[4]byte{0xf, 0x38, 0xdb, 0x00}, PREF_66, featset_AES, 0<<11 | 414, 3<<4 | 15, argp_yowo},                                      // aesimc (804)
+	enc{[4]byte{0xf, 0x3a, 0xdf, 0x00}, PREF_66, featset_AES, 0<<11 | 415, 3<<4 | 15, argp_yowoib},                                    // aeskeygenassist (805)
+	enc{[4]byte{0xf, 0x38, 0xc9, 0x00}, 0, featset_SHA, 0<<11 | 416, 3<<4 | 15, argp_yowo},                                            // sha1msg1 (806)
+	enc{[4]byte{0xf, 0x38, 0xca, 0x00}, 0, featset_SHA, 0<<11 | 417, 3<<4 | 15, argp_yowo},                                            // sha1msg2 (807)
+	enc{[4]byte{0xf, 0x38, 0xc8, 0x00}, 0, featset_SHA, 0<<11 | 418, 3<<4 | 15, argp_yowo},                                            // sha1nexte (808)
+	enc{[4]byte{0xf, 0x3a, 0xcc, 0x00}, 0, featset_SHA, 0<<11 | 419, 3<<4 | 15, argp_yowoib},                                          // sha1rnds4 (809)
+	enc{[4]byte{0xf, 0x38, 0xcc, 0x00}, 0, featset_SHA, 0<<11 | 420, 3<<4 | 15, argp_yowo},                                            // sha256msg1 (810)
+	enc{[4]byte{0xf, 0x38, 0xcd, 0x00}, 0, featset_SHA, 0<<11 | 421, 3<<4 | 15, argp_yowo},                                            // sha256msg2 (811)
+	enc{[4]byte{0xf, 0x38, 0xcb, 0x00}, 0, featset_SHA, 0<<11 | 422, 3<<4 | 15, argp_yowo},                                            // sha256rnds2 (812)
+	enc{[4]byte{0xc6, 0xf8, 0x00, 0x00}, 0, featset_RTM, 0<<11 | 423, 2<<4 | 15, argp_ib},                                             // xabort (813)
+	enc{[4]byte{0xf, 0xc0, 0x00, 0x00}, LOCK | ENC_MR, featset_X64_IMPLICIT, 0<<11 | 424, 2<<4 | 15, argp_mbrb},                       // xadd (814)
+	enc{[4]byte{0xf, 0xc0, 0x00, 0x00}, ENC_MR, featset_X64_IMPLICIT, 1<<11 | 424, 2<<4 | 15, argp_rbrb},                              // xadd (815)
+	enc{[4]byte{0xf, 0xc1, 0x00, 0x00}, AUTO_SIZE | LOCK | ENC_MR, featset_X64_IMPLICIT, 2<<11 | 424, 2<<4 | 15, argp_m0r0},           // xadd (816)
+	enc{[4]byte{0xf, 0xc1, 0x00, 0x00}, AUTO_SIZE | ENC_MR, featset_X64_IMPLICIT, 3<<11 | 424, 2<<4 | 15, argp_r0r0},                  // xadd (817)
+	enc{[4]byte{0xc7, 0xf8, 0x00, 0x00}, 0, featset_RTM, 0<<11 | 425, 2<<4 | 15, argp_od},                                             // xbegin (818)
+	enc{[4]byte{0x86, 0x00, 0x00, 0x00}, LOCK | ENC_MR, featset_X64_IMPLICIT, 0<<11 | 426, 1<<4 | 15, argp_mbrb},                      // xchg (819)
+	enc{[4]byte{0x86, 0x00, 0x00, 0x00}, LOCK, featset_X64_IMPLICIT, 1<<11 | 426, 1<<4 | 15, argp_rbmb},                               // xchg (820)
+	enc{[4]byte{0x86, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 2<<11 | 426, 1<<4 | 15, argp_rbrb},                                  // xchg (821)
+	enc{[4]byte{0x86, 0x00, 0x00, 0x00}, ENC_MR, featset_X64_IMPLICIT, 3<<11 | 426, 1<<4 | 15, argp_rbrb},                             // xchg (822)
+	enc{[4]byte{0x90, 0x00, 0x00, 0x00}, AUTO_SIZE | SHORT_ARG, featset_X64_IMPLICIT, 4<<11 | 426, 1<<4 | 15, argp_A0r0},              // xchg (823)
+	enc{[4]byte{0x87, 0x00, 0x00, 0x00}, AUTO_SIZE | ENC_MR, featset_X64_IMPLICIT, 5<<11 | 426, 1<<4 | 15, argp_m0r0},                 // xchg (824)
+	enc{[4]byte{0x90, 0x00, 0x00, 0x00}, AUTO_SIZE | SHORT_ARG, featset_X64_IMPLICIT, 6<<11 | 426, 1<<4 | 15, argp_r0A0},              // xchg (825)
+	enc{[4]byte{0x87, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 7<<11 | 426, 1<<4 | 15, argp_r0m0},                          // xchg (826)
+	enc{[4]byte{0x87, 0x00, 0x00, 0x00}, AUTO_SIZE, featset_X64_IMPLICIT, 8<<11 | 426, 1<<4 | 15, argp_r0r0},                          // xchg (827)
+	enc{[4]byte{0x87, 0x00, 0x00, 0x00}, AUTO_SIZE | ENC_MR, featset_X64_IMPLICIT, 9<<11 | 426, 1<<4 | 15, argp_r0r0},                 // xchg (828)
+	enc{[4]byte{0xf, 0x1, 0xd5, 0x00}, 0, featset_RTM, 0<<11 | 427, 3<<4 | 15, argp_},                                                 // xend (829)
+	enc{[4]byte{0xf, 0x1, 0xd0, 0x00}, 0, featset_XSAVE, 0<<11 | 428, 3<<4 | 15, argp_},                                               // xgetbv (830)
+	enc{[4]byte{0xd7, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 429, 1<<4 | 15, argp_},                                      // xlat (831)
+	enc{[4]byte{0xd7, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 430, 1<<4 | 15, argp_},                                      // xlatb (832)
+	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, 0, featset_XSAVE, 0<<11 | 431, 2<<4 | 5, argp_m1},                                             // xrstor (833)
+	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, WITH_REXW, featset_XSAVE, 0<<11 | 432, 2<<4 | 5, argp_m1},                                     // xrstor64 (834)
+	enc{[4]byte{0xf, 0xc7, 0x00, 0x00}, WITH_REXW, featset_XSAVE, 0<<11 | 433, 2<<4 | 3, argp_m1},                                     // xrstors64 (835)
+	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, 0, featset_XSAVE, 0<<11 | 434, 2<<4 | 4, argp_m1},                                             // xsave (836)
+	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, WITH_REXW, featset_XSAVE, 0<<11 | 435, 2<<4 | 4, argp_m1},                                     // xsave64 (837)
+	enc{[4]byte{0xf, 0xc7, 0x00, 0x00}, WITH_REXW, featset_XSAVE, 0<<11 | 436, 2<<4 | 4, argp_m1},                                     // xsavec64 (838)
+	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, WITH_REXW, featset_XSAVE, 0<<11 | 437, 2<<4 | 6, argp_m1},                                     // xsaveopt64 (839)
+	enc{[4]byte{0xf, 0xc7, 0x00, 0x00}, WITH_REXW, featset_XSAVE, 0<<11 | 438, 2<<4 | 5, argp_m1},                                     // xsaves64 (840)
+	enc{[4]byte{0xf, 0x1, 0xd1, 0x00}, 0, featset_XSAVE, 0<<11 | 439, 3<<4 | 15, argp_},                                               // xsetbv (841)
+	enc{[4]byte{0xf, 0x1, 0xd6, 0x00}, 0, featset_RTM, 0<<11 | 440, 3<<4 | 15, argp_},                                                 // xtest (842)
+	enc{[4]byte{0xd9, 0xf0, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 441, 2<<4 | 15, argp_},                                               // f2xm1 (843)
+	enc{[4]byte{0xd9, 0xe1, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 442, 2<<4 | 15, argp_},                                               // fabs (844)
+	enc{[4]byte{0xde, 0xc1, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 443, 2<<4 | 15, argp_},                                               // fadd (845)
+	enc{[4]byte{0xd8, 0xc0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 443, 2<<4 | 15, argp_Xpfp},                                   // fadd (846)
+	enc{[4]byte{0xd8, 0xc0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 443, 2<<4 | 15, argp_fp},                                     // fadd (847)
+	enc{[4]byte{0xdc, 0xc0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 3<<11 | 443, 2<<4 | 15, argp_fpXp},                                   // fadd (848)
+	enc{[4]byte{0xd8, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 4<<11 | 443, 1<<4 | 0, argp_md},                                     // fadd (849)
+	enc{[4]byte{0xdc, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 5<<11 | 443, 1<<4 | 0, argp_mq},                                     // fadd (850)
+	enc{[4]byte{0xde, 0xc1, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 444, 2<<4 | 15, argp_},                                               // faddp (851)
+	enc{[4]byte{0xde, 0xc0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 444, 2<<4 | 15, argp_fp},                                     // faddp (852)
+	enc{[4]byte{0xde, 0xc0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 444, 2<<4 | 15, argp_fpXp},                                   // faddp (853)
+	enc{[4]byte{0xdf, 0x00, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 445, 1<<4 | 4, argp_m1},                                              // fbld (854)
+	enc{[4]byte{0xdf, 0x00, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 446, 1<<4 | 6, argp_m1},                                              // fbstp (855)
+	enc{[4]byte{0xd9, 0xe0, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 447, 2<<4 | 15, argp_},                                               // fchs (856)
+	enc{[4]byte{0x9b, 0xdb, 0xe2, 0x00}, 0, featset_FPU, 0<<11 | 448, 3<<4 | 15, argp_},                                               // fclex (857)
+	enc{[4]byte{0xda, 0xc1, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 449, 2<<4 | 15, argp_},                                               // fcmovb (858)
+	enc{[4]byte{0xda, 0xc0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 449, 2<<4 | 15, argp_Xpfp},                                   // fcmovb (859)
+	enc{[4]byte{0xda, 0xc0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 449, 2<<4 | 15, argp_fp},                                     // fcmovb (860)
+	enc{[4]byte{0xda, 0xd1, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 450, 2<<4 | 15, argp_},                                               // fcmovbe (861)
+	enc{[4]byte{0xda, 0xd0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 450, 2<<4 | 15, argp_Xpfp},                                   // fcmovbe (862)
+	enc{[4]byte{0xda, 0xd0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 450, 2<<4 | 15, argp_fp},                                     // fcmovbe (863)
+	enc{[4]byte{0xda, 0xc9, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 451, 2<<4 | 15, argp_},                                               // fcmove (864)
+	enc{[4]byte{0xda, 0xc8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 451, 2<<4 | 15, argp_Xpfp},                                   // fcmove (865)
+	enc{[4]byte{0xda, 0xc8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 451, 2<<4 | 15, argp_fp},                                     // fcmove (866)
+	enc{[4]byte{0xdb, 0xc1, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 452, 2<<4 | 15, argp_},                                               // fcmovnb (867)
+	enc{[4]byte{0xdb, 0xc0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 452, 2<<4 | 15, argp_Xpfp},                                   // fcmovnb (868)
+	enc{[4]byte{0xdb, 0xc0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 452, 2<<4 | 15, argp_fp},                                     // fcmovnb (869)
+	enc{[4]byte{0xdb, 0xd1, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 453, 2<<4 | 15, argp_},                                               // fcmovnbe (870)
+	enc{[4]byte{0xdb, 0xd0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 453, 2<<4 | 15, argp_Xpfp},                                   // fcmovnbe (871)
+	enc{[4]byte{0xdb, 0xd0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 453, 2<<4 | 15, argp_fp},                                     // fcmovnbe (872)
+	enc{[4]byte{0xdb, 0xc9, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 454, 2<<4 | 15, argp_},                                               // fcmovne (873)
+	enc{[4]byte{0xdb, 0xc8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 454, 2<<4 | 15, argp_Xpfp},                                   // fcmovne (874)
+	enc{[4]byte{0xdb, 0xc8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 454, 2<<4 | 15, argp_fp},                                     // fcmovne (875)
+	enc{[4]byte{0xdb, 0xd9, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 455, 2<<4 | 15, argp_},                                               // fcmovnu (876)
+	enc{[4]byte{0xdb, 0xd8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 455, 2<<4 | 15, argp_Xpfp},                                   // fcmovnu (877)
+	enc{[4]byte{0xdb, 0xd8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 455, 2<<4 | 15, argp_fp},                                     // fcmovnu (878)
+	enc{[4]byte{0xda, 0xd9, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 456, 2<<4 | 15, argp_},                                               // fcmovu (879)
+	enc{[4]byte{0xda, 0xd8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 456, 2<<4 | 15, argp_Xpfp},                                   // fcmovu (880)
+	enc{[4]byte{0xda, 0xd8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 456, 2<<4 | 15, argp_fp},                                     // fcmovu (881)
+	enc{[4]byte{0xd8, 0xd1, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 457, 2<<4 | 15, argp_},                                               // fcom (882)
+	enc{[4]byte{0xd8, 0xd0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 457, 2<<4 | 15, argp_Xpfp},                                   // fcom (883)
+	enc{[4]byte{0xd8, 0xd0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 457, 2<<4 | 15, argp_fp},                                     // fcom (884)
+	enc{[4]byte{0xd8, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 3<<11 | 457, 1<<4 | 2, argp_md},                                     // fcom (885)
+	enc{[4]byte{0xdc, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 4<<11 | 457, 1<<4 | 2, argp_mq},                                     // fcom (886)
+	enc{[4]byte{0xdb, 0xf1, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 458, 2<<4 | 15, argp_},                                               // fcomi (887)
+	enc{[4]byte{0xdb, 0xf0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 458, 2<<4 | 15, argp_Xpfp},                                   // fcomi (888)
+	enc{[4]byte{0xdb, 0xf0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 458, 2<<4 | 15, argp_fp},                                     // fcomi (889)
+	enc{[4]byte{0xdf, 0xf1, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 459, 2<<4 | 15, argp_},                                               // fcomip (890)
+	enc{[4]byte{0xdf, 0xf0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 459, 2<<4 | 15, argp_Xpfp},                                   // fcomip (891)
+	enc{[4]byte{0xdf, 0xf0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 459, 2<<4 | 15, argp_fp},                                     // fcomip (892)
+	enc{[4]byte{0xd8, 0xd9, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 460, 2<<4 | 15, argp_},                                               // fcomp (893)
+	enc{[4]byte{0xd8, 0xd8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 460, 2<<4 | 15, argp_Xpfp},                                   // fcomp (894)
+	enc{[4]byte{0xd8, 0xd8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 460, 2<<4 | 15, argp_fp},                                     // fcomp (895)
+	enc{[4]byte{0xd8, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 3<<11 | 460, 1<<4 | 3, argp_md},                                     // fcomp (896)
+	enc{[4]byte{0xdc, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 4<<11 | 460, 1<<4 | 3, argp_mq},                                     // fcomp (897)
+	enc{[4]byte{0xde, 0xd9, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 461, 2<<4 | 15, argp_},                                               // fcompp (898)
+	enc{[4]byte{0xd9, 0xff, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 462, 2<<4 | 15, argp_},                                               // fcos (899)
+	enc{[4]byte{0xd9, 0xf6, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 463, 2<<4 | 15, argp_},                                               // fdecstp (900)
+	enc{[4]byte{0x9b, 0xdb, 0xe1, 0x00}, 0, featset_FPU, 0<<11 | 464, 3<<4 | 15, argp_},                                               // fdisi (901)
+	enc{[4]byte{0xde, 0xf9, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 465, 2<<4 | 15, argp_},                                               // fdiv (902)
+	enc{[4]byte{0xd8, 0xf0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 465, 2<<4 | 15, argp_Xpfp},                                   // fdiv (903)
+	enc{[4]byte{0xd8, 0xf0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 465, 2<<4 | 15, argp_fp},                                     // fdiv (904)
+	enc{[4]byte{0xdc, 0xf8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 3<<11 | 465, 2<<4 | 15, argp_fpXp},                                   // fdiv (905)
+	enc{[4]byte{0xd8, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 4<<11 | 465, 1<<4 | 6, argp_md},                                     // fdiv (906)
+	enc{[4]byte{0xdc, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 5<<11 | 465, 1<<4 | 6, argp_mq},                                     // fdiv (907)
+	enc{[4]byte{0xde, 0xf9, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 466, 2<<4 | 15, argp_},                                               // fdivp (908)
+	enc{[4]byte{0xde, 0xf8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 466, 2<<4 | 15, argp_fp},                                     // fdivp (909)
+	enc{[4]byte{0xde, 0xf8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 466, 2<<4 | 15, argp_fpXp},                                   // fdivp (910)
+	enc{[4]byte{0xde, 0xf1, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 467, 2<<4 | 15, argp_},                                               // fdivr (911)
+	enc{[4]byte{0xd8, 0xf8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 467, 2<<4 | 15, argp_Xpfp},                                   // fdivr (912)
+	enc{[4]byte{0xd8, 0xf8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 467, 2<<4 | 15, argp_fp},                                     // fdivr (913)
+	enc{[4]byte{0xdc, 0xf0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 3<<11 | 467, 2<<4 | 15, argp_fpXp},                                   // fdivr (914)
+	enc{[4]byte{0xd8, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 4<<11 | 467, 1<<4 | 7, argp_md},                                     // fdivr (915)
+	enc{[4]byte{0xdc, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 5<<11 | 467, 1<<4 | 7, argp_mq},                                     // fdivr (916)
+	enc{[4]byte{0xde, 0xf1, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 468, 2<<4 | 15, argp_},                                               // fdivrp (917)
+	enc{[4]byte{0xde, 0xf0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 468, 2<<4 | 15, argp_fp},                                     // fdivrp (918)
+	enc{[4]byte{0xde, 0xf0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 468, 2<<4 | 15, argp_fpXp},                                   // fdivrp (919)
+	enc{[4]byte{0xf, 0xe, 0x00, 0x00}, 0, featset_TDNOW, 0<<11 | 469, 2<<4 | 15, argp_},                                               // femms (920)
+	enc{[4]byte{0x9b, 0xdb, 0xe0, 0x00}, 0, featset_FPU, 0<<11 | 470, 3<<4 | 15, argp_},                                               // feni (921)
+	enc{[4]byte{0xdd, 0xc1, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 471, 2<<4 | 15, argp_},                                               // ffree (922)
+	enc{[4]byte{0xdd, 0xc0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 471, 2<<4 | 15, argp_fp},                                     // ffree (923)
+	enc{[4]byte{0xda, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 0<<11 | 472, 1<<4 | 0, argp_md},                                     // fiadd (924)
+	enc{[4]byte{0xde, 0x00, 0x00, 0x00}, 0, featset_FPU, 1<<11 | 472, 1<<4 | 0, argp_mw},                                              // fiadd (925)
+	enc{[4]byte{0xda, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 0<<11 | 473, 1<<4 | 2, argp_md},                                     // ficom (926)
+	enc{[4]byte{0xde, 0x00, 0x00, 0x00}, 0, featset_FPU, 1<<11 | 473, 1<<4 | 2, argp_mw},                                              // ficom (927)
+	enc{[4]byte{0xda, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 0<<11 | 474, 1<<4 | 3, argp_md},                                     // ficomp (928)
+	enc{[4]byte{0xde, 0x00, 0x00, 0x00}, 0, featset_FPU, 1<<11 | 474, 1<<4 | 3, argp_mw},                                              // ficomp (929)
+	enc{[4]byte{0xda, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 0<<11 | 475, 1<<4 | 6, argp_md},                                     // fidiv (930)
+	enc{[4]byte{0xde, 0x00, 0x00, 0x00}, 0, featset_FPU, 1<<11 | 475, 1<<4 | 6, argp_mw},                                              // fidiv (931)
+	enc{[4]byte{0xda, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 0<<11 | 476, 1<<4 | 7, argp_md},                                     // fidivr (932)
+	enc{[4]byte{0xde, 0x00, 0x00, 0x00}, 0, featset_FPU, 1<<11 | 476, 1<<4 | 7, argp_mw},                                              // fidivr (933)
+	enc{[4]byte{0xdb, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 0<<11 | 477, 1<<4 | 0, argp_md},                                     // fild (934)
+	enc{[4]byte{0xdf, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 1<<11 | 477, 1<<4 | 5, argp_mq},                                     // fild (935)
+	enc{[4]byte{0xdf, 0x00, 0x00, 0x00}, 0, featset_FPU, 2<<11 | 477, 1<<4 | 0, argp_mw},                                              // fild (936)
+	enc{[4]byte{0xda, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 0<<11 | 478, 1<<4 | 1, argp_md},                                     // fimul (937)
+	enc{[4]byte{0xde, 0x00, 0x00, 0x00}, 0, featset_FPU, 1<<11 | 478, 1<<4 | 1, argp_mw},                                              // fimul (938)
+	enc{[4]byte{0xd9, 0xf7, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 479, 2<<4 | 15, argp_},                                               // fincstp (939)
+	enc{[4]byte{0x9b, 0xdb, 0xe3, 0x00}, 0, featset_FPU, 0<<11 | 480, 3<<4 | 15, argp_},                                               // finit (940)
+	enc{[4]byte{0xdb, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 0<<11 | 481, 1<<4 | 2, argp_md},                                     // fist (941)
+	enc{[4]byte{0xdf, 0x00, 0x00, 0x00}, 0, featset_FPU, 1<<11 | 481, 1<<4 | 2, argp_mw},                                              // fist (942)
+	enc{[4]byte{0xdb, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 0<<11 | 482, 1<<4 | 3, argp_md},                                     // fistp (943)
+	enc{[4]byte{0xdf, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 1<<11 | 482, 1<<4 | 7, argp_mq},                                     // fistp (944)
+	enc{[4]byte{0xdf, 0x00, 0x00, 0x00}, 0, featset_FPU, 2<<11 | 482, 1<<4 | 3, argp_mw},                                              // fistp (945)
+	enc{[4]byte{0xdb, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 0<<11 | 483, 1<<4 | 1, argp_md},                                     // fisttp (946)
+	enc{[4]byte{0xdd, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 1<<11 | 483, 1<<4 | 1, argp_mq},                                     // fisttp (947)
+	enc{[4]byte{0xdf, 0x00, 0x00, 0x00}, 0, featset_FPU, 2<<11 | 483, 1<<4 | 1, argp_mw},                                              // fisttp (948)
+	enc{[4]byte{0xda, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 0<<11 | 484, 1<<4 | 4, argp_md},                                     // fisub (949)
+	enc{[4]byte{0xde, 0x00, 0x00, 0x00}, 0, featset_FPU, 1<<11 | 484, 1<<4 | 4, argp_mw},                                              // fisub (950)
+	enc{[4]byte{0xda, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 0<<11 | 485, 1<<4 | 5, argp_md},                                     // fisubr (951)
+	enc{[4]byte{0xde, 0x00, 0x00, 0x00}, 0, featset_FPU, 1<<11 | 485, 1<<4 | 5, argp_mw},                                              // fisubr (952)
+	enc{[4]byte{0xd9, 0xc1, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 486, 2<<4 | 15, argp_},                                               // fld (953)
+	enc{[4]byte{0xd9, 0xc0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 486, 2<<4 | 15, argp_fp},                                     // fld (954)
+	enc{[4]byte{0xd9, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 2<<11 | 486, 1<<4 | 0, argp_md},                                     // fld (955)
+	enc{[4]byte{0xdb, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 3<<11 | 486, 1<<4 | 5, argp_mp},                                     // fld (956)
+	enc{[4]byte{0xdd, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 4<<11 | 486, 1<<4 | 0, argp_mq},                                     // fld (957)
+	enc{[4]byte{0xd9, 0xe8, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 487, 2<<4 | 15, argp_},                                               // fld1 (958)
+	enc{[4]byte{0xd9, 0x00, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 488, 1<<4 | 5, argp_mw},                                              // fldcw (959)
+	enc{[4]byte{0xd9, 0x00, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 489, 1<<4 | 4, argp_m1},                                              // fldenv (960)
+	enc{[4]byte{0xd9, 0xea, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 490, 2<<4 | 15, argp_},                                               // fldl2e (961)
+	enc{[4]byte{0xd9, 0xe9, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 491, 2<<4 | 15, argp_},                                               // fldl2t (962)
+	enc{[4]byte{0xd9, 0xec, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 492, 2<<4 | 15, argp_},                                               // fldlg2 (963)
+	enc{[4]byte{0xd9, 0xed, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 493, 2<<4 | 15, argp_},                                               // fldln2 (964)
+	enc{[4]byte{0xd9, 0xeb, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 494, 2<<4 | 15, argp_},                                               // fldpi (965)
+	enc{[4]byte{0xd9, 0xee, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 495, 2<<4 | 15, argp_},                                               // fldz (966)
+	enc{[4]byte{0xde, 0xc9, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 496, 2<<4 | 15, argp_},                                               // fmul (967)
+	enc{[4]byte{0xd8, 0xc8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 496, 2<<4 | 15, argp_Xpfp},                                   // fmul (968)
+	enc{[4]byte{0xd8, 0xc8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 496, 2<<4 | 15, argp_fp},                                     // fmul (969)
+	enc{[4]byte{0xdc, 0xc8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 3<<11 | 496, 2<<4 | 15, argp_fpXp},                                   // fmul (970)
+	enc{[4]byte{0xd8, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 4<<11 | 496, 1<<4 | 1, argp_md},                                     // fmul (971)
+	enc{[4]byte{0xdc, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 5<<11 | 496, 1<<4 | 1, argp_mq},                                     // fmul (972)
+	enc{[4]byte{0xde, 0xc9, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 497, 2<<4 | 15, argp_},                                               // fmulp (973)
+	enc{[4]byte{0xde, 0xc8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 497, 2<<4 | 15, argp_fp},                                     // fmulp (974)
+	enc{[4]byte{0xde, 0xc8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 497, 2<<4 | 15, argp_fpXp},                                   // fmulp (975)
+	enc{[4]byte{0xdb, 0xe2, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 498, 2<<4 | 15, argp_},                                               // fnclex (976)
+	enc{[4]byte{0xdb, 0xe1, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 499, 2<<4 | 15, argp_},                                               // fndisi (977)
+	enc{[4]byte{0xdb, 0xe0, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 500, 2<<4 | 15, argp_},                                               // fneni (978)
+	enc{[4]byte{0xdb, 0xe3, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 501, 2<<4 | 15, argp_},                                               // fninit (979)
+	enc{[4]byte{0xd9, 0xd0, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 502, 2<<4 | 15, argp_},                                               // fnop (980)
+	enc{[4]byte{0xdd, 0x00, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 503, 1<<4 | 6, argp_m1},                                              // fnsave (981)
+	enc{[4]byte{0xd9, 0x00, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 504, 1<<4 | 7, argp_mw},                                              // fnstcw (982)
+	enc{[4]byte{0xd9, 0x00, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 505, 1<<4 | 6, argp_m1},                                              // fnstenv (983)
+	enc{[4]byte{0xdf, 0xe0, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 506, 2<<4 | 15, argp_Aw},                                             // fnstsw (984)
+	enc{[4]byte{0xdd, 0x00, 0x00, 0x00}, 0, featset_FPU, 1<<11 | 506, 1<<4 | 7, argp_mw},                                              // fnstsw (985)
+	enc{[4]byte{0xd9, 0xf3, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 507, 2<<4 | 15, argp_},                                               // fpatan (986)
+	enc{[4]byte{0xd9, 0xf8, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 508, 2<<4 | 15, argp_},                                               // fprem (987)
+	enc{[4]byte{0xd9, 0xf5, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 509, 2<<4 | 15, argp_},                                               // fprem1 (988)
+	enc{[4]byte{0xd9, 0xf2, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 510, 2<<4 | 15, argp_},                                               // fptan (989)
+	enc{[4]byte{0xd9, 0xfc, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 511, 2<<4 | 15, argp_},                                               // frndint (990)
+	enc{[4]byte{0xdd, 0x00, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 512, 1<<4 | 4, argp_m1},                                              // frstor (991)
+	enc{[4]byte{0x9b, 0xdd, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 513, 2<<4 | 6, argp_m1},                                              // fsave (992)
+	enc{[4]byte{0xd9, 0xfd, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 514, 2<<4 | 15, argp_},                                               // fscale (993)
+	enc{[4]byte{0xdb, 0xe4, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 515, 2<<4 | 15, argp_},                                               // fsetpm (994)
+	enc{[4]byte{0xd9, 0xfe, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 516, 2<<4 | 15, argp_},                                               // fsin (995)
+	enc{[4]byte{0xd9, 0xfb, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 517, 2<<4 | 15, argp_},                                               // fsincos (996)
+	enc{[4]byte{0xd9, 0xfa, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 518, 2<<4 | 15, argp_},                                               // fsqrt (997)
+	enc{[4]byte{0xdd, 0xd1, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 519, 2<<4 | 15, argp_},                                               // fst (998)
+	enc{[4]byte{0xdd, 0xd0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 519, 2<<4 | 15, argp_fp},                                     // fst (999)
+	enc{[4]byte{0xd9, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 2<<11 | 519, 1<<4 | 2, argp_md},                                     // fst (1000)
+	enc{[4]byte{0xdd, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 3<<11 | 519, 1<<4 | 2, argp_mq},                                     // fst (1001)
+	enc{[4]byte{0x9b, 0xd9, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 520, 2<<4 | 7, argp_mw},                                              // fstcw (1002)
+	enc{[4]byte{0x9b, 0xd9, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 521, 2<<4 | 6, argp_m1},                                              // fstenv (1003)
+	enc{[4]byte{0xdd, 0xd9, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 522, 2<<4 | 15, argp_},                                               // fstp (1004)
+	enc{[4]byte{0xdd, 0xd8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 522, 2<<4 | 15, argp_fp},                                     // fstp (1005)
+	enc{[4]byte{0xd9, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 2<<11 | 522, 1<<4 | 3, argp_md},                                     // fstp (1006)
+	enc{[4]byte{0xdb, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 3<<11 | 522, 1<<4 | 7, argp_mp},                                     // fstp (1007)
+	enc{[4]byte{0xdd, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 4<<11 | 522, 1<<4 | 3, argp_mq},                                     // fstp (1008)
+	enc{[4]byte{0x9b, 0xdf, 0xe0, 0x00}, 0, featset_FPU, 0<<11 | 523, 3<<4 | 15, argp_Aw},                                             // fstsw (1009)
+	enc{[4]byte{0x9b, 0xdd, 0x00, 0x00}, 0, featset_FPU, 1<<11 | 523, 2<<4 | 7, argp_mw},                                              // fstsw (1010)
+	enc{[4]byte{0xde, 0xe9, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 524, 2<<4 | 15, argp_},                                               // fsub (1011)
+	enc{[4]byte{0xd8, 0xe0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 524, 2<<4 | 15, argp_Xpfp},                                   // fsub (1012)
+	enc{[4]byte{0xd8, 0xe0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 524, 2<<4 | 15, argp_fp},                                     // fsub (1013)
+	enc{[4]byte{0xdc, 0xe8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 3<<11 | 524, 2<<4 | 15, argp_fpXp},                                   // fsub (1014)
+	enc{[4]byte{0xd8, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 4<<11 | 524, 1<<4 | 4, argp_md},                                     // fsub (1015)
+	enc{[4]byte{0xdc, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 5<<11 | 524, 1<<4 | 4, argp_mq},                                     // fsub (1016)
+	enc{[4]byte{0xde, 0xe9, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 525, 2<<4 | 15, argp_},                                               // fsubp (1017)
+	enc{[4]byte{0xde, 0xe8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 525, 2<<4 | 15, argp_fp},                                     // fsubp (1018)
+	enc{[4]byte{0xde, 0xe8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 525, 2<<4 | 15, argp_fpXp},                                   // fsubp (1019)
+	enc{[4]byte{0xde, 0xe1, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 526, 2<<4 | 15, argp_},                                               // fsubr (1020)
+	enc{[4]byte{0xd8, 0xe8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 526, 2<<4 | 15, argp_Xpfp},                                   // fsubr (1021)
+	enc{[4]byte{0xd8, 0xe8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 526, 2<<4 | 15, argp_fp},                                     // fsubr (1022)
+	enc{[4]byte{0xdc, 0xe0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 3<<11 | 526, 2<<4 | 15, argp_fpXp},                                   // fsubr (1023)
+	enc{[4]byte{0xd8, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 4<<11 | 526, 1<<4 | 5, argp_md},                                     // fsubr (1024)
+	enc{[4]byte{0xdc, 0x00, 0x00, 0x00}, EXACT_SIZE, featset_FPU, 5<<11 | 526, 1<<4 | 5, argp_mq},                                     // fsubr (1025)
+	enc{[4]byte{0xde, 0xe1, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 527, 2<<4 | 15, argp_},                                               // fsubrp (1026)
+	enc{[4]byte{0xde, 0xe0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 527, 2<<4 | 15, argp_fp},                                     // fsubrp (1027)
+	enc{[4]byte{0xde, 0xe0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 527, 2<<4 | 15, argp_fpXp},                                   // fsubrp (1028)
+	enc{[4]byte{0xd9, 0xe4, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 528, 2<<4 | 15, argp_},                                               // ftst (1029)
+	enc{[4]byte{0xdd, 0xe1, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 529, 2<<4 | 15, argp_},                                               // fucom (1030)
+	enc{[4]byte{0xdd, 0xe0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 529, 2<<4 | 15, argp_Xpfp},                                   // fucom (1031)
+	enc{[4]byte{0xdd, 0xe0, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 529, 2<<4 | 15, argp_fp},                                     // fucom (1032)
+	enc{[4]byte{0xdb, 0xe9, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 530, 2<<4 | 15, argp_},                                               // fucomi (1033)
+	enc{[4]byte{0xdb, 0xe8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 530, 2<<4 | 15, argp_Xpfp},                                   // fucomi (1034)
+	enc{[4]byte{0xdb, 0xe8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 530, 2<<4 | 15, argp_fp},                                     // fucomi (1035)
+	enc{[4]byte{0xdf, 0xe9, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 531, 2<<4 | 15, argp_},                                               // fucomip (1036)
+	enc{[4]byte{0xdf, 0xe8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 531, 2<<4 | 15, argp_Xpfp},                                   // fucomip (1037)
+	enc{[4]byte{0xdf, 0xe8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 531, 2<<4 | 15, argp_fp},                                     // fucomip (1038)
+	enc{[4]byte{0xdd, 0xe9, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 532, 2<<4 | 15, argp_},                                               // fucomp (1039)
+	enc{[4]byte{0xdd, 0xe8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 532, 2<<4 | 15, argp_Xpfp},                                   // fucomp (1040)
+	enc{[4]byte{0xdd, 0xe8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 532, 2<<4 | 15, argp_fp},                                     // fucomp (1041)
+	enc{[4]byte{0xda, 0xe9, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 533, 2<<4 | 15, argp_},                                               // fucompp (1042)
+	enc{[4]byte{0x9b, 0x00, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 534, 1<<4 | 15, argp_},                                      // fwait (1043)
+	enc{[4]byte{0xd9, 0xe5, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 535, 2<<4 | 15, argp_},                                               // fxam (1044)
+	enc{[4]byte{0xd9, 0xc9, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 536, 2<<4 | 15, argp_},                                               // fxch (1045)
+	enc{[4]byte{0xd9, 0xc8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 1<<11 | 536, 2<<4 | 15, argp_Xpfp},                                   // fxch (1046)
+	enc{[4]byte{0xd9, 0xc8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 2<<11 | 536, 2<<4 | 15, argp_fp},                                     // fxch (1047)
+	enc{[4]byte{0xd9, 0xc8, 0x00, 0x00}, SHORT_ARG, featset_FPU, 3<<11 | 536, 2<<4 | 15, argp_fpXp},                                   // fxch (1048)
+	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, 0, featset_FPU_SSE, 0<<11 | 537, 2<<4 | 1, argp_m1},                                           // fxrstor (1049)
+	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, WITH_REXW, featset_FPU_SSE, 0<<11 | 538, 2<<4 | 1, argp_m1},                                   // fxrstor64 (1050)
+	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, 0, featset_FPU_SSE, 0<<11 | 539, 2<<4 | 0, argp_m1},                                           // fxsave (1051)
+	enc{[4]byte{0xf, 0xae, 0x00, 0x00}, WITH_REXW, featset_FPU_SSE, 0<<11 | 540, 2<<4 | 0, argp_m1},                                   // fxsave64 (1052)
+	enc{[4]byte{0xd9, 0xf4, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 541, 2<<4 | 15, argp_},                                               // fxtract (1053)
+	enc{[4]byte{0xd9, 0xf1, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 542, 2<<4 | 15, argp_},                                               // fyl2x (1054)
+	enc{[4]byte{0xd9, 0xf9, 0x00, 0x00}, 0, featset_FPU, 0<<11 | 543, 2<<4 | 15, argp_},                                               // fyl2xp1 (1055)
+	enc{[4]byte{0xf, 0x58, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 544, 2<<4 | 15, argp_yowo},                                     // addpd (1056)
+	enc{[4]byte{0xf, 0x58, 0x00, 0x00}, 0, featset_SSE, 0<<11 | 545, 2<<4 | 15, argp_yowo},                                            // addps (1057)
+	enc{[4]byte{0xf, 0xd0, 0x00, 0x00}, PREF_66, featset_SSE3, 0<<11 | 546, 2<<4 | 15, argp_yowo},                                     // addsubpd (1058)
+	enc{[4]byte{0xf, 0xd0, 0x00, 0x00}, PREF_F2, featset_SSE3, 0<<11 | 547, 2<<4 | 15, argp_yowo},                                     // addsubps (1059)
+	enc{[4]byte{0xf, 0x55, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 548, 2<<4 | 15, argp_yowo},                                     // andnpd (1060)
+	enc{[4]byte{0xf, 0x55, 0x00, 0x00}, 0, featset_SSE, 0<<11 | 549, 2<<4 | 15, argp_yowo},                                            // andnps (1061)
+	enc{[4]byte{0xf, 0x54, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 550, 2<<4 | 15, argp_yowo},                                     // andpd (1062)
+	enc{[4]byte{0xf, 0x54, 0x00, 0x00}, 0, featset_SSE, 0<<11 | 551, 2<<4 | 15, argp_yowo},                                            // andps (1063)
+	enc{[4]byte{0xf, 0x3a, 0xd, 0x00}, PREF_66, featset_SSE41, 0<<11 | 552, 3<<4 | 15, argp_yomqib},                                   // blendpd (1064)
+	enc{[4]byte{0xf, 0x3a, 0xd, 0x00}, PREF_66, featset_SSE41, 1<<11 | 552, 3<<4 | 15, argp_yoyoib},                                   // blendpd (1065)
+	enc{[4]byte{0xf, 0x3a, 0xc, 0x00}, PREF_66, featset_SSE41, 0<<11 | 553, 3<<4 | 15, argp_yomqib},                                   // blendps (1066)
+	enc{[4]byte{0xf, 0x3a, 0xc, 0x00}, PREF_66, featset_SSE41, 1<<11 | 553, 3<<4 | 15, argp_yoyoib},                                   // blendps (1067)
+	enc{[4]byte{0xf, 0x38, 0x15, 0x00}, PREF_66, featset_SSE41, 0<<11 | 554, 3<<4 | 15, argp_yomq},                                    // blendvpd (1068)
+	enc{[4]byte{0xf, 0x38, 0x15, 0x00}, PREF_66, featset_SSE41, 1<<11 | 554, 3<<4 | 15, argp_yoyo},                                    // blendvpd (1069)
+	enc{[4]byte{0xf, 0x38, 0x14, 0x00}, PREF_66, featset_SSE41, 0<<11 | 555, 3<<4 | 15, argp_yomq},                                    // blendvps (1070)
+	enc{[4]byte{0xf, 0x38, 0x14, 0x00}, PREF_66, featset_SSE41, 1<<11 | 555, 3<<4 | 15, argp_yoyo},                                    // blendvps (1071)
+	enc{[4]byte{0xf, 0xc2, 0x0, 0x00}, IMM_OP | PREF_66, featset_SSE2, 0<<11 | 556, 3<<4 | 15, argp_yowo},                             // cmpeqpd (1072)
+	enc{[4]byte{0xf, 0xc2, 0x0, 0x00}, IMM_OP, featset_SSE, 0<<11 | 557, 3<<4 | 15, argp_yowo},                                        // cmpeqps (1073)
+	enc{[4]byte{0xf, 0xc2, 0x2, 0x00}, IMM_OP | PREF_66, featset_SSE2, 0<<11 | 558, 3<<4 | 15, argp_yowo},                             // cmplepd (1074)
+	enc{[4]byte{0xf, 0xc2, 0x2, 0x00}, IMM_OP, featset_SSE, 0<<11 | 559, 3<<4 | 15, argp_yowo},                                        // cmpleps (1075)
+	enc{[4]byte{0xf, 0xc2, 0x1, 0x00}, IMM_OP | PREF_66, featset_SSE2, 0<<11 | 560, 3<<4 | 15, argp_yowo},                             // cmpltpd (1076)
+	enc{[4]byte{0xf, 0xc2, 0x1, 0x00}, IMM_OP, featset_SSE, 0<<11 | 561, 3<<4 | 15, argp_yowo},                                        // cmpltps (1077)
+	enc{[4]byte{0xf, 0xc2, 0x4, 0x00}, IMM_OP | PREF_66, featset_SSE2, 0<<11 | 562, 3<<4 | 15, argp_yowo},                             // cmpneqpd (1078)
+	enc{[4]byte{0xf, 0xc2, 0x4, 0x00}, IMM_OP, featset_SSE, 0<<11 | 563, 3<<4 | 15, argp_yowo},                                        // cmpneqps (1079)
+	enc{[4]byte{0xf, 0xc2, 0x6, 0x00}, IMM_OP | PREF_66, featset_SSE2, 0<<11 | 564, 3<<4 | 15, argp_yowo},                             // cmpnlepd (1080)
+	enc{[4]byte{0xf, 0xc2, 0x6, 0x00}, IMM_OP, featset_SSE, 0<<11 | 565, 3<<4 | 15, argp_yowo},                                        // cmpnleps (1081)
+	enc{[4]byte{0xf, 0xc2, 0x5, 0x00}, IMM_OP | PREF_66, featset_SSE2, 0<<11 | 566, 3<<4 | 15, argp_yowo},                             // cmpnltpd (1082)
+	enc{[4]byte{0xf, 0xc2, 0x5, 0x00}, IMM_OP, featset_SSE, 0<<11 | 567, 3<<4 | 15, argp_yowo},                                        // cmpnltps (1083)
+	enc{[4]byte{0xf, 0xc2, 0x7, 0x00}, IMM_OP | PREF_66, featset_SSE2, 0<<11 | 568, 3<<4 | 15, argp_yowo},                             // cmpordpd (1084)
+	enc{[4]byte{0xf, 0xc2, 0x7, 0x00}, IMM_OP, featset_SSE, 0<<11 | 569, 3<<4 | 15, argp_yowo},                                        // cmpordps (1085)
+	enc{[4]byte{0xf, 0xc2, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 570, 2<<4 | 15, argp_yowoib},                                   // cmppd (1086)
+	enc{[4]byte{0xf, 0xc2, 0x00, 0x00}, 0, featset_SSE, 0<<11 | 571, 2<<4 | 15, argp_yom1ib},                                          // cmpps (1087)
+	enc{[4]byte{0xf, 0xc2, 0x00, 0x00}, 0, featset_SSE, 1<<11 | 571, 2<<4 | 15, argp_yoyoib},                                          // cmpps (1088)
+	enc{[4]byte{0xf, 0xc2, 0x3, 0x00}, IMM_OP | PREF_66, featset_SSE2, 0<<11 | 572, 3<<4 | 15, argp_yowo},                             // cmpunordpd (1089)
+	enc{[4]byte{0xf, 0xc2, 0x3, 0x00}, IMM_OP, featset_SSE, 0<<11 | 573, 3<<4 | 15, argp_yowo},                                        // cmpunordps (1090)
+	enc{[4]byte{0xf, 0xe6, 0x00, 0x00}, PREF_F3, featset_SSE2, 0<<11 | 574, 2<<4 | 15, argp_yomq},                                     // cvtdq2pd (1091)
+	enc{[4]byte{0xf, 0xe6, 0x00, 0x00}, PREF_F3, featset_SSE2, 1<<11 | 574, 2<<4 | 15, argp_yoyo},                                     // cvtdq2pd (1092)
+	enc{[4]byte{0xf, 0x5b, 0x00, 0x00}, 0, featset_SSE2, 0<<11 | 575, 2<<4 | 15, argp_yowo},                                           // cvtdq2ps (1093)
+	enc{[4]byte{0xf, 0x5a, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 576, 2<<4 | 15, argp_yowo},                                     // cvtpd2ps (1094)
+	enc{[4]byte{0xf, 0x2a, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 577, 2<<4 | 15, argp_youq},                                     // cvtpi2pd (1095)
+	enc{[4]byte{0xf, 0x2a, 0x00, 0x00}, 0, featset_MMX_SSE, 0<<11 | 578, 2<<4 | 15, argp_youq},                                        // cvtpi2ps (1096)
+	enc{[4]byte{0xf, 0x5a, 0x00, 0x00}, 0, featset_SSE2, 0<<11 | 579, 2<<4 | 15, argp_yomq},                                           // cvtps2pd (1097)
+	enc{[4]byte{0xf, 0x5a, 0x00, 0x00}, 0, featset_SSE2, 1<<11 | 579, 2<<4 | 15, argp_yoyo},                                           // cvtps2pd (1098)
+	enc{[4]byte{0xf, 0x5e, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 580, 2<<4 | 15, argp_yowo},                                     // divpd (1099)
+	enc{[4]byte{0xf, 0x5e, 0x00, 0x00}, 0, featset_SSE, 0<<11 | 581, 2<<4 | 15, argp_yowo},                                            // divps (1100)
+	enc{[4]byte{0xf, 0x3a, 0x41, 0x00}, PREF_66, featset_SSE41, 0<<11 | 582, 3<<4 | 15, argp_yomqib},                                  // dppd (1101)
+	enc{[4]byte{0xf, 0x3a, 0x41, 0x00}, PREF_66, featset_SSE41, 1<<11 | 582, 3<<4 | 15, argp_yoyoib},                                  // dppd (1102)
+	enc{[4]byte{0xf, 0x3a, 0x40, 0x00}, PREF_66, featset_SSE41, 0<<11 | 583, 3<<4 | 15, argp_yomqib},                                  // dpps (1103)
+	enc{[4]byte{0xf, 0x3a, 0x40, 0x00}, PREF_66, featset_SSE41, 1<<11 | 583, 3<<4 | 15, argp_yoyoib},                                  // dpps (1104)
+	enc{[4]byte{0xf, 0x3a, 0x17, 0x00}, WITH_REXW | PREF_66 | ENC_MR, featset_SSE41, 0<<11 | 584, 3<<4 | 15, argp_rqyoib},             // extractps (1105)
+	enc{[4]byte{0xf, 0x3a, 0x17, 0x00}, PREF_66 | ENC_MR, featset_SSE41, 1<<11 | 584, 3<<4 | 15, argp_vdyoib},                         // extractps (1106)
+	enc{[4]byte{0xf, 0x7c, 0x00, 0x00}, PREF_66, featset_SSE3, 0<<11 | 585, 2<<4 | 15, argp_yowo},                                     // haddpd (1107)
+	enc{[4]byte{0xf, 0x7c, 0x00, 0x00}, PREF_F2, featset_SSE3, 0<<11 | 586, 2<<4 | 15, argp_yowo},                                     // haddps (1108)
+	enc{[4]byte{0xf, 0x7d, 0x00, 0x00}, PREF_66, featset_SSE3, 0<<11 | 587, 2<<4 | 15, argp_yowo},                                     // hsubpd (1109)
+	enc{[4]byte{0xf, 0x7d, 0x00, 0x00}, PREF_F2, featset_SSE3, 0<<11 | 588, 2<<4 | 15, argp_yowo},                                     // hsubps (1110)
+	enc{[4]byte{0xf, 0x3a, 0x21, 0x00}, PREF_66, featset_SSE41, 0<<11 | 589, 3<<4 | 15, argp_yomdib},                                  // insertps (1111)
+	enc{[4]byte{0xf, 0x3a, 0x21, 0x00}, PREF_66, featset_SSE41, 1<<11 | 589, 3<<4 | 15, argp_yoyoib},                                  // insertps (1112)
+	enc{[4]byte{0xf, 0x5f, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 590, 2<<4 | 15, argp_yowo},                                     // maxpd (1113)
+	enc{[4]byte{0xf, 0x5f, 0x00, 0x00}, 0, featset_SSE, 0<<11 | 591, 2<<4 | 15, argp_yowo},                                            // maxps (1114)
+	enc{[4]byte{0xf, 0x5d, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 592, 2<<4 | 15, argp_yowo},                                     // minpd (1115)
+	enc{[4]byte{0xf, 0x5d, 0x00, 0x00}, 0, featset_SSE, 0<<11 | 593, 2<<4 | 15, argp_yowo},                                            // minps (1116)
+	enc{[4]byte{0xf, 0x59, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 594, 2<<4 | 15, argp_yowo},                                     // mulpd (1117)
+	enc{[4]byte{0xf, 0x59, 0x00, 0x00}, 0, featset_SSE, 0<<11 | 595, 2<<4 | 15, argp_yowo},                                            // mulps (1118)
+	enc{[4]byte{0xf, 0x56, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 596, 2<<4 | 15, argp_yowo},                                     // orpd (1119)
+	enc{[4]byte{0xf, 0x56, 0x00, 0x00}, 0, featset_SSE, 0<<11 | 597, 2<<4 | 15, argp_yowo},                                            // orps (1120)
+	enc{[4]byte{0xf, 0x53, 0x00, 0x00}, 0, featset_SSE, 0<<11 | 598, 2<<4 | 15, argp_yowo},                                            // rcpps (1121)
+	enc{[4]byte{0xf, 0x3a, 0x9, 0x00}, PREF_66, featset_SSE41, 0<<11 | 599, 3<<4 | 15, argp_yomqib},                                   // roundpd (1122)
+	enc{[4]byte{0xf, 0x3a, 0x9, 0x00}, PREF_66, featset_SSE41, 1<<11 | 599, 3<<4 | 15, argp_yoyoib},                                   // roundpd (1123)
+	enc{[4]byte{0xf, 0x3a, 0x8, 0x00}, PREF_66, featset_SSE41, 0<<11 | 600, 3<<4 | 15, argp_yomqib},                                   // roundps (1124)
+	enc{[4]byte{0xf, 0x3a, 0x8, 0x00}, PREF_66, featset_SSE41, 1<<11 | 600, 3<<4 | 15, argp_yoyoib},                                   // roundps (1125)
+	enc{[4]byte{0xf, 0x52, 0x00, 0x00}, 0, featset_SSE, 0<<11 | 601, 2<<4 | 15, argp_yowo},                                            // rsqrtps (1126)
+	enc{[4]byte{0xf, 0xc6, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 602, 2<<4 | 15, argp_yowoib},                                   // shufpd (1127)
+	enc{[4]byte{0xf, 0xc6, 0x00, 0x00}, 0, featset_SSE, 0<<11 | 603, 2<<4 | 15, argp_yowoib},                                          // shufps (1128)
+	enc{[4]byte{0xf, 0x51, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 604, 2<<4 | 15, argp_yowo},                                     // sqrtpd (1129)
+	enc{[4]byte{0xf, 0x51, 0x00, 0x00}, 0, featset_SSE, 0<<11 | 605, 2<<4 | 15, argp_yowo},                                            // sqrtps (1130)
+	enc{[4]byte{0xf, 0x5c, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 606, 2<<4 | 15, argp_yowo},                                     // subpd (1131)
+	enc{[4]byte{0xf, 0x5c, 0x00, 0x00}, 0, featset_SSE, 0<<11 | 607, 2<<4 | 15, argp_yowo},                                            // subps (1132)
+	enc{[4]byte{0xf, 0x15, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 608, 2<<4 | 15, argp_yowo},                                     // unpckhpd (1133)
+	enc{[4]byte{0xf, 0x15, 0x00, 0x00}, 0, featset_SSE, 0<<11 | 609, 2<<4 | 15, argp_yowo},                                            // unpckhps (1134)
+	enc{[4]byte{0xf, 0x14, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 610, 2<<4 | 15, argp_yowo},                                     // unpcklpd (1135)
+	enc{[4]byte{0xf, 0x14, 0x00, 0x00}, 0, featset_SSE, 0<<11 | 611, 2<<4 | 15, argp_yowo},                                            // unpcklps (1136)
+	enc{[4]byte{0xf, 0x57, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 612, 2<<4 | 15, argp_yowo},                                     // xorpd (1137)
+	enc{[4]byte{0xf, 0x57, 0x00, 0x00}, 0, featset_SSE, 0<<11 | 613, 2<<4 | 15, argp_yowo},                                            // xorps (1138)
+	enc{[4]byte{0xf, 0x38, 0x1c, 0x00}, 0, featset_MMX_SSSE3, 0<<11 | 614, 3<<4 | 15, argp_xquq},                                      // pabsb (1139)
+	enc{[4]byte{0xf, 0x38, 0x1c, 0x00}, PREF_66, featset_SSSE3, 1<<11 | 614, 3<<4 | 15, argp_yomq},                                    // pabsb (1140)
+	enc{[4]byte{0xf, 0x38, 0x1c, 0x00}, PREF_66, featset_SSSE3, 2<<11 | 614, 3<<4 | 15, argp_yoyo},                                    // pabsb (1141)
+	enc{[4]byte{0xf, 0x38, 0x1e, 0x00}, 0, featset_MMX_SSSE3, 0<<11 | 615, 3<<4 | 15, argp_xquq},                                      // pabsd (1142)
+	enc{[4]byte{0xf, 0x38, 0x1e, 0x00}, PREF_66, featset_SSSE3, 1<<11 | 615, 3<<4 | 15, argp_yomq},                                    // pabsd (1143)
+	enc{[4]byte{0xf, 0x38, 0x1e, 0x00}, PREF_66, featset_SSSE3, 2<<11 | 615, 3<<4 | 15, argp_yoyo},                                    // pabsd (1144)
+	enc{[4]byte{0xf, 0x38, 0x1d, 0x00}, 0, featset_MMX_SSSE3, 0<<11 | 616, 3<<4 | 15, argp_xquq},                                      // pabsw (1145)
+	enc{[4]byte{0xf, 0x38, 0x1d, 0x00}, PREF_66, featset_SSSE3, 1<<11 | 616, 3<<4 | 15, argp_yomq},                                    // pabsw (1146)
+	enc{[4]byte{0xf, 0x38, 0x1d, 0x00}, PREF_66, featset_SSSE3, 2<<11 | 616, 3<<4 | 15, argp_yoyo},                                    // pabsw (1147)
+	enc{[4]byte{0xf, 0x6b, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 617, 2<<4 | 15, argp_xquq},                                            // packssdw (1148)
+	enc{[4]byte{0xf, 0x6b, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 617, 2<<4 | 15, argp_yowo},                                     // packssdw (1149)
+	enc{[4]byte{0xf, 0x63, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 618, 2<<4 | 15, argp_xquq},                                            // packsswb (1150)
+	enc{[4]byte{0xf, 0x63, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 618, 2<<4 | 15, argp_yowo},                                     // packsswb (1151)
+	enc{[4]byte{0xf, 0x38, 0x2b, 0x00}, PREF_66, featset_SSE41, 0<<11 | 619, 3<<4 | 15, argp_yomq},                                    // packusdw (1152)
+	enc{[4]byte{0xf, 0x38, 0x2b, 0x00}, PREF_66, featset_SSE41, 1<<11 | 619, 3<<4 | 15, argp_yoyo},                                    // packusdw (1153)
+	enc{[4]byte{0xf, 0x67, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 620, 2<<4 | 15, argp_xquq},                                            // packuswb (1154)
+	enc{[4]byte{0xf, 0x67, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 620, 2<<4 | 15, argp_yowo},                                     // packuswb (1155)
+	enc{[4]byte{0xf, 0xfc, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 621, 2<<4 | 15, argp_xquq},                                            // paddb (1156)
+	enc{[4]byte{0xf, 0xfc, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 621, 2<<4 | 15, argp_yowo},                                     // paddb (1157)
+	enc{[4]byte{0xf, 0xfe, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 622, 2<<4 | 15, argp_xquq},                                            // paddd (1158)
+	enc{[4]byte{0xf, 0xfe, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 622, 2<<4 | 15, argp_yowo},                                     // paddd (1159)
+	enc{[4]byte{0xf, 0xd4, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 623, 2<<4 | 15, argp_xquq},                                            // paddq (1160)
+	enc{[4]byte{0xf, 0xd4, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 623, 2<<4 | 15, argp_yowo},                                     // paddq (1161)
+	enc{[4]byte{0xf, 0xec, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 624, 2<<4 | 15, argp_xquq},                                            // paddsb (1162)
+	enc{[4]byte{0xf, 0xec, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 624, 2<<4 | 15, argp_yowo},                                     // paddsb (1163)
+	enc{[4]byte{0xf, 0xed, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 625, 2<<4 | 15, argp_xquq},                                            // paddsw (1164)
+	enc{[4]byte{0xf, 0xed, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 625, 2<<4 | 15, argp_yowo},                                     // paddsw (1165)
+	enc{[4]byte{0xf, 0xdc, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 626, 2<<4 | 15, argp_xquq},                                            // paddusb (1166)
+	enc{[4]byte{0xf, 0xdc, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 626, 2<<4 | 15, argp_yowo},                                     // paddusb (1167)
+	enc{[4]byte{0xf, 0xdd, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 627, 2<<4 | 15, argp_xquq},                                            // paddusw (1168)
+	enc{[4]byte{0xf, 0xdd, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 627, 2<<4 | 15, argp_yowo},                                     // paddusw (1169)
+	enc{[4]byte{0xf, 0xfd, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 628, 2<<4 | 15, argp_xquq},                                            // paddw (1170)
+	enc{[4]byte{0xf, 0xfd, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 628, 2<<4 | 15, argp_yowo},                                     // paddw (1171)
+	enc{[4]byte{0xf, 0x3a, 0xf, 0x00}, 0, featset_MMX_SSSE3, 0<<11 | 629, 3<<4 | 15, argp_xquqib},                                     // palignr (1172)
+	enc{[4]byte{0xf, 0x3a, 0xf, 0x00}, PREF_66, featset_SSSE3, 1<<11 | 629, 3<<4 | 15, argp_yomqib},                                   // palignr (1173)
+	enc{[4]byte{0xf, 0x3a, 0xf, 0x00}, PREF_66, featset_SSSE3, 2<<11 | 629, 3<<4 | 15, argp_yoyoib},                                   // palignr (1174)
+	enc{[4]byte{0xf, 0xdb, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 630, 2<<4 | 15, argp_xquq},                                            // pand (1175)
+	enc{[4]byte{0xf, 0xdb, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 630, 2<<4 | 15, argp_yowo},                                     // pand (1176)
+	enc{[4]byte{0xf, 0xdf, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 631, 2<<4 | 15, argp_xquq},                                            // pandn (1177)
+	enc{[4]byte{0xf, 0xdf, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 631, 2<<4 | 15, argp_yowo},                                     // pandn (1178)
+	enc{[4]byte{0xf, 0xe0, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 632, 2<<4 | 15, argp_xquq},                                            // pavgb (1179)
+	enc{[4]byte{0xf, 0xe0, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 632, 2<<4 | 15, argp_yowo},                                     // pavgb (1180)
+	enc{[4]byte{0xf, 0xf, 0xbf, 0x00}, IMM_OP, featset_TDNOW, 0<<11 | 633, 3<<4 | 15, argp_xquq},                                      // pavgusb (1181)
+	enc{[4]byte{0xf, 0xe3, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 634, 2<<4 | 15, argp_xquq},                                            // pavgw (1182)
+	enc{[4]byte{0xf, 0xe3, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 634, 2<<4 | 15, argp_yowo},                                     // pavgw (1183)
+	enc{[4]byte{0xf, 0x38, 0x10, 0x00}, PREF_66, featset_SSE41, 0<<11 | 635, 3<<4 | 15, argp_yomq},                                    // pblendvb (1184)
+	enc{[4]byte{0xf, 0x38, 0x10, 0x00}, PREF_66, featset_SSE41, 1<<11 | 635, 3<<4 | 15, argp_yoyo},                                    // pblendvb (1185)
+	enc{[4]byte{0xf, 0x3a, 0xe, 0x00}, PREF_66, featset_SSE41, 0<<11 | 636, 3<<4 | 15, argp_yomqib},                                   // pblendw (1186)
+	enc{[4]byte{0xf, 0x3a, 0xe, 0x00}, PREF_66, featset_SSE41, 1<<11 | 636, 3<<4 | 15, argp_yoyoib},                                   // pblendw (1187)
+	enc{[4]byte{0xf, 0x3a, 0x44, 0x11}, IMM_OP | PREF_66, featset_PCLMULQDQ, 0<<11 | 637, 4<<4 | 15, argp_yowo},                       // pclmulhqhqdq (1188)
+	enc{[4]byte{0xf, 0x3a, 0x44, 0x1}, IMM_OP | PREF_66, featset_PCLMULQDQ, 0<<11 | 638, 4<<4 | 15, argp_yowo},                        // pclmulhqlqdq (1189)
+	enc{[4]byte{0xf, 0x3a, 0x44, 0x10}, IMM_OP | PREF_66, featset_PCLMULQDQ, 0<<11 | 639, 4<<4 | 15, argp_yowo},                       // pclmullqhqdq (1190)
+	enc{[4]byte{0xf, 0x3a, 0x44, 0x0}, IMM_OP | PREF_66, featset_PCLMULQDQ, 0<<11 | 640, 4<<4 | 15, argp_yowo},                        // pclmullqlqdq (1191)
+	enc{[4]byte{0xf, 0x3a, 0x44, 0x00}, PREF_66, featset_PCLMULQDQ, 0<<11 | 641, 3<<4 | 15, argp_yowoib},                              // pclmulqdq (1192)
+	enc{[4]byte{0xf, 0x74, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 642, 2<<4 | 15, argp_xquq},                                            // pcmpeqb (1193)
+	enc{[4]byte{0xf, 0x74, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 642, 2<<4 | 15, argp_yowo},                                     // pcmpeqb (1194)
+	enc{[4]byte{0xf, 0x76, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 643, 2<<4 | 15, argp_xquq},                                            // pcmpeqd (1195)
+	enc{[4]byte{0xf, 0x76, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 643, 2<<4 | 15, argp_yowo},                                     // pcmpeqd (1196)
+	enc{[4]byte{0xf, 0x38, 0x29, 0x00}, PREF_66, featset_SSE41, 0<<11 | 644, 3<<4 | 15, argp_yomq},                                    // pcmpeqq (1197)
+	enc{[4]byte{0xf, 0x38, 0x29, 0x00}, PREF_66, featset_SSE41, 1<<11 | 644, 3<<4 | 15, argp_yoyo},                                    // pcmpeqq (1198)
+	enc{[4]byte{0xf, 0x75, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 645, 2<<4 | 15, argp_xquq},                                            // pcmpeqw (1199)
+	enc{[4]byte{0xf, 0x75, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 645, 2<<4 | 15, argp_yowo},                                     // pcmpeqw (1200)
+	enc{[4]byte{0xf, 0x3a, 0x61, 0x00}, PREF_66, featset_SSE42, 0<<11 | 646, 3<<4 | 15, argp_yomqib},                                  // pcmpestri (1201)
+	enc{[4]byte{0xf, 0x3a, 0x61, 0x00}, PREF_66, featset_SSE42, 1<<11 | 646, 3<<4 | 15, argp_yoyoib},                                  // pcmpestri (1202)
+	enc{[4]byte{0xf, 0x3a, 0x60, 0x00}, PREF_66, featset_SSE42, 0<<11 | 647, 3<<4 | 15, argp_yomqib},                                  // pcmpestrm (1203)
+	enc{[4]byte{0xf, 0x3a, 0x60, 0x00}, PREF_66, featset_SSE42, 1<<11 | 647, 3<<4 | 15, argp_yoyoib},                                  // pcmpestrm (1204)
+	enc{[4]byte{0xf, 0x64, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 648, 2<<4 | 15, argp_xquq},                                            // pcmpgtb (1205)
+	enc{[4]byte{0xf, 0x64, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 648, 2<<4 | 15, argp_yowo},                                     // pcmpgtb (1206)
+	enc{[4]byte{0xf, 0x66, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 649, 2<<4 | 15, argp_xquq},                                            // pcmpgtd (1207)
+	enc{[4]byte{0xf, 0x66, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 649, 2<<4 | 15, argp_yowo},                                     // pcmpgtd (1208)
+	enc{[4]byte{0xf, 0x38, 0x37, 0x00}, PREF_66, featset_SSE42, 0<<11 | 650, 3<<4 | 15, argp_yomq},                                    // pcmpgtq (1209)
+	enc{[4]byte{0xf, 0x38, 0x37, 0x00}, PREF_66, featset_SSE42, 1<<11 | 650, 3<<4 | 15, argp_yoyo},                                    // pcmpgtq (1210)
+	enc{[4]byte{0xf, 0x65, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 651, 2<<4 | 15, argp_xquq},                                            // pcmpgtw (1211)
+	enc{[4]byte{0xf, 0x65, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 651, 2<<4 | 15, argp_yowo},                                     // pcmpgtw (1212)
+	enc{[4]byte{0xf, 0x3a, 0x63, 0x00}, PREF_66, featset_SSE42, 0<<11 | 652, 3<<4 | 15, argp_yomqib},                                  // pcmpistri (1213)
+	enc{[4]byte{0xf, 0x3a, 0x63, 0x00}, PREF_66, featset_SSE42, 1<<11 | 652, 3<<4 | 15, argp_yoyoib},                                  // pcmpistri (1214)
+	enc{[4]byte{0xf, 0x3a, 0x62, 0x00}, PREF_66, featset_SSE42, 0<<11 | 653, 3<<4 | 15, argp_yomqib},                                  // pcmpistrm (1215)
+	enc{[4]byte{0xf, 0x3a, 0x62, 0x00}, PREF_66, featset_SSE42, 1<<11 | 653, 3<<4 | 15, argp_yoyoib},                                  // pcmpistrm (1216)
+	enc{[4]byte{0x2, 0xf5, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F2, featset_BMI2, 0<<11 | 654, 2<<4 | 15, argp_r0r0v0},              // pdep (1217)
+	enc{[4]byte{0x2, 0xf5, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F3, featset_BMI2, 0<<11 | 655, 2<<4 | 15, argp_r0r0v0},              // pext (1218)
+	enc{[4]byte{0xf, 0x3a, 0x14, 0x00}, PREF_66 | ENC_MR, featset_SSE41, 0<<11 | 656, 3<<4 | 15, argp_mbyoib},                         // pextrb (1219)
+	enc{[4]byte{0xf, 0x3a, 0x14, 0x00}, PREF_66 | ENC_MR, featset_SSE41, 1<<11 | 656, 3<<4 | 15, argp_rdyoib},                         // pextrb (1220)
+	enc{[4]byte{0xf, 0x3a, 0x14, 0x00}, WITH_REXW | PREF_66 | ENC_MR, featset_SSE41, 2<<11 | 656, 3<<4 | 15, argp_rqyoib},             // pextrb (1221)
+	enc{[4]byte{0xf, 0x3a, 0x16, 0x00}, PREF_66 | ENC_MR, featset_SSE41, 0<<11 | 657, 3<<4 | 15, argp_vdyoib},                         // pextrd (1222)
+	enc{[4]byte{0xf, 0x3a, 0x16, 0x00}, WITH_REXW | PREF_66 | ENC_MR, featset_SSE41, 0<<11 | 658, 3<<4 | 15, argp_vqyoib},             // pextrq (1223)
+	enc{[4]byte{0xf, 0x3a, 0x15, 0x00}, PREF_66 | ENC_MR, featset_SSE41, 0<<11 | 659, 3<<4 | 15, argp_mwyoib},                         // pextrw (1224)
+	enc{[4]byte{0xf, 0xc5, 0x00, 0x00}, 0, featset_MMX, 1<<11 | 659, 2<<4 | 15, argp_rdxqib},                                          // pextrw (1225)
+	enc{[4]byte{0xf, 0xc5, 0x00, 0x00}, PREF_66, featset_SSE2, 2<<11 | 659, 2<<4 | 15, argp_rdyoib},                                   // pextrw (1226)
+	enc{[4]byte{0xf, 0x3a, 0x15, 0x00}, PREF_66 | ENC_MR, featset_SSE41, 3<<11 | 659, 3<<4 | 15, argp_rdyoib},                         // pextrw (1227)
+	enc{[4]byte{0xf, 0x3a, 0x15, 0x00}, WITH_REXW | PREF_66 | ENC_MR, featset_SSE41, 4<<11 | 659, 3<<4 | 15, argp_rqyoib},             // pextrw (1228)
+	enc{[4]byte{0xf, 0xf, 0x1d, 0x00}, IMM_OP, featset_TDNOW, 0<<11 | 660, 3<<4 | 15, argp_xquq},                                      // pf2id (1229)
+	enc{[4]byte{0xf, 0xf, 0x1c, 0x00}, IMM_OP, featset_TDNOW, 0<<11 | 661, 3<<4 | 15, argp_xquq},                                      // pf2iw (1230)
+	enc{[4]byte{0xf, 0xf, 0xae, 0x00}, IMM_OP, featset_TDNOW, 0<<11 | 662, 3<<4 | 15, argp_xquq},                                      // pfacc (1231)
+	enc{[4]byte{0xf, 0xf, 0x9e, 0x00}, IMM_OP, featset_TDNOW, 0<<11 | 663, 3<<4 | 15, argp_xquq},                                      // pfadd (1232)
+	enc{[4]byte{0xf, 0xf, 0xb0, 0x00}, IMM_OP, featset_TDNOW, 0<<11 | 664, 3<<4 | 15, argp_xquq},                                      // pfcmpeq (1233)
+	enc{[4]byte{0xf, 0xf, 0x90, 0x00}, IMM_OP, featset_TDNOW, 0<<11 | 665, 3<<4 | 15, argp_xquq},                                      // pfcmpge (1234)
+	enc{[4]byte{0xf, 0xf, 0xa0, 0x00}, IMM_OP, featset_TDNOW, 0<<11 | 666, 3<<4 | 15, argp_xquq},                                      // pfcmpgt (1235)
+	enc{[4]byte{0xf, 0xf, 0xa4, 0x00}, IMM_OP, featset_TDNOW, 0<<11 | 667, 3<<4 | 15, argp_xquq},                                      // pfmax (1236)
+	enc{[4]byte{0xf, 0xf, 0x94, 0x00}, IMM_OP, featset_TDNOW, 0<<11 | 668, 3<<4 | 15, argp_xquq},                                      // pfmin (1237)
+	enc{[4]byte{0xf, 0xf, 0xb4, 0x00}, IMM_OP, featset_TDNOW, 0<<11 | 669, 3<<4 | 15, argp_xquq},                                      // pfmul (1238)
+	enc{[4]byte{0xf, 0xf, 0x8a, 0x00}, IMM_OP, featset_TDNOW, 0<<11 | 670, 3<<4 | 15, argp_xquq},                                      // pfnacc (1239)
+	enc{[4]byte{0xf, 0xf, 0x8e, 0x00}, IMM_OP, featset_TDNOW, 0<<11 | 671, 3<<4 | 15, argp_xquq},                                      // pfpnacc (1240)
+	enc{[4]byte{0xf, 0xf, 0x96, 0x00}, IMM_OP, featset_TDNOW, 0<<11 | 672, 3<<4 | 15, argp_xquq},                                      // pfrcp (1241)
+	enc{[4]byte{0xf, 0xf, 0xa6, 0x00}, IMM_OP, featset_TDNOW, 0<<11 | 673, 3<<4 | 15, argp_xquq},                                      // pfrcpit1 (1242)
+	enc{[4]byte{0xf, 0xf, 0xb6, 0x00}, IMM_OP, featset_TDNOW, 0<<11 | 674, 3<<4 | 15, argp_xquq},                                      // pfrcpit2 (1243)
+	enc{[4]byte{0xf, 0xf, 0xa7, 0x00}, IMM_OP, featset_TDNOW, 0<<11 | 675, 3<<4 | 15, argp_xquq},                                      // pfrsqit1 (1244)
+	enc{[4]byte{0xf, 0xf, 0x97, 0x00}, IMM_OP, featset_TDNOW, 0<<11 | 676, 3<<4 | 15, argp_xquq},                                      // pfrsqrt (1245)
+	enc{[4]byte{0xf, 0xf, 0x9a, 0x00}, IMM_OP, featset_TDNOW, 0<<11 | 677, 3<<4 | 15, argp_xquq},                                      // pfsub (1246)
+	enc{[4]byte{0xf, 0xf, 0xaa, 0x00}, IMM_OP, featset_TDNOW, 0<<11 | 678, 3<<4 | 15, argp_xquq},                                      // pfsubr (1247)
+	enc{[4]byte{0xf, 0x38, 0x2, 0x00}, 0, featset_MMX_SSSE3, 0<<11 | 679, 3<<4 | 15, argp_xquq},                                       // phaddd (1248)
+	enc{[4]byte{0xf, 0x38, 0x2, 0x00}, PREF_66, featset_SSSE3, 1<<11 | 679, 3<<4 | 15, argp_yomq},                                     // phaddd (1249)
+	enc{[4]byte{0xf, 0x38, 0x2, 0x00}, PREF_66, featset_SSSE3, 2<<11 | 679, 3<<4 | 15, argp_yoyo},                                     // phaddd (1250)
+	enc{[4]byte{0xf, 0x38, 0x3, 0x00}, 0, featset_MMX_SSSE3, 0<<11 | 680, 3<<4 | 15, argp_xquq},                                       // phaddsw (1251)
+	enc{[4]byte{0xf, 0x38, 0x3, 0x00}, PREF_66, featset_SSSE3, 1<<11 | 680, 3<<4 | 15, argp_yomq},                                     // phaddsw (1252)
+	enc{[4]byte{0xf, 0x38, 0x3, 0x00}, PREF_66, featset_SSSE3, 2<<11 | 680, 3<<4 | 15, argp_yoyo},                                     // phaddsw (1253)
+	enc{[4]byte{0xf, 0x38, 0x1, 0x00}, 0, featset_MMX_SSSE3, 0<<11 | 681, 3<<4 | 15, argp_xquq},                                       // phaddw (1254)
+	enc{[4]byte{0xf, 0x38, 0x1, 0x00}, PREF_66, featset_SSSE3, 1<<11 | 681, 3<<4 | 15, argp_yomq},                                     // phaddw (1255)
+	enc{[4]byte{0xf, 0x38, 0x1, 0x00}, PREF_66, featset_SSSE3, 2<<11 | 681, 3<<4 | 15, argp_yoyo},                                     // phaddw (1256)
+	enc{[4]byte{0xf, 0x38, 0x41, 0x00}, PREF_66, featset_SSE41, 0<<11 | 682, 3<<4 | 15, argp_yomq},                                    // phminposuw (1257)
+	enc{[4]byte{0xf, 0x38, 0x41, 0x00}, PREF_66, featset_SSE41, 1<<11 | 682, 3<<4 | 15, argp_yoyo},                                    // phminposuw (1258)
+	enc{[4]byte{0xf, 0x38, 0x6, 0x00}, 0, featset_MMX_SSSE3, 0<<11 | 683, 3<<4 | 15, argp_xquq},                                       // phsubd (1259)
+	enc{[4]byte{0xf, 0x38, 0x6, 0x00}, PREF_66, featset_SSSE3, 1<<11 | 683, 3<<4 | 15, argp_yomq},                                     // phsubd (1260)
+	enc{[4]byte{0xf, 0x38, 0x6, 0x00}, PREF_66, featset_SSSE3, 2<<11 | 683, 3<<4 | 15, argp_yoyo},                                     // phsubd (1261)
+	enc{[4]byte{0xf, 0x38, 0x7, 0x00}, 0, featset_MMX_SSSE3, 0<<11 | 684, 3<<4 | 15, argp_xquq},                                       // phsubsw (1262)
+	enc{[4]byte{0xf, 0x38, 0x7, 0x00}, PREF_66, featset_SSSE3, 1<<11 | 684, 3<<4 | 15, argp_yomq},                                     // phsubsw (1263)
+	enc{[4]byte{0xf, 0x38, 0x7, 0x00}, PREF_66, featset_SSSE3, 2<<11 | 684, 3<<4 | 15, argp_yoyo},                                     // phsubsw (1264)
+	enc{[4]byte{0xf, 0x38, 0x5, 0x00}, 0, featset_MMX_SSSE3, 0<<11 | 685, 3<<4 | 15, argp_xquq},                                       // phsubw (1265)
+	enc{[4]byte{0xf, 0x38, 0x5, 0x00}, PREF_66, featset_SSSE3, 1<<11 | 685, 3<<4 | 15, argp_yomq},                                     // phsubw (1266)
+	enc{[4]byte{0xf, 0x38, 0x5, 0x00}, PREF_66, featset_SSSE3, 2<<11 | 685, 3<<4 | 15, argp_yoyo},                                     // phsubw (1267)
+	enc{[4]byte{0xf, 0xf, 0xd, 0x00}, IMM_OP, featset_TDNOW, 0<<11 | 686, 3<<4 | 15, argp_xquq},                                       // pi2fd (1268)
+	enc{[4]byte{0xf, 0xf, 0xc, 0x00}, IMM_OP, featset_TDNOW, 0<<11 | 687, 3<<4 | 15, argp_xquq},                                       // pi2fw (1269)
+	enc{[4]byte{0xf, 0x3a, 0x20, 0x00}, PREF_66, featset_SSE41, 0<<11 | 688, 3<<4 | 15, argp_yom1ib},                                  // pinsrb (1270)
+	enc{[4]byte{0xf, 0x3a, 0x20, 0x00}, PREF_66, featset_SSE41, 1<<11 | 688, 3<<4 | 15, argp_yordib},                                  // pinsrb (1271)
+	enc{[4]byte{0xf, 0x3a, 0x20, 0x00}, PREF_66, featset_SSE41, 2<<11 | 688, 3<<4 | 15, argp_yovbib},                                  // pinsrb (1272)
+	enc{[4]byte{0xf, 0x3a, 0x22, 0x00}, PREF_66, featset_SSE41, 0<<11 | 689, 3<<4 | 15, argp_yom1ib},                                  // pinsrd (1273)
+	enc{[4]byte{0xf, 0x3a, 0x22, 0x00}, PREF_66, featset_SSE41, 1<<11 | 689, 3<<4 | 15, argp_yovdib},                                  // pinsrd (1274)
+	enc{[4]byte{0xf, 0x3a, 0x22, 0x00}, WITH_REXW | PREF_66, featset_SSE41, 0<<11 | 690, 3<<4 | 15, argp_yom1ib},                      // pinsrq (1275)
+	enc{[4]byte{0xf, 0x3a, 0x22, 0x00}, WITH_REXW | PREF_66, featset_SSE41, 1<<11 | 690, 3<<4 | 15, argp_yovqib},                      // pinsrq (1276)
+	enc{[4]byte{0xf, 0xc4, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 691, 2<<4 | 15, argp_xqm1ib},                                          // pinsrw (1277)
+	enc{[4]byte{0xf, 0xc4, 0x00, 0x00}, 0, featset_MMX, 1<<11 | 691, 2<<4 | 15, argp_xqrdib},                                          // pinsrw (1278)
+	enc{[4]byte{0xf, 0xc4, 0x00, 0x00}, 0, featset_MMX, 2<<11 | 691, 2<<4 | 15, argp_xqvwib},                                          // pinsrw (1279)
+	enc{[4]byte{0xf, 0xc4, 0x00, 0x00}, PREF_66, featset_SSE2, 3<<11 | 691, 2<<4 | 15, argp_yom1ib},                                   // pinsrw (1280)
+	enc{[4]byte{0xf, 0xc4, 0x00, 0x00}, PREF_66, featset_SSE2, 4<<11 | 691, 2<<4 | 15, argp_yomwib},                                   // pinsrw (1281)
+	enc{[4]byte{0xf, 0xc4, 0x00, 0x00}, PREF_66, featset_SSE2, 5<<11 | 691, 2<<4 | 15, argp_yordib},                                   // pinsrw (1282)
+	enc{[4]byte{0xf, 0xc4, 0x00, 0x00}, PREF_66, featset_SSE2, 6<<11 | 691, 2<<4 | 15, argp_yorwib},                                   // pinsrw (1283)
+	enc{[4]byte{0xf, 0x38, 0x4, 0x00}, 0, featset_MMX_SSSE3, 0<<11 | 692, 3<<4 | 15, argp_xquq},                                       // pmaddubsw (1284)
+	enc{[4]byte{0xf, 0x38, 0x4, 0x00}, PREF_66, featset_SSSE3, 1<<11 | 692, 3<<4 | 15, argp_yomq},                                     // pmaddubsw (1285)
+	enc{[4]byte{0xf, 0x38, 0x4, 0x00}, PREF_66, featset_SSSE3, 2<<11 | 692, 3<<4 | 15, argp_yoyo},                                     // pmaddubsw (1286)
+	enc{[4]byte{0xf, 0xf5, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 693, 2<<4 | 15, argp_xquq},                                            // pmaddwd (1287)
+	enc{[4]byte{0xf, 0xf5, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 693, 2<<4 | 15, argp_yowo},                                     // pmaddwd (1288)
+	enc{[4]byte{0xf, 0x38, 0x3c, 0x00}, PREF_66, featset_SSE41, 0<<11 | 694, 3<<4 | 15, argp_yomq},                                    // pmaxsb (1289)
+	enc{[4]byte{0xf, 0x38, 0x3c, 0x00}, PREF_66, featset_SSE41, 1<<11 | 694, 3<<4 | 15, argp_yoyo},                                    // pmaxsb (1290)
+	enc{[4]byte{0xf, 0x38, 0x3d, 0x00}, PREF_66, featset_SSE41, 0<<11 | 695, 3<<4 | 15, argp_yomq},                                    // pmaxsd (1291)
+	enc{[4]byte{0xf, 0x38, 0x3d, 0x00}, PREF_66, featset_SSE41, 1<<11 | 695, 3<<4 | 15, argp_yoyo},                                    // pmaxsd (1292)
+	enc{[4]byte{0xf, 0xee, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 696, 2<<4 | 15, argp_xquq},                                            // pmaxsw (1293)
+	enc{[4]byte{0xf, 0xee, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 696, 2<<4 | 15, argp_yowo},                                     // pmaxsw (1294)
+	enc{[4]byte{0xf, 0xde, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 697, 2<<4 | 15, argp_xquq},                                            // pmaxub (1295)
+	enc{[4]byte{0xf, 0xde, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 697, 2<<4 | 15, argp_yowo},                                     // pmaxub (1296)
+	enc{[4]byte{0xf, 0x38, 0x3f, 0x00}, PREF_66, featset_SSE41, 0<<11 | 698, 3<<4 | 15, argp_yomq},                                    // pmaxud (1297)
+	enc{[4]byte{0xf, 0x38, 0x3f, 0x00}, PREF_66, featset_SSE41, 1<<11 | 698, 3<<4 | 15, argp_yoyo},                                    // pmaxud (1298)
+	enc{[4]byte{0xf, 0x38, 0x3e, 0x00}, PREF_66, featset_SSE41, 0<<11 | 699, 3<<4 | 15, argp_yomq},                                    // pmaxuw (1299)
+	enc{[4]byte{0xf, 0x38, 0x3e, 0x00}, PREF_66, featset_SSE41, 1<<11 | 699, 3<<4 | 15, argp_yoyo},                                    // pmaxuw (1300)
+	enc{[4]byte{0xf, 0x38, 0x38, 0x00}, PREF_66, featset_SSE41, 0<<11 | 700, 3<<4 | 15, argp_yomq},                                    // pminsb (1301)
+	enc{[4]byte{0xf, 0x38, 0x38, 0x00}, PREF_66, featset_SSE41, 1<<11 | 700, 3<<4 | 15, argp_yoyo},                                    // pminsb (1302)
+	enc{[4]byte{0xf, 0x38, 0x39, 0x00}, PREF_66, featset_SSE41, 0<<11 | 701, 3<<4 | 15, argp_yomq},                                    // pminsd (1303)
+	enc{[4]byte{0xf, 0x38, 0x39, 0x00}, PREF_66, featset_SSE41, 1<<11 | 701, 3<<4 | 15, argp_yoyo},                                    // pminsd (1304)
+	enc{[4]byte{0xf, 0xea, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 702, 2<<4 | 15, argp_xquq},                                            // pminsw (1305)
+	enc{[4]byte{0xf, 0xea, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 702, 2<<4 | 15, argp_yowo},                                     // pminsw (1306)
+	enc{[4]byte{0xf, 0xda, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 703, 2<<4 | 15, argp_xquq},                                            // pminub (1307)
+	enc{[4]byte{0xf, 0xda, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 703, 2<<4 | 15, argp_yowo},                                     // pminub (1308)
+	enc{[4]byte{0xf, 0x38, 0x3b, 0x00}, PREF_66, featset_SSE41, 0<<11 | 704, 3<<4 | 15, argp_yomq},                                    // pminud (1309)
+	enc{[4]byte{0xf, 0x38, 0x3b, 0x00}, PREF_66, featset_SSE41, 1<<11 | 704, 3<<4 | 15, argp_yoyo},                                    // pminud (1310)
+	enc{[4]byte{0xf, 0x38, 0x3a, 0x00}, PREF_66, featset_SSE41, 0<<11 | 705, 3<<4 | 15, argp_yomq},                                    // pminuw (1311)
+	enc{[4]byte{0xf, 0x38, 0x3a, 0x00}, PREF_66, featset_SSE41, 1<<11 | 705, 3<<4 | 15, argp_yoyo},                                    // pminuw (1312)
+	enc{[4]byte{0xf, 0xd7, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 706, 2<<4 | 15, argp_rdxq},                                            // pmovmskb (1313)
+	enc{[4]byte{0xf, 0xd7, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 706, 2<<4 | 15, argp_rdyo},                                     // pmovmskb (1314)
+	enc{[4]byte{0xf, 0x38, 0x21, 0x00}, PREF_66, featset_SSE41, 0<<11 | 707, 3<<4 | 15, argp_yomd},                                    // pmovsxbd (1315)
+	enc{[4]byte{0xf, 0x38, 0x21, 0x00}, PREF_66, featset_SSE41, 1<<11 | 707, 3<<4 | 15, argp_yoyo},                                    // pmovsxbd (1316)
+	enc{[4]byte{0xf, 0x38, 0x22, 0x00}, PREF_66, featset_SSE41, 0<<11 | 708, 3<<4 | 15, argp_yomw},                                    // pmovsxbq (1317)
+	enc{[4]byte{0xf, 0x38, 0x22, 0x00}, PREF_66, featset_SSE41, 1<<11 | 708, 3<<4 | 15, argp_yoyo},                                    // pmovsxbq (1318)
+	enc{[4]byte{0xf, 0x38, 0x20, 0x00}, PREF_66, featset_SSE41, 0<<11 | 709, 3<<4 | 15, argp_yomq},                                    // pmovsxbw (1319)
+	enc{[4]byte{0xf, 0x38, 0x20, 0x00}, PREF_66, featset_SSE41, 1<<11 | 709, 3<<4 | 15, argp_yoyo},                                    // pmovsxbw (1320)
+	enc{[4]byte{0xf, 0x38, 0x25, 0x00}, PREF_66, featset_SSE41, 0<<11 | 710, 3<<4 | 15, argp_yomq},                                    // pmovsxdq (1321)
+	enc{[4]byte{0xf, 0x38, 0x25, 0x00}, PREF_66, featset_SSE41, 1<<11 | 710, 3<<4 | 15, argp_yoyo},                                    // pmovsxdq (1322)
+	enc{[4]byte{0xf, 0x38, 0x23, 0x00}, PREF_66, featset_SSE41, 0<<11 | 711, 3<<4 | 15, argp_yomq},                                    // pmovsxwd (1323)
+	enc{[4]byte{0xf, 0x38, 0x23, 0x00}, PREF_66, featset_SSE41, 1<<11 | 711, 3<<4 | 15, argp_yoyo},                                    // pmovsxwd (1324)
+	enc{[4]byte{0xf, 0x38, 0x24, 0x00}, PREF_66, featset_SSE41, 0<<11 | 712, 3<<4 | 15, argp_yomd},                                    // pmovsxwq (1325)
+	enc{[4]byte{0xf, 0x38, 0x24, 0x00}, PREF_66, featset_SSE41, 1<<11 | 712, 3<<4 | 15, argp_yoyo},                                    // pmovsxwq (1326)
+	enc{[4]byte{0xf, 0x38, 0x31, 0x00}, PREF_66, featset_SSE41, 0<<11 | 713, 3<<4 | 15, argp_yomd},                                    // pmovzxbd (1327)
+	enc{[4]byte{0xf, 0x38, 0x31, 0x00}, PREF_66, featset_SSE41, 1<<11 | 713, 3<<4 | 15, argp_yoyo},                                    // pmovzxbd (1328)
+	enc{[4]byte{0xf, 0x38, 0x32, 0x00}, PREF_66, featset_SSE41, 0<<11 | 714, 3<<4 | 15, argp_yomw},                                    // pmovzxbq (1329)
+	enc{[4]byte{0xf, 0x38, 0x32, 0x00}, PREF_66, featset_SSE41, 1<<11 | 714, 3<<4 | 15, argp_yoyo},                                    // pmovzxbq (1330)
+	enc{[4]byte{0xf, 0x38, 0x30, 0x00}, PREF_66, featset_SSE41, 0<<11 | 715, 3<<4 | 15, argp_yomq},                                    // pmovzxbw (1331)
+	enc{[4]byte{0xf, 0x38, 0x30, 0x00}, PREF_66, featset_SSE41, 1<<11 | 715, 3<<4 | 15, argp_yoyo},                                    // pmovzxbw (1332)
+	enc{[4]byte{0xf, 0x38, 0x35, 0x00}, PREF_66, featset_SSE41, 0<<11 | 716, 3<<4 | 15, argp_yomq},                                    // pmovzxdq (1333)
+	enc{[4]byte{0xf, 0x38, 0x35, 0x00}, PREF_66, featset_SSE41, 1<<11 | 716, 3<<4 | 15, argp_yoyo},                                    // pmovzxdq (1334)
+	enc{[4]byte{0xf, 0x38, 0x33, 0x00}, PREF_66, featset_SSE41, 0<<11 | 717, 3<<4 | 15, argp_yomq},                                    // pmovzxwd (1335)
+	enc{[4]byte{0xf, 0x38, 0x33, 0x00}, PREF_66, featset_SSE41, 1<<11 | 717, 3<<4 | 15, argp_yoyo},                                    // pmovzxwd (1336)
+	enc{[4]byte{0xf, 0x38, 0x34, 0x00}, PREF_66, featset_SSE41, 0<<11 | 718, 3<<4 | 15, argp_yomd},                                    // pmovzxwq (1337)
+	enc{[4]byte{0xf, 0x38, 0x34, 0x00}, PREF_66, featset_SSE41, 1<<11 | 718, 3<<4 | 15, argp_yoyo},                                    // pmovzxwq (1338)
+	enc{[4]byte{0xf, 0x38, 0x28, 0x00}, PREF_66, featset_SSE41, 0<<11 | 719, 3<<4 | 15, argp_yomq},                                    // pmuldq (1339)
+	enc{[4]byte{0xf, 0x38, 0x28, 0x00}, PREF_66, featset_SSE41, 1<<11 | 719, 3<<4 | 15, argp_yoyo},                                    // pmuldq (1340)
+	enc{[4]byte{0xf, 0x38, 0xb, 0x00}, 0, featset_MMX_SSSE3, 0<<11 | 720, 3<<4 | 15, argp_xquq},                                       // pmulhrsw (1341)
+	enc{[4]byte{0xf, 0x38, 0xb, 0x00}, PREF_66, featset_SSSE3, 1<<11 | 720, 3<<4 | 15, argp_yomq},                                     // pmulhrsw (1342)
+	enc{[4]byte{0xf, 0x38, 0xb, 0x00}, PREF_66, featset_SSSE3, 2<<11 | 720, 3<<4 | 15, argp_yoyo},                                     // pmulhrsw (1343)
+	enc{[4]byte{0xf, 0xf, 0xb7, 0x00}, IMM_OP, featset_TDNOW, 0<<11 | 721, 3<<4 | 15, argp_xquq},                                      // pmulhrwa (1344)
+	enc{[4]byte{0xf, 0xe4, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 722, 2<<4 | 15, argp_xquq},                                            // pmulhuw (1345)
+	enc{[4]byte{0xf, 0xe4, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 722, 2<<4 | 15, argp_yowo},                                     // pmulhuw (1346)
+	enc{[4]byte{0xf, 0xe5, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 723, 2<<4 | 15, argp_xquq},                                            // pmulhw (1347)
+	enc{[4]byte{0xf, 0xe5, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 723, 2<<4 | 15, argp_yowo},                                     // pmulhw (1348)
+	enc{[4]byte{0xf, 0x38, 0x40, 0x00}, PREF_66, featset_SSE41, 0<<11 | 724, 3<<4 | 15, argp_yomq},                                    // pmulld (1349)
+	enc{[4]byte{0xf, 0x38, 0x40, 0x00}, PREF_66, featset_SSE41, 1<<11 | 724, 3<<4 | 15, argp_yoyo},                                    // pmulld (1350)
+	enc{[4]byte{0xf, 0xd5, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 725, 2<<4 | 15, argp_xquq},                                            // pmullw (1351)
+	enc{[4]byte{0xf, 0xd5, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 725, 2<<4 | 15, argp_yowo},                                     // pmullw (1352)
+	enc{[4]byte{0xf, 0xf4, 0x00, 0x00}, 0, featset_SSE2, 0<<11 | 726, 2<<4 | 15, argp_xquq},                                           // pmuludq (1353)
+	enc{[4]byte{0xf, 0xf4, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 726, 2<<4 | 15, argp_yowo},                                     // pmuludq (1354)
+	enc{[4]byte{0xf, 0xeb, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 727, 2<<4 | 15, argp_xquq},                                            // por (1355)
+	enc{[4]byte{0xf, 0xeb, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 727, 2<<4 | 15, argp_yowo},                                     // por (1356)
+	enc{[4]byte{0xf, 0xf6, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 728, 2<<4 | 15, argp_xquq},                                            // psadbw (1357)
+	enc{[4]byte{0xf, 0xf6, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 728, 2<<4 | 15, argp_yowo},                                     // psadbw (1358)
+	enc{[4]byte{0xf, 0x38, 0x0, 0x00}, 0, featset_MMX_SSSE3, 0<<11 | 729, 3<<4 | 15, argp_xquq},                                       // pshufb (1359)
+	enc{[4]byte{0xf, 0x38, 0x0, 0x00}, PREF_66, featset_SSSE3, 1<<11 | 729, 3<<4 | 15, argp_yomq},                                     // pshufb (1360)
+	enc{[4]byte{0xf, 0x38, 0x0, 0x00}, PREF_66, featset_SSSE3, 2<<11 | 729, 3<<4 | 15, argp_yoyo},                                     // pshufb (1361)
+	enc{[4]byte{0xf, 0x70, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 730, 2<<4 | 15, argp_yowoib},                                   // pshufd (1362)
+	enc{[4]byte{0xf, 0x70, 0x00, 0x00}, PREF_F3, featset_SSE2, 0<<11 | 731, 2<<4 | 15, argp_yowoib},                                   // pshufhw (1363)
+	enc{[4]byte{0xf, 0x70, 0x00, 0x00}, PREF_F2, featset_SSE2, 0<<11 | 732, 2<<4 | 15, argp_yowoib},                                   // pshuflw (1364)
+	enc{[4]byte{0xf, 0x70, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 733, 2<<4 | 15, argp_xquqib},                                          // pshufw (1365)
+	enc{[4]byte{0xf, 0x38, 0x8, 0x00}, 0, featset_MMX_SSSE3, 0<<11 | 734, 3<<4 | 15, argp_xquq},                                       // psignb (1366)
+	enc{[4]byte{0xf, 0x38, 0x8, 0x00}, PREF_66, featset_SSSE3, 1<<11 | 734, 3<<4 | 15, argp_yomq},                                     // psignb (1367)
+	enc{[4]byte{0xf, 0x38, 0x8, 0x00}, PREF_66, featset_SSSE3, 2<<11 | 734, 3<<4 | 15, argp_yoyo},                                     // psignb (1368)
+	enc{[4]byte{0xf, 0x38, 0xa, 0x00}, 0, featset_MMX_SSSE3, 0<<11 | 735, 3<<4 | 15, argp_xquq},                                       // psignd (1369)
+	enc{[4]byte{0xf, 0x38, 0xa, 0x00}, PREF_66, featset_SSSE3, 1<<11 | 735, 3<<4 | 15, argp_yomq},                                     // psignd (1370)
+	enc{[4]byte{0xf, 0x38, 0xa, 0x00}, PREF_66, featset_SSSE3, 2<<11 | 735, 3<<4 | 15, argp_yoyo},                                     // psignd (1371)
+	enc{[4]byte{0xf, 0x38, 0x9, 0x00}, 0, featset_MMX_SSSE3, 0<<11 | 736, 3<<4 | 15, argp_xquq},                                       // psignw (1372)
+	enc{[4]byte{0xf, 0x38, 0x9, 0x00}, PREF_66, featset_SSSE3, 1<<11 | 736, 3<<4 | 15, argp_yomq},                                     // psignw (1373)
+	enc{[4]byte{0xf, 0x38, 0x9, 0x00}, PREF_66, featset_SSSE3, 2<<11 | 736, 3<<4 | 15, argp_yoyo},                                     // psignw (1374)
+	enc{[4]byte{0xf, 0x72, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 737, 2<<4 | 6, argp_xqib},                                             // pslld (1375)
+	enc{[4]byte{0xf, 0xf2, 0x00, 0x00}, 0, featset_MMX, 1<<11 | 737, 2<<4 | 15, argp_xquq},                                            // pslld (1376)
+	enc{[4]byte{0xf, 0x72, 0x00, 0x00}, PREF_66, featset_SSE2, 2<<11 | 737, 2<<4 | 6, argp_yoib},                                      // pslld (1377)
+	enc{[4]byte{0xf, 0xf2, 0x00, 0x00}, PREF_66, featset_SSE2, 3<<11 | 737, 2<<4 | 15, argp_yowo},                                     // pslld (1378)
+	enc{[4]byte{0xf, 0x73, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 738, 2<<4 | 7, argp_yoib},                                      // pslldq (1379)
+	enc{[4]byte{0xf, 0x73, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 739, 2<<4 | 6, argp_xqib},                                             // psllq (1380)
+	enc{[4]byte{0xf, 0xf3, 0x00, 0x00}, 0, featset_MMX, 1<<11 | 739, 2<<4 | 15, argp_xquq},                                            // psllq (1381)
+	enc{[4]byte{0xf, 0x73, 0x00, 0x00}, PREF_66, featset_SSE2, 2<<11 | 739, 2<<4 | 6, argp_yoib},                                      // psllq (1382)
+	enc{[4]byte{0xf, 0xf3, 0x00, 0x00}, PREF_66, featset_SSE2, 3<<11 | 739, 2<<4 | 15, argp_yowo},                                     // psllq (1383)
+	enc{[4]byte{0xf, 0x71, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 740, 2<<4 | 6, argp_xqib},                                             // psllw (1384)
+	enc{[4]byte{0xf, 0xf1, 0x00, 0x00}, 0, featset_MMX, 1<<11 | 740, 2<<4 | 15, argp_xquq},                                            // psllw (1385)
+	enc{[4]byte{0xf, 0x71, 0x00, 0x00}, PREF_66, featset_SSE2, 2<<11 | 740, 2<<4 | 6, argp_yoib},                                      // psllw (1386)
+	enc{[4]byte{0xf, 0xf1, 0x00, 0x00}, PREF_66, featset_SSE2, 3<<11 | 740, 2<<4 | 15, argp_yowo},                                     // psllw (1387)
+	enc{[4]byte{0xf, 0x72, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 741, 2<<4 | 4, argp_xqib},                                             // psrad (1388)
+	enc{[4]byte{0xf, 0xe2, 0x00, 0x00}, 0, featset_MMX, 1<<11 | 741, 2<<4 | 15, argp_xquq},                                            // psrad (1389)
+	enc{[4]byte{0xf, 0x72, 0x00, 0x00}, PREF_66, featset_SSE2, 2<<11 | 741, 2<<4 | 4, argp_yoib},                                      // psrad (1390)
+	enc{[4]byte{0xf, 0xe2, 0x00, 0x00}, PREF_66, featset_SSE2, 3<<11 | 741, 2<<4 | 15, argp_yowo},                                     // psrad (1391)
+	enc{[4]byte{0xf, 0x71, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 742, 2<<4 | 4, argp_xqib},                                             // psraw (1392)
+	enc{[4]byte{0xf, 0xe1, 0x00, 0x00}, 0, featset_MMX, 1<<11 | 742, 2<<4 | 15, argp_xquq},                                            // psraw (1393)
+	enc{[4]byte{0xf, 0x71, 0x00, 0x00}, PREF_66, featset_SSE2, 2<<11 | 742, 2<<4 | 4, argp_yoib},                                      // psraw (1394)
+	enc{[4]byte{0xf, 0xe1, 0x00, 0x00}, PREF_66, featset_SSE2, 3<<11 | 742, 2<<4 | 15, argp_yowo},                                     // psraw (1395)
+	enc{[4]byte{0xf, 0x72, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 743, 2<<4 | 2, argp_xqib},                                             // psrld (1396)
+	enc{[4]byte{0xf, 0xd2, 0x00, 0x00}, 0, featset_MMX, 1<<11 | 743, 2<<4 | 15, argp_xquq},                                            // psrld (1397)
+	enc{[4]byte{0xf, 0x72, 0x00, 0x00}, PREF_66, featset_SSE2, 2<<11 | 743, 2<<4 | 2, argp_yoib},                                      // psrld (1398)
+	enc{[4]byte{0xf, 0xd2, 0x00, 0x00}, PREF_66, featset_SSE2, 3<<11 | 743, 2<<4 | 15, argp_yowo},                                     // psrld (1399)
+	enc{[4]byte{0xf, 0x73, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 744, 2<<4 | 3, argp_yoib},                                      // psrldq (1400)
+	enc{[4]byte{0xf, 0x73, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 745, 2<<4 | 2, argp_xqib},                                             // psrlq (1401)
+	enc{[4]byte{0xf, 0xd3, 0x00, 0x00}, 0, featset_MMX, 1<<11 | 745, 2<<4 | 15, argp_xquq},                                            // psrlq (1402)
+	enc{[4]byte{0xf, 0x73, 0x00, 0x00}, PREF_66, featset_SSE2, 2<<11 | 745, 2<<4 | 2, argp_yoib},                                      // psrlq (1403)
+	enc{[4]byte{0xf, 0xd3, 0x00, 0x00}, PREF_66, featset_SSE2, 3<<11 | 745, 2<<4 | 15, argp_yowo},                                     // psrlq (1404)
+	enc{[4]byte{0xf, 0x71, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 746, 2<<4 | 2, argp_xqib},                                             // psrlw (1405)
+	enc{[4]byte{0xf, 0xd1, 0x00, 0x00}, 0, featset_MMX, 1<<11 | 746, 2<<4 | 15, argp_xquq},                                            // psrlw (1406)
+	enc{[4]byte{0xf, 0x71, 0x00, 0x00}, PREF_66, featset_SSE2, 2<<11 | 746, 2<<4 | 2, argp_yoib},                                      // psrlw (1407)
+	enc{[4]byte{0xf, 0xd1, 0x00, 0x00}, PREF_66, featset_SSE2, 3<<11 | 746, 2<<4 | 15, argp_yowo},                                     // psrlw (1408)
+	enc{[4]byte{0xf, 0xf8, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 747, 2<<4 | 15, argp_xquq},                                            // psubb (1409)
+	enc{[4]byte{0xf, 0xf8, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 747, 2<<4 | 15, argp_yowo},                                     // psubb (1410)
+	enc{[4]byte{0xf, 0xfa, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 748, 2<<4 | 15, argp_xquq},                                            // psubd (1411)
+	enc{[4]byte{0xf, 0xfa, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 748, 2<<4 | 15, argp_yowo},                                     // psubd (1412)
+	enc{[4]byte{0xf, 0xfb, 0x00, 0x00}, 0, featset_SSE2, 0<<11 | 749, 2<<4 | 15, argp_xquq},                                           // psubq (1413)
+	enc{[4]byte{0xf, 0xfb, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 749, 2<<4 | 15, argp_yowo},                                     // psubq (1414)
+	enc{[4]byte{0xf, 0xe8, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 750, 2<<4 | 15, argp_xquq},                                            // psubsb (1415)
+	enc{[4]byte{0xf, 0xe8, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 750, 2<<4 | 15, argp_yowo},                                     // psubsb (1416)
+	enc{[4]byte{0xf, 0xe9, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 751, 2<<4 | 15, argp_xquq},                                            // psubsw (1417)
+	enc{[4]byte{0xf, 0xe9, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 751, 2<<4 | 15, argp_yowo},                                     // psubsw (1418)
+	enc{[4]byte{0xf, 0xd8, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 752, 2<<4 | 15, argp_xquq},                                            // psubusb (1419)
+	enc{[4]byte{0xf, 0xd8, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 752, 2<<4 | 15, argp_yowo},                                     // psubusb (1420)
+	enc{[4]byte{0xf, 0xd9, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 753, 2<<4 | 15, argp_xquq},                                            // psubusw (1421)
+	enc{[4]byte{0xf, 0xd9, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 753, 2<<4 | 15, argp_yowo},                                     // psubusw (1422)
+	enc{[4]byte{0xf, 0xf9, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 754, 2<<4 | 15, argp_xquq},                                            // psubw (1423)
+	enc{[4]byte{0xf, 0xf9, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 754, 2<<4 | 15, argp_yowo},                                     // psubw (1424)
+	enc{[4]byte{0xf, 0xf, 0xbb, 0x00}, IMM_OP, featset_TDNOW, 0<<11 | 755, 3<<4 | 15, argp_xquq},                                      // pswapd (1425)
+	enc{[4]byte{0xf, 0x38, 0x17, 0x00}, PREF_66, featset_SSE41, 0<<11 | 756, 3<<4 | 15, argp_yomq},                                    // ptest (1426)
+	enc{[4]byte{0xf, 0x38, 0x17, 0x00}, PREF_66, featset_SSE41, 1<<11 | 756, 3<<4 | 15, argp_yoyo},                                    // ptest (1427)
+	enc{[4]byte{0xf, 0x68, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 757, 2<<4 | 15, argp_xquq},                                            // punpckhbw (1428)
+	enc{[4]byte{0xf, 0x68, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 757, 2<<4 | 15, argp_yowo},                                     // punpckhbw (1429)
+	enc{[4]byte{0xf, 0x6a, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 758, 2<<4 | 15, argp_xquq},                                            // punpckhdq (1430)
+	enc{[4]byte{0xf, 0x6a, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 758, 2<<4 | 15, argp_yowo},                                     // punpckhdq (1431)
+	enc{[4]byte{0xf, 0x6d, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 759, 2<<4 | 15, argp_yowo},                                     // punpckhqdq (1432)
+	enc{[4]byte{0xf, 0x69, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 760, 2<<4 | 15, argp_xquq},                                            // punpckhwd (1433)
+	enc{[4]byte{0xf, 0x69, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 760, 2<<4 | 15, argp_yowo},                                     // punpckhwd (1434)
+	enc{[4]byte{0xf, 0x60, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 761, 2<<4 | 15, argp_xquq},                                            // punpcklbw (1435)
+	enc{[4]byte{0xf, 0x60, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 761, 2<<4 | 15, argp_yowo},                                     // punpcklbw (1436)
+	enc{[4]byte{0xf, 0x62, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 762, 2<<4 | 15, argp_xquq},                                            // punpckldq (1437)
+	enc{[4]byte{0xf, 0x62, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 762, 2<<4 | 15, argp_yowo},                                     // punpckldq (1438)
+	enc{[4]byte{0xf, 0x6c, 0x00, 0x00}, PREF_66, featset_SSE2, 0<<11 | 763, 2<<4 | 15, argp_yowo},                                     // punpcklqdq (1439)
+	enc{[4]byte{0xf, 0x61, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 764, 2<<4 | 15, argp_xquq},                                            // punpcklwd (1440)
+	enc{[4]byte{0xf, 0x61, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 764, 2<<4 | 15, argp_yowo},                                     // punpcklwd (1441)
+	enc{[4]byte{0xf, 0xef, 0x00, 0x00}, 0, featset_MMX, 0<<11 | 765, 2<<4 | 15, argp_xquq},                                            // pxor (1442)
+	enc{[4]byte{0xf, 0xef, 0x00, 0x00}, PREF_66, featset_SSE2, 1<<11 | 765, 2<<4 | 15, argp_yowo},                                     // pxor (1443)
+	enc{[4]byte{0x1, 0x58, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 766, 2<<4 | 15, argp_y0y0w0},               // vaddpd (1444)
+	enc{[4]byte{0x1, 0x58, 0x00, 0x00}, VEX_OP | AUTO_VEXL, featset_AVX, 0<<11 | 767, 2<<4 | 15, argp_y0y0w0},                         // vaddps (1445)
+	enc{[4]byte{0x1, 0x58, 0x00, 0x00}, VEX_OP | PREF_F2, featset_AVX, 0<<11 | 768, 2<<4 | 15, argp_yoyomq},                           // vaddsd (1446)
+	enc{[4]byte{0x1, 0x58, 0x00, 0x00}, VEX_OP | PREF_F2, featset_AVX, 1<<11 | 768, 2<<4 | 15, argp_yoyoyo},                           // vaddsd (1447)
+	enc{[4]byte{0x1, 0x58, 0x00, 0x00}, VEX_OP | PREF_F3, featset_AVX, 0<<11 | 769, 2<<4 | 15, argp_yoyomd},                           // vaddss (1448)
+	enc{[4]byte{0x1, 0x58, 0x00, 0x00}, VEX_OP | PREF_F3, featset_AVX, 1<<11 | 769, 2<<4 | 15, argp_yoyoyo},                           // vaddss (1449)
+	enc{[4]byte{0x1, 0xd0, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 770, 2<<4 | 15, argp_y0y0w0},               // vaddsubpd (1450)
+	enc{[4]byte{0x1, 0xd0, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F2, featset_AVX, 0<<11 | 771, 2<<4 | 15, argp_y0y0w0},               // vaddsubps (1451)
+	enc{[4]byte{0x2, 0xde, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX_AES, 0<<11 | 772, 2<<4 | 15, argp_yoyowo},                       // vaesdec (1452)
+	enc{[4]byte{0x2, 0xdf, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX_AES, 0<<11 | 773, 2<<4 | 15, argp_yoyowo},                       // vaesdeclast (1453)
+	enc{[4]byte{0x2, 0xdc, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX_AES, 0<<11 | 774, 2<<4 | 15, argp_yoyowo},                       // vaesenc (1454)
+	enc{[4]byte{0x2, 0xdd, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX_AES, 0<<11 | 775, 2<<4 | 15, argp_yoyowo},                       // vaesenclast (1455)
+	enc{[4]byte{0x2, 0xdb, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX_AES, 0<<11 | 776, 2<<4 | 15, argp_yowo},                         // vaesimc (1456)
+	enc{[4]byte{0x3, 0xdf, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX_AES, 0<<11 | 777, 2<<4 | 15, argp_yowoib},                       // vaeskeygenassist (1457)
+	enc{[4]byte{0x1, 0x55, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 778, 2<<4 | 15, argp_y0y0w0},               // vandnpd (1458)
+	enc{[4]byte{0x1, 0x55, 0x00, 0x00}, VEX_OP | AUTO_VEXL, featset_AVX, 0<<11 | 779, 2<<4 | 15, argp_y0y0w0},                         // vandnps (1459)
+	enc{[4]byte{0x1, 0x54, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 780, 2<<4 | 15, argp_y0y0w0},               // vandpd (1460)
+	enc{[4]byte{0x1, 0x54, 0x00, 0x00}, VEX_OP | AUTO_VEXL, featset_AVX, 0<<11 | 781, 2<<4 | 15, argp_y0y0w0},                         // vandps (1461)
+	enc{[4]byte{0x3, 0xd, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, featset_AVX, 0<<11 | 782, 2<<4 | 15, argp_y0y0w0ib},     // vblendpd (1462)
+	enc{[4]byte{0x3, 0xc, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, featset_AVX, 0<<11 | 783, 2<<4 | 15, argp_y0y0w0ib},     // vblendps (1463)
+	enc{[4]byte{0x3, 0x4b, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 784, 2<<4 | 15, argp_y0y0w0y0},             // vblendvpd (1464)
+	enc{[4]byte{0x3, 0x4a, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 785, 2<<4 | 15, argp_y0y0w0y0},             // vblendvps (1465)
+	enc{[4]byte{0x2, 0x1a, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66, featset_AVX, 0<<11 | 786, 2<<4 | 15, argp_yhmo},                 // vbroadcastf128 (1466)
+	enc{[4]byte{0x2, 0x5a, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66, featset_AVX2, 0<<11 | 787, 2<<4 | 15, argp_yhmo},                // vbroadcasti128 (1467)
+	enc{[4]byte{0x2, 0x19, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66, featset_AVX, 0<<11 | 788, 2<<4 | 15, argp_yhmq},                 // vbroadcastsd (1468)
+	enc{[4]byte{0x2, 0x19, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66, featset_AVX, 1<<11 | 788, 2<<4 | 15, argp_yhyo},                 // vbroadcastsd (1469)
+	enc{[4]byte{0x2, 0x18, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 789, 2<<4 | 15, argp_y0md},                             // vbroadcastss (1470)
+	enc{[4]byte{0x2, 0x18, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 1<<11 | 789, 2<<4 | 15, argp_y0yo},                 // vbroadcastss (1471)
+	enc{[4]byte{0x1, 0xc2, 0x10, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX, 0<<11 | 790, 3<<4 | 15, argp_y0y0w0},                  // vcmpeq_ospd (1472)
+	enc{[4]byte{0x1, 0xc2, 0x10, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX, 1<<11 | 790, 3<<4 | 15, argp_yoyowo},                  // vcmpeq_ospd (1473)
+	enc{[4]byte{0x1, 0xc2, 0x10, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 791, 3<<4 | 15, argp_y0y0w0},                // vcmpeq_osps (1474)
+	enc{[4]byte{0x1, 0xc2, 0x10, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 792, 3<<4 | 15, argp_yoyomq},                  // vcmpeq_ossd (1475)
+	enc{[4]byte{0x1, 0xc2, 0x10, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 792, 3<<4 | 15, argp_yoyoyo},                  // vcmpeq_ossd (1476)
+	enc{[4]byte{0x1, 0xc2, 0x10, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 793, 3<<4 | 15, argp_yoyomq},                  // vcmpeq_osss (1477)
+	enc{[4]byte{0x1, 0xc2, 0x10, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 793, 3<<4 | 15, argp_yoyoyo},                  // vcmpeq_osss (1478)
+	enc{[4]byte{0x1, 0xc2, 0x8, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, featset_AVX, 0<<11 | 794, 3<<4 | 15, argp_yhyhwh},       // vcmpeq_uqpd (1479)
+	enc{[4]byte{0x1, 0xc2, 0x8, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX, 1<<11 | 794, 3<<4 | 15, argp_yoyowo},                   // vcmpeq_uqpd (1480)
+	enc{[4]byte{0x1, 0xc2, 0x8, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 795, 3<<4 | 15, argp_y0y0w0},                 // vcmpeq_uqps (1481)
+	enc{[4]byte{0x1, 0xc2, 0x8, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 796, 3<<4 | 15, argp_yoyomq},                   // vcmpeq_uqsd (1482)
+	enc{[4]byte{0x1, 0xc2, 0x8, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 796, 3<<4 | 15, argp_yoyoyo},                   // vcmpeq_uqsd (1483)
+	enc{[4]byte{0x1, 0xc2, 0x8, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 797, 3<<4 | 15, argp_yoyomq},                   // vcmpeq_uqss (1484)
+	enc{[4]byte{0x1, 0xc2, 0x8, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 797, 3<<4 | 15, argp_yoyoyo},                   // vcmpeq_uqss (1485)
+	enc{[4]byte{0x1, 0xc2, 0x18, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, featset_AVX, 0<<11 | 798, 3<<4 | 15, argp_yhyhwh},      // vcmpeq_uspd (1486)
+	enc{[4]byte{0x1, 0xc2, 0x18, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX, 1<<11 | 798, 3<<4 | 15, argp_yoyowo},                  // vcmpeq_uspd (1487)
+	enc{[4]byte{0x1, 0xc2, 0x18, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 799, 3<<4 | 15, argp_y0y0w0},                // vcmpeq_usps (1488)
+	enc{[4]byte{0x1, 0xc2, 0x18, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 800, 3<<4 | 15, argp_yoyomq},                  // vcmpeq_ussd (1489)
+	enc{[4]byte{0x1, 0xc2, 0x18, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 800, 3<<4 | 15, argp_yoyoyo},                  // vcmpeq_ussd (1490)
+	enc{[4]byte{0x1, 0xc2, 0x18, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 801, 3<<4 | 15, argp_yoyomq},                  // vcmpeq_usss (1491)
+	enc{[4]byte{0x1, 0xc2, 0x18, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 801, 3<<4 | 15, argp_yoyoyo},                  // vcmpeq_usss (1492)
+	enc{[4]byte{0x1, 0xc2, 0x0, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 802, 3<<4 | 15, argp_y0y0w0},       // vcmpeqpd (1493)
+	enc{[4]byte{0x1, 0xc2, 0x0, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 803, 3<<4 | 15, argp_y0y0w0},                 // vcmpeqps (1494)
+	enc{[4]byte{0x1, 0xc2, 0x0, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 804, 3<<4 | 15, argp_yoyomq},                   // vcmpeqsd (1495)
+	enc{[4]byte{0x1, 0xc2, 0x0, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 804, 3<<4 | 15, argp_yoyoyo},                   // vcmpeqsd (1496)
+	enc{[4]byte{0x1, 0xc2, 0x0, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 805, 3<<4 | 15, argp_yoyomq},                   // vcmpeqss (1497)
+	enc{[4]byte{0x1, 0xc2, 0x0, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 805, 3<<4 | 15, argp_yoyoyo},                   // vcmpeqss (1498)
+	enc{[4]byte{0x1, 0xc2, 0xb, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 806, 3<<4 | 15, argp_y0y0w0},       // vcmpfalse_oqpd (1499)
+	enc{[4]byte{0x1, 0xc2, 0xb, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 807, 3<<4 | 15, argp_y0y0w0},                 // vcmpfalse_oqps (1500)
+	enc{[4]byte{0x1, 0xc2, 0xb, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 808, 3<<4 | 15, argp_yoyomq},                   // vcmpfalse_oqsd (1501)
+	enc{[4]byte{0x1, 0xc2, 0xb, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 808, 3<<4 | 15, argp_yoyoyo},                   // vcmpfalse_oqsd (1502)
+	enc{[4]byte{0x1, 0xc2, 0xb, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 809, 3<<4 | 15, argp_yoyomq},                   // vcmpfalse_oqss (1503)
+	enc{[4]byte{0x1, 0xc2, 0xb, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 809, 3<<4 | 15, argp_yoyoyo},                   // vcmpfalse_oqss (1504)
+	enc{[4]byte{0x1, 0xc2, 0x1b, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 810, 3<<4 | 15, argp_y0y0w0},      // vcmpfalse_ospd (1505)
+	enc{[4]byte{0x1, 0xc2, 0x1b, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 811, 3<<4 | 15, argp_y0y0w0},                // vcmpfalse_osps (1506)
+	enc{[4]byte{0x1, 0xc2, 0x1b, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 812, 3<<4 | 15, argp_yoyomq},                  // vcmpfalse_ossd (1507)
+	enc{[4]byte{0x1, 0xc2, 0x1b, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 812, 3<<4 | 15, argp_yoyoyo},                  // vcmpfalse_ossd (1508)
+	enc{[4]byte{0x1, 0xc2, 0x1b, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 813, 3<<4 | 15, argp_yoyomq},                  // vcmpfalse_osss (1509)
+	enc{[4]byte{0x1, 0xc2, 0x1b, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 813, 3<<4 | 15, argp_yoyoyo},                  // vcmpfalse_osss (1510)
+	enc{[4]byte{0x1, 0xc2, 0xb, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, featset_AVX, 0<<11 | 814, 3<<4 | 15, argp_yhyhwh},       // vcmpfalsepd (1511)
+	enc{[4]byte{0x1, 0xc2, 0xb, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX, 1<<11 | 814, 3<<4 | 15, argp_yoyowo},                   // vcmpfalsepd (1512)
+	enc{[4]byte{0x1, 0xc2, 0xb, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 815, 3<<4 | 15, argp_y0y0w0},                 // vcmpfalseps (1513)
+	enc{[4]byte{0x1, 0xc2, 0xb, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 816, 3<<4 | 15, argp_yoyomq},                   // vcmpfalsesd (1514)
+	enc{[4]byte{0x1, 0xc2, 0xb, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 816, 3<<4 | 15, argp_yoyoyo},                   // vcmpfalsesd (1515)
+	enc{[4]byte{0x1, 0xc2, 0xb, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 817, 3<<4 | 15, argp_yoyomq},                   // vcmpfalsess (1516)
+	enc{[4]byte{0x1, 0xc2, 0xb, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 817, 3<<4 | 15, argp_yoyoyo},                   // vcmpfalsess (1517)
+	enc{[4]byte{0x1, 0xc2, 0x1d, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, featset_AVX, 0<<11 | 818, 3<<4 | 15, argp_yhyhwh},      // vcmpge_oqpd (1518)
+	enc{[4]byte{0x1, 0xc2, 0x1d, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX, 1<<11 | 818, 3<<4 | 15, argp_yoyowo},                  // vcmpge_oqpd (1519)
+	enc{[4]byte{0x1, 0xc2, 0x1d, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 819, 3<<4 | 15, argp_y0y0w0},                // vcmpge_oqps (1520)
+	enc{[4]byte{0x1, 0xc2, 0x1d, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 820, 3<<4 | 15, argp_yoyomq},                  // vcmpge_oqsd (1521)
+	enc{[4]byte{0x1, 0xc2, 0x1d, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 820, 3<<4 | 15, argp_yoyoyo},                  // vcmpge_oqsd (1522)
+	enc{[4]byte{0x1, 0xc2, 0x1d, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 821, 3<<4 | 15, argp_yoyomq},                  // vcmpge_oqss (1523)
+	enc{[4]byte{0x1, 0xc2, 0x1d, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 821, 3<<4 | 15, argp_yoyoyo},                  // vcmpge_oqss (1524)
+	enc{[4]byte{0x1, 0xc2, 0xd, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 822, 3<<4 | 15, argp_y0y0w0},       // vcmpge_ospd (1525)
+	enc{[4]byte{0x1, 0xc2, 0xd, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 823, 3<<4 | 15, argp_y0y0w0},                 // vcmpge_osps (1526)
+	enc{[4]byte{0x1, 0xc2, 0xd, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 824, 3<<4 | 15, argp_yoyomq},                   // vcmpge_ossd (1527)
+	enc{[4]byte{0x1, 0xc2, 0xd, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 824, 3<<4 | 15, argp_yoyoyo},                   // vcmpge_ossd (1528)
+	enc{[4]byte{0x1, 0xc2, 0xd, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 825, 3<<4 | 15, argp_yoyomq},                   // vcmpge_osss (1529)
+	enc{[4]byte{0x1, 0xc2, 0xd, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 825, 3<<4 | 15, argp_yoyoyo},                   // vcmpge_osss (1530)
+	enc{[4]byte{0x1, 0xc2, 0xd, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 826, 3<<4 | 15, argp_y0y0w0},       // vcmpgepd (1531)
+	enc{[4]byte{0x1, 0xc2, 0xd, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 827, 3<<4 | 15, argp_y0y0w0},                 // vcmpgeps (1532)
+	enc{[4]byte{0x1, 0xc2, 0xd, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 828, 3<<4 | 15, argp_yoyomq},                   // vcmpgesd (1533)
+	enc{[4]byte{0x1, 0xc2, 0xd, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 828, 3<<4 | 15, argp_yoyoyo},                   // vcmpgesd (1534)
+	enc{[4]byte{0x1, 0xc2, 0xd, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 829, 3<<4 | 15, argp_yoyomq},                   // vcmpgess (1535)
+	enc{[4]byte{0x1, 0xc2, 0xd, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 829, 3<<4 | 15, argp_yoyoyo},                   // vcmpgess (1536)
+	enc{[4]byte{0x1, 0xc2, 0x1e, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 830, 3<<4 | 15, argp_y0y0w0},      // vcmpgt_oqpd (1537)
+	enc{[4]byte{0x1, 0xc2, 0x1e, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 831, 3<<4 | 15, argp_y0y0w0},                // vcmpgt_oqps (1538)
+	enc{[4]byte{0x1, 0xc2, 0x1e, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 832, 3<<4 | 15, argp_yoyomq},                  // vcmpgt_oqsd (1539)
+	enc{[4]byte{0x1, 0xc2, 0x1e, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 832, 3<<4 | 15, argp_yoyoyo},                  // vcmpgt_oqsd (1540)
+	enc{[4]byte{0x1, 0xc2, 0x1e, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 833, 3<<4 | 15, argp_yoyomq},                  // vcmpgt_oqss (1541)
+	enc{[4]byte{0x1, 0xc2, 0x1e, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 833, 3<<4 | 15, argp_yoyoyo},                  // vcmpgt_oqss (1542)
+	enc{[4]byte{0x1, 0xc2, 0xe, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 834, 3<<4 | 15, argp_y0y0w0},       // vcmpgt_ospd (1543)
+	enc{[4]byte{0x1, 0xc2, 0xe, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 835, 3<<4 | 15, argp_y0y0w0},                 // vcmpgt_osps (1544)
+	enc{[4]byte{0x1, 0xc2, 0xe, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 836, 3<<4 | 15, argp_yoyomq},                   // vcmpgt_ossd (1545)
+	enc{[4]byte{0x1, 0xc2, 0xe, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 836, 3<<4 | 15, argp_yoyoyo},                   // vcmpgt_ossd (1546)
+	enc{[4]byte{0x1, 0xc2, 0xe, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 837, 3<<4 | 15, argp_yoyomq},                   // vcmpgt_osss (1547)
+	enc{[4]byte{0x1, 0xc2, 0xe, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 837, 3<<4 | 15, argp_yoyoyo},                   // vcmpgt_osss (1548)
+	enc{[4]byte{0x1, 0xc2, 0xe, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, featset_AVX, 0<<11 | 838, 3<<4 | 15, argp_yhyhwh},       // vcmpgtpd (1549)
+	enc{[4]byte{0x1, 0xc2, 0xe, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX, 1<<11 | 838, 3<<4 | 15, argp_yoyowo},                   // vcmpgtpd (1550)
+	enc{[4]byte{0x1, 0xc2, 0xe, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 839, 3<<4 | 15, argp_y0y0w0},                 // vcmpgtps (1551)
+	enc{[4]byte{0x1, 0xc2, 0xe, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 840, 3<<4 | 15, argp_yoyomq},                   // vcmpgtsd (1552)
+	enc{[4]byte{0x1, 0xc2, 0xe, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 840, 3<<4 | 15, argp_yoyoyo},                   // vcmpgtsd (1553)
+	enc{[4]byte{0x1, 0xc2, 0xe, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 841, 3<<4 | 15, argp_yoyomq},                   // vcmpgtss (1554)
+	enc{[4]byte{0x1, 0xc2, 0xe, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 841, 3<<4 | 15, argp_yoyoyo},                   // vcmpgtss (1555)
+	enc{[4]byte{0x1, 0xc2, 0x12, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, featset_AVX, 0<<11 | 842, 3<<4 | 15, argp_yhyhwh},      // vcmple_oqpd (1556)
+	enc{[4]byte{0x1, 0xc2, 0x12, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX, 1<<11 | 842, 3<<4 | 15, argp_yoyowo},                  // vcmple_oqpd (1557)
+	enc{[4]byte{0x1, 0xc2, 0x12, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 843, 3<<4 | 15, argp_y0y0w0},                // vcmple_oqps (1558)
+	enc{[4]byte{0x1, 0xc2, 0x12, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 844, 3<<4 | 15, argp_yoyomq},                  // vcmple_oqsd (1559)
+	enc{[4]byte{0x1, 0xc2, 0x12, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 844, 3<<4 | 15, argp_yoyoyo},                  // vcmple_oqsd (1560)
+	enc{[4]byte{0x1, 0xc2, 0x12, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 845, 3<<4 | 15, argp_yoyomq},                  // vcmple_oqss (1561)
+	enc{[4]byte{0x1, 0xc2, 0x12, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 845, 3<<4 | 15, argp_yoyoyo},                  // vcmple_oqss (1562)
+	enc{[4]byte{0x1, 0xc2, 0x2, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 846, 3<<4 | 15, argp_y0y0w0},       // vcmple_ospd (1563)
+	enc{[4]byte{0x1, 0xc2, 0x2, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 847, 3<<4 | 15, argp_y0y0w0},                 // vcmple_osps (1564)
+	enc{[4]byte{0x1, 0xc2, 0x2, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 848, 3<<4 | 15, argp_yoyomq},                   // vcmple_ossd (1565)
+	enc{[4]byte{0x1, 0xc2, 0x2, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 848, 3<<4 | 15, argp_yoyoyo},                   // vcmple_ossd (1566)
+	enc{[4]byte{0x1, 0xc2, 0x2, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 849, 3<<4 | 15, argp_yoyomq},                   // vcmple_osss (1567)
+	enc{[4]byte{0x1, 0xc2, 0x2, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 849, 3<<4 | 15, argp_yoyoyo},                   // vcmple_osss (1568)
+	enc{[4]byte{0x1, 0xc2, 0x2, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, featset_AVX, 0<<11 | 850, 3<<4 | 15, argp_yhyhwh},       // vcmplepd (1569)
+	enc{[4]byte{0x1, 0xc2, 0x2, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX, 1<<11 | 850, 3<<4 | 15, argp_yoyowo},                   // vcmplepd (1570)
+	enc{[4]byte{0x1, 0xc2, 0x2, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 851, 3<<4 | 15, argp_y0y0w0},                 // vcmpleps (1571)
+	enc{[4]byte{0x1, 0xc2, 0x2, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 852, 3<<4 | 15, argp_yoyomq},                   // vcmplesd (1572)
+	enc{[4]byte{0x1, 0xc2, 0x2, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 852, 3<<4 | 15, argp_yoyoyo},                   // vcmplesd (1573)
+	enc{[4]byte{0x1, 0xc2, 0x2, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 853, 3<<4 | 15, argp_yoyomq},                   // vcmpless (1574)
+	enc{[4]byte{0x1, 0xc2, 0x2, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 853, 3<<4 | 15, argp_yoyoyo},                   // vcmpless (1575)
+	enc{[4]byte{0x1, 0xc2, 0x11, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 854, 3<<4 | 15, argp_y0y0w0},      // vcmplt_oqpd (1576)
+	enc{[4]byte{0x1, 0xc2, 0x11, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 855, 3<<4 | 15, argp_y0y0w0},                // vcmplt_oqps (1577)
+	enc{[4]byte{0x1, 0xc2, 0x11, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 856, 3<<4 | 15, argp_yoyomq},                  // vcmplt_oqsd (1578)
+	enc{[4]byte{0x1, 0xc2, 0x11, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 856, 3<<4 | 15, argp_yoyoyo},                  // vcmplt_oqsd (1579)
+	enc{[4]byte{0x1, 0xc2, 0x11, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 857, 3<<4 | 15, argp_yoyomq},                  // vcmplt_oqss (1580)
+	enc{[4]byte{0x1, 0xc2, 0x11, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 857, 3<<4 | 15, argp_yoyoyo},                  // vcmplt_oqss (1581)
+	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, featset_AVX, 0<<11 | 858, 3<<4 | 15, argp_yhyhwh},       // vcmplt_ospd (1582)
+	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX, 1<<11 | 858, 3<<4 | 15, argp_yoyowo},                   // vcmplt_ospd (1583)
+	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 859, 3<<4 | 15, argp_y0y0w0},                 // vcmplt_osps (1584)
+	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 860, 3<<4 | 15, argp_yoyomq},                   // vcmplt_ossd (1585)
+	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 860, 3<<4 | 15, argp_yoyoyo},                   // vcmplt_ossd (1586)
+	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 861, 3<<4 | 15, argp_yoyomq},                   // vcmplt_osss (1587)
+	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 861, 3<<4 | 15, argp_yoyoyo},                   // vcmplt_osss (1588)
+	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, featset_AVX, 0<<11 | 862, 3<<4 | 15, argp_yhyhwh},       // vcmpltpd (1589)
+	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX, 1<<11 | 862, 3<<4 | 15, argp_yoyowo},                   // vcmpltpd (1590)
+	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 863, 3<<4 | 15, argp_y0y0w0},                 // vcmpltps (1591)
+	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 864, 3<<4 | 15, argp_yoyomq},                   // vcmpltsd (1592)
+	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 864, 3<<4 | 15, argp_yoyoyo},                   // vcmpltsd (1593)
+	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 865, 3<<4 | 15, argp_yoyomq},                   // vcmpltss (1594)
+	enc{[4]byte{0x1, 0xc2, 0x1, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 865, 3<<4 | 15, argp_yoyoyo},                   // vcmpltss (1595)
+	enc{[4]byte{0x1, 0xc2, 0xc, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, featset_AVX, 0<<11 | 866, 3<<4 | 15, argp_yhyhwh},       // vcmpneq_oqpd (1596)
+	enc{[4]byte{0x1, 0xc2, 0xc, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX, 1<<11 | 866, 3<<4 | 15, argp_yoyowo},                   // vcmpneq_oqpd (1597)
+	enc{[4]byte{0x1, 0xc2, 0xc, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 867, 3<<4 | 15, argp_y0y0w0},                 // vcmpneq_oqps (1598)
+	enc{[4]byte{0x1, 0xc2, 0xc, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 868, 3<<4 | 15, argp_yoyomq},                   // vcmpneq_oqsd (1599)
+	enc{[4]byte{0x1, 0xc2, 0xc, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 868, 3<<4 | 15, argp_yoyoyo},                   // vcmpneq_oqsd (1600)
+	enc{[4]byte{0x1, 0xc2, 0xc, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 869, 3<<4 | 15, argp_yoyomq},                   // vcmpneq_oqss (1601)
+	enc{[4]byte{0x1, 0xc2, 0xc, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 869, 3<<4 | 15, argp_yoyoyo},                   // vcmpneq_oqss (1602)
+	enc{[4]byte{0x1, 0xc2, 0x1c, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, featset_AVX, 0<<11 | 870, 3<<4 | 15, argp_yhyhwh},      // vcmpneq_ospd (1603)
+	enc{[4]byte{0x1, 0xc2, 0x1c, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX, 1<<11 | 870, 3<<4 | 15, argp_yoyowo},                  // vcmpneq_ospd (1604)
+	enc{[4]byte{0x1, 0xc2, 0x1c, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 871, 3<<4 | 15, argp_y0y0w0},                // vcmpneq_osps (1605)
+	enc{[4]byte{0x1, 0xc2, 0x1c, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 872, 3<<4 | 15, argp_yoyomq},                  // vcmpneq_ossd (1606)
+	enc{[4]byte{0x1, 0xc2, 0x1c, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 872, 3<<4 | 15, argp_yoyoyo},                  // vcmpneq_ossd (1607)
+	enc{[4]byte{0x1, 0xc2, 0x1c, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 873, 3<<4 | 15, argp_yoyomq},                  // vcmpneq_osss (1608)
+	enc{[4]byte{0x1, 0xc2, 0x1c, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 873, 3<<4 | 15, argp_yoyoyo},                  // vcmpneq_osss (1609)
+	enc{[4]byte{0x1, 0xc2, 0x4, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 874, 3<<4 | 15, argp_y0y0w0},       // vcmpneq_uqpd (1610)
+	enc{[4]byte{0x1, 0xc2, 0x4, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 875, 3<<4 | 15, argp_y0y0w0},                 // vcmpneq_uqps (1611)
+	enc{[4]byte{0x1, 0xc2, 0x4, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 876, 3<<4 | 15, argp_yoyomq},                   // vcmpneq_uqsd (1612)
+	enc{[4]byte{0x1, 0xc2, 0x4, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 876, 3<<4 | 15, argp_yoyoyo},                   // vcmpneq_uqsd (1613)
+	enc{[4]byte{0x1, 0xc2, 0x4, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 877, 3<<4 | 15, argp_yoyomq},                   // vcmpneq_uqss (1614)
+	enc{[4]byte{0x1, 0xc2, 0x4, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 877, 3<<4 | 15, argp_yoyoyo},                   // vcmpneq_uqss (1615)
+	enc{[4]byte{0x1, 0xc2, 0x14, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, featset_AVX, 0<<11 | 878, 3<<4 | 15, argp_yhyhwh},      // vcmpneq_uspd (1616)
+	enc{[4]byte{0x1, 0xc2, 0x14, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX, 1<<11 | 878, 3<<4 | 15, argp_yoyowo},                  // vcmpneq_uspd (1617)
+	enc{[4]byte{0x1, 0xc2, 0x14, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 879, 3<<4 | 15, argp_y0y0w0},                // vcmpneq_usps (1618)
+	enc{[4]byte{0x1, 0xc2, 0x14, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 880, 3<<4 | 15, argp_yoyomq},                  // vcmpneq_ussd (1619)
+	enc{[4]byte{0x1, 0xc2, 0x14, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 880, 3<<4 | 15, argp_yoyoyo},                  // vcmpneq_ussd (1620)
+	enc{[4]byte{0x1, 0xc2, 0x14, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 881, 3<<4 | 15, argp_yoyomq},                  // vcmpneq_usss (1621)
+	enc{[4]byte{0x1, 0xc2, 0x14, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 881, 3<<4 | 15, argp_yoyoyo},                  // vcmpneq_usss (1622)
+	enc{[4]byte{0x1, 0xc2, 0x4, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, featset_AVX, 0<<11 | 882, 3<<4 | 15, argp_yhyhwh},       // vcmpneqpd (1623)
+	enc{[4]byte{0x1, 0xc2, 0x4, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX, 1<<11 | 882, 3<<4 | 15, argp_yoyowo},                   // vcmpneqpd (1624)
+	enc{[4]byte{0x1, 0xc2, 0x4, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 883, 3<<4 | 15, argp_y0y0w0},                 // vcmpneqps (1625)
+	enc{[4]byte{0x1, 0xc2, 0x4, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 884, 3<<4 | 15, argp_yoyomq},                   // vcmpneqsd (1626)
+	enc{[4]byte{0x1, 0xc2, 0x4, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 884, 3<<4 | 15, argp_yoyoyo},                   // vcmpneqsd (1627)
+	enc{[4]byte{0x1, 0xc2, 0x4, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 885, 3<<4 | 15, argp_yoyomq},                   // vcmpneqss (1628)
+	enc{[4]byte{0x1, 0xc2, 0x4, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 885, 3<<4 | 15, argp_yoyoyo},                   // vcmpneqss (1629)
+	enc{[4]byte{0x1, 0xc2, 0x19, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 886, 3<<4 | 15, argp_y0y0w0},      // vcmpnge_uqpd (1630)
+	enc{[4]byte{0x1, 0xc2, 0x19, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 887, 3<<4 | 15, argp_y0y0w0},                // vcmpnge_uqps (1631)
+	enc{[4]byte{0x1, 0xc2, 0x19, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 888, 3<<4 | 15, argp_yoyomq},                  // vcmpnge_uqsd (1632)
+	enc{[4]byte{0x1, 0xc2, 0x19, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 888, 3<<4 | 15, argp_yoyoyo},                  // vcmpnge_uqsd (1633)
+	enc{[4]byte{0x1, 0xc2, 0x19, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 889, 3<<4 | 15, argp_yoyomq},                  // vcmpnge_uqss (1634)
+	enc{[4]byte{0x1, 0xc2, 0x19, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 889, 3<<4 | 15, argp_yoyoyo},                  // vcmpnge_uqss (1635)
+	enc{[4]byte{0x1, 0xc2, 0x9, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 890, 3<<4 | 15, argp_y0y0w0},       // vcmpnge_uspd (1636)
+	enc{[4]byte{0x1, 0xc2, 0x9, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 891, 3<<4 | 15, argp_y0y0w0},                 // vcmpnge_usps (1637)
+	enc{[4]byte{0x1, 0xc2, 0x9, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 892, 3<<4 | 15, argp_yoyomq},                   // vcmpnge_ussd (1638)
+	enc{[4]byte{0x1, 0xc2, 0x9, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 892, 3<<4 | 15, argp_yoyoyo},                   // vcmpnge_ussd (1639)
+	enc{[4]byte{0x1, 0xc2, 0x9, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 893, 3<<4 | 15, argp_yoyomq},                   // vcmpnge_usss (1640)
+	enc{[4]byte{0x1, 0xc2, 0x9, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 893, 3<<4 | 15, argp_yoyoyo},                   // vcmpnge_usss (1641)
+	enc{[4]byte{0x1, 0xc2, 0x9, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, featset_AVX, 0<<11 | 894, 3<<4 | 15, argp_yhyhwh},       // vcmpngepd (1642)
+	enc{[4]byte{0x1, 0xc2, 0x9, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX, 1<<11 | 894, 3<<4 | 15, argp_yoyowo},                   // vcmpngepd (1643)
+	enc{[4]byte{0x1, 0xc2, 0x9, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 895, 3<<4 | 15, argp_y0y0w0},                 // vcmpngeps (1644)
+	enc{[4]byte{0x1, 0xc2, 0x9, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 896, 3<<4 | 15, argp_yoyomq},                   // vcmpngesd (1645)
+	enc{[4]byte{0x1, 0xc2, 0x9, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 896, 3<<4 | 15, argp_yoyoyo},                   // vcmpngesd (1646)
+	enc{[4]byte{0x1, 0xc2, 0x9, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 897, 3<<4 | 15, argp_yoyomq},                   // vcmpngess (1647)
+	enc{[4]byte{0x1, 0xc2, 0x9, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 897, 3<<4 | 15, argp_yoyoyo},                   // vcmpngess (1648)
+	enc{[4]byte{0x1, 0xc2, 0x1a, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 898, 3<<4 | 15, argp_y0y0w0},      // vcmpngt_uqpd (1649)
+	enc{[4]byte{0x1, 0xc2, 0x1a, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 899, 3<<4 | 15, argp_y0y0w0},                // vcmpngt_uqps (1650)
+	enc{[4]byte{0x1, 0xc2, 0x1a, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 900, 3<<4 | 15, argp_yoyomq},                  // vcmpngt_uqsd (1651)
+	enc{[4]byte{0x1, 0xc2, 0x1a, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 900, 3<<4 | 15, argp_yoyoyo},                  // vcmpngt_uqsd (1652)
+	enc{[4]byte{0x1, 0xc2, 0x1a, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 901, 3<<4 | 15, argp_yoyomq},                  // vcmpngt_uqss (1653)
+	enc{[4]byte{0x1, 0xc2, 0x1a, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 901, 3<<4 | 15, argp_yoyoyo},                  // vcmpngt_uqss (1654)
+	enc{[4]byte{0x1, 0xc2, 0xa, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 902, 3<<4 | 15, argp_y0y0w0},       // vcmpngt_uspd (1655)
+	enc{[4]byte{0x1, 0xc2, 0xa, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 903, 3<<4 | 15, argp_y0y0w0},                 // vcmpngt_usps (1656)
+	enc{[4]byte{0x1, 0xc2, 0xa, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 904, 3<<4 | 15, argp_yoyomq},                   // vcmpngt_ussd (1657)
+	enc{[4]byte{0x1, 0xc2, 0xa, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 904, 3<<4 | 15, argp_yoyoyo},                   // vcmpngt_ussd (1658)
+	enc{[4]byte{0x1, 0xc2, 0xa, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 905, 3<<4 | 15, argp_yoyomq},                   // vcmpngt_usss (1659)
+	enc{[4]byte{0x1, 0xc2, 0xa, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 905, 3<<4 | 15, argp_yoyoyo},                   // vcmpngt_usss (1660)
+	enc{[4]byte{0x1, 0xc2, 0xa, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 906, 3<<4 | 15, argp_y0y0w0},       // vcmpngtpd (1661)
+	enc{[4]byte{0x1, 0xc2, 0xa, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 907, 3<<4 | 15, argp_y0y0w0},                 // vcmpngtps (1662)
+	enc{[4]byte{0x1, 0xc2, 0xa, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 908, 3<<4 | 15, argp_yoyomq},                   // vcmpngtsd (1663)
+	enc{[4]byte{0x1, 0xc2, 0xa, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 908, 3<<4 | 15, argp_yoyoyo},                   // vcmpngtsd (1664)
+	enc{[4]byte{0x1, 0xc2, 0xa, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 909, 3<<4 | 15, argp_yoyomq},                   // vcmpngtss (1665)
+	enc{[4]byte{0x1, 0xc2, 0xa, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 909, 3<<4 | 15, argp_yoyoyo},                   // vcmpngtss (1666)
+	enc{[4]byte{0x1, 0xc2, 0x16, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 910, 3<<4 | 15, argp_y0y0w0},      // vcmpnle_uqpd (1667)
+	enc{[4]byte{0x1, 0xc2, 0x16, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 911, 3<<4 | 15, argp_y0y0w0},                // vcmpnle_uqps (1668)
+	enc{[4]byte{0x1, 0xc2, 0x16, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 912, 3<<4 | 15, argp_yoyomq},                  // vcmpnle_uqsd (1669)
+	enc{[4]byte{0x1, 0xc2, 0x16, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 912, 3<<4 | 15, argp_yoyoyo},                  // vcmpnle_uqsd (1670)
+	enc{[4]byte{0x1, 0xc2, 0x16, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 913, 3<<4 | 15, argp_yoyomq},                  // vcmpnle_uqss (1671)
+	enc{[4]byte{0x1, 0xc2, 0x16, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 913, 3<<4 | 15, argp_yoyoyo},                  // vcmpnle_uqss (1672)
+	enc{[4]byte{0x1, 0xc2, 0x6, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, featset_AVX, 0<<11 | 914, 3<<4 | 15, argp_yhyhwh},       // vcmpnle_uspd (1673)
+	enc{[4]byte{0x1, 0xc2, 0x6, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX, 1<<11 | 914, 3<<4 | 15, argp_yoyowo},                   // vcmpnle_uspd (1674)
+	enc{[4]byte{0x1, 0xc2, 0x6, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 915, 3<<4 | 15, argp_y0y0w0},                 // vcmpnle_usps (1675)
+	enc{[4]byte{0x1, 0xc2, 0x6, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 916, 3<<4 | 15, argp_yoyomq},                   // vcmpnle_ussd (1676)
+	enc{[4]byte{0x1, 0xc2, 0x6, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 916, 3<<4 | 15, argp_yoyoyo},                   // vcmpnle_ussd (1677)
+	enc{[4]byte{0x1, 0xc2, 0x6, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 917, 3<<4 | 15, argp_yoyomq},                   // vcmpnle_usss (1678)
+	enc{[4]byte{0x1, 0xc2, 0x6, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 917, 3<<4 | 15, argp_yoyoyo},                   // vcmpnle_usss (1679)
+	enc{[4]byte{0x1, 0xc2, 0x6, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 918, 3<<4 | 15, argp_y0y0w0},       // vcmpnlepd (1680)
+	enc{[4]byte{0x1, 0xc2, 0x6, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 919, 3<<4 | 15, argp_y0y0w0},                 // vcmpnleps (1681)
+	enc{[4]byte{0x1, 0xc2, 0x6, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 920, 3<<4 | 15, argp_yoyomq},                   // vcmpnlesd (1682)
+	enc{[4]byte{0x1, 0xc2, 0x6, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 920, 3<<4 | 15, argp_yoyoyo},                   // vcmpnlesd (1683)
+	enc{[4]byte{0x1, 0xc2, 0x6, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 921, 3<<4 | 15, argp_yoyomq},                   // vcmpnless (1684)
+	enc{[4]byte{0x1, 0xc2, 0x6, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 921, 3<<4 | 15, argp_yoyoyo},                   // vcmpnless (1685)
+	enc{[4]byte{0x1, 0xc2, 0x15, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, featset_AVX, 0<<11 | 922, 3<<4 | 15, argp_yhyhwh},      // vcmpnlt_uqpd (1686)
+	enc{[4]byte{0x1, 0xc2, 0x15, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX, 1<<11 | 922, 3<<4 | 15, argp_yoyowo},                  // vcmpnlt_uqpd (1687)
+	enc{[4]byte{0x1, 0xc2, 0x15, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 923, 3<<4 | 15, argp_y0y0w0},                // vcmpnlt_uqps (1688)
+	enc{[4]byte{0x1, 0xc2, 0x15, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 924, 3<<4 | 15, argp_yoyomq},                  // vcmpnlt_uqsd (1689)
+	enc{[4]byte{0x1, 0xc2, 0x15, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 924, 3<<4 | 15, argp_yoyoyo},                  // vcmpnlt_uqsd (1690)
+	enc{[4]byte{0x1, 0xc2, 0x15, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 925, 3<<4 | 15, argp_yoyomq},                  // vcmpnlt_uqss (1691)
+	enc{[4]byte{0x1, 0xc2, 0x15, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 925, 3<<4 | 15, argp_yoyoyo},                  // vcmpnlt_uqss (1692)
+	enc{[4]byte{0x1, 0xc2, 0x5, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 926, 3<<4 | 15, argp_y0y0w0},       // vcmpnlt_uspd (1693)
+	enc{[4]byte{0x1, 0xc2, 0x5, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 927, 3<<4 | 15, argp_y0y0w0},                 // vcmpnlt_usps (1694)
+	enc{[4]byte{0x1, 0xc2, 0x5, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 928, 3<<4 | 15, argp_yoyomq},                   // vcmpnlt_ussd (1695)
+	enc{[4]byte{0x1, 0xc2, 0x5, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 928, 3<<4 | 15, argp_yoyoyo},                   // vcmpnlt_ussd (1696)
+	enc{[4]byte{0x1, 0xc2, 0x5, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 929, 3<<4 | 15, argp_yoyomq},                   // vcmpnlt_usss (1697)
+	enc{[4]byte{0x1, 0xc2, 0x5, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 929, 3<<4 | 15, argp_yoyoyo},                   // vcmpnlt_usss (1698)
+	enc{[4]byte{0x1, 0xc2, 0x5, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, featset_AVX, 0<<11 | 930, 3<<4 | 15, argp_yhyhwh},       // vcmpnltpd (1699)
+	enc{[4]byte{0x1, 0xc2, 0x5, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX, 1<<11 | 930, 3<<4 | 15, argp_yoyowo},                   // vcmpnltpd (1700)
+	enc{[4]byte{0x1, 0xc2, 0x5, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 931, 3<<4 | 15, argp_y0y0w0},                 // vcmpnltps (1701)
+	enc{[4]byte{0x1, 0xc2, 0x5, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 932, 3<<4 | 15, argp_yoyomq},                   // vcmpnltsd (1702)
+	enc{[4]byte{0x1, 0xc2, 0x5, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 932, 3<<4 | 15, argp_yoyoyo},                   // vcmpnltsd (1703)
+	enc{[4]byte{0x1, 0xc2, 0x5, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 933, 3<<4 | 15, argp_yoyomq},                   // vcmpnltss (1704)
+	enc{[4]byte{0x1, 0xc2, 0x5, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 933, 3<<4 | 15, argp_yoyoyo},                   // vcmpnltss (1705)
+	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, featset_AVX, 0<<11 | 934, 3<<4 | 15, argp_yhyhwh},       // vcmpord_qpd (1706)
+	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX, 1<<11 | 934, 3<<4 | 15, argp_yoyowo},                   // vcmpord_qpd (1707)
+	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 935, 3<<4 | 15, argp_y0y0w0},                 // vcmpord_qps (1708)
+	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 936, 3<<4 | 15, argp_yoyomq},                   // vcmpord_qsd (1709)
+	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 936, 3<<4 | 15, argp_yoyoyo},                   // vcmpord_qsd (1710)
+	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 937, 3<<4 | 15, argp_yoyomq},                   // vcmpord_qss (1711)
+	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 937, 3<<4 | 15, argp_yoyoyo},                   // vcmpord_qss (1712)
+	enc{[4]byte{0x1, 0xc2, 0x17, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, featset_AVX, 0<<11 | 938, 3<<4 | 15, argp_yhyhwh},      // vcmpord_spd (1713)
+	enc{[4]byte{0x1, 0xc2, 0x17, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX, 1<<11 | 938, 3<<4 | 15, argp_yoyowo},                  // vcmpord_spd (1714)
+	enc{[4]byte{0x1, 0xc2, 0x17, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 939, 3<<4 | 15, argp_y0y0w0},                // vcmpord_sps (1715)
+	enc{[4]byte{0x1, 0xc2, 0x17, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 940, 3<<4 | 15, argp_yoyomq},                  // vcmpord_ssd (1716)
+	enc{[4]byte{0x1, 0xc2, 0x17, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 940, 3<<4 | 15, argp_yoyoyo},                  // vcmpord_ssd (1717)
+	enc{[4]byte{0x1, 0xc2, 0x17, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 941, 3<<4 | 15, argp_yoyomq},                  // vcmpord_sss (1718)
+	enc{[4]byte{0x1, 0xc2, 0x17, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 941, 3<<4 | 15, argp_yoyoyo},                  // vcmpord_sss (1719)
+	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, featset_AVX, 0<<11 | 942, 3<<4 | 15, argp_yhyhwh},       // vcmpordpd (1720)
+	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX, 1<<11 | 942, 3<<4 | 15, argp_yoyowo},                   // vcmpordpd (1721)
+	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 943, 3<<4 | 15, argp_y0y0w0},                 // vcmpordps (1722)
+	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 944, 3<<4 | 15, argp_yoyomq},                   // vcmpordsd (1723)
+	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 944, 3<<4 | 15, argp_yoyoyo},                   // vcmpordsd (1724)
+	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 945, 3<<4 | 15, argp_yoyomq},                   // vcmpordss (1725)
+	enc{[4]byte{0x1, 0xc2, 0x7, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 945, 3<<4 | 15, argp_yoyoyo},                   // vcmpordss (1726)
+	enc{[4]byte{0x1, 0xc2, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, featset_AVX, 0<<11 | 946, 2<<4 | 15, argp_y0y0w0ib},    // vcmppd (1727)
+	enc{[4]byte{0x1, 0xc2, 0x00, 0x00}, VEX_OP | AUTO_VEXL | ENC_MR, featset_AVX, 0<<11 | 947, 2<<4 | 15, argp_y0y0w0ib},              // vcmpps (1728)
+	enc{[4]byte{0x1, 0xc2, 0x00, 0x00}, VEX_OP | PREF_F2, featset_AVX, 0<<11 | 948, 2<<4 | 15, argp_yoyomqib},                         // vcmpsd (1729)
+	enc{[4]byte{0x1, 0xc2, 0x00, 0x00}, VEX_OP | PREF_F2, featset_AVX, 1<<11 | 948, 2<<4 | 15, argp_yoyoyoib},                         // vcmpsd (1730)
+	enc{[4]byte{0x1, 0xc2, 0x00, 0x00}, VEX_OP | PREF_F3, featset_AVX, 0<<11 | 949, 2<<4 | 15, argp_yoyomqib},                         // vcmpss (1731)
+	enc{[4]byte{0x1, 0xc2, 0x00, 0x00}, VEX_OP | PREF_F3, featset_AVX, 1<<11 | 949, 2<<4 | 15, argp_yoyoyoib},                         // vcmpss (1732)
+	enc{[4]byte{0x1, 0xc2, 0xf, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, featset_AVX, 0<<11 | 950, 3<<4 | 15, argp_yhyhwh},       // vcmptrue_uqpd (1733)
+	enc{[4]byte{0x1, 0xc2, 0xf, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX, 1<<11 | 950, 3<<4 | 15, argp_yoyowo},                   // vcmptrue_uqpd (1734)
+	enc{[4]byte{0x1, 0xc2, 0xf, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 951, 3<<4 | 15, argp_y0y0w0},                 // vcmptrue_uqps (1735)
+	enc{[4]byte{0x1, 0xc2, 0xf, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 952, 3<<4 | 15, argp_yoyomq},                   // vcmptrue_uqsd (1736)
+	enc{[4]byte{0x1, 0xc2, 0xf, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 952, 3<<4 | 15, argp_yoyoyo},                   // vcmptrue_uqsd (1737)
+	enc{[4]byte{0x1, 0xc2, 0xf, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 953, 3<<4 | 15, argp_yoyomq},                   // vcmptrue_uqss (1738)
+	enc{[4]byte{0x1, 0xc2, 0xf, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 953, 3<<4 | 15, argp_yoyoyo},                   // vcmptrue_uqss (1739)
+	enc{[4]byte{0x1, 0xc2, 0x1f, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 954, 3<<4 | 15, argp_y0y0w0},      // vcmptrue_uspd (1740)
+	enc{[4]byte{0x1, 0xc2, 0x1f, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 955, 3<<4 | 15, argp_y0y0w0},                // vcmptrue_usps (1741)
+	enc{[4]byte{0x1, 0xc2, 0x1f, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 956, 3<<4 | 15, argp_yoyomq},                  // vcmptrue_ussd (1742)
+	enc{[4]byte{0x1, 0xc2, 0x1f, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 956, 3<<4 | 15, argp_yoyoyo},                  // vcmptrue_ussd (1743)
+	enc{[4]byte{0x1, 0xc2, 0x1f, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 957, 3<<4 | 15, argp_yoyomq},                  // vcmptrue_usss (1744)
+	enc{[4]byte{0x1, 0xc2, 0x1f, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 957, 3<<4 | 15, argp_yoyoyo},                  // vcmptrue_usss (1745)
+	enc{[4]byte{0x1, 0xc2, 0xf, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 958, 3<<4 | 15, argp_y0y0w0},       // vcmptruepd (1746)
+	enc{[4]byte{0x1, 0xc2, 0xf, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 959, 3<<4 | 15, argp_y0y0w0},                 // vcmptrueps (1747)
+	enc{[4]byte{0x1, 0xc2, 0xf, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 960, 3<<4 | 15, argp_yoyomq},                   // vcmptruesd (1748)
+	enc{[4]byte{0x1, 0xc2, 0xf, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 960, 3<<4 | 15, argp_yoyoyo},                   // vcmptruesd (1749)
+	enc{[4]byte{0x1, 0xc2, 0xf, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 961, 3<<4 | 15, argp_yoyomq},                   // vcmptruess (1750)
+	enc{[4]byte{0x1, 0xc2, 0xf, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 961, 3<<4 | 15, argp_yoyoyo},                   // vcmptruess (1751)
+	enc{[4]byte{0x1, 0xc2, 0x3, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 962, 3<<4 | 15, argp_y0y0w0},       // vcmpunord_qpd (1752)
+	enc{[4]byte{0x1, 0xc2, 0x3, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 963, 3<<4 | 15, argp_y0y0w0},                 // vcmpunord_qps (1753)
+	enc{[4]byte{0x1, 0xc2, 0x3, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 964, 3<<4 | 15, argp_yoyomq},                   // vcmpunord_qsd (1754)
+	enc{[4]byte{0x1, 0xc2, 0x3, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 964, 3<<4 | 15, argp_yoyoyo},                   // vcmpunord_qsd (1755)
+	enc{[4]byte{0x1, 0xc2, 0x3, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 965, 3<<4 | 15, argp_yoyomq},                   // vcmpunord_qss (1756)
+	enc{[4]byte{0x1, 0xc2, 0x3, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 965, 3<<4 | 15, argp_yoyoyo},                   // vcmpunord_qss (1757)
+	enc{[4]byte{0x1, 0xc2, 0x13, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 966, 3<<4 | 15, argp_y0y0w0},      // vcmpunord_spd (1758)
+	enc{[4]byte{0x1, 0xc2, 0x13, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 967, 3<<4 | 15, argp_y0y0w0},                // vcmpunord_sps (1759)
+	enc{[4]byte{0x1, 0xc2, 0x13, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 968, 3<<4 | 15, argp_yoyomq},                  // vcmpunord_ssd (1760)
+	enc{[4]byte{0x1, 0xc2, 0x13, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 968, 3<<4 | 15, argp_yoyoyo},                  // vcmpunord_ssd (1761)
+	enc{[4]byte{0x1, 0xc2, 0x13, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 969, 3<<4 | 15, argp_yoyomq},                  // vcmpunord_sss (1762)
+	enc{[4]byte{0x1, 0xc2, 0x13, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 969, 3<<4 | 15, argp_yoyoyo},                  // vcmpunord_sss (1763)
+	enc{[4]byte{0x1, 0xc2, 0x3, 0x00}, VEX_OP | IMM_OP | WITH_VEXL | PREF_66, featset_AVX, 0<<11 | 970, 3<<4 | 15, argp_yhyhwh},       // vcmpunordpd (1764)
+	enc{[4]byte{0x1, 0xc2, 0x3, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX, 1<<11 | 970, 3<<4 | 15, argp_yoyowo},                   // vcmpunordpd (1765)
+	enc{[4]byte{0x1, 0xc2, 0x3, 0x00}, VEX_OP | IMM_OP | AUTO_VEXL, featset_AVX, 0<<11 | 971, 3<<4 | 15, argp_y0y0w0},                 // vcmpunordps (1766)
+	enc{[4]byte{0x1, 0xc2, 0x3, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 0<<11 | 972, 3<<4 | 15, argp_yoyomq},                   // vcmpunordsd (1767)
+	enc{[4]byte{0x1, 0xc2, 0x3, 0x00}, VEX_OP | IMM_OP | PREF_F2, featset_AVX, 1<<11 | 972, 3<<4 | 15, argp_yoyoyo},                   // vcmpunordsd (1768)
+	enc{[4]byte{0x1, 0xc2, 0x3, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 0<<11 | 973, 3<<4 | 15, argp_yoyomq},                   // vcmpunordss (1769)
+	enc{[4]byte{0x1, 0xc2, 0x3, 0x00}, VEX_OP | IMM_OP | PREF_F3, featset_AVX, 1<<11 | 973, 3<<4 | 15, argp_yoyoyo},                   // vcmpunordss (1770)
+	enc{[4]byte{0x1, 0x2f, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 974, 2<<4 | 15, argp_yomq},                             // vcomisd (1771)
+	enc{[4]byte{0x1, 0x2f, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 1<<11 | 974, 2<<4 | 15, argp_yoyo},                             // vcomisd (1772)
+	enc{[4]byte{0x1, 0x2f, 0x00, 0x00}, VEX_OP, featset_AVX, 0<<11 | 975, 2<<4 | 15, argp_yomd},                                       // vcomiss (1773)
+	enc{[4]byte{0x1, 0x2f, 0x00, 0x00}, VEX_OP, featset_AVX, 1<<11 | 975, 2<<4 | 15, argp_yoyo},                                       // vcomiss (1774)
+	enc{[4]byte{0x1, 0xe6, 0x00, 0x00}, VEX_OP | PREF_F3, featset_AVX, 0<<11 | 976, 2<<4 | 15, argp_yomq},                             // vcvtdq2pd (1775)
+	enc{[4]byte{0x1, 0xe6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F3, featset_AVX, 1<<11 | 976, 2<<4 | 15, argp_y0wo},                 // vcvtdq2pd (1776)
+	enc{[4]byte{0x1, 0x5b, 0x00, 0x00}, VEX_OP | AUTO_VEXL, featset_AVX, 0<<11 | 977, 2<<4 | 15, argp_y0w0},                           // vcvtdq2ps (1777)
+	enc{[4]byte{0x1, 0xe6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F2, featset_AVX, 0<<11 | 978, 2<<4 | 15, argp_yom0},                 // vcvtpd2dq (1778)
+	enc{[4]byte{0x1, 0xe6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F2, featset_AVX, 1<<11 | 978, 2<<4 | 15, argp_yoy0},                 // vcvtpd2dq (1779)
+	enc{[4]byte{0x1, 0x5a, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 979, 2<<4 | 15, argp_yom0},                 // vcvtpd2ps (1780)
+	enc{[4]byte{0x1, 0x5a, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 1<<11 | 979, 2<<4 | 15, argp_yoy0},                 // vcvtpd2ps (1781)
+	enc{[4]byte{0x2, 0x13, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX_F16C, 0<<11 | 980, 2<<4 | 15, argp_yomq},                        // vcvtph2ps (1782)
+	enc{[4]byte{0x2, 0x13, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX_F16C, 1<<11 | 980, 2<<4 | 15, argp_y0wo},            // vcvtph2ps (1783)
+	enc{[4]byte{0x1, 0x5b, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 981, 2<<4 | 15, argp_y0w0},                 // vcvtps2dq (1784)
+	enc{[4]byte{0x1, 0x5a, 0x00, 0x00}, VEX_OP, featset_AVX, 0<<11 | 982, 2<<4 | 15, argp_yomq},                                       // vcvtps2pd (1785)
+	enc{[4]byte{0x1, 0x5a, 0x00, 0x00}, VEX_OP | AUTO_VEXL, featset_AVX, 1<<11 | 982, 2<<4 | 15, argp_y0wo},                           // vcvtps2pd (1786)
+	enc{[4]byte{0x3, 0x1d, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, featset_AVX_F16C, 0<<11 | 983, 2<<4 | 15, argp_mqyoib},             // vcvtps2ph (1787)
+	enc{[4]byte{0x3, 0x1d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, featset_AVX_F16C, 1<<11 | 983, 2<<4 | 15, argp_woy0ib}, // vcvtps2ph (1788)
+	enc{[4]byte{0x1, 0x2d, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F2, featset_AVX, 0<<11 | 984, 2<<4 | 15, argp_r0mq},                 // vcvtsd2si (1789)
+	enc{[4]byte{0x1, 0x2d, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F2, featset_AVX, 1<<11 | 984, 2<<4 | 15, argp_r0yo},                 // vcvtsd2si (1790)
+	enc{[4]byte{0x1, 0x5a, 0x00, 0x00}, VEX_OP | PREF_F2, featset_AVX, 0<<11 | 985, 2<<4 | 15, argp_yoyomq},                           // vcvtsd2ss (1791)
+	enc{[4]byte{0x1, 0x5a, 0x00, 0x00}, VEX_OP | PREF_F2, featset_AVX, 1<<11 | 985, 2<<4 | 15, argp_yoyoyo},                           // vcvtsd2ss (1792)
+	enc{[4]byte{0x1, 0x2a, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F2, featset_AVX, 0<<11 | 986, 2<<4 | 15, argp_yoyov0},               // vcvtsi2sd (1793)
+	enc{[4]byte{0x1, 0x2a, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F3, featset_AVX, 0<<11 | 987, 2<<4 | 15, argp_yoyov0},               // vcvtsi2ss (1794)
+	enc{[4]byte{0x1, 0x5a, 0x00, 0x00}, VEX_OP | PREF_F3, featset_AVX, 0<<11 | 988, 2<<4 | 15, argp_yoyomd},                           // vcvtss2sd (1795)
+	enc{[4]byte{0x1, 0x5a, 0x00, 0x00}, VEX_OP | PREF_F3, featset_AVX, 1<<11 | 988, 2<<4 | 15, argp_yoyoyo},                           // vcvtss2sd (1796)
+	enc{[4]byte{0x1, 0x2d, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F3, featset_AVX, 0<<11 | 989, 2<<4 | 15, argp_r0md},                 // vcvtss2si (1797)
+	enc{[4]byte{0x1, 0x2d, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F3, featset_AVX, 1<<11 | 989, 2<<4 | 15, argp_r0yo},                 // vcvtss2si (1798)
+	enc{[4]byte{0x1, 0xe6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 990, 2<<4 | 15, argp_yom0},                 // vcvttpd2dq (1799)
+	enc{[4]byte{0x1, 0xe6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 1<<11 | 990, 2<<4 | 15, argp_yoy0},                 // vcvttpd2dq (1800)
+	enc{[4]byte{0x1, 0x5b, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F3, featset_AVX, 0<<11 | 991, 2<<4 | 15, argp_y0w0},                 // vcvttps2dq (1801)
+	enc{[4]byte{0x1, 0x2c, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F2, featset_AVX, 0<<11 | 992, 2<<4 | 15, argp_r0mq},                 // vcvttsd2si (1802)
+	enc{[4]byte{0x1, 0x2c, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F2, featset_AVX, 1<<11 | 992, 2<<4 | 15, argp_r0yo},                 // vcvttsd2si (1803)
+	enc{[4]byte{0x1, 0x2c, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F3, featset_AVX, 0<<11 | 993, 2<<4 | 15, argp_r0md},                 // vcvttss2si (1804)
+	enc{[4]byte{0x1, 0x2c, 0x00, 0x00}, VEX_OP | AUTO_REXW | PREF_F3, featset_AVX, 1<<11 | 993, 2<<4 | 15, argp_r0yo},                 // vcvttss2si (1805)
+	enc{[4]byte{0x1, 0x5e, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 994, 2<<4 | 15, argp_y0y0w0},               // vdivpd (1806)
+	enc{[4]byte{0x1, 0x5e, 0x00, 0x00}, VEX_OP | AUTO_VEXL, featset_AVX, 0<<11 | 995, 2<<4 | 15, argp_y0y0w0},                         // vdivps (1807)
+	enc{[4]byte{0x1, 0x5e, 0x00, 0x00}, VEX_OP | PREF_F2, featset_AVX, 0<<11 | 996, 2<<4 | 15, argp_yoyomq},                           // vdivsd (1808)
+	enc{[4]byte{0x1, 0x5e, 0x00, 0x00}, VEX_OP | PREF_F2, featset_AVX, 1<<11 | 996, 2<<4 | 15, argp_yoyoyo},                           // vdivsd (1809)
+	enc{[4]byte{0x1, 0x5e, 0x00, 0x00}, VEX_OP | PREF_F3, featset_AVX, 0<<11 | 997, 2<<4 | 15, argp_yoyomd},                           // vdivss (1810)
+	enc{[4]byte{0x1, 0x5e, 0x00, 0x00}, VEX_OP | PREF_F3, featset_AVX, 1<<11 | 997, 2<<4 | 15, argp_yoyoyo},                           // vdivss (1811)
+	enc{[4]byte{0x3, 0x41, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 998, 2<<4 | 15, argp_yoyowoib},                         // vdppd (1812)
+	enc{[4]byte{0x3, 0x40, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, featset_AVX, 0<<11 | 999, 2<<4 | 15, argp_y0y0w0ib},    // vdpps (1813)
+	enc{[4]byte{0xf, 0x0, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 1000, 2<<4 | 4, argp_m1},                                      // verr (1814)
+	enc{[4]byte{0xf, 0x0, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 1000, 2<<4 | 4, argp_rw},                                      // verr (1815)
+	enc{[4]byte{0xf, 0x0, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 0<<11 | 1001, 2<<4 | 5, argp_m1},                                      // verw (1816)
+	enc{[4]byte{0xf, 0x0, 0x00, 0x00}, 0, featset_X64_IMPLICIT, 1<<11 | 1001, 2<<4 | 5, argp_rw},                                      // verw (1817)
+	enc{[4]byte{0x3, 0x19, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66 | ENC_MR, featset_AVX, 0<<11 | 1002, 2<<4 | 15, argp_woyhib},     // vextractf128 (1818)
+	enc{[4]byte{0x3, 0x39, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66 | ENC_MR, featset_AVX2, 0<<11 | 1003, 2<<4 | 15, argp_woyhib},    // vextracti128 (1819)
+	enc{[4]byte{0x3, 0x17, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, featset_AVX, 0<<11 | 1004, 2<<4 | 15, argp_vdyoib},                 // vextractps (1820)
+	enc{[4]byte{0x2, 0xa8, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1005, 2<<4 | 15, argp_y0y0w0},              // vfmadd123pd (1821)
+	enc{[4]byte{0x2, 0xa8, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1006, 2<<4 | 15, argp_y0y0w0},              // vfmadd123ps (1822)
+	enc{[4]byte{0x2, 0xa9, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 0<<11 | 1007, 2<<4 | 15, argp_yoyomq},              // vfmadd123sd (1823)
+	enc{[4]byte{0x2, 0xa9, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 1<<11 | 1007, 2<<4 | 15, argp_yoyoyo},              // vfmadd123sd (1824)
+	enc{[4]byte{0x2, 0xa9, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 0<<11 | 1008, 2<<4 | 15, argp_yoyomd},                          // vfmadd123ss (1825)
+	enc{[4]byte{0x2, 0xa9, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 1<<11 | 1008, 2<<4 | 15, argp_yoyoyo},                          // vfmadd123ss (1826)
+	enc{[4]byte{0x2, 0x98, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1009, 2<<4 | 15, argp_y0y0w0},              // vfmadd132pd (1827)
+	enc{[4]byte{0x2, 0x98, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1010, 2<<4 | 15, argp_y0y0w0},              // vfmadd132ps (1828)
+	enc{[4]byte{0x2, 0x99, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 0<<11 | 1011, 2<<4 | 15, argp_yoyomq},              // vfmadd132sd (1829)
+	enc{[4]byte{0x2, 0x99, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 1<<11 | 1011, 2<<4 | 15, argp_yoyoyo},              // vfmadd132sd (1830)
+	enc{[4]byte{0x2, 0x99, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 0<<11 | 1012, 2<<4 | 15, argp_yoyomd},                          // vfmadd132ss (1831)
+	enc{[4]byte{0x2, 0x99, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 1<<11 | 1012, 2<<4 | 15, argp_yoyoyo},                          // vfmadd132ss (1832)
+	enc{[4]byte{0x2, 0xa8, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1013, 2<<4 | 15, argp_y0y0w0},              // vfmadd213pd (1833)
+	enc{[4]byte{0x2, 0xa8, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1014, 2<<4 | 15, argp_y0y0w0},              // vfmadd213ps (1834)
+	enc{[4]byte{0x2, 0xa9, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 0<<11 | 1015, 2<<4 | 15, argp_yoyomq},              // vfmadd213sd (1835)
+	enc{[4]byte{0x2, 0xa9, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 1<<11 | 1015, 2<<4 | 15, argp_yoyoyo},              // vfmadd213sd (1836)
+	enc{[4]byte{0x2, 0xa9, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 0<<11 | 1016, 2<<4 | 15, argp_yoyomd},                          // vfmadd213ss (1837)
+	enc{[4]byte{0x2, 0xa9, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 1<<11 | 1016, 2<<4 | 15, argp_yoyoyo},                          // vfmadd213ss (1838)
+	enc{[4]byte{0x2, 0xb8, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1017, 2<<4 | 15, argp_y0y0w0},              // vfmadd231pd (1839)
+	enc{[4]byte{0x2, 0xb8, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1018, 2<<4 | 15, argp_y0y0w0},              // vfmadd231ps (1840)
+	enc{[4]byte{0x2, 0xb9, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 0<<11 | 1019, 2<<4 | 15, argp_yoyomq},              // vfmadd231sd (1841)
+	enc{[4]byte{0x2, 0xb9, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 1<<11 | 1019, 2<<4 | 15, argp_yoyoyo},              // vfmadd231sd (1842)
+	enc{[4]byte{0x2, 0xb9, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 0<<11 | 1020, 2<<4 | 15, argp_yoyomd},                          // vfmadd231ss (1843)
+	enc{[4]byte{0x2, 0xb9, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 1<<11 | 1020, 2<<4 | 15, argp_yoyoyo},                          // vfmadd231ss (1844)
+	enc{[4]byte{0x2, 0x98, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1021, 2<<4 | 15, argp_y0y0w0},              // vfmadd312pd (1845)
+	enc{[4]byte{0x2, 0x98, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1022, 2<<4 | 15, argp_y0y0w0},              // vfmadd312ps (1846)
+	enc{[4]byte{0x2, 0x99, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 0<<11 | 1023, 2<<4 | 15, argp_yoyomq},              // vfmadd312sd (1847)
+	enc{[4]byte{0x2, 0x99, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 1<<11 | 1023, 2<<4 | 15, argp_yoyoyo},              // vfmadd312sd (1848)
+	enc{[4]byte{0x2, 0x99, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 0<<11 | 1024, 2<<4 | 15, argp_yoyomd},                          // vfmadd312ss (1849)
+	enc{[4]byte{0x2, 0x99, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 1<<11 | 1024, 2<<4 | 15, argp_yoyoyo},                          // vfmadd312ss (1850)
+	enc{[4]byte{0x2, 0xb8, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1025, 2<<4 | 15, argp_y0y0w0},              // vfmadd321pd (1851)
+	enc{[4]byte{0x2, 0xb8, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1026, 2<<4 | 15, argp_y0y0w0},              // vfmadd321ps (1852)
+	enc{[4]byte{0x2, 0xb9, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 0<<11 | 1027, 2<<4 | 15, argp_yoyomq},              // vfmadd321sd (1853)
+	enc{[4]byte{0x2, 0xb9, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 1<<11 | 1027, 2<<4 | 15, argp_yoyoyo},              // vfmadd321sd (1854)
+	enc{[4]byte{0x2, 0xb9, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 0<<11 | 1028, 2<<4 | 15, argp_yoyomd},                          // vfmadd321ss (1855)
+	enc{[4]byte{0x2, 0xb9, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 1<<11 | 1028, 2<<4 | 15, argp_yoyoyo},                          // vfmadd321ss (1856)
+	enc{[4]byte{0x3, 0x69, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_SSE5_AMD, 0<<11 | 1029, 2<<4 | 15, argp_y0y0y0w0},       // vfmaddpd (1857)
+	enc{[4]byte{0x3, 0x69, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_SSE5_AMD, 1<<11 | 1029, 2<<4 | 15, argp_y0y0w0y0},       // vfmaddpd (1858)
+	enc{[4]byte{0x3, 0x68, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_SSE5_AMD, 0<<11 | 1030, 2<<4 | 15, argp_y0y0y0w0},       // vfmaddps (1859)
+	enc{[4]byte{0x3, 0x68, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_SSE5_AMD, 1<<11 | 1030, 2<<4 | 15, argp_y0y0w0y0},       // vfmaddps (1860)
+	enc{[4]byte{0x3, 0x6b, 0x00, 0x00}, VEX_OP | PREF_66, featset_SSE5_AMD, 0<<11 | 1031, 2<<4 | 15, argp_yoyomqyo},                   // vfmaddsd (1861)
+	enc{[4]byte{0x3, 0x6b, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_SSE5_AMD, 1<<11 | 1031, 2<<4 | 15, argp_yoyoyomq},       // vfmaddsd (1862)
+	enc{[4]byte{0x3, 0x6b, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_SSE5_AMD, 2<<11 | 1031, 2<<4 | 15, argp_yoyoyoyo},       // vfmaddsd (1863)
+	enc{[4]byte{0x3, 0x6a, 0x00, 0x00}, VEX_OP | PREF_66, featset_SSE5_AMD, 0<<11 | 1032, 2<<4 | 15, argp_yoyomdyo},                   // vfmaddss (1864)
+	enc{[4]byte{0x3, 0x6a, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_SSE5_AMD, 1<<11 | 1032, 2<<4 | 15, argp_yoyoyomd},       // vfmaddss (1865)
+	enc{[4]byte{0x3, 0x6a, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_SSE5_AMD, 2<<11 | 1032, 2<<4 | 15, argp_yoyoyoyo},       // vfmaddss (1866)
+	enc{[4]byte{0x2, 0xa6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1033, 2<<4 | 15, argp_y0y0w0},              // vfmaddsub123pd (1867)
+	enc{[4]byte{0x2, 0xa6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1034, 2<<4 | 15, argp_y0y0w0},              // vfmaddsub123ps (1868)
+	enc{[4]byte{0x2, 0x96, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1035, 2<<4 | 15, argp_y0y0w0},              // vfmaddsub132pd (1869)
+	enc{[4]byte{0x2, 0x96, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1036, 2<<4 | 15, argp_y0y0w0},              // vfmaddsub132ps (1870)
+	enc{[4]byte{0x2, 0xa6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1037, 2<<4 | 15, argp_y0y0w0},              // vfmaddsub213pd (1871)
+	enc{[4]byte{0x2, 0xa6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1038, 2<<4 | 15, argp_y0y0w0},              // vfmaddsub213ps (1872)
+	enc{[4]byte{0x2, 0xb6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1039, 2<<4 | 15, argp_y0y0w0},              // vfmaddsub231pd (1873)
+	enc{[4]byte{0x2, 0xb6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1040, 2<<4 | 15, argp_y0y0w0},              // vfmaddsub231ps (1874)
+	enc{[4]byte{0x2, 0x96, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1041, 2<<4 | 15, argp_y0y0w0},              // vfmaddsub312pd (1875)
+	enc{[4]byte{0x2, 0x96, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1042, 2<<4 | 15, argp_y0y0w0},              // vfmaddsub312ps (1876)
+	enc{[4]byte{0x2, 0xb6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1043, 2<<4 | 15, argp_y0y0w0},              // vfmaddsub321pd (1877)
+	enc{[4]byte{0x2, 0xb6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1044, 2<<4 | 15, argp_y0y0w0},              // vfmaddsub321ps (1878)
+	enc{[4]byte{0x3, 0x5d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_SSE5_AMD, 0<<11 | 1045, 2<<4 | 15, argp_y0y0y0w0},       // vfmaddsubpd (1879)
+	enc{[4]byte{0x3, 0x5d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_SSE5_AMD, 1<<11 | 1045, 2<<4 | 15, argp_y0y0w0y0},       // vfmaddsubpd (1880)
+	enc{[4]byte{0x3, 0x5c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_SSE5_AMD, 0<<11 | 1046, 2<<4 | 15, argp_y0y0y0w0},       // vfmaddsubps (1881)
+	enc{[4]byte{0x3, 0x5c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_SSE5_AMD, 1<<11 | 1046, 2<<4 | 15, argp_y0y0w0y0},       // vfmaddsubps (1882)
+	enc{[4]byte{0x2, 0xaa, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1047, 2<<4 | 15, argp_y0y0w0},              // vfmsub123pd (1883)
+	enc{[4]byte{0x2, 0xaa, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1048, 2<<4 | 15, argp_y0y0w0},              // vfmsub123ps (1884)
+	enc{[4]byte{0x2, 0xab, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 0<<11 | 1049, 2<<4 | 15, argp_yoyomq},              // vfmsub123sd (1885)
+	enc{[4]byte{0x2, 0xab, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 1<<11 | 1049, 2<<4 | 15, argp_yoyoyo},              // vfmsub123sd (1886)
+	enc{[4]byte{0x2, 0xab, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 0<<11 | 1050, 2<<4 | 15, argp_yoyomd},                          // vfmsub123ss (1887)
+	enc{[4]byte{0x2, 0xab, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 1<<11 | 1050, 2<<4 | 15, argp_yoyoyo},                          // vfmsub123ss (1888)
+	enc{[4]byte{0x2, 0x9a, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1051, 2<<4 | 15, argp_y0y0w0},              // vfmsub132pd (1889)
+	enc{[4]byte{0x2, 0x9a, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1052, 2<<4 | 15, argp_y0y0w0},              // vfmsub132ps (1890)
+	enc{[4]byte{0x2, 0x9b, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 0<<11 | 1053, 2<<4 | 15, argp_yoyomq},              // vfmsub132sd (1891)
+	enc{[4]byte{0x2, 0x9b, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 1<<11 | 1053, 2<<4 | 15, argp_yoyoyo},              // vfmsub132sd (1892)
+	enc{[4]byte{0x2, 0x9b, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 0<<11 | 1054, 2<<4 | 15, argp_yoyomd},                          // vfmsub132ss (1893)
+	enc{[4]byte{0x2, 0x9b, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 1<<11 | 1054, 2<<4 | 15, argp_yoyoyo},                          // vfmsub132ss (1894)
+	enc{[4]byte{0x2, 0xaa, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1055, 2<<4 | 15, argp_y0y0w0},              // vfmsub213pd (1895)
+	enc{[4]byte{0x2, 0xaa, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1056, 2<<4 | 15, argp_y0y0w0},              // vfmsub213ps (1896)
+	enc{[4]byte{0x2, 0xab, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 0<<11 | 1057, 2<<4 | 15, argp_yoyomq},              // vfmsub213sd (1897)
+	enc{[4]byte{0x2, 0xab, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 1<<11 | 1057, 2<<4 | 15, argp_yoyoyo},              // vfmsub213sd (1898)
+	enc{[4]byte{0x2, 0xab, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 0<<11 | 1058, 2<<4 | 15, argp_yoyomd},                          // vfmsub213ss (1899)
+	enc{[4]byte{0x2, 0xab, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 1<<11 | 1058, 2<<4 | 15, argp_yoyoyo},                          // vfmsub213ss (1900)
+	enc{[4]byte{0x2, 0xba, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1059, 2<<4 | 15, argp_y0y0w0},              // vfmsub231pd (1901)
+	enc{[4]byte{0x2, 0xba, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1060, 2<<4 | 15, argp_y0y0w0},              // vfmsub231ps (1902)
+	enc{[4]byte{0x2, 0xbb, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 0<<11 | 1061, 2<<4 | 15, argp_yoyomq},              // vfmsub231sd (1903)
+	enc{[4]byte{0x2, 0xbb, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 1<<11 | 1061, 2<<4 | 15, argp_yoyoyo},              // vfmsub231sd (1904)
+	enc{[4]byte{0x2, 0xbb, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 0<<11 | 1062, 2<<4 | 15, argp_yoyomd},                          // vfmsub231ss (1905)
+	enc{[4]byte{0x2, 0xbb, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 1<<11 | 1062, 2<<4 | 15, argp_yoyoyo},                          // vfmsub231ss (1906)
+	enc{[4]byte{0x2, 0x9a, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1063, 2<<4 | 15, argp_y0y0w0},              // vfmsub312pd (1907)
+	enc{[4]byte{0x2, 0x9a, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1064, 2<<4 | 15, argp_y0y0w0},              // vfmsub312ps (1908)
+	enc{[4]byte{0x2, 0x9b, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 0<<11 | 1065, 2<<4 | 15, argp_yoyomq},              // vfmsub312sd (1909)
+	enc{[4]byte{0x2, 0x9b, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 1<<11 | 1065, 2<<4 | 15, argp_yoyoyo},              // vfmsub312sd (1910)
+	enc{[4]byte{0x2, 0x9b, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 0<<11 | 1066, 2<<4 | 15, argp_yoyomd},                          // vfmsub312ss (1911)
+	enc{[4]byte{0x2, 0x9b, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 1<<11 | 1066, 2<<4 | 15, argp_yoyoyo},                          // vfmsub312ss (1912)
+	enc{[4]byte{0x2, 0xba, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1067, 2<<4 | 15, argp_y0y0w0},              // vfmsub321pd (1913)
+	enc{[4]byte{0x2, 0xba, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1068, 2<<4 | 15, argp_y0y0w0},              // vfmsub321ps (1914)
+	enc{[4]byte{0x2, 0xbb, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 0<<11 | 1069, 2<<4 | 15, argp_yoyomq},              // vfmsub321sd (1915)
+	enc{[4]byte{0x2, 0xbb, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 1<<11 | 1069, 2<<4 | 15, argp_yoyoyo},              // vfmsub321sd (1916)
+	enc{[4]byte{0x2, 0xbb, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 0<<11 | 1070, 2<<4 | 15, argp_yoyomd},                          // vfmsub321ss (1917)
+	enc{[4]byte{0x2, 0xbb, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 1<<11 | 1070, 2<<4 | 15, argp_yoyoyo},                          // vfmsub321ss (1918)
+	enc{[4]byte{0x2, 0xa7, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1071, 2<<4 | 15, argp_y0y0w0},              // vfmsubadd123pd (1919)
+	enc{[4]byte{0x2, 0xa7, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1072, 2<<4 | 15, argp_y0y0w0},              // vfmsubadd123ps (1920)
+	enc{[4]byte{0x2, 0x97, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1073, 2<<4 | 15, argp_y0y0w0},              // vfmsubadd132pd (1921)
+	enc{[4]byte{0x2, 0x97, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1074, 2<<4 | 15, argp_y0y0w0},              // vfmsubadd132ps (1922)
+	enc{[4]byte{0x2, 0xa7, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1075, 2<<4 | 15, argp_y0y0w0},              // vfmsubadd213pd (1923)
+	enc{[4]byte{0x2, 0xa7, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1076, 2<<4 | 15, argp_y0y0w0},              // vfmsubadd213ps (1924)
+	enc{[4]byte{0x2, 0xb7, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1077, 2<<4 | 15, argp_y0y0w0},              // vfmsubadd231pd (1925)
+	enc{[4]byte{0x2, 0xb7, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1078, 2<<4 | 15, argp_y0y0w0},              // vfmsubadd231ps (1926)
+	enc{[4]byte{0x2, 0x97, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1079, 2<<4 | 15, argp_y0y0w0},              // vfmsubadd312pd (1927)
+	enc{[4]byte{0x2, 0x97, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1080, 2<<4 | 15, argp_y0y0w0},              // vfmsubadd312ps (1928)
+	enc{[4]byte{0x2, 0xb7, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1081, 2<<4 | 15, argp_y0y0w0},              // vfmsubadd321pd (1929)
+	enc{[4]byte{0x2, 0xb7, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1082, 2<<4 | 15, argp_y0y0w0},              // vfmsubadd321ps (1930)
+	enc{[4]byte{0x3, 0x5f, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_SSE5_AMD, 0<<11 | 1083, 2<<4 | 15, argp_y0y0y0w0},       // vfmsubaddpd (1931)
+	enc{[4]byte{0x3, 0x5f, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_SSE5_AMD, 1<<11 | 1083, 2<<4 | 15, argp_y0y0w0y0},       // vfmsubaddpd (1932)
+	enc{[4]byte{0x3, 0x5e, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_SSE5_AMD, 0<<11 | 1084, 2<<4 | 15, argp_y0y0y0w0},       // vfmsubaddps (1933)
+	enc{[4]byte{0x3, 0x5e, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_SSE5_AMD, 1<<11 | 1084, 2<<4 | 15, argp_y0y0w0y0},       // vfmsubaddps (1934)
+	enc{[4]byte{0x3, 0x6d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_SSE5_AMD, 0<<11 | 1085, 2<<4 | 15, argp_y0y0y0w0},       // vfmsubpd (1935)
+	enc{[4]byte{0x3, 0x6d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_SSE5_AMD, 1<<11 | 1085, 2<<4 | 15, argp_y0y0w0y0},       // vfmsubpd (1936)
+	enc{[4]byte{0x3, 0x6c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_SSE5_AMD, 0<<11 | 1086, 2<<4 | 15, argp_y0y0y0w0},       // vfmsubps (1937)
+	enc{[4]byte{0x3, 0x6c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_SSE5_AMD, 1<<11 | 1086, 2<<4 | 15, argp_y0y0w0y0},       // vfmsubps (1938)
+	enc{[4]byte{0x3, 0x6f, 0x00, 0x00}, VEX_OP | PREF_66, featset_SSE5_AMD, 0<<11 | 1087, 2<<4 | 15, argp_yoyomqyo},                   // vfmsubsd (1939)
+	enc{[4]byte{0x3, 0x6f, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_SSE5_AMD, 1<<11 | 1087, 2<<4 | 15, argp_yoyoyomq},       // vfmsubsd (1940)
+	enc{[4]byte{0x3, 0x6f, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_SSE5_AMD, 2<<11 | 1087, 2<<4 | 15, argp_yoyoyoyo},       // vfmsubsd (1941)
+	enc{[4]byte{0x3, 0x6e, 0x00, 0x00}, VEX_OP | PREF_66, featset_SSE5_AMD, 0<<11 | 1088, 2<<4 | 15, argp_yoyomdyo},                   // vfmsubss (1942)
+	enc{[4]byte{0x3, 0x6e, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_SSE5_AMD, 1<<11 | 1088, 2<<4 | 15, argp_yoyoyomd},       // vfmsubss (1943)
+	enc{[4]byte{0x3, 0x6e, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_SSE5_AMD, 2<<11 | 1088, 2<<4 | 15, argp_yoyoyoyo},       // vfmsubss (1944)
+	enc{[4]byte{0x2, 0xac, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1089, 2<<4 | 15, argp_y0y0w0},              // vfnmadd123pd (1945)
+	enc{[4]byte{0x2, 0xac, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1090, 2<<4 | 15, argp_y0y0w0},              // vfnmadd123ps (1946)
+	enc{[4]byte{0x2, 0xad, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 0<<11 | 1091, 2<<4 | 15, argp_yoyomq},              // vfnmadd123sd (1947)
+	enc{[4]byte{0x2, 0xad, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 1<<11 | 1091, 2<<4 | 15, argp_yoyoyo},              // vfnmadd123sd (1948)
+	enc{[4]byte{0x2, 0xad, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 0<<11 | 1092, 2<<4 | 15, argp_yoyomd},                          // vfnmadd123ss (1949)
+	enc{[4]byte{0x2, 0xad, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 1<<11 | 1092, 2<<4 | 15, argp_yoyoyo},                          // vfnmadd123ss (1950)
+	enc{[4]byte{0x2, 0x9c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1093, 2<<4 | 15, argp_y0y0w0},              // vfnmadd132pd (1951)
+	enc{[4]byte{0x2, 0x9c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1094, 2<<4 | 15, argp_y0y0w0},              // vfnmadd132ps (1952)
+	enc{[4]byte{0x2, 0x9d, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 0<<11 | 1095, 2<<4 | 15, argp_yoyomq},              // vfnmadd132sd (1953)
+	enc{[4]byte{0x2, 0x9d, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 1<<11 | 1095, 2<<4 | 15, argp_yoyoyo},              // vfnmadd132sd (1954)
+	enc{[4]byte{0x2, 0x9d, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 0<<11 | 1096, 2<<4 | 15, argp_yoyomd},                          // vfnmadd132ss (1955)
+	enc{[4]byte{0x2, 0x9d, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 1<<11 | 1096, 2<<4 | 15, argp_yoyoyo},                          // vfnmadd132ss (1956)
+	enc{[4]byte{0x2, 0xac, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1097, 2<<4 | 15, argp_y0y0w0},              // vfnmadd213pd (1957)
+	enc{[4]byte{0x2, 0xac, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1098, 2<<4 | 15, argp_y0y0w0},              // vfnmadd213ps (1958)
+	enc{[4]byte{0x2, 0xad, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 0<<11 | 1099, 2<<4 | 15, argp_yoyomq},              // vfnmadd213sd (1959)
+	enc{[4]byte{0x2, 0xad, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 1<<11 | 1099, 2<<4 | 15, argp_yoyoyo},              // vfnmadd213sd (1960)
+	enc{[4]byte{0x2, 0xad, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 0<<11 | 1100, 2<<4 | 15, argp_yoyomd},                          // vfnmadd213ss (1961)
+	enc{[4]byte{0x2, 0xad, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 1<<11 | 1100, 2<<4 | 15, argp_yoyoyo},                          // vfnmadd213ss (1962)
+	enc{[4]byte{0x2, 0xbc, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1101, 2<<4 | 15, argp_y0y0w0},              // vfnmadd231pd (1963)
+	enc{[4]byte{0x2, 0xbc, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1102, 2<<4 | 15, argp_y0y0w0},              // vfnmadd231ps (1964)
+	enc{[4]byte{0x2, 0xbd, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 0<<11 | 1103, 2<<4 | 15, argp_yoyomq},              // vfnmadd231sd (1965)
+	enc{[4]byte{0x2, 0xbd, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 1<<11 | 1103, 2<<4 | 15, argp_yoyoyo},              // vfnmadd231sd (1966)
+	enc{[4]byte{0x2, 0xbd, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 0<<11 | 1104, 2<<4 | 15, argp_yoyomd},                          // vfnmadd231ss (1967)
+	enc{[4]byte{0x2, 0xbd, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 1<<11 | 1104, 2<<4 | 15, argp_yoyoyo},                          // vfnmadd231ss (1968)
+	enc{[4]byte{0x2, 0x9c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1105, 2<<4 | 15, argp_y0y0w0},              // vfnmadd312pd (1969)
+	enc{[4]byte{0x2, 0x9c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1106, 2<<4 | 15, argp_y0y0w0},              // vfnmadd312ps (1970)
+	enc{[4]byte{0x2, 0x9d, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 0<<11 | 1107, 2<<4 | 15, argp_yoyomq},              // vfnmadd312sd (1971)
+	enc{[4]byte{0x2, 0x9d, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 1<<11 | 1107, 2<<4 | 15, argp_yoyoyo},              // vfnmadd312sd (1972)
+	enc{[4]byte{0x2, 0x9d, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 0<<11 | 1108, 2<<4 | 15, argp_yoyomd},                          // vfnmadd312ss (1973)
+	enc{[4]byte{0x2, 0x9d, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 1<<11 | 1108, 2<<4 | 15, argp_yoyoyo},                          // vfnmadd312ss (1974)
+	enc{[4]byte{0x2, 0xbc, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1109, 2<<4 | 15, argp_y0y0w0},              // vfnmadd321pd (1975)
+	enc{[4]byte{0x2, 0xbc, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1110, 2<<4 | 15, argp_y0y0w0},              // vfnmadd321ps (1976)
+	enc{[4]byte{0x2, 0xbd, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 0<<11 | 1111, 2<<4 | 15, argp_yoyomq},              // vfnmadd321sd (1977)
+	enc{[4]byte{0x2, 0xbd, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 1<<11 | 1111, 2<<4 | 15, argp_yoyoyo},              // vfnmadd321sd (1978)
+	enc{[4]byte{0x2, 0xbd, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 0<<11 | 1112, 2<<4 | 15, argp_yoyomd},                          // vfnmadd321ss (1979)
+	enc{[4]byte{0x2, 0xbd, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 1<<11 | 1112, 2<<4 | 15, argp_yoyoyo},                          // vfnmadd321ss (1980)
+	enc{[4]byte{0x3, 0x79, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_SSE5_AMD, 0<<11 | 1113, 2<<4 | 15, argp_y0y0y0w0},       // vfnmaddpd (1981)
+	enc{[4]byte{0x3, 0x79, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_SSE5_AMD, 1<<11 | 1113, 2<<4 | 15, argp_y0y0w0y0},       // vfnmaddpd (1982)
+	enc{[4]byte{0x3, 0x78, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_SSE5_AMD, 0<<11 | 1114, 2<<4 | 15, argp_y0y0y0w0},       // vfnmaddps (1983)
+	enc{[4]byte{0x3, 0x78, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_SSE5_AMD, 1<<11 | 1114, 2<<4 | 15, argp_y0y0w0y0},       // vfnmaddps (1984)
+	enc{[4]byte{0x3, 0x7b, 0x00, 0x00}, VEX_OP | PREF_66, featset_SSE5_AMD, 0<<11 | 1115, 2<<4 | 15, argp_yoyomqyo},                   // vfnmaddsd (1985)
+	enc{[4]byte{0x3, 0x7b, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_SSE5_AMD, 1<<11 | 1115, 2<<4 | 15, argp_yoyoyomq},       // vfnmaddsd (1986)
+	enc{[4]byte{0x3, 0x7b, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_SSE5_AMD, 2<<11 | 1115, 2<<4 | 15, argp_yoyoyoyo},       // vfnmaddsd (1987)
+	enc{[4]byte{0x3, 0x7a, 0x00, 0x00}, VEX_OP | PREF_66, featset_SSE5_AMD, 0<<11 | 1116, 2<<4 | 15, argp_yoyomdyo},                   // vfnmaddss (1988)
+	enc{[4]byte{0x3, 0x7a, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_SSE5_AMD, 1<<11 | 1116, 2<<4 | 15, argp_yoyoyomd},       // vfnmaddss (1989)
+	enc{[4]byte{0x3, 0x7a, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_SSE5_AMD, 2<<11 | 1116, 2<<4 | 15, argp_yoyoyoyo},       // vfnmaddss (1990)
+	enc{[4]byte{0x2, 0xae, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1117, 2<<4 | 15, argp_y0y0w0},              // vfnmsub123pd (1991)
+	enc{[4]byte{0x2, 0xae, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1118, 2<<4 | 15, argp_y0y0w0},              // vfnmsub123ps (1992)
+	enc{[4]byte{0x2, 0xaf, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 0<<11 | 1119, 2<<4 | 15, argp_yoyomq},              // vfnmsub123sd (1993)
+	enc{[4]byte{0x2, 0xaf, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 1<<11 | 1119, 2<<4 | 15, argp_yoyoyo},              // vfnmsub123sd (1994)
+	enc{[4]byte{0x2, 0xaf, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 0<<11 | 1120, 2<<4 | 15, argp_yoyomd},                          // vfnmsub123ss (1995)
+	enc{[4]byte{0x2, 0xaf, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 1<<11 | 1120, 2<<4 | 15, argp_yoyoyo},                          // vfnmsub123ss (1996)
+	enc{[4]byte{0x2, 0x9e, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1121, 2<<4 | 15, argp_y0y0w0},              // vfnmsub132pd (1997)
+	enc{[4]byte{0x2, 0x9e, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1122, 2<<4 | 15, argp_y0y0w0},              // vfnmsub132ps (1998)
+	enc{[4]byte{0x2, 0x9f, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 0<<11 | 1123, 2<<4 | 15, argp_yoyomq},              // vfnmsub132sd (1999)
+	enc{[4]byte{0x2, 0x9f, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 1<<11 | 1123, 2<<4 | 15, argp_yoyoyo},              // vfnmsub132sd (2000)
+	enc{[4]byte{0x2, 0x9f, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 0<<11 | 1124, 2<<4 | 15, argp_yoyomd},                          // vfnmsub132ss (2001)
+	enc{[4]byte{0x2, 0x9f, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 1<<11 | 1124, 2<<4 | 15, argp_yoyoyo},                          // vfnmsub132ss (2002)
+	enc{[4]byte{0x2, 0xae, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1125, 2<<4 | 15, argp_y0y0w0},              // vfnmsub213pd (2003)
+	enc{[4]byte{0x2, 0xae, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1126, 2<<4 | 15, argp_y0y0w0},              // vfnmsub213ps (2004)
+	enc{[4]byte{0x2, 0xaf, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 0<<11 | 1127, 2<<4 | 15, argp_yoyomq},              // vfnmsub213sd (2005)
+	enc{[4]byte{0x2, 0xaf, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 1<<11 | 1127, 2<<4 | 15, argp_yoyoyo},              // vfnmsub213sd (2006)
+	enc{[4]byte{0x2, 0xaf, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 0<<11 | 1128, 2<<4 | 15, argp_yoyomd},                          // vfnmsub213ss (2007)
+	enc{[4]byte{0x2, 0xaf, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 1<<11 | 1128, 2<<4 | 15, argp_yoyoyo},                          // vfnmsub213ss (2008)
+	enc{[4]byte{0x2, 0xbe, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1129, 2<<4 | 15, argp_y0y0w0},              // vfnmsub231pd (2009)
+	enc{[4]byte{0x2, 0xbe, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1130, 2<<4 | 15, argp_y0y0w0},              // vfnmsub231ps (2010)
+	enc{[4]byte{0x2, 0xbf, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 0<<11 | 1131, 2<<4 | 15, argp_yoyomq},              // vfnmsub231sd (2011)
+	enc{[4]byte{0x2, 0xbf, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 1<<11 | 1131, 2<<4 | 15, argp_yoyoyo},              // vfnmsub231sd (2012)
+	enc{[4]byte{0x2, 0xbf, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 0<<11 | 1132, 2<<4 | 15, argp_yoyomd},                          // vfnmsub231ss (2013)
+	enc{[4]byte{0x2, 0xbf, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 1<<11 | 1132, 2<<4 | 15, argp_yoyoyo},                          // vfnmsub231ss (2014)
+	enc{[4]byte{0x2, 0x9e, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1133, 2<<4 | 15, argp_y0y0w0},              // vfnmsub312pd (2015)
+	enc{[4]byte{0x2, 0x9e, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1134, 2<<4 | 15, argp_y0y0w0},              // vfnmsub312ps (2016)
+	enc{[4]byte{0x2, 0x9f, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 0<<11 | 1135, 2<<4 | 15, argp_yoyomq},              // vfnmsub312sd (2017)
+	enc{[4]byte{0x2, 0x9f, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 1<<11 | 1135, 2<<4 | 15, argp_yoyoyo},              // vfnmsub312sd (2018)
+	enc{[4]byte{0x2, 0x9f, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 0<<11 | 1136, 2<<4 | 15, argp_yoyomd},                          // vfnmsub312ss (2019)
+	enc{[4]byte{0x2, 0x9f, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 1<<11 | 1136, 2<<4 | 15, argp_yoyoyo},                          // vfnmsub312ss (2020)
+	enc{[4]byte{0x2, 0xbe, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1137, 2<<4 | 15, argp_y0y0w0},              // vfnmsub321pd (2021)
+	enc{[4]byte{0x2, 0xbe, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_FMA, 0<<11 | 1138, 2<<4 | 15, argp_y0y0w0},              // vfnmsub321ps (2022)
+	enc{[4]byte{0x2, 0xbf, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 0<<11 | 1139, 2<<4 | 15, argp_yoyomq},              // vfnmsub321sd (2023)
+	enc{[4]byte{0x2, 0xbf, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_FMA, 1<<11 | 1139, 2<<4 | 15, argp_yoyoyo},              // vfnmsub321sd (2024)
+	enc{[4]byte{0x2, 0xbf, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 0<<11 | 1140, 2<<4 | 15, argp_yoyomd},                          // vfnmsub321ss (2025)
+	enc{[4]byte{0x2, 0xbf, 0x00, 0x00}, VEX_OP | PREF_66, featset_FMA, 1<<11 | 1140, 2<<4 | 15, argp_yoyoyo},                          // vfnmsub321ss (2026)
+	enc{[4]byte{0x3, 0x7d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_SSE5_AMD, 0<<11 | 1141, 2<<4 | 15, argp_y0y0y0w0},       // vfnmsubpd (2027)
+	enc{[4]byte{0x3, 0x7d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_SSE5_AMD, 1<<11 | 1141, 2<<4 | 15, argp_y0y0w0y0},       // vfnmsubpd (2028)
+	enc{[4]byte{0x3, 0x7c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_SSE5_AMD, 0<<11 | 1142, 2<<4 | 15, argp_y0y0y0w0},       // vfnmsubps (2029)
+	enc{[4]byte{0x3, 0x7c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_SSE5_AMD, 1<<11 | 1142, 2<<4 | 15, argp_y0y0w0y0},       // vfnmsubps (2030)
+	enc{[4]byte{0x3, 0x7f, 0x00, 0x00}, VEX_OP | PREF_66, featset_SSE5_AMD, 0<<11 | 1143, 2<<4 | 15, argp_yoyomqyo},                   // vfnmsubsd (2031)
+	enc{[4]byte{0x3, 0x7f, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_SSE5_AMD, 1<<11 | 1143, 2<<4 | 15, argp_yoyoyomq},       // vfnmsubsd (2032)
+	enc{[4]byte{0x3, 0x7f, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_SSE5_AMD, 2<<11 | 1143, 2<<4 | 15, argp_yoyoyoyo},       // vfnmsubsd (2033)
+	enc{[4]byte{0x3, 0x7e, 0x00, 0x00}, VEX_OP | PREF_66, featset_SSE5_AMD, 0<<11 | 1144, 2<<4 | 15, argp_yoyomdyo},                   // vfnmsubss (2034)
+	enc{[4]byte{0x3, 0x7e, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_SSE5_AMD, 1<<11 | 1144, 2<<4 | 15, argp_yoyoyomd},       // vfnmsubss (2035)
+	enc{[4]byte{0x3, 0x7e, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_SSE5_AMD, 2<<11 | 1144, 2<<4 | 15, argp_yoyoyoyo},       // vfnmsubss (2036)
+	enc{[4]byte{0x9, 0x81, 0x00, 0x00}, XOP_OP | AUTO_VEXL, featset_SSE5_AMD, 0<<11 | 1145, 2<<4 | 15, argp_y0w0},                     // vfrczpd (2037)
+	enc{[4]byte{0x9, 0x80, 0x00, 0x00}, XOP_OP | AUTO_VEXL, featset_SSE5_AMD, 0<<11 | 1146, 2<<4 | 15, argp_y0w0},                     // vfrczps (2038)
+	enc{[4]byte{0x9, 0x83, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1147, 2<<4 | 15, argp_yomq},                                 // vfrczsd (2039)
+	enc{[4]byte{0x9, 0x83, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 1<<11 | 1147, 2<<4 | 15, argp_yoyo},                                 // vfrczsd (2040)
+	enc{[4]byte{0x9, 0x82, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1148, 2<<4 | 15, argp_yomd},                                 // vfrczss (2041)
+	enc{[4]byte{0x9, 0x82, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 1<<11 | 1148, 2<<4 | 15, argp_yoyo},                                 // vfrczss (2042)
+	enc{[4]byte{0x2, 0x92, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, featset_AVX2, 0<<11 | 1149, 2<<4 | 15, argp_y0loy0},    // vgatherdpd (2043)
+	enc{[4]byte{0x2, 0x92, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, featset_AVX2, 0<<11 | 1150, 2<<4 | 15, argp_y0k0y0},    // vgatherdps (2044)
+	enc{[4]byte{0x2, 0x93, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, featset_AVX2, 0<<11 | 1151, 2<<4 | 15, argp_y0l0y0},    // vgatherqpd (2045)
+	enc{[4]byte{0x2, 0x93, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, featset_AVX2, 0<<11 | 1152, 2<<4 | 15, argp_yok0yo},    // vgatherqps (2046)
+	enc{[4]byte{0x1, 0x7c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1153, 2<<4 | 15, argp_y0y0w0},              // vhaddpd (2047)
+	enc{[4]byte{0x1, 0x7c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F2, featset_AVX, 0<<11 | 1154, 2<<4 | 15, argp_y0y0w0},              // vhaddps (2048)
+	enc{[4]byte{0x1, 0x7d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1155, 2<<4 | 15, argp_y0y0w0},              // vhsubpd (2049)
+	enc{[4]byte{0x1, 0x7d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F2, featset_AVX, 0<<11 | 1156, 2<<4 | 15, argp_y0y0w0},              // vhsubps (2050)
+	enc{[4]byte{0x3, 0x18, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66, featset_AVX, 0<<11 | 1157, 2<<4 | 15, argp_yhyhwoib},            // vinsertf128 (2051)
+	enc{[4]byte{0x3, 0x38, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66, featset_AVX2, 0<<11 | 1158, 2<<4 | 15, argp_yhyhwoib},           // vinserti128 (2052)
+	enc{[4]byte{0x3, 0x21, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 1159, 2<<4 | 15, argp_yoyomdib},                        // vinsertps (2053)
+	enc{[4]byte{0x3, 0x21, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 1<<11 | 1159, 2<<4 | 15, argp_yoyoyoib},                        // vinsertps (2054)
+	enc{[4]byte{0x1, 0xf0, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F2, featset_AVX, 0<<11 | 1160, 2<<4 | 15, argp_y0m0},                // vlddqu (2055)
+	enc{[4]byte{0x1, 0xae, 0x00, 0x00}, VEX_OP, featset_AVX, 0<<11 | 1161, 2<<4 | 2, argp_md},                                         // vldmxcsr (2056)
+	enc{[4]byte{0x1, 0xf0, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_F2, featset_AVX, 0<<11 | 1162, 2<<4 | 15, argp_yhmh},                // vldqqu (2057)
+	enc{[4]byte{0x1, 0xf7, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 1163, 2<<4 | 15, argp_yoyo},                            // vmaskmovdqu (2058)
+	enc{[4]byte{0x2, 0x2f, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_VM, featset_AVX, 0<<11 | 1164, 2<<4 | 15, argp_m0y0y0},     // vmaskmovpd (2059)
+	enc{[4]byte{0x2, 0x2d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 1<<11 | 1164, 2<<4 | 15, argp_y0y0m0},              // vmaskmovpd (2060)
+	enc{[4]byte{0x2, 0x2e, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_VM, featset_AVX, 0<<11 | 1165, 2<<4 | 15, argp_m0y0y0},     // vmaskmovps (2061)
+	enc{[4]byte{0x2, 0x2c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 1<<11 | 1165, 2<<4 | 15, argp_y0y0m0},              // vmaskmovps (2062)
+	enc{[4]byte{0x1, 0x5f, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1166, 2<<4 | 15, argp_y0y0w0},              // vmaxpd (2063)
+	enc{[4]byte{0x1, 0x5f, 0x00, 0x00}, VEX_OP | AUTO_VEXL, featset_AVX, 0<<11 | 1167, 2<<4 | 15, argp_y0y0w0},                        // vmaxps (2064)
+	enc{[4]byte{0x1, 0x5f, 0x00, 0x00}, VEX_OP | PREF_F2, featset_AVX, 0<<11 | 1168, 2<<4 | 15, argp_yoyomq},                          // vmaxsd (2065)
+	enc{[4]byte{0x1, 0x5f, 0x00, 0x00}, VEX_OP | PREF_F2, featset_AVX, 1<<11 | 1168, 2<<4 | 15, argp_yoyoyo},                          // vmaxsd (2066)
+	enc{[4]byte{0x1, 0x5f, 0x00, 0x00}, VEX_OP | PREF_F3, featset_AVX, 0<<11 | 1169, 2<<4 | 15, argp_yoyomd},                          // vmaxss (2067)
+	enc{[4]byte{0x1, 0x5f, 0x00, 0x00}, VEX_OP | PREF_F3, featset_AVX, 1<<11 | 1169, 2<<4 | 15, argp_yoyoyo},                          // vmaxss (2068)
+	enc{[4]byte{0xf, 0x1, 0xc1, 0x00}, 0, featset_VMX, 0<<11 | 1170, 3<<4 | 15, argp_},                                                // vmcall (2069)
+	enc{[4]byte{0xf, 0xc7, 0x00, 0x00}, PREF_66, featset_VMX, 0<<11 | 1171, 2<<4 | 6, argp_m1},                                        // vmclear (2070)
+	enc{[4]byte{0xf, 0x1, 0xd4, 0x00}, 0, featset_VMX, 0<<11 | 1172, 3<<4 | 15, argp_},                                                // vmfunc (2071)
+	enc{[4]byte{0x1, 0x5d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1173, 2<<4 | 15, argp_y0y0w0},              // vminpd (2072)
+	enc{[4]byte{0x1, 0x5d, 0x00, 0x00}, VEX_OP | AUTO_VEXL, featset_AVX, 0<<11 | 1174, 2<<4 | 15, argp_y0y0w0},                        // vminps (2073)
+	enc{[4]byte{0x1, 0x5d, 0x00, 0x00}, VEX_OP | PREF_F2, featset_AVX, 0<<11 | 1175, 2<<4 | 15, argp_yoyomq},                          // vminsd (2074)
+	enc{[4]byte{0x1, 0x5d, 0x00, 0x00}, VEX_OP | PREF_F2, featset_AVX, 1<<11 | 1175, 2<<4 | 15, argp_yoyoyo},                          // vminsd (2075)
+	enc{[4]byte{0x1, 0x5d, 0x00, 0x00}, VEX_OP | PREF_F3, featset_AVX, 0<<11 | 1176, 2<<4 | 15, argp_yoyomd},                          // vminss (2076)
+	enc{[4]byte{0x1, 0x5d, 0x00, 0x00}, VEX_OP | PREF_F3, featset_AVX, 1<<11 | 1176, 2<<4 | 15, argp_yoyoyo},                          // vminss (2077)
+	enc{[4]byte{0xf, 0x1, 0xc2, 0x00}, 0, featset_VMX, 0<<11 | 1177, 3<<4 | 15, argp_},                                                // vmlaunch (2078)
+	enc{[4]byte{0xf, 0x1, 0xda, 0x00}, 0, featset_VMX_AMD, 0<<11 | 1178, 3<<4 | 15, argp_},                                            // vmload (2079)
+	enc{[4]byte{0xf, 0x1, 0xd9, 0x00}, 0, featset_VMX_AMD, 0<<11 | 1179, 3<<4 | 15, argp_},                                            // vmmcall (2080)
+	enc{[4]byte{0x1, 0x28, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1180, 2<<4 | 15, argp_y0w0},                // vmovapd (2081)
+	enc{[4]byte{0x1, 0x29, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66 | ENC_MR, featset_AVX, 1<<11 | 1180, 2<<4 | 15, argp_whyh},       // vmovapd (2082)
+	enc{[4]byte{0x1, 0x29, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, featset_AVX, 2<<11 | 1180, 2<<4 | 15, argp_woyo},                   // vmovapd (2083)
+	enc{[4]byte{0x1, 0x28, 0x00, 0x00}, VEX_OP | AUTO_VEXL, featset_AVX, 0<<11 | 1181, 2<<4 | 15, argp_y0w0},                          // vmovaps (2084)
+	enc{[4]byte{0x1, 0x29, 0x00, 0x00}, VEX_OP | WITH_VEXL | ENC_MR, featset_AVX, 1<<11 | 1181, 2<<4 | 15, argp_whyh},                 // vmovaps (2085)
+	enc{[4]byte{0x1, 0x29, 0x00, 0x00}, VEX_OP | ENC_MR, featset_AVX, 2<<11 | 1181, 2<<4 | 15, argp_woyo},                             // vmovaps (2086)
+	enc{[4]byte{0x1, 0x6e, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 1182, 2<<4 | 15, argp_yovd},                            // vmovd (2087)
+	enc{[4]byte{0x1, 0x7e, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, featset_AVX, 1<<11 | 1182, 2<<4 | 15, argp_vdyo},                   // vmovd (2088)
+	enc{[4]byte{0x1, 0x12, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F2, featset_AVX, 0<<11 | 1183, 2<<4 | 15, argp_y0w0},                // vmovddup (2089)
+	enc{[4]byte{0x1, 0x12, 0x00, 0x00}, VEX_OP | PREF_F2, featset_AVX, 1<<11 | 1183, 2<<4 | 15, argp_yomq},                            // vmovddup (2090)
+	enc{[4]byte{0x1, 0x6f, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1184, 2<<4 | 15, argp_y0w0},                // vmovdqa (2091)
+	enc{[4]byte{0x1, 0x7f, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66 | ENC_MR, featset_AVX, 1<<11 | 1184, 2<<4 | 15, argp_whyh},       // vmovdqa (2092)
+	enc{[4]byte{0x1, 0x7f, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, featset_AVX, 2<<11 | 1184, 2<<4 | 15, argp_woyo},                   // vmovdqa (2093)
+	enc{[4]byte{0x1, 0x6f, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F3, featset_AVX, 0<<11 | 1185, 2<<4 | 15, argp_y0w0},                // vmovdqu (2094)
+	enc{[4]byte{0x1, 0x7f, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_F3 | ENC_MR, featset_AVX, 1<<11 | 1185, 2<<4 | 15, argp_whyh},       // vmovdqu (2095)
+	enc{[4]byte{0x1, 0x7f, 0x00, 0x00}, VEX_OP | PREF_F3 | ENC_MR, featset_AVX, 2<<11 | 1185, 2<<4 | 15, argp_woyo},                   // vmovdqu (2096)
+	enc{[4]byte{0x1, 0x12, 0x00, 0x00}, VEX_OP, featset_AVX, 0<<11 | 1186, 2<<4 | 15, argp_yoyoyo},                                    // vmovhlps (2097)
+	enc{[4]byte{0x1, 0x17, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, featset_AVX, 0<<11 | 1187, 2<<4 | 15, argp_mqyo},                   // vmovhpd (2098)
+	enc{[4]byte{0x1, 0x16, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 1<<11 | 1187, 2<<4 | 15, argp_yoyomq},                          // vmovhpd (2099)
+	enc{[4]byte{0x1, 0x17, 0x00, 0x00}, VEX_OP | ENC_MR, featset_AVX, 0<<11 | 1188, 2<<4 | 15, argp_mqyo},                             // vmovhps (2100)
+	enc{[4]byte{0x1, 0x16, 0x00, 0x00}, VEX_OP, featset_AVX, 1<<11 | 1188, 2<<4 | 15, argp_yoyomq},                                    // vmovhps (2101)
+	enc{[4]byte{0x1, 0x16, 0x00, 0x00}, VEX_OP, featset_AVX, 0<<11 | 1189, 2<<4 | 15, argp_yoyoyo},                                    // vmovlhps (2102)
+	enc{[4]byte{0x1, 0x13, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, featset_AVX, 0<<11 | 1190, 2<<4 | 15, argp_mqyo},                   // vmovlpd (2103)
+	enc{[4]byte{0x1, 0x12, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 1<<11 | 1190, 2<<4 | 15, argp_yoyomq},                          // vmovlpd (2104)
+	enc{[4]byte{0x1, 0x13, 0x00, 0x00}, VEX_OP | ENC_MR, featset_AVX, 0<<11 | 1191, 2<<4 | 15, argp_mqyo},                             // vmovlps (2105)
+	enc{[4]byte{0x1, 0x12, 0x00, 0x00}, VEX_OP, featset_AVX, 1<<11 | 1191, 2<<4 | 15, argp_yoyomq},                                    // vmovlps (2106)
+	enc{[4]byte{0x1, 0x50, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 1192, 2<<4 | 15, argp_r0y0},                            // vmovmskpd (2107)
+	enc{[4]byte{0x1, 0x50, 0x00, 0x00}, VEX_OP, featset_AVX, 0<<11 | 1193, 2<<4 | 15, argp_r0y0},                                      // vmovmskps (2108)
+	enc{[4]byte{0x1, 0xe7, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, featset_AVX, 0<<11 | 1194, 2<<4 | 15, argp_m0y0},       // vmovntdq (2109)
+	enc{[4]byte{0x2, 0x2a, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1195, 2<<4 | 15, argp_y0m0},                // vmovntdqa (2110)
+	enc{[4]byte{0x1, 0x2b, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, featset_AVX, 0<<11 | 1196, 2<<4 | 15, argp_m0y0},       // vmovntpd (2111)
+	enc{[4]byte{0x1, 0x2b, 0x00, 0x00}, VEX_OP | AUTO_VEXL | ENC_MR, featset_AVX, 0<<11 | 1197, 2<<4 | 15, argp_m0y0},                 // vmovntps (2112)
+	enc{[4]byte{0x1, 0xe7, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66 | ENC_MR, featset_AVX, 0<<11 | 1198, 2<<4 | 15, argp_mhyh},       // vmovntqq (2113)
+	enc{[4]byte{0x1, 0xd6, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, featset_AVX, 0<<11 | 1199, 2<<4 | 15, argp_mqyo},                   // vmovq (2114)
+	enc{[4]byte{0x1, 0x7e, 0x00, 0x00}, VEX_OP | PREF_F3, featset_AVX, 1<<11 | 1199, 2<<4 | 15, argp_yomq},                            // vmovq (2115)
+	enc{[4]byte{0x1, 0x6e, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_AVX, 2<<11 | 1199, 2<<4 | 15, argp_yovq},                // vmovq (2116)
+	enc{[4]byte{0x1, 0x7e, 0x00, 0x00}, VEX_OP | PREF_F3, featset_AVX, 3<<11 | 1199, 2<<4 | 15, argp_yoyo},                            // vmovq (2117)
+	enc{[4]byte{0x1, 0xd6, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, featset_AVX, 4<<11 | 1199, 2<<4 | 15, argp_yoyo},                   // vmovq (2118)
+	enc{[4]byte{0x1, 0x7e, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66 | ENC_MR, featset_AVX, 5<<11 | 1199, 2<<4 | 15, argp_vqyo},       // vmovq (2119)
+	enc{[4]byte{0x1, 0x6f, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66, featset_AVX, 0<<11 | 1200, 2<<4 | 15, argp_yhwh},                // vmovqqa (2120)
+	enc{[4]byte{0x1, 0x7f, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66 | ENC_MR, featset_AVX, 1<<11 | 1200, 2<<4 | 15, argp_whyh},       // vmovqqa (2121)
+	enc{[4]byte{0x1, 0x6f, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_F3, featset_AVX, 0<<11 | 1201, 2<<4 | 15, argp_yhwh},                // vmovqqu (2122)
+	enc{[4]byte{0x1, 0x7f, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_F3 | ENC_MR, featset_AVX, 1<<11 | 1201, 2<<4 | 15, argp_whyh},       // vmovqqu (2123)
+	enc{[4]byte{0x1, 0x11, 0x00, 0x00}, VEX_OP | PREF_F2 | ENC_MR, featset_AVX, 0<<11 | 1202, 2<<4 | 15, argp_mqyo},                   // vmovsd (2124)
+	enc{[4]byte{0x1, 0x10, 0x00, 0x00}, VEX_OP | PREF_F2, featset_AVX, 1<<11 | 1202, 2<<4 | 15, argp_yomq},                            // vmovsd (2125)
+	enc{[4]byte{0x1, 0x10, 0x00, 0x00}, VEX_OP | PREF_F2, featset_AVX, 2<<11 | 1202, 2<<4 | 15, argp_yoyoyo},                          // vmovsd (2126)
+	enc{[4]byte{0x1, 0x11, 0x00, 0x00}, VEX_OP | PREF_F2 | ENC_VM, featset_AVX, 3<<11 | 1202, 2<<4 | 15, argp_yoyoyo},                 // vmovsd (2127)
+	enc{[4]byte{0x1, 0x16, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F3, featset_AVX, 0<<11 | 1203, 2<<4 | 15, argp_y0w0},                // vmovshdup (2128)
+	enc{[4]byte{0x1, 0x12, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F3, featset_AVX, 0<<11 | 1204, 2<<4 | 15, argp_y0w0},                // vmovsldup (2129)
+	enc{[4]byte{0x1, 0x11, 0x00, 0x00}, VEX_OP | PREF_F3 | ENC_MR, featset_AVX, 0<<11 | 1205, 2<<4 | 15, argp_mdyo},                   // vmovss (2130)
+	enc{[4]byte{0x1, 0x10, 0x00, 0x00}, VEX_OP | PREF_F3, featset_AVX, 1<<11 | 1205, 2<<4 | 15, argp_yomd},                            // vmovss (2131)
+	enc{[4]byte{0x1, 0x10, 0x00, 0x00}, VEX_OP | PREF_F3, featset_AVX, 2<<11 | 1205, 2<<4 | 15, argp_yoyoyo},                          // vmovss (2132)
+	enc{[4]byte{0x1, 0x11, 0x00, 0x00}, VEX_OP | PREF_F3 | ENC_VM, featset_AVX, 3<<11 | 1205, 2<<4 | 15, argp_yoyoyo},                 // vmovss (2133)
+	enc{[4]byte{0x1, 0x10, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1206, 2<<4 | 15, argp_y0w0},                // vmovupd (2134)
+	enc{[4]byte{0x1, 0x11, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66 | ENC_MR, featset_AVX, 1<<11 | 1206, 2<<4 | 15, argp_whyh},       // vmovupd (2135)
+	enc{[4]byte{0x1, 0x11, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, featset_AVX, 2<<11 | 1206, 2<<4 | 15, argp_woyo},                   // vmovupd (2136)
+	enc{[4]byte{0x1, 0x10, 0x00, 0x00}, VEX_OP | AUTO_VEXL, featset_AVX, 0<<11 | 1207, 2<<4 | 15, argp_y0w0},                          // vmovups (2137)
+	enc{[4]byte{0x1, 0x11, 0x00, 0x00}, VEX_OP | WITH_VEXL | ENC_MR, featset_AVX, 1<<11 | 1207, 2<<4 | 15, argp_whyh},                 // vmovups (2138)
+	enc{[4]byte{0x1, 0x11, 0x00, 0x00}, VEX_OP | ENC_MR, featset_AVX, 2<<11 | 1207, 2<<4 | 15, argp_woyo},                             // vmovups (2139)
+	enc{[4]byte{0x3, 0x42, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, featset_AVX, 0<<11 | 1208, 2<<4 | 15, argp_y0y0w0ib},   // vmpsadbw (2140)
+	enc{[4]byte{0xf, 0xc7, 0x00, 0x00}, 0, featset_VMX, 0<<11 | 1209, 2<<4 | 6, argp_m1},                                              // vmptrld (2141)
+	enc{[4]byte{0xf, 0xc7, 0x00, 0x00}, 0, featset_VMX, 0<<11 | 1210, 2<<4 | 7, argp_m1},                                              // vmptrst (2142)
+	enc{[4]byte{0xf, 0x78, 0x00, 0x00}, ENC_MR, featset_VMX, 0<<11 | 1211, 2<<4 | 15, argp_vqrq},                                      // vmread (2143)
+	enc{[4]byte{0xf, 0x1, 0xc3, 0x00}, 0, featset_VMX, 0<<11 | 1212, 3<<4 | 15, argp_},                                                // vmresume (2144)
+	enc{[4]byte{0xf, 0x1, 0xd8, 0x00}, 0, featset_VMX_AMD, 0<<11 | 1213, 3<<4 | 15, argp_},                                            // vmrun (2145)
+	enc{[4]byte{0xf, 0x1, 0xdb, 0x00}, 0, featset_VMX_AMD, 0<<11 | 1214, 3<<4 | 15, argp_},                                            // vmsave (2146)
+	enc{[4]byte{0x1, 0x59, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1215, 2<<4 | 15, argp_y0y0w0},              // vmulpd (2147)
+	enc{[4]byte{0x1, 0x59, 0x00, 0x00}, VEX_OP | AUTO_VEXL, featset_AVX, 0<<11 | 1216, 2<<4 | 15, argp_y0y0w0},                        // vmulps (2148)
+	enc{[4]byte{0x1, 0x59, 0x00, 0x00}, VEX_OP | PREF_F2, featset_AVX, 0<<11 | 1217, 2<<4 | 15, argp_yoyomq},                          // vmulsd (2149)
+	enc{[4]byte{0x1, 0x59, 0x00, 0x00}, VEX_OP | PREF_F2, featset_AVX, 1<<11 | 1217, 2<<4 | 15, argp_yoyoyo},                          // vmulsd (2150)
+	enc{[4]byte{0x1, 0x59, 0x00, 0x00}, VEX_OP | PREF_F3, featset_AVX, 0<<11 | 1218, 2<<4 | 15, argp_yoyomd},                          // vmulss (2151)
+	enc{[4]byte{0x1, 0x59, 0x00, 0x00}, VEX_OP | PREF_F3, featset_AVX, 1<<11 | 1218, 2<<4 | 15, argp_yoyoyo},                          // vmulss (2152)
+	enc{[4]byte{0xf, 0x79, 0x00, 0x00}, 0, featset_VMX, 0<<11 | 1219, 2<<4 | 15, argp_rqvq},                                           // vmwrite (2153)
+	enc{[4]byte{0xf, 0x1, 0xc4, 0x00}, 0, featset_VMX, 0<<11 | 1220, 3<<4 | 15, argp_},                                                // vmxoff (2154)
+	enc{[4]byte{0xf, 0xc7, 0x00, 0x00}, PREF_F3, featset_VMX, 0<<11 | 1221, 2<<4 | 6, argp_m1},                                        // vmxon (2155)
+	enc{[4]byte{0x1, 0x56, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1222, 2<<4 | 15, argp_y0y0w0},              // vorpd (2156)
+	enc{[4]byte{0x1, 0x56, 0x00, 0x00}, VEX_OP | AUTO_VEXL, featset_AVX, 0<<11 | 1223, 2<<4 | 15, argp_y0y0w0},                        // vorps (2157)
+	enc{[4]byte{0x2, 0x1c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1224, 2<<4 | 15, argp_y0w0},                // vpabsb (2158)
+	enc{[4]byte{0x2, 0x1e, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1225, 2<<4 | 15, argp_y0w0},                // vpabsd (2159)
+	enc{[4]byte{0x2, 0x1d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1226, 2<<4 | 15, argp_y0w0},                // vpabsw (2160)
+	enc{[4]byte{0x1, 0x6b, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1227, 2<<4 | 15, argp_y0y0w0},              // vpackssdw (2161)
+	enc{[4]byte{0x1, 0x63, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1228, 2<<4 | 15, argp_y0y0w0},              // vpacksswb (2162)
+	enc{[4]byte{0x2, 0x2b, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1229, 2<<4 | 15, argp_y0y0w0},              // vpackusdw (2163)
+	enc{[4]byte{0x1, 0x67, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1230, 2<<4 | 15, argp_y0y0w0},              // vpackuswb (2164)
+	enc{[4]byte{0x1, 0xfc, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1231, 2<<4 | 15, argp_y0y0w0},              // vpaddb (2165)
+	enc{[4]byte{0x1, 0xfe, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1232, 2<<4 | 15, argp_y0y0w0},              // vpaddd (2166)
+	enc{[4]byte{0x1, 0xd4, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1233, 2<<4 | 15, argp_y0y0w0},              // vpaddq (2167)
+	enc{[4]byte{0x1, 0xec, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1234, 2<<4 | 15, argp_y0y0w0},              // vpaddsb (2168)
+	enc{[4]byte{0x1, 0xed, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1235, 2<<4 | 15, argp_y0y0w0},              // vpaddsw (2169)
+	enc{[4]byte{0x1, 0xdc, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1236, 2<<4 | 15, argp_y0y0w0},              // vpaddusb (2170)
+	enc{[4]byte{0x1, 0xdd, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1237, 2<<4 | 15, argp_y0y0w0},              // vpaddusw (2171)
+	enc{[4]byte{0x1, 0xfd, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1238, 2<<4 | 15, argp_y0y0w0},              // vpaddw (2172)
+	enc{[4]byte{0x3, 0xf, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, featset_AVX, 0<<11 | 1239, 2<<4 | 15, argp_y0y0w0ib},    // vpalignr (2173)
+	enc{[4]byte{0x1, 0xdb, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1240, 2<<4 | 15, argp_y0y0w0},              // vpand (2174)
+	enc{[4]byte{0x1, 0xdf, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1241, 2<<4 | 15, argp_y0y0w0},              // vpandn (2175)
+	enc{[4]byte{0x1, 0xe0, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1242, 2<<4 | 15, argp_y0y0w0},              // vpavgb (2176)
+	enc{[4]byte{0x1, 0xe3, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1243, 2<<4 | 15, argp_y0y0w0},              // vpavgw (2177)
+	enc{[4]byte{0x3, 0x2, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, featset_AVX2, 0<<11 | 1244, 2<<4 | 15, argp_y0y0w0ib},   // vpblendd (2178)
+	enc{[4]byte{0x3, 0x4c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1245, 2<<4 | 15, argp_y0y0w0y0},            // vpblendvb (2179)
+	enc{[4]byte{0x3, 0xe, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, featset_AVX, 0<<11 | 1246, 2<<4 | 15, argp_y0y0w0ib},    // vpblendw (2180)
+	enc{[4]byte{0x2, 0x78, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX2, 0<<11 | 1247, 2<<4 | 15, argp_y0mb},               // vpbroadcastb (2181)
+	enc{[4]byte{0x2, 0x78, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX2, 1<<11 | 1247, 2<<4 | 15, argp_y0yo},               // vpbroadcastb (2182)
+	enc{[4]byte{0x2, 0x58, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX2, 0<<11 | 1248, 2<<4 | 15, argp_y0md},               // vpbroadcastd (2183)
+	enc{[4]byte{0x2, 0x58, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX2, 1<<11 | 1248, 2<<4 | 15, argp_y0yo},               // vpbroadcastd (2184)
+	enc{[4]byte{0x2, 0x59, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66, featset_AVX2, 0<<11 | 1249, 2<<4 | 15, argp_yhmq},               // vpbroadcastq (2185)
+	enc{[4]byte{0x2, 0x59, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX2, 1<<11 | 1249, 2<<4 | 15, argp_yomq},                           // vpbroadcastq (2186)
+	enc{[4]byte{0x2, 0x59, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX2, 2<<11 | 1249, 2<<4 | 15, argp_y0yo},               // vpbroadcastq (2187)
+	enc{[4]byte{0x2, 0x79, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX2, 0<<11 | 1250, 2<<4 | 15, argp_y0mw},               // vpbroadcastw (2188)
+	enc{[4]byte{0x2, 0x79, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX2, 1<<11 | 1250, 2<<4 | 15, argp_y0yo},               // vpbroadcastw (2189)
+	enc{[4]byte{0x3, 0x44, 0x11, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX_PCLMULQDQ, 0<<11 | 1251, 3<<4 | 15, argp_yoyowo},       // vpclmulhqhqdq (2190)
+	enc{[4]byte{0x3, 0x44, 0x1, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX_PCLMULQDQ, 0<<11 | 1252, 3<<4 | 15, argp_yoyowo},        // vpclmulhqlqdq (2191)
+	enc{[4]byte{0x3, 0x44, 0x10, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX_PCLMULQDQ, 0<<11 | 1253, 3<<4 | 15, argp_yoyowo},       // vpclmullqhqdq (2192)
+	enc{[4]byte{0x3, 0x44, 0x0, 0x00}, VEX_OP | IMM_OP | PREF_66, featset_AVX_PCLMULQDQ, 0<<11 | 1254, 3<<4 | 15, argp_yoyowo},        // vpclmullqlqdq (2193)
+	enc{[4]byte{0x3, 0x44, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX_PCLMULQDQ, 0<<11 | 1255, 2<<4 | 15, argp_yoyowoib},              // vpclmulqdq (2194)
+	enc{[4]byte{0x8, 0xa2, 0x00, 0x00}, XOP_OP | AUTO_VEXL, featset_SSE5_AMD, 0<<11 | 1256, 2<<4 | 15, argp_y0y0w0y0},                 // vpcmov (2195)
+	enc{[4]byte{0x8, 0xa2, 0x00, 0x00}, XOP_OP | AUTO_VEXL, featset_SSE5_AMD, 1<<11 | 1256, 2<<4 | 15, argp_y0y0y0w0},                 // vpcmov (2196)
+	enc{[4]byte{0x1, 0x74, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1257, 2<<4 | 15, argp_y0y0w0},              // vpcmpeqb (2197)
+	enc{[4]byte{0x1, 0x76, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1258, 2<<4 | 15, argp_y0y0w0},              // vpcmpeqd (2198)
+	enc{[4]byte{0x2, 0x29, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1259, 2<<4 | 15, argp_y0y0w0},              // vpcmpeqq (2199)
+	enc{[4]byte{0x1, 0x75, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1260, 2<<4 | 15, argp_y0y0w0},              // vpcmpeqw (2200)
+	enc{[4]byte{0x3, 0x61, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 1261, 2<<4 | 15, argp_yowoib},                          // vpcmpestri (2201)
+	enc{[4]byte{0x3, 0x60, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 1262, 2<<4 | 15, argp_yowoib},                          // vpcmpestrm (2202)
+	enc{[4]byte{0x1, 0x64, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1263, 2<<4 | 15, argp_y0y0w0},              // vpcmpgtb (2203)
+	enc{[4]byte{0x1, 0x66, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1264, 2<<4 | 15, argp_y0y0w0},              // vpcmpgtd (2204)
+	enc{[4]byte{0x2, 0x37, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1265, 2<<4 | 15, argp_y0y0w0},              // vpcmpgtq (2205)
+	enc{[4]byte{0x1, 0x65, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1266, 2<<4 | 15, argp_y0y0w0},              // vpcmpgtw (2206)
+	enc{[4]byte{0x3, 0x63, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 1267, 2<<4 | 15, argp_yowoib},                          // vpcmpistri (2207)
+	enc{[4]byte{0x3, 0x62, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 1268, 2<<4 | 15, argp_yowoib},                          // vpcmpistrm (2208)
+	enc{[4]byte{0x8, 0xcc, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1269, 2<<4 | 15, argp_yoyowoib},                             // vpcomb (2209)
+	enc{[4]byte{0x8, 0xce, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1270, 2<<4 | 15, argp_yoyowoib},                             // vpcomd (2210)
+	enc{[4]byte{0x8, 0xcf, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1271, 2<<4 | 15, argp_yoyowoib},                             // vpcomq (2211)
+	enc{[4]byte{0x8, 0xec, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1272, 2<<4 | 15, argp_yoyowoib},                             // vpcomub (2212)
+	enc{[4]byte{0x8, 0xee, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1273, 2<<4 | 15, argp_yoyowoib},                             // vpcomud (2213)
+	enc{[4]byte{0x8, 0xef, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1274, 2<<4 | 15, argp_yoyowoib},                             // vpcomuq (2214)
+	enc{[4]byte{0x8, 0xed, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1275, 2<<4 | 15, argp_yoyowoib},                             // vpcomuw (2215)
+	enc{[4]byte{0x8, 0xcd, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1276, 2<<4 | 15, argp_yoyowoib},                             // vpcomw (2216)
+	enc{[4]byte{0x3, 0x6, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66, featset_AVX, 0<<11 | 1277, 2<<4 | 15, argp_yhyhwhib},             // vperm2f128 (2217)
+	enc{[4]byte{0x3, 0x46, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66, featset_AVX2, 0<<11 | 1278, 2<<4 | 15, argp_yhyhwhib},           // vperm2i128 (2218)
+	enc{[4]byte{0x2, 0x36, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66, featset_AVX2, 0<<11 | 1279, 2<<4 | 15, argp_yhyhwh},             // vpermd (2219)
+	enc{[4]byte{0x2, 0xd, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1280, 2<<4 | 15, argp_y0y0w0},               // vpermilpd (2220)
+	enc{[4]byte{0x3, 0x5, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 1<<11 | 1280, 2<<4 | 15, argp_y0w0ib},               // vpermilpd (2221)
+	enc{[4]byte{0x2, 0xc, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1281, 2<<4 | 15, argp_y0y0w0},               // vpermilps (2222)
+	enc{[4]byte{0x3, 0x4, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 1<<11 | 1281, 2<<4 | 15, argp_y0w0ib},               // vpermilps (2223)
+	enc{[4]byte{0x3, 0x1, 0x00, 0x00}, VEX_OP | WITH_REXW | WITH_VEXL | PREF_66, featset_AVX2, 0<<11 | 1282, 2<<4 | 15, argp_yhwhib},  // vpermpd (2224)
+	enc{[4]byte{0x2, 0x16, 0x00, 0x00}, VEX_OP | WITH_VEXL | PREF_66, featset_AVX2, 0<<11 | 1283, 2<<4 | 15, argp_yhyhwh},             // vpermps (2225)
+	enc{[4]byte{0x3, 0x0, 0x00, 0x00}, VEX_OP | WITH_REXW | WITH_VEXL | PREF_66, featset_AVX2, 0<<11 | 1284, 2<<4 | 15, argp_yhwhib},  // vpermq (2226)
+	enc{[4]byte{0x3, 0x14, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, featset_AVX, 0<<11 | 1285, 2<<4 | 15, argp_mbyoib},                 // vpextrb (2227)
+	enc{[4]byte{0x3, 0x14, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, featset_AVX, 1<<11 | 1285, 2<<4 | 15, argp_rdyoib},                 // vpextrb (2228)
+	enc{[4]byte{0x3, 0x14, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, featset_AVX, 2<<11 | 1285, 2<<4 | 15, argp_rqyoib},                 // vpextrb (2229)
+	enc{[4]byte{0x3, 0x16, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, featset_AVX, 0<<11 | 1286, 2<<4 | 15, argp_rqyoib},                 // vpextrd (2230)
+	enc{[4]byte{0x3, 0x16, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, featset_AVX, 1<<11 | 1286, 2<<4 | 15, argp_vdyoib},                 // vpextrd (2231)
+	enc{[4]byte{0x3, 0x16, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66 | ENC_MR, featset_AVX, 0<<11 | 1287, 2<<4 | 15, argp_vqyoib},     // vpextrq (2232)
+	enc{[4]byte{0x3, 0x15, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, featset_AVX, 0<<11 | 1288, 2<<4 | 15, argp_mwyoib},                 // vpextrw (2233)
+	enc{[4]byte{0x1, 0xc5, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 1<<11 | 1288, 2<<4 | 15, argp_rdyoib},                          // vpextrw (2234)
+	enc{[4]byte{0x3, 0x15, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, featset_AVX, 2<<11 | 1288, 2<<4 | 15, argp_rdyoib},                 // vpextrw (2235)
+	enc{[4]byte{0x1, 0xc5, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 3<<11 | 1288, 2<<4 | 15, argp_rqyoib},                          // vpextrw (2236)
+	enc{[4]byte{0x3, 0x15, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_MR, featset_AVX, 4<<11 | 1288, 2<<4 | 15, argp_rqyoib},                 // vpextrw (2237)
+	enc{[4]byte{0x2, 0x90, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, featset_AVX2, 0<<11 | 1289, 2<<4 | 15, argp_y0k0y0},    // vpgatherdd (2238)
+	enc{[4]byte{0x2, 0x90, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, featset_AVX2, 0<<11 | 1290, 2<<4 | 15, argp_y0loy0},    // vpgatherdq (2239)
+	enc{[4]byte{0x2, 0x91, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, featset_AVX2, 0<<11 | 1291, 2<<4 | 15, argp_yok0yo},    // vpgatherqd (2240)
+	enc{[4]byte{0x2, 0x91, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, featset_AVX2, 0<<11 | 1292, 2<<4 | 15, argp_y0l0y0},    // vpgatherqq (2241)
+	enc{[4]byte{0x9, 0xc2, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1293, 2<<4 | 15, argp_yowo},                                 // vphaddbd (2242)
+	enc{[4]byte{0x9, 0xc3, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1294, 2<<4 | 15, argp_yowo},                                 // vphaddbq (2243)
+	enc{[4]byte{0x9, 0xc1, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1295, 2<<4 | 15, argp_yowo},                                 // vphaddbw (2244)
+	enc{[4]byte{0x2, 0x2, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1296, 2<<4 | 15, argp_y0y0w0},               // vphaddd (2245)
+	enc{[4]byte{0x9, 0xcb, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1297, 2<<4 | 15, argp_yowo},                                 // vphadddq (2246)
+	enc{[4]byte{0x2, 0x3, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1298, 2<<4 | 15, argp_y0y0w0},               // vphaddsw (2247)
+	enc{[4]byte{0x9, 0xd2, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1299, 2<<4 | 15, argp_yowo},                                 // vphaddubd (2248)
+	enc{[4]byte{0x9, 0xd3, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1300, 2<<4 | 15, argp_yowo},                                 // vphaddubq (2249)
+	enc{[4]byte{0x9, 0xd1, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1301, 2<<4 | 15, argp_yowo},                                 // vphaddubw (2250)
+	enc{[4]byte{0x9, 0xdb, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1302, 2<<4 | 15, argp_yowo},                                 // vphaddudq (2251)
+	enc{[4]byte{0x9, 0xd6, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1303, 2<<4 | 15, argp_yowo},                                 // vphadduwd (2252)
+	enc{[4]byte{0x9, 0xd7, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1304, 2<<4 | 15, argp_yowo},                                 // vphadduwq (2253)
+	enc{[4]byte{0x2, 0x1, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1305, 2<<4 | 15, argp_y0y0w0},               // vphaddw (2254)
+	enc{[4]byte{0x9, 0xc6, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1306, 2<<4 | 15, argp_yowo},                                 // vphaddwd (2255)
+	enc{[4]byte{0x9, 0xc7, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1307, 2<<4 | 15, argp_yowo},                                 // vphaddwq (2256)
+	enc{[4]byte{0x2, 0x41, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 1308, 2<<4 | 15, argp_yowo},                            // vphminposuw (2257)
+	enc{[4]byte{0x9, 0xe1, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1309, 2<<4 | 15, argp_yowo},                                 // vphsubbw (2258)
+	enc{[4]byte{0x2, 0x6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1310, 2<<4 | 15, argp_y0y0w0},               // vphsubd (2259)
+	enc{[4]byte{0x9, 0xe3, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1311, 2<<4 | 15, argp_yowo},                                 // vphsubdq (2260)
+	enc{[4]byte{0x2, 0x7, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1312, 2<<4 | 15, argp_y0y0w0},               // vphsubsw (2261)
+	enc{[4]byte{0x2, 0x5, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1313, 2<<4 | 15, argp_y0y0w0},               // vphsubw (2262)
+	enc{[4]byte{0x9, 0xe2, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1314, 2<<4 | 15, argp_yowo},                                 // vphsubwd (2263)
+	enc{[4]byte{0x3, 0x20, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 1315, 2<<4 | 15, argp_yoyordib},                        // vpinsrb (2264)
+	enc{[4]byte{0x3, 0x20, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 1<<11 | 1315, 2<<4 | 15, argp_yoyovbib},                        // vpinsrb (2265)
+	enc{[4]byte{0x3, 0x22, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 1316, 2<<4 | 15, argp_yoyovdib},                        // vpinsrd (2266)
+	enc{[4]byte{0x3, 0x22, 0x00, 0x00}, VEX_OP | WITH_REXW | PREF_66, featset_AVX, 0<<11 | 1317, 2<<4 | 15, argp_yoyovqib},            // vpinsrq (2267)
+	enc{[4]byte{0x1, 0xc4, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 1318, 2<<4 | 15, argp_yoyordib},                        // vpinsrw (2268)
+	enc{[4]byte{0x1, 0xc4, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 1<<11 | 1318, 2<<4 | 15, argp_yoyovwib},                        // vpinsrw (2269)
+	enc{[4]byte{0x8, 0x9e, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1319, 2<<4 | 15, argp_yoyowoyo},                             // vpmacsdd (2270)
+	enc{[4]byte{0x8, 0x9f, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1320, 2<<4 | 15, argp_yoyowoyo},                             // vpmacsdqh (2271)
+	enc{[4]byte{0x8, 0x97, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1321, 2<<4 | 15, argp_yoyowoyo},                             // vpmacsdql (2272)
+	enc{[4]byte{0x8, 0x8e, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1322, 2<<4 | 15, argp_yoyowoyo},                             // vpmacssdd (2273)
+	enc{[4]byte{0x8, 0x8f, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1323, 2<<4 | 15, argp_yoyowoyo},                             // vpmacssdqh (2274)
+	enc{[4]byte{0x8, 0x87, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1324, 2<<4 | 15, argp_yoyowoyo},                             // vpmacssdql (2275)
+	enc{[4]byte{0x8, 0x86, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1325, 2<<4 | 15, argp_yoyowoyo},                             // vpmacsswd (2276)
+	enc{[4]byte{0x8, 0x85, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1326, 2<<4 | 15, argp_yoyowoyo},                             // vpmacssww (2277)
+	enc{[4]byte{0x8, 0x96, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1327, 2<<4 | 15, argp_yoyowoyo},                             // vpmacswd (2278)
+	enc{[4]byte{0x8, 0x95, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1328, 2<<4 | 15, argp_yoyowoyo},                             // vpmacsww (2279)
+	enc{[4]byte{0x8, 0xa6, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1329, 2<<4 | 15, argp_yoyowoyo},                             // vpmadcsswd (2280)
+	enc{[4]byte{0x8, 0xb6, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1330, 2<<4 | 15, argp_yoyowoyo},                             // vpmadcswd (2281)
+	enc{[4]byte{0x2, 0x4, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1331, 2<<4 | 15, argp_y0y0w0},               // vpmaddubsw (2282)
+	enc{[4]byte{0x1, 0xf5, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1332, 2<<4 | 15, argp_y0y0w0},              // vpmaddwd (2283)
+	enc{[4]byte{0x2, 0x8e, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_VM, featset_AVX2, 0<<11 | 1333, 2<<4 | 15, argp_m0y0y0},    // vpmaskmovd (2284)
+	enc{[4]byte{0x2, 0x8c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX2, 1<<11 | 1333, 2<<4 | 15, argp_y0y0m0},             // vpmaskmovd (2285)
+	enc{[4]byte{0x2, 0x8e, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_VM, featset_AVX2, 0<<11 | 1334, 2<<4 | 15, argp_m0y0y0},    // vpmaskmovq (2286)
+	enc{[4]byte{0x2, 0x8c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX2, 1<<11 | 1334, 2<<4 | 15, argp_y0y0m0},             // vpmaskmovq (2287)
+	enc{[4]byte{0x2, 0x3c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1335, 2<<4 | 15, argp_y0y0w0},              // vpmaxsb (2288)
+	enc{[4]byte{0x2, 0x3d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1336, 2<<4 | 15, argp_y0y0w0},              // vpmaxsd (2289)
+	enc{[4]byte{0x1, 0xee, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1337, 2<<4 | 15, argp_y0y0w0},              // vpmaxsw (2290)
+	enc{[4]byte{0x1, 0xde, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1338, 2<<4 | 15, argp_y0y0w0},              // vpmaxub (2291)
+	enc{[4]byte{0x2, 0x3f, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1339, 2<<4 | 15, argp_y0y0w0},              // vpmaxud (2292)
+	enc{[4]byte{0x2, 0x3e, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1340, 2<<4 | 15, argp_y0y0w0},              // vpmaxuw (2293)
+	enc{[4]byte{0x2, 0x38, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1341, 2<<4 | 15, argp_y0y0w0},              // vpminsb (2294)
+	enc{[4]byte{0x2, 0x39, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1342, 2<<4 | 15, argp_y0y0w0},              // vpminsd (2295)
+	enc{[4]byte{0x1, 0xea, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1343, 2<<4 | 15, argp_y0y0w0},              // vpminsw (2296)
+	enc{[4]byte{0x1, 0xda, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1344, 2<<4 | 15, argp_y0y0w0},              // vpminub (2297)
+	enc{[4]byte{0x2, 0x3b, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1345, 2<<4 | 15, argp_y0y0w0},              // vpminud (2298)
+	enc{[4]byte{0x2, 0x3a, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1346, 2<<4 | 15, argp_y0y0w0},              // vpminuw (2299)
+	enc{[4]byte{0x1, 0xd7, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 1347, 2<<4 | 15, argp_r0y0},                            // vpmovmskb (2300)
+	enc{[4]byte{0x2, 0x21, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 1348, 2<<4 | 15, argp_yomd},                            // vpmovsxbd (2301)
+	enc{[4]byte{0x2, 0x21, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 1<<11 | 1348, 2<<4 | 15, argp_y0yo},                // vpmovsxbd (2302)
+	enc{[4]byte{0x2, 0x22, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1349, 2<<4 | 15, argp_y0mw},                // vpmovsxbq (2303)
+	enc{[4]byte{0x2, 0x22, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 1<<11 | 1349, 2<<4 | 15, argp_y0yo},                // vpmovsxbq (2304)
+	enc{[4]byte{0x2, 0x20, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 1350, 2<<4 | 15, argp_yomq},                            // vpmovsxbw (2305)
+	enc{[4]byte{0x2, 0x20, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 1<<11 | 1350, 2<<4 | 15, argp_y0wo},                // vpmovsxbw (2306)
+	enc{[4]byte{0x2, 0x25, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 1351, 2<<4 | 15, argp_yomq},                            // vpmovsxdq (2307)
+	enc{[4]byte{0x2, 0x25, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 1<<11 | 1351, 2<<4 | 15, argp_y0wo},                // vpmovsxdq (2308)
+	enc{[4]byte{0x2, 0x23, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 1352, 2<<4 | 15, argp_yomq},                            // vpmovsxwd (2309)
+	enc{[4]byte{0x2, 0x23, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 1<<11 | 1352, 2<<4 | 15, argp_y0wo},                // vpmovsxwd (2310)
+	enc{[4]byte{0x2, 0x24, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 1353, 2<<4 | 15, argp_yomd},                            // vpmovsxwq (2311)
+	enc{[4]byte{0x2, 0x24, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 1<<11 | 1353, 2<<4 | 15, argp_y0yo},                // vpmovsxwq (2312)
+	enc{[4]byte{0x2, 0x31, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 1354, 2<<4 | 15, argp_yomd},                            // vpmovzxbd (2313)
+	enc{[4]byte{0x2, 0x31, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 1<<11 | 1354, 2<<4 | 15, argp_y0yo},                // vpmovzxbd (2314)
+	enc{[4]byte{0x2, 0x32, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1355, 2<<4 | 15, argp_y0mw},                // vpmovzxbq (2315)
+	enc{[4]byte{0x2, 0x32, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 1<<11 | 1355, 2<<4 | 15, argp_y0yo},                // vpmovzxbq (2316)
+	enc{[4]byte{0x2, 0x30, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 1356, 2<<4 | 15, argp_yomq},                            // vpmovzxbw (2317)
+	enc{[4]byte{0x2, 0x30, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 1<<11 | 1356, 2<<4 | 15, argp_y0wo},                // vpmovzxbw (2318)
+	enc{[4]byte{0x2, 0x35, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 1357, 2<<4 | 15, argp_yomq},                            // vpmovzxdq (2319)
+	enc{[4]byte{0x2, 0x35, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 1<<11 | 1357, 2<<4 | 15, argp_y0wo},                // vpmovzxdq (2320)
+	enc{[4]byte{0x2, 0x33, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 1358, 2<<4 | 15, argp_yomq},                            // vpmovzxwd (2321)
+	enc{[4]byte{0x2, 0x33, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 1<<11 | 1358, 2<<4 | 15, argp_y0wo},                // vpmovzxwd (2322)
+	enc{[4]byte{0x2, 0x34, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 1359, 2<<4 | 15, argp_yomd},                            // vpmovzxwq (2323)
+	enc{[4]byte{0x2, 0x34, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 1<<11 | 1359, 2<<4 | 15, argp_y0yo},                // vpmovzxwq (2324)
+	enc{[4]byte{0x2, 0x28, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1360, 2<<4 | 15, argp_y0y0w0},              // vpmuldq (2325)
+	enc{[4]byte{0x2, 0xb, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1361, 2<<4 | 15, argp_y0y0w0},               // vpmulhrsw (2326)
+	enc{[4]byte{0x1, 0xe4, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1362, 2<<4 | 15, argp_y0y0w0},              // vpmulhuw (2327)
+	enc{[4]byte{0x1, 0xe5, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1363, 2<<4 | 15, argp_y0y0w0},              // vpmulhw (2328)
+	enc{[4]byte{0x2, 0x40, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1364, 2<<4 | 15, argp_y0y0w0},              // vpmulld (2329)
+	enc{[4]byte{0x1, 0xd5, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1365, 2<<4 | 15, argp_y0y0w0},              // vpmullw (2330)
+	enc{[4]byte{0x1, 0xf4, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1366, 2<<4 | 15, argp_y0y0w0},              // vpmuludq (2331)
+	enc{[4]byte{0x1, 0xeb, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1367, 2<<4 | 15, argp_y0y0w0},              // vpor (2332)
+	enc{[4]byte{0x8, 0xa3, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1368, 2<<4 | 15, argp_yoyowoyo},                             // vpperm (2333)
+	enc{[4]byte{0x8, 0xa3, 0x00, 0x00}, XOP_OP | WITH_REXW, featset_SSE5_AMD, 1<<11 | 1368, 2<<4 | 15, argp_yoyoyowo},                 // vpperm (2334)
+	enc{[4]byte{0x8, 0xc0, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1369, 2<<4 | 15, argp_yowoib},                               // vprotb (2335)
+	enc{[4]byte{0x9, 0x90, 0x00, 0x00}, XOP_OP | ENC_MR, featset_SSE5_AMD, 1<<11 | 1369, 2<<4 | 15, argp_yowoyo},                      // vprotb (2336)
+	enc{[4]byte{0x9, 0x90, 0x00, 0x00}, XOP_OP | WITH_REXW, featset_SSE5_AMD, 2<<11 | 1369, 2<<4 | 15, argp_yoyowo},                   // vprotb (2337)
+	enc{[4]byte{0x8, 0xc2, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1370, 2<<4 | 15, argp_yowoib},                               // vprotd (2338)
+	enc{[4]byte{0x9, 0x92, 0x00, 0x00}, XOP_OP | ENC_MR, featset_SSE5_AMD, 1<<11 | 1370, 2<<4 | 15, argp_yowoyo},                      // vprotd (2339)
+	enc{[4]byte{0x9, 0x92, 0x00, 0x00}, XOP_OP | WITH_REXW, featset_SSE5_AMD, 2<<11 | 1370, 2<<4 | 15, argp_yoyowo},                   // vprotd (2340)
+	enc{[4]byte{0x8, 0xc3, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1371, 2<<4 | 15, argp_yowoib},                               // vprotq (2341)
+	enc{[4]byte{0x9, 0x93, 0x00, 0x00}, XOP_OP | ENC_MR, featset_SSE5_AMD, 1<<11 | 1371, 2<<4 | 15, argp_yowoyo},                      // vprotq (2342)
+	enc{[4]byte{0x9, 0x93, 0x00, 0x00}, XOP_OP | WITH_REXW, featset_SSE5_AMD, 2<<11 | 1371, 2<<4 | 15, argp_yoyowo},                   // vprotq (2343)
+	enc{[4]byte{0x8, 0xc1, 0x00, 0x00}, XOP_OP, featset_SSE5_AMD, 0<<11 | 1372, 2<<4 | 15, argp_yowoib},                               // vprotw (2344)
+	enc{[4]byte{0x9, 0x91, 0x00, 0x00}, XOP_OP | ENC_MR, featset_SSE5_AMD, 1<<11 | 1372, 2<<4 | 15, argp_yowoyo},                      // vprotw (2345)
+	enc{[4]byte{0x9, 0x91, 0x00, 0x00}, XOP_OP | WITH_REXW, featset_SSE5_AMD, 2<<11 | 1372, 2<<4 | 15, argp_yoyowo},                   // vprotw (2346)
+	enc{[4]byte{0x1, 0xf6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1373, 2<<4 | 15, argp_y0y0w0},              // vpsadbw (2347)
+	enc{[4]byte{0x9, 0x98, 0x00, 0x00}, XOP_OP | ENC_MR, featset_SSE5_AMD, 0<<11 | 1374, 2<<4 | 15, argp_yowoyo},                      // vpshab (2348)
+	enc{[4]byte{0x9, 0x98, 0x00, 0x00}, XOP_OP | WITH_REXW, featset_SSE5_AMD, 1<<11 | 1374, 2<<4 | 15, argp_yoyowo},                   // vpshab (2349)
+	enc{[4]byte{0x9, 0x9a, 0x00, 0x00}, XOP_OP | ENC_MR, featset_SSE5_AMD, 0<<11 | 1375, 2<<4 | 15, argp_yowoyo},                      // vpshad (2350)
+	enc{[4]byte{0x9, 0x9a, 0x00, 0x00}, XOP_OP | WITH_REXW, featset_SSE5_AMD, 1<<11 | 1375, 2<<4 | 15, argp_yoyowo},                   // vpshad (2351)
+	enc{[4]byte{0x9, 0x9b, 0x00, 0x00}, XOP_OP | ENC_MR, featset_SSE5_AMD, 0<<11 | 1376, 2<<4 | 15, argp_yowoyo},                      // vpshaq (2352)
+	enc{[4]byte{0x9, 0x9b, 0x00, 0x00}, XOP_OP | WITH_REXW, featset_SSE5_AMD, 1<<11 | 1376, 2<<4 | 15, argp_yoyowo},                   // vpshaq (2353)
+	enc{[4]byte{0x9, 0x99, 0x00, 0x00}, XOP_OP | ENC_MR, featset_SSE5_AMD, 0<<11 | 1377, 2<<4 | 15, argp_yowoyo},                      // vpshaw (2354)
+	enc{[4]byte{0x9, 0x99, 0x00, 0x00}, XOP_OP | WITH_REXW, featset_SSE5_AMD, 1<<11 | 1377, 2<<4 | 15, argp_yoyowo},                   // vpshaw (2355)
+	enc{[4]byte{0x9, 0x94, 0x00, 0x00}, XOP_OP | ENC_MR, featset_SSE5_AMD, 0<<11 | 1378, 2<<4 | 15, argp_yowoyo},                      // vpshlb (2356)
+	enc{[4]byte{0x9, 0x94, 0x00, 0x00}, XOP_OP | WITH_REXW, featset_SSE5_AMD, 1<<11 | 1378, 2<<4 | 15, argp_yoyowo},                   // vpshlb (2357)
+	enc{[4]byte{0x9, 0x96, 0x00, 0x00}, XOP_OP | ENC_MR, featset_SSE5_AMD, 0<<11 | 1379, 2<<4 | 15, argp_yowoyo},                      // vpshld (2358)
+	enc{[4]byte{0x9, 0x96, 0x00, 0x00}, XOP_OP | WITH_REXW, featset_SSE5_AMD, 1<<11 | 1379, 2<<4 | 15, argp_yoyowo},                   // vpshld (2359)
+	enc{[4]byte{0x9, 0x97, 0x00, 0x00}, XOP_OP | ENC_MR, featset_SSE5_AMD, 0<<11 | 1380, 2<<4 | 15, argp_yowoyo},                      // vpshlq (2360)
+	enc{[4]byte{0x9, 0x97, 0x00, 0x00}, XOP_OP | WITH_REXW, featset_SSE5_AMD, 1<<11 | 1380, 2<<4 | 15, argp_yoyowo},                   // vpshlq (2361)
+	enc{[4]byte{0x9, 0x95, 0x00, 0x00}, XOP_OP | ENC_MR, featset_SSE5_AMD, 0<<11 | 1381, 2<<4 | 15, argp_yowoyo},                      // vpshlw (2362)
+	enc{[4]byte{0x9, 0x95, 0x00, 0x00}, XOP_OP | WITH_REXW, featset_SSE5_AMD, 1<<11 | 1381, 2<<4 | 15, argp_yoyowo},                   // vpshlw (2363)
+	enc{[4]byte{0x2, 0x0, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1382, 2<<4 | 15, argp_y0y0w0},               // vpshufb (2364)
+	enc{[4]byte{0x1, 0x70, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1383, 2<<4 | 15, argp_y0w0ib},              // vpshufd (2365)
+	enc{[4]byte{0x1, 0x70, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F3, featset_AVX, 0<<11 | 1384, 2<<4 | 15, argp_y0w0ib},              // vpshufhw (2366)
+	enc{[4]byte{0x1, 0x70, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_F2, featset_AVX, 0<<11 | 1385, 2<<4 | 15, argp_y0w0ib},              // vpshuflw (2367)
+	enc{[4]byte{0x2, 0x8, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1386, 2<<4 | 15, argp_y0y0w0},               // vpsignb (2368)
+	enc{[4]byte{0x2, 0xa, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1387, 2<<4 | 15, argp_y0y0w0},               // vpsignd (2369)
+	enc{[4]byte{0x2, 0x9, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1388, 2<<4 | 15, argp_y0y0w0},               // vpsignw (2370)
+	enc{[4]byte{0x1, 0x72, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_VM, featset_AVX, 0<<11 | 1389, 2<<4 | 6, argp_y0y0ib},      // vpslld (2371)
+	enc{[4]byte{0x1, 0xf2, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 1<<11 | 1389, 2<<4 | 15, argp_y0y0wo},              // vpslld (2372)
+	enc{[4]byte{0x1, 0x73, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_VM, featset_AVX, 0<<11 | 1390, 2<<4 | 7, argp_y0y0ib},      // vpslldq (2373)
+	enc{[4]byte{0x1, 0x73, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_VM, featset_AVX, 0<<11 | 1391, 2<<4 | 6, argp_y0y0ib},      // vpsllq (2374)
+	enc{[4]byte{0x1, 0xf3, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 1<<11 | 1391, 2<<4 | 15, argp_y0y0wo},              // vpsllq (2375)
+	enc{[4]byte{0x2, 0x47, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX2, 0<<11 | 1392, 2<<4 | 15, argp_y0y0w0},             // vpsllvd (2376)
+	enc{[4]byte{0x2, 0x47, 0x00, 0x00}, VEX_OP | AUTO_VEXL | WITH_REXW | PREF_66, featset_AVX2, 0<<11 | 1393, 2<<4 | 15, argp_y0y0w0}, // vpsllvq (2377)
+	enc{[4]byte{0x1, 0x71, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_VM, featset_AVX, 0<<11 | 1394, 2<<4 | 6, argp_y0y0ib},      // vpsllw (2378)
+	enc{[4]byte{0x1, 0xf1, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 1<<11 | 1394, 2<<4 | 15, argp_y0y0wo},              // vpsllw (2379)
+	enc{[4]byte{0x1, 0x72, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_VM, featset_AVX, 0<<11 | 1395, 2<<4 | 4, argp_y0y0ib},      // vpsrad (2380)
+	enc{[4]byte{0x1, 0xe2, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 1<<11 | 1395, 2<<4 | 15, argp_y0y0wo},              // vpsrad (2381)
+	enc{[4]byte{0x2, 0x46, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX2, 0<<11 | 1396, 2<<4 | 15, argp_y0y0w0},             // vpsravd (2382)
+	enc{[4]byte{0x1, 0x71, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_VM, featset_AVX, 0<<11 | 1397, 2<<4 | 4, argp_y0y0ib},      // vpsraw (2383)
+	enc{[4]byte{0x1, 0xe1, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 1<<11 | 1397, 2<<4 | 15, argp_y0y0wo},              // vpsraw (2384)
+	enc{[4]byte{0x1, 0x72, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_VM, featset_AVX, 0<<11 | 1398, 2<<4 | 2, argp_y0y0ib},      // vpsrld (2385)
+	enc{[4]byte{0x1, 0xd2, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 1<<11 | 1398, 2<<4 | 15, argp_y0y0wo},              // vpsrld (2386)
+	enc{[4]byte{0x1, 0x73, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_VM, featset_AVX, 0<<11 | 1399, 2<<4 | 3, argp_y0y0ib},      // vpsrldq (2387)
+	enc{[4]byte{0x1, 0x73, 0x00, 0x00}, VEX_OP | PREF_66 | ENC_VM, featset_AVX, 0<<11 | 1400, 2<<4 | 2, argp_y0y0ib},                  // vpsrlq (2388)
+	enc{[4]byte{0x1, 0xd3, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 1<<11 | 1400, 2<<4 | 15, argp_y0y0wo},              // vpsrlq (2389)
+	enc{[4]byte{0x2, 0x45, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX2, 0<<11 | 1401, 2<<4 | 15, argp_y0y0w0},             // vpsrlvd (2390)
+	enc{[4]byte{0x2, 0x45, 0x00, 0x00}, VEX_OP | AUTO_VEXL | WITH_REXW | PREF_66, featset_AVX2, 0<<11 | 1402, 2<<4 | 15, argp_y0y0w0}, // vpsrlvq (2391)
+	enc{[4]byte{0x1, 0x71, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_VM, featset_AVX, 0<<11 | 1403, 2<<4 | 2, argp_y0y0ib},      // vpsrlw (2392)
+	enc{[4]byte{0x1, 0xd1, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 1<<11 | 1403, 2<<4 | 15, argp_y0y0wo},              // vpsrlw (2393)
+	enc{[4]byte{0x1, 0xf8, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1404, 2<<4 | 15, argp_y0y0w0},              // vpsubb (2394)
+	enc{[4]byte{0x1, 0xfa, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1405, 2<<4 | 15, argp_y0y0w0},              // vpsubd (2395)
+	enc{[4]byte{0x1, 0xfb, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1406, 2<<4 | 15, argp_y0y0w0},              // vpsubq (2396)
+	enc{[4]byte{0x1, 0xe8, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1407, 2<<4 | 15, argp_y0y0w0},              // vpsubsb (2397)
+	enc{[4]byte{0x1, 0xe9, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1408, 2<<4 | 15, argp_y0y0w0},              // vpsubsw (2398)
+	enc{[4]byte{0x1, 0xd8, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1409, 2<<4 | 15, argp_y0y0w0},              // vpsubusb (2399)
+	enc{[4]byte{0x1, 0xd9, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1410, 2<<4 | 15, argp_y0y0w0},              // vpsubusw (2400)
+	enc{[4]byte{0x1, 0xf9, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1411, 2<<4 | 15, argp_y0y0w0},              // vpsubw (2401)
+	enc{[4]byte{0x2, 0x17, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1412, 2<<4 | 15, argp_y0w0},                // vptest (2402)
+	enc{[4]byte{0x1, 0x68, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1413, 2<<4 | 15, argp_y0y0w0},              // vpunpckhbw (2403)
+	enc{[4]byte{0x1, 0x6a, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1414, 2<<4 | 15, argp_y0y0w0},              // vpunpckhdq (2404)
+	enc{[4]byte{0x1, 0x6d, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1415, 2<<4 | 15, argp_y0y0w0},              // vpunpckhqdq (2405)
+	enc{[4]byte{0x1, 0x69, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1416, 2<<4 | 15, argp_y0y0w0},              // vpunpckhwd (2406)
+	enc{[4]byte{0x1, 0x60, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1417, 2<<4 | 15, argp_y0y0w0},              // vpunpcklbw (2407)
+	enc{[4]byte{0x1, 0x62, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1418, 2<<4 | 15, argp_y0y0w0},              // vpunpckldq (2408)
+	enc{[4]byte{0x1, 0x6c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1419, 2<<4 | 15, argp_y0y0w0},              // vpunpcklqdq (2409)
+	enc{[4]byte{0x1, 0x61, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1420, 2<<4 | 15, argp_y0y0w0},              // vpunpcklwd (2410)
+	enc{[4]byte{0x1, 0xef, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1421, 2<<4 | 15, argp_y0y0w0},              // vpxor (2411)
+	enc{[4]byte{0x1, 0x53, 0x00, 0x00}, VEX_OP | AUTO_VEXL, featset_AVX, 0<<11 | 1422, 2<<4 | 15, argp_y0w0},                          // vrcpps (2412)
+	enc{[4]byte{0x1, 0x53, 0x00, 0x00}, VEX_OP | PREF_F3, featset_AVX, 0<<11 | 1423, 2<<4 | 15, argp_yoyomd},                          // vrcpss (2413)
+	enc{[4]byte{0x1, 0x53, 0x00, 0x00}, VEX_OP | PREF_F3, featset_AVX, 1<<11 | 1423, 2<<4 | 15, argp_yoyoyo},                          // vrcpss (2414)
+	enc{[4]byte{0x3, 0x9, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1424, 2<<4 | 15, argp_y0w0ib},               // vroundpd (2415)
+	enc{[4]byte{0x3, 0x8, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1425, 2<<4 | 15, argp_y0w0ib},               // vroundps (2416)
+	enc{[4]byte{0x3, 0xb, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 1426, 2<<4 | 15, argp_yoyomqib},                         // vroundsd (2417)
+	enc{[4]byte{0x3, 0xb, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 1<<11 | 1426, 2<<4 | 15, argp_yoyoyoib},                         // vroundsd (2418)
+	enc{[4]byte{0x3, 0xa, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 1427, 2<<4 | 15, argp_yoyomdib},                         // vroundss (2419)
+	enc{[4]byte{0x3, 0xa, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 1<<11 | 1427, 2<<4 | 15, argp_yoyoyoib},                         // vroundss (2420)
+	enc{[4]byte{0x1, 0x52, 0x00, 0x00}, VEX_OP | AUTO_VEXL, featset_AVX, 0<<11 | 1428, 2<<4 | 15, argp_y0w0},                          // vrsqrtps (2421)
+	enc{[4]byte{0x1, 0x52, 0x00, 0x00}, VEX_OP | PREF_F3, featset_AVX, 0<<11 | 1429, 2<<4 | 15, argp_yoyomd},                          // vrsqrtss (2422)
+	enc{[4]byte{0x1, 0x52, 0x00, 0x00}, VEX_OP | PREF_F3, featset_AVX, 1<<11 | 1429, 2<<4 | 15, argp_yoyoyo},                          // vrsqrtss (2423)
+	enc{[4]byte{0x1, 0xc6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66 | ENC_MR, featset_AVX, 0<<11 | 1430, 2<<4 | 15, argp_y0y0w0ib},   // vshufpd (2424)
+	enc{[4]byte{0x1, 0xc6, 0x00, 0x00}, VEX_OP | AUTO_VEXL | ENC_MR, featset_AVX, 0<<11 | 1431, 2<<4 | 15, argp_y0y0w0ib},             // vshufps (2425)
+	enc{[4]byte{0x1, 0x51, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1432, 2<<4 | 15, argp_y0w0},                // vsqrtpd (2426)
+	enc{[4]byte{0x1, 0x51, 0x00, 0x00}, VEX_OP | AUTO_VEXL, featset_AVX, 0<<11 | 1433, 2<<4 | 15, argp_y0w0},                          // vsqrtps (2427)
+	enc{[4]byte{0x1, 0x51, 0x00, 0x00}, VEX_OP | PREF_F2, featset_AVX, 0<<11 | 1434, 2<<4 | 15, argp_yoyomq},                          // vsqrtsd (2428)
+	enc{[4]byte{0x1, 0x51, 0x00, 0x00}, VEX_OP | PREF_F2, featset_AVX, 1<<11 | 1434, 2<<4 | 15, argp_yoyoyo},                          // vsqrtsd (2429)
+	enc{[4]byte{0x1, 0x51, 0x00, 0x00}, VEX_OP | PREF_F3, featset_AVX, 0<<11 | 1435, 2<<4 | 15, argp_yoyomd},                          // vsqrtss (2430)
+	enc{[4]byte{0x1, 0x51, 0x00, 0x00}, VEX_OP | PREF_F3, featset_AVX, 1<<11 | 1435, 2<<4 | 15, argp_yoyoyo},                          // vsqrtss (2431)
+	enc{[4]byte{0x1, 0xae, 0x00, 0x00}, VEX_OP, featset_AVX, 0<<11 | 1436, 2<<4 | 3, argp_md},                                         // vstmxcsr (2432)
+	enc{[4]byte{0x1, 0x5c, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1437, 2<<4 | 15, argp_y0y0w0},              // vsubpd (2433)
+	enc{[4]byte{0x1, 0x5c, 0x00, 0x00}, VEX_OP | AUTO_VEXL, featset_AVX, 0<<11 | 1438, 2<<4 | 15, argp_y0y0w0},                        // vsubps (2434)
+	enc{[4]byte{0x1, 0x5c, 0x00, 0x00}, VEX_OP | PREF_F2, featset_AVX, 0<<11 | 1439, 2<<4 | 15, argp_yoyomq},                          // vsubsd (2435)
+	enc{[4]byte{0x1, 0x5c, 0x00, 0x00}, VEX_OP | PREF_F2, featset_AVX, 1<<11 | 1439, 2<<4 | 15, argp_yoyoyo},                          // vsubsd (2436)
+	enc{[4]byte{0x1, 0x5c, 0x00, 0x00}, VEX_OP | PREF_F3, featset_AVX, 0<<11 | 1440, 2<<4 | 15, argp_yoyomd},                          // vsubss (2437)
+	enc{[4]byte{0x1, 0x5c, 0x00, 0x00}, VEX_OP | PREF_F3, featset_AVX, 1<<11 | 1440, 2<<4 | 15, argp_yoyoyo},                          // vsubss (2438)
+	enc{[4]byte{0x2, 0xf, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1441, 2<<4 | 15, argp_y0w0},                 // vtestpd (2439)
+	enc{[4]byte{0x2, 0xe, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1442, 2<<4 | 15, argp_y0w0},                 // vtestps (2440)
+	enc{[4]byte{0x1, 0x2e, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 0<<11 | 1443, 2<<4 | 15, argp_yomq},                            // vucomisd (2441)
+	enc{[4]byte{0x1, 0x2e, 0x00, 0x00}, VEX_OP | PREF_66, featset_AVX, 1<<11 | 1443, 2<<4 | 15, argp_yoyo},                            // vucomisd (2442)
+	enc{[4]byte{0x1, 0x2e, 0x00, 0x00}, VEX_OP, featset_AVX, 0<<11 | 1444, 2<<4 | 15, argp_yomd},                                      // vucomiss (2443)
+	enc{[4]byte{0x1, 0x2e, 0x00, 0x00}, VEX_OP, featset_AVX, 1<<11 | 1444, 2<<4 | 15, argp_yoyo},                                      // vucomiss (2444)
+	enc{[4]byte{0x1, 0x15, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1445, 2<<4 | 15, argp_y0y0w0},              // vunpckhpd (2445)
+	enc{[4]byte{0x1, 0x15, 0x00, 0x00}, VEX_OP | AUTO_VEXL, featset_AVX, 0<<11 | 1446, 2<<4 | 15, argp_y0y0w0},                        // vunpckhps (2446)
+	enc{[4]byte{0x1, 0x14, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1447, 2<<4 | 15, argp_y0y0w0},              // vunpcklpd (2447)
+	enc{[4]byte{0x1, 0x14, 0x00, 0x00}, VEX_OP | AUTO_VEXL, featset_AVX, 0<<11 | 1448, 2<<4 | 15, argp_y0y0w0},                        // vunpcklps (2448)
+	enc{[4]byte{0x1, 0x57, 0x00, 0x00}, VEX_OP | AUTO_VEXL | PREF_66, featset_AVX, 0<<11 | 1449, 2<<4 | 15, argp_y0y0w0},              // vxorpd (2449)
+	enc{[4]byte{0x1, 0x57, 0x00, 0x00}, VEX_OP | AUTO_VEXL, featset_AVX, 0<<11 | 1450, 2<<4 | 15, argp_y0y0w0},                        // vxorps (2450)
+	enc{[4]byte{0x1, 0x77, 0x00, 0x00}, VEX_OP | WITH_VEXL, featset_AVX, 0<<11 | 1451, 2<<4 | 15, argp_},                              // vzeroall (2451)
+	enc{[4]byte{0x1, 0x77, 0x00, 0x00}, VEX_OP, featset_AVX, 0<<11 | 1452, 2<<4 | 15, argp_},                                          // vzeroupper (2452)
 
 }
@@ -1,7 +1,7 @@
 package x64
 
 // THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT!
-// go build -o ./gen/gen ./gen/gen.go && ./gen/gen mnemonics > $output
+// go build -o ./gen/gen ./gen && ./gen/gen mnemonics > $output
 
 // Instruction-mnemonic constants
 //
@@ -162,1308 +162,1310 @@ const (
 	INVEPT           Inst = 150<<21 | 1<<16 | 280
 	INVLPG           Inst = 151<<21 | 1<<16 | 281
 	INVLPGA          Inst = 152<<21 | 2<<16 | 282
-	INVVPID          Inst = 153<<21 | 1<<16 | 284
-	IRET             Inst = 154<<21 | 1<<16 | 285
-	IRETD            Inst = 155<<21 | 1<<16 | 286
-	IRETQ            Inst = 156<<21 | 1<<16 | 287
-	IRETW            Inst = 157<<21 | 1<<16 | 288
-	JA               Inst = 158<<21 | 2<<16 | 289
-	JAE              Inst = 159<<21 | 2<<16 | 291
-	JB               Inst = 160<<21 | 2<<16 | 293
-	JBE              Inst = 161<<21 | 2<<16 | 295
-	JC               Inst = 162<<21 | 2<<16 | 297
-	JE               Inst = 163<<21 | 2<<16 | 299
-	JECXZ            Inst = 164<<21 | 1<<16 | 301
-	JG               Inst = 165<<21 | 2<<16 | 302
-	JGE              Inst = 166<<21 | 2<<16 | 304
-	JL               Inst = 167<<21 | 2<<16 | 306
-	JLE              Inst = 168<<21 | 2<<16 | 308
-	JMP              Inst = 169<<21 | 3<<16 | 310
-	JNA              Inst = 170<<21 | 2<<16 | 313
-	JNAE             Inst = 171<<21 | 2<<16 | 315
-	JNB              Inst = 172<<21 | 2<<16 | 317
-	JNBE             Inst = 173<<21 | 2<<16 | 319
-	JNC              Inst = 174<<21 | 2<<16 | 321
-	JNE              Inst = 175<<21 | 2<<16 | 323
-	JNG              Inst = 176<<21 | 2<<16 | 325
-	JNGE             Inst = 177<<21 | 2<<16 | 327
-	JNL              Inst = 178<<21 | 2<<16 | 329
-	JNLE             Inst = 179<<21 | 2<<16 | 331
-	JNO              Inst = 180<<21 | 2<<16 | 333
-	JNP              Inst = 181<<21 | 2<<16 | 335
-	JNS              Inst = 182<<21 | 2<<16 | 337
-	JNZ              Inst = 183<<21 | 2<<16 | 339
-	JO               Inst = 184<<21 | 2<<16 | 341
-	JP               Inst = 185<<21 | 2<<16 | 343
-	JPE              Inst = 186<<21 | 2<<16 | 345
-	JPO              Inst = 187<<21 | 2<<16 | 347
-	JRCXZ            Inst = 188<<21 | 1<<16 | 349
-	JS               Inst = 189<<21 | 2<<16 | 350
-	JZ               Inst = 190<<21 | 2<<16 | 352
-	LAHF             Inst = 191<<21 | 1<<16 | 354
-	LAR              Inst = 192<<21 | 2<<16 | 355
-	LDDQU            Inst = 193<<21 | 1<<16 | 357
-	LDMXCSR          Inst = 194<<21 | 1<<16 | 358
-	LEA              Inst = 195<<21 | 1<<16 | 359
-	LEAVE            Inst = 196<<21 | 1<<16 | 360
-	LFENCE           Inst = 197<<21 | 1<<16 | 361
-	LFS              Inst = 198<<21 | 1<<16 | 362
-	LGDT             Inst = 199<<21 | 1<<16 | 363
-	LGS              Inst = 200<<21 | 1<<16 | 364
-	LIDT             Inst = 201<<21 | 1<<16 | 365
-	LLDT             Inst = 202<<21 | 2<<16 | 366
-	LLWPCB           Inst = 203<<21 | 1<<16 | 368
-	LMSW             Inst = 204<<21 | 2<<16 | 369
-	LODSB            Inst = 205<<21 | 1<<16 | 371
-	LODSD            Inst = 206<<21 | 1<<16 | 372
-	LODSQ            Inst = 207<<21 | 1<<16 | 373
-	LODSW            Inst = 208<<21 | 1<<16 | 374
-	LOOP             Inst = 209<<21 | 1<<16 | 375
-	LOOPE            Inst = 210<<21 | 1<<16 | 376
-	LOOPNE           Inst = 211<<21 | 1<<16 | 377
-	LOOPNZ           Inst = 212<<21 | 1<<16 | 378
-	LOOPZ            Inst = 213<<21 | 1<<16 | 379
-	LSL              Inst = 214<<21 | 2<<16 | 380
-	LSS              Inst = 215<<21 | 1<<16 | 382
-	LTR              Inst = 216<<21 | 2<<16 | 383
-	LWPINS           Inst = 217<<21 | 1<<16 | 385
-	LWPVAL           Inst = 218<<21 | 1<<16 | 386
-	LZCNT            Inst = 219<<21 | 1<<16 | 387
-	MASKMOVDQU       Inst = 220<<21 | 1<<16 | 388
-	MASKMOVQ         Inst = 221<<21 | 1<<16 | 389
-	MAXSD            Inst = 222<<21 | 2<<16 | 390
-	MAXSS            Inst = 223<<21 | 2<<16 | 392
-	MFENCE           Inst = 224<<21 | 1<<16 | 394
-	MINSD            Inst = 225<<21 | 2<<16 | 395
-	MINSS            Inst = 226<<21 | 2<<16 | 397
-	MONITOR          Inst = 227<<21 | 2<<16 | 399
-	MONITORX         Inst = 228<<21 | 2<<16 | 401
-	MOV              Inst = 229<<21 | 26<<16 | 403
-	MOVABS           Inst = 230<<21 | 8<<16 | 429
-	MOVAPD           Inst = 231<<21 | 4<<16 | 437
-	MOVAPS           Inst = 232<<21 | 2<<16 | 441
-	MOVBE            Inst = 233<<21 | 2<<16 | 443
-	MOVD             Inst = 234<<21 | 8<<16 | 445
-	MOVDDUP          Inst = 235<<21 | 2<<16 | 453
-	MOVDQ2Q          Inst = 236<<21 | 1<<16 | 455
-	MOVDQA           Inst = 237<<21 | 4<<16 | 456
-	MOVDQU           Inst = 238<<21 | 4<<16 | 460
-	MOVHLPS          Inst = 239<<21 | 1<<16 | 464
-	MOVHPD           Inst = 240<<21 | 2<<16 | 465
-	MOVHPS           Inst = 241<<21 | 2<<16 | 467
-	MOVLHPS          Inst = 242<<21 | 1<<16 | 469
-	MOVLPD           Inst = 243<<21 | 2<<16 | 470
-	MOVLPS           Inst = 244<<21 | 2<<16 | 472
-	MOVMSKPD         Inst = 245<<21 | 2<<16 | 474
-	MOVMSKPS         Inst = 246<<21 | 2<<16 | 476
-	MOVNTDQ          Inst = 247<<21 | 1<<16 | 478
-	MOVNTDQA         Inst = 248<<21 | 1<<16 | 479
-	MOVNTI           Inst = 249<<21 | 2<<16 | 480
-	MOVNTPD          Inst = 250<<21 | 1<<16 | 482
-	MOVNTPS          Inst = 251<<21 | 1<<16 | 483
-	MOVNTQ           Inst = 252<<21 | 1<<16 | 484
-	MOVNTSD          Inst = 253<<21 | 1<<16 | 485
-	MOVNTSS          Inst = 254<<21 | 1<<16 | 486
-	MOVQ             Inst = 255<<21 | 10<<16 | 487
-	MOVQ2DQ          Inst = 256<<21 | 1<<16 | 497
-	MOVSB            Inst = 257<<21 | 1<<16 | 498
-	MOVSD            Inst = 258<<21 | 5<<16 | 499
-	MOVSHDUP         Inst = 259<<21 | 2<<16 | 504
-	MOVSLDUP         Inst = 260<<21 | 2<<16 | 506
-	MOVSQ            Inst = 261<<21 | 1<<16 | 508
-	MOVSS            Inst = 262<<21 | 3<<16 | 509
-	MOVSW            Inst = 263<<21 | 1<<16 | 512
-	MOVSX            Inst = 264<<21 | 4<<16 | 513
-	MOVSXD           Inst = 265<<21 | 1<<16 | 517
-	MOVUPD           Inst = 266<<21 | 4<<16 | 518
-	MOVUPS           Inst = 267<<21 | 2<<16 | 522
-	MOVZX            Inst = 268<<21 | 3<<16 | 524
-	MPSADBW          Inst = 269<<21 | 2<<16 | 527
-	MUL              Inst = 270<<21 | 2<<16 | 529
-	MULSD            Inst = 271<<21 | 2<<16 | 531
-	MULSS            Inst = 272<<21 | 2<<16 | 533
-	MULX             Inst = 273<<21 | 1<<16 | 535
-	MWAIT            Inst = 274<<21 | 2<<16 | 536
-	MWAITX           Inst = 275<<21 | 2<<16 | 538
-	NEG              Inst = 276<<21 | 4<<16 | 540
-	NOP              Inst = 277<<21 | 2<<16 | 544
-	NOT              Inst = 278<<21 | 4<<16 | 546
-	OR               Inst = 279<<21 | 14<<16 | 550
-	OUT              Inst = 280<<21 | 6<<16 | 564
-	OUTSB            Inst = 281<<21 | 1<<16 | 570
-	OUTSD            Inst = 282<<21 | 1<<16 | 571
-	OUTSW            Inst = 283<<21 | 1<<16 | 572
-	PAUSE            Inst = 284<<21 | 1<<16 | 573
-	POP              Inst = 285<<21 | 4<<16 | 574
-	POPCNT           Inst = 286<<21 | 1<<16 | 578
-	POPF             Inst = 287<<21 | 1<<16 | 579
-	POPFQ            Inst = 288<<21 | 1<<16 | 580
-	POPFW            Inst = 289<<21 | 1<<16 | 581
-	PREFETCH         Inst = 290<<21 | 1<<16 | 582
-	PREFETCHNTA      Inst = 291<<21 | 1<<16 | 583
-	PREFETCHT0       Inst = 292<<21 | 1<<16 | 584
-	PREFETCHT1       Inst = 293<<21 | 1<<16 | 585
-	PREFETCHT2       Inst = 294<<21 | 1<<16 | 586
-	PREFETCHW        Inst = 295<<21 | 1<<16 | 587
-	PUSH             Inst = 296<<21 | 7<<16 | 588
-	PUSHF            Inst = 297<<21 | 1<<16 | 595
-	PUSHFQ           Inst = 298<<21 | 1<<16 | 596
-	PUSHFW           Inst = 299<<21 | 1<<16 | 597
-	RCL              Inst = 300<<21 | 4<<16 | 598
-	RCPSS            Inst = 301<<21 | 2<<16 | 602
-	RCR              Inst = 302<<21 | 4<<16 | 604
-	RDFSBASE         Inst = 303<<21 | 2<<16 | 608
-	RDGSBASE         Inst = 304<<21 | 2<<16 | 610
-	RDMSR            Inst = 305<<21 | 1<<16 | 612
-	RDPID            Inst = 306<<21 | 1<<16 | 613
-	RDPKRU           Inst = 307<<21 | 1<<16 | 614
-	RDPMC            Inst = 308<<21 | 1<<16 | 615
-	RDRAND           Inst = 309<<21 | 1<<16 | 616
-	RDSEED           Inst = 310<<21 | 1<<16 | 617
-	RDTSC            Inst = 311<<21 | 1<<16 | 618
-	RDTSCP           Inst = 312<<21 | 1<<16 | 619
-	RET              Inst = 313<<21 | 2<<16 | 620
-	RETF             Inst = 314<<21 | 2<<16 | 622
-	RETN             Inst = 315<<21 | 2<<16 | 624
-	ROL              Inst = 316<<21 | 4<<16 | 626
-	ROR              Inst = 317<<21 | 4<<16 | 630
-	RORX             Inst = 318<<21 | 1<<16 | 634
-	ROUNDSD          Inst = 319<<21 | 2<<16 | 635
-	ROUNDSS          Inst = 320<<21 | 2<<16 | 637
-	RSM              Inst = 321<<21 | 1<<16 | 639
-	RSQRTSS          Inst = 322<<21 | 2<<16 | 640
-	SAHF             Inst = 323<<21 | 1<<16 | 642
-	SAL              Inst = 324<<21 | 4<<16 | 643
-	SAR              Inst = 325<<21 | 4<<16 | 647
-	SARX             Inst = 326<<21 | 1<<16 | 651
-	SBB              Inst = 327<<21 | 14<<16 | 652
-	SCASB            Inst = 328<<21 | 1<<16 | 666
-	SCASD            Inst = 329<<21 | 1<<16 | 667
-	SCASQ            Inst = 330<<21 | 1<<16 | 668
-	SCASW            Inst = 331<<21 | 1<<16 | 669
-	SETA             Inst = 332<<21 | 1<<16 | 670
-	SETAE            Inst = 333<<21 | 1<<16 | 671
-	SETB             Inst = 334<<21 | 1<<16 | 672
-	SETBE            Inst = 335<<21 | 1<<16 | 673
-	SETC             Inst = 336<<21 | 1<<16 | 674
-	SETE             Inst = 337<<21 | 1<<16 | 675
-	SETG             Inst = 338<<21 | 1<<16 | 676
-	SETGE            Inst = 339<<21 | 1<<16 | 677
-	SETL             Inst = 340<<21 | 1<<16 | 678
-	SETLE            Inst = 341<<21 | 1<<16 | 679
-	SETNA            Inst = 342<<21 | 1<<16 | 680
-	SETNAE           Inst = 343<<21 | 1<<16 | 681
-	SETNB            Inst = 344<<21 | 1<<16 | 682
-	SETNBE           Inst = 345<<21 | 1<<16 | 683
-	SETNC            Inst = 346<<21 | 1<<16 | 684
-	SETNE            Inst = 347<<21 | 1<<16 | 685
-	SETNG            Inst = 348<<21 | 1<<16 | 686
-	SETNGE           Inst = 349<<21 | 1<<16 | 687
-	SETNL            Inst = 350<<21 | 1<<16 | 688
-	SETNLE           Inst = 351<<21 | 1<<16 | 689
-	SETNO            Inst = 352<<21 | 1<<16 | 690
-	SETNP            Inst = 353<<21 | 1<<16 | 691
-	SETNS            Inst = 354<<21 | 1<<16 | 692
-	SETNZ            Inst = 355<<21 | 1<<16 | 693
-	SETO             Inst = 356<<21 | 1<<16 | 694
-	SETP             Inst = 357<<21 | 1<<16 | 695
-	SETPE            Inst = 358<<21 | 1<<16 | 696
-	SETPO            Inst = 359<<21 | 1<<16 | 697
-	SETS             Inst = 360<<21 | 1<<16 | 698
-	SETZ             Inst = 361<<21 | 1<<16 | 699
-	SFENCE           Inst = 362<<21 | 1<<16 | 700
-	SGDT             Inst = 363<<21 | 1<<16 | 701
-	SHL              Inst = 364<<21 | 4<<16 | 702
-	SHLD             Inst = 365<<21 | 2<<16 | 706
-	SHLX             Inst = 366<<21 | 1<<16 | 708
-	SHR              Inst = 367<<21 | 4<<16 | 709
-	SHRD             Inst = 368<<21 | 2<<16 | 713
-	SHRX             Inst = 369<<21 | 1<<16 | 715
-	SIDT             Inst = 370<<21 | 1<<16 | 716
-	SKINIT           Inst = 371<<21 | 1<<16 | 717
-	SLDT             Inst = 372<<21 | 2<<16 | 718
-	SLWPCB           Inst = 373<<21 | 1<<16 | 720
-	SMSW             Inst = 374<<21 | 2<<16 | 721
-	SQRTSD           Inst = 375<<21 | 2<<16 | 723
-	SQRTSS           Inst = 376<<21 | 2<<16 | 725
-	STAC             Inst = 377<<21 | 1<<16 | 727
-	STC              Inst = 378<<21 | 1<<16 | 728
-	STD              Inst = 379<<21 | 1<<16 | 729
-	STGI             Inst = 380<<21 | 1<<16 | 730
-	STI              Inst = 381<<21 | 1<<16 | 731
-	STMXCSR          Inst = 382<<21 | 1<<16 | 732
-	STOSB            Inst = 383<<21 | 1<<16 | 733
-	STOSD            Inst = 384<<21 | 1<<16 | 734
-	STOSQ            Inst = 385<<21 | 1<<16 | 735
-	STOSW            Inst = 386<<21 | 1<<16 | 736
-	STR              Inst = 387<<21 | 2<<16 | 737
-	SUB              Inst = 388<<21 | 14<<16 | 739
-	SUBSD            Inst = 389<<21 | 2<<16 | 753
-	SUBSS            Inst = 390<<21 | 2<<16 | 755
-	SWAPGS           Inst = 391<<21 | 1<<16 | 757
-	SYSCALL          Inst = 392<<21 | 1<<16 | 758
-	SYSRET           Inst = 393<<21 | 1<<16 | 759
-	T1MSKC           Inst = 394<<21 | 1<<16 | 760
-	TEST             Inst = 395<<21 | 8<<16 | 761
-	TZCNT            Inst = 396<<21 | 1<<16 | 769
-	TZMSK            Inst = 397<<21 | 1<<16 | 770
-	UCOMISD          Inst = 398<<21 | 2<<16 | 771
-	UCOMISS          Inst = 399<<21 | 2<<16 | 773
-	UD2              Inst = 400<<21 | 1<<16 | 775
-	UD2A             Inst = 401<<21 | 1<<16 | 776
-	WBINVD           Inst = 402<<21 | 1<<16 | 777
-	WRFSBASE         Inst = 403<<21 | 2<<16 | 778
-	WRGSBASE         Inst = 404<<21 | 2<<16 | 780
-	WRMSR            Inst = 405<<21 | 1<<16 | 782
-	WRPKRU           Inst = 406<<21 | 1<<16 | 783
-	XOR              Inst = 407<<21 | 14<<16 | 784
-	AESDEC           Inst = 408<<21 | 1<<16 | 798
-	AESDECLAST       Inst = 409<<21 | 1<<16 | 799
-	AESENC           Inst = 410<<21 | 1<<16 | 800
-	AESENCLAST       Inst = 411<<21 | 1<<16 | 801
-	AESIMC           Inst = 412<<21 | 1<<16 | 802
-	AESKEYGENASSIST  Inst = 413<<21 | 1<<16 | 803
-	SHA1MSG1         Inst = 414<<21 | 1<<16 | 804
-	SHA1MSG2         Inst = 415<<21 | 1<<16 | 805
-	SHA1NEXTE        Inst = 416<<21 | 1<<16 | 806
-	SHA1RNDS4        Inst = 417<<21 | 1<<16 | 807
-	SHA256MSG1       Inst = 418<<21 | 1<<16 | 808
-	SHA256MSG2       Inst = 419<<21 | 1<<16 | 809
-	SHA256RNDS2      Inst = 420<<21 | 1<<16 | 810
-	XABORT           Inst = 421<<21 | 1<<16 | 811
-	XADD             Inst = 422<<21 | 4<<16 | 812
-	XBEGIN           Inst = 423<<21 | 1<<16 | 816
-	XCHG             Inst = 424<<21 | 10<<16 | 817
-	XEND             Inst = 425<<21 | 1<<16 | 827
-	XGETBV           Inst = 426<<21 | 1<<16 | 828
-	XLAT             Inst = 427<<21 | 1<<16 | 829
-	XLATB            Inst = 428<<21 | 1<<16 | 830
-	XRSTOR           Inst = 429<<21 | 1<<16 | 831
-	XRSTOR64         Inst = 430<<21 | 1<<16 | 832
-	XRSTORS64        Inst = 431<<21 | 1<<16 | 833
-	XSAVE            Inst = 432<<21 | 1<<16 | 834
-	XSAVE64          Inst = 433<<21 | 1<<16 | 835
-	XSAVEC64         Inst = 434<<21 | 1<<16 | 836
-	XSAVEOPT64       Inst = 435<<21 | 1<<16 | 837
-	XSAVES64         Inst = 436<<21 | 1<<16 | 838
-	XSETBV           Inst = 437<<21 | 1<<16 | 839
-	XTEST            Inst = 438<<21 | 1<<16 | 840
-	F2XM1            Inst = 439<<21 | 1<<16 | 841
-	FABS             Inst = 440<<21 | 1<<16 | 842
-	FADD             Inst = 441<<21 | 7<<16 | 843
-	FADDP            Inst = 442<<21 | 3<<16 | 850
-	FBLD             Inst = 443<<21 | 1<<16 | 853
-	FBSTP            Inst = 444<<21 | 1<<16 | 854
-	FCHS             Inst = 445<<21 | 1<<16 | 855
-	FCLEX            Inst = 446<<21 | 1<<16 | 856
-	FCMOVB           Inst = 447<<21 | 3<<16 | 857
-	FCMOVBE          Inst = 448<<21 | 3<<16 | 860
-	FCMOVE           Inst = 449<<21 | 3<<16 | 863
-	FCMOVNB          Inst = 450<<21 | 3<<16 | 866
-	FCMOVNBE         Inst = 451<<21 | 3<<16 | 869
-	FCMOVNE          Inst = 452<<21 | 3<<16 | 872
-	FCMOVNU          Inst = 453<<21 | 3<<16 | 875
-	FCMOVU           Inst = 454<<21 | 3<<16 | 878
-	FCOM             Inst = 455<<21 | 5<<16 | 881
-	FCOMI            Inst = 456<<21 | 3<<16 | 886
-	FCOMIP           Inst = 457<<21 | 3<<16 | 889
-	FCOMP            Inst = 458<<21 | 5<<16 | 892
-	FCOMPP           Inst = 459<<21 | 1<<16 | 897
-	FCOS             Inst = 460<<21 | 1<<16 | 898
-	FDECSTP          Inst = 461<<21 | 1<<16 | 899
-	FDISI            Inst = 462<<21 | 1<<16 | 900
-	FDIV             Inst = 463<<21 | 7<<16 | 901
-	FDIVP            Inst = 464<<21 | 3<<16 | 908
-	FDIVR            Inst = 465<<21 | 7<<16 | 911
-	FDIVRP           Inst = 466<<21 | 3<<16 | 918
-	FEMMS            Inst = 467<<21 | 1<<16 | 921
-	FENI             Inst = 468<<21 | 1<<16 | 922
-	FFREE            Inst = 469<<21 | 2<<16 | 923
-	FIADD            Inst = 470<<21 | 2<<16 | 925
-	FICOM            Inst = 471<<21 | 2<<16 | 927
-	FICOMP           Inst = 472<<21 | 2<<16 | 929
-	FIDIV            Inst = 473<<21 | 2<<16 | 931
-	FIDIVR           Inst = 474<<21 | 2<<16 | 933
-	FILD             Inst = 475<<21 | 3<<16 | 935
-	FIMUL            Inst = 476<<21 | 2<<16 | 938
-	FINCSTP          Inst = 477<<21 | 1<<16 | 940
-	FINIT            Inst = 478<<21 | 1<<16 | 941
-	FIST             Inst = 479<<21 | 2<<16 | 942
-	FISTP            Inst = 480<<21 | 3<<16 | 944
-	FISTTP           Inst = 481<<21 | 3<<16 | 947
-	FISUB            Inst = 482<<21 | 2<<16 | 950
-	FISUBR           Inst = 483<<21 | 2<<16 | 952
-	FLD              Inst = 484<<21 | 5<<16 | 954
-	FLD1             Inst = 485<<21 | 1<<16 | 959
-	FLDCW            Inst = 486<<21 | 1<<16 | 960
-	FLDENV           Inst = 487<<21 | 1<<16 | 961
-	FLDL2E           Inst = 488<<21 | 1<<16 | 962
-	FLDL2T           Inst = 489<<21 | 1<<16 | 963
-	FLDLG2           Inst = 490<<21 | 1<<16 | 964
-	FLDLN2           Inst = 491<<21 | 1<<16 | 965
-	FLDPI            Inst = 492<<21 | 1<<16 | 966
-	FLDZ             Inst = 493<<21 | 1<<16 | 967
-	FMUL             Inst = 494<<21 | 7<<16 | 968
-	FMULP            Inst = 495<<21 | 3<<16 | 975
-	FNCLEX           Inst = 496<<21 | 1<<16 | 978
-	FNDISI           Inst = 497<<21 | 1<<16 | 979
-	FNENI            Inst = 498<<21 | 1<<16 | 980
-	FNINIT           Inst = 499<<21 | 1<<16 | 981
-	FNOP             Inst = 500<<21 | 1<<16 | 982
-	FNSAVE           Inst = 501<<21 | 1<<16 | 983
-	FNSTCW           Inst = 502<<21 | 1<<16 | 984
-	FNSTENV          Inst = 503<<21 | 1<<16 | 985
-	FNSTSW           Inst = 504<<21 | 2<<16 | 986
-	FPATAN           Inst = 505<<21 | 1<<16 | 988
-	FPREM            Inst = 506<<21 | 1<<16 | 989
-	FPREM1           Inst = 507<<21 | 1<<16 | 990
-	FPTAN            Inst = 508<<21 | 1<<16 | 991
-	FRNDINT          Inst = 509<<21 | 1<<16 | 992
-	FRSTOR           Inst = 510<<21 | 1<<16 | 993
-	FSAVE            Inst = 511<<21 | 1<<16 | 994
-	FSCALE           Inst = 512<<21 | 1<<16 | 995
-	FSETPM           Inst = 513<<21 | 1<<16 | 996
-	FSIN             Inst = 514<<21 | 1<<16 | 997
-	FSINCOS          Inst = 515<<21 | 1<<16 | 998
-	FSQRT            Inst = 516<<21 | 1<<16 | 999
-	FST              Inst = 517<<21 | 4<<16 | 1000
-	FSTCW            Inst = 518<<21 | 1<<16 | 1004
-	FSTENV           Inst = 519<<21 | 1<<16 | 1005
-	FSTP             Inst = 520<<21 | 5<<16 | 1006
-	FSTSW            Inst = 521<<21 | 2<<16 | 1011
-	FSUB             Inst = 522<<21 | 7<<16 | 1013
-	FSUBP            Inst = 523<<21 | 3<<16 | 1020
-	FSUBR            Inst = 524<<21 | 7<<16 | 1023
-	FSUBRP           Inst = 525<<21 | 3<<16 | 1030
-	FTST             Inst = 526<<21 | 1<<16 | 1033
-	FUCOM            Inst = 527<<21 | 3<<16 | 1034
-	FUCOMI           Inst = 528<<21 | 3<<16 | 1037
-	FUCOMIP          Inst = 529<<21 | 3<<16 | 1040
-	FUCOMP           Inst = 530<<21 | 3<<16 | 1043
-	FUCOMPP          Inst = 531<<21 | 1<<16 | 1046
-	FWAIT            Inst = 532<<21 | 1<<16 | 1047
-	FXAM             Inst = 533<<21 | 1<<16 | 1048
-	FXCH             Inst = 534<<21 | 4<<16 | 1049
-	FXRSTOR          Inst = 535<<21 | 1<<16 | 1053
-	FXRSTOR64        Inst = 536<<21 | 1<<16 | 1054
-	FXSAVE           Inst = 537<<21 | 1<<16 | 1055
-	FXSAVE64         Inst = 538<<21 | 1<<16 | 1056
-	FXTRACT          Inst = 539<<21 | 1<<16 | 1057
-	FYL2X            Inst = 540<<21 | 1<<16 | 1058
-	FYL2XP1          Inst = 541<<21 | 1<<16 | 1059
-	ADDPD            Inst = 542<<21 | 1<<16 | 1060
-	ADDPS            Inst = 543<<21 | 1<<16 | 1061
-	ADDSUBPD         Inst = 544<<21 | 1<<16 | 1062
-	ADDSUBPS         Inst = 545<<21 | 1<<16 | 1063
-	ANDNPD           Inst = 546<<21 | 1<<16 | 1064
-	ANDNPS           Inst = 547<<21 | 1<<16 | 1065
-	ANDPD            Inst = 548<<21 | 1<<16 | 1066
-	ANDPS            Inst = 549<<21 | 1<<16 | 1067
-	BLENDPD          Inst = 550<<21 | 2<<16 | 1068
-	BLENDPS          Inst = 551<<21 | 2<<16 | 1070
-	BLENDVPD         Inst = 552<<21 | 2<<16 | 1072
-	BLENDVPS         Inst = 553<<21 | 2<<16 | 1074
-	CMPEQPD          Inst = 554<<21 | 1<<16 | 1076
-	CMPEQPS          Inst = 555<<21 | 1<<16 | 1077
-	CMPLEPD          Inst = 556<<21 | 1<<16 | 1078
-	CMPLEPS          Inst = 557<<21 | 1<<16 | 1079
-	CMPLTPD          Inst = 558<<21 | 1<<16 | 1080
-	CMPLTPS          Inst = 559<<21 | 1<<16 | 1081
-	CMPNEQPD         Inst = 560<<21 | 1<<16 | 1082
-	CMPNEQPS         Inst = 561<<21 | 1<<16 | 1083
-	CMPNLEPD         Inst = 562<<21 | 1<<16 | 1084
-	CMPNLEPS         Inst = 563<<21 | 1<<16 | 1085
-	CMPNLTPD         Inst = 564<<21 | 1<<16 | 1086
-	CMPNLTPS         Inst = 565<<21 | 1<<16 | 1087
-	CMPORDPD         Inst = 566<<21 | 1<<16 | 1088
-	CMPORDPS         Inst = 567<<21 | 1<<16 | 1089
-	CMPPD            Inst = 568<<21 | 1<<16 | 1090
-	CMPPS            Inst = 569<<21 | 2<<16 | 1091
-	CMPUNORDPD       Inst = 570<<21 | 1<<16 | 1093
-	CMPUNORDPS       Inst = 571<<21 | 1<<16 | 1094
-	CVTDQ2PD         Inst = 572<<21 | 2<<16 | 1095
-	CVTDQ2PS         Inst = 573<<21 | 1<<16 | 1097
-	CVTPD2PS         Inst = 574<<21 | 1<<16 | 1098
-	CVTPI2PD         Inst = 575<<21 | 1<<16 | 1099
-	CVTPI2PS         Inst = 576<<21 | 1<<16 | 1100
-	CVTPS2PD         Inst = 577<<21 | 2<<16 | 1101
-	DIVPD            Inst = 578<<21 | 1<<16 | 1103
-	DIVPS            Inst = 579<<21 | 1<<16 | 1104
-	DPPD             Inst = 580<<21 | 2<<16 | 1105
-	DPPS             Inst = 581<<21 | 2<<16 | 1107
-	EXTRACTPS        Inst = 582<<21 | 2<<16 | 1109
-	HADDPD           Inst = 583<<21 | 1<<16 | 1111
-	HADDPS           Inst = 584<<21 | 1<<16 | 1112
-	HSUBPD           Inst = 585<<21 | 1<<16 | 1113
-	HSUBPS           Inst = 586<<21 | 1<<16 | 1114
-	INSERTPS         Inst = 587<<21 | 2<<16 | 1115
-	MAXPD            Inst = 588<<21 | 1<<16 | 1117
-	MAXPS            Inst = 589<<21 | 1<<16 | 1118
-	MINPD            Inst = 590<<21 | 1<<16 | 1119
-	MINPS            Inst = 591<<21 | 1<<16 | 1120
-	MULPD            Inst = 592<<21 | 1<<16 | 1121
-	MULPS            Inst = 593<<21 | 1<<16 | 1122
-	ORPD             Inst = 594<<21 | 1<<16 | 1123
-	ORPS             Inst = 595<<21 | 1<<16 | 1124
-	RCPPS            Inst = 596<<21 | 1<<16 | 1125
-	ROUNDPD          Inst = 597<<21 | 2<<16 | 1126
-	ROUNDPS          Inst = 598<<21 | 2<<16 | 1128
-	RSQRTPS          Inst = 599<<21 | 1<<16 | 1130
-	SHUFPD           Inst = 600<<21 | 1<<16 | 1131
-	SHUFPS           Inst = 601<<21 | 1<<16 | 1132
-	SQRTPD           Inst = 602<<21 | 1<<16 | 1133
-	SQRTPS           Inst = 603<<21 | 1<<16 | 1134
-	SUBPD            Inst = 604<<21 | 1<<16 | 1135
-	SUBPS            Inst = 605<<21 | 1<<16 | 1136
-	UNPCKHPD         Inst = 606<<21 | 1<<16 | 1137
-	UNPCKHPS         Inst = 607<<21 | 1<<16 | 1138
-	UNPCKLPD         Inst = 608<<21 | 1<<16 | 1139
-	UNPCKLPS         Inst = 609<<21 | 1<<16 | 1140
-	XORPD            Inst = 610<<21 | 1<<16 | 1141
-	XORPS            Inst = 611<<21 | 1<<16 | 1142
-	PABSB            Inst = 612<<21 | 3<<16 | 1143
-	PABSD            Inst = 613<<21 | 3<<16 | 1146
-	PABSW            Inst = 614<<21 | 3<<16 | 1149
-	PACKSSDW         Inst = 615<<21 | 2<<16 | 1152
-	PACKSSWB         Inst = 616<<21 | 2<<16 | 1154
-	PACKUSDW         Inst = 617<<21 | 2<<16 | 1156
-	PACKUSWB         Inst = 618<<21 | 2<<16 | 1158
-	PADDB            Inst = 619<<21 | 2<<16 | 1160
-	PADDD            Inst = 620<<21 | 2<<16 | 1162
-	PADDQ            Inst = 621<<21 | 2<<16 | 1164
-	PADDSB           Inst = 622<<21 | 2<<16 | 1166
-	PADDSW           Inst = 623<<21 | 2<<16 | 1168
-	PADDUSB          Inst = 624<<21 | 2<<16 | 1170
-	PADDUSW          Inst = 625<<21 | 2<<16 | 1172
-	PADDW            Inst = 626<<21 | 2<<16 | 1174
-	PALIGNR          Inst = 627<<21 | 3<<16 | 1176
-	PAND             Inst = 628<<21 | 2<<16 | 1179
-	PANDN            Inst = 629<<21 | 2<<16 | 1181
-	PAVGB            Inst = 630<<21 | 2<<16 | 1183
-	PAVGUSB          Inst = 631<<21 | 1<<16 | 1185
-	PAVGW            Inst = 632<<21 | 2<<16 | 1186
-	PBLENDVB         Inst = 633<<21 | 2<<16 | 1188
-	PBLENDW          Inst = 634<<21 | 2<<16 | 1190
-	PCLMULHQHQDQ     Inst = 635<<21 | 1<<16 | 1192
-	PCLMULHQLQDQ     Inst = 636<<21 | 1<<16 | 1193
-	PCLMULLQHQDQ     Inst = 637<<21 | 1<<16 | 1194
-	PCLMULLQLQDQ     Inst = 638<<21 | 1<<16 | 1195
-	PCLMULQDQ        Inst = 639<<21 | 1<<16 | 1196
-	PCMPEQB          Inst = 640<<21 | 2<<16 | 1197
-	PCMPEQD          Inst = 641<<21 | 2<<16 | 1199
-	PCMPEQQ          Inst = 642<<21 | 2<<16 | 1201
-	PCMPEQW          Inst = 643<<21 | 2<<16 | 1203
-	PCMPESTRI        Inst = 644<<21 | 2<<16 | 1205
-	PCMPESTRM        Inst = 645<<21 | 2<<16 | 1207
-	PCMPGTB          Inst = 646<<21 | 2<<16 | 1209
-	PCMPGTD          Inst = 647<<21 | 2<<16 | 1211
-	PCMPGTQ          Inst = 648<<21 | 2<<16 | 1213
-	PCMPGTW          Inst = 649<<21 | 2<<16 | 1215
-	PCMPISTRI        Inst = 650<<21 | 2<<16 | 1217
-	PCMPISTRM        Inst = 651<<21 | 2<<16 | 1219
-	PDEP             Inst = 652<<21 | 1<<16 | 1221
-	PEXT             Inst = 653<<21 | 1<<16 | 1222
-	PEXTRB           Inst = 654<<21 | 3<<16 | 1223
-	PEXTRD           Inst = 655<<21 | 1<<16 | 1226
-	PEXTRQ           Inst = 656<<21 | 1<<16 | 1227
-	PEXTRW           Inst = 657<<21 | 5<<16 | 1228
-	PF2ID            Inst = 658<<21 | 1<<16 | 1233
-	PF2IW            Inst = 659<<21 | 1<<16 | 1234
-	PFACC            Inst = 660<<21 | 1<<16 | 1235
-	PFADD            Inst = 661<<21 | 1<<16 | 1236
-	PFCMPEQ          Inst = 662<<21 | 1<<16 | 1237
-	PFCMPGE          Inst = 663<<21 | 1<<16 | 1238
-	PFCMPGT          Inst = 664<<21 | 1<<16 | 1239
-	PFMAX            Inst = 665<<21 | 1<<16 | 1240
-	PFMIN            Inst = 666<<21 | 1<<16 | 1241
-	PFMUL            Inst = 667<<21 | 1<<16 | 1242
-	PFNACC           Inst = 668<<21 | 1<<16 | 1243
-	PFPNACC          Inst = 669<<21 | 1<<16 | 1244
-	PFRCP            Inst = 670<<21 | 1<<16 | 1245
-	PFRCPIT1         Inst = 671<<21 | 1<<16 | 1246
-	PFRCPIT2         Inst = 672<<21 | 1<<16 | 1247
-	PFRSQIT1         Inst = 673<<21 | 1<<16 | 1248
-	PFRSQRT          Inst = 674<<21 | 1<<16 | 1249
-	PFSUB            Inst = 675<<21 | 1<<16 | 1250
-	PFSUBR           Inst = 676<<21 | 1<<16 | 1251
-	PHADDD           Inst = 677<<21 | 3<<16 | 1252
-	PHADDSW          Inst = 678<<21 | 3<<16 | 1255
-	PHADDW           Inst = 679<<21 | 3<<16 | 1258
-	PHMINPOSUW       Inst = 680<<21 | 2<<16 | 1261
-	PHSUBD           Inst = 681<<21 | 3<<16 | 1263
-	PHSUBSW          Inst = 682<<21 | 3<<16 | 1266
-	PHSUBW           Inst = 683<<21 | 3<<16 | 1269
-	PI2FD            Inst = 684<<21 | 1<<16 | 1272
-	PI2FW            Inst = 685<<21 | 1<<16 | 1273
-	PINSRB           Inst = 686<<21 | 3<<16 | 1274
-	PINSRD           Inst = 687<<21 | 2<<16 | 1277
-	PINSRQ           Inst = 688<<21 | 2<<16 | 1279
-	PINSRW           Inst = 689<<21 | 7<<16 | 1281
-	PMADDUBSW        Inst = 690<<21 | 3<<16 | 1288
-	PMADDWD          Inst = 691<<21 | 2<<16 | 1291
-	PMAXSB           Inst = 692<<21 | 2<<16 | 1293
-	PMAXSD           Inst = 693<<21 | 2<<16 | 1295
-	PMAXSW           Inst = 694<<21 | 2<<16 | 1297
-	PMAXUB           Inst = 695<<21 | 2<<16 | 1299
-	PMAXUD           Inst = 696<<21 | 2<<16 | 1301
-	PMAXUW           Inst = 697<<21 | 2<<16 | 1303
-	PMINSB           Inst = 698<<21 | 2<<16 | 1305
-	PMINSD           Inst = 699<<21 | 2<<16 | 1307
-	PMINSW           Inst = 700<<21 | 2<<16 | 1309
-	PMINUB           Inst = 701<<21 | 2<<16 | 1311
-	PMINUD           Inst = 702<<21 | 2<<16 | 1313
-	PMINUW           Inst = 703<<21 | 2<<16 | 1315
-	PMOVMSKB         Inst = 704<<21 | 2<<16 | 1317
-	PMOVSXBD         Inst = 705<<21 | 2<<16 | 1319
-	PMOVSXBQ         Inst = 706<<21 | 2<<16 | 1321
-	PMOVSXBW         Inst = 707<<21 | 2<<16 | 1323
-	PMOVSXDQ         Inst = 708<<21 | 2<<16 | 1325
-	PMOVSXWD         Inst = 709<<21 | 2<<16 | 1327
-	PMOVSXWQ         Inst = 710<<21 | 2<<16 | 1329
-	PMOVZXBD         Inst = 711<<21 | 2<<16 | 1331
-	PMOVZXBQ         Inst = 712<<21 | 2<<16 | 1333
-	PMOVZXBW         Inst = 713<<21 | 2<<16 | 1335
-	PMOVZXDQ         Inst = 714<<21 | 2<<16 | 1337
-	PMOVZXWD         Inst = 715<<21 | 2<<16 | 1339
-	PMOVZXWQ         Inst = 716<<21 | 2<<16 | 1341
-	PMULDQ           Inst = 717<<21 | 2<<16 | 1343
-	PMULHRSW         Inst = 718<<21 | 3<<16 | 1345
-	PMULHRWA         Inst = 719<<21 | 1<<16 | 1348
-	PMULHUW          Inst = 720<<21 | 2<<16 | 1349
-	PMULHW           Inst = 721<<21 | 2<<16 | 1351
-	PMULLD           Inst = 722<<21 | 2<<16 | 1353
-	PMULLW           Inst = 723<<21 | 2<<16 | 1355
-	PMULUDQ          Inst = 724<<21 | 2<<16 | 1357
-	POR              Inst = 725<<21 | 2<<16 | 1359
-	PSADBW           Inst = 726<<21 | 2<<16 | 1361
-	PSHUFB           Inst = 727<<21 | 3<<16 | 1363
-	PSHUFD           Inst = 728<<21 | 1<<16 | 1366
-	PSHUFHW          Inst = 729<<21 | 1<<16 | 1367
-	PSHUFLW          Inst = 730<<21 | 1<<16 | 1368
-	PSHUFW           Inst = 731<<21 | 1<<16 | 1369
-	PSIGNB           Inst = 732<<21 | 3<<16 | 1370
-	PSIGND           Inst = 733<<21 | 3<<16 | 1373
-	PSIGNW           Inst = 734<<21 | 3<<16 | 1376
-	PSLLD            Inst = 735<<21 | 4<<16 | 1379
-	PSLLDQ           Inst = 736<<21 | 1<<16 | 1383
-	PSLLQ            Inst = 737<<21 | 4<<16 | 1384
-	PSLLW            Inst = 738<<21 | 4<<16 | 1388
-	PSRAD            Inst = 739<<21 | 4<<16 | 1392
-	PSRAW            Inst = 740<<21 | 4<<16 | 1396
-	PSRLD            Inst = 741<<21 | 4<<16 | 1400
-	PSRLDQ           Inst = 742<<21 | 1<<16 | 1404
-	PSRLQ            Inst = 743<<21 | 4<<16 | 1405
-	PSRLW            Inst = 744<<21 | 4<<16 | 1409
-	PSUBB            Inst = 745<<21 | 2<<16 | 1413
-	PSUBD            Inst = 746<<21 | 2<<16 | 1415
-	PSUBQ            Inst = 747<<21 | 2<<16 | 1417
-	PSUBSB           Inst = 748<<21 | 2<<16 | 1419
-	PSUBSW           Inst = 749<<21 | 2<<16 | 1421
-	PSUBUSB          Inst = 750<<21 | 2<<16 | 1423
-	PSUBUSW          Inst = 751<<21 | 2<<16 | 1425
-	PSUBW            Inst = 752<<21 | 2<<16 | 1427
-	PSWAPD           Inst = 753<<21 | 1<<16 | 1429
-	PTEST            Inst = 754<<21 | 2<<16 | 1430
-	PUNPCKHBW        Inst = 755<<21 | 2<<16 | 1432
-	PUNPCKHDQ        Inst = 756<<21 | 2<<16 | 1434
-	PUNPCKHQDQ       Inst = 757<<21 | 1<<16 | 1436
-	PUNPCKHWD        Inst = 758<<21 | 2<<16 | 1437
-	PUNPCKLBW        Inst = 759<<21 | 2<<16 | 1439
-	PUNPCKLDQ        Inst = 760<<21 | 2<<16 | 1441
-	PUNPCKLQDQ       Inst = 761<<21 | 1<<16 | 1443
-	PUNPCKLWD        Inst = 762<<21 | 2<<16 | 1444
-	PXOR             Inst = 763<<21 | 2<<16 | 1446
-	VADDPD           Inst = 764<<21 | 1<<16 | 1448
-	VADDPS           Inst = 765<<21 | 1<<16 | 1449
-	VADDSD           Inst = 766<<21 | 2<<16 | 1450
-	VADDSS           Inst = 767<<21 | 2<<16 | 1452
-	VADDSUBPD        Inst = 768<<21 | 1<<16 | 1454
-	VADDSUBPS        Inst = 769<<21 | 1<<16 | 1455
-	VAESDEC          Inst = 770<<21 | 1<<16 | 1456
-	VAESDECLAST      Inst = 771<<21 | 1<<16 | 1457
-	VAESENC          Inst = 772<<21 | 1<<16 | 1458
-	VAESENCLAST      Inst = 773<<21 | 1<<16 | 1459
-	VAESIMC          Inst = 774<<21 | 1<<16 | 1460
-	VAESKEYGENASSIST Inst = 775<<21 | 1<<16 | 1461
-	VANDNPD          Inst = 776<<21 | 1<<16 | 1462
-	VANDNPS          Inst = 777<<21 | 1<<16 | 1463
-	VANDPD           Inst = 778<<21 | 1<<16 | 1464
-	VANDPS           Inst = 779<<21 | 1<<16 | 1465
-	VBLENDPD         Inst = 780<<21 | 1<<16 | 1466
-	VBLENDPS         Inst = 781<<21 | 1<<16 | 1467
-	VBLENDVPD        Inst = 782<<21 | 1<<16 | 1468
-	VBLENDVPS        Inst = 783<<21 | 1<<16 | 1469
-	VBROADCASTF128   Inst = 784<<21 | 1<<16 | 1470
-	VBROADCASTI128   Inst = 785<<21 | 1<<16 | 1471
-	VBROADCASTSD     Inst = 786<<21 | 2<<16 | 1472
-	VBROADCASTSS     Inst = 787<<21 | 2<<16 | 1474
-	VCMPEQ_OSPD      Inst = 788<<21 | 2<<16 | 1476
-	VCMPEQ_OSPS      Inst = 789<<21 | 1<<16 | 1478
-	VCMPEQ_OSSD      Inst = 790<<21 | 2<<16 | 1479
-	VCMPEQ_OSSS      Inst = 791<<21 | 2<<16 | 1481
-	VCMPEQ_UQPD      Inst = 792<<21 | 2<<16 | 1483
-	VCMPEQ_UQPS      Inst = 793<<21 | 1<<16 | 1485
-	VCMPEQ_UQSD      Inst = 794<<21 | 2<<16 | 1486
-	VCMPEQ_UQSS      Inst = 795<<21 | 2<<16 | 1488
-	VCMPEQ_USPD      Inst = 796<<21 | 2<<16 | 1490
-	VCMPEQ_USPS      Inst = 797<<21 | 1<<16 | 1492
-	VCMPEQ_USSD      Inst = 798<<21 | 2<<16 | 1493
-	VCMPEQ_USSS      Inst = 799<<21 | 2<<16 | 1495
-	VCMPEQPD         Inst = 800<<21 | 1<<16 | 1497
-	VCMPEQPS         Inst = 801<<21 | 1<<16 | 1498
-	VCMPEQSD         Inst = 802<<21 | 2<<16 | 1499
-	VCMPEQSS         Inst = 803<<21 | 2<<16 | 1501
-	VCMPFALSE_OQPD   Inst = 804<<21 | 1<<16 | 1503
-	VCMPFALSE_OQPS   Inst = 805<<21 | 1<<16 | 1504
-	VCMPFALSE_OQSD   Inst = 806<<21 | 2<<16 | 1505
-	VCMPFALSE_OQSS   Inst = 807<<21 | 2<<16 | 1507
-	VCMPFALSE_OSPD   Inst = 808<<21 | 1<<16 | 1509
-	VCMPFALSE_OSPS   Inst = 809<<21 | 1<<16 | 1510
-	VCMPFALSE_OSSD   Inst = 810<<21 | 2<<16 | 1511
-	VCMPFALSE_OSSS   Inst = 811<<21 | 2<<16 | 1513
-	VCMPFALSEPD      Inst = 812<<21 | 2<<16 | 1515
-	VCMPFALSEPS      Inst = 813<<21 | 1<<16 | 1517
-	VCMPFALSESD      Inst = 814<<21 | 2<<16 | 1518
-	VCMPFALSESS      Inst = 815<<21 | 2<<16 | 1520
-	VCMPGE_OQPD      Inst = 816<<21 | 2<<16 | 1522
-	VCMPGE_OQPS      Inst = 817<<21 | 1<<16 | 1524
-	VCMPGE_OQSD      Inst = 818<<21 | 2<<16 | 1525
-	VCMPGE_OQSS      Inst = 819<<21 | 2<<16 | 1527
-	VCMPGE_OSPD      Inst = 820<<21 | 1<<16 | 1529
-	VCMPGE_OSPS      Inst = 821<<21 | 1<<16 | 1530
-	VCMPGE_OSSD      Inst = 822<<21 | 2<<16 | 1531
-	VCMPGE_OSSS      Inst = 823<<21 | 2<<16 | 1533
-	VCMPGEPD         Inst = 824<<21 | 1<<16 | 1535
-	VCMPGEPS         Inst = 825<<21 | 1<<16 | 1536
-	VCMPGESD         Inst = 826<<21 | 2<<16 | 1537
-	VCMPGESS         Inst = 827<<21 | 2<<16 | 1539
-	VCMPGT_OQPD      Inst = 828<<21 | 1<<16 | 1541
-	VCMPGT_OQPS      Inst = 829<<21 | 1<<16 | 1542
-	VCMPGT_OQSD      Inst = 830<<21 | 2<<16 | 1543
-	VCMPGT_OQSS      Inst = 831<<21 | 2<<16 | 1545
-	VCMPGT_OSPD      Inst = 832<<21 | 1<<16 | 1547
-	VCMPGT_OSPS      Inst = 833<<21 | 1<<16 | 1548
-	VCMPGT_OSSD      Inst = 834<<21 | 2<<16 | 1549
-	VCMPGT_OSSS      Inst = 835<<21 | 2<<16 | 1551
-	VCMPGTPD         Inst = 836<<21 | 2<<16 | 1553
-	VCMPGTPS         Inst = 837<<21 | 1<<16 | 1555
-	VCMPGTSD         Inst = 838<<21 | 2<<16 | 1556
-	VCMPGTSS         Inst = 839<<21 | 2<<16 | 1558
-	VCMPLE_OQPD      Inst = 840<<21 | 2<<16 | 1560
-	VCMPLE_OQPS      Inst = 841<<21 | 1<<16 | 1562
-	VCMPLE_OQSD      Inst = 842<<21 | 2<<16 | 1563
-	VCMPLE_OQSS      Inst = 843<<21 | 2<<16 | 1565
-	VCMPLE_OSPD      Inst = 844<<21 | 1<<16 | 1567
-	VCMPLE_OSPS      Inst = 845<<21 | 1<<16 | 1568
-	VCMPLE_OSSD      Inst = 846<<21 | 2<<16 | 1569
-	VCMPLE_OSSS      Inst = 847<<21 | 2<<16 | 1571
-	VCMPLEPD         Inst = 848<<21 | 2<<16 | 1573
-	VCMPLEPS         Inst = 849<<21 | 1<<16 | 1575
-	VCMPLESD         Inst = 850<<21 | 2<<16 | 1576
-	VCMPLESS         Inst = 851<<21 | 2<<16 | 1578
-	VCMPLT_OQPD      Inst = 852<<21 | 1<<16 | 1580
-	VCMPLT_OQPS      Inst = 853<<21 | 1<<16 | 1581
-	VCMPLT_OQSD      Inst = 854<<21 | 2<<16 | 1582
-	VCMPLT_OQSS      Inst = 855<<21 | 2<<16 | 1584
-	VCMPLT_OSPD      Inst = 856<<21 | 2<<16 | 1586
-	VCMPLT_OSPS      Inst = 857<<21 | 1<<16 | 1588
-	VCMPLT_OSSD      Inst = 858<<21 | 2<<16 | 1589
-	VCMPLT_OSSS      Inst = 859<<21 | 2<<16 | 1591
-	VCMPLTPD         Inst = 860<<21 | 2<<16 | 1593
-	VCMPLTPS         Inst = 861<<21 | 1<<16 | 1595
-	VCMPLTSD         Inst = 862<<21 | 2<<16 | 1596
-	VCMPLTSS         Inst = 863<<21 | 2<<16 | 1598
-	VCMPNEQ_OQPD     Inst = 864<<21 | 2<<16 | 1600
-	VCMPNEQ_OQPS     Inst = 865<<21 | 1<<16 | 1602
-	VCMPNEQ_OQSD     Inst = 866<<21 | 2<<16 | 1603
-	VCMPNEQ_OQSS     Inst = 867<<21 | 2<<16 | 1605
-	VCMPNEQ_OSPD     Inst = 868<<21 | 2<<16 | 1607
-	VCMPNEQ_OSPS     Inst = 869<<21 | 1<<16 | 1609
-	VCMPNEQ_OSSD     Inst = 870<<21 | 2<<16 | 1610
-	VCMPNEQ_OSSS     Inst = 871<<21 | 2<<16 | 1612
-	VCMPNEQ_UQPD     Inst = 872<<21 | 1<<16 | 1614
-	VCMPNEQ_UQPS     Inst = 873<<21 | 1<<16 | 1615
-	VCMPNEQ_UQSD     Inst = 874<<21 | 2<<16 | 1616
-	VCMPNEQ_UQSS     Inst = 875<<21 | 2<<16 | 1618
-	VCMPNEQ_USPD     Inst = 876<<21 | 2<<16 | 1620
-	VCMPNEQ_USPS     Inst = 877<<21 | 1<<16 | 1622
-	VCMPNEQ_USSD     Inst = 878<<21 | 2<<16 | 1623
-	VCMPNEQ_USSS     Inst = 879<<21 | 2<<16 | 1625
-	VCMPNEQPD        Inst = 880<<21 | 2<<16 | 1627
-	VCMPNEQPS        Inst = 881<<21 | 1<<16 | 1629
-	VCMPNEQSD        Inst = 882<<21 | 2<<16 | 1630
-	VCMPNEQSS        Inst = 883<<21 | 2<<16 | 1632
-	VCMPNGE_UQPD     Inst = 884<<21 | 1<<16 | 1634
-	VCMPNGE_UQPS     Inst = 885<<21 | 1<<16 | 1635
-	VCMPNGE_UQSD     Inst = 886<<21 | 2<<16 | 1636
-	VCMPNGE_UQSS     Inst = 887<<21 | 2<<16 | 1638
-	VCMPNGE_USPD     Inst = 888<<21 | 1<<16 | 1640
-	VCMPNGE_USPS     Inst = 889<<21 | 1<<16 | 1641
-	VCMPNGE_USSD     Inst = 890<<21 | 2<<16 | 1642
-	VCMPNGE_USSS     Inst = 891<<21 | 2<<16 | 1644
-	VCMPNGEPD        Inst = 892<<21 | 2<<16 | 1646
-	VCMPNGEPS        Inst = 893<<21 | 1<<16 | 1648
-	VCMPNGESD        Inst = 894<<21 | 2<<16 | 1649
-	VCMPNGESS        Inst = 895<<21 | 2<<16 | 1651
-	VCMPNGT_UQPD     Inst = 896<<21 | 1<<16 | 1653
-	VCMPNGT_UQPS     Inst = 897<<21 | 1<<16 | 1654
-	VCMPNGT_UQSD     Inst = 898<<21 | 2<<16 | 1655
-	VCMPNGT_UQSS     Inst = 899<<21 | 2<<16 | 1657
-	VCMPNGT_USPD     Inst = 900<<21 | 1<<16 | 1659
-	VCMPNGT_USPS     Inst = 901<<21 | 1<<16 | 1660
-	VCMPNGT_USSD     Inst = 902<<21 | 2<<16 | 1661
-	VCMPNGT_USSS     Inst = 903<<21 | 2<<16 | 1663
-	VCMPNGTPD        Inst = 904<<21 | 1<<16 | 1665
-	VCMPNGTPS        Inst = 905<<21 | 1<<16 | 1666
-	VCMPNGTSD        Inst = 906<<21 | 2<<16 | 1667
-	VCMPNGTSS        Inst = 907<<21 | 2<<16 | 1669
-	VCMPNLE_UQPD     Inst = 908<<21 | 1<<16 | 1671
-	VCMPNLE_UQPS     Inst = 909<<21 | 1<<16 | 1672
-	VCMPNLE_UQSD     Inst = 910<<21 | 2<<16 | 1673
-	VCMPNLE_UQSS     Inst = 911<<21 | 2<<16 | 1675
-	VCMPNLE_USPD     Inst = 912<<21 | 2<<16 | 1677
-	VCMPNLE_USPS     Inst = 913<<21 | 1<<16 | 1679
-	VCMPNLE_USSD     Inst = 914<<21 | 2<<16 | 1680
-	VCMPNLE_USSS     Inst = 915<<21 | 2<<16 | 1682
-	VCMPNLEPD        Inst = 916<<21 | 1<<16 | 1684
-	VCMPNLEPS        Inst = 917<<21 | 1<<16 | 1685
-	VCMPNLESD        Inst = 918<<21 | 2<<16 | 1686
-	VCMPNLESS        Inst = 919<<21 | 2<<16 | 1688
-	VCMPNLT_UQPD     Inst = 920<<21 | 2<<16 | 1690
-	VCMPNLT_UQPS     Inst = 921<<21 | 1<<16 | 1692
-	VCMPNLT_UQSD     Inst = 922<<21 | 2<<16 | 1693
-	VCMPNLT_UQSS     Inst = 923<<21 | 2<<16 | 1695
-	VCMPNLT_USPD     Inst = 924<<21 | 1<<16 | 1697
-	VCMPNLT_USPS     Inst = 925<<21 | 1<<16 | 1698
-	VCMPNLT_USSD     Inst = 926<<21 | 2<<16 | 1699
-	VCMPNLT_USSS     Inst = 927<<21 | 2<<16 | 1701
-	VCMPNLTPD        Inst = 928<<21 | 2<<16 | 1703
-	VCMPNLTPS        Inst = 929<<21 | 1<<16 | 1705
-	VCMPNLTSD        Inst = 930<<21 | 2<<16 | 1706
-	VCMPNLTSS        Inst = 931<<21 | 2<<16 | 1708
-	VCMPORD_QPD      Inst = 932<<21 | 2<<16 | 1710
-	VCMPORD_QPS      Inst = 933<<21 | 1<<16 | 1712
-	VCMPORD_QSD      Inst = 934<<21 | 2<<16 | 1713
-	VCMPORD_QSS      Inst = 935<<21 | 2<<16 | 1715
-	VCMPORD_SPD      Inst = 936<<21 | 2<<16 | 1717
-	VCMPORD_SPS      Inst = 937<<21 | 1<<16 | 1719
-	VCMPORD_SSD      Inst = 938<<21 | 2<<16 | 1720
-	VCMPORD_SSS      Inst = 939<<21 | 2<<16 | 1722
-	VCMPORDPD        Inst = 940<<21 | 2<<16 | 1724
-	VCMPORDPS        Inst = 941<<21 | 1<<16 | 1726
-	VCMPORDSD        Inst = 942<<21 | 2<<16 | 1727
-	VCMPORDSS        Inst = 943<<21 | 2<<16 | 1729
-	VCMPPD           Inst = 944<<21 | 1<<16 | 1731
-	VCMPPS           Inst = 945<<21 | 1<<16 | 1732
-	VCMPSD           Inst = 946<<21 | 2<<16 | 1733
-	VCMPSS           Inst = 947<<21 | 2<<16 | 1735
-	VCMPTRUE_UQPD    Inst = 948<<21 | 2<<16 | 1737
-	VCMPTRUE_UQPS    Inst = 949<<21 | 1<<16 | 1739
-	VCMPTRUE_UQSD    Inst = 950<<21 | 2<<16 | 1740
-	VCMPTRUE_UQSS    Inst = 951<<21 | 2<<16 | 1742
-	VCMPTRUE_USPD    Inst = 952<<21 | 1<<16 | 1744
-	VCMPTRUE_USPS    Inst = 953<<21 | 1<<16 | 1745
-	VCMPTRUE_USSD    Inst = 954<<21 | 2<<16 | 1746
-	VCMPTRUE_USSS    Inst = 955<<21 | 2<<16 | 1748
-	VCMPTRUEPD       Inst = 956<<21 | 1<<16 | 1750
-	VCMPTRUEPS       Inst = 957<<21 | 1<<16 | 1751
-	VCMPTRUESD       Inst = 958<<21 | 2<<16 | 1752
-	VCMPTRUESS       Inst = 959<<21 | 2<<16 | 1754
-	VCMPUNORD_QPD    Inst = 960<<21 | 1<<16 | 1756
-	VCMPUNORD_QPS    Inst = 961<<21 | 1<<16 | 1757
-	VCMPUNORD_QSD    Inst = 962<<21 | 2<<16 | 1758
-	VCMPUNORD_QSS    Inst = 963<<21 | 2<<16 | 1760
-	VCMPUNORD_SPD    Inst = 964<<21 | 1<<16 | 1762
-	VCMPUNORD_SPS    Inst = 965<<21 | 1<<16 | 1763
-	VCMPUNORD_SSD    Inst = 966<<21 | 2<<16 | 1764
-	VCMPUNORD_SSS    Inst = 967<<21 | 2<<16 | 1766
-	VCMPUNORDPD      Inst = 968<<21 | 2<<16 | 1768
-	VCMPUNORDPS      Inst = 969<<21 | 1<<16 | 1770
-	VCMPUNORDSD      Inst = 970<<21 | 2<<16 | 1771
-	VCMPUNORDSS      Inst = 971<<21 | 2<<16 | 1773
-	VCOMISD          Inst = 972<<21 | 2<<16 | 1775
-	VCOMISS          Inst = 973<<21 | 2<<16 | 1777
-	VCVTDQ2PD        Inst = 974<<21 | 2<<16 | 1779
-	VCVTDQ2PS        Inst = 975<<21 | 1<<16 | 1781
-	VCVTPD2DQ        Inst = 976<<21 | 2<<16 | 1782
-	VCVTPD2PS        Inst = 977<<21 | 2<<16 | 1784
-	VCVTPH2PS        Inst = 978<<21 | 2<<16 | 1786
-	VCVTPS2DQ        Inst = 979<<21 | 1<<16 | 1788
-	VCVTPS2PD        Inst = 980<<21 | 2<<16 | 1789
-	VCVTPS2PH        Inst = 981<<21 | 2<<16 | 1791
-	VCVTSD2SI        Inst = 982<<21 | 2<<16 | 1793
-	VCVTSD2SS        Inst = 983<<21 | 2<<16 | 1795
-	VCVTSI2SD        Inst = 984<<21 | 1<<16 | 1797
-	VCVTSI2SS        Inst = 985<<21 | 1<<16 | 1798
-	VCVTSS2SD        Inst = 986<<21 | 2<<16 | 1799
-	VCVTSS2SI        Inst = 987<<21 | 2<<16 | 1801
-	VCVTTPD2DQ       Inst = 988<<21 | 2<<16 | 1803
-	VCVTTPS2DQ       Inst = 989<<21 | 1<<16 | 1805
-	VCVTTSD2SI       Inst = 990<<21 | 2<<16 | 1806
-	VCVTTSS2SI       Inst = 991<<21 | 2<<16 | 1808
-	VDIVPD           Inst = 992<<21 | 1<<16 | 1810
-	VDIVPS           Inst = 993<<21 | 1<<16 | 1811
-	VDIVSD           Inst = 994<<21 | 2<<16 | 1812
-	VDIVSS           Inst = 995<<21 | 2<<16 | 1814
-	VDPPD            Inst = 996<<21 | 1<<16 | 1816
-	VDPPS            Inst = 997<<21 | 1<<16 | 1817
-	VERR             Inst = 998<<21 | 2<<16 | 1818
-	VERW             Inst = 999<<21 | 2<<16 | 1820
-	VEXTRACTF128     Inst = 1000<<21 | 1<<16 | 1822
-	VEXTRACTI128     Inst = 1001<<21 | 1<<16 | 1823
-	VEXTRACTPS       Inst = 1002<<21 | 1<<16 | 1824
-	VFMADD123PD      Inst = 1003<<21 | 1<<16 | 1825
-	VFMADD123PS      Inst = 1004<<21 | 1<<16 | 1826
-	VFMADD123SD      Inst = 1005<<21 | 2<<16 | 1827
-	VFMADD123SS      Inst = 1006<<21 | 2<<16 | 1829
-	VFMADD132PD      Inst = 1007<<21 | 1<<16 | 1831
-	VFMADD132PS      Inst = 1008<<21 | 1<<16 | 1832
-	VFMADD132SD      Inst = 1009<<21 | 2<<16 | 1833
-	VFMADD132SS      Inst = 1010<<21 | 2<<16 | 1835
-	VFMADD213PD      Inst = 1011<<21 | 1<<16 | 1837
-	VFMADD213PS      Inst = 1012<<21 | 1<<16 | 1838
-	VFMADD213SD      Inst = 1013<<21 | 2<<16 | 1839
-	VFMADD213SS      Inst = 1014<<21 | 2<<16 | 1841
-	VFMADD231PD      Inst = 1015<<21 | 1<<16 | 1843
-	VFMADD231PS      Inst = 1016<<21 | 1<<16 | 1844
-	VFMADD231SD      Inst = 1017<<21 | 2<<16 | 1845
-	VFMADD231SS      Inst = 1018<<21 | 2<<16 | 1847
-	VFMADD312PD      Inst = 1019<<21 | 1<<16 | 1849
-	VFMADD312PS      Inst = 1020<<21 | 1<<16 | 1850
-	VFMADD312SD      Inst = 1021<<21 | 2<<16 | 1851
-	VFMADD312SS      Inst = 1022<<21 | 2<<16 | 1853
-	VFMADD321PD      Inst = 1023<<21 | 1<<16 | 1855
-	VFMADD321PS      Inst = 1024<<21 | 1<<16 | 1856
-	VFMADD321SD      Inst = 1025<<21 | 2<<16 | 1857
-	VFMADD321SS      Inst = 1026<<21 | 2<<16 | 1859
-	VFMADDPD         Inst = 1027<<21 | 2<<16 | 1861
-	VFMADDPS         Inst = 1028<<21 | 2<<16 | 1863
-	VFMADDSD         Inst = 1029<<21 | 3<<16 | 1865
-	VFMADDSS         Inst = 1030<<21 | 3<<16 | 1868
-	VFMADDSUB123PD   Inst = 1031<<21 | 1<<16 | 1871
-	VFMADDSUB123PS   Inst = 1032<<21 | 1<<16 | 1872
-	VFMADDSUB132PD   Inst = 1033<<21 | 1<<16 | 1873
-	VFMADDSUB132PS   Inst = 1034<<21 | 1<<16 | 1874
-	VFMADDSUB213PD   Inst = 1035<<21 | 1<<16 | 1875
-	VFMADDSUB213PS   Inst = 1036<<21 | 1<<16 | 1876
-	VFMADDSUB231PD   Inst = 1037<<21 | 1<<16 | 1877
-	VFMADDSUB231PS   Inst = 1038<<21 | 1<<16 | 1878
-	VFMADDSUB312PD   Inst = 1039<<21 | 1<<16 | 1879
-	VFMADDSUB312PS   Inst = 1040<<21 | 1<<16 | 1880
-	VFMADDSUB321PD   Inst = 1041<<21 | 1<<16 | 1881
-	VFMADDSUB321PS   Inst = 1042<<21 | 1<<16 | 1882
-	VFMADDSUBPD      Inst = 1043<<21 | 2<<16 | 1883
-	VFMADDSUBPS      Inst = 1044<<21 | 2<<16 | 1885
-	VFMSUB123PD      Inst = 1045<<21 | 1<<16 | 1887
-	VFMSUB123PS      Inst = 1046<<21 | 1<<16 | 1888
-	VFMSUB123SD      Inst = 1047<<21 | 2<<16 | 1889
-	VFMSUB123SS      Inst = 1048<<21 | 2<<16 | 1891
-	VFMSUB132PD      Inst = 1049<<21 | 1<<16 | 1893
-	VFMSUB132PS      Inst = 1050<<21 | 1<<16 | 1894
-	VFMSUB132SD      Inst = 1051<<21 | 2<<16 | 1895
-	VFMSUB132SS      Inst = 1052<<21 | 2<<16 | 1897
-	VFMSUB213PD      Inst = 1053<<21 | 1<<16 | 1899
-	VFMSUB213PS      Inst = 1054<<21 | 1<<16 | 1900
-	VFMSUB213SD      Inst = 1055<<21 | 2<<16 | 1901
-	VFMSUB213SS      Inst = 1056<<21 | 2<<16 | 1903
-	VFMSUB231PD      Inst = 1057<<21 | 1<<16 | 1905
-	VFMSUB231PS      Inst = 1058<<21 | 1<<16 | 1906
-	VFMSUB231SD      Inst = 1059<<21 | 2<<16 | 1907
-	VFMSUB231SS      Inst = 1060<<21 | 2<<16 | 1909
-	VFMSUB312PD      Inst = 1061<<21 | 1<<16 | 1911
-	VFMSUB312PS      Inst = 1062<<21 | 1<<16 | 1912
-	VFMSUB312SD      Inst = 1063<<21 | 2<<16 | 1913
-	VFMSUB312SS      Inst = 1064<<21 | 2<<16 | 1915
-	VFMSUB321PD      Inst = 1065<<21 | 1<<16 | 1917
-	VFMSUB321PS      Inst = 1066<<21 | 1<<16 | 1918
-	VFMSUB321SD      Inst = 1067<<21 | 2<<16 | 1919
-	VFMSUB321SS      Inst = 1068<<21 | 2<<16 | 1921
-	VFMSUBADD123PD   Inst = 1069<<21 | 1<<16 | 1923
-	VFMSUBADD123PS   Inst = 1070<<21 | 1<<16 | 1924
-	VFMSUBADD132PD   Inst = 1071<<21 | 1<<16 | 1925
-	VFMSUBADD132PS   Inst = 1072<<21 | 1<<16 | 1926
-	VFMSUBADD213PD   Inst = 1073<<21 | 1<<16 | 1927
-	VFMSUBADD213PS   Inst = 1074<<21 | 1<<16 | 1928
-	VFMSUBADD231PD   Inst = 1075<<21 | 1<<16 | 1929
-	VFMSUBADD231PS   Inst = 1076<<21 | 1<<16 | 1930
-	VFMSUBADD312PD   Inst = 1077<<21 | 1<<16 | 1931
-	VFMSUBADD312PS   Inst = 1078<<21 | 1<<16 | 1932
-	VFMSUBADD321PD   Inst = 1079<<21 | 1<<16 | 1933
-	VFMSUBADD321PS   Inst = 1080<<21 | 1<<16 | 1934
-	VFMSUBADDPD      Inst = 1081<<21 | 2<<16 | 1935
-	VFMSUBADDPS      Inst = 1082<<21 | 2<<16 | 1937
-	VFMSUBPD         Inst = 1083<<21 | 2<<16 | 1939
-	VFMSUBPS         Inst = 1084<<21 | 2<<16 | 1941
-	VFMSUBSD         Inst = 1085<<21 | 3<<16 | 1943
-	VFMSUBSS         Inst = 1086<<21 | 3<<16 | 1946
-	VFNMADD123PD     Inst = 1087<<21 | 1<<16 | 1949
-	VFNMADD123PS     Inst = 1088<<21 | 1<<16 | 1950
-	VFNMADD123SD     Inst = 1089<<21 | 2<<16 | 1951
-	VFNMADD123SS     Inst = 1090<<21 | 2<<16 | 1953
-	VFNMADD132PD     Inst = 1091<<21 | 1<<16 | 1955
-	VFNMADD132PS     Inst = 1092<<21 | 1<<16 | 1956
-	VFNMADD132SD     Inst = 1093<<21 | 2<<16 | 1957
-	VFNMADD132SS     Inst = 1094<<21 | 2<<16 | 1959
-	VFNMADD213PD     Inst = 1095<<21 | 1<<16 | 1961
-	VFNMADD213PS     Inst = 1096<<21 | 1<<16 | 1962
-	VFNMADD213SD     Inst = 1097<<21 | 2<<16 | 1963
-	VFNMADD213SS     Inst = 1098<<21 | 2<<16 | 1965
-	VFNMADD231PD     Inst = 1099<<21 | 1<<16 | 1967
-	VFNMADD231PS     Inst = 1100<<21 | 1<<16 | 1968
-	VFNMADD231SD     Inst = 1101<<21 | 2<<16 | 1969
-	VFNMADD231SS     Inst = 1102<<21 | 2<<16 | 1971
-	VFNMADD312PD     Inst = 1103<<21 | 1<<16 | 1973
-	VFNMADD312PS     Inst = 1104<<21 | 1<<16 | 1974
-	VFNMADD312SD     Inst = 1105<<21 | 2<<16 | 1975
-	VFNMADD312SS     Inst = 1106<<21 | 2<<16 | 1977
-	VFNMADD321PD     Inst = 1107<<21 | 1<<16 | 1979
-	VFNMADD321PS     Inst = 1108<<21 | 1<<16 | 1980
-	VFNMADD321SD     Inst = 1109<<21 | 2<<16 | 1981
-	VFNMADD321SS     Inst = 1110<<21 | 2<<16 | 1983
-	VFNMADDPD        Inst = 1111<<21 | 2<<16 | 1985
-	VFNMADDPS        Inst = 1112<<21 | 2<<16 | 1987
-	VFNMADDSD        Inst = 1113<<21 | 3<<16 | 1989
-	VFNMADDSS        Inst = 1114<<21 | 3<<16 | 1992
-	VFNMSUB123PD     Inst = 1115<<21 | 1<<16 | 1995
-	VFNMSUB123PS     Inst = 1116<<21 | 1<<16 | 1996
-	VFNMSUB123SD     Inst = 1117<<21 | 2<<16 | 1997
-	VFNMSUB123SS     Inst = 1118<<21 | 2<<16 | 1999
-	VFNMSUB132PD     Inst = 1119<<21 | 1<<16 | 2001
-	VFNMSUB132PS     Inst = 1120<<21 | 1<<16 | 2002
-	VFNMSUB132SD     Inst = 1121<<21 | 2<<16 | 2003
-	VFNMSUB132SS     Inst = 1122<<21 | 2<<16 | 2005
-	VFNMSUB213PD     Inst = 1123<<21 | 1<<16 | 2007
-	VFNMSUB213PS     Inst = 1124<<21 | 1<<16 | 2008
-	VFNMSUB213SD     Inst = 1125<<21 | 2<<16 | 2009
-	VFNMSUB213SS     Inst = 1126<<21 | 2<<16 | 2011
-	VFNMSUB231PD     Inst = 1127<<21 | 1<<16 | 2013
-	VFNMSUB231PS     Inst = 1128<<21 | 1<<16 | 2014
-	VFNMSUB231SD     Inst = 1129<<21 | 2<<16 | 2015
-	VFNMSUB231SS     Inst = 1130<<21 | 2<<16 | 2017
-	VFNMSUB312PD     Inst = 1131<<21 | 1<<16 | 2019
-	VFNMSUB312PS     Inst = 1132<<21 | 1<<16 | 2020
-	VFNMSUB312SD     Inst = 1133<<21 | 2<<16 | 2021
-	VFNMSUB312SS     Inst = 1134<<21 | 2<<16 | 2023
-	VFNMSUB321PD     Inst = 1135<<21 | 1<<16 | 2025
-	VFNMSUB321PS     Inst = 1136<<21 | 1<<16 | 2026
-	VFNMSUB321SD     Inst = 1137<<21 | 2<<16 | 2027
-	VFNMSUB321SS     Inst = 1138<<21 | 2<<16 | 2029
-	VFNMSUBPD        Inst = 1139<<21 | 2<<16 | 2031
-	VFNMSUBPS        Inst = 1140<<21 | 2<<16 | 2033
-	VFNMSUBSD        Inst = 1141<<21 | 3<<16 | 2035
-	VFNMSUBSS        Inst = 1142<<21 | 3<<16 | 2038
-	VFRCZPD          Inst = 1143<<21 | 1<<16 | 2041
-	VFRCZPS          Inst = 1144<<21 | 1<<16 | 2042
-	VFRCZSD          Inst = 1145<<21 | 2<<16 | 2043
-	VFRCZSS          Inst = 1146<<21 | 2<<16 | 2045
-	VGATHERDPD       Inst = 1147<<21 | 1<<16 | 2047
-	VGATHERDPS       Inst = 1148<<21 | 1<<16 | 2048
-	VGATHERQPD       Inst = 1149<<21 | 1<<16 | 2049
-	VGATHERQPS       Inst = 1150<<21 | 1<<16 | 2050
-	VHADDPD          Inst = 1151<<21 | 1<<16 | 2051
-	VHADDPS          Inst = 1152<<21 | 1<<16 | 2052
-	VHSUBPD          Inst = 1153<<21 | 1<<16 | 2053
-	VHSUBPS          Inst = 1154<<21 | 1<<16 | 2054
-	VINSERTF128      Inst = 1155<<21 | 1<<16 | 2055
-	VINSERTI128      Inst = 1156<<21 | 1<<16 | 2056
-	VINSERTPS        Inst = 1157<<21 | 2<<16 | 2057
-	VLDDQU           Inst = 1158<<21 | 1<<16 | 2059
-	VLDMXCSR         Inst = 1159<<21 | 1<<16 | 2060
-	VLDQQU           Inst = 1160<<21 | 1<<16 | 2061
-	VMASKMOVDQU      Inst = 1161<<21 | 1<<16 | 2062
-	VMASKMOVPD       Inst = 1162<<21 | 2<<16 | 2063
-	VMASKMOVPS       Inst = 1163<<21 | 2<<16 | 2065
-	VMAXPD           Inst = 1164<<21 | 1<<16 | 2067
-	VMAXPS           Inst = 1165<<21 | 1<<16 | 2068
-	VMAXSD           Inst = 1166<<21 | 2<<16 | 2069
-	VMAXSS           Inst = 1167<<21 | 2<<16 | 2071
-	VMCALL           Inst = 1168<<21 | 1<<16 | 2073
-	VMCLEAR          Inst = 1169<<21 | 1<<16 | 2074
-	VMFUNC           Inst = 1170<<21 | 1<<16 | 2075
-	VMINPD           Inst = 1171<<21 | 1<<16 | 2076
-	VMINPS           Inst = 1172<<21 | 1<<16 | 2077
-	VMINSD           Inst = 1173<<21 | 2<<16 | 2078
-	VMINSS           Inst = 1174<<21 | 2<<16 | 2080
-	VMLAUNCH         Inst = 1175<<21 | 1<<16 | 2082
-	VMLOAD           Inst = 1176<<21 | 1<<16 | 2083
-	VMMCALL          Inst = 1177<<21 | 1<<16 | 2084
-	VMOVAPD          Inst = 1178<<21 | 3<<16 | 2085
-	VMOVAPS          Inst = 1179<<21 | 3<<16 | 2088
-	VMOVD            Inst = 1180<<21 | 2<<16 | 2091
-	VMOVDDUP         Inst = 1181<<21 | 2<<16 | 2093
-	VMOVDQA          Inst = 1182<<21 | 3<<16 | 2095
-	VMOVDQU          Inst = 1183<<21 | 3<<16 | 2098
-	VMOVHLPS         Inst = 1184<<21 | 1<<16 | 2101
-	VMOVHPD          Inst = 1185<<21 | 2<<16 | 2102
-	VMOVHPS          Inst = 1186<<21 | 2<<16 | 2104
-	VMOVLHPS         Inst = 1187<<21 | 1<<16 | 2106
-	VMOVLPD          Inst = 1188<<21 | 2<<16 | 2107
-	VMOVLPS          Inst = 1189<<21 | 2<<16 | 2109
-	VMOVMSKPD        Inst = 1190<<21 | 1<<16 | 2111
-	VMOVMSKPS        Inst = 1191<<21 | 1<<16 | 2112
-	VMOVNTDQ         Inst = 1192<<21 | 1<<16 | 2113
-	VMOVNTDQA        Inst = 1193<<21 | 1<<16 | 2114
-	VMOVNTPD         Inst = 1194<<21 | 1<<16 | 2115
-	VMOVNTPS         Inst = 1195<<21 | 1<<16 | 2116
-	VMOVNTQQ         Inst = 1196<<21 | 1<<16 | 2117
-	VMOVQ            Inst = 1197<<21 | 6<<16 | 2118
-	VMOVQQA          Inst = 1198<<21 | 2<<16 | 2124
-	VMOVQQU          Inst = 1199<<21 | 2<<16 | 2126
-	VMOVSD           Inst = 1200<<21 | 4<<16 | 2128
-	VMOVSHDUP        Inst = 1201<<21 | 1<<16 | 2132
-	VMOVSLDUP        Inst = 1202<<21 | 1<<16 | 2133
-	VMOVSS           Inst = 1203<<21 | 4<<16 | 2134
-	VMOVUPD          Inst = 1204<<21 | 3<<16 | 2138
-	VMOVUPS          Inst = 1205<<21 | 3<<16 | 2141
-	VMPSADBW         Inst = 1206<<21 | 1<<16 | 2144
-	VMPTRLD          Inst = 1207<<21 | 1<<16 | 2145
-	VMPTRST          Inst = 1208<<21 | 1<<16 | 2146
-	VMREAD           Inst = 1209<<21 | 1<<16 | 2147
-	VMRESUME         Inst = 1210<<21 | 1<<16 | 2148
-	VMRUN            Inst = 1211<<21 | 1<<16 | 2149
-	VMSAVE           Inst = 1212<<21 | 1<<16 | 2150
-	VMULPD           Inst = 1213<<21 | 1<<16 | 2151
-	VMULPS           Inst = 1214<<21 | 1<<16 | 2152
-	VMULSD           Inst = 1215<<21 | 2<<16 | 2153
-	VMULSS           Inst = 1216<<21 | 2<<16 | 2155
-	VMWRITE          Inst = 1217<<21 | 1<<16 | 2157
-	VMXOFF           Inst = 1218<<21 | 1<<16 | 2158
-	VMXON            Inst = 1219<<21 | 1<<16 | 2159
-	VORPD            Inst = 1220<<21 | 1<<16 | 2160
-	VORPS            Inst = 1221<<21 | 1<<16 | 2161
-	VPABSB           Inst = 1222<<21 | 1<<16 | 2162
-	VPABSD           Inst = 1223<<21 | 1<<16 | 2163
-	VPABSW           Inst = 1224<<21 | 1<<16 | 2164
-	VPACKSSDW        Inst = 1225<<21 | 1<<16 | 2165
-	VPACKSSWB        Inst = 1226<<21 | 1<<16 | 2166
-	VPACKUSDW        Inst = 1227<<21 | 1<<16 | 2167
-	VPACKUSWB        Inst = 1228<<21 | 1<<16 | 2168
-	VPADDB           Inst = 1229<<21 | 1<<16 | 2169
-	VPADDD           Inst = 1230<<21 | 1<<16 | 2170
-	VPADDQ           Inst = 1231<<21 | 1<<16 | 2171
-	VPADDSB          Inst = 1232<<21 | 1<<16 | 2172
-	VPADDSW          Inst = 1233<<21 | 1<<16 | 2173
-	VPADDUSB         Inst = 1234<<21 | 1<<16 | 2174
-	VPADDUSW         Inst = 1235<<21 | 1<<16 | 2175
-	VPADDW           Inst = 1236<<21 | 1<<16 | 2176
-	VPALIGNR         Inst = 1237<<21 | 1<<16 | 2177
-	VPAND            Inst = 1238<<21 | 1<<16 | 2178
-	VPANDN           Inst = 1239<<21 | 1<<16 | 2179
-	VPAVGB           Inst = 1240<<21 | 1<<16 | 2180
-	VPAVGW           Inst = 1241<<21 | 1<<16 | 2181
-	VPBLENDD         Inst = 1242<<21 | 1<<16 | 2182
-	VPBLENDVB        Inst = 1243<<21 | 1<<16 | 2183
-	VPBLENDW         Inst = 1244<<21 | 1<<16 | 2184
-	VPBROADCASTB     Inst = 1245<<21 | 2<<16 | 2185
-	VPBROADCASTD     Inst = 1246<<21 | 2<<16 | 2187
-	VPBROADCASTQ     Inst = 1247<<21 | 3<<16 | 2189
-	VPBROADCASTW     Inst = 1248<<21 | 2<<16 | 2192
-	VPCLMULHQHQDQ    Inst = 1249<<21 | 1<<16 | 2194
-	VPCLMULHQLQDQ    Inst = 1250<<21 | 1<<16 | 2195
-	VPCLMULLQHQDQ    Inst = 1251<<21 | 1<<16 | 2196
-	VPCLMULLQLQDQ    Inst = 1252<<21 | 1<<16 | 2197
-	VPCLMULQDQ       Inst = 1253<<21 | 1<<16 | 2198
-	VPCMOV           Inst = 1254<<21 | 2<<16 | 2199
-	VPCMPEQB         Inst = 1255<<21 | 1<<16 | 2201
-	VPCMPEQD         Inst = 1256<<21 | 1<<16 | 2202
-	VPCMPEQQ         Inst = 1257<<21 | 1<<16 | 2203
-	VPCMPEQW         Inst = 1258<<21 | 1<<16 | 2204
-	VPCMPESTRI       Inst = 1259<<21 | 1<<16 | 2205
-	VPCMPESTRM       Inst = 1260<<21 | 1<<16 | 2206
-	VPCMPGTB         Inst = 1261<<21 | 1<<16 | 2207
-	VPCMPGTD         Inst = 1262<<21 | 1<<16 | 2208
-	VPCMPGTQ         Inst = 1263<<21 | 1<<16 | 2209
-	VPCMPGTW         Inst = 1264<<21 | 1<<16 | 2210
-	VPCMPISTRI       Inst = 1265<<21 | 1<<16 | 2211
-	VPCMPISTRM       Inst = 1266<<21 | 1<<16 | 2212
-	VPCOMB           Inst = 1267<<21 | 1<<16 | 2213
-	VPCOMD           Inst = 1268<<21 | 1<<16 | 2214
-	VPCOMQ           Inst = 1269<<21 | 1<<16 | 2215
-	VPCOMUB          Inst = 1270<<21 | 1<<16 | 2216
-	VPCOMUD          Inst = 1271<<21 | 1<<16 | 2217
-	VPCOMUQ          Inst = 1272<<21 | 1<<16 | 2218
-	VPCOMUW          Inst = 1273<<21 | 1<<16 | 2219
-	VPCOMW           Inst = 1274<<21 | 1<<16 | 2220
-	VPERM2F128       Inst = 1275<<21 | 1<<16 | 2221
-	VPERM2I128       Inst = 1276<<21 | 1<<16 | 2222
-	VPERMD           Inst = 1277<<21 | 1<<16 | 2223
-	VPERMILPD        Inst = 1278<<21 | 2<<16 | 2224
-	VPERMILPS        Inst = 1279<<21 | 2<<16 | 2226
-	VPERMPD          Inst = 1280<<21 | 1<<16 | 2228
-	VPERMPS          Inst = 1281<<21 | 1<<16 | 2229
-	VPERMQ           Inst = 1282<<21 | 1<<16 | 2230
-	VPEXTRB          Inst = 1283<<21 | 3<<16 | 2231
-	VPEXTRD          Inst = 1284<<21 | 2<<16 | 2234
-	VPEXTRQ          Inst = 1285<<21 | 1<<16 | 2236
-	VPEXTRW          Inst = 1286<<21 | 5<<16 | 2237
-	VPGATHERDD       Inst = 1287<<21 | 1<<16 | 2242
-	VPGATHERDQ       Inst = 1288<<21 | 1<<16 | 2243
-	VPGATHERQD       Inst = 1289<<21 | 1<<16 | 2244
-	VPGATHERQQ       Inst = 1290<<21 | 1<<16 | 2245
-	VPHADDBD         Inst = 1291<<21 | 1<<16 | 2246
-	VPHADDBQ         Inst = 1292<<21 | 1<<16 | 2247
-	VPHADDBW         Inst = 1293<<21 | 1<<16 | 2248
-	VPHADDD          Inst = 1294<<21 | 1<<16 | 2249
-	VPHADDDQ         Inst = 1295<<21 | 1<<16 | 2250
-	VPHADDSW         Inst = 1296<<21 | 1<<16 | 2251
-	VPHADDUBD        Inst = 1297<<21 | 1<<16 | 2252
-	VPHADDUBQ        Inst = 1298<<21 | 1<<16 | 2253
-	VPHADDUBW        Inst = 1299<<21 | 1<<16 | 2254
-	VPHADDUDQ        Inst = 1300<<21 | 1<<16 | 2255
-	VPHADDUWD        Inst = 1301<<21 | 1<<16 | 2256
-	VPHADDUWQ        Inst = 1302<<21 | 1<<16 | 2257
-	VPHADDW          Inst = 1303<<21 | 1<<16 | 2258
-	VPHADDWD         Inst = 1304<<21 | 1<<16 | 2259
-	VPHADDWQ         Inst = 1305<<21 | 1<<16 | 2260
-	VPHMINPOSUW      Inst = 1306<<21 | 1<<16 | 2261
-	VPHSUBBW         Inst = 1307<<21 | 1<<16 | 2262
-	VPHSUBD          Inst = 1308<<21 | 1<<16 | 2263
-	VPHSUBDQ         Inst = 1309<<21 | 1<<16 | 2264
-	VPHSUBSW         Inst = 1310<<21 | 1<<16 | 2265
-	VPHSUBW          Inst = 1311<<21 | 1<<16 | 2266
-	VPHSUBWD         Inst = 1312<<21 | 1<<16 | 2267
-	VPINSRB          Inst = 1313<<21 | 2<<16 | 2268
-	VPINSRD          Inst = 1314<<21 | 1<<16 | 2270
-	VPINSRQ          Inst = 1315<<21 | 1<<16 | 2271
-	VPINSRW          Inst = 1316<<21 | 2<<16 | 2272
-	VPMACSDD         Inst = 1317<<21 | 1<<16 | 2274
-	VPMACSDQH        Inst = 1318<<21 | 1<<16 | 2275
-	VPMACSDQL        Inst = 1319<<21 | 1<<16 | 2276
-	VPMACSSDD        Inst = 1320<<21 | 1<<16 | 2277
-	VPMACSSDQH       Inst = 1321<<21 | 1<<16 | 2278
-	VPMACSSDQL       Inst = 1322<<21 | 1<<16 | 2279
-	VPMACSSWD        Inst = 1323<<21 | 1<<16 | 2280
-	VPMACSSWW        Inst = 1324<<21 | 1<<16 | 2281
-	VPMACSWD         Inst = 1325<<21 | 1<<16 | 2282
-	VPMACSWW         Inst = 1326<<21 | 1<<16 | 2283
-	VPMADCSSWD       Inst = 1327<<21 | 1<<16 | 2284
-	VPMADCSWD        Inst = 1328<<21 | 1<<16 | 2285
-	VPMADDUBSW       Inst = 1329<<21 | 1<<16 | 2286
-	VPMADDWD         Inst = 1330<<21 | 1<<16 | 2287
-	VPMASKMOVD       Inst = 1331<<21 | 2<<16 | 2288
-	VPMASKMOVQ       Inst = 1332<<21 | 2<<16 | 2290
-	VPMAXSB          Inst = 1333<<21 | 1<<16 | 2292
-	VPMAXSD          Inst = 1334<<21 | 1<<16 | 2293
-	VPMAXSW          Inst = 1335<<21 | 1<<16 | 2294
-	VPMAXUB          Inst = 1336<<21 | 1<<16 | 2295
-	VPMAXUD          Inst = 1337<<21 | 1<<16 | 2296
-	VPMAXUW          Inst = 1338<<21 | 1<<16 | 2297
-	VPMINSB          Inst = 1339<<21 | 1<<16 | 2298
-	VPMINSD          Inst = 1340<<21 | 1<<16 | 2299
-	VPMINSW          Inst = 1341<<21 | 1<<16 | 2300
-	VPMINUB          Inst = 1342<<21 | 1<<16 | 2301
-	VPMINUD          Inst = 1343<<21 | 1<<16 | 2302
-	VPMINUW          Inst = 1344<<21 | 1<<16 | 2303
-	VPMOVMSKB        Inst = 1345<<21 | 1<<16 | 2304
-	VPMOVSXBD        Inst = 1346<<21 | 2<<16 | 2305
-	VPMOVSXBQ        Inst = 1347<<21 | 2<<16 | 2307
-	VPMOVSXBW        Inst = 1348<<21 | 2<<16 | 2309
-	VPMOVSXDQ        Inst = 1349<<21 | 2<<16 | 2311
-	VPMOVSXWD        Inst = 1350<<21 | 2<<16 | 2313
-	VPMOVSXWQ        Inst = 1351<<21 | 2<<16 | 2315
-	VPMOVZXBD        Inst = 1352<<21 | 2<<16 | 2317
-	VPMOVZXBQ        Inst = 1353<<21 | 2<<16 | 2319
-	VPMOVZXBW        Inst = 1354<<21 | 2<<16 | 2321
-	VPMOVZXDQ        Inst = 1355<<21 | 2<<16 | 2323
-	VPMOVZXWD        Inst = 1356<<21 | 2<<16 | 2325
-	VPMOVZXWQ        Inst = 1357<<21 | 2<<16 | 2327
-	VPMULDQ          Inst = 1358<<21 | 1<<16 | 2329
-	VPMULHRSW        Inst = 1359<<21 | 1<<16 | 2330
-	VPMULHUW         Inst = 1360<<21 | 1<<16 | 2331
-	VPMULHW          Inst = 1361<<21 | 1<<16 | 2332
-	VPMULLD          Inst = 1362<<21 | 1<<16 | 2333
-	VPMULLW          Inst = 1363<<21 | 1<<16 | 2334
-	VPMULUDQ         Inst = 1364<<21 | 1<<16 | 2335
-	VPOR             Inst = 1365<<21 | 1<<16 | 2336
-	VPPERM           Inst = 1366<<21 | 2<<16 | 2337
-	VPROTB           Inst = 1367<<21 | 3<<16 | 2339
-	VPROTD           Inst = 1368<<21 | 3<<16 | 2342
-	VPROTQ           Inst = 1369<<21 | 3<<16 | 2345
-	VPROTW           Inst = 1370<<21 | 3<<16 | 2348
-	VPSADBW          Inst = 1371<<21 | 1<<16 | 2351
-	VPSHAB           Inst = 1372<<21 | 2<<16 | 2352
-	VPSHAD           Inst = 1373<<21 | 2<<16 | 2354
-	VPSHAQ           Inst = 1374<<21 | 2<<16 | 2356
-	VPSHAW           Inst = 1375<<21 | 2<<16 | 2358
-	VPSHLB           Inst = 1376<<21 | 2<<16 | 2360
-	VPSHLD           Inst = 1377<<21 | 2<<16 | 2362
-	VPSHLQ           Inst = 1378<<21 | 2<<16 | 2364
-	VPSHLW           Inst = 1379<<21 | 2<<16 | 2366
-	VPSHUFB          Inst = 1380<<21 | 1<<16 | 2368
-	VPSHUFD          Inst = 1381<<21 | 1<<16 | 2369
-	VPSHUFHW         Inst = 1382<<21 | 1<<16 | 2370
-	VPSHUFLW         Inst = 1383<<21 | 1<<16 | 2371
-	VPSIGNB          Inst = 1384<<21 | 1<<16 | 2372
-	VPSIGND          Inst = 1385<<21 | 1<<16 | 2373
-	VPSIGNW          Inst = 1386<<21 | 1<<16 | 2374
-	VPSLLD           Inst = 1387<<21 | 2<<16 | 2375
-	VPSLLDQ          Inst = 1388<<21 | 1<<16 | 2377
-	VPSLLQ           Inst = 1389<<21 | 2<<16 | 2378
-	VPSLLVD          Inst = 1390<<21 | 1<<16 | 2380
-	VPSLLVQ          Inst = 1391<<21 | 1<<16 | 2381
-	VPSLLW           Inst = 1392<<21 | 2<<16 | 2382
-	VPSRAD           Inst = 1393<<21 | 2<<16 | 2384
-	VPSRAVD          Inst = 1394<<21 | 1<<16 | 2386
-	VPSRAW           Inst = 1395<<21 | 2<<16 | 2387
-	VPSRLD           Inst = 1396<<21 | 2<<16 | 2389
-	VPSRLDQ          Inst = 1397<<21 | 1<<16 | 2391
-	VPSRLQ           Inst = 1398<<21 | 2<<16 | 2392
-	VPSRLVD          Inst = 1399<<21 | 1<<16 | 2394
-	VPSRLVQ          Inst = 1400<<21 | 1<<16 | 2395
-	VPSRLW           Inst = 1401<<21 | 2<<16 | 2396
-	VPSUBB           Inst = 1402<<21 | 1<<16 | 2398
-	VPSUBD           Inst = 1403<<21 | 1<<16 | 2399
-	VPSUBQ           Inst = 1404<<21 | 1<<16 | 2400
-	VPSUBSB          Inst = 1405<<21 | 1<<16 | 2401
-	VPSUBSW          Inst = 1406<<21 | 1<<16 | 2402
-	VPSUBUSB         Inst = 1407<<21 | 1<<16 | 2403
-	VPSUBUSW         Inst = 1408<<21 | 1<<16 | 2404
-	VPSUBW           Inst = 1409<<21 | 1<<16 | 2405
-	VPTEST           Inst = 1410<<21 | 1<<16 | 2406
-	VPUNPCKHBW       Inst = 1411<<21 | 1<<16 | 2407
-	VPUNPCKHDQ       Inst = 1412<<21 | 1<<16 | 2408
-	VPUNPCKHQDQ      Inst = 1413<<21 | 1<<16 | 2409
-	VPUNPCKHWD       Inst = 1414<<21 | 1<<16 | 2410
-	VPUNPCKLBW       Inst = 1415<<21 | 1<<16 | 2411
-	VPUNPCKLDQ       Inst = 1416<<21 | 1<<16 | 2412
-	VPUNPCKLQDQ      Inst = 1417<<21 | 1<<16 | 2413
-	VPUNPCKLWD       Inst = 1418<<21 | 1<<16 | 2414
-	VPXOR            Inst = 1419<<21 | 1<<16 | 2415
-	VRCPPS           Inst = 1420<<21 | 1<<16 | 2416
-	VRCPSS           Inst = 1421<<21 | 2<<16 | 2417
-	VROUNDPD         Inst = 1422<<21 | 1<<16 | 2419
-	VROUNDPS         Inst = 1423<<21 | 1<<16 | 2420
-	VROUNDSD         Inst = 1424<<21 | 2<<16 | 2421
-	VROUNDSS         Inst = 1425<<21 | 2<<16 | 2423
-	VRSQRTPS         Inst = 1426<<21 | 1<<16 | 2425
-	VRSQRTSS         Inst = 1427<<21 | 2<<16 | 2426
-	VSHUFPD          Inst = 1428<<21 | 1<<16 | 2428
-	VSHUFPS          Inst = 1429<<21 | 1<<16 | 2429
-	VSQRTPD          Inst = 1430<<21 | 1<<16 | 2430
-	VSQRTPS          Inst = 1431<<21 | 1<<16 | 2431
-	VSQRTSD          Inst = 1432<<21 | 2<<16 | 2432
-	VSQRTSS          Inst = 1433<<21 | 2<<16 | 2434
-	VSTMXCSR         Inst = 1434<<21 | 1<<16 | 2436
-	VSUBPD           Inst = 1435<<21 | 1<<16 | 2437
-	VSUBPS           Inst = 1436<<21 | 1<<16 | 2438
-	VSUBSD           Inst = 1437<<21 | 2<<16 | 2439
-	VSUBSS           Inst = 1438<<21 | 2<<16 | 2441
-	VTESTPD          Inst = 1439<<21 | 1<<16 | 2443
-	VTESTPS          Inst = 1440<<21 | 1<<16 | 2444
-	VUCOMISD         Inst = 1441<<21 | 2<<16 | 2445
-	VUCOMISS         Inst = 1442<<21 | 2<<16 | 2447
-	VUNPCKHPD        Inst = 1443<<21 | 1<<16 | 2449
-	VUNPCKHPS        Inst = 1444<<21 | 1<<16 | 2450
-	VUNPCKLPD        Inst = 1445<<21 | 1<<16 | 2451
-	VUNPCKLPS        Inst = 1446<<21 | 1<<16 | 2452
-	VXORPD           Inst = 1447<<21 | 1<<16 | 2453
-	VXORPS           Inst = 1448<<21 | 1<<16 | 2454
-	VZEROALL         Inst = 1449<<21 | 1<<16 | 2455
-	VZEROUPPER       Inst = 1450<<21 | 1<<16 | 2456
+	INVPCID          Inst = 153<<21 | 1<<16 | 284
+	INVVPID          Inst = 154<<21 | 1<<16 | 285
+	IRET             Inst = 155<<21 | 1<<16 | 286
+	IRETD            Inst = 156<<21 | 1<<16 | 287
+	IRETQ            Inst = 157<<21 | 1<<16 | 288
+	IRETW            Inst = 158<<21 | 1<<16 | 289
+	JA               Inst = 159<<21 | 2<<16 | 290
+	JAE              Inst = 160<<21 | 2<<16 | 292
+	JB               Inst = 161<<21 | 2<<16 | 294
+	JBE              Inst = 162<<21 | 2<<16 | 296
+	JC               Inst = 163<<21 | 2<<16 | 298
+	JE               Inst = 164<<21 | 2<<16 | 300
+	JECXZ            Inst = 165<<21 | 1<<16 | 302
+	JG               Inst = 166<<21 | 2<<16 | 303
+	JGE              Inst = 167<<21 | 2<<16 | 305
+	JL               Inst = 168<<21 | 2<<16 | 307
+	JLE              Inst = 169<<21 | 2<<16 | 309
+	JMP              Inst = 170<<21 | 3<<16 | 311
+	JNA              Inst = 171<<21 | 2<<16 | 314
+	JNAE             Inst = 172<<21 | 2<<16 | 316
+	JNB              Inst = 173<<21 | 2<<16 | 318
+	JNBE             Inst = 174<<21 | 2<<16 | 320
+	JNC              Inst = 175<<21 | 2<<16 | 322
+	JNE              Inst = 176<<21 | 2<<16 | 324
+	JNG              Inst = 177<<21 | 2<<16 | 326
+	JNGE             Inst = 178<<21 | 2<<16 | 328
+	JNL              Inst = 179<<21 | 2<<16 | 330
+	JNLE             Inst = 180<<21 | 2<<16 | 332
+	JNO              Inst = 181<<21 | 2<<16 | 334
+	JNP              Inst = 182<<21 | 2<<16 | 336
+	JNS              Inst = 183<<21 | 2<<16 | 338
+	JNZ              Inst = 184<<21 | 2<<16 | 340
+	JO               Inst = 185<<21 | 2<<16 | 342
+	JP               Inst = 186<<21 | 2<<16 | 344
+	JPE              Inst = 187<<21 | 2<<16 | 346
+	JPO              Inst = 188<<21 | 2<<16 | 348
+	JRCXZ            Inst = 189<<21 | 1<<16 | 350
+	JS               Inst = 190<<21 | 2<<16 | 351
+	JZ               Inst = 191<<21 | 2<<16 | 353
+	LAHF             Inst = 192<<21 | 1<<16 | 355
+	LAR              Inst = 193<<21 | 2<<16 | 356
+	LDDQU            Inst = 194<<21 | 1<<16 | 358
+	LDMXCSR          Inst = 195<<21 | 1<<16 | 359
+	LEA              Inst = 196<<21 | 1<<16 | 360
+	LEAVE            Inst = 197<<21 | 1<<16 | 361
+	LFENCE           Inst = 198<<21 | 1<<16 | 362
+	LFS              Inst = 199<<21 | 1<<16 | 363
+	LGDT             Inst = 200<<21 | 1<<16 | 364
+	LGS              Inst = 201<<21 | 1<<16 | 365
+	LIDT             Inst = 202<<21 | 1<<16 | 366
+	LLDT             Inst = 203<<21 | 2<<16 | 367
+	LLWPCB           Inst = 204<<21 | 1<<16 | 369
+	LMSW             Inst = 205<<21 | 2<<16 | 370
+	LODSB            Inst = 206<<21 | 1<<16 | 372
+	LODSD            Inst = 207<<21 | 1<<16 | 373
+	LODSQ            Inst = 208<<21 | 1<<16 | 374
+	LODSW            Inst = 209<<21 | 1<<16 | 375
+	LOOP             Inst = 210<<21 | 1<<16 | 376
+	LOOPE            Inst = 211<<21 | 1<<16 | 377
+	LOOPNE           Inst = 212<<21 | 1<<16 | 378
+	LOOPNZ           Inst = 213<<21 | 1<<16 | 379
+	LOOPZ            Inst = 214<<21 | 1<<16 | 380
+	LSL              Inst = 215<<21 | 2<<16 | 381
+	LSS              Inst = 216<<21 | 1<<16 | 383
+	LTR              Inst = 217<<21 | 2<<16 | 384
+	LWPINS           Inst = 218<<21 | 1<<16 | 386
+	LWPVAL           Inst = 219<<21 | 1<<16 | 387
+	LZCNT            Inst = 220<<21 | 1<<16 | 388
+	MASKMOVDQU       Inst = 221<<21 | 1<<16 | 389
+	MASKMOVQ         Inst = 222<<21 | 1<<16 | 390
+	MAXSD            Inst = 223<<21 | 2<<16 | 391
+	MAXSS            Inst = 224<<21 | 2<<16 | 393
+	MFENCE           Inst = 225<<21 | 1<<16 | 395
+	MINSD            Inst = 226<<21 | 2<<16 | 396
+	MINSS            Inst = 227<<21 | 2<<16 | 398
+	MONITOR          Inst = 228<<21 | 2<<16 | 400
+	MONITORX         Inst = 229<<21 | 2<<16 | 402
+	MOV              Inst = 230<<21 | 26<<16 | 404
+	MOVABS           Inst = 231<<21 | 8<<16 | 430
+	MOVAPD           Inst = 232<<21 | 4<<16 | 438
+	MOVAPS           Inst = 233<<21 | 2<<16 | 442
+	MOVBE            Inst = 234<<21 | 2<<16 | 444
+	MOVD             Inst = 235<<21 | 8<<16 | 446
+	MOVDDUP          Inst = 236<<21 | 2<<16 | 454
+	MOVDQ2Q          Inst = 237<<21 | 1<<16 | 456
+	MOVDQA           Inst = 238<<21 | 4<<16 | 457
+	MOVDQU           Inst = 239<<21 | 4<<16 | 461
+	MOVHLPS          Inst = 240<<21 | 1<<16 | 465
+	MOVHPD           Inst = 241<<21 | 2<<16 | 466
+	MOVHPS           Inst = 242<<21 | 2<<16 | 468
+	MOVLHPS          Inst = 243<<21 | 1<<16 | 470
+	MOVLPD           Inst = 244<<21 | 2<<16 | 471
+	MOVLPS           Inst = 245<<21 | 2<<16 | 473
+	MOVMSKPD         Inst = 246<<21 | 2<<16 | 475
+	MOVMSKPS         Inst = 247<<21 | 2<<16 | 477
+	MOVNTDQ          Inst = 248<<21 | 1<<16 | 479
+	MOVNTDQA         Inst = 249<<21 | 1<<16 | 480
+	MOVNTI           Inst = 250<<21 | 2<<16 | 481
+	MOVNTPD          Inst = 251<<21 | 1<<16 | 483
+	MOVNTPS          Inst = 252<<21 | 1<<16 | 484
+	MOVNTQ           Inst = 253<<21 | 1<<16 | 485
+	MOVNTSD          Inst = 254<<21 | 1<<16 | 486
+	MOVNTSS          Inst = 255<<21 | 1<<16 | 487
+	MOVQ             Inst = 256<<21 | 10<<16 | 488
+	MOVQ2DQ          Inst = 257<<21 | 1<<16 | 498
+	MOVSB            Inst = 258<<21 | 1<<16 | 499
+	MOVSD            Inst = 259<<21 | 5<<16 | 500
+	MOVSHDUP         Inst = 260<<21 | 2<<16 | 505
+	MOVSLDUP         Inst = 261<<21 | 2<<16 | 507
+	MOVSQ            Inst = 262<<21 | 1<<16 | 509
+	MOVSS            Inst = 263<<21 | 3<<16 | 510
+	MOVSW            Inst = 264<<21 | 1<<16 | 513
+	MOVSX            Inst = 265<<21 | 4<<16 | 514
+	MOVSXD           Inst = 266<<21 | 1<<16 | 518
+	MOVUPD           Inst = 267<<21 | 4<<16 | 519
+	MOVUPS           Inst = 268<<21 | 2<<16 | 523
+	MOVZX            Inst = 269<<21 | 3<<16 | 525
+	MPSADBW          Inst = 270<<21 | 2<<16 | 528
+	MUL              Inst = 271<<21 | 2<<16 | 530
+	MULSD            Inst = 272<<21 | 2<<16 | 532
+	MULSS            Inst = 273<<21 | 2<<16 | 534
+	MULX             Inst = 274<<21 | 1<<16 | 536
+	MWAIT            Inst = 275<<21 | 2<<16 | 537
+	MWAITX           Inst = 276<<21 | 2<<16 | 539
+	NEG              Inst = 277<<21 | 4<<16 | 541
+	NOP              Inst = 278<<21 | 2<<16 | 545
+	NOT              Inst = 279<<21 | 4<<16 | 547
+	OR               Inst = 280<<21 | 14<<16 | 551
+	OUT              Inst = 281<<21 | 6<<16 | 565
+	OUTSB            Inst = 282<<21 | 1<<16 | 571
+	OUTSD            Inst = 283<<21 | 1<<16 | 572
+	OUTSW            Inst = 284<<21 | 1<<16 | 573
+	PAUSE            Inst = 285<<21 | 1<<16 | 574
+	POP              Inst = 286<<21 | 4<<16 | 575
+	POPCNT           Inst = 287<<21 | 1<<16 | 579
+	POPF             Inst = 288<<21 | 1<<16 | 580
+	POPFQ            Inst = 289<<21 | 1<<16 | 581
+	POPFW            Inst = 290<<21 | 1<<16 | 582
+	PREFETCH         Inst = 291<<21 | 1<<16 | 583
+	PREFETCHNTA      Inst = 292<<21 | 1<<16 | 584
+	PREFETCHT0       Inst = 293<<21 | 1<<16 | 585
+	PREFETCHT1       Inst = 294<<21 | 1<<16 | 586
+	PREFETCHT2       Inst = 295<<21 | 1<<16 | 587
+	PREFETCHW        Inst = 296<<21 | 1<<16 | 588
+	PREFETCHWT1      Inst = 297<<21 | 1<<16 | 589
+	PUSH             Inst = 298<<21 | 7<<16 | 590
+	PUSHF            Inst = 299<<21 | 1<<16 | 597
+	PUSHFQ           Inst = 300<<21 | 1<<16 | 598
+	PUSHFW           Inst = 301<<21 | 1<<16 | 599
+	RCL              Inst = 302<<21 | 4<<16 | 600
+	RCPSS            Inst = 303<<21 | 2<<16 | 604
+	RCR              Inst = 304<<21 | 4<<16 | 606
+	RDFSBASE         Inst = 305<<21 | 2<<16 | 610
+	RDGSBASE         Inst = 306<<21 | 2<<16 | 612
+	RDMSR            Inst = 307<<21 | 1<<16 | 614
+	RDPID            Inst = 308<<21 | 1<<16 | 615
+	RDPKRU           Inst = 309<<21 | 1<<16 | 616
+	RDPMC            Inst = 310<<21 | 1<<16 | 617
+	RDRAND           Inst = 311<<21 | 1<<16 | 618
+	RDSEED           Inst = 312<<21 | 1<<16 | 619
+	RDTSC            Inst = 313<<21 | 1<<16 | 620
+	RDTSCP           Inst = 314<<21 | 1<<16 | 621
+	RET              Inst = 315<<21 | 2<<16 | 622
+	RETF             Inst = 316<<21 | 2<<16 | 624
+	RETN             Inst = 317<<21 | 2<<16 | 626
+	ROL              Inst = 318<<21 | 4<<16 | 628
+	ROR              Inst = 319<<21 | 4<<16 | 632
+	RORX             Inst = 320<<21 | 1<<16 | 636
+	ROUNDSD          Inst = 321<<21 | 2<<16 | 637
+	ROUNDSS          Inst = 322<<21 | 2<<16 | 639
+	RSM              Inst = 323<<21 | 1<<16 | 641
+	RSQRTSS          Inst = 324<<21 | 2<<16 | 642
+	SAHF             Inst = 325<<21 | 1<<16 | 644
+	SAL              Inst = 326<<21 | 4<<16 | 645
+	SAR              Inst = 327<<21 | 4<<16 | 649
+	SARX             Inst = 328<<21 | 1<<16 | 653
+	SBB              Inst = 329<<21 | 14<<16 | 654
+	SCASB            Inst = 330<<21 | 1<<16 | 668
+	SCASD            Inst = 331<<21 | 1<<16 | 669
+	SCASQ            Inst = 332<<21 | 1<<16 | 670
+	SCASW            Inst = 333<<21 | 1<<16 | 671
+	SETA             Inst = 334<<21 | 1<<16 | 672
+	SETAE            Inst = 335<<21 | 1<<16 | 673
+	SETB             Inst = 336<<21 | 1<<16 | 674
+	SETBE            Inst = 337<<21 | 1<<16 | 675
+	SETC             Inst = 338<<21 | 1<<16 | 676
+	SETE             Inst = 339<<21 | 1<<16 | 677
+	SETG             Inst = 340<<21 | 1<<16 | 678
+	SETGE            Inst = 341<<21 | 1<<16 | 679
+	SETL             Inst = 342<<21 | 1<<16 | 680
+	SETLE            Inst = 343<<21 | 1<<16 | 681
+	SETNA            Inst = 344<<21 | 1<<16 | 682
+	SETNAE           Inst = 345<<21 | 1<<16 | 683
+	SETNB            Inst = 346<<21 | 1<<16 | 684
+	SETNBE           Inst = 347<<21 | 1<<16 | 685
+	SETNC            Inst = 348<<21 | 1<<16 | 686
+	SETNE            Inst = 349<<21 | 1<<16 | 687
+	SETNG            Inst = 350<<21 | 1<<16 | 688
+	SETNGE           Inst = 351<<21 | 1<<16 | 689
+	SETNL            Inst = 352<<21 | 1<<16 | 690
+	SETNLE           Inst = 353<<21 | 1<<16 | 691
+	SETNO            Inst = 354<<21 | 1<<16 | 692
+	SETNP            Inst = 355<<21 | 1<<16 | 693
+	SETNS            Inst = 356<<21 | 1<<16 | 694
+	SETNZ            Inst = 357<<21 | 1<<16 | 695
+	SETO             Inst = 358<<21 | 1<<16 | 696
+	SETP             Inst = 359<<21 | 1<<16 | 697
+	SETPE            Inst = 360<<21 | 1<<16 | 698
+	SETPO            Inst = 361<<21 | 1<<16 | 699
+	SETS             Inst = 362<<21 | 1<<16 | 700
+	SETZ             Inst = 363<<21 | 1<<16 | 701
+	SFENCE           Inst = 364<<21 | 1<<16 | 702
+	SGDT             Inst = 365<<21 | 1<<16 | 703
+	SHL              Inst = 366<<21 | 4<<16 | 704
+	SHLD             Inst = 367<<21 | 2<<16 | 708
+	SHLX             Inst = 368<<21 | 1<<16 | 710
+	SHR              Inst = 369<<21 | 4<<16 | 711
+	SHRD             Inst = 370<<21 | 2<<16 | 715
+	SHRX             Inst = 371<<21 | 1<<16 | 717
+	SIDT             Inst = 372<<21 | 1<<16 | 718
+	SKINIT           Inst = 373<<21 | 1<<16 | 719
+	SLDT             Inst = 374<<21 | 2<<16 | 720
+	SLWPCB           Inst = 375<<21 | 1<<16 | 722
+	SMSW             Inst = 376<<21 | 2<<16 | 723
+	SQRTSD           Inst = 377<<21 | 2<<16 | 725
+	SQRTSS           Inst = 378<<21 | 2<<16 | 727
+	STAC             Inst = 379<<21 | 1<<16 | 729
+	STC              Inst = 380<<21 | 1<<16 | 730
+	STD              Inst = 381<<21 | 1<<16 | 731
+	STGI             Inst = 382<<21 | 1<<16 | 732
+	STI              Inst = 383<<21 | 1<<16 | 733
+	STMXCSR          Inst = 384<<21 | 1<<16 | 734
+	STOSB            Inst = 385<<21 | 1<<16 | 735
+	STOSD            Inst = 386<<21 | 1<<16 | 736
+	STOSQ            Inst = 387<<21 | 1<<16 | 737
+	STOSW            Inst = 388<<21 | 1<<16 | 738
+	STR              Inst = 389<<21 | 2<<16 | 739
+	SUB              Inst = 390<<21 | 14<<16 | 741
+	SUBSD            Inst = 391<<21 | 2<<16 | 755
+	SUBSS            Inst = 392<<21 | 2<<16 | 757
+	SWAPGS           Inst = 393<<21 | 1<<16 | 759
+	SYSCALL          Inst = 394<<21 | 1<<16 | 760
+	SYSRET           Inst = 395<<21 | 1<<16 | 761
+	T1MSKC           Inst = 396<<21 | 1<<16 | 762
+	TEST             Inst = 397<<21 | 8<<16 | 763
+	TZCNT            Inst = 398<<21 | 1<<16 | 771
+	TZMSK            Inst = 399<<21 | 1<<16 | 772
+	UCOMISD          Inst = 400<<21 | 2<<16 | 773
+	UCOMISS          Inst = 401<<21 | 2<<16 | 775
+	UD2              Inst = 402<<21 | 1<<16 | 777
+	UD2A             Inst = 403<<21 | 1<<16 | 778
+	WBINVD           Inst = 404<<21 | 1<<16 | 779
+	WRFSBASE         Inst = 405<<21 | 2<<16 | 780
+	WRGSBASE         Inst = 406<<21 | 2<<16 | 782
+	WRMSR            Inst = 407<<21 | 1<<16 | 784
+	WRPKRU           Inst = 408<<21 | 1<<16 | 785
+	XOR              Inst = 409<<21 | 14<<16 | 786
+	AESDEC           Inst = 410<<21 | 1<<16 | 800
+	AESDECLAST       Inst = 411<<21 | 1<<16 | 801
+	AESENC           Inst = 412<<21 | 1<<16 | 802
+	AESENCLAST       Inst = 413<<21 | 1<<16 | 803
+	AESIMC           Inst = 414<<21 | 1<<16 | 804
+	AESKEYGENASSIST  Inst = 415<<21 | 1<<16 | 805
+	SHA1MSG1         Inst = 416<<21 | 1<<16 | 806
+	SHA1MSG2         Inst = 417<<21 | 1<<16 | 807
+	SHA1NEXTE        Inst = 418<<21 | 1<<16 | 808
+	SHA1RNDS4        Inst = 419<<21 | 1<<16 | 809
+	SHA256MSG1       Inst = 420<<21 | 1<<16 | 810
+	SHA256MSG2       Inst = 421<<21 | 1<<16 | 811
+	SHA256RNDS2      Inst = 422<<21 | 1<<16 | 812
+	XABORT           Inst = 423<<21 | 1<<16 | 813
+	XADD             Inst = 424<<21 | 4<<16 | 814
+	XBEGIN           Inst = 425<<21 | 1<<16 | 818
+	XCHG             Inst = 426<<21 | 10<<16 | 819
+	XEND             Inst = 427<<21 | 1<<16 | 829
+	XGETBV           Inst = 428<<21 | 1<<16 | 830
+	XLAT             Inst = 429<<21 | 1<<16 | 831
+	XLATB            Inst = 430<<21 | 1<<16 | 832
+	XRSTOR           Inst = 431<<21 | 1<<16 | 833
+	XRSTOR64         Inst = 432<<21 | 1<<16 | 834
+	XRSTORS64        Inst = 433<<21 | 1<<16 | 835
+	XSAVE            Inst = 434<<21 | 1<<16 | 836
+	XSAVE64          Inst = 435<<21 | 1<<16 | 837
+	XSAVEC64         Inst = 436<<21 | 1<<16 | 838
+	XSAVEOPT64       Inst = 437<<21 | 1<<16 | 839
+	XSAVES64         Inst = 438<<21 | 1<<16 | 840
+	XSETBV           Inst = 439<<21 | 1<<16 | 841
+	XTEST            Inst = 440<<21 | 1<<16 | 842
+	F2XM1            Inst = 441<<21 | 1<<16 | 843
+	FABS             Inst = 442<<21 | 1<<16 | 844
+	FADD             Inst = 443<<21 | 6<<16 | 845
+	FADDP            Inst = 444<<21 | 3<<16 | 851
+	FBLD             Inst = 445<<21 | 1<<16 | 854
+	FBSTP            Inst = 446<<21 | 1<<16 | 855
+	FCHS             Inst = 447<<21 | 1<<16 | 856
+	FCLEX            Inst = 448<<21 | 1<<16 | 857
+	FCMOVB           Inst = 449<<21 | 3<<16 | 858
+	FCMOVBE          Inst = 450<<21 | 3<<16 | 861
+	FCMOVE           Inst = 451<<21 | 3<<16 | 864
+	FCMOVNB          Inst = 452<<21 | 3<<16 | 867
+	FCMOVNBE         Inst = 453<<21 | 3<<16 | 870
+	FCMOVNE          Inst = 454<<21 | 3<<16 | 873
+	FCMOVNU          Inst = 455<<21 | 3<<16 | 876
+	FCMOVU           Inst = 456<<21 | 3<<16 | 879
+	FCOM             Inst = 457<<21 | 5<<16 | 882
+	FCOMI            Inst = 458<<21 | 3<<16 | 887
+	FCOMIP           Inst = 459<<21 | 3<<16 | 890
+	FCOMP            Inst = 460<<21 | 5<<16 | 893
+	FCOMPP           Inst = 461<<21 | 1<<16 | 898
+	FCOS             Inst = 462<<21 | 1<<16 | 899
+	FDECSTP          Inst = 463<<21 | 1<<16 | 900
+	FDISI            Inst = 464<<21 | 1<<16 | 901
+	FDIV             Inst = 465<<21 | 6<<16 | 902
+	FDIVP            Inst = 466<<21 | 3<<16 | 908
+	FDIVR            Inst = 467<<21 | 6<<16 | 911
+	FDIVRP           Inst = 468<<21 | 3<<16 | 917
+	FEMMS            Inst = 469<<21 | 1<<16 | 920
+	FENI             Inst = 470<<21 | 1<<16 | 921
+	FFREE            Inst = 471<<21 | 2<<16 | 922
+	FIADD            Inst = 472<<21 | 2<<16 | 924
+	FICOM            Inst = 473<<21 | 2<<16 | 926
+	FICOMP           Inst = 474<<21 | 2<<16 | 928
+	FIDIV            Inst = 475<<21 | 2<<16 | 930
+	FIDIVR           Inst = 476<<21 | 2<<16 | 932
+	FILD             Inst = 477<<21 | 3<<16 | 934
+	FIMUL            Inst = 478<<21 | 2<<16 | 937
+	FINCSTP          Inst = 479<<21 | 1<<16 | 939
+	FINIT            Inst = 480<<21 | 1<<16 | 940
+	FIST             Inst = 481<<21 | 2<<16 | 941
+	FISTP            Inst = 482<<21 | 3<<16 | 943
+	FISTTP           Inst = 483<<21 | 3<<16 | 946
+	FISUB            Inst = 484<<21 | 2<<16 | 949
+	FISUBR           Inst = 485<<21 | 2<<16 | 951
+	FLD              Inst = 486<<21 | 5<<16 | 953
+	FLD1             Inst = 487<<21 | 1<<16 | 958
+	FLDCW            Inst = 488<<21 | 1<<16 | 959
+	FLDENV           Inst = 489<<21 | 1<<16 | 960
+	FLDL2E           Inst = 490<<21 | 1<<16 | 961
+	FLDL2T           Inst = 491<<21 | 1<<16 | 962
+	FLDLG2           Inst = 492<<21 | 1<<16 | 963
+	FLDLN2           Inst = 493<<21 | 1<<16 | 964
+	FLDPI            Inst = 494<<21 | 1<<16 | 965
+	FLDZ             Inst = 495<<21 | 1<<16 | 966
+	FMUL             Inst = 496<<21 | 6<<16 | 967
+	FMULP            Inst = 497<<21 | 3<<16 | 973
+	FNCLEX           Inst = 498<<21 | 1<<16 | 976
+	FNDISI           Inst = 499<<21 | 1<<16 | 977
+	FNENI            Inst = 500<<21 | 1<<16 | 978
+	FNINIT           Inst = 501<<21 | 1<<16 | 979
+	FNOP             Inst = 502<<21 | 1<<16 | 980
+	FNSAVE           Inst = 503<<21 | 1<<16 | 981
+	FNSTCW           Inst = 504<<21 | 1<<16 | 982
+	FNSTENV          Inst = 505<<21 | 1<<16 | 983
+	FNSTSW           Inst = 506<<21 | 2<<16 | 984
+	FPATAN           Inst = 507<<21 | 1<<16 | 986
+	FPREM            Inst = 508<<21 | 1<<16 | 987
+	FPREM1           Inst = 509<<21 | 1<<16 | 988
+	FPTAN            Inst = 510<<21 | 1<<16 | 989
+	FRNDINT          Inst = 511<<21 | 1<<16 | 990
+	FRSTOR           Inst = 512<<21 | 1<<16 | 991
+	FSAVE            Inst = 513<<21 | 1<<16 | 992
+	FSCALE           Inst = 514<<21 | 1<<16 | 993
+	FSETPM           Inst = 515<<21 | 1<<16 | 994
+	FSIN             Inst = 516<<21 | 1<<16 | 995
+	FSINCOS          Inst = 517<<21 | 1<<16 | 996
+	FSQRT            Inst = 518<<21 | 1<<16 | 997
+	FST              Inst = 519<<21 | 4<<16 | 998
+	FSTCW            Inst = 520<<21 | 1<<16 | 1002
+	FSTENV           Inst = 521<<21 | 1<<16 | 1003
+	FSTP             Inst = 522<<21 | 5<<16 | 1004
+	FSTSW            Inst = 523<<21 | 2<<16 | 1009
+	FSUB             Inst = 524<<21 | 6<<16 | 1011
+	FSUBP            Inst = 525<<21 | 3<<16 | 1017
+	FSUBR            Inst = 526<<21 | 6<<16 | 1020
+	FSUBRP           Inst = 527<<21 | 3<<16 | 1026
+	FTST             Inst = 528<<21 | 1<<16 | 1029
+	FUCOM            Inst = 529<<21 | 3<<16 | 1030
+	FUCOMI           Inst = 530<<21 | 3<<16 | 1033
+	FUCOMIP          Inst = 531<<21 | 3<<16 | 1036
+	FUCOMP           Inst = 532<<21 | 3<<16 | 1039
+	FUCOMPP          Inst = 533<<21 | 1<<16 | 1042
+	FWAIT            Inst = 534<<21 | 1<<16 | 1043
+	FXAM             Inst = 535<<21 | 1<<16 | 1044
+	FXCH             Inst = 536<<21 | 4<<16 | 1045
+	FXRSTOR          Inst = 537<<21 | 1<<16 | 1049
+	FXRSTOR64        Inst = 538<<21 | 1<<16 | 1050
+	FXSAVE           Inst = 539<<21 | 1<<16 | 1051
+	FXSAVE64         Inst = 540<<21 | 1<<16 | 1052
+	FXTRACT          Inst = 541<<21 | 1<<16 | 1053
+	FYL2X            Inst = 542<<21 | 1<<16 | 1054
+	FYL2XP1          Inst = 543<<21 | 1<<16 | 1055
+	ADDPD            Inst = 544<<21 | 1<<16 | 1056
+	ADDPS            Inst = 545<<21 | 1<<16 | 1057
+	ADDSUBPD         Inst = 546<<21 | 1<<16 | 1058
+	ADDSUBPS         Inst = 547<<21 | 1<<16 | 1059
+	ANDNPD           Inst = 548<<21 | 1<<16 | 1060
+	ANDNPS           Inst = 549<<21 | 1<<16 | 1061
+	ANDPD            Inst = 550<<21 | 1<<16 | 1062
+	ANDPS            Inst = 551<<21 | 1<<16 | 1063
+	BLENDPD          Inst = 552<<21 | 2<<16 | 1064
+	BLENDPS          Inst = 553<<21 | 2<<16 | 1066
+	BLENDVPD         Inst = 554<<21 | 2<<16 | 1068
+	BLENDVPS         Inst = 555<<21 | 2<<16 | 1070
+	CMPEQPD          Inst = 556<<21 | 1<<16 | 1072
+	CMPEQPS          Inst = 557<<21 | 1<<16 | 1073
+	CMPLEPD          Inst = 558<<21 | 1<<16 | 1074
+	CMPLEPS          Inst = 559<<21 | 1<<16 | 1075
+	CMPLTPD          Inst = 560<<21 | 1<<16 | 1076
+	CMPLTPS          Inst = 561<<21 | 1<<16 | 1077
+	CMPNEQPD         Inst = 562<<21 | 1<<16 | 1078
+	CMPNEQPS         Inst = 563<<21 | 1<<16 | 1079
+	CMPNLEPD         Inst = 564<<21 | 1<<16 | 1080
+	CMPNLEPS         Inst = 565<<21 | 1<<16 | 1081
+	CMPNLTPD         Inst = 566<<21 | 1<<16 | 1082
+	CMPNLTPS         Inst = 567<<21 | 1<<16 | 1083
+	CMPORDPD         Inst = 568<<21 | 1<<16 | 1084
+	CMPORDPS         Inst = 569<<21 | 1<<16 | 1085
+	CMPPD            Inst = 570<<21 | 1<<16 | 1086
+	CMPPS            Inst = 571<<21 | 2<<16 | 1087
+	CMPUNORDPD       Inst = 572<<21 | 1<<16 | 1089
+	CMPUNORDPS       Inst = 573<<21 | 1<<16 | 1090
+	CVTDQ2PD         Inst = 574<<21 | 2<<16 | 1091
+	CVTDQ2PS         Inst = 575<<21 | 1<<16 | 1093
+	CVTPD2PS         Inst = 576<<21 | 1<<16 | 1094
+	CVTPI2PD         Inst = 577<<21 | 1<<16 | 1095
+	CVTPI2PS         Inst = 578<<21 | 1<<16 | 1096
+	CVTPS2PD         Inst = 579<<21 | 2<<16 | 1097
+	DIVPD            Inst = 580<<21 | 1<<16 | 1099
+	DIVPS            Inst = 581<<21 | 1<<16 | 1100
+	DPPD             Inst = 582<<21 | 2<<16 | 1101
+	DPPS             Inst = 583<<21 | 2<<16 | 1103
+	EXTRACTPS        Inst = 584<<21 | 2<<16 | 1105
+	HADDPD           Inst = 585<<21 | 1<<16 | 1107
+	HADDPS           Inst = 586<<21 | 1<<16 | 1108
+	HSUBPD           Inst = 587<<21 | 1<<16 | 1109
+	HSUBPS           Inst = 588<<21 | 1<<16 | 1110
+	INSERTPS         Inst = 589<<21 | 2<<16 | 1111
+	MAXPD            Inst = 590<<21 | 1<<16 | 1113
+	MAXPS            Inst = 591<<21 | 1<<16 | 1114
+	MINPD            Inst = 592<<21 | 1<<16 | 1115
+	MINPS            Inst = 593<<21 | 1<<16 | 1116
+	MULPD            Inst = 594<<21 | 1<<16 | 1117
+	MULPS            Inst = 595<<21 | 1<<16 | 1118
+	ORPD             Inst = 596<<21 | 1<<16 | 1119
+	ORPS             Inst = 597<<21 | 1<<16 | 1120
+	RCPPS            Inst = 598<<21 | 1<<16 | 1121
+	ROUNDPD          Inst = 599<<21 | 2<<16 | 1122
+	ROUNDPS          Inst = 600<<21 | 2<<16 | 1124
+	RSQRTPS          Inst = 601<<21 | 1<<16 | 1126
+	SHUFPD           Inst = 602<<21 | 1<<16 | 1127
+	SHUFPS           Inst = 603<<21 | 1<<16 | 1128
+	SQRTPD           Inst = 604<<21 | 1<<16 | 1129
+	SQRTPS           Inst = 605<<21 | 1<<16 | 1130
+	SUBPD            Inst = 606<<21 | 1<<16 | 1131
+	SUBPS            Inst = 607<<21 | 1<<16 | 1132
+	UNPCKHPD         Inst = 608<<21 | 1<<16 | 1133
+	UNPCKHPS         Inst = 609<<21 | 1<<16 | 1134
+	UNPCKLPD         Inst = 610<<21 | 1<<16 | 1135
+	UNPCKLPS         Inst = 611<<21 | 1<<16 | 1136
+	XORPD            Inst = 612<<21 | 1<<16 | 1137
+	XORPS            Inst = 613<<21 | 1<<16 | 1138
+	PABSB            Inst = 614<<21 | 3<<16 | 1139
+	PABSD            Inst = 615<<21 | 3<<16 | 1142
+	PABSW            Inst = 616<<21 | 3<<16 | 1145
+	PACKSSDW         Inst = 617<<21 | 2<<16 | 1148
+	PACKSSWB         Inst = 618<<21 | 2<<16 | 1150
+	PACKUSDW         Inst = 619<<21 | 2<<16 | 1152
+	PACKUSWB         Inst = 620<<21 | 2<<16 | 1154
+	PADDB            Inst = 621<<21 | 2<<16 | 1156
+	PADDD            Inst = 622<<21 | 2<<16 | 1158
+	PADDQ            Inst = 623<<21 | 2<<16 | 1160
+	PADDSB           Inst = 624<<21 | 2<<16 | 1162
+	PADDSW           Inst = 625<<21 | 2<<16 | 1164
+	PADDUSB          Inst = 626<<21 | 2<<16 | 1166
+	PADDUSW          Inst = 627<<21 | 2<<16 | 1168
+	PADDW            Inst = 628<<21 | 2<<16 | 1170
+	PALIGNR          Inst = 629<<21 | 3<<16 | 1172
+	PAND             Inst = 630<<21 | 2<<16 | 1175
+	PANDN            Inst = 631<<21 | 2<<16 | 1177
+	PAVGB            Inst = 632<<21 | 2<<16 | 1179
+	PAVGUSB          Inst = 633<<21 | 1<<16 | 1181
+	PAVGW            Inst = 634<<21 | 2<<16 | 1182
+	PBLENDVB         Inst = 635<<21 | 2<<16 | 1184
+	PBLENDW          Inst = 636<<21 | 2<<16 | 1186
+	PCLMULHQHQDQ     Inst = 637<<21 | 1<<16 | 1188
+	PCLMULHQLQDQ     Inst = 638<<21 | 1<<16 | 1189
+	PCLMULLQHQDQ     Inst = 639<<21 | 1<<16 | 1190
+	PCLMULLQLQDQ     Inst = 640<<21 | 1<<16 | 1191
+	PCLMULQDQ        Inst = 641<<21 | 1<<16 | 1192
+	PCMPEQB          Inst = 642<<21 | 2<<16 | 1193
+	PCMPEQD          Inst = 643<<21 | 2<<16 | 1195
+	PCMPEQQ          Inst = 644<<21 | 2<<16 | 1197
+	PCMPEQW          Inst = 645<<21 | 2<<16 | 1199
+	PCMPESTRI        Inst = 646<<21 | 2<<16 | 1201
+	PCMPESTRM        Inst = 647<<21 | 2<<16 | 1203
+	PCMPGTB          Inst = 648<<21 | 2<<16 | 1205
+	PCMPGTD          Inst = 649<<21 | 2<<16 | 1207
+	PCMPGTQ          Inst = 650<<21 | 2<<16 | 1209
+	PCMPGTW          Inst = 651<<21 | 2<<16 | 1211
+	PCMPISTRI        Inst = 652<<21 | 2<<16 | 1213
+	PCMPISTRM        Inst = 653<<21 | 2<<16 | 1215
+	PDEP             Inst = 654<<21 | 1<<16 | 1217
+	PEXT             Inst = 655<<21 | 1<<16 | 1218
+	PEXTRB           Inst = 656<<21 | 3<<16 | 1219
+	PEXTRD           Inst = 657<<21 | 1<<16 | 1222
+	PEXTRQ           Inst = 658<<21 | 1<<16 | 1223
+	PEXTRW           Inst = 659<<21 | 5<<16 | 1224
+	PF2ID            Inst = 660<<21 | 1<<16 | 1229
+	PF2IW            Inst = 661<<21 | 1<<16 | 1230
+	PFACC            Inst = 662<<21 | 1<<16 | 1231
+	PFADD            Inst = 663<<21 | 1<<16 | 1232
+	PFCMPEQ          Inst = 664<<21 | 1<<16 | 1233
+	PFCMPGE          Inst = 665<<21 | 1<<16 | 1234
+	PFCMPGT          Inst = 666<<21 | 1<<16 | 1235
+	PFMAX            Inst = 667<<21 | 1<<16 | 1236
+	PFMIN            Inst = 668<<21 | 1<<16 | 1237
+	PFMUL            Inst = 669<<21 | 1<<16 | 1238
+	PFNACC           Inst = 670<<21 | 1<<16 | 1239
+	PFPNACC          Inst = 671<<21 | 1<<16 | 1240
+	PFRCP            Inst = 672<<21 | 1<<16 | 1241
+	PFRCPIT1         Inst = 673<<21 | 1<<16 | 1242
+	PFRCPIT2         Inst = 674<<21 | 1<<16 | 1243
+	PFRSQIT1         Inst = 675<<21 | 1<<16 | 1244
+	PFRSQRT          Inst = 676<<21 | 1<<16 | 1245
+	PFSUB            Inst = 677<<21 | 1<<16 | 1246
+	PFSUBR           Inst = 678<<21 | 1<<16 | 1247
+	PHADDD           Inst = 679<<21 | 3<<16 | 1248
+	PHADDSW          Inst = 680<<21 | 3<<16 | 1251
+	PHADDW           Inst = 681<<21 | 3<<16 | 1254
+	PHMINPOSUW       Inst = 682<<21 | 2<<16 | 1257
+	PHSUBD           Inst = 683<<21 | 3<<16 | 1259
+	PHSUBSW          Inst = 684<<21 | 3<<16 | 1262
+	PHSUBW           Inst = 685<<21 | 3<<16 | 1265
+	PI2FD            Inst = 686<<21 | 1<<16 | 1268
+	PI2FW            Inst = 687<<21 | 1<<16 | 1269
+	PINSRB           Inst = 688<<21 | 3<<16 | 1270
+	PINSRD           Inst = 689<<21 | 2<<16 | 1273
+	PINSRQ           Inst = 690<<21 | 2<<16 | 1275
+	PINSRW           Inst = 691<<21 | 7<<16 | 1277
+	PMADDUBSW        Inst = 692<<21 | 3<<16 | 1284
+	PMADDWD          Inst = 693<<21 | 2<<16 | 1287
+	PMAXSB           Inst = 694<<21 | 2<<16 | 1289
+	PMAXSD           Inst = 695<<21 | 2<<16 | 1291
+	PMAXSW           Inst = 696<<21 | 2<<16 | 1293
+	PMAXUB           Inst = 697<<21 | 2<<16 | 1295
+	PMAXUD           Inst = 698<<21 | 2<<16 | 1297
+	PMAXUW           Inst = 699<<21 | 2<<16 | 1299
+	PMINSB           Inst = 700<<21 | 2<<16 | 1301
+	PMINSD           Inst = 701<<21 | 2<<16 | 1303
+	PMINSW           Inst = 702<<21 | 2<<16 | 1305
+	PMINUB           Inst = 703<<21 | 2<<16 | 1307
+	PMINUD           Inst = 704<<21 | 2<<16 | 1309
+	PMINUW           Inst = 705<<21 | 2<<16 | 1311
+	PMOVMSKB         Inst = 706<<21 | 2<<16 | 1313
+	PMOVSXBD         Inst = 707<<21 | 2<<16 | 1315
+	PMOVSXBQ         Inst = 708<<21 | 2<<16 | 1317
+	PMOVSXBW         Inst = 709<<21 | 2<<16 | 1319
+	PMOVSXDQ         Inst = 710<<21 | 2<<16 | 1321
+	PMOVSXWD         Inst = 711<<21 | 2<<16 | 1323
+	PMOVSXWQ         Inst = 712<<21 | 2<<16 | 1325
+	PMOVZXBD         Inst = 713<<21 | 2<<16 | 1327
+	PMOVZXBQ         Inst = 714<<21 | 2<<16 | 1329
+	PMOVZXBW         Inst = 715<<21 | 2<<16 | 1331
+	PMOVZXDQ         Inst = 716<<21 | 2<<16 | 1333
+	PMOVZXWD         Inst = 717<<21 | 2<<16 | 1335
+	PMOVZXWQ         Inst = 718<<21 | 2<<16 | 1337
+	PMULDQ           Inst = 719<<21 | 2<<16 | 1339
+	PMULHRSW         Inst = 720<<21 | 3<<16 | 1341
+	PMULHRWA         Inst = 721<<21 | 1<<16 | 1344
+	PMULHUW          Inst = 722<<21 | 2<<16 | 1345
+	PMULHW           Inst = 723<<21 | 2<<16 | 1347
+	PMULLD           Inst = 724<<21 | 2<<16 | 1349
+	PMULLW           Inst = 725<<21 | 2<<16 | 1351
+	PMULUDQ          Inst = 726<<21 | 2<<16 | 1353
+	POR              Inst = 727<<21 | 2<<16 | 1355
+	PSADBW           Inst = 728<<21 | 2<<16 | 1357
+	PSHUFB           Inst = 729<<21 | 3<<16 | 1359
+	PSHUFD           Inst = 730<<21 | 1<<16 | 1362
+	PSHUFHW          Inst = 731<<21 | 1<<16 | 1363
+	PSHUFLW          Inst = 732<<21 | 1<<16 | 1364
+	PSHUFW           Inst = 733<<21 | 1<<16 | 1365
+	PSIGNB           Inst = 734<<21 | 3<<16 | 1366
+	PSIGND           Inst = 735<<21 | 3<<16 | 1369
+	PSIGNW           Inst = 736<<21 | 3<<16 | 1372
+	PSLLD            Inst = 737<<21 | 4<<16 | 1375
+	PSLLDQ           Inst = 738<<21 | 1<<16 | 1379
+	PSLLQ            Inst = 739<<21 | 4<<16 | 1380
+	PSLLW            Inst = 740<<21 | 4<<16 | 1384
+	PSRAD            Inst = 741<<21 | 4<<16 | 1388
+	PSRAW            Inst = 742<<21 | 4<<16 | 1392
+	PSRLD            Inst = 743<<21 | 4<<16 | 1396
+	PSRLDQ           Inst = 744<<21 | 1<<16 | 1400
+	PSRLQ            Inst = 745<<21 | 4<<16 | 1401
+	PSRLW            Inst = 746<<21 | 4<<16 | 1405
+	PSUBB            Inst = 747<<21 | 2<<16 | 1409
+	PSUBD            Inst = 748<<21 | 2<<16 | 1411
+	PSUBQ            Inst = 749<<21 | 2<<16 | 1413
+	PSUBSB           Inst = 750<<21 | 2<<16 | 1415
+	PSUBSW           Inst = 751<<21 | 2<<16 | 1417
+	PSUBUSB          Inst = 752<<21 | 2<<16 | 1419
+	PSUBUSW          Inst = 753<<21 | 2<<16 | 1421
+	PSUBW            Inst = 754<<21 | 2<<16 | 1423
+	PSWAPD           Inst = 755<<21 | 1<<16 | 1425
+	PTEST            Inst = 756<<21 | 2<<16 | 1426
+	PUNPCKHBW        Inst = 757<<21 | 2<<16 | 1428
+	PUNPCKHDQ        Inst = 758<<21 | 2<<16 | 1430
+	PUNPCKHQDQ       Inst = 759<<21 | 1<<16 | 1432
+	PUNPCKHWD        Inst = 760<<21 | 2<<16 | 1433
+	PUNPCKLBW        Inst = 761<<21 | 2<<16 | 1435
+	PUNPCKLDQ        Inst = 762<<21 | 2<<16 | 1437
+	PUNPCKLQDQ       Inst = 763<<21 | 1<<16 | 1439
+	PUNPCKLWD        Inst = 764<<21 | 2<<16 | 1440
+	PXOR             Inst = 765<<21 | 2<<16 | 1442
+	VADDPD           Inst = 766<<21 | 1<<16 | 1444
+	VADDPS           Inst = 767<<21 | 1<<16 | 1445
+	VADDSD           Inst = 768<<21 | 2<<16 | 1446
+	VADDSS           Inst = 769<<21 | 2<<16 | 1448
+	VADDSUBPD        Inst = 770<<21 | 1<<16 | 1450
+	VADDSUBPS        Inst = 771<<21 | 1<<16 | 1451
+	VAESDEC          Inst = 772<<21 | 1<<16 | 1452
+	VAESDECLAST      Inst = 773<<21 | 1<<16 | 1453
+	VAESENC          Inst = 774<<21 | 1<<16 | 1454
+	VAESENCLAST      Inst = 775<<21 | 1<<16 | 1455
+	VAESIMC          Inst = 776<<21 | 1<<16 | 1456
+	VAESKEYGENASSIST Inst = 777<<21 | 1<<16 | 1457
+	VANDNPD          Inst = 778<<21 | 1<<16 | 1458
+	VANDNPS          Inst = 779<<21 | 1<<16 | 1459
+	VANDPD           Inst = 780<<21 | 1<<16 | 1460
+	VANDPS           Inst = 781<<21 | 1<<16 | 1461
+	VBLENDPD         Inst = 782<<21 | 1<<16 | 1462
+	VBLENDPS         Inst = 783<<21 | 1<<16 | 1463
+	VBLENDVPD        Inst = 784<<21 | 1<<16 | 1464
+	VBLENDVPS        Inst = 785<<21 | 1<<16 | 1465
+	VBROADCASTF128   Inst = 786<<21 | 1<<16 | 1466
+	VBROADCASTI128   Inst = 787<<21 | 1<<16 | 1467
+	VBROADCASTSD     Inst = 788<<21 | 2<<16 | 1468
+	VBROADCASTSS     Inst = 789<<21 | 2<<16 | 1470
+	VCMPEQ_OSPD      Inst = 790<<21 | 2<<16 | 1472
+	VCMPEQ_OSPS      Inst = 791<<21 | 1<<16 | 1474
+	VCMPEQ_OSSD      Inst = 792<<21 | 2<<16 | 1475
+	VCMPEQ_OSSS      Inst = 793<<21 | 2<<16 | 1477
+	VCMPEQ_UQPD      Inst = 794<<21 | 2<<16 | 1479
+	VCMPEQ_UQPS      Inst = 795<<21 | 1<<16 | 1481
+	VCMPEQ_UQSD      Inst = 796<<21 | 2<<16 | 1482
+	VCMPEQ_UQSS      Inst = 797<<21 | 2<<16 | 1484
+	VCMPEQ_USPD      Inst = 798<<21 | 2<<16 | 1486
+	VCMPEQ_USPS      Inst = 799<<21 | 1<<16 | 1488
+	VCMPEQ_USSD      Inst = 800<<21 | 2<<16 | 1489
+	VCMPEQ_USSS      Inst = 801<<21 | 2<<16 | 1491
+	VCMPEQPD         Inst = 802<<21 | 1<<16 | 1493
+	VCMPEQPS         Inst = 803<<21 | 1<<16 | 1494
+	VCMPEQSD         Inst = 804<<21 | 2<<16 | 1495
+	VCMPEQSS         Inst = 805<<21 | 2<<16 | 1497
+	VCMPFALSE_OQPD   Inst = 806<<21 | 1<<16 | 1499
+	VCMPFALSE_OQPS   Inst = 807<<21 | 1<<16 | 1500
+	VCMPFALSE_OQSD   Inst = 808<<21 | 2<<16 | 1501
+	VCMPFALSE_OQSS   Inst = 809<<21 | 2<<16 | 1503
+	VCMPFALSE_OSPD   Inst = 810<<21 | 1<<16 | 1505
+	VCMPFALSE_OSPS   Inst = 811<<21 | 1<<16 | 1506
+	VCMPFALSE_OSSD   Inst = 812<<21 | 2<<16 | 1507
+	VCMPFALSE_OSSS   Inst = 813<<21 | 2<<16 | 1509
+	VCMPFALSEPD      Inst = 814<<21 | 2<<16 | 1511
+	VCMPFALSEPS      Inst = 815<<21 | 1<<16 | 1513
+	VCMPFALSESD      Inst = 816<<21 | 2<<16 | 1514
+	VCMPFALSESS      Inst = 817<<21 | 2<<16 | 1516
+	VCMPGE_OQPD      Inst = 818<<21 | 2<<16 | 1518
+	VCMPGE_OQPS      Inst = 819<<21 | 1<<16 | 1520
+	VCMPGE_OQSD      Inst = 820<<21 | 2<<16 | 1521
+	VCMPGE_OQSS      Inst = 821<<21 | 2<<16 | 1523
+	VCMPGE_OSPD      Inst = 822<<21 | 1<<16 | 1525
+	VCMPGE_OSPS      Inst = 823<<21 | 1<<16 | 1526
+	VCMPGE_OSSD      Inst = 824<<21 | 2<<16 | 1527
+	VCMPGE_OSSS      Inst = 825<<21 | 2<<16 | 1529
+	VCMPGEPD         Inst = 826<<21 | 1<<16 | 1531
+	VCMPGEPS         Inst = 827<<21 | 1<<16 | 1532
+	VCMPGESD         Inst = 828<<21 | 2<<16 | 1533
+	VCMPGESS         Inst = 829<<21 | 2<<16 | 1535
+	VCMPGT_OQPD      Inst = 830<<21 | 1<<16 | 1537
+	VCMPGT_OQPS      Inst = 831<<21 | 1<<16 | 1538
+	VCMPGT_OQSD      Inst = 832<<21 | 2<<16 | 1539
+	VCMPGT_OQSS      Inst = 833<<21 | 2<<16 | 1541
+	VCMPGT_OSPD      Inst = 834<<21 | 1<<16 | 1543
+	VCMPGT_OSPS      Inst = 835<<21 | 1<<16 | 1544
+	VCMPGT_OSSD      Inst = 836<<21 | 2<<16 | 1545
+	VCMPGT_OSSS      Inst = 837<<21 | 2<<16 | 1547
+	VCMPGTPD         Inst = 838<<21 | 2<<16 | 1549
+	VCMPGTPS         Inst = 839<<21 | 1<<16 | 1551
+	VCMPGTSD         Inst = 840<<21 | 2<<16 | 1552
+	VCMPGTSS         Inst = 841<<21 | 2<<16 | 1554
+	VCMPLE_OQPD      Inst = 842<<21 | 2<<16 | 1556
+	VCMPLE_OQPS      Inst = 843<<21 | 1<<16 | 1558
+	VCMPLE_OQSD      Inst = 844<<21 | 2<<16 | 1559
+	VCMPLE_OQSS      Inst = 845<<21 | 2<<16 | 1561
+	VCMPLE_OSPD      Inst = 846<<21 | 1<<16 | 1563
+	VCMPLE_OSPS      Inst = 847<<21 | 1<<16 | 1564
+	VCMPLE_OSSD      Inst = 848<<21 | 2<<16 | 1565
+	VCMPLE_OSSS      Inst = 849<<21 | 2<<16 | 1567
+	VCMPLEPD         Inst = 850<<21 | 2<<16 | 1569
+	VCMPLEPS         Inst = 851<<21 | 1<<16 | 1571
+	VCMPLESD         Inst = 852<<21 | 2<<16 | 1572
+	VCMPLESS         Inst = 853<<21 | 2<<16 | 1574
+	VCMPLT_OQPD      Inst = 854<<21 | 1<<16 | 1576
+	VCMPLT_OQPS      Inst = 855<<21 | 1<<16 | 1577
+	VCMPLT_OQSD      Inst = 856<<21 | 2<<16 | 1578
+	VCMPLT_OQSS      Inst = 857<<21 | 2<<16 | 1580
+	VCMPLT_OSPD      Inst = 858<<21 | 2<<16 | 1582
+	VCMPLT_OSPS      Inst = 859<<21 | 1<<16 | 1584
+	VCMPLT_OSSD      Inst = 860<<21 | 2<<16 | 1585
+	VCMPLT_OSSS      Inst = 861<<21 | 2<<16 | 1587
+	VCMPLTPD         Inst = 862<<21 | 2<<16 | 1589
+	VCMPLTPS         Inst = 863<<21 | 1<<16 | 1591
+	VCMPLTSD         Inst = 864<<21 | 2<<16 | 1592
+	VCMPLTSS         Inst = 865<<21 | 2<<16 | 1594
+	VCMPNEQ_OQPD     Inst = 866<<21 | 2<<16 | 1596
+	VCMPNEQ_OQPS     Inst = 867<<21 | 1<<16 | 1598
+	VCMPNEQ_OQSD     Inst = 868<<21 | 2<<16 | 1599
+	VCMPNEQ_OQSS     Inst = 869<<21 | 2<<16 | 1601
+	VCMPNEQ_OSPD     Inst = 870<<21 | 2<<16 | 1603
+	VCMPNEQ_OSPS     Inst = 871<<21 | 1<<16 | 1605
+	VCMPNEQ_OSSD     Inst = 872<<21 | 2<<16 | 1606
+	VCMPNEQ_OSSS     Inst = 873<<21 | 2<<16 | 1608
+	VCMPNEQ_UQPD     Inst = 874<<21 | 1<<16 | 1610
+	VCMPNEQ_UQPS     Inst = 875<<21 | 1<<16 | 1611
+	VCMPNEQ_UQSD     Inst = 876<<21 | 2<<16 | 1612
+	VCMPNEQ_UQSS     Inst = 877<<21 | 2<<16 | 1614
+	VCMPNEQ_USPD     Inst = 878<<21 | 2<<16 | 1616
+	VCMPNEQ_USPS     Inst = 879<<21 | 1<<16 | 1618
+	VCMPNEQ_USSD     Inst = 880<<21 | 2<<16 | 1619
+	VCMPNEQ_USSS     Inst = 881<<21 | 2<<16 | 1621
+	VCMPNEQPD        Inst = 882<<21 | 2<<16 | 1623
+	VCMPNEQPS        Inst = 883<<21 | 1<<16 | 1625
+	VCMPNEQSD        Inst = 884<<21 | 2<<16 | 1626
+	VCMPNEQSS        Inst = 885<<21 | 2<<16 | 1628
+	VCMPNGE_UQPD     Inst = 886<<21 | 1<<16 | 1630
+	VCMPNGE_UQPS     Inst = 887<<21 | 1<<16 | 1631
+	VCMPNGE_UQSD     Inst = 888<<21 | 2<<16 | 1632
+	VCMPNGE_UQSS     Inst = 889<<21 | 2<<16 | 1634
+	VCMPNGE_USPD     Inst = 890<<21 | 1<<16 | 1636
+	VCMPNGE_USPS     Inst = 891<<21 | 1<<16 | 1637
+	VCMPNGE_USSD     Inst = 892<<21 | 2<<16 | 1638
+	VCMPNGE_USSS     Inst = 893<<21 | 2<<16 | 1640
+	VCMPNGEPD        Inst = 894<<21 | 2<<16 | 1642
+	VCMPNGEPS        Inst = 895<<21 | 1<<16 | 1644
+	VCMPNGESD        Inst = 896<<21 | 2<<16 | 1645
+	VCMPNGESS        Inst = 897<<21 | 2<<16 | 1647
+	VCMPNGT_UQPD     Inst = 898<<21 | 1<<16 | 1649
+	VCMPNGT_UQPS     Inst = 899<<21 | 1<<16 | 1650
+	VCMPNGT_UQSD     Inst = 900<<21 | 2<<16 | 1651
+	VCMPNGT_UQSS     Inst = 901<<21 | 2<<16 | 1653
+	VCMPNGT_USPD     Inst = 902<<21 | 1<<16 | 1655
+	VCMPNGT_USPS     Inst = 903<<21 | 1<<16 | 1656
+	VCMPNGT_USSD     Inst = 904<<21 | 2<<16 | 1657
+	VCMPNGT_USSS     Inst = 905<<21 | 2<<16 | 1659
+	VCMPNGTPD        Inst = 906<<21 | 1<<16 | 1661
+	VCMPNGTPS        Inst = 907<<21 | 1<<16 | 1662
+	VCMPNGTSD        Inst = 908<<21 | 2<<16 | 1663
+	VCMPNGTSS        Inst = 909<<21 | 2<<16 | 1665
+	VCMPNLE_UQPD     Inst = 910<<21 | 1<<16 | 1667
+	VCMPNLE_UQPS     Inst = 911<<21 | 1<<16 | 1668
+	VCMPNLE_UQSD     Inst = 912<<21 | 2<<16 | 1669
+	VCMPNLE_UQSS     Inst = 913<<21 | 2<<16 | 1671
+	VCMPNLE_USPD     Inst = 914<<21 | 2<<16 | 1673
+	VCMPNLE_USPS     Inst = 915<<21 | 1<<16 | 1675
+	VCMPNLE_USSD     Inst = 916<<21 | 2<<16 | 1676
+	VCMPNLE_USSS     Inst = 917<<21 | 2<<16 | 1678
+	VCMPNLEPD        Inst = 918<<21 | 1<<16 | 1680
+	VCMPNLEPS        Inst = 919<<21 | 1<<16 | 1681
+	VCMPNLESD        Inst = 920<<21 | 2<<16 | 1682
+	VCMPNLESS        Inst = 921<<21 | 2<<16 | 1684
+	VCMPNLT_UQPD     Inst = 922<<21 | 2<<16 | 1686
+	VCMPNLT_UQPS     Inst = 923<<21 | 1<<16 | 1688
+	VCMPNLT_UQSD     Inst = 924<<21 | 2<<16 | 1689
+	VCMPNLT_UQSS     Inst = 925<<21 | 2<<16 | 1691
+	VCMPNLT_USPD     Inst = 926<<21 | 1<<16 | 1693
+	VCMPNLT_USPS     Inst = 927<<21 | 1<<16 | 1694
+	VCMPNLT_USSD     Inst = 928<<21 | 2<<16 | 1695
+	VCMPNLT_USSS     Inst = 929<<21 | 2<<16 | 1697
+	VCMPNLTPD        Inst = 930<<21 | 2<<16 | 1699
+	VCMPNLTPS        Inst = 931<<21 | 1<<16 | 1701
+	VCMPNLTSD        Inst = 932<<21 | 2<<16 | 1702
+	VCMPNLTSS        Inst = 933<<21 | 2<<16 | 1704
+	VCMPORD_QPD      Inst = 934<<21 | 2<<16 | 1706
+	VCMPORD_QPS      Inst = 935<<21 | 1<<16 | 1708
+	VCMPORD_QSD      Inst = 936<<21 | 2<<16 | 1709
+	VCMPORD_QSS      Inst = 937<<21 | 2<<16 | 1711
+	VCMPORD_SPD      Inst = 938<<21 | 2<<16 | 1713
+	VCMPORD_SPS      Inst = 939<<21 | 1<<16 | 1715
+	VCMPORD_SSD      Inst = 940<<21 | 2<<16 | 1716
+	VCMPORD_SSS      Inst = 941<<21 | 2<<16 | 1718
+	VCMPORDPD        Inst = 942<<21 | 2<<16 | 1720
+	VCMPORDPS        Inst = 943<<21 | 1<<16 | 1722
+	VCMPORDSD        Inst = 944<<21 | 2<<16 | 1723
+	VCMPORDSS        Inst = 945<<21 | 2<<16 | 1725
+	VCMPPD           Inst = 946<<21 | 1<<16 | 1727
+	VCMPPS           Inst = 947<<21 | 1<<16 | 1728
+	VCMPSD           Inst = 948<<21 | 2<<16 | 1729
+	VCMPSS           Inst = 949<<21 | 2<<16 | 1731
+	VCMPTRUE_UQPD    Inst = 950<<21 | 2<<16 | 1733
+	VCMPTRUE_UQPS    Inst = 951<<21 | 1<<16 | 1735
+	VCMPTRUE_UQSD    Inst = 952<<21 | 2<<16 | 1736
+	VCMPTRUE_UQSS    Inst = 953<<21 | 2<<16 | 1738
+	VCMPTRUE_USPD    Inst = 954<<21 | 1<<16 | 1740
+	VCMPTRUE_USPS    Inst = 955<<21 | 1<<16 | 1741
+	VCMPTRUE_USSD    Inst = 956<<21 | 2<<16 | 1742
+	VCMPTRUE_USSS    Inst = 957<<21 | 2<<16 | 1744
+	VCMPTRUEPD       Inst = 958<<21 | 1<<16 | 1746
+	VCMPTRUEPS       Inst = 959<<21 | 1<<16 | 1747
+	VCMPTRUESD       Inst = 960<<21 | 2<<16 | 1748
+	VCMPTRUESS       Inst = 961<<21 | 2<<16 | 1750
+	VCMPUNORD_QPD    Inst = 962<<21 | 1<<16 | 1752
+	VCMPUNORD_QPS    Inst = 963<<21 | 1<<16 | 1753
+	VCMPUNORD_QSD    Inst = 964<<21 | 2<<16 | 1754
+	VCMPUNORD_QSS    Inst = 965<<21 | 2<<16 | 1756
+	VCMPUNORD_SPD    Inst = 966<<21 | 1<<16 | 1758
+	VCMPUNORD_SPS    Inst = 967<<21 | 1<<16 | 1759
+	VCMPUNORD_SSD    Inst = 968<<21 | 2<<16 | 1760
+	VCMPUNORD_SSS    Inst = 969<<21 | 2<<16 | 1762
+	VCMPUNORDPD      Inst = 970<<21 | 2<<16 | 1764
+	VCMPUNORDPS      Inst = 971<<21 | 1<<16 | 1766
+	VCMPUNORDSD      Inst = 972<<21 | 2<<16 | 1767
+	VCMPUNORDSS      Inst = 973<<21 | 2<<16 | 1769
+	VCOMISD          Inst = 974<<21 | 2<<16 | 1771
+	VCOMISS          Inst = 975<<21 | 2<<16 | 1773
+	VCVTDQ2PD        Inst = 976<<21 | 2<<16 | 1775
+	VCVTDQ2PS        Inst = 977<<21 | 1<<16 | 1777
+	VCVTPD2DQ        Inst = 978<<21 | 2<<16 | 1778
+	VCVTPD2PS        Inst = 979<<21 | 2<<16 | 1780
+	VCVTPH2PS        Inst = 980<<21 | 2<<16 | 1782
+	VCVTPS2DQ        Inst = 981<<21 | 1<<16 | 1784
+	VCVTPS2PD        Inst = 982<<21 | 2<<16 | 1785
+	VCVTPS2PH        Inst = 983<<21 | 2<<16 | 1787
+	VCVTSD2SI        Inst = 984<<21 | 2<<16 | 1789
+	VCVTSD2SS        Inst = 985<<21 | 2<<16 | 1791
+	VCVTSI2SD        Inst = 986<<21 | 1<<16 | 1793
+	VCVTSI2SS        Inst = 987<<21 | 1<<16 | 1794
+	VCVTSS2SD        Inst = 988<<21 | 2<<16 | 1795
+	VCVTSS2SI        Inst = 989<<21 | 2<<16 | 1797
+	VCVTTPD2DQ       Inst = 990<<21 | 2<<16 | 1799
+	VCVTTPS2DQ       Inst = 991<<21 | 1<<16 | 1801
+	VCVTTSD2SI       Inst = 992<<21 | 2<<16 | 1802
+	VCVTTSS2SI       Inst = 993<<21 | 2<<16 | 1804
+	VDIVPD           Inst = 994<<21 | 1<<16 | 1806
+	VDIVPS           Inst = 995<<21 | 1<<16 | 1807
+	VDIVSD           Inst = 996<<21 | 2<<16 | 1808
+	VDIVSS           Inst = 997<<21 | 2<<16 | 1810
+	VDPPD            Inst = 998<<21 | 1<<16 | 1812
+	VDPPS            Inst = 999<<21 | 1<<16 | 1813
+	VERR             Inst = 1000<<21 | 2<<16 | 1814
+	VERW             Inst = 1001<<21 | 2<<16 | 1816
+	VEXTRACTF128     Inst = 1002<<21 | 1<<16 | 1818
+	VEXTRACTI128     Inst = 1003<<21 | 1<<16 | 1819
+	VEXTRACTPS       Inst = 1004<<21 | 1<<16 | 1820
+	VFMADD123PD      Inst = 1005<<21 | 1<<16 | 1821
+	VFMADD123PS      Inst = 1006<<21 | 1<<16 | 1822
+	VFMADD123SD      Inst = 1007<<21 | 2<<16 | 1823
+	VFMADD123SS      Inst = 1008<<21 | 2<<16 | 1825
+	VFMADD132PD      Inst = 1009<<21 | 1<<16 | 1827
+	VFMADD132PS      Inst = 1010<<21 | 1<<16 | 1828
+	VFMADD132SD      Inst = 1011<<21 | 2<<16 | 1829
+	VFMADD132SS      Inst = 1012<<21 | 2<<16 | 1831
+	VFMADD213PD      Inst = 1013<<21 | 1<<16 | 1833
+	VFMADD213PS      Inst = 1014<<21 | 1<<16 | 1834
+	VFMADD213SD      Inst = 1015<<21 | 2<<16 | 1835
+	VFMADD213SS      Inst = 1016<<21 | 2<<16 | 1837
+	VFMADD231PD      Inst = 1017<<21 | 1<<16 | 1839
+	VFMADD231PS      Inst = 1018<<21 | 1<<16 | 1840
+	VFMADD231SD      Inst = 1019<<21 | 2<<16 | 1841
+	VFMADD231SS      Inst = 1020<<21 | 2<<16 | 1843
+	VFMADD312PD      Inst = 1021<<21 | 1<<16 | 1845
+	VFMADD312PS      Inst = 1022<<21 | 1<<16 | 1846
+	VFMADD312SD      Inst = 1023<<21 | 2<<16 | 1847
+	VFMADD312SS      Inst = 1024<<21 | 2<<16 | 1849
+	VFMADD321PD      Inst = 1025<<21 | 1<<16 | 1851
+	VFMADD321PS      Inst = 1026<<21 | 1<<16 | 1852
+	VFMADD321SD      Inst = 1027<<21 | 2<<16 | 1853
+	VFMADD321SS      Inst = 1028<<21 | 2<<16 | 1855
+	VFMADDPD         Inst = 1029<<21 | 2<<16 | 1857
+	VFMADDPS         Inst = 1030<<21 | 2<<16 | 1859
+	VFMADDSD         Inst = 1031<<21 | 3<<16 | 1861
+	VFMADDSS         Inst = 1032<<21 | 3<<16 | 1864
+	VFMADDSUB123PD   Inst = 1033<<21 | 1<<16 | 1867
+	VFMADDSUB123PS   Inst = 1034<<21 | 1<<16 | 1868
+	VFMADDSUB132PD   Inst = 1035<<21 | 1<<16 | 1869
+	VFMADDSUB132PS   Inst = 1036<<21 | 1<<16 | 1870
+	VFMADDSUB213PD   Inst = 1037<<21 | 1<<16 | 1871
+	VFMADDSUB213PS   Inst = 1038<<21 | 1<<16 | 1872
+	VFMADDSUB231PD   Inst = 1039<<21 | 1<<16 | 1873
+	VFMADDSUB231PS   Inst = 1040<<21 | 1<<16 | 1874
+	VFMADDSUB312PD   Inst = 1041<<21 | 1<<16 | 1875
+	VFMADDSUB312PS   Inst = 1042<<21 | 1<<16 | 1876
+	VFMADDSUB321PD   Inst = 1043<<21 | 1<<16 | 1877
+	VFMADDSUB321PS   Inst = 1044<<21 | 1<<16 | 1878
+	VFMADDSUBPD      Inst = 1045<<21 | 2<<16 | 1879
+	VFMADDSUBPS      Inst = 1046<<21 | 2<<16 | 1881
+	VFMSUB123PD      Inst = 1047<<21 | 1<<16 | 1883
+	VFMSUB123PS      Inst = 1048<<21 | 1<<16 | 1884
+	VFMSUB123SD      Inst = 1049<<21 | 2<<16 | 1885
+	VFMSUB123SS      Inst = 1050<<21 | 2<<16 | 1887
+	VFMSUB132PD      Inst = 1051<<21 | 1<<16 | 1889
+	VFMSUB132PS      Inst = 1052<<21 | 1<<16 | 1890
+	VFMSUB132SD      Inst = 1053<<21 | 2<<16 | 1891
+	VFMSUB132SS      Inst = 1054<<21 | 2<<16 | 1893
+	VFMSUB213PD      Inst = 1055<<21 | 1<<16 | 1895
+	VFMSUB213PS      Inst = 1056<<21 | 1<<16 | 1896
+	VFMSUB213SD      Inst = 1057<<21 | 2<<16 | 1897
+	VFMSUB213SS      Inst = 1058<<21 | 2<<16 | 1899
+	VFMSUB231PD      Inst = 1059<<21 | 1<<16 | 1901
+	VFMSUB231PS      Inst = 1060<<21 | 1<<16 | 1902
+	VFMSUB231SD      Inst = 1061<<21 | 2<<16 | 1903
+	VFMSUB231SS      Inst = 1062<<21 | 2<<16 | 1905
+	VFMSUB312PD      Inst = 1063<<21 | 1<<16 | 1907
+	VFMSUB312PS      Inst = 1064<<21 | 1<<16 | 1908
+	VFMSUB312SD      Inst = 1065<<21 | 2<<16 | 1909
+	VFMSUB312SS      Inst = 1066<<21 | 2<<16 | 1911
+	VFMSUB321PD      Inst = 1067<<21 | 1<<16 | 1913
+	VFMSUB321PS      Inst = 1068<<21 | 1<<16 | 1914
+	VFMSUB321SD      Inst = 1069<<21 | 2<<16 | 1915
+	VFMSUB321SS      Inst = 1070<<21 | 2<<16 | 1917
+	VFMSUBADD123PD   Inst = 1071<<21 | 1<<16 | 1919
+	VFMSUBADD123PS   Inst = 1072<<21 | 1<<16 | 1920
+	VFMSUBADD132PD   Inst = 1073<<21 | 1<<16 | 1921
+	VFMSUBADD132PS   Inst = 1074<<21 | 1<<16 | 1922
+	VFMSUBADD213PD   Inst = 1075<<21 | 1<<16 | 1923
+	VFMSUBADD213PS   Inst = 1076<<21 | 1<<16 | 1924
+	VFMSUBADD231PD   Inst = 1077<<21 | 1<<16 | 1925
+	VFMSUBADD231PS   Inst = 1078<<21 | 1<<16 | 1926
+	VFMSUBADD312PD   Inst = 1079<<21 | 1<<16 | 1927
+	VFMSUBADD312PS   Inst = 1080<<21 | 1<<16 | 1928
+	VFMSUBADD321PD   Inst = 1081<<21 | 1<<16 | 1929
+	VFMSUBADD321PS   Inst = 1082<<21 | 1<<16 | 1930
+	VFMSUBADDPD      Inst = 1083<<21 | 2<<16 | 1931
+	VFMSUBADDPS      Inst = 1084<<21 | 2<<16 | 1933
+	VFMSUBPD         Inst = 1085<<21 | 2<<16 | 1935
+	VFMSUBPS         Inst = 1086<<21 | 2<<16 | 1937
+	VFMSUBSD         Inst = 1087<<21 | 3<<16 | 1939
+	VFMSUBSS         Inst = 1088<<21 | 3<<16 | 1942
+	VFNMADD123PD     Inst = 1089<<21 | 1<<16 | 1945
+	VFNMADD123PS     Inst = 1090<<21 | 1<<16 | 1946
+	VFNMADD123SD     Inst = 1091<<21 | 2<<16 | 1947
+	VFNMADD123SS     Inst = 1092<<21 | 2<<16 | 1949
+	VFNMADD132PD     Inst = 1093<<21 | 1<<16 | 1951
+	VFNMADD132PS     Inst = 1094<<21 | 1<<16 | 1952
+	VFNMADD132SD     Inst = 1095<<21 | 2<<16 | 1953
+	VFNMADD132SS     Inst = 1096<<21 | 2<<16 | 1955
+	VFNMADD213PD     Inst = 1097<<21 | 1<<16 | 1957
+	VFNMADD213PS     Inst = 1098<<21 | 1<<16 | 1958
+	VFNMADD213SD     Inst = 1099<<21 | 2<<16 | 1959
+	VFNMADD213SS     Inst = 1100<<21 | 2<<16 | 1961
+	VFNMADD231PD     Inst = 1101<<21 | 1<<16 | 1963
+	VFNMADD231PS     Inst = 1102<<21 | 1<<16 | 1964
+	VFNMADD231SD     Inst = 1103<<21 | 2<<16 | 1965
+	VFNMADD231SS     Inst = 1104<<21 | 2<<16 | 1967
+	VFNMADD312PD     Inst = 1105<<21 | 1<<16 | 1969
+	VFNMADD312PS     Inst = 1106<<21 | 1<<16 | 1970
+	VFNMADD312SD     Inst = 1107<<21 | 2<<16 | 1971
+	VFNMADD312SS     Inst = 1108<<21 | 2<<16 | 1973
+	VFNMADD321PD     Inst = 1109<<21 | 1<<16 | 1975
+	VFNMADD321PS     Inst = 1110<<21 | 1<<16 | 1976
+	VFNMADD321SD     Inst = 1111<<21 | 2<<16 | 1977
+	VFNMADD321SS     Inst = 1112<<21 | 2<<16 | 1979
+	VFNMADDPD        Inst = 1113<<21 | 2<<16 | 1981
+	VFNMADDPS        Inst = 1114<<21 | 2<<16 | 1983
+	VFNMADDSD        Inst = 1115<<21 | 3<<16 | 1985
+	VFNMADDSS        Inst = 1116<<21 | 3<<16 | 1988
+	VFNMSUB123PD     Inst = 1117<<21 | 1<<16 | 1991
+	VFNMSUB123PS     Inst = 1118<<21 | 1<<16 | 1992
+	VFNMSUB123SD     Inst = 1119<<21 | 2<<16 | 1993
+	VFNMSUB123SS     Inst = 1120<<21 | 2<<16 | 1995
+	VFNMSUB132PD     Inst = 1121<<21 | 1<<16 | 1997
+	VFNMSUB132PS     Inst = 1122<<21 | 1<<16 | 1998
+	VFNMSUB132SD     Inst = 1123<<21 | 2<<16 | 1999
+	VFNMSUB132SS     Inst = 1124<<21 | 2<<16 | 2001
+	VFNMSUB213PD     Inst = 1125<<21 | 1<<16 | 2003
+	VFNMSUB213PS     Inst = 1126<<21 | 1<<16 | 2004
+	VFNMSUB213SD     Inst = 1127<<21 | 2<<16 | 2005
+	VFNMSUB213SS     Inst = 1128<<21 | 2<<16 | 2007
+	VFNMSUB231PD     Inst = 1129<<21 | 1<<16 | 2009
+	VFNMSUB231PS     Inst = 1130<<21 | 1<<16 | 2010
+	VFNMSUB231SD     Inst = 1131<<21 | 2<<16 | 2011
+	VFNMSUB231SS     Inst = 1132<<21 | 2<<16 | 2013
+	VFNMSUB312PD     Inst = 1133<<21 | 1<<16 | 2015
+	VFNMSUB312PS     Inst = 1134<<21 | 1<<16 | 2016
+	VFNMSUB312SD     Inst = 1135<<21 | 2<<16 | 2017
+	VFNMSUB312SS     Inst = 1136<<21 | 2<<16 | 2019
+	VFNMSUB321PD     Inst = 1137<<21 | 1<<16 | 2021
+	VFNMSUB321PS     Inst = 1138<<21 | 1<<16 | 2022
+	VFNMSUB321SD     Inst = 1139<<21 | 2<<16 | 2023
+	VFNMSUB321SS     Inst = 1140<<21 | 2<<16 | 2025
+	VFNMSUBPD        Inst = 1141<<21 | 2<<16 | 2027
+	VFNMSUBPS        Inst = 1142<<21 | 2<<16 | 2029
+	VFNMSUBSD        Inst = 1143<<21 | 3<<16 | 2031
+	VFNMSUBSS        Inst = 1144<<21 | 3<<16 | 2034
+	VFRCZPD          Inst = 1145<<21 | 1<<16 | 2037
+	VFRCZPS          Inst = 1146<<21 | 1<<16 | 2038
+	VFRCZSD          Inst = 1147<<21 | 2<<16 | 2039
+	VFRCZSS          Inst = 1148<<21 | 2<<16 | 2041
+	VGATHERDPD       Inst = 1149<<21 | 1<<16 | 2043
+	VGATHERDPS       Inst = 1150<<21 | 1<<16 | 2044
+	VGATHERQPD       Inst = 1151<<21 | 1<<16 | 2045
+	VGATHERQPS       Inst = 1152<<21 | 1<<16 | 2046
+	VHADDPD          Inst = 1153<<21 | 1<<16 | 2047
+	VHADDPS          Inst = 1154<<21 | 1<<16 | 2048
+	VHSUBPD          Inst = 1155<<21 | 1<<16 | 2049
+	VHSUBPS          Inst = 1156<<21 | 1<<16 | 2050
+	VINSERTF128      Inst = 1157<<21 | 1<<16 | 2051
+	VINSERTI128      Inst = 1158<<21 | 1<<16 | 2052
+	VINSERTPS        Inst = 1159<<21 | 2<<16 | 2053
+	VLDDQU           Inst = 1160<<21 | 1<<16 | 2055
+	VLDMXCSR         Inst = 1161<<21 | 1<<16 | 2056
+	VLDQQU           Inst = 1162<<21 | 1<<16 | 2057
+	VMASKMOVDQU      Inst = 1163<<21 | 1<<16 | 2058
+	VMASKMOVPD       Inst = 1164<<21 | 2<<16 | 2059
+	VMASKMOVPS       Inst = 1165<<21 | 2<<16 | 2061
+	VMAXPD           Inst = 1166<<21 | 1<<16 | 2063
+	VMAXPS           Inst = 1167<<21 | 1<<16 | 2064
+	VMAXSD           Inst = 1168<<21 | 2<<16 | 2065
+	VMAXSS           Inst = 1169<<21 | 2<<16 | 2067
+	VMCALL           Inst = 1170<<21 | 1<<16 | 2069
+	VMCLEAR          Inst = 1171<<21 | 1<<16 | 2070
+	VMFUNC           Inst = 1172<<21 | 1<<16 | 2071
+	VMINPD           Inst = 1173<<21 | 1<<16 | 2072
+	VMINPS           Inst = 1174<<21 | 1<<16 | 2073
+	VMINSD           Inst = 1175<<21 | 2<<16 | 2074
+	VMINSS           Inst = 1176<<21 | 2<<16 | 2076
+	VMLAUNCH         Inst = 1177<<21 | 1<<16 | 2078
+	VMLOAD           Inst = 1178<<21 | 1<<16 | 2079
+	VMMCALL          Inst = 1179<<21 | 1<<16 | 2080
+	VMOVAPD          Inst = 1180<<21 | 3<<16 | 2081
+	VMOVAPS          Inst = 1181<<21 | 3<<16 | 2084
+	VMOVD            Inst = 1182<<21 | 2<<16 | 2087
+	VMOVDDUP         Inst = 1183<<21 | 2<<16 | 2089
+	VMOVDQA          Inst = 1184<<21 | 3<<16 | 2091
+	VMOVDQU          Inst = 1185<<21 | 3<<16 | 2094
+	VMOVHLPS         Inst = 1186<<21 | 1<<16 | 2097
+	VMOVHPD          Inst = 1187<<21 | 2<<16 | 2098
+	VMOVHPS          Inst = 1188<<21 | 2<<16 | 2100
+	VMOVLHPS         Inst = 1189<<21 | 1<<16 | 2102
+	VMOVLPD          Inst = 1190<<21 | 2<<16 | 2103
+	VMOVLPS          Inst = 1191<<21 | 2<<16 | 2105
+	VMOVMSKPD        Inst = 1192<<21 | 1<<16 | 2107
+	VMOVMSKPS        Inst = 1193<<21 | 1<<16 | 2108
+	VMOVNTDQ         Inst = 1194<<21 | 1<<16 | 2109
+	VMOVNTDQA        Inst = 1195<<21 | 1<<16 | 2110
+	VMOVNTPD         Inst = 1196<<21 | 1<<16 | 2111
+	VMOVNTPS         Inst = 1197<<21 | 1<<16 | 2112
+	VMOVNTQQ         Inst = 1198<<21 | 1<<16 | 2113
+	VMOVQ            Inst = 1199<<21 | 6<<16 | 2114
+	VMOVQQA          Inst = 1200<<21 | 2<<16 | 2120
+	VMOVQQU          Inst = 1201<<21 | 2<<16 | 2122
+	VMOVSD           Inst = 1202<<21 | 4<<16 | 2124
+	VMOVSHDUP        Inst = 1203<<21 | 1<<16 | 2128
+	VMOVSLDUP        Inst = 1204<<21 | 1<<16 | 2129
+	VMOVSS           Inst = 1205<<21 | 4<<16 | 2130
+	VMOVUPD          Inst = 1206<<21 | 3<<16 | 2134
+	VMOVUPS          Inst = 1207<<21 | 3<<16 | 2137
+	VMPSADBW         Inst = 1208<<21 | 1<<16 | 2140
+	VMPTRLD          Inst = 1209<<21 | 1<<16 | 2141
+	VMPTRST          Inst = 1210<<21 | 1<<16 | 2142
+	VMREAD           Inst = 1211<<21 | 1<<16 | 2143
+	VMRESUME         Inst = 1212<<21 | 1<<16 | 2144
+	VMRUN            Inst = 1213<<21 | 1<<16 | 2145
+	VMSAVE           Inst = 1214<<21 | 1<<16 | 2146
+	VMULPD           Inst = 1215<<21 | 1<<16 | 2147
+	VMULPS           Inst = 1216<<21 | 1<<16 | 2148
+	VMULSD           Inst = 1217<<21 | 2<<16 | 2149
+	VMULSS           Inst = 1218<<21 | 2<<16 | 2151
+	VMWRITE          Inst = 1219<<21 | 1<<16 | 2153
+	VMXOFF           Inst = 1220<<21 | 1<<16 | 2154
+	VMXON            Inst = 1221<<21 | 1<<16 | 2155
+	VORPD            Inst = 1222<<21 | 1<<16 | 2156
+	VORPS            Inst = 1223<<21 | 1<<16 | 2157
+	VPABSB           Inst = 1224<<21 | 1<<16 | 2158
+	VPABSD           Inst = 1225<<21 | 1<<16 | 2159
+	VPABSW           Inst = 1226<<21 | 1<<16 | 2160
+	VPACKSSDW        Inst = 1227<<21 | 1<<16 | 2161
+	VPACKSSWB        Inst = 1228<<21 | 1<<16 | 2162
+	VPACKUSDW        Inst = 1229<<21 | 1<<16 | 2163
+	VPACKUSWB        Inst = 1230<<21 | 1<<16 | 2164
+	VPADDB           Inst = 1231<<21 | 1<<16 | 2165
+	VPADDD           Inst = 1232<<21 | 1<<16 | 2166
+	VPADDQ           Inst = 1233<<21 | 1<<16 | 2167
+	VPADDSB          Inst = 1234<<21 | 1<<16 | 2168
+	VPADDSW          Inst = 1235<<21 | 1<<16 | 2169
+	VPADDUSB         Inst = 1236<<21 | 1<<16 | 2170
+	VPADDUSW         Inst = 1237<<21 | 1<<16 | 2171
+	VPADDW           Inst = 1238<<21 | 1<<16 | 2172
+	VPALIGNR         Inst = 1239<<21 | 1<<16 | 2173
+	VPAND            Inst = 1240<<21 | 1<<16 | 2174
+	VPANDN           Inst = 1241<<21 | 1<<16 | 2175
+	VPAVGB           Inst = 1242<<21 | 1<<16 | 2176
+	VPAVGW           Inst = 1243<<21 | 1<<16 | 2177
+	VPBLENDD         Inst = 1244<<21 | 1<<16 | 2178
+	VPBLENDVB        Inst = 1245<<21 | 1<<16 | 2179
+	VPBLENDW         Inst = 1246<<21 | 1<<16 | 2180
+	VPBROADCASTB     Inst = 1247<<21 | 2<<16 | 2181
+	VPBROADCASTD     Inst = 1248<<21 | 2<<16 | 2183
+	VPBROADCASTQ     Inst = 1249<<21 | 3<<16 | 2185
+	VPBROADCASTW     Inst = 1250<<21 | 2<<16 | 2188
+	VPCLMULHQHQDQ    Inst = 1251<<21 | 1<<16 | 2190
+	VPCLMULHQLQDQ    Inst = 1252<<21 | 1<<16 | 2191
+	VPCLMULLQHQDQ    Inst = 1253<<21 | 1<<16 | 2192
+	VPCLMULLQLQDQ    Inst = 1254<<21 | 1<<16 | 2193
+	VPCLMULQDQ       Inst = 1255<<21 | 1<<16 | 2194
+	VPCMOV           Inst = 1256<<21 | 2<<16 | 2195
+	VPCMPEQB         Inst = 1257<<21 | 1<<16 | 2197
+	VPCMPEQD         Inst = 1258<<21 | 1<<16 | 2198
+	VPCMPEQQ         Inst = 1259<<21 | 1<<16 | 2199
+	VPCMPEQW         Inst = 1260<<21 | 1<<16 | 2200
+	VPCMPESTRI       Inst = 1261<<21 | 1<<16 | 2201
+	VPCMPESTRM       Inst = 1262<<21 | 1<<16 | 2202
+	VPCMPGTB         Inst = 1263<<21 | 1<<16 | 2203
+	VPCMPGTD         Inst = 1264<<21 | 1<<16 | 2204
+	VPCMPGTQ         Inst = 1265<<21 | 1<<16 | 2205
+	VPCMPGTW         Inst = 1266<<21 | 1<<16 | 2206
+	VPCMPISTRI       Inst = 1267<<21 | 1<<16 | 2207
+	VPCMPISTRM       Inst = 1268<<21 | 1<<16 | 2208
+	VPCOMB           Inst = 1269<<21 | 1<<16 | 2209
+	VPCOMD           Inst = 1270<<21 | 1<<16 | 2210
+	VPCOMQ           Inst = 1271<<21 | 1<<16 | 2211
+	VPCOMUB          Inst = 1272<<21 | 1<<16 | 2212
+	VPCOMUD          Inst = 1273<<21 | 1<<16 | 2213
+	VPCOMUQ          Inst = 1274<<21 | 1<<16 | 2214
+	VPCOMUW          Inst = 1275<<21 | 1<<16 | 2215
+	VPCOMW           Inst = 1276<<21 | 1<<16 | 2216
+	VPERM2F128       Inst = 1277<<21 | 1<<16 | 2217
+	VPERM2I128       Inst = 1278<<21 | 1<<16 | 2218
+	VPERMD           Inst = 1279<<21 | 1<<16 | 2219
+	VPERMILPD        Inst = 1280<<21 | 2<<16 | 2220
+	VPERMILPS        Inst = 1281<<21 | 2<<16 | 2222
+	VPERMPD          Inst = 1282<<21 | 1<<16 | 2224
+	VPERMPS          Inst = 1283<<21 | 1<<16 | 2225
+	VPERMQ           Inst = 1284<<21 | 1<<16 | 2226
+	VPEXTRB          Inst = 1285<<21 | 3<<16 | 2227
+	VPEXTRD          Inst = 1286<<21 | 2<<16 | 2230
+	VPEXTRQ          Inst = 1287<<21 | 1<<16 | 2232
+	VPEXTRW          Inst = 1288<<21 | 5<<16 | 2233
+	VPGATHERDD       Inst = 1289<<21 | 1<<16 | 2238
+	VPGATHERDQ       Inst = 1290<<21 | 1<<16 | 2239
+	VPGATHERQD       Inst = 1291<<21 | 1<<16 | 2240
+	VPGATHERQQ       Inst = 1292<<21 | 1<<16 | 2241
+	VPHADDBD         Inst = 1293<<21 | 1<<16 | 2242
+	VPHADDBQ         Inst = 1294<<21 | 1<<16 | 2243
+	VPHADDBW         Inst = 1295<<21 | 1<<16 | 2244
+	VPHADDD          Inst = 1296<<21 | 1<<16 | 2245
+	VPHADDDQ         Inst = 1297<<21 | 1<<16 | 2246
+	VPHADDSW         Inst = 1298<<21 | 1<<16 | 2247
+	VPHADDUBD        Inst = 1299<<21 | 1<<16 | 2248
+	VPHADDUBQ        Inst = 1300<<21 | 1<<16 | 2249
+	VPHADDUBW        Inst = 1301<<21 | 1<<16 | 2250
+	VPHADDUDQ        Inst = 1302<<21 | 1<<16 | 2251
+	VPHADDUWD        Inst = 1303<<21 | 1<<16 | 2252
+	VPHADDUWQ        Inst = 1304<<21 | 1<<16 | 2253
+	VPHADDW          Inst = 1305<<21 | 1<<16 | 2254
+	VPHADDWD         Inst = 1306<<21 | 1<<16 | 2255
+	VPHADDWQ         Inst = 1307<<21 | 1<<16 | 2256
+	VPHMINPOSUW      Inst = 1308<<21 | 1<<16 | 2257
+	VPHSUBBW         Inst = 1309<<21 | 1<<16 | 2258
+	VPHSUBD          Inst = 1310<<21 | 1<<16 | 2259
+	VPHSUBDQ         Inst = 1311<<21 | 1<<16 | 2260
+	VPHSUBSW         Inst = 1312<<21 | 1<<16 | 2261
+	VPHSUBW          Inst = 1313<<21 | 1<<16 | 2262
+	VPHSUBWD         Inst = 1314<<21 | 1<<16 | 2263
+	VPINSRB          Inst = 1315<<21 | 2<<16 | 2264
+	VPINSRD          Inst = 1316<<21 | 1<<16 | 2266
+	VPINSRQ          Inst = 1317<<21 | 1<<16 | 2267
+	VPINSRW          Inst = 1318<<21 | 2<<16 | 2268
+	VPMACSDD         Inst = 1319<<21 | 1<<16 | 2270
+	VPMACSDQH        Inst = 1320<<21 | 1<<16 | 2271
+	VPMACSDQL        Inst = 1321<<21 | 1<<16 | 2272
+	VPMACSSDD        Inst = 1322<<21 | 1<<16 | 2273
+	VPMACSSDQH       Inst = 1323<<21 | 1<<16 | 2274
+	VPMACSSDQL       Inst = 1324<<21 | 1<<16 | 2275
+	VPMACSSWD        Inst = 1325<<21 | 1<<16 | 2276
+	VPMACSSWW        Inst = 1326<<21 | 1<<16 | 2277
+	VPMACSWD         Inst = 1327<<21 | 1<<16 | 2278
+	VPMACSWW         Inst = 1328<<21 | 1<<16 | 2279
+	VPMADCSSWD       Inst = 1329<<21 | 1<<16 | 2280
+	VPMADCSWD        Inst = 1330<<21 | 1<<16 | 2281
+	VPMADDUBSW       Inst = 1331<<21 | 1<<16 | 2282
+	VPMADDWD         Inst = 1332<<21 | 1<<16 | 2283
+	VPMASKMOVD       Inst = 1333<<21 | 2<<16 | 2284
+	VPMASKMOVQ       Inst = 1334<<21 | 2<<16 | 2286
+	VPMAXSB          Inst = 1335<<21 | 1<<16 | 2288
+	VPMAXSD          Inst = 1336<<21 | 1<<16 | 2289
+	VPMAXSW          Inst = 1337<<21 | 1<<16 | 2290
+	VPMAXUB          Inst = 1338<<21 | 1<<16 | 2291
+	VPMAXUD          Inst = 1339<<21 | 1<<16 | 2292
+	VPMAXUW          Inst = 1340<<21 | 1<<16 | 2293
+	VPMINSB          Inst = 1341<<21 | 1<<16 | 2294
+	VPMINSD          Inst = 1342<<21 | 1<<16 | 2295
+	VPMINSW          Inst = 1343<<21 | 1<<16 | 2296
+	VPMINUB          Inst = 1344<<21 | 1<<16 | 2297
+	VPMINUD          Inst = 1345<<21 | 1<<16 | 2298
+	VPMINUW          Inst = 1346<<21 | 1<<16 | 2299
+	VPMOVMSKB        Inst = 1347<<21 | 1<<16 | 2300
+	VPMOVSXBD        Inst = 1348<<21 | 2<<16 | 2301
+	VPMOVSXBQ        Inst = 1349<<21 | 2<<16 | 2303
+	VPMOVSXBW        Inst = 1350<<21 | 2<<16 | 2305
+	VPMOVSXDQ        Inst = 1351<<21 | 2<<16 | 2307
+	VPMOVSXWD        Inst = 1352<<21 | 2<<16 | 2309
+	VPMOVSXWQ        Inst = 1353<<21 | 2<<16 | 2311
+	VPMOVZXBD        Inst = 1354<<21 | 2<<16 | 2313
+	VPMOVZXBQ        Inst = 1355<<21 | 2<<16 | 2315
+	VPMOVZXBW        Inst = 1356<<21 | 2<<16 | 2317
+	VPMOVZXDQ        Inst = 1357<<21 | 2<<16 | 2319
+	VPMOVZXWD        Inst = 1358<<21 | 2<<16 | 2321
+	VPMOVZXWQ        Inst = 1359<<21 | 2<<16 | 2323
+	VPMULDQ          Inst = 1360<<21 | 1<<16 | 2325
+	VPMULHRSW        Inst = 1361<<21 | 1<<16 | 2326
+	VPMULHUW         Inst = 1362<<21 | 1<<16 | 2327
+	VPMULHW          Inst = 1363<<21 | 1<<16 | 2328
+	VPMULLD          Inst = 1364<<21 | 1<<16 | 2329
+	VPMULLW          Inst = 1365<<21 | 1<<16 | 2330
+	VPMULUDQ         Inst = 1366<<21 | 1<<16 | 2331
+	VPOR             Inst = 1367<<21 | 1<<16 | 2332
+	VPPERM           Inst = 1368<<21 | 2<<16 | 2333
+	VPROTB           Inst = 1369<<21 | 3<<16 | 2335
+	VPROTD           Inst = 1370<<21 | 3<<16 | 2338
+	VPROTQ           Inst = 1371<<21 | 3<<16 | 2341
+	VPROTW           Inst = 1372<<21 | 3<<16 | 2344
+	VPSADBW          Inst = 1373<<21 | 1<<16 | 2347
+	VPSHAB           Inst = 1374<<21 | 2<<16 | 2348
+	VPSHAD           Inst = 1375<<21 | 2<<16 | 2350
+	VPSHAQ           Inst = 1376<<21 | 2<<16 | 2352
+	VPSHAW           Inst = 1377<<21 | 2<<16 | 2354
+	VPSHLB           Inst = 1378<<21 | 2<<16 | 2356
+	VPSHLD           Inst = 1379<<21 | 2<<16 | 2358
+	VPSHLQ           Inst = 1380<<21 | 2<<16 | 2360
+	VPSHLW           Inst = 1381<<21 | 2<<16 | 2362
+	VPSHUFB          Inst = 1382<<21 | 1<<16 | 2364
+	VPSHUFD          Inst = 1383<<21 | 1<<16 | 2365
+	VPSHUFHW         Inst = 1384<<21 | 1<<16 | 2366
+	VPSHUFLW         Inst = 1385<<21 | 1<<16 | 2367
+	VPSIGNB          Inst = 1386<<21 | 1<<16 | 2368
+	VPSIGND          Inst = 1387<<21 | 1<<16 | 2369
+	VPSIGNW          Inst = 1388<<21 | 1<<16 | 2370
+	VPSLLD           Inst = 1389<<21 | 2<<16 | 2371
+	VPSLLDQ          Inst = 1390<<21 | 1<<16 | 2373
+	VPSLLQ           Inst = 1391<<21 | 2<<16 | 2374
+	VPSLLVD          Inst = 1392<<21 | 1<<16 | 2376
+	VPSLLVQ          Inst = 1393<<21 | 1<<16 | 2377
+	VPSLLW           Inst = 1394<<21 | 2<<16 | 2378
+	VPSRAD           Inst = 1395<<21 | 2<<16 | 2380
+	VPSRAVD          Inst = 1396<<21 | 1<<16 | 2382
+	VPSRAW           Inst = 1397<<21 | 2<<16 | 2383
+	VPSRLD           Inst = 1398<<21 | 2<<16 | 2385
+	VPSRLDQ          Inst = 1399<<21 | 1<<16 | 2387
+	VPSRLQ           Inst = 1400<<21 | 2<<16 | 2388
+	VPSRLVD          Inst = 1401<<21 | 1<<16 | 2390
+	VPSRLVQ          Inst = 1402<<21 | 1<<16 | 2391
+	VPSRLW           Inst = 1403<<21 | 2<<16 | 2392
+	VPSUBB           Inst = 1404<<21 | 1<<16 | 2394
+	VPSUBD           Inst = 1405<<21 | 1<<16 | 2395
+	VPSUBQ           Inst = 1406<<21 | 1<<16 | 2396
+	VPSUBSB          Inst = 1407<<21 | 1<<16 | 2397
+	VPSUBSW          Inst = 1408<<21 | 1<<16 | 2398
+	VPSUBUSB         Inst = 1409<<21 | 1<<16 | 2399
+	VPSUBUSW         Inst = 1410<<21 | 1<<16 | 2400
+	VPSUBW           Inst = 1411<<21 | 1<<16 | 2401
+	VPTEST           Inst = 1412<<21 | 1<<16 | 2402
+	VPUNPCKHBW       Inst = 1413<<21 | 1<<16 | 2403
+	VPUNPCKHDQ       Inst = 1414<<21 | 1<<16 | 2404
+	VPUNPCKHQDQ      Inst = 1415<<21 | 1<<16 | 2405
+	VPUNPCKHWD       Inst = 1416<<21 | 1<<16 | 2406
+	VPUNPCKLBW       Inst = 1417<<21 | 1<<16 | 2407
+	VPUNPCKLDQ       Inst = 1418<<21 | 1<<16 | 2408
+	VPUNPCKLQDQ      Inst = 1419<<21 | 1<<16 | 2409
+	VPUNPCKLWD       Inst = 1420<<21 | 1<<16 | 2410
+	VPXOR            Inst = 1421<<21 | 1<<16 | 2411
+	VRCPPS           Inst = 1422<<21 | 1<<16 | 2412
+	VRCPSS           Inst = 1423<<21 | 2<<16 | 2413
+	VROUNDPD         Inst = 1424<<21 | 1<<16 | 2415
+	VROUNDPS         Inst = 1425<<21 | 1<<16 | 2416
+	VROUNDSD         Inst = 1426<<21 | 2<<16 | 2417
+	VROUNDSS         Inst = 1427<<21 | 2<<16 | 2419
+	VRSQRTPS         Inst = 1428<<21 | 1<<16 | 2421
+	VRSQRTSS         Inst = 1429<<21 | 2<<16 | 2422
+	VSHUFPD          Inst = 1430<<21 | 1<<16 | 2424
+	VSHUFPS          Inst = 1431<<21 | 1<<16 | 2425
+	VSQRTPD          Inst = 1432<<21 | 1<<16 | 2426
+	VSQRTPS          Inst = 1433<<21 | 1<<16 | 2427
+	VSQRTSD          Inst = 1434<<21 | 2<<16 | 2428
+	VSQRTSS          Inst = 1435<<21 | 2<<16 | 2430
+	VSTMXCSR         Inst = 1436<<21 | 1<<16 | 2432
+	VSUBPD           Inst = 1437<<21 | 1<<16 | 2433
+	VSUBPS           Inst = 1438<<21 | 1<<16 | 2434
+	VSUBSD           Inst = 1439<<21 | 2<<16 | 2435
+	VSUBSS           Inst = 1440<<21 | 2<<16 | 2437
+	VTESTPD          Inst = 1441<<21 | 1<<16 | 2439
+	VTESTPS          Inst = 1442<<21 | 1<<16 | 2440
+	VUCOMISD         Inst = 1443<<21 | 2<<16 | 2441
+	VUCOMISS         Inst = 1444<<21 | 2<<16 | 2443
+	VUNPCKHPD        Inst = 1445<<21 | 1<<16 | 2445
+	VUNPCKHPS        Inst = 1446<<21 | 1<<16 | 2446
+	VUNPCKLPD        Inst = 1447<<21 | 1<<16 | 2447
+	VUNPCKLPS        Inst = 1448<<21 | 1<<16 | 2448
+	VXORPD           Inst = 1449<<21 | 1<<16 | 2449
+	VXORPS           Inst = 1450<<21 | 1<<16 | 2450
+	VZEROALL         Inst = 1451<<21 | 1<<16 | 2451
+	VZEROUPPER       Inst = 1452<<21 | 1<<16 | 2452
 )
 
 // Instruction-mnemonic names:
 
-const instNames = "ADCADCXADDADDSDADDSSADOXANDANDNBEXTRBLCFILLBLCIBLCICBLCMSKBLCSBLSFILLBLSIBLSICBLSMSKBLSRBNDCLBNDCNBNDCUBNDLDXBNDMKBNDMOVBNDSTXBSFBSRBSWAPBTBTCBTRBTSBZHICALLCBWCDQCDQECLACCLCCLDCLFLUSHCLGICLICLTSCLZEROCMCCMOVACMOVAECMOVBCMOVBECMOVCCMOVECMOVGCMOVGECMOVLCMOVLECMOVNACMOVNAECMOVNBCMOVNBECMOVNCCMOVNECMOVNGCMOVNGECMOVNLCMOVNLECMOVNOCMOVNPCMOVNSCMOVNZCMOVOCMOVPCMOVPECMOVPOCMOVSCMOVZCMPCMPEQSDCMPEQSSCMPLESDCMPLESSCMPLTSDCMPLTSSCMPNEQSDCMPNEQSSCMPNLESDCMPNLESSCMPNLTSDCMPNLTSSCMPORDSDCMPORDSSCMPSBCMPSDCMPSQCMPSSCMPSWCMPUNORDSDCMPUNORDSSCMPXCHGCMPXCHG16BCMPXCHG8BCOMISDCOMISSCPUIDCQOCRC32CVTPD2DQCVTPD2PICVTPS2DQCVTPS2PICVTSD2SICVTSD2SSCVTSI2SDCVTSI2SSCVTSS2SDCVTSS2SICVTTPD2DQCVTTPD2PICVTTPS2DQCVTTPS2PICVTTSD2SICVTTSS2SICWDCWDEDECDIVDIVSDDIVSSEMMSENTEREXTRQGETSECHLTICEBPIDIVIMULININCINSBINSDINSERTQINSWINTINT01INT03INT1INT3INVDINVEPTINVLPGINVLPGAINVVPIDIRETIRETDIRETQIRETWJAJAEJBJBEJCJEJECXZJGJGEJLJLEJMPJNAJNAEJNBJNBEJNCJNEJNGJNGEJNLJNLEJNOJNPJNSJNZJOJPJPEJPOJRCXZJSJZLAHFLARLDDQULDMXCSRLEALEAVELFENCELFSLGDTLGSLIDTLLDTLLWPCBLMSWLODSBLODSDLODSQLODSWLOOPLOOPELOOPNELOOPNZLOOPZLSLLSSLTRLWPINSLWPVALLZCNTMASKMOVDQUMASKMOVQMAXSDMAXSSMFENCEMINSDMINSSMONITORMONITORXMOVMOVABSMOVAPDMOVAPSMOVBEMOVDMOVDDUPMOVDQ2QMOVDQAMOVDQUMOVHLPSMOVHPDMOVHPSMOVLHPSMOVLPDMOVLPSMOVMSKPDMOVMSKPSMOVNTDQMOVNTDQAMOVNTIMOVNTPDMOVNTPSMOVNTQMOVNTSDMOVNTSSMOVQMOVQ2DQMOVSBMOVSDMOVSHDUPMOVSLDUPMOVSQMOVSSMOVSWMOVSXMOVSXDMOVUPDMOVUPSMOVZXMPSADBWMULMULSDMULSSMULXMWAITMWAITXNEGNOPNOTOROUTOUTSBOUTSDOUTSWPAUSEPOPPOPCNTPOPFPOPFQPOPFWPREFETCHPREFETCHNTAPREFETCHT0PREFETCHT1PREFETCHT2PREFETCHWPUSHPUSHFPUSHFQPUSHFWRCLRCPSSRCRRDFSBASERDGSBASERDMSRRDPIDRDPKRURDPMCRDRANDRDSEEDRDTSCRDTSCPRETRETFRETNROLRORRORXROUNDSDROUNDSSRSMRSQRTSSSAHFSALSARSARXSBBSCASBSCASDSCASQSCASWSETASETAESETBSETBESETCSETESETGSETGESETLSETLESETNASETNAESETNBSETNBESETNCSETNESETNGSETNGESETNLSETNLESETNOSETNPSETNSSETNZSETOSETPSETPESETPOSETSSETZSFENCESGDTSHLSHLDSHLXSHRSHRDSHRXSIDTSKINITSLDTSLWPCBSMSWSQRTSDSQRTSSSTACSTCSTDSTGISTISTMXCSRSTOSBSTOSDSTOSQSTOSWSTRSUBSUBSDSUBSSSWAPGSSYSCALLSYSRETT1MSKCTESTTZCNTTZMSKUCOMISDUCOMISSUD2UD2AWBINVDWRFSBASEWRGSBASEWRMSRWRPKRUXORAESDECAESDECLASTAESENCAESENCLASTAESIMCAESKEYGENASSISTSHA1MSG1SHA1MSG2SHA1NEXTESHA1RNDS4SHA256MSG1SHA256MSG2SHA256RNDS2XABORTXADDXBEGINXCHGXENDXGETBVXLATXLATBXRSTORXRSTOR64XRSTORS64XSAVEXSAVE64XSAVEC64XSAVEOPT64XSAVES64XSETBVXTESTF2XM1FABSFADDFADDPFBLDFBSTPFCHSFCLEXFCMOVBFCMOVBEFCMOVEFCMOVNBFCMOVNBEFCMOVNEFCMOVNUFCMOVUFCOMFCOMIFCOMIPFCOMPFCOMPPFCOSFDECSTPFDISIFDIVFDIVPFDIVRFDIVRPFEMMSFENIFFREEFIADDFICOMFICOMPFIDIVFIDIVRFILDFIMULFINCSTPFINITFISTFISTPFISTTPFISUBFISUBRFLDFLD1FLDCWFLDENVFLDL2EFLDL2TFLDLG2FLDLN2FLDPIFLDZFMULFMULPFNCLEXFNDISIFNENIFNINITFNOPFNSAVEFNSTCWFNSTENVFNSTSWFPATANFPREMFPREM1FPTANFRNDINTFRSTORFSAVEFSCALEFSETPMFSINFSINCOSFSQRTFSTFSTCWFSTENVFSTPFSTSWFSUBFSUBPFSUBRFSUBRPFTSTFUCOMFUCOMIFUCOMIPFUCOMPFUCOMPPFWAITFXAMFXCHFXRSTORFXRSTOR64FXSAVEFXSAVE64FXTRACTFYL2XFYL2XP1ADDPDADDPSADDSUBPDADDSUBPSANDNPDANDNPSANDPDANDPSBLENDPDBLENDPSBLENDVPDBLENDVPSCMPEQPDCMPEQPSCMPLEPDCMPLEPSCMPLTPDCMPLTPSCMPNEQPDCMPNEQPSCMPNLEPDCMPNLEPSCMPNLTPDCMPNLTPSCMPORDPDCMPORDPSCMPPDCMPPSCMPUNORDPDCMPUNORDPSCVTDQ2PDCVTDQ2PSCVTPD2PSCVTPI2PDCVTPI2PSCVTPS2PDDIVPDDIVPSDPPDDPPSEXTRACTPSHADDPDHADDPSHSUBPDHSUBPSINSERTPSMAXPDMAXPSMINPDMINPSMULPDMULPSORPDORPSRCPPSROUNDPDROUNDPSRSQRTPSSHUFPDSHUFPSSQRTPDSQRTPSSUBPDSUBPSUNPCKHPDUNPCKHPSUNPCKLPDUNPCKLPSXORPDXORPSPABSBPABSDPABSWPACKSSDWPACKSSWBPACKUSDWPACKUSWBPADDBPADDDPADDQPADDSBPADDSWPADDUSBPADDUSWPADDWPALIGNRPANDPANDNPAVGBPAVGUSBPAVGWPBLENDVBPBLENDWPCLMULHQHQDQPCLMULHQLQDQPCLMULLQHQDQPCLMULLQLQDQPCLMULQDQPCMPEQBPCMPEQDPCMPEQQPCMPEQWPCMPESTRIPCMPESTRMPCMPGTBPCMPGTDPCMPGTQPCMPGTWPCMPISTRIPCMPISTRMPDEPPEXTPEXTRBPEXTRDPEXTRQPEXTRWPF2IDPF2IWPFACCPFADDPFCMPEQPFCMPGEPFCMPGTPFMAXPFMINPFMULPFNACCPFPNACCPFRCPPFRCPIT1PFRCPIT2PFRSQIT1PFRSQRTPFSUBPFSUBRPHADDDPHADDSWPHADDWPHMINPOSUWPHSUBDPHSUBSWPHSUBWPI2FDPI2FWPINSRBPINSRDPINSRQPINSRWPMADDUBSWPMADDWDPMAXSBPMAXSDPMAXSWPMAXUBPMAXUDPMAXUWPMINSBPMINSDPMINSWPMINUBPMINUDPMINUWPMOVMSKBPMOVSXBDPMOVSXBQPMOVSXBWPMOVSXDQPMOVSXWDPMOVSXWQPMOVZXBDPMOVZXBQPMOVZXBWPMOVZXDQPMOVZXWDPMOVZXWQPMULDQPMULHRSWPMULHRWAPMULHUWPMULHWPMULLDPMULLWPMULUDQPORPSADBWPSHUFBPSHUFDPSHUFHWPSHUFLWPSHUFWPSIGNBPSIGNDPSIGNWPSLLDPSLLDQPSLLQPSLLWPSRADPSRAWPSRLDPSRLDQPSRLQPSRLWPSUBBPSUBDPSUBQPSUBSBPSUBSWPSUBUSBPSUBUSWPSUBWPSWAPDPTESTPUNPCKHBWPUNPCKHDQPUNPCKHQDQPUNPCKHWDPUNPCKLBWPUNPCKLDQPUNPCKLQDQPUNPCKLWDPXORVADDPDVADDPSVADDSDVADDSSVADDSUBPDVADDSUBPSVAESDECVAESDECLASTVAESENCVAESENCLASTVAESIMCVAESKEYGENASSISTVANDNPDVANDNPSVANDPDVANDPSVBLENDPDVBLENDPSVBLENDVPDVBLENDVPSVBROADCASTF128VBROADCASTI128VBROADCASTSDVBROADCASTSSVCMPEQ_OSPDVCMPEQ_OSPSVCMPEQ_OSSDVCMPEQ_OSSSVCMPEQ_UQPDVCMPEQ_UQPSVCMPEQ_UQSDVCMPEQ_UQSSVCMPEQ_USPDVCMPEQ_USPSVCMPEQ_USSDVCMPEQ_USSSVCMPEQPDVCMPEQPSVCMPEQSDVCMPEQSSVCMPFALSE_OQPDVCMPFALSE_OQPSVCMPFALSE_OQSDVCMPFALSE_OQSSVCMPFALSE_OSPDVCMPFALSE_OSPSVCMPFALSE_OSSDVCMPFALSE_OSSSVCMPFALSEPDVCMPFALSEPSVCMPFALSESDVCMPFALSESSVCMPGE_OQPDVCMPGE_OQPSVCMPGE_OQSDVCMPGE_OQSSVCMPGE_OSPDVCMPGE_OSPSVCMPGE_OSSDVCMPGE_OSSSVCMPGEPDVCMPGEPSVCMPGESDVCMPGESSVCMPGT_OQPDVCMPGT_OQPSVCMPGT_OQSDVCMPGT_OQSSVCMPGT_OSPDVCMPGT_OSPSVCMPGT_OSSDVCMPGT_OSSSVCMPGTPDVCMPGTPSVCMPGTSDVCMPGTSSVCMPLE_OQPDVCMPLE_OQPSVCMPLE_OQSDVCMPLE_OQSSVCMPLE_OSPDVCMPLE_OSPSVCMPLE_OSSDVCMPLE_OSSSVCMPLEPDVCMPLEPSVCMPLESDVCMPLESSVCMPLT_OQPDVCMPLT_OQPSVCMPLT_OQSDVCMPLT_OQSSVCMPLT_OSPDVCMPLT_OSPSVCMPLT_OSSDVCMPLT_OSSSVCMPLTPDVCMPLTPSVCMPLTSDVCMPLTSSVCMPNEQ_OQPDVCMPNEQ_OQPSVCMPNEQ_OQSDVCMPNEQ_OQSSVCMPNEQ_OSPDVCMPNEQ_OSPSVCMPNEQ_OSSDVCMPNEQ_OSSSVCMPNEQ_UQPDVCMPNEQ_UQPSVCMPNEQ_UQSDVCMPNEQ_UQSSVCMPNEQ_USPDVCMPNEQ_USPSVCMPNEQ_USSDVCMPNEQ_USSSVCMPNEQPDVCMPNEQPSVCMPNEQSDVCMPNEQSSVCMPNGE_UQPDVCMPNGE_UQPSVCMPNGE_UQSDVCMPNGE_UQSSVCMPNGE_USPDVCMPNGE_USPSVCMPNGE_USSDVCMPNGE_USSSVCMPNGEPDVCMPNGEPSVCMPNGESDVCMPNGESSVCMPNGT_UQPDVCMPNGT_UQPSVCMPNGT_UQSDVCMPNGT_UQSSVCMPNGT_USPDVCMPNGT_USPSVCMPNGT_USSDVCMPNGT_USSSVCMPNGTPDVCMPNGTPSVCMPNGTSDVCMPNGTSSVCMPNLE_UQPDVCMPNLE_UQPSVCMPNLE_UQSDVCMPNLE_UQSSVCMPNLE_USPDVCMPNLE_USPSVCMPNLE_USSDVCMPNLE_USSSVCMPNLEPDVCMPNLEPSVCMPNLESDVCMPNLESSVCMPNLT_UQPDVCMPNLT_UQPSVCMPNLT_UQSDVCMPNLT_UQSSVCMPNLT_USPDVCMPNLT_USPSVCMPNLT_USSDVCMPNLT_USSSVCMPNLTPDVCMPNLTPSVCMPNLTSDVCMPNLTSSVCMPORD_QPDVCMPORD_QPSVCMPORD_QSDVCMPORD_QSSVCMPORD_SPDVCMPORD_SPSVCMPORD_SSDVCMPORD_SSSVCMPORDPDVCMPORDPSVCMPORDSDVCMPORDSSVCMPPDVCMPPSVCMPSDVCMPSSVCMPTRUE_UQPDVCMPTRUE_UQPSVCMPTRUE_UQSDVCMPTRUE_UQSSVCMPTRUE_USPDVCMPTRUE_USPSVCMPTRUE_USSDVCMPTRUE_USSSVCMPTRUEPDVCMPTRUEPSVCMPTRUESDVCMPTRUESSVCMPUNORD_QPDVCMPUNORD_QPSVCMPUNORD_QSDVCMPUNORD_QSSVCMPUNORD_SPDVCMPUNORD_SPSVCMPUNORD_SSDVCMPUNORD_SSSVCMPUNORDPDVCMPUNORDPSVCMPUNORDSDVCMPUNORDSSVCOMISDVCOMISSVCVTDQ2PDVCVTDQ2PSVCVTPD2DQVCVTPD2PSVCVTPH2PSVCVTPS2DQVCVTPS2PDVCVTPS2PHVCVTSD2SIVCVTSD2SSVCVTSI2SDVCVTSI2SSVCVTSS2SDVCVTSS2SIVCVTTPD2DQVCVTTPS2DQVCVTTSD2SIVCVTTSS2SIVDIVPDVDIVPSVDIVSDVDIVSSVDPPDVDPPSVERRVERWVEXTRACTF128VEXTRACTI128VEXTRACTPSVFMADD123PDVFMADD123PSVFMADD123SDVFMADD123SSVFMADD132PDVFMADD132PSVFMADD132SDVFMADD132SSVFMADD213PDVFMADD213PSVFMADD213SDVFMADD213SSVFMADD231PDVFMADD231PSVFMADD231SDVFMADD231SSVFMADD312PDVFMADD312PSVFMADD312SDVFMADD312SSVFMADD321PDVFMADD321PSVFMADD321SDVFMADD321SSVFMADDPDVFMADDPSVFMADDSDVFMADDSSVFMADDSUB123PDVFMADDSUB123PSVFMADDSUB132PDVFMADDSUB132PSVFMADDSUB213PDVFMADDSUB213PSVFMADDSUB231PDVFMADDSUB231PSVFMADDSUB312PDVFMADDSUB312PSVFMADDSUB321PDVFMADDSUB321PSVFMADDSUBPDVFMADDSUBPSVFMSUB123PDVFMSUB123PSVFMSUB123SDVFMSUB123SSVFMSUB132PDVFMSUB132PSVFMSUB132SDVFMSUB132SSVFMSUB213PDVFMSUB213PSVFMSUB213SDVFMSUB213SSVFMSUB231PDVFMSUB231PSVFMSUB231SDVFMSUB231SSVFMSUB312PDVFMSUB312PSVFMSUB312SDVFMSUB312SSVFMSUB321PDVFMSUB321PSVFMSUB321SDVFMSUB321SSVFMSUBADD123PDVFMSUBADD123PSVFMSUBADD132PDVFMSUBADD132PSVFMSUBADD213PDVFMSUBADD213PSVFMSUBADD231PDVFMSUBADD231PSVFMSUBADD312PDVFMSUBADD312PSVFMSUBADD321PDVFMSUBADD321PSVFMSUBADDPDVFMSUBADDPSVFMSUBPDVFMSUBPSVFMSUBSDVFMSUBSSVFNMADD123PDVFNMADD123PSVFNMADD123SDVFNMADD123SSVFNMADD132PDVFNMADD132PSVFNMADD132SDVFNMADD132SSVFNMADD213PDVFNMADD213PSVFNMADD213SDVFNMADD213SSVFNMADD231PDVFNMADD231PSVFNMADD231SDVFNMADD231SSVFNMADD312PDVFNMADD312PSVFNMADD312SDVFNMADD312SSVFNMADD321PDVFNMADD321PSVFNMADD321SDVFNMADD321SSVFNMADDPDVFNMADDPSVFNMADDSDVFNMADDSSVFNMSUB123PDVFNMSUB123PSVFNMSUB123SDVFNMSUB123SSVFNMSUB132PDVFNMSUB132PSVFNMSUB132SDVFNMSUB132SSVFNMSUB213PDVFNMSUB213PSVFNMSUB213SDVFNMSUB213SSVFNMSUB231PDVFNMSUB231PSVFNMSUB231SDVFNMSUB231SSVFNMSUB312PDVFNMSUB312PSVFNMSUB312SDVFNMSUB312SSVFNMSUB321PDVFNMSUB321PSVFNMSUB321SDVFNMSUB321SSVFNMSUBPDVFNMSUBPSVFNMSUBSDVFNMSUBSSVFRCZPDVFRCZPSVFRCZSDVFRCZSSVGATHERDPDVGATHERDPSVGATHERQPDVGATHERQPSVHADDPDVHADDPSVHSUBPDVHSUBPSVINSERTF128VINSERTI128VINSERTPSVLDDQUVLDMXCSRVLDQQUVMASKMOVDQUVMASKMOVPDVMASKMOVPSVMAXPDVMAXPSVMAXSDVMAXSSVMCALLVMCLEARVMFUNCVMINPDVMINPSVMINSDVMINSSVMLAUNCHVMLOADVMMCALLVMOVAPDVMOVAPSVMOVDVMOVDDUPVMOVDQAVMOVDQUVMOVHLPSVMOVHPDVMOVHPSVMOVLHPSVMOVLPDVMOVLPSVMOVMSKPDVMOVMSKPSVMOVNTDQVMOVNTDQAVMOVNTPDVMOVNTPSVMOVNTQQVMOVQVMOVQQAVMOVQQUVMOVSDVMOVSHDUPVMOVSLDUPVMOVSSVMOVUPDVMOVUPSVMPSADBWVMPTRLDVMPTRSTVMREADVMRESUMEVMRUNVMSAVEVMULPDVMULPSVMULSDVMULSSVMWRITEVMXOFFVMXONVORPDVORPSVPABSBVPABSDVPABSWVPACKSSDWVPACKSSWBVPACKUSDWVPACKUSWBVPADDBVPADDDVPADDQVPADDSBVPADDSWVPADDUSBVPADDUSWVPADDWVPALIGNRVPANDVPANDNVPAVGBVPAVGWVPBLENDDVPBLENDVBVPBLENDWVPBROADCASTBVPBROADCASTDVPBROADCASTQVPBROADCASTWVPCLMULHQHQDQVPCLMULHQLQDQVPCLMULLQHQDQVPCLMULLQLQDQVPCLMULQDQVPCMOVVPCMPEQBVPCMPEQDVPCMPEQQVPCMPEQWVPCMPESTRIVPCMPESTRMVPCMPGTBVPCMPGTDVPCMPGTQVPCMPGTWVPCMPISTRIVPCMPISTRMVPCOMBVPCOMDVPCOMQVPCOMUBVPCOMUDVPCOMUQVPCOMUWVPCOMWVPERM2F128VPERM2I128VPERMDVPERMILPDVPERMILPSVPERMPDVPERMPSVPERMQVPEXTRBVPEXTRDVPEXTRQVPEXTRWVPGATHERDDVPGATHERDQVPGATHERQDVPGATHERQQVPHADDBDVPHADDBQVPHADDBWVPHADDDVPHADDDQVPHADDSWVPHADDUBDVPHADDUBQVPHADDUBWVPHADDUDQVPHADDUWDVPHADDUWQVPHADDWVPHADDWDVPHADDWQVPHMINPOSUWVPHSUBBWVPHSUBDVPHSUBDQVPHSUBSWVPHSUBWVPHSUBWDVPINSRBVPINSRDVPINSRQVPINSRWVPMACSDDVPMACSDQHVPMACSDQLVPMACSSDDVPMACSSDQHVPMACSSDQLVPMACSSWDVPMACSSWWVPMACSWDVPMACSWWVPMADCSSWDVPMADCSWDVPMADDUBSWVPMADDWDVPMASKMOVDVPMASKMOVQVPMAXSBVPMAXSDVPMAXSWVPMAXUBVPMAXUDVPMAXUWVPMINSBVPMINSDVPMINSWVPMINUBVPMINUDVPMINUWVPMOVMSKBVPMOVSXBDVPMOVSXBQVPMOVSXBWVPMOVSXDQVPMOVSXWDVPMOVSXWQVPMOVZXBDVPMOVZXBQVPMOVZXBWVPMOVZXDQVPMOVZXWDVPMOVZXWQVPMULDQVPMULHRSWVPMULHUWVPMULHWVPMULLDVPMULLWVPMULUDQVPORVPPERMVPROTBVPROTDVPROTQVPROTWVPSADBWVPSHABVPSHADVPSHAQVPSHAWVPSHLBVPSHLDVPSHLQVPSHLWVPSHUFBVPSHUFDVPSHUFHWVPSHUFLWVPSIGNBVPSIGNDVPSIGNWVPSLLDVPSLLDQVPSLLQVPSLLVDVPSLLVQVPSLLWVPSRADVPSRAVDVPSRAWVPSRLDVPSRLDQVPSRLQVPSRLVDVPSRLVQVPSRLWVPSUBBVPSUBDVPSUBQVPSUBSBVPSUBSWVPSUBUSBVPSUBUSWVPSUBWVPTESTVPUNPCKHBWVPUNPCKHDQVPUNPCKHQDQVPUNPCKHWDVPUNPCKLBWVPUNPCKLDQVPUNPCKLQDQVPUNPCKLWDVPXORVRCPPSVRCPSSVROUNDPDVROUNDPSVROUNDSDVROUNDSSVRSQRTPSVRSQRTSSVSHUFPDVSHUFPSVSQRTPDVSQRTPSVSQRTSDVSQRTSSVSTMXCSRVSUBPDVSUBPSVSUBSDVSUBSSVTESTPDVTESTPSVUCOMISDVUCOMISSVUNPCKHPDVUNPCKHPSVUNPCKLPDVUNPCKLPSVXORPDVXORPSVZEROALLVZEROUPPER"
+const instNames = "ADCADCXADDADDSDADDSSADOXANDANDNBEXTRBLCFILLBLCIBLCICBLCMSKBLCSBLSFILLBLSIBLSICBLSMSKBLSRBNDCLBNDCNBNDCUBNDLDXBNDMKBNDMOVBNDSTXBSFBSRBSWAPBTBTCBTRBTSBZHICALLCBWCDQCDQECLACCLCCLDCLFLUSHCLGICLICLTSCLZEROCMCCMOVACMOVAECMOVBCMOVBECMOVCCMOVECMOVGCMOVGECMOVLCMOVLECMOVNACMOVNAECMOVNBCMOVNBECMOVNCCMOVNECMOVNGCMOVNGECMOVNLCMOVNLECMOVNOCMOVNPCMOVNSCMOVNZCMOVOCMOVPCMOVPECMOVPOCMOVSCMOVZCMPCMPEQSDCMPEQSSCMPLESDCMPLESSCMPLTSDCMPLTSSCMPNEQSDCMPNEQSSCMPNLESDCMPNLESSCMPNLTSDCMPNLTSSCMPORDSDCMPORDSSCMPSBCMPSDCMPSQCMPSSCMPSWCMPUNORDSDCMPUNORDSSCMPXCHGCMPXCHG16BCMPXCHG8BCOMISDCOMISSCPUIDCQOCRC32CVTPD2DQCVTPD2PICVTPS2DQCVTPS2PICVTSD2SICVTSD2SSCVTSI2SDCVTSI2SSCVTSS2SDCVTSS2SICVTTPD2DQCVTTPD2PICVTTPS2DQCVTTPS2PICVTTSD2SICVTTSS2SICWDCWDEDECDIVDIVSDDIVSSEMMSENTEREXTRQGETSECHLTICEBPIDIVIMULININCINSBINSDINSERTQINSWINTINT01INT03INT1INT3INVDINVEPTINVLPGINVLPGAINVPCIDINVVPIDIRETIRETDIRETQIRETWJAJAEJBJBEJCJEJECXZJGJGEJLJLEJMPJNAJNAEJNBJNBEJNCJNEJNGJNGEJNLJNLEJNOJNPJNSJNZJOJPJPEJPOJRCXZJSJZLAHFLARLDDQULDMXCSRLEALEAVELFENCELFSLGDTLGSLIDTLLDTLLWPCBLMSWLODSBLODSDLODSQLODSWLOOPLOOPELOOPNELOOPNZLOOPZLSLLSSLTRLWPINSLWPVALLZCNTMASKMOVDQUMASKMOVQMAXSDMAXSSMFENCEMINSDMINSSMONITORMONITORXMOVMOVABSMOVAPDMOVAPSMOVBEMOVDMOVDDUPMOVDQ2QMOVDQAMOVDQUMOVHLPSMOVHPDMOVHPSMOVLHPSMOVLPDMOVLPSMOVMSKPDMOVMSKPSMOVNTDQMOVNTDQAMOVNTIMOVNTPDMOVNTPSMOVNTQMOVNTSDMOVNTSSMOVQMOVQ2DQMOVSBMOVSDMOVSHDUPMOVSLDUPMOVSQMOVSSMOVSWMOVSXMOVSXDMOVUPDMOVUPSMOVZXMPSADBWMULMULSDMULSSMULXMWAITMWAITXNEGNOPNOTOROUTOUTSBOUTSDOUTSWPAUSEPOPPOPCNTPOPFPOPFQPOPFWPREFETCHPREFETCHNTAPREFETCHT0PREFETCHT1PREFETCHT2PREFETCHWPREFETCHWT1PUSHPUSHFPUSHFQPUSHFWRCLRCPSSRCRRDFSBASERDGSBASERDMSRRDPIDRDPKRURDPMCRDRANDRDSEEDRDTSCRDTSCPRETRETFRETNROLRORRORXROUNDSDROUNDSSRSMRSQRTSSSAHFSALSARSARXSBBSCASBSCASDSCASQSCASWSETASETAESETBSETBESETCSETESETGSETGESETLSETLESETNASETNAESETNBSETNBESETNCSETNESETNGSETNGESETNLSETNLESETNOSETNPSETNSSETNZSETOSETPSETPESETPOSETSSETZSFENCESGDTSHLSHLDSHLXSHRSHRDSHRXSIDTSKINITSLDTSLWPCBSMSWSQRTSDSQRTSSSTACSTCSTDSTGISTISTMXCSRSTOSBSTOSDSTOSQSTOSWSTRSUBSUBSDSUBSSSWAPGSSYSCALLSYSRETT1MSKCTESTTZCNTTZMSKUCOMISDUCOMISSUD2UD2AWBINVDWRFSBASEWRGSBASEWRMSRWRPKRUXORAESDECAESDECLASTAESENCAESENCLASTAESIMCAESKEYGENASSISTSHA1MSG1SHA1MSG2SHA1NEXTESHA1RNDS4SHA256MSG1SHA256MSG2SHA256RNDS2XABORTXADDXBEGINXCHGXENDXGETBVXLATXLATBXRSTORXRSTOR64XRSTORS64XSAVEXSAVE64XSAVEC64XSAVEOPT64XSAVES64XSETBVXTESTF2XM1FABSFADDFADDPFBLDFBSTPFCHSFCLEXFCMOVBFCMOVBEFCMOVEFCMOVNBFCMOVNBEFCMOVNEFCMOVNUFCMOVUFCOMFCOMIFCOMIPFCOMPFCOMPPFCOSFDECSTPFDISIFDIVFDIVPFDIVRFDIVRPFEMMSFENIFFREEFIADDFICOMFICOMPFIDIVFIDIVRFILDFIMULFINCSTPFINITFISTFISTPFISTTPFISUBFISUBRFLDFLD1FLDCWFLDENVFLDL2EFLDL2TFLDLG2FLDLN2FLDPIFLDZFMULFMULPFNCLEXFNDISIFNENIFNINITFNOPFNSAVEFNSTCWFNSTENVFNSTSWFPATANFPREMFPREM1FPTANFRNDINTFRSTORFSAVEFSCALEFSETPMFSINFSINCOSFSQRTFSTFSTCWFSTENVFSTPFSTSWFSUBFSUBPFSUBRFSUBRPFTSTFUCOMFUCOMIFUCOMIPFUCOMPFUCOMPPFWAITFXAMFXCHFXRSTORFXRSTOR64FXSAVEFXSAVE64FXTRACTFYL2XFYL2XP1ADDPDADDPSADDSUBPDADDSUBPSANDNPDANDNPSANDPDANDPSBLENDPDBLENDPSBLENDVPDBLENDVPSCMPEQPDCMPEQPSCMPLEPDCMPLEPSCMPLTPDCMPLTPSCMPNEQPDCMPNEQPSCMPNLEPDCMPNLEPSCMPNLTPDCMPNLTPSCMPORDPDCMPORDPSCMPPDCMPPSCMPUNORDPDCMPUNORDPSCVTDQ2PDCVTDQ2PSCVTPD2PSCVTPI2PDCVTPI2PSCVTPS2PDDIVPDDIVPSDPPDDPPSEXTRACTPSHADDPDHADDPSHSUBPDHSUBPSINSERTPSMAXPDMAXPSMINPDMINPSMULPDMULPSORPDORPSRCPPSROUNDPDROUNDPSRSQRTPSSHUFPDSHUFPSSQRTPDSQRTPSSUBPDSUBPSUNPCKHPDUNPCKHPSUNPCKLPDUNPCKLPSXORPDXORPSPABSBPABSDPABSWPACKSSDWPACKSSWBPACKUSDWPACKUSWBPADDBPADDDPADDQPADDSBPADDSWPADDUSBPADDUSWPADDWPALIGNRPANDPANDNPAVGBPAVGUSBPAVGWPBLENDVBPBLENDWPCLMULHQHQDQPCLMULHQLQDQPCLMULLQHQDQPCLMULLQLQDQPCLMULQDQPCMPEQBPCMPEQDPCMPEQQPCMPEQWPCMPESTRIPCMPESTRMPCMPGTBPCMPGTDPCMPGTQPCMPGTWPCMPISTRIPCMPISTRMPDEPPEXTPEXTRBPEXTRDPEXTRQPEXTRWPF2IDPF2IWPFACCPFADDPFCMPEQPFCMPGEPFCMPGTPFMAXPFMINPFMULPFNACCPFPNACCPFRCPPFRCPIT1PFRCPIT2PFRSQIT1PFRSQRTPFSUBPFSUBRPHADDDPHADDSWPHADDWPHMINPOSUWPHSUBDPHSUBSWPHSUBWPI2FDPI2FWPINSRBPINSRDPINSRQPINSRWPMADDUBSWPMADDWDPMAXSBPMAXSDPMAXSWPMAXUBPMAXUDPMAXUWPMINSBPMINSDPMINSWPMINUBPMINUDPMINUWPMOVMSKBPMOVSXBDPMOVSXBQPMOVSXBWPMOVSXDQPMOVSXWDPMOVSXWQPMOVZXBDPMOVZXBQPMOVZXBWPMOVZXDQPMOVZXWDPMOVZXWQPMULDQPMULHRSWPMULHRWAPMULHUWPMULHWPMULLDPMULLWPMULUDQPORPSADBWPSHUFBPSHUFDPSHUFHWPSHUFLWPSHUFWPSIGNBPSIGNDPSIGNWPSLLDPSLLDQPSLLQPSLLWPSRADPSRAWPSRLDPSRLDQPSRLQPSRLWPSUBBPSUBDPSUBQPSUBSBPSUBSWPSUBUSBPSUBUSWPSUBWPSWAPDPTESTPUNPCKHBWPUNPCKHDQPUNPCKHQDQPUNPCKHWDPUNPCKLBWPUNPCKLDQPUNPCKLQDQPUNPCKLWDPXORVADDPDVADDPSVADDSDVADDSSVADDSUBPDVADDSUBPSVAESDECVAESDECLASTVAESENCVAESENCLASTVAESIMCVAESKEYGENASSISTVANDNPDVANDNPSVANDPDVANDPSVBLENDPDVBLENDPSVBLENDVPDVBLENDVPSVBROADCASTF128VBROADCASTI128VBROADCASTSDVBROADCASTSSVCMPEQ_OSPDVCMPEQ_OSPSVCMPEQ_OSSDVCMPEQ_OSSSVCMPEQ_UQPDVCMPEQ_UQPSVCMPEQ_UQSDVCMPEQ_UQSSVCMPEQ_USPDVCMPEQ_USPSVCMPEQ_USSDVCMPEQ_USSSVCMPEQPDVCMPEQPSVCMPEQSDVCMPEQSSVCMPFALSE_OQPDVCMPFALSE_OQPSVCMPFALSE_OQSDVCMPFALSE_OQSSVCMPFALSE_OSPDVCMPFALSE_OSPSVCMPFALSE_OSSDVCMPFALSE_OSSSVCMPFALSEPDVCMPFALSEPSVCMPFALSESDVCMPFALSESSVCMPGE_OQPDVCMPGE_OQPSVCMPGE_OQSDVCMPGE_OQSSVCMPGE_OSPDVCMPGE_OSPSVCMPGE_OSSDVCMPGE_OSSSVCMPGEPDVCMPGEPSVCMPGESDVCMPGESSVCMPGT_OQPDVCMPGT_OQPSVCMPGT_OQSDVCMPGT_OQSSVCMPGT_OSPDVCMPGT_OSPSVCMPGT_OSSDVCMPGT_OSSSVCMPGTPDVCMPGTPSVCMPGTSDVCMPGTSSVCMPLE_OQPDVCMPLE_OQPSVCMPLE_OQSDVCMPLE_OQSSVCMPLE_OSPDVCMPLE_OSPSVCMPLE_OSSDVCMPLE_OSSSVCMPLEPDVCMPLEPSVCMPLESDVCMPLESSVCMPLT_OQPDVCMPLT_OQPSVCMPLT_OQSDVCMPLT_OQSSVCMPLT_OSPDVCMPLT_OSPSVCMPLT_OSSDVCMPLT_OSSSVCMPLTPDVCMPLTPSVCMPLTSDVCMPLTSSVCMPNEQ_OQPDVCMPNEQ_OQPSVCMPNEQ_OQSDVCMPNEQ_OQSSVCMPNEQ_OSPDVCMPNEQ_OSPSVCMPNEQ_OSSDVCMPNEQ_OSSSVCMPNEQ_UQPDVCMPNEQ_UQPSVCMPNEQ_UQSDVCMPNEQ_UQSSVCMPNEQ_USPDVCMPNEQ_USPSVCMPNEQ_USSDVCMPNEQ_USSSVCMPNEQPDVCMPNEQPSVCMPNEQSDVCMPNEQSSVCMPNGE_UQPDVCMPNGE_UQPSVCMPNGE_UQSDVCMPNGE_UQSSVCMPNGE_USPDVCMPNGE_USPSVCMPNGE_USSDVCMPNGE_USSSVCMPNGEPDVCMPNGEPSVCMPNGESDVCMPNGESSVCMPNGT_UQPDVCMPNGT_UQPSVCMPNGT_UQSDVCMPNGT_UQSSVCMPNGT_USPDVCMPNGT_USPSVCMPNGT_USSDVCMPNGT_USSSVCMPNGTPDVCMPNGTPSVCMPNGTSDVCMPNGTSSVCMPNLE_UQPDVCMPNLE_UQPSVCMPNLE_UQSDVCMPNLE_UQSSVCMPNLE_USPDVCMPNLE_USPSVCMPNLE_USSDVCMPNLE_USSSVCMPNLEPDVCMPNLEPSVCMPNLESDVCMPNLESSVCMPNLT_UQPDVCMPNLT_UQPSVCMPNLT_UQSDVCMPNLT_UQSSVCMPNLT_USPDVCMPNLT_USPSVCMPNLT_USSDVCMPNLT_USSSVCMPNLTPDVCMPNLTPSVCMPNLTSDVCMPNLTSSVCMPORD_QPDVCMPORD_QPSVCMPORD_QSDVCMPORD_QSSVCMPORD_SPDVCMPORD_SPSVCMPORD_SSDVCMPORD_SSSVCMPORDPDVCMPORDPSVCMPORDSDVCMPORDSSVCMPPDVCMPPSVCMPSDVCMPSSVCMPTRUE_UQPDVCMPTRUE_UQPSVCMPTRUE_UQSDVCMPTRUE_UQSSVCMPTRUE_USPDVCMPTRUE_USPSVCMPTRUE_USSDVCMPTRUE_USSSVCMPTRUEPDVCMPTRUEPSVCMPTRUESDVCMPTRUESSVCMPUNORD_QPDVCMPUNORD_QPSVCMPUNORD_QSDVCMPUNORD_QSSVCMPUNORD_SPDVCMPUNORD_SPSVCMPUNORD_SSDVCMPUNORD_SSSVCMPUNORDPDVCMPUNORDPSVCMPUNORDSDVCMPUNORDSSVCOMISDVCOMISSVCVTDQ2PDVCVTDQ2PSVCVTPD2DQVCVTPD2PSVCVTPH2PSVCVTPS2DQVCVTPS2PDVCVTPS2PHVCVTSD2SIVCVTSD2SSVCVTSI2SDVCVTSI2SSVCVTSS2SDVCVTSS2SIVCVTTPD2DQVCVTTPS2DQVCVTTSD2SIVCVTTSS2SIVDIVPDVDIVPSVDIVSDVDIVSSVDPPDVDPPSVERRVERWVEXTRACTF128VEXTRACTI128VEXTRACTPSVFMADD123PDVFMADD123PSVFMADD123SDVFMADD123SSVFMADD132PDVFMADD132PSVFMADD132SDVFMADD132SSVFMADD213PDVFMADD213PSVFMADD213SDVFMADD213SSVFMADD231PDVFMADD231PSVFMADD231SDVFMADD231SSVFMADD312PDVFMADD312PSVFMADD312SDVFMADD312SSVFMADD321PDVFMADD321PSVFMADD321SDVFMADD321SSVFMADDPDVFMADDPSVFMADDSDVFMADDSSVFMADDSUB123PDVFMADDSUB123PSVFMADDSUB132PDVFMADDSUB132PSVFMADDSUB213PDVFMADDSUB213PSVFMADDSUB231PDVFMADDSUB231PSVFMADDSUB312PDVFMADDSUB312PSVFMADDSUB321PDVFMADDSUB321PSVFMADDSUBPDVFMADDSUBPSVFMSUB123PDVFMSUB123PSVFMSUB123SDVFMSUB123SSVFMSUB132PDVFMSUB132PSVFMSUB132SDVFMSUB132SSVFMSUB213PDVFMSUB213PSVFMSUB213SDVFMSUB213SSVFMSUB231PDVFMSUB231PSVFMSUB231SDVFMSUB231SSVFMSUB312PDVFMSUB312PSVFMSUB312SDVFMSUB312SSVFMSUB321PDVFMSUB321PSVFMSUB321SDVFMSUB321SSVFMSUBADD123PDVFMSUBADD123PSVFMSUBADD132PDVFMSUBADD132PSVFMSUBADD213PDVFMSUBADD213PSVFMSUBADD231PDVFMSUBADD231PSVFMSUBADD312PDVFMSUBADD312PSVFMSUBADD321PDVFMSUBADD321PSVFMSUBADDPDVFMSUBADDPSVFMSUBPDVFMSUBPSVFMSUBSDVFMSUBSSVFNMADD123PDVFNMADD123PSVFNMADD123SDVFNMADD123SSVFNMADD132PDVFNMADD132PSVFNMADD132SDVFNMADD132SSVFNMADD213PDVFNMADD213PSVFNMADD213SDVFNMADD213SSVFNMADD231PDVFNMADD231PSVFNMADD231SDVFNMADD231SSVFNMADD312PDVFNMADD312PSVFNMADD312SDVFNMADD312SSVFNMADD321PDVFNMADD321PSVFNMADD321SDVFNMADD321SSVFNMADDPDVFNMADDPSVFNMADDSDVFNMADDSSVFNMSUB123PDVFNMSUB123PSVFNMSUB123SDVFNMSUB123SSVFNMSUB132PDVFNMSUB132PSVFNMSUB132SDVFNMSUB132SSVFNMSUB213PDVFNMSUB213PSVFNMSUB213SDVFNMSUB213SSVFNMSUB231PDVFNMSUB231PSVFNMSUB231SDVFNMSUB231SSVFNMSUB312PDVFNMSUB312PSVFNMSUB312SDVFNMSUB312SSVFNMSUB321PDVFNMSUB321PSVFNMSUB321SDVFNMSUB321SSVFNMSUBPDVFNMSUBPSVFNMSUBSDVFNMSUBSSVFRCZPDVFRCZPSVFRCZSDVFRCZSSVGATHERDPDVGATHERDPSVGATHERQPDVGATHERQPSVHADDPDVHADDPSVHSUBPDVHSUBPSVINSERTF128VINSERTI128VINSERTPSVLDDQUVLDMXCSRVLDQQUVMASKMOVDQUVMASKMOVPDVMASKMOVPSVMAXPDVMAXPSVMAXSDVMAXSSVMCALLVMCLEARVMFUNCVMINPDVMINPSVMINSDVMINSSVMLAUNCHVMLOADVMMCALLVMOVAPDVMOVAPSVMOVDVMOVDDUPVMOVDQAVMOVDQUVMOVHLPSVMOVHPDVMOVHPSVMOVLHPSVMOVLPDVMOVLPSVMOVMSKPDVMOVMSKPSVMOVNTDQVMOVNTDQAVMOVNTPDVMOVNTPSVMOVNTQQVMOVQVMOVQQAVMOVQQUVMOVSDVMOVSHDUPVMOVSLDUPVMOVSSVMOVUPDVMOVUPSVMPSADBWVMPTRLDVMPTRSTVMREADVMRESUMEVMRUNVMSAVEVMULPDVMULPSVMULSDVMULSSVMWRITEVMXOFFVMXONVORPDVORPSVPABSBVPABSDVPABSWVPACKSSDWVPACKSSWBVPACKUSDWVPACKUSWBVPADDBVPADDDVPADDQVPADDSBVPADDSWVPADDUSBVPADDUSWVPADDWVPALIGNRVPANDVPANDNVPAVGBVPAVGWVPBLENDDVPBLENDVBVPBLENDWVPBROADCASTBVPBROADCASTDVPBROADCASTQVPBROADCASTWVPCLMULHQHQDQVPCLMULHQLQDQVPCLMULLQHQDQVPCLMULLQLQDQVPCLMULQDQVPCMOVVPCMPEQBVPCMPEQDVPCMPEQQVPCMPEQWVPCMPESTRIVPCMPESTRMVPCMPGTBVPCMPGTDVPCMPGTQVPCMPGTWVPCMPISTRIVPCMPISTRMVPCOMBVPCOMDVPCOMQVPCOMUBVPCOMUDVPCOMUQVPCOMUWVPCOMWVPERM2F128VPERM2I128VPERMDVPERMILPDVPERMILPSVPERMPDVPERMPSVPERMQVPEXTRBVPEXTRDVPEXTRQVPEXTRWVPGATHERDDVPGATHERDQVPGATHERQDVPGATHERQQVPHADDBDVPHADDBQVPHADDBWVPHADDDVPHADDDQVPHADDSWVPHADDUBDVPHADDUBQVPHADDUBWVPHADDUDQVPHADDUWDVPHADDUWQVPHADDWVPHADDWDVPHADDWQVPHMINPOSUWVPHSUBBWVPHSUBDVPHSUBDQVPHSUBSWVPHSUBWVPHSUBWDVPINSRBVPINSRDVPINSRQVPINSRWVPMACSDDVPMACSDQHVPMACSDQLVPMACSSDDVPMACSSDQHVPMACSSDQLVPMACSSWDVPMACSSWWVPMACSWDVPMACSWWVPMADCSSWDVPMADCSWDVPMADDUBSWVPMADDWDVPMASKMOVDVPMASKMOVQVPMAXSBVPMAXSDVPMAXSWVPMAXUBVPMAXUDVPMAXUWVPMINSBVPMINSDVPMINSWVPMINUBVPMINUDVPMINUWVPMOVMSKBVPMOVSXBDVPMOVSXBQVPMOVSXBWVPMOVSXDQVPMOVSXWDVPMOVSXWQVPMOVZXBDVPMOVZXBQVPMOVZXBWVPMOVZXDQVPMOVZXWDVPMOVZXWQVPMULDQVPMULHRSWVPMULHUWVPMULHWVPMULLDVPMULLWVPMULUDQVPORVPPERMVPROTBVPROTDVPROTQVPROTWVPSADBWVPSHABVPSHADVPSHAQVPSHAWVPSHLBVPSHLDVPSHLQVPSHLWVPSHUFBVPSHUFDVPSHUFHWVPSHUFLWVPSIGNBVPSIGNDVPSIGNWVPSLLDVPSLLDQVPSLLQVPSLLVDVPSLLVQVPSLLWVPSRADVPSRAVDVPSRAWVPSRLDVPSRLDQVPSRLQVPSRLVDVPSRLVQVPSRLWVPSUBBVPSUBDVPSUBQVPSUBSBVPSUBSWVPSUBUSBVPSUBUSWVPSUBWVPTESTVPUNPCKHBWVPUNPCKHDQVPUNPCKHQDQVPUNPCKHWDVPUNPCKLBWVPUNPCKLDQVPUNPCKLQDQVPUNPCKLWDVPXORVRCPPSVRCPSSVROUNDPDVROUNDPSVROUNDSDVROUNDSSVRSQRTPSVRSQRTSSVSHUFPDVSHUFPSVSQRTPDVSQRTPSVSQRTSDVSQRTSSVSTMXCSRVSUBPDVSUBPSVSUBSDVSUBSSVTESTPDVTESTPSVUCOMISDVUCOMISSVUNPCKHPDVUNPCKHPSVUNPCKLPDVUNPCKLPSVXORPDVXORPSVZEROALLVZEROUPPER"
 
-var instNameOffsets = [...]uint16{0, 3, 7, 10, 15, 20, 24, 27, 31, 36, 43, 47, 52, 58, 62, 69, 73, 78, 84, 88, 93, 98, 103, 109, 114, 120, 126, 129, 132, 137, 139, 142, 145, 148, 152, 156, 159, 162, 166, 170, 173, 176, 183, 187, 190, 194, 200, 203, 208, 214, 219, 225, 230, 235, 240, 246, 251, 257, 263, 270, 276, 283, 289, 295, 301, 308, 314, 321, 327, 333, 339, 345, 350, 355, 361, 367, 372, 377, 380, 387, 394, 401, 408, 415, 422, 430, 438, 446, 454, 462, 470, 478, 486, 491, 496, 501, 506, 511, 521, 531, 538, 548, 557, 563, 569, 574, 577, 582, 590, 598, 606, 614, 622, 630, 638, 646, 654, 662, 671, 680, 689, 698, 707, 716, 719, 723, 726, 729, 734, 739, 743, 748, 753, 759, 762, 767, 771, 775, 777, 780, 784, 788, 795, 799, 802, 807, 812, 816, 820, 824, 830, 836, 843, 850, 854, 859, 864, 869, 871, 874, 876, 879, 881, 883, 888, 890, 893, 895, 898, 901, 904, 908, 911, 915, 918, 921, 924, 928, 931, 935, 938, 941, 944, 947, 949, 951, 954, 957, 962, 964, 966, 970, 973, 978, 985, 988, 993, 999, 1002, 1006, 1009, 1013, 1017, 1023, 1027, 1032, 1037, 1042, 1047, 1051, 1056, 1062, 1068, 1073, 1076, 1079, 1082, 1088, 1094, 1099, 1109, 1117, 1122, 1127, 1133, 1138, 1143, 1150, 1158, 1161, 1167, 1173, 1179, 1184, 1188, 1195, 1202, 1208, 1214, 1221, 1227, 1233, 1240, 1246, 1252, 1260, 1268, 1275, 1283, 1289, 1296, 1303, 1309, 1316, 1323, 1327, 1334, 1339, 1344, 1352, 1360, 1365, 1370, 1375, 1380, 1386, 1392, 1398, 1403, 1410, 1413, 1418, 1423, 1427, 1432, 1438, 1441, 1444, 1447, 1449, 1452, 1457, 1462, 1467, 1472, 1475, 1481, 1485, 1490, 1495, 1503, 1514, 1524, 1534, 1544, 1553, 1557, 1562, 1568, 1574, 1577, 1582, 1585, 1593, 1601, 1606, 1611, 1617, 1622, 1628, 1634, 1639, 1645, 1648, 1652, 1656, 1659, 1662, 1666, 1673, 1680, 1683, 1690, 1694, 1697, 1700, 1704, 1707, 1712, 1717, 1722, 1727, 1731, 1736, 1740, 1745, 1749, 1753, 1757, 1762, 1766, 1771, 1776, 1782, 1787, 1793, 1798, 1803, 1808, 1814, 1819, 1825, 1830, 1835, 1840, 1845, 1849, 1853, 1858, 1863, 1867, 1871, 1877, 1881, 1884, 1888, 1892, 1895, 1899, 1903, 1907, 1913, 1917, 1923, 1927, 1933, 1939, 1943, 1946, 1949, 1953, 1956, 1963, 1968, 1973, 1978, 1983, 1986, 1989, 1994, 1999, 2005, 2012, 2018, 2024, 2028, 2033, 2038, 2045, 2052, 2055, 2059, 2065, 2073, 2081, 2086, 2092, 2095, 2101, 2111, 2117, 2127, 2133, 2148, 2156, 2164, 2173, 2182, 2192, 2202, 2213, 2219, 2223, 2229, 2233, 2237, 2243, 2247, 2252, 2258, 2266, 2275, 2280, 2287, 2295, 2305, 2313, 2319, 2324, 2329, 2333, 2337, 2342, 2346, 2351, 2355, 2360, 2366, 2373, 2379, 2386, 2394, 2401, 2408, 2414, 2418, 2423, 2429, 2434, 2440, 2444, 2451, 2456, 2460, 2465, 2470, 2476, 2481, 2485, 2490, 2495, 2500, 2506, 2511, 2517, 2521, 2526, 2533, 2538, 2542, 2547, 2553, 2558, 2564, 2567, 2571, 2576, 2582, 2588, 2594, 2600, 2606, 2611, 2615, 2619, 2624, 2630, 2636, 2641, 2647, 2651, 2657, 2663, 2670, 2676, 2682, 2687, 2693, 2698, 2705, 2711, 2716, 2722, 2728, 2732, 2739, 2744, 2747, 2752, 2758, 2762, 2767, 2771, 2776, 2781, 2787, 2791, 2796, 2802, 2809, 2815, 2822, 2827, 2831, 2835, 2842, 2851, 2857, 2865, 2872, 2877, 2884, 2889, 2894, 2902, 2910, 2916, 2922, 2927, 2932, 2939, 2946, 2954, 2962, 2969, 2976, 2983, 2990, 2997, 3004, 3012, 3020, 3028, 3036, 3044, 3052, 3060, 3068, 3073, 3078, 3088, 3098, 3106, 3114, 3122, 3130, 3138, 3146, 3151, 3156, 3160, 3164, 3173, 3179, 3185, 3191, 3197, 3205, 3210, 3215, 3220, 3225, 3230, 3235, 3239, 3243, 3248, 3255, 3262, 3269, 3275, 3281, 3287, 3293, 3298, 3303, 3311, 3319, 3327, 3335, 3340, 3345, 3350, 3355, 3360, 3368, 3376, 3384, 3392, 3397, 3402, 3407, 3413, 3419, 3426, 3433, 3438, 3445, 3449, 3454, 3459, 3466, 3471, 3479, 3486, 3498, 3510, 3522, 3534, 3543, 3550, 3557, 3564, 3571, 3580, 3589, 3596, 3603, 3610, 3617, 3626, 3635, 3639, 3643, 3649, 3655, 3661, 3667, 3672, 3677, 3682, 3687, 3694, 3701, 3708, 3713, 3718, 3723, 3729, 3736, 3741, 3749, 3757, 3765, 3772, 3777, 3783, 3789, 3796, 3802, 3812, 3818, 3825, 3831, 3836, 3841, 3847, 3853, 3859, 3865, 3874, 3881, 3887, 3893, 3899, 3905, 3911, 3917, 3923, 3929, 3935, 3941, 3947, 3953, 3961, 3969, 3977, 3985, 3993, 4001, 4009, 4017, 4025, 4033, 4041, 4049, 4057, 4063, 4071, 4079, 4086, 4092, 4098, 4104, 4111, 4114, 4120, 4126, 4132, 4139, 4146, 4152, 4158, 4164, 4170, 4175, 4181, 4186, 4191, 4196, 4201, 4206, 4212, 4217, 4222, 4227, 4232, 4237, 4243, 4249, 4256, 4263, 4268, 4274, 4279, 4288, 4297, 4307, 4316, 4325, 4334, 4344, 4353, 4357, 4363, 4369, 4375, 4381, 4390, 4399, 4406, 4417, 4424, 4435, 4442, 4458, 4465, 4472, 4478, 4484, 4492, 4500, 4509, 4518, 4532, 4546, 4558, 4570, 4581, 4592, 4603, 4614, 4625, 4636, 4647, 4658, 4669, 4680, 4691, 4702, 4710, 4718, 4726, 4734, 4748, 4762, 4776, 4790, 4804, 4818, 4832, 4846, 4857, 4868, 4879, 4890, 4901, 4912, 4923, 4934, 4945, 4956, 4967, 4978, 4986, 4994, 5002, 5010, 5021, 5032, 5043, 5054, 5065, 5076, 5087, 5098, 5106, 5114, 5122, 5130, 5141, 5152, 5163, 5174, 5185, 5196, 5207, 5218, 5226, 5234, 5242, 5250, 5261, 5272, 5283, 5294, 5305, 5316, 5327, 5338, 5346, 5354, 5362, 5370, 5382, 5394, 5406, 5418, 5430, 5442, 5454, 5466, 5478, 5490, 5502, 5514, 5526, 5538, 5550, 5562, 5571, 5580, 5589, 5598, 5610, 5622, 5634, 5646, 5658, 5670, 5682, 5694, 5703, 5712, 5721, 5730, 5742, 5754, 5766, 5778, 5790, 5802, 5814, 5826, 5835, 5844, 5853, 5862, 5874, 5886, 5898, 5910, 5922, 5934, 5946, 5958, 5967, 5976, 5985, 5994, 6006, 6018, 6030, 6042, 6054, 6066, 6078, 6090, 6099, 6108, 6117, 6126, 6137, 6148, 6159, 6170, 6181, 6192, 6203, 6214, 6223, 6232, 6241, 6250, 6256, 6262, 6268, 6274, 6287, 6300, 6313, 6326, 6339, 6352, 6365, 6378, 6388, 6398, 6408, 6418, 6431, 6444, 6457, 6470, 6483, 6496, 6509, 6522, 6533, 6544, 6555, 6566, 6573, 6580, 6589, 6598, 6607, 6616, 6625, 6634, 6643, 6652, 6661, 6670, 6679, 6688, 6697, 6706, 6716, 6726, 6736, 6746, 6752, 6758, 6764, 6770, 6775, 6780, 6784, 6788, 6800, 6812, 6822, 6833, 6844, 6855, 6866, 6877, 6888, 6899, 6910, 6921, 6932, 6943, 6954, 6965, 6976, 6987, 6998, 7009, 7020, 7031, 7042, 7053, 7064, 7075, 7086, 7094, 7102, 7110, 7118, 7132, 7146, 7160, 7174, 7188, 7202, 7216, 7230, 7244, 7258, 7272, 7286, 7297, 7308, 7319, 7330, 7341, 7352, 7363, 7374, 7385, 7396, 7407, 7418, 7429, 7440, 7451, 7462, 7473, 7484, 7495, 7506, 7517, 7528, 7539, 7550, 7561, 7572, 7586, 7600, 7614, 7628, 7642, 7656, 7670, 7684, 7698, 7712, 7726, 7740, 7751, 7762, 7770, 7778, 7786, 7794, 7806, 7818, 7830, 7842, 7854, 7866, 7878, 7890, 7902, 7914, 7926, 7938, 7950, 7962, 7974, 7986, 7998, 8010, 8022, 8034, 8046, 8058, 8070, 8082, 8091, 8100, 8109, 8118, 8130, 8142, 8154, 8166, 8178, 8190, 8202, 8214, 8226, 8238, 8250, 8262, 8274, 8286, 8298, 8310, 8322, 8334, 8346, 8358, 8370, 8382, 8394, 8406, 8415, 8424, 8433, 8442, 8449, 8456, 8463, 8470, 8480, 8490, 8500, 8510, 8517, 8524, 8531, 8538, 8549, 8560, 8569, 8575, 8583, 8589, 8600, 8610, 8620, 8626, 8632, 8638, 8644, 8650, 8657, 8663, 8669, 8675, 8681, 8687, 8695, 8701, 8708, 8715, 8722, 8727, 8735, 8742, 8749, 8757, 8764, 8771, 8779, 8786, 8793, 8802, 8811, 8819, 8828, 8836, 8844, 8852, 8857, 8864, 8871, 8877, 8886, 8895, 8901, 8908, 8915, 8923, 8930, 8937, 8943, 8951, 8956, 8962, 8968, 8974, 8980, 8986, 8993, 8999, 9004, 9009, 9014, 9020, 9026, 9032, 9041, 9050, 9059, 9068, 9074, 9080, 9086, 9093, 9100, 9108, 9116, 9122, 9130, 9135, 9141, 9147, 9153, 9161, 9170, 9178, 9190, 9202, 9214, 9226, 9239, 9252, 9265, 9278, 9288, 9294, 9302, 9310, 9318, 9326, 9336, 9346, 9354, 9362, 9370, 9378, 9388, 9398, 9404, 9410, 9416, 9423, 9430, 9437, 9444, 9450, 9460, 9470, 9476, 9485, 9494, 9501, 9508, 9514, 9521, 9528, 9535, 9542, 9552, 9562, 9572, 9582, 9590, 9598, 9606, 9613, 9621, 9629, 9638, 9647, 9656, 9665, 9674, 9683, 9690, 9698, 9706, 9717, 9725, 9732, 9740, 9748, 9755, 9763, 9770, 9777, 9784, 9791, 9799, 9808, 9817, 9826, 9836, 9846, 9855, 9864, 9872, 9880, 9890, 9899, 9909, 9917, 9927, 9937, 9944, 9951, 9958, 9965, 9972, 9979, 9986, 9993, 10000, 10007, 10014, 10021, 10030, 10039, 10048, 10057, 10066, 10075, 10084, 10093, 10102, 10111, 10120, 10129, 10138, 10145, 10154, 10162, 10169, 10176, 10183, 10191, 10195, 10201, 10207, 10213, 10219, 10225, 10232, 10238, 10244, 10250, 10256, 10262, 10268, 10274, 10280, 10287, 10294, 10302, 10310, 10317, 10324, 10331, 10337, 10344, 10350, 10357, 10364, 10370, 10376, 10383, 10389, 10395, 10402, 10408, 10415, 10422, 10428, 10434, 10440, 10446, 10453, 10460, 10468, 10476, 10482, 10488, 10498, 10508, 10519, 10529, 10539, 10549, 10560, 10570, 10575, 10581, 10587, 10595, 10603, 10611, 10619, 10627, 10635, 10642, 10649, 10656, 10663, 10670, 10677, 10685, 10691, 10697, 10703, 10709, 10716, 10723, 10731, 10739, 10748, 10757, 10766, 10775, 10781, 10787, 10795}
+var instNameOffsets = [...]uint16{0, 3, 7, 10, 15, 20, 24, 27, 31, 36, 43, 47, 52, 58, 62, 69, 73, 78, 84, 88, 93, 98, 103, 109, 114, 120, 126, 129, 132, 137, 139, 142, 145, 148, 152, 156, 159, 162, 166, 170, 173, 176, 183, 187, 190, 194, 200, 203, 208, 214, 219, 225, 230, 235, 240, 246, 251, 257, 263, 270, 276, 283, 289, 295, 301, 308, 314, 321, 327, 333, 339, 345, 350, 355, 361, 367, 372, 377, 380, 387, 394, 401, 408, 415, 422, 430, 438, 446, 454, 462, 470, 478, 486, 491, 496, 501, 506, 511, 521, 531, 538, 548, 557, 563, 569, 574, 577, 582, 590, 598, 606, 614, 622, 630, 638, 646, 654, 662, 671, 680, 689, 698, 707, 716, 719, 723, 726, 729, 734, 739, 743, 748, 753, 759, 762, 767, 771, 775, 777, 780, 784, 788, 795, 799, 802, 807, 812, 816, 820, 824, 830, 836, 843, 850, 857, 861, 866, 871, 876, 878, 881, 883, 886, 888, 890, 895, 897, 900, 902, 905, 908, 911, 915, 918, 922, 925, 928, 931, 935, 938, 942, 945, 948, 951, 954, 956, 958, 961, 964, 969, 971, 973, 977, 980, 985, 992, 995, 1000, 1006, 1009, 1013, 1016, 1020, 1024, 1030, 1034, 1039, 1044, 1049, 1054, 1058, 1063, 1069, 1075, 1080, 1083, 1086, 1089, 1095, 1101, 1106, 1116, 1124, 1129, 1134, 1140, 1145, 1150, 1157, 1165, 1168, 1174, 1180, 1186, 1191, 1195, 1202, 1209, 1215, 1221, 1228, 1234, 1240, 1247, 1253, 1259, 1267, 1275, 1282, 1290, 1296, 1303, 1310, 1316, 1323, 1330, 1334, 1341, 1346, 1351, 1359, 1367, 1372, 1377, 1382, 1387, 1393, 1399, 1405, 1410, 1417, 1420, 1425, 1430, 1434, 1439, 1445, 1448, 1451, 1454, 1456, 1459, 1464, 1469, 1474, 1479, 1482, 1488, 1492, 1497, 1502, 1510, 1521, 1531, 1541, 1551, 1560, 1571, 1575, 1580, 1586, 1592, 1595, 1600, 1603, 1611, 1619, 1624, 1629, 1635, 1640, 1646, 1652, 1657, 1663, 1666, 1670, 1674, 1677, 1680, 1684, 1691, 1698, 1701, 1708, 1712, 1715, 1718, 1722, 1725, 1730, 1735, 1740, 1745, 1749, 1754, 1758, 1763, 1767, 1771, 1775, 1780, 1784, 1789, 1794, 1800, 1805, 1811, 1816, 1821, 1826, 1832, 1837, 1843, 1848, 1853, 1858, 1863, 1867, 1871, 1876, 1881, 1885, 1889, 1895, 1899, 1902, 1906, 1910, 1913, 1917, 1921, 1925, 1931, 1935, 1941, 1945, 1951, 1957, 1961, 1964, 1967, 1971, 1974, 1981, 1986, 1991, 1996, 2001, 2004, 2007, 2012, 2017, 2023, 2030, 2036, 2042, 2046, 2051, 2056, 2063, 2070, 2073, 2077, 2083, 2091, 2099, 2104, 2110, 2113, 2119, 2129, 2135, 2145, 2151, 2166, 2174, 2182, 2191, 2200, 2210, 2220, 2231, 2237, 2241, 2247, 2251, 2255, 2261, 2265, 2270, 2276, 2284, 2293, 2298, 2305, 2313, 2323, 2331, 2337, 2342, 2347, 2351, 2355, 2360, 2364, 2369, 2373, 2378, 2384, 2391, 2397, 2404, 2412, 2419, 2426, 2432, 2436, 2441, 2447, 2452, 2458, 2462, 2469, 2474, 2478, 2483, 2488, 2494, 2499, 2503, 2508, 2513, 2518, 2524, 2529, 2535, 2539, 2544, 2551, 2556, 2560, 2565, 2571, 2576, 2582, 2585, 2589, 2594, 2600, 2606, 2612, 2618, 2624, 2629, 2633, 2637, 2642, 2648, 2654, 2659, 2665, 2669, 2675, 2681, 2688, 2694, 2700, 2705, 2711, 2716, 2723, 2729, 2734, 2740, 2746, 2750, 2757, 2762, 2765, 2770, 2776, 2780, 2785, 2789, 2794, 2799, 2805, 2809, 2814, 2820, 2827, 2833, 2840, 2845, 2849, 2853, 2860, 2869, 2875, 2883, 2890, 2895, 2902, 2907, 2912, 2920, 2928, 2934, 2940, 2945, 2950, 2957, 2964, 2972, 2980, 2987, 2994, 3001, 3008, 3015, 3022, 3030, 3038, 3046, 3054, 3062, 3070, 3078, 3086, 3091, 3096, 3106, 3116, 3124, 3132, 3140, 3148, 3156, 3164, 3169, 3174, 3178, 3182, 3191, 3197, 3203, 3209, 3215, 3223, 3228, 3233, 3238, 3243, 3248, 3253, 3257, 3261, 3266, 3273, 3280, 3287, 3293, 3299, 3305, 3311, 3316, 3321, 3329, 3337, 3345, 3353, 3358, 3363, 3368, 3373, 3378, 3386, 3394, 3402, 3410, 3415, 3420, 3425, 3431, 3437, 3444, 3451, 3456, 3463, 3467, 3472, 3477, 3484, 3489, 3497, 3504, 3516, 3528, 3540, 3552, 3561, 3568, 3575, 3582, 3589, 3598, 3607, 3614, 3621, 3628, 3635, 3644, 3653, 3657, 3661, 3667, 3673, 3679, 3685, 3690, 3695, 3700, 3705, 3712, 3719, 3726, 3731, 3736, 3741, 3747, 3754, 3759, 3767, 3775, 3783, 3790, 3795, 3801, 3807, 3814, 3820, 3830, 3836, 3843, 3849, 3854, 3859, 3865, 3871, 3877, 3883, 3892, 3899, 3905, 3911, 3917, 3923, 3929, 3935, 3941, 3947, 3953, 3959, 3965, 3971, 3979, 3987, 3995, 4003, 4011, 4019, 4027, 4035, 4043, 4051, 4059, 4067, 4075, 4081, 4089, 4097, 4104, 4110, 4116, 4122, 4129, 4132, 4138, 4144, 4150, 4157, 4164, 4170, 4176, 4182, 4188, 4193, 4199, 4204, 4209, 4214, 4219, 4224, 4230, 4235, 4240, 4245, 4250, 4255, 4261, 4267, 4274, 4281, 4286, 4292, 4297, 4306, 4315, 4325, 4334, 4343, 4352, 4362, 4371, 4375, 4381, 4387, 4393, 4399, 4408, 4417, 4424, 4435, 4442, 4453, 4460, 4476, 4483, 4490, 4496, 4502, 4510, 4518, 4527, 4536, 4550, 4564, 4576, 4588, 4599, 4610, 4621, 4632, 4643, 4654, 4665, 4676, 4687, 4698, 4709, 4720, 4728, 4736, 4744, 4752, 4766, 4780, 4794, 4808, 4822, 4836, 4850, 4864, 4875, 4886, 4897, 4908, 4919, 4930, 4941, 4952, 4963, 4974, 4985, 4996, 5004, 5012, 5020, 5028, 5039, 5050, 5061, 5072, 5083, 5094, 5105, 5116, 5124, 5132, 5140, 5148, 5159, 5170, 5181, 5192, 5203, 5214, 5225, 5236, 5244, 5252, 5260, 5268, 5279, 5290, 5301, 5312, 5323, 5334, 5345, 5356, 5364, 5372, 5380, 5388, 5400, 5412, 5424, 5436, 5448, 5460, 5472, 5484, 5496, 5508, 5520, 5532, 5544, 5556, 5568, 5580, 5589, 5598, 5607, 5616, 5628, 5640, 5652, 5664, 5676, 5688, 5700, 5712, 5721, 5730, 5739, 5748, 5760, 5772, 5784, 5796, 5808, 5820, 5832, 5844, 5853, 5862, 5871, 5880, 5892, 5904, 5916, 5928, 5940, 5952, 5964, 5976, 5985, 5994, 6003, 6012, 6024, 6036, 6048, 6060, 6072, 6084, 6096, 6108, 6117, 6126, 6135, 6144, 6155, 6166, 6177, 6188, 6199, 6210, 6221, 6232, 6241, 6250, 6259, 6268, 6274, 6280, 6286, 6292, 6305, 6318, 6331, 6344, 6357, 6370, 6383, 6396, 6406, 6416, 6426, 6436, 6449, 6462, 6475, 6488, 6501, 6514, 6527, 6540, 6551, 6562, 6573, 6584, 6591, 6598, 6607, 6616, 6625, 6634, 6643, 6652, 6661, 6670, 6679, 6688, 6697, 6706, 6715, 6724, 6734, 6744, 6754, 6764, 6770, 6776, 6782, 6788, 6793, 6798, 6802, 6806, 6818, 6830, 6840, 6851, 6862, 6873, 6884, 6895, 6906, 6917, 6928, 6939, 6950, 6961, 6972, 6983, 6994, 7005, 7016, 7027, 7038, 7049, 7060, 7071, 7082, 7093, 7104, 7112, 7120, 7128, 7136, 7150, 7164, 7178, 7192, 7206, 7220, 7234, 7248, 7262, 7276, 7290, 7304, 7315, 7326, 7337, 7348, 7359, 7370, 7381, 7392, 7403, 7414, 7425, 7436, 7447, 7458, 7469, 7480, 7491, 7502, 7513, 7524, 7535, 7546, 7557, 7568, 7579, 7590, 7604, 7618, 7632, 7646, 7660, 7674, 7688, 7702, 7716, 7730, 7744, 7758, 7769, 7780, 7788, 7796, 7804, 7812, 7824, 7836, 7848, 7860, 7872, 7884, 7896, 7908, 7920, 7932, 7944, 7956, 7968, 7980, 7992, 8004, 8016, 8028, 8040, 8052, 8064, 8076, 8088, 8100, 8109, 8118, 8127, 8136, 8148, 8160, 8172, 8184, 8196, 8208, 8220, 8232, 8244, 8256, 8268, 8280, 8292, 8304, 8316, 8328, 8340, 8352, 8364, 8376, 8388, 8400, 8412, 8424, 8433, 8442, 8451, 8460, 8467, 8474, 8481, 8488, 8498, 8508, 8518, 8528, 8535, 8542, 8549, 8556, 8567, 8578, 8587, 8593, 8601, 8607, 8618, 8628, 8638, 8644, 8650, 8656, 8662, 8668, 8675, 8681, 8687, 8693, 8699, 8705, 8713, 8719, 8726, 8733, 8740, 8745, 8753, 8760, 8767, 8775, 8782, 8789, 8797, 8804, 8811, 8820, 8829, 8837, 8846, 8854, 8862, 8870, 8875, 8882, 8889, 8895, 8904, 8913, 8919, 8926, 8933, 8941, 8948, 8955, 8961, 8969, 8974, 8980, 8986, 8992, 8998, 9004, 9011, 9017, 9022, 9027, 9032, 9038, 9044, 9050, 9059, 9068, 9077, 9086, 9092, 9098, 9104, 9111, 9118, 9126, 9134, 9140, 9148, 9153, 9159, 9165, 9171, 9179, 9188, 9196, 9208, 9220, 9232, 9244, 9257, 9270, 9283, 9296, 9306, 9312, 9320, 9328, 9336, 9344, 9354, 9364, 9372, 9380, 9388, 9396, 9406, 9416, 9422, 9428, 9434, 9441, 9448, 9455, 9462, 9468, 9478, 9488, 9494, 9503, 9512, 9519, 9526, 9532, 9539, 9546, 9553, 9560, 9570, 9580, 9590, 9600, 9608, 9616, 9624, 9631, 9639, 9647, 9656, 9665, 9674, 9683, 9692, 9701, 9708, 9716, 9724, 9735, 9743, 9750, 9758, 9766, 9773, 9781, 9788, 9795, 9802, 9809, 9817, 9826, 9835, 9844, 9854, 9864, 9873, 9882, 9890, 9898, 9908, 9917, 9927, 9935, 9945, 9955, 9962, 9969, 9976, 9983, 9990, 9997, 10004, 10011, 10018, 10025, 10032, 10039, 10048, 10057, 10066, 10075, 10084, 10093, 10102, 10111, 10120, 10129, 10138, 10147, 10156, 10163, 10172, 10180, 10187, 10194, 10201, 10209, 10213, 10219, 10225, 10231, 10237, 10243, 10250, 10256, 10262, 10268, 10274, 10280, 10286, 10292, 10298, 10305, 10312, 10320, 10328, 10335, 10342, 10349, 10355, 10362, 10368, 10375, 10382, 10388, 10394, 10401, 10407, 10413, 10420, 10426, 10433, 10440, 10446, 10452, 10458, 10464, 10471, 10478, 10486, 10494, 10500, 10506, 10516, 10526, 10537, 10547, 10557, 10567, 10578, 10588, 10593, 10599, 10605, 10613, 10621, 10629, 10637, 10645, 10653, 10660, 10667, 10674, 10681, 10688, 10695, 10703, 10709, 10715, 10721, 10727, 10734, 10741, 10749, 10757, 10766, 10775, 10784, 10793, 10799, 10805, 10813}
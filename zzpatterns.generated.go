@@ -1,7 +1,7 @@
 package x64
 
 // THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT!
-// go build -o ./gen/gen ./gen/gen.go && ./gen/gen patterns > $output
+// go build -o ./gen/gen ./gen && ./gen/gen patterns > $output
 
 // Operand type/size patterns
 //